@@ -171,6 +171,24 @@ func (alerts *AlertsV1) DisableRetries() {
 	alerts.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (alerts *AlertsV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(alerts.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (alerts *AlertsV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(alerts.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (alerts *AlertsV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(alerts.Service, middleware...)
+}
+
 // GetAlertPolicies : List alert policies
 // List configured alert policies for the CIS instance.
 func (alerts *AlertsV1) GetAlertPolicies(getAlertPoliciesOptions *GetAlertPoliciesOptions) (result *ListAlertPoliciesResp, response *core.DetailedResponse, err error) {
@@ -214,6 +232,7 @@ func (alerts *AlertsV1) GetAlertPoliciesWithContext(ctx context.Context, getAler
 	var rawResponse map[string]json.RawMessage
 	response, err = alerts.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -298,6 +317,7 @@ func (alerts *AlertsV1) CreateAlertPolicyWithContext(ctx context.Context, create
 	var rawResponse map[string]json.RawMessage
 	response, err = alerts.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -359,6 +379,7 @@ func (alerts *AlertsV1) GetAlertPolicyWithContext(ctx context.Context, getAlertP
 	var rawResponse map[string]json.RawMessage
 	response, err = alerts.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -448,6 +469,7 @@ func (alerts *AlertsV1) UpdateAlertPolicyWithContext(ctx context.Context, update
 	var rawResponse map[string]json.RawMessage
 	response, err = alerts.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -509,6 +531,7 @@ func (alerts *AlertsV1) DeleteAlertPolicyWithContext(ctx context.Context, delete
 	var rawResponse map[string]json.RawMessage
 	response, err = alerts.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,41 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alertsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AlertsV1API is the interface of the operations of AlertsV1, through which they can be replaced
+// in unit tests, for instance with the mocks.AlertsV1 of the mocks package.
+type AlertsV1API interface {
+	GetAlertPolicies(getAlertPoliciesOptions *GetAlertPoliciesOptions) (result *ListAlertPoliciesResp, response *core.DetailedResponse, err error)
+	GetAlertPoliciesWithContext(ctx context.Context, getAlertPoliciesOptions *GetAlertPoliciesOptions) (result *ListAlertPoliciesResp, response *core.DetailedResponse, err error)
+	CreateAlertPolicy(createAlertPolicyOptions *CreateAlertPolicyOptions) (result *AlertSuccessResp, response *core.DetailedResponse, err error)
+	CreateAlertPolicyWithContext(ctx context.Context, createAlertPolicyOptions *CreateAlertPolicyOptions) (result *AlertSuccessResp, response *core.DetailedResponse, err error)
+	GetAlertPolicy(getAlertPolicyOptions *GetAlertPolicyOptions) (result *GetAlertPolicyResp, response *core.DetailedResponse, err error)
+	GetAlertPolicyWithContext(ctx context.Context, getAlertPolicyOptions *GetAlertPolicyOptions) (result *GetAlertPolicyResp, response *core.DetailedResponse, err error)
+	UpdateAlertPolicy(updateAlertPolicyOptions *UpdateAlertPolicyOptions) (result *AlertSuccessResp, response *core.DetailedResponse, err error)
+	UpdateAlertPolicyWithContext(ctx context.Context, updateAlertPolicyOptions *UpdateAlertPolicyOptions) (result *AlertSuccessResp, response *core.DetailedResponse, err error)
+	DeleteAlertPolicy(deleteAlertPolicyOptions *DeleteAlertPolicyOptions) (result *AlertSuccessResp, response *core.DetailedResponse, err error)
+	DeleteAlertPolicyWithContext(ctx context.Context, deleteAlertPolicyOptions *DeleteAlertPolicyOptions) (result *AlertSuccessResp, response *core.DetailedResponse, err error)
+}
+
+// AlertsV1 implements AlertsV1API.
+var _ AlertsV1API = (*AlertsV1)(nil)
@@ -179,6 +179,24 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) DisableRetries()
 	authenticatedOriginPullApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(authenticatedOriginPullApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(authenticatedOriginPullApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(authenticatedOriginPullApi.Service, middleware...)
+}
+
 // GetZoneOriginPullSettings : Get Zone level Authenticated Origin Pull Settings
 // Get whether zone-level authenticated origin pulls is enabled or not. It is false by default.
 func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) GetZoneOriginPullSettings(getZoneOriginPullSettingsOptions *GetZoneOriginPullSettingsOptions) (result *GetZoneOriginPullSettingsResp, response *core.DetailedResponse, err error) {
@@ -226,6 +244,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) GetZoneOriginPul
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -297,6 +316,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) SetZoneOriginPul
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -357,6 +377,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) ListZoneOriginPu
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -433,6 +454,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) UploadZoneOrigin
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -498,6 +520,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) GetZoneOriginPul
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -563,6 +586,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) DeleteZoneOrigin
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -635,6 +659,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) SetHostnameOrigi
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -700,6 +725,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) GetHostnameOrigi
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -774,6 +800,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) UploadHostnameOr
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -839,6 +866,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) GetHostnameOrigi
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -904,6 +932,7 @@ func (authenticatedOriginPullApi *AuthenticatedOriginPullApiV1) DeleteHostnameOr
 	var rawResponse map[string]json.RawMessage
 	response, err = authenticatedOriginPullApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,53 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package authenticatedoriginpullapiv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AuthenticatedOriginPullApiV1API is the interface of the operations of AuthenticatedOriginPullApiV1, through which they can be replaced
+// in unit tests, for instance with the mocks.AuthenticatedOriginPullApiV1 of the mocks package.
+type AuthenticatedOriginPullApiV1API interface {
+	GetZoneOriginPullSettings(getZoneOriginPullSettingsOptions *GetZoneOriginPullSettingsOptions) (result *GetZoneOriginPullSettingsResp, response *core.DetailedResponse, err error)
+	GetZoneOriginPullSettingsWithContext(ctx context.Context, getZoneOriginPullSettingsOptions *GetZoneOriginPullSettingsOptions) (result *GetZoneOriginPullSettingsResp, response *core.DetailedResponse, err error)
+	SetZoneOriginPullSettings(setZoneOriginPullSettingsOptions *SetZoneOriginPullSettingsOptions) (result *GetZoneOriginPullSettingsResp, response *core.DetailedResponse, err error)
+	SetZoneOriginPullSettingsWithContext(ctx context.Context, setZoneOriginPullSettingsOptions *SetZoneOriginPullSettingsOptions) (result *GetZoneOriginPullSettingsResp, response *core.DetailedResponse, err error)
+	ListZoneOriginPullCertificates(listZoneOriginPullCertificatesOptions *ListZoneOriginPullCertificatesOptions) (result *ListZoneOriginPullCertificatesResp, response *core.DetailedResponse, err error)
+	ListZoneOriginPullCertificatesWithContext(ctx context.Context, listZoneOriginPullCertificatesOptions *ListZoneOriginPullCertificatesOptions) (result *ListZoneOriginPullCertificatesResp, response *core.DetailedResponse, err error)
+	UploadZoneOriginPullCertificate(uploadZoneOriginPullCertificateOptions *UploadZoneOriginPullCertificateOptions) (result *ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	UploadZoneOriginPullCertificateWithContext(ctx context.Context, uploadZoneOriginPullCertificateOptions *UploadZoneOriginPullCertificateOptions) (result *ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	GetZoneOriginPullCertificate(getZoneOriginPullCertificateOptions *GetZoneOriginPullCertificateOptions) (result *ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	GetZoneOriginPullCertificateWithContext(ctx context.Context, getZoneOriginPullCertificateOptions *GetZoneOriginPullCertificateOptions) (result *ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	DeleteZoneOriginPullCertificate(deleteZoneOriginPullCertificateOptions *DeleteZoneOriginPullCertificateOptions) (result *ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	DeleteZoneOriginPullCertificateWithContext(ctx context.Context, deleteZoneOriginPullCertificateOptions *DeleteZoneOriginPullCertificateOptions) (result *ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	SetHostnameOriginPullSettings(setHostnameOriginPullSettingsOptions *SetHostnameOriginPullSettingsOptions) (result *ListHostnameOriginPullSettingsResp, response *core.DetailedResponse, err error)
+	SetHostnameOriginPullSettingsWithContext(ctx context.Context, setHostnameOriginPullSettingsOptions *SetHostnameOriginPullSettingsOptions) (result *ListHostnameOriginPullSettingsResp, response *core.DetailedResponse, err error)
+	GetHostnameOriginPullSettings(getHostnameOriginPullSettingsOptions *GetHostnameOriginPullSettingsOptions) (result *GetHostnameOriginPullSettingsResp, response *core.DetailedResponse, err error)
+	GetHostnameOriginPullSettingsWithContext(ctx context.Context, getHostnameOriginPullSettingsOptions *GetHostnameOriginPullSettingsOptions) (result *GetHostnameOriginPullSettingsResp, response *core.DetailedResponse, err error)
+	UploadHostnameOriginPullCertificate(uploadHostnameOriginPullCertificateOptions *UploadHostnameOriginPullCertificateOptions) (result *HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	UploadHostnameOriginPullCertificateWithContext(ctx context.Context, uploadHostnameOriginPullCertificateOptions *UploadHostnameOriginPullCertificateOptions) (result *HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	GetHostnameOriginPullCertificate(getHostnameOriginPullCertificateOptions *GetHostnameOriginPullCertificateOptions) (result *HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	GetHostnameOriginPullCertificateWithContext(ctx context.Context, getHostnameOriginPullCertificateOptions *GetHostnameOriginPullCertificateOptions) (result *HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	DeleteHostnameOriginPullCertificate(deleteHostnameOriginPullCertificateOptions *DeleteHostnameOriginPullCertificateOptions) (result *HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error)
+	DeleteHostnameOriginPullCertificateWithContext(ctx context.Context, deleteHostnameOriginPullCertificateOptions *DeleteHostnameOriginPullCertificateOptions) (result *HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error)
+}
+
+// AuthenticatedOriginPullApiV1 implements AuthenticatedOriginPullApiV1API.
+var _ AuthenticatedOriginPullApiV1API = (*AuthenticatedOriginPullApiV1)(nil)
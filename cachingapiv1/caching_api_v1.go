@@ -178,6 +178,24 @@ func (cachingApi *CachingApiV1) DisableRetries() {
 	cachingApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (cachingApi *CachingApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(cachingApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (cachingApi *CachingApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(cachingApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (cachingApi *CachingApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(cachingApi.Service, middleware...)
+}
+
 // PurgeAll : Purge all
 // All resources in CDN edge servers' cache should be removed. This may have dramatic affects on your origin server load
 // after performing this action.
@@ -223,6 +241,7 @@ func (cachingApi *CachingApiV1) PurgeAllWithContext(ctx context.Context, purgeAl
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPurgeAllResponse)
@@ -288,6 +307,7 @@ func (cachingApi *CachingApiV1) PurgeByUrlsWithContext(ctx context.Context, purg
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPurgeAllResponse)
@@ -353,6 +373,7 @@ func (cachingApi *CachingApiV1) PurgeByCacheTagsWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPurgeAllResponse)
@@ -418,6 +439,7 @@ func (cachingApi *CachingApiV1) PurgeByHostsWithContext(ctx context.Context, pur
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPurgeAllResponse)
@@ -474,6 +496,7 @@ func (cachingApi *CachingApiV1) GetBrowserCacheTTLWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalBrowserTTLResponse)
@@ -540,6 +563,7 @@ func (cachingApi *CachingApiV1) UpdateBrowserCacheTTLWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalBrowserTTLResponse)
@@ -595,6 +619,7 @@ func (cachingApi *CachingApiV1) GetServeStaleContentWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalServeStaleContentResponse)
@@ -660,6 +685,7 @@ func (cachingApi *CachingApiV1) UpdateServeStaleContentWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalServeStaleContentResponse)
@@ -715,6 +741,7 @@ func (cachingApi *CachingApiV1) GetDevelopmentModeWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeveopmentModeResponse)
@@ -780,6 +807,7 @@ func (cachingApi *CachingApiV1) UpdateDevelopmentModeWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeveopmentModeResponse)
@@ -835,6 +863,7 @@ func (cachingApi *CachingApiV1) GetQueryStringSortWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalEnableQueryStringSortResponse)
@@ -900,6 +929,7 @@ func (cachingApi *CachingApiV1) UpdateQueryStringSortWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalEnableQueryStringSortResponse)
@@ -955,6 +985,7 @@ func (cachingApi *CachingApiV1) GetCacheLevelWithContext(ctx context.Context, ge
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCacheLevelResponse)
@@ -1022,6 +1053,7 @@ func (cachingApi *CachingApiV1) UpdateCacheLevelWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = cachingApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCacheLevelResponse)
@@ -0,0 +1,59 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cachingapiv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CachingApiV1API is the interface of the operations of CachingApiV1, through which they can be replaced
+// in unit tests, for instance with the mocks.CachingApiV1 of the mocks package.
+type CachingApiV1API interface {
+	PurgeAll(purgeAllOptions *PurgeAllOptions) (result *PurgeAllResponse, response *core.DetailedResponse, err error)
+	PurgeAllWithContext(ctx context.Context, purgeAllOptions *PurgeAllOptions) (result *PurgeAllResponse, response *core.DetailedResponse, err error)
+	PurgeByUrls(purgeByUrlsOptions *PurgeByUrlsOptions) (result *PurgeAllResponse, response *core.DetailedResponse, err error)
+	PurgeByUrlsWithContext(ctx context.Context, purgeByUrlsOptions *PurgeByUrlsOptions) (result *PurgeAllResponse, response *core.DetailedResponse, err error)
+	PurgeByCacheTags(purgeByCacheTagsOptions *PurgeByCacheTagsOptions) (result *PurgeAllResponse, response *core.DetailedResponse, err error)
+	PurgeByCacheTagsWithContext(ctx context.Context, purgeByCacheTagsOptions *PurgeByCacheTagsOptions) (result *PurgeAllResponse, response *core.DetailedResponse, err error)
+	PurgeByHosts(purgeByHostsOptions *PurgeByHostsOptions) (result *PurgeAllResponse, response *core.DetailedResponse, err error)
+	PurgeByHostsWithContext(ctx context.Context, purgeByHostsOptions *PurgeByHostsOptions) (result *PurgeAllResponse, response *core.DetailedResponse, err error)
+	GetBrowserCacheTTL(getBrowserCacheTtlOptions *GetBrowserCacheTtlOptions) (result *BrowserTTLResponse, response *core.DetailedResponse, err error)
+	GetBrowserCacheTTLWithContext(ctx context.Context, getBrowserCacheTtlOptions *GetBrowserCacheTtlOptions) (result *BrowserTTLResponse, response *core.DetailedResponse, err error)
+	UpdateBrowserCacheTTL(updateBrowserCacheTtlOptions *UpdateBrowserCacheTtlOptions) (result *BrowserTTLResponse, response *core.DetailedResponse, err error)
+	UpdateBrowserCacheTTLWithContext(ctx context.Context, updateBrowserCacheTtlOptions *UpdateBrowserCacheTtlOptions) (result *BrowserTTLResponse, response *core.DetailedResponse, err error)
+	GetServeStaleContent(getServeStaleContentOptions *GetServeStaleContentOptions) (result *ServeStaleContentResponse, response *core.DetailedResponse, err error)
+	GetServeStaleContentWithContext(ctx context.Context, getServeStaleContentOptions *GetServeStaleContentOptions) (result *ServeStaleContentResponse, response *core.DetailedResponse, err error)
+	UpdateServeStaleContent(updateServeStaleContentOptions *UpdateServeStaleContentOptions) (result *ServeStaleContentResponse, response *core.DetailedResponse, err error)
+	UpdateServeStaleContentWithContext(ctx context.Context, updateServeStaleContentOptions *UpdateServeStaleContentOptions) (result *ServeStaleContentResponse, response *core.DetailedResponse, err error)
+	GetDevelopmentMode(getDevelopmentModeOptions *GetDevelopmentModeOptions) (result *DeveopmentModeResponse, response *core.DetailedResponse, err error)
+	GetDevelopmentModeWithContext(ctx context.Context, getDevelopmentModeOptions *GetDevelopmentModeOptions) (result *DeveopmentModeResponse, response *core.DetailedResponse, err error)
+	UpdateDevelopmentMode(updateDevelopmentModeOptions *UpdateDevelopmentModeOptions) (result *DeveopmentModeResponse, response *core.DetailedResponse, err error)
+	UpdateDevelopmentModeWithContext(ctx context.Context, updateDevelopmentModeOptions *UpdateDevelopmentModeOptions) (result *DeveopmentModeResponse, response *core.DetailedResponse, err error)
+	GetQueryStringSort(getQueryStringSortOptions *GetQueryStringSortOptions) (result *EnableQueryStringSortResponse, response *core.DetailedResponse, err error)
+	GetQueryStringSortWithContext(ctx context.Context, getQueryStringSortOptions *GetQueryStringSortOptions) (result *EnableQueryStringSortResponse, response *core.DetailedResponse, err error)
+	UpdateQueryStringSort(updateQueryStringSortOptions *UpdateQueryStringSortOptions) (result *EnableQueryStringSortResponse, response *core.DetailedResponse, err error)
+	UpdateQueryStringSortWithContext(ctx context.Context, updateQueryStringSortOptions *UpdateQueryStringSortOptions) (result *EnableQueryStringSortResponse, response *core.DetailedResponse, err error)
+	GetCacheLevel(getCacheLevelOptions *GetCacheLevelOptions) (result *CacheLevelResponse, response *core.DetailedResponse, err error)
+	GetCacheLevelWithContext(ctx context.Context, getCacheLevelOptions *GetCacheLevelOptions) (result *CacheLevelResponse, response *core.DetailedResponse, err error)
+	UpdateCacheLevel(updateCacheLevelOptions *UpdateCacheLevelOptions) (result *CacheLevelResponse, response *core.DetailedResponse, err error)
+	UpdateCacheLevelWithContext(ctx context.Context, updateCacheLevelOptions *UpdateCacheLevelOptions) (result *CacheLevelResponse, response *core.DetailedResponse, err error)
+}
+
+// CachingApiV1 implements CachingApiV1API.
+var _ CachingApiV1API = (*CachingApiV1)(nil)
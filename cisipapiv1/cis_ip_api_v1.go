@@ -159,6 +159,24 @@ func (cisIpApi *CisIpApiV1) DisableRetries() {
 	cisIpApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (cisIpApi *CisIpApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(cisIpApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (cisIpApi *CisIpApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(cisIpApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (cisIpApi *CisIpApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(cisIpApi.Service, middleware...)
+}
+
 // ListIps : List of all IP addresses used by the CIS proxy
 // List of all IP addresses used by the CIS proxy.
 func (cisIpApi *CisIpApiV1) ListIps(listIpsOptions *ListIpsOptions) (result *IpResponse, response *core.DetailedResponse, err error) {
@@ -198,6 +216,7 @@ func (cisIpApi *CisIpApiV1) ListIpsWithContext(ctx context.Context, listIpsOptio
 	var rawResponse map[string]json.RawMessage
 	response, err = cisIpApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalIpResponse)
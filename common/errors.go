@@ -0,0 +1,228 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The response headers that may carry the trace or correlation ID of a request, in order of preference.
+var traceHeaders = []string{"X-Correlation-ID", "X-Request-ID", "Transaction-Id", "CF-Ray"}
+
+// APIErrorDetail is one of the errors reported in the body of an error response.
+type APIErrorDetail struct {
+	// The service-specific error code (e.g. "not_found", or "1003" for a numeric CIS code).
+	Code string
+
+	// The error message.
+	Message string
+
+	// A link to more information about the error, if any.
+	MoreInfo string
+}
+
+// APIError is the error returned by the operations of the service packages when the service responds
+// with an error status code. It decodes the error envelopes of the different services:
+//
+//   CIS:                   {"success": false, "errors": [{"code": 1003, "message": "..."}], "messages": []}
+//   DNS Services:          {"code": "...", "message": "...", "trace": "..."}
+//   Direct Link, Transit:  {"errors": [{"code": "...", "message": "...", "more_info": "..."}], "trace": "..."}
+//
+// Use errors.As to retrieve it from the error returned by an operation:
+//
+//   var apiErr *common.APIError
+//   if errors.As(err, &apiErr) { ... }
+type APIError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The errors reported in the response body, if any.
+	Errors []APIErrorDetail
+
+	// The trace or correlation ID of the request, taken from the response body or headers.
+	Trace string
+
+	// The response, whose Result holds the decoded response body.
+	Response *core.DetailedResponse
+
+	// The error returned by the base service, which provides the error message.
+	Err error
+}
+
+// NewAPIError returns an *APIError describing the error response, or err itself if no response was
+// received (e.g. the connection failed) or err is nil.
+func NewAPIError(response *core.DetailedResponse, err error) error {
+	if err == nil || response == nil {
+		return err
+	}
+	apiErr := &APIError{
+		StatusCode: response.StatusCode,
+		Response:   response,
+		Err:        err,
+	}
+
+	result, ok := response.Result.(map[string]interface{})
+	if !ok && len(response.RawResult) > 0 {
+		_ = json.Unmarshal(response.RawResult, &result)
+	}
+	apiErr.Errors, apiErr.Trace = decodeErrorEnvelope(result)
+	if apiErr.Trace == "" {
+		for _, header := range traceHeaders {
+			if trace := response.GetHeaders().Get(header); trace != "" {
+				apiErr.Trace = trace
+				break
+			}
+		}
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error returned by the base service.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Codes returns the service-specific error codes reported in the response body.
+func (e *APIError) Codes() (codes []string) {
+	for _, detail := range e.Errors {
+		if detail.Code != "" {
+			codes = append(codes, detail.Code)
+		}
+	}
+	return
+}
+
+// HasCode returns true if the response body reports the given service-specific error code.
+func (e *APIError) HasCode(code string) bool {
+	return core.SliceContains(e.Codes(), code)
+}
+
+// Retryable returns true if the request was throttled, failed with a transient server error, or was
+// rejected with one of the CisRetryableErrorCodes or DnsSvcsRetryableErrorCodes. Note that retrying
+// a non-idempotent operation after a server error may repeat its effect; see RetryPolicy.
+func (e *APIError) Retryable() bool {
+	if e.StatusCode == http.StatusTooManyRequests ||
+		(e.StatusCode >= 500 && e.StatusCode != http.StatusNotImplemented) {
+		return true
+	}
+	for _, code := range e.Codes() {
+		if core.SliceContains(CisRetryableErrorCodes, code) || core.SliceContains(DnsSvcsRetryableErrorCodes, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// AsAPIError returns the *APIError in the chain of err, or nil if there is none.
+func AsAPIError(err error) *APIError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	return nil
+}
+
+// IsNotFound returns true if err is an *APIError for a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict returns true if err is an *APIError for a 409 Conflict response.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsRateLimited returns true if err is an *APIError for a request that was throttled by the service.
+func IsRateLimited(err error) bool {
+	apiErr := AsAPIError(err)
+	if apiErr == nil {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	for _, code := range CisRetryableErrorCodes {
+		if apiErr.HasCode(code) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRetryable returns true if err is an *APIError that is Retryable.
+func IsRetryable(err error) bool {
+	apiErr := AsAPIError(err)
+	return apiErr != nil && apiErr.Retryable()
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	apiErr := AsAPIError(err)
+	return apiErr != nil && apiErr.StatusCode == statusCode
+}
+
+// decodeErrorEnvelope returns the errors and trace found in a decoded error response body.
+func decodeErrorEnvelope(result map[string]interface{}) (details []APIErrorDetail, trace string) {
+	if result == nil {
+		return
+	}
+	if errs, ok := result["errors"].([]interface{}); ok {
+		for _, e := range errs {
+			if e, ok := e.(map[string]interface{}); ok {
+				details = append(details, APIErrorDetail{
+					Code:     jsonString(e["code"]),
+					Message:  jsonString(e["message"]),
+					MoreInfo: jsonString(e["more_info"]),
+				})
+			}
+		}
+	}
+	if len(details) == 0 {
+		code := jsonString(result["code"])
+		if code == "" {
+			code = jsonString(result["error_code"])
+		}
+		message := jsonString(result["message"])
+		if message == "" {
+			message = jsonString(result["error"])
+		}
+		if code != "" || message != "" {
+			details = append(details, APIErrorDetail{Code: code, Message: message})
+		}
+	}
+	trace = jsonString(result["trace"])
+	return
+}
+
+// jsonString returns a string or numeric JSON value as a string, or "" for any other value.
+func jsonString(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return ""
+}
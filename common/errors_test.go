@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// requestError invokes a request against a server that answers with the given status code, headers
+// and body, and returns the error built by NewAPIError.
+func requestError(t *testing.T, statusCode int, header http.Header, body string) error {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		for name, values := range header {
+			res.Header()[name] = values
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(statusCode)
+		fmt.Fprint(res, body)
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ResolveRequestURL(server.URL, "/resource", nil)
+	assert.Nil(t, err)
+	request, err := builder.Build()
+	assert.Nil(t, err)
+
+	var result map[string]interface{}
+	response, err := service.Request(request, &result)
+	return NewAPIError(response, err)
+}
+
+func TestAPIErrorCisEnvelope(t *testing.T) {
+	err := requestError(t, 400, http.Header{"Cf-Ray": {"6c2b1b2b2c3d4e5f"}},
+		`{"success": false, "errors": [{"code": 1003, "message": "Invalid or missing zone id."}], "messages": []}`)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 400, apiErr.StatusCode)
+	assert.Equal(t, []string{"1003"}, apiErr.Codes())
+	assert.True(t, apiErr.HasCode("1003"))
+	assert.Equal(t, "Invalid or missing zone id.", apiErr.Errors[0].Message)
+	assert.Equal(t, "6c2b1b2b2c3d4e5f", apiErr.Trace)
+	assert.False(t, apiErr.Retryable())
+	assert.Equal(t, "Invalid or missing zone id.", err.Error())
+
+	err = requestError(t, 400, nil, `{"success": false, "errors": [{"code": 971, "message": "Please wait and consider throttling your request speed"}]}`)
+	assert.True(t, IsRateLimited(err))
+	assert.True(t, IsRetryable(err))
+}
+
+func TestAPIErrorDnsSvcsEnvelope(t *testing.T) {
+	err := requestError(t, 409, nil, `{"code": "resource_busy", "message": "The zone is being updated.", "trace": "trace-1"}`)
+
+	apiErr := AsAPIError(err)
+	assert.NotNil(t, apiErr)
+	assert.Equal(t, 409, apiErr.StatusCode)
+	assert.Equal(t, []string{"resource_busy"}, apiErr.Codes())
+	assert.Equal(t, "trace-1", apiErr.Trace)
+	assert.True(t, IsConflict(err))
+	assert.True(t, apiErr.Retryable())
+	assert.False(t, IsNotFound(err))
+
+	// A record conflict is permanent.
+	err = requestError(t, 409, nil, `{"code": "conflict", "message": "A type record conflict with other records", "trace": "trace-2"}`)
+	assert.True(t, IsConflict(err))
+	assert.False(t, IsRetryable(err))
+}
+
+func TestAPIErrorPlatformEnvelope(t *testing.T) {
+	err := requestError(t, 404, http.Header{"X-Request-Id": {"request-1"}},
+		`{"errors": [{"code": "not_found", "message": "Gateway not found", "more_info": "https://cloud.ibm.com/docs"}], "trace": "trace-2"}`)
+
+	apiErr := AsAPIError(fmt.Errorf("get gateway: %w", err))
+	assert.NotNil(t, apiErr)
+	assert.Equal(t, "not_found", apiErr.Errors[0].Code)
+	assert.Equal(t, "https://cloud.ibm.com/docs", apiErr.Errors[0].MoreInfo)
+	assert.Equal(t, "trace-2", apiErr.Trace)
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsRateLimited(err))
+
+	err = requestError(t, 429, http.Header{"X-Correlation-Id": {"correlation-1"}}, `{"errors": [{"code": "too_many_requests", "message": "Slow down"}]}`)
+	assert.True(t, IsRateLimited(err))
+	assert.Equal(t, "correlation-1", AsAPIError(err).Trace)
+
+	err = requestError(t, 503, nil, ``)
+	assert.True(t, IsRetryable(err))
+	assert.Empty(t, AsAPIError(err).Errors)
+}
+
+func TestNewAPIErrorWithoutResponse(t *testing.T) {
+	assert.Nil(t, NewAPIError(nil, nil))
+
+	transportErr := errors.New("connection refused")
+	err := NewAPIError(nil, transportErr)
+	assert.Equal(t, transportErr, err)
+	assert.Nil(t, AsAPIError(err))
+	assert.False(t, IsNotFound(err))
+}
@@ -18,12 +18,15 @@ package common
 
 import (
 	"fmt"
+	"net/http"
 	"runtime"
+	"strings"
 )
 
 const (
 	sdkName = "networking-go-sdk"
 	headerNameUserAgent = "User-Agent"
+	headerNameSdkAnalytics = "X-IBMCloud-SDK-Analytics"
 )
 
 //
@@ -65,10 +68,43 @@ func GetSdkHeaders(serviceName string, serviceVersion string, operationId string
 	sdkHeaders := make(map[string]string)
 
 	sdkHeaders[headerNameUserAgent] = GetUserAgentInfo()
+	sdkHeaders[headerNameSdkAnalytics] = fmt.Sprintf("service_name=%s;service_version=%s;operation_id=%s",
+		serviceName, serviceVersion, operationId)
 
 	return sdkHeaders
 }
 
+//
+// GetSdkAnalytics - returns the service name, service version and operationId recorded by GetSdkHeaders
+// in the headers of an outgoing request, or empty strings if the headers do not carry them.
+//
+// This allows HTTP-level components, such as the RetryTransport, to tell which operation a request belongs to.
+//
+func GetSdkAnalytics(header http.Header) (serviceName string, serviceVersion string, operationId string) {
+	// The request builder stores header names as given, so the name may not be in canonical form.
+	var value string
+	for name, values := range header {
+		if strings.EqualFold(name, headerNameSdkAnalytics) && len(values) > 0 {
+			value = values[0]
+		}
+	}
+	for _, field := range strings.Split(value, ";") {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "service_name":
+			serviceName = kv[1]
+		case "service_version":
+			serviceVersion = kv[1]
+		case "operation_id":
+			operationId = kv[1]
+		}
+	}
+	return
+}
+
 var userAgent string = fmt.Sprintf("%s/%s %s", sdkName, Version, GetSystemInfo())
 
 func GetUserAgentInfo() string {
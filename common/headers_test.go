@@ -18,6 +18,7 @@ package common
 
 import (
 	"github.com/stretchr/testify/assert"
+	"net/http"
 	"strings"
 	"testing"
 )
@@ -41,3 +42,18 @@ func TestGetSdkHeaders(t *testing.T) {
 	assert.True(t, foundIt)
 	t.Logf("user agent: %s\n", headers[headerNameUserAgent])
 }
+
+func TestGetSdkAnalytics(t *testing.T) {
+	header := http.Header{}
+	for name, value := range GetSdkHeaders("dns_svcs", "V1", "CreateResourceRecord") {
+		header.Set(name, value)
+	}
+
+	serviceName, serviceVersion, operationId := GetSdkAnalytics(header)
+	assert.Equal(t, "dns_svcs", serviceName)
+	assert.Equal(t, "V1", serviceVersion)
+	assert.Equal(t, "CreateResourceRecord", operationId)
+
+	_, _, operationId = GetSdkAnalytics(http.Header{})
+	assert.Equal(t, "", operationId)
+}
@@ -0,0 +1,319 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/google/uuid"
+)
+
+// CorrelationIDHeader is the header that carries the correlation ID of a request.
+const CorrelationIDHeader = "X-Correlation-ID"
+
+// Handler sends a request and returns its response.
+type Handler func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the Handler that sends the requests of a service with another Handler, which can
+// change the request, observe or change the response, or answer the request without calling next:
+//
+//   func(next common.Handler) common.Handler {
+//       return func(req *http.Request) (*http.Response, error) {
+//           req.Header.Set("X-Tenant", "tenant-1")
+//           return next(req)
+//       }
+//   }
+//
+// Use EnableMiddleware to install middleware on a service, or UseGlobalMiddleware to install it on
+// every service with middleware enabled.
+type Middleware func(next Handler) Handler
+
+var (
+	globalMiddlewareMutex sync.RWMutex
+	globalMiddleware      []Middleware
+)
+
+// UseGlobalMiddleware adds middleware that every MiddlewareTransport runs before its own.
+func UseGlobalMiddleware(middleware ...Middleware) {
+	globalMiddlewareMutex.Lock()
+	defer globalMiddlewareMutex.Unlock()
+	globalMiddleware = append(globalMiddleware, middleware...)
+}
+
+// ResetGlobalMiddleware removes the middleware added with UseGlobalMiddleware.
+func ResetGlobalMiddleware() {
+	globalMiddlewareMutex.Lock()
+	defer globalMiddlewareMutex.Unlock()
+	globalMiddleware = nil
+}
+
+// MiddlewareTransport is an http.RoundTripper that passes each request through the global middleware
+// and then through its own, in the order in which they were added, before sending it with Base.
+type MiddlewareTransport struct {
+	// The transport that sends the requests.
+	Base http.RoundTripper
+
+	mutex      sync.RWMutex
+	middleware []Middleware
+}
+
+// NewMiddlewareTransport returns a MiddlewareTransport that sends requests with base, or with a default
+// transport if base is nil.
+func NewMiddlewareTransport(base http.RoundTripper, middleware ...Middleware) *MiddlewareTransport {
+	if base == nil {
+		base = core.DefaultHTTPClient().Transport
+	}
+	return &MiddlewareTransport{Base: base, middleware: middleware}
+}
+
+// Use adds middleware to the transport.
+func (transport *MiddlewareTransport) Use(middleware ...Middleware) *MiddlewareTransport {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+	transport.middleware = append(transport.middleware, middleware...)
+	return transport
+}
+
+// RoundTrip passes the request through the middleware, and sends it.
+func (transport *MiddlewareTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	globalMiddlewareMutex.RLock()
+	chain := append([]Middleware(nil), globalMiddleware...)
+	globalMiddlewareMutex.RUnlock()
+	transport.mutex.RLock()
+	chain = append(chain, transport.middleware...)
+	transport.mutex.RUnlock()
+
+	handler := Handler(transport.Base.RoundTrip)
+	for i := len(chain) - 1; i >= 0; i-- {
+		handler = chain[i](handler)
+	}
+	// The request must not be modified by a RoundTripper, so the middleware is given a copy.
+	return handler(req.Clone(req.Context()))
+}
+
+// EnableMiddleware adds middleware to the MiddlewareTransport of the service, which is installed in
+// front of the transport the service already uses if it has none, and returns it. As EnableRetries,
+// DisableRetries and DisableSSLVerification replace the HTTP client of a service, and with it the
+// middleware, enable the middleware after them.
+func EnableMiddleware(service *core.BaseService, middleware ...Middleware) *MiddlewareTransport {
+	client := core.DefaultHTTPClient()
+	if service.Client != nil {
+		if transport, ok := service.Client.Transport.(*MiddlewareTransport); ok {
+			return transport.Use(middleware...)
+		}
+		// Copy the client, which may be shared with other services.
+		copied := *service.Client
+		client = &copied
+	}
+	transport := NewMiddlewareTransport(client.Transport, middleware...)
+	client.Transport = transport
+	service.SetHTTPClient(client)
+	return transport
+}
+
+// HeaderMiddleware returns middleware that sets the given headers on every request.
+func HeaderMiddleware(header http.Header) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			for name, values := range header {
+				req.Header[http.CanonicalHeaderKey(name)] = append([]string(nil), values...)
+			}
+			return next(req)
+		}
+	}
+}
+
+type contextKey string
+
+const (
+	headersContextKey       contextKey = "headers"
+	correlationIDContextKey contextKey = "correlation_id"
+)
+
+// ContextWithHeaders returns a context that makes the ContextHeaderMiddleware set the given headers on
+// the requests sent with it, such as those of the WithContext variants of the operations.
+func ContextWithHeaders(ctx context.Context, header http.Header) context.Context {
+	merged := http.Header{}
+	if existing, ok := ctx.Value(headersContextKey).(http.Header); ok {
+		for name, values := range existing {
+			merged[name] = values
+		}
+	}
+	for name, values := range header {
+		merged[http.CanonicalHeaderKey(name)] = values
+	}
+	return context.WithValue(ctx, headersContextKey, merged)
+}
+
+// ContextHeaderMiddleware returns middleware that sets the headers of the context of each request,
+// which are given with ContextWithHeaders.
+func ContextHeaderMiddleware() Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if header, ok := req.Context().Value(headersContextKey).(http.Header); ok {
+				for name, values := range header {
+					req.Header[name] = append([]string(nil), values...)
+				}
+			}
+			return next(req)
+		}
+	}
+}
+
+// ContextWithCorrelationID returns a context that makes the CorrelationIDMiddleware send the requests
+// sent with it with the given correlation ID.
+func ContextWithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDContextKey, correlationID)
+}
+
+// CorrelationIDMiddleware returns middleware that sets the CorrelationIDHeader of the requests that
+// have none, to the correlation ID of their context, if any, or else to a new one made by generate,
+// or a random UUID if generate is nil.
+func CorrelationIDMiddleware(generate func() string) Middleware {
+	if generate == nil {
+		generate = func() string {
+			return uuid.New().String()
+		}
+	}
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(CorrelationIDHeader) == "" {
+				correlationID, _ := req.Context().Value(correlationIDContextKey).(string)
+				if correlationID == "" {
+					correlationID = generate()
+				}
+				req.Header.Set(CorrelationIDHeader, correlationID)
+			}
+			return next(req)
+		}
+	}
+}
+
+// RequestMetrics describes a request sent by a service, for the MetricsMiddleware.
+type RequestMetrics struct {
+	// The service and operation of the request, as given by GetSdkAnalytics.
+	ServiceName string
+	OperationId string
+
+	Method string
+	URL    string
+
+	// The status code of the response, or 0 if there is none.
+	StatusCode int
+
+	// The time it took to get the response.
+	Duration time.Duration
+
+	// The error of the request, if any.
+	Err error
+}
+
+// MetricsMiddleware returns middleware that passes the metrics of every request to observe.
+func MetricsMiddleware(observe func(metrics *RequestMetrics)) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			serviceName, _, operationId := GetSdkAnalytics(req.Header)
+			start := time.Now()
+			resp, err := next(req)
+			metrics := &RequestMetrics{
+				ServiceName: serviceName,
+				OperationId: operationId,
+				Method:      req.Method,
+				URL:         req.URL.String(),
+				Duration:    time.Since(start),
+				Err:         err,
+			}
+			if resp != nil {
+				metrics.StatusCode = resp.StatusCode
+			}
+			observe(metrics)
+			return resp, err
+		}
+	}
+}
+
+// LoggingMiddleware returns middleware that logs every request and its response with logf, or with
+// the logger of the SDK core at the debug level if logf is nil. The values of the RedactedHeaders and
+// the secrets in the URL are redacted.
+func LoggingMiddleware(logf func(format string, args ...interface{})) Middleware {
+	if logf == nil {
+		logf = func(format string, args ...interface{}) {
+			core.GetLogger().Debug(format, args...)
+		}
+	}
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			url := core.RedactSecrets(req.URL.String())
+			logf("--> %s %s %s", req.Method, url, formatHeader(req.Header))
+			start := time.Now()
+			resp, err := next(req)
+			duration := time.Since(start).Round(time.Millisecond)
+			if err != nil {
+				logf("<-- %s %s error: %s (%s)", req.Method, url, core.RedactSecrets(err.Error()), duration)
+			} else {
+				logf("<-- %s %s %d %s (%s)", req.Method, url, resp.StatusCode, formatHeader(resp.Header), duration)
+			}
+			return resp, err
+		}
+	}
+}
+
+// formatHeader formats a header for a log, with the values of the RedactedHeaders redacted.
+func formatHeader(header http.Header) string {
+	redacted := redactHeader(header)
+	var fields []string
+	for name, values := range redacted {
+		fields = append(fields, fmt.Sprintf("%s: %s", name, strings.Join(values, ", ")))
+	}
+	sort.Strings(fields)
+	return "[" + strings.Join(fields, "; ") + "]"
+}
+
+// NewResponse returns a response to req with the given status code and JSON body, for instance
+// for middleware that answers requests in tests without sending them.
+func NewResponse(req *http.Request, statusCode int, body string) *http.Response {
+	recorded := &RecordedResponse{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       body,
+	}
+	return recorded.toHTTPResponse(req)
+}
+
+// StubMiddleware returns middleware that answers the requests for which respond returns a response
+// or an error, without sending them, and sends the others.
+func StubMiddleware(respond func(req *http.Request) (*http.Response, error)) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := respond(req)
+			if resp != nil || err != nil {
+				if req.Body != nil {
+					req.Body.Close()
+				}
+				return resp, err
+			}
+			return next(req)
+		}
+	}
+}
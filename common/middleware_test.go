@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMiddlewareTestService returns a service whose requests are answered with the headers they were
+// sent with, along with a pointer to the number of requests received.
+func newMiddlewareTestService(t *testing.T) (*core.BaseService, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(res, `{"correlation_id":%q,"tenant":%q,"trace":%q}`,
+			req.Header.Get(CorrelationIDHeader), req.Header.Get("X-Tenant"), req.Header.Get("X-Trace"))
+	}))
+	t.Cleanup(server.Close)
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.BearerTokenAuthenticator{BearerToken: "secret-bearer"},
+	})
+	require.Nil(t, err)
+	return service, &requests
+}
+
+func invokeWithContext(t *testing.T, ctx context.Context, service *core.BaseService) (map[string]string, *core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(core.GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(service.Options.URL, "/records", nil)
+	require.Nil(t, err)
+	for headerName, headerValue := range GetSdkHeaders("test_service", "V1", "ListRecords") {
+		builder.AddHeader(headerName, headerValue)
+	}
+	request, err := builder.Build()
+	require.Nil(t, err)
+	var result map[string]string
+	response, err := service.Request(request, &result)
+	return result, response, err
+}
+
+func TestMiddlewareHeaders(t *testing.T) {
+	service, _ := newMiddlewareTestService(t)
+	transport := EnableMiddleware(service,
+		HeaderMiddleware(http.Header{"x-tenant": []string{"tenant-1"}}),
+		ContextHeaderMiddleware(),
+		CorrelationIDMiddleware(func() string { return "generated" }))
+	assert.Same(t, transport, EnableMiddleware(service))
+
+	result, _, err := invokeWithContext(t, context.Background(), service)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"correlation_id": "generated", "tenant": "tenant-1", "trace": ""}, result)
+
+	ctx := ContextWithHeaders(context.Background(), http.Header{"x-trace": []string{"trace-1"}})
+	ctx = ContextWithHeaders(ctx, http.Header{"X-Tenant": []string{"tenant-2"}})
+	ctx = ContextWithCorrelationID(ctx, "correlation-1")
+	result, _, err = invokeWithContext(t, ctx, service)
+	require.Nil(t, err)
+	// The context headers are set after the static ones, so they take precedence.
+	assert.Equal(t, map[string]string{"correlation_id": "correlation-1", "tenant": "tenant-2", "trace": "trace-1"}, result)
+}
+
+func TestMiddlewareOrder(t *testing.T) {
+	service, _ := newMiddlewareTestService(t)
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next(req)
+			}
+		}
+	}
+	UseGlobalMiddleware(trace("global"))
+	defer ResetGlobalMiddleware()
+	EnableMiddleware(service, trace("first")).Use(trace("second"))
+	_, _, err := invokeWithContext(t, context.Background(), service)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"global", "first", "second"}, order)
+}
+
+func TestMiddlewareMetricsAndLogging(t *testing.T) {
+	service, _ := newMiddlewareTestService(t)
+	var metrics []*RequestMetrics
+	var logs []string
+	EnableMiddleware(service,
+		MetricsMiddleware(func(m *RequestMetrics) { metrics = append(metrics, m) }),
+		LoggingMiddleware(func(format string, args ...interface{}) { logs = append(logs, fmt.Sprintf(format, args...)) }))
+	_, _, err := invokeWithContext(t, context.Background(), service)
+	require.Nil(t, err)
+
+	require.Len(t, metrics, 1)
+	assert.Equal(t, "test_service", metrics[0].ServiceName)
+	assert.Equal(t, "ListRecords", metrics[0].OperationId)
+	assert.Equal(t, http.MethodGet, metrics[0].Method)
+	assert.Equal(t, 200, metrics[0].StatusCode)
+	assert.Nil(t, metrics[0].Err)
+
+	require.Len(t, logs, 2)
+	assert.True(t, strings.HasPrefix(logs[0], "--> GET "))
+	assert.Contains(t, logs[0], "Authorization: "+Redacted)
+	assert.NotContains(t, strings.Join(logs, "\n"), "secret-bearer")
+	assert.Contains(t, logs[1], " 200 ")
+}
+
+func TestStubMiddleware(t *testing.T) {
+	service, requests := newMiddlewareTestService(t)
+	EnableMiddleware(service, StubMiddleware(func(req *http.Request) (*http.Response, error) {
+		if _, _, operationId := GetSdkAnalytics(req.Header); operationId == "ListRecords" {
+			return NewResponse(req, 404, `{"errors":[{"code":"not_found","message":"stubbed"}]}`), nil
+		}
+		return nil, nil
+	}))
+	_, response, err := invokeWithContext(t, context.Background(), service)
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+	assert.Equal(t, 0, *requests)
+}
+
+func TestEnableMiddlewareCopiesSharedClient(t *testing.T) {
+	shared := core.DefaultHTTPClient()
+	service, _ := newMiddlewareTestService(t)
+	service.SetHTTPClient(shared)
+	EnableMiddleware(service)
+	assert.NotSame(t, shared, service.Client)
+	assert.NotEqual(t, shared.Transport, service.Client.Transport)
+	_, isMiddleware := shared.Transport.(*MiddlewareTransport)
+	assert.False(t, isMiddleware)
+}
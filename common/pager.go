@@ -0,0 +1,111 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+)
+
+// ResultInfoPager - tracks the progress of a walk over the pages of a CIS list operation.
+//
+// CIS list operations are paginated with the "page" and "per_page" query parameters and describe
+// each page of results with a "result_info" object (page, per_page, count, total_count).
+// The pagers of the CIS service packages use a ResultInfoPager to decide which page to
+// request next and when the walk is complete.
+type ResultInfoPager struct {
+	hasNext  bool
+	page     int64
+	perPage  *int64
+	received int64
+}
+
+// NewResultInfoPager returns a ResultInfoPager positioned at the first page.
+// If perPage is nil, the page size is left to the service default.
+func NewResultInfoPager(perPage *int64) (pager *ResultInfoPager, err error) {
+	if perPage != nil && *perPage < 1 {
+		err = fmt.Errorf("the 'per_page' value must be greater than zero")
+		return
+	}
+	pager = &ResultInfoPager{
+		hasNext: true,
+		page:    1,
+		perPage: perPage,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more pages to be retrieved.
+func (pager *ResultInfoPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// Page returns the "page" value to send with the next request.
+func (pager *ResultInfoPager) Page() *int64 {
+	page := pager.page
+	return &page
+}
+
+// PerPage returns the "per_page" value to send with the next request, or nil to use the service default.
+func (pager *ResultInfoPager) PerPage() *int64 {
+	return pager.perPage
+}
+
+// Done ends the walk.
+func (pager *ResultInfoPager) Done() {
+	pager.hasNext = false
+}
+
+// Update records the "result_info" returned with the page that was requested last, along with the
+// number of items actually received. It returns false if the service answered with an earlier
+// page than the one that was requested (i.e. the operation ignores pagination), in which case
+// the items are a repeat of results that were already returned and should be discarded.
+func (pager *ResultInfoPager) Update(page *int64, perPage *int64, count *int64, totalCount *int64, itemCount int) bool {
+	if page != nil && *page < pager.page {
+		pager.hasNext = false
+		return false
+	}
+
+	size := int64(0)
+	if perPage != nil && *perPage > 0 {
+		size = *perPage
+	} else if pager.perPage != nil {
+		size = *pager.perPage
+	}
+	received := int64(itemCount)
+	if count != nil {
+		received = *count
+	}
+	pager.received += received
+
+	switch {
+	case itemCount == 0:
+		pager.hasNext = false
+	case totalCount != nil && size > 0:
+		pager.hasNext = pager.page*size < *totalCount
+	case totalCount != nil:
+		pager.hasNext = pager.received < *totalCount
+	case size > 0:
+		pager.hasNext = received >= size
+	default:
+		// Without a page size there is no way to tell whether this was the last page, so keep going
+		// until the service returns an empty page, as long as it reports the page that was requested.
+		// A service that doesn't is taken to ignore pagination, and to have returned all the results.
+		pager.hasNext = page != nil && *page == pager.page
+	}
+	pager.page++
+	return true
+}
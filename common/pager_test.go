@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func TestNewResultInfoPager(t *testing.T) {
+	pager, err := NewResultInfoPager(nil)
+	assert.Nil(t, err)
+	assert.True(t, pager.HasNext())
+	assert.Equal(t, int64(1), *pager.Page())
+	assert.Nil(t, pager.PerPage())
+
+	pager, err = NewResultInfoPager(int64Ptr(0))
+	assert.NotNil(t, err)
+	assert.Nil(t, pager)
+}
+
+func TestResultInfoPagerTotalCount(t *testing.T) {
+	pager, _ := NewResultInfoPager(int64Ptr(2))
+
+	assert.True(t, pager.Update(int64Ptr(1), int64Ptr(2), int64Ptr(2), int64Ptr(5), 2))
+	assert.True(t, pager.HasNext())
+	assert.Equal(t, int64(2), *pager.Page())
+
+	assert.True(t, pager.Update(int64Ptr(2), int64Ptr(2), int64Ptr(2), int64Ptr(5), 2))
+	assert.True(t, pager.HasNext())
+
+	assert.True(t, pager.Update(int64Ptr(3), int64Ptr(2), int64Ptr(1), int64Ptr(5), 1))
+	assert.False(t, pager.HasNext())
+}
+
+func TestResultInfoPagerShrinkingTotalCount(t *testing.T) {
+	pager, _ := NewResultInfoPager(int64Ptr(2))
+
+	assert.True(t, pager.Update(int64Ptr(1), int64Ptr(2), int64Ptr(2), int64Ptr(6), 2))
+	assert.True(t, pager.HasNext())
+
+	// Items were deleted while paging; the total count now ends on this page.
+	assert.True(t, pager.Update(int64Ptr(2), int64Ptr(2), int64Ptr(1), int64Ptr(3), 1))
+	assert.False(t, pager.HasNext())
+}
+
+func TestResultInfoPagerWithoutTotalCount(t *testing.T) {
+	pager, _ := NewResultInfoPager(nil)
+
+	assert.True(t, pager.Update(nil, int64Ptr(3), nil, nil, 3))
+	assert.True(t, pager.HasNext())
+
+	assert.True(t, pager.Update(nil, int64Ptr(3), nil, nil, 1))
+	assert.False(t, pager.HasNext())
+
+	pager, _ = NewResultInfoPager(nil)
+	assert.True(t, pager.Update(int64Ptr(1), nil, nil, nil, 4))
+	assert.True(t, pager.HasNext())
+
+	assert.True(t, pager.Update(int64Ptr(2), nil, nil, nil, 0))
+	assert.False(t, pager.HasNext())
+
+	pager, _ = NewResultInfoPager(nil)
+	assert.True(t, pager.Update(int64Ptr(1), nil, int64Ptr(4), int64Ptr(6), 4))
+	assert.True(t, pager.HasNext())
+
+	assert.True(t, pager.Update(int64Ptr(2), nil, int64Ptr(2), int64Ptr(6), 2))
+	assert.False(t, pager.HasNext())
+}
+
+func TestResultInfoPagerWithoutResultInfo(t *testing.T) {
+	pager, _ := NewResultInfoPager(nil)
+
+	// Without a result_info, the service is taken to ignore pagination.
+	assert.True(t, pager.Update(nil, nil, nil, nil, 4))
+	assert.False(t, pager.HasNext())
+}
+
+func TestResultInfoPagerRepeatedPage(t *testing.T) {
+	pager, _ := NewResultInfoPager(int64Ptr(2))
+
+	assert.True(t, pager.Update(int64Ptr(1), nil, nil, nil, 2))
+	assert.True(t, pager.HasNext())
+
+	// The service ignored the "page" parameter and returned the first page again.
+	assert.False(t, pager.Update(int64Ptr(1), nil, nil, nil, 2))
+	assert.False(t, pager.HasNext())
+}
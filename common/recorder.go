@@ -0,0 +1,428 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// RecorderMode tells a Recorder whether to record requests or to replay them.
+type RecorderMode int
+
+const (
+	// RecorderModeRecord sends every request and records it, along with its response, in the cassette.
+	RecorderModeRecord RecorderMode = iota
+
+	// RecorderModeReplay answers every request with a recorded response, and never sends a request.
+	// A request that matches no recorded interaction fails.
+	RecorderModeReplay
+
+	// RecorderModeReplayOrRecord answers the requests that match a recorded interaction with its
+	// response, and sends and records the others.
+	RecorderModeReplayOrRecord
+)
+
+// Redacted replaces the values that a Recorder keeps out of cassettes.
+const Redacted = "REDACTED"
+
+// RedactedHeaders are the headers whose values a Recorder redacts.
+var RedactedHeaders = []string{"Authorization", "X-Auth-User-Token", "X-Auth-Refresh-Token", "Cookie", "Set-Cookie"}
+
+// RedactedFields are the fields of JSON and form bodies, such as the IAM tokens and API keys, whose
+// values a Recorder redacts.
+var RedactedFields = []string{"access_token", "refresh_token", "delegated_refresh_token", "apikey", "api_key", "password"}
+
+// RecordedRequest is a request recorded in a cassette.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response recorded in a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Interaction is a request recorded in a cassette, along with its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Cassette is the file in which a Recorder keeps its interactions.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// MatcherFunc decides whether a request, whose body is given, matches a recorded request.
+type MatcherFunc func(req *http.Request, body []byte, recorded *RecordedRequest) bool
+
+// Recorder is an http.RoundTripper that records requests and their responses in a cassette file,
+// and replays them from it. Use EnableRecorder to install one on a service, for instance to turn an
+// integration test into a test that runs offline:
+//
+//   recorder, err := common.EnableRecorder(service.Service, "testdata/dns_svcs.json", common.RecorderModeReplayOrRecord)
+//
+// The values of the RedactedHeaders and the RedactedFields of bodies are redacted before they
+// reach the cassette. Requests are replayed in the order in which they were recorded, so a request
+// that is sent several times, for instance while waiting for a resource, gets each of the recorded
+// responses in turn, and then the last of them again.
+type Recorder struct {
+	// The transport that sends the requests that are recorded.
+	Base http.RoundTripper
+
+	// Whether requests are recorded or replayed.
+	Mode RecorderMode
+
+	// Decides whether a request matches a recorded request. If nil, MatchRequest is used.
+	Matcher MatcherFunc
+
+	path     string
+	mutex    sync.Mutex
+	cassette *Cassette
+	replays  map[*Interaction]int
+}
+
+// NewRecorder returns a Recorder for the cassette file at path, which sends requests with base, or
+// with a default transport if base is nil. The interactions of the file are loaded if it exists;
+// in RecorderModeReplay, it must exist.
+func NewRecorder(path string, mode RecorderMode, base http.RoundTripper) (*Recorder, error) {
+	if base == nil {
+		base = core.DefaultHTTPClient().Transport
+	}
+	recorder := &Recorder{
+		Base:     base,
+		Mode:     mode,
+		path:     path,
+		cassette: &Cassette{},
+		replays:  make(map[*Interaction]int),
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && mode != RecorderModeReplay {
+			return recorder, nil
+		}
+		return nil, err
+	}
+	if err = json.Unmarshal(data, recorder.cassette); err != nil {
+		return nil, fmt.Errorf("error reading cassette %s: %s", path, err.Error())
+	}
+	return recorder, nil
+}
+
+// Interactions returns the interactions of the cassette.
+func (recorder *Recorder) Interactions() []*Interaction {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return append([]*Interaction(nil), recorder.cassette.Interactions...)
+}
+
+// RoundTrip answers the request with a recorded response, or sends and records it, according to the
+// mode of the recorder.
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if recorder.Mode != RecorderModeRecord {
+		if interaction := recorder.replay(req, body); interaction != nil {
+			return interaction.Response.toHTTPResponse(req), nil
+		}
+		if recorder.Mode == RecorderModeReplay {
+			return nil, fmt.Errorf("no recorded interaction matches %s %s", req.Method, req.URL.String())
+		}
+	}
+
+	sent := req.Clone(req.Context())
+	if body != nil {
+		sent.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	resp, err := recorder.Base.RoundTrip(sent)
+	if err != nil {
+		return nil, err
+	}
+	var respBody []byte
+	if resp.Body != nil {
+		respBody, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: redactHeader(req.Header),
+			Body:   redactBody(body),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redactHeader(resp.Header),
+			Body:       redactBody(respBody),
+		},
+	}
+	if err = recorder.record(interaction); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// replay returns the interaction whose response answers the request, or nil if none matches it.
+func (recorder *Recorder) replay(req *http.Request, body []byte) *Interaction {
+	matcher := recorder.Matcher
+	if matcher == nil {
+		matcher = MatchRequest
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	var last *Interaction
+	for _, interaction := range recorder.cassette.Interactions {
+		if !matcher(req, body, &interaction.Request) {
+			continue
+		}
+		if recorder.replays[interaction] == 0 {
+			recorder.replays[interaction]++
+			return interaction
+		}
+		last = interaction
+	}
+	if last != nil {
+		recorder.replays[last]++
+	}
+	return last
+}
+
+// record adds an interaction to the cassette, and saves it.
+func (recorder *Recorder) record(interaction *Interaction) error {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, interaction)
+	// The interaction counts as replayed, so that it isn't replayed in RecorderModeReplayOrRecord.
+	recorder.replays[interaction]++
+	return recorder.save()
+}
+
+// save writes the cassette to its file.
+func (recorder *Recorder) save() error {
+	data, err := json.MarshalIndent(recorder.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(recorder.path); dir != "" {
+		if err = os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(recorder.path, data, 0644)
+}
+
+// toHTTPResponse returns the recorded response as the response to req.
+func (recorded *RecordedResponse) toHTTPResponse(req *http.Request) *http.Response {
+	header := http.Header{}
+	for name, values := range recorded.Header {
+		header[name] = append([]string(nil), values...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
+
+// MatchRequest is the default MatcherFunc of a Recorder. A request matches a recorded request when
+// they have the same method, path and query parameters, in any order, and the same body. JSON bodies
+// are compared by value, and the RedactedFields are ignored.
+func MatchRequest(req *http.Request, body []byte, recorded *RecordedRequest) bool {
+	if req.Method != recorded.Method {
+		return false
+	}
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil || req.URL.Path != recordedURL.Path {
+		return false
+	}
+	if !reflect.DeepEqual(normalizeValues(req.URL.Query()), normalizeValues(recordedURL.Query())) {
+		return false
+	}
+	return equalBodies(redactBody(body), recorded.Body)
+}
+
+// normalizeValues returns nil for empty values, so that they compare equal to missing ones.
+func normalizeValues(values url.Values) url.Values {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// equalBodies reports whether two bodies are the same, comparing JSON bodies by value.
+func equalBodies(a string, b string) bool {
+	if a == b {
+		return true
+	}
+	var aValue, bValue interface{}
+	if json.Unmarshal([]byte(a), &aValue) != nil || json.Unmarshal([]byte(b), &bValue) != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// redactHeader returns a copy of the header in which the values of the RedactedHeaders are redacted.
+func redactHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	redacted := http.Header{}
+	for name, values := range header {
+		values = append([]string(nil), values...)
+		for _, redactedName := range RedactedHeaders {
+			if strings.EqualFold(name, redactedName) {
+				for i := range values {
+					values[i] = Redacted
+				}
+			}
+		}
+		redacted[name] = values
+	}
+	return redacted
+}
+
+// redactBody returns the body with the values of the RedactedFields redacted, at any depth of a JSON
+// body, or among the parameters of a form body.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err == nil {
+		if redactValue(value) {
+			if data, err := json.Marshal(value); err == nil {
+				return string(data)
+			}
+		}
+		return string(body)
+	}
+	if form, err := url.ParseQuery(string(body)); err == nil && strings.Contains(string(body), "=") {
+		redacted := false
+		for _, field := range RedactedFields {
+			if _, ok := form[field]; ok {
+				form.Set(field, Redacted)
+				redacted = true
+			}
+		}
+		if redacted {
+			return form.Encode()
+		}
+	}
+	return string(body)
+}
+
+// redactValue redacts the RedactedFields of a decoded JSON value in place, and reports whether any were found.
+func redactValue(value interface{}) bool {
+	redacted := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isRedactedField(key) {
+				v[key] = Redacted
+				redacted = true
+			} else if redactValue(field) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if redactValue(item) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+func isRedactedField(name string) bool {
+	for _, field := range RedactedFields {
+		if strings.EqualFold(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnableRecorder installs a Recorder for the cassette file at path on the service, in front of the
+// transport the service already uses, and returns it.
+//
+// In RecorderModeReplay, the authenticator of the service is replaced with a NoAuthAuthenticator,
+// so that no credentials are needed and no token is requested from IAM.
+func EnableRecorder(service *core.BaseService, path string, mode RecorderMode) (*Recorder, error) {
+	client := core.DefaultHTTPClient()
+	if service.Client != nil {
+		client.Timeout = service.Client.Timeout
+		client.Jar = service.Client.Jar
+		client.CheckRedirect = service.Client.CheckRedirect
+		switch base := service.Client.Transport.(type) {
+		case nil:
+		case *retryablehttp.RoundTripper:
+			client.Transport = base
+		case *Recorder:
+			client.Transport = base.Base
+		default:
+			client.Transport = base
+		}
+	}
+	recorder, err := NewRecorder(path, mode, client.Transport)
+	if err != nil {
+		return nil, err
+	}
+	client.Transport = recorder
+	service.SetHTTPClient(client)
+	if mode == RecorderModeReplay {
+		service.Options.Authenticator = &core.NoAuthAuthenticator{}
+	}
+	return recorder, nil
+}
@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRecorderTestServer returns a server that answers each request with its number, in a JSON body
+// that also carries a token, along with a pointer to the number of requests received.
+func newRecorderTestServer(t *testing.T) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("Set-Cookie", "session=secret")
+		fmt.Fprintf(res, `{"request":%d,"access_token":"secret-token"}`, requests)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newRecorderTestService(t *testing.T, url string) *core.BaseService {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           url,
+		Authenticator: &core.BearerTokenAuthenticator{BearerToken: "secret-bearer"},
+	})
+	assert.Nil(t, err)
+	return service
+}
+
+func invokeRecorded(t *testing.T, service *core.BaseService, method string, query map[string]string, body interface{}) map[string]interface{} {
+	builder := core.NewRequestBuilder(method)
+	_, err := builder.ResolveRequestURL(service.Options.URL, "/records", nil)
+	assert.Nil(t, err)
+	for name, value := range query {
+		builder.AddQuery(name, value)
+	}
+	if body != nil {
+		_, err = builder.SetBodyContentJSON(body)
+		assert.Nil(t, err)
+	}
+	request, err := builder.Build()
+	assert.Nil(t, err)
+	var result map[string]interface{}
+	_, err = service.Request(request, &result)
+	if !assert.Nil(t, err) {
+		return nil
+	}
+	return result
+}
+
+func TestRecorderRecordsAndReplays(t *testing.T) {
+	server, requests := newRecorderTestServer(t)
+	path := filepath.Join(t.TempDir(), "cassettes", "records.json")
+
+	service := newRecorderTestService(t, server.URL)
+	recorder, err := EnableRecorder(service, path, RecorderModeRecord)
+	assert.Nil(t, err)
+	invokeRecorded(t, service, core.GET, map[string]string{"a": "1", "b": "2"}, nil)
+	invokeRecorded(t, service, core.GET, map[string]string{"a": "1", "b": "2"}, nil)
+	invokeRecorded(t, service, core.POST, nil, map[string]interface{}{"name": "test", "apikey": "secret-key"})
+	assert.Equal(t, 3, *requests)
+	assert.Len(t, recorder.Interactions(), 3)
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), "secret")
+	var cassette Cassette
+	assert.Nil(t, json.Unmarshal(data, &cassette))
+	assert.Equal(t, []string{Redacted}, cassette.Interactions[0].Request.Header["Authorization"])
+	assert.Equal(t, `{"apikey":"REDACTED","name":"test"}`, cassette.Interactions[2].Request.Body)
+
+	// Replay against a URL that nothing listens on, with the query parameters in another order.
+	service = newRecorderTestService(t, "http://127.0.0.1:1")
+	_, err = EnableRecorder(service, path, RecorderModeReplay)
+	assert.Nil(t, err)
+	assert.IsType(t, &core.NoAuthAuthenticator{}, service.Options.Authenticator)
+	result := invokeRecorded(t, service, core.POST, nil, map[string]interface{}{"apikey": "other-key", "name": "test"})
+	assert.Equal(t, float64(3), result["request"])
+	result = invokeRecorded(t, service, core.GET, map[string]string{"b": "2", "a": "1"}, nil)
+	assert.Equal(t, float64(1), result["request"])
+	result = invokeRecorded(t, service, core.GET, map[string]string{"b": "2", "a": "1"}, nil)
+	assert.Equal(t, float64(2), result["request"])
+	// Once the recorded responses are used up, the last one is replayed again.
+	result = invokeRecorded(t, service, core.GET, map[string]string{"b": "2", "a": "1"}, nil)
+	assert.Equal(t, float64(2), result["request"])
+	assert.Equal(t, 3, *requests)
+
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ResolveRequestURL(service.Options.URL, "/records", nil)
+	assert.Nil(t, err)
+	builder.AddQuery("a", "2")
+	request, _ := builder.Build()
+	_, err = service.Request(request, nil)
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "no recorded interaction matches"))
+}
+
+func TestRecorderReplayOrRecord(t *testing.T) {
+	server, requests := newRecorderTestServer(t)
+	path := filepath.Join(t.TempDir(), "records.json")
+
+	_, err := NewRecorder(path, RecorderModeReplay, nil)
+	assert.NotNil(t, err)
+
+	service := newRecorderTestService(t, server.URL)
+	recorder, err := EnableRecorder(service, path, RecorderModeReplayOrRecord)
+	assert.Nil(t, err)
+	invokeRecorded(t, service, core.POST, nil, map[string]interface{}{"name": "a"})
+	assert.Equal(t, 1, *requests)
+
+	service = newRecorderTestService(t, server.URL)
+	recorder, err = EnableRecorder(service, path, RecorderModeReplayOrRecord)
+	assert.Nil(t, err)
+	result := invokeRecorded(t, service, core.POST, nil, map[string]interface{}{"name": "a"})
+	assert.Equal(t, float64(1), result["request"])
+	result = invokeRecorded(t, service, core.POST, nil, map[string]interface{}{"name": "b"})
+	assert.Equal(t, float64(2), result["request"])
+	assert.Equal(t, 2, *requests)
+	assert.Len(t, recorder.Interactions(), 2)
+}
+
+func TestRedactBody(t *testing.T) {
+	assert.Equal(t, "", redactBody(nil))
+	assert.Equal(t, `{"result":[{"token":{"refresh_token":"REDACTED"}}]}`,
+		redactBody([]byte(`{"result":[{"token":{"refresh_token":"abc"}}]}`)))
+	assert.Equal(t, `{"name":"test"}`, redactBody([]byte(`{"name":"test"}`)))
+	assert.Equal(t, "apikey=REDACTED&grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey",
+		redactBody([]byte("grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey&apikey=abc")))
+	assert.Equal(t, "plain text", redactBody([]byte("plain text")))
+}
+
+func TestMatchRequest(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/v1/zones?b=2&a=1", nil)
+	assert.True(t, MatchRequest(req, nil, &RecordedRequest{Method: "GET", URL: "http://127.0.0.1/v1/zones?a=1&b=2"}))
+	assert.False(t, MatchRequest(req, nil, &RecordedRequest{Method: "GET", URL: "http://127.0.0.1/v1/zones?a=1"}))
+	assert.False(t, MatchRequest(req, nil, &RecordedRequest{Method: "DELETE", URL: "http://127.0.0.1/v1/zones?a=1&b=2"}))
+	assert.False(t, MatchRequest(req, nil, &RecordedRequest{Method: "GET", URL: "http://127.0.0.1/v1/records?a=1&b=2"}))
+	req, _ = http.NewRequest(http.MethodPost, "https://api.example.com/v1/zones", nil)
+	assert.True(t, MatchRequest(req, []byte(`{"b":2, "a":1}`), &RecordedRequest{Method: "POST", URL: "/v1/zones", Body: `{"a":1,"b":2}`}))
+	assert.False(t, MatchRequest(req, []byte(`{"a":2}`), &RecordedRequest{Method: "POST", URL: "/v1/zones", Body: `{"a":1}`}))
+}
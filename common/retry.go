@@ -0,0 +1,432 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a request is retried.
+	DefaultMaxRetries = 4
+
+	// DefaultMinRetryInterval is the default lower bound of the interval between two attempts.
+	DefaultMinRetryInterval = 1 * time.Second
+
+	// DefaultMaxRetryInterval is the default upper bound of the interval between two attempts.
+	DefaultMaxRetryInterval = 30 * time.Second
+
+	// RetryCountHeader is the response header in which the number of retries of a request is reported.
+	// Use GetRetryCount to read it from a DetailedResponse.
+	RetryCountHeader = "X-Sdk-Retry-Count"
+)
+
+// CisRetryableErrorCodes are the CIS error codes that indicate the request was throttled
+// without being processed, and can be retried even for a non-idempotent operation.
+var CisRetryableErrorCodes = []string{"971"}
+
+// DnsSvcsRetryableErrorCodes are the DNS Services error codes that indicate the request was
+// rejected because the resource is being modified by another request, and can be retried
+// even for a non-idempotent operation. The "conflict" code is not one of them: it reports a
+// permanent error, such as a record that conflicts with the other records of its name.
+var DnsSvcsRetryableErrorCodes = []string{"resource_busy"}
+
+// RetryPolicy describes if and how the requests for an operation are retried.
+//
+// An attempt is retried when one of the Classifiers decides so, when its status code is one of
+// RetryableStatusCodes, when the error response carries one of RetryableErrorCodes, or when the
+// service throttled it (429). Otherwise, the attempt of an idempotent operation is also retried
+// on the transient failures recognized by core.IBMCloudSDKRetryPolicy (connection errors and 5xx
+// responses other than 501), which are not retried for non-idempotent operations because the
+// request may have been carried out.
+type RetryPolicy struct {
+	// The maximum number of retries. If zero, DefaultMaxRetries is used; if negative, requests are not retried.
+	MaxRetries int
+
+	// The lower bound of the interval between two attempts.
+	MinRetryInterval time.Duration
+
+	// The upper bound of the interval between two attempts.
+	MaxRetryInterval time.Duration
+
+	// Overrides the idempotency of the operation. By default, GET, HEAD, OPTIONS, PUT and DELETE
+	// requests are idempotent, while POST and PATCH requests are not.
+	Idempotent *bool
+
+	// Status codes that are always retryable.
+	RetryableStatusCodes []int
+
+	// Error codes of the service (see CisRetryableErrorCodes, DnsSvcsRetryableErrorCodes) that are always retryable.
+	RetryableErrorCodes []string
+
+	// Hooks consulted, in order, before the rules above.
+	Classifiers []RetryClassifier
+}
+
+// RetryAttempt describes the outcome of one attempt at a request.
+type RetryAttempt struct {
+	// The operationId of the request (see GetSdkAnalytics), if known.
+	OperationId string
+
+	// The number of the attempt, starting at 1.
+	Attempt int
+
+	// Whether the operation is idempotent.
+	Idempotent bool
+
+	// The status code and headers of the response, or zero and nil if no response was received.
+	StatusCode int
+	Header     http.Header
+
+	// The error codes found in the body of an error response.
+	ErrorCodes []string
+
+	// The error that prevented a response from being received, if any.
+	Err error
+}
+
+// RetryClassifier inspects an attempt and returns decided=true to settle whether it is retried;
+// returning decided=false defers to the next classifier and to the rules of the RetryPolicy.
+type RetryClassifier func(attempt *RetryAttempt) (retry bool, decided bool)
+
+// ShouldRetry returns true if the attempt should be retried, regardless of the number of retries left.
+func (policy *RetryPolicy) ShouldRetry(ctx context.Context, attempt *RetryAttempt) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	for _, classifier := range policy.Classifiers {
+		if retry, decided := classifier(attempt); decided {
+			return retry
+		}
+	}
+	if attempt.Err == nil {
+		if attempt.StatusCode == http.StatusTooManyRequests {
+			return true
+		}
+		for _, code := range policy.RetryableStatusCodes {
+			if code == attempt.StatusCode {
+				return true
+			}
+		}
+		for _, code := range attempt.ErrorCodes {
+			if core.SliceContains(policy.RetryableErrorCodes, code) {
+				return true
+			}
+		}
+	}
+	if !attempt.Idempotent {
+		return false
+	}
+	var resp *http.Response
+	if attempt.Err == nil {
+		resp = &http.Response{StatusCode: attempt.StatusCode, Header: attempt.Header}
+	}
+	retry, _ := core.IBMCloudSDKRetryPolicy(ctx, resp, attempt.Err)
+	return retry
+}
+
+// IsIdempotent returns true if a request with the given method is idempotent under the policy.
+func (policy *RetryPolicy) IsIdempotent(method string) bool {
+	if policy.Idempotent != nil {
+		return *policy.Idempotent
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func (policy *RetryPolicy) maxRetries() int {
+	if policy.MaxRetries == 0 {
+		return DefaultMaxRetries
+	}
+	return policy.MaxRetries
+}
+
+// backoff returns the interval to wait before the given retry, honoring a Retry-After header.
+func (policy *RetryPolicy) backoff(retry int, attempt *RetryAttempt) time.Duration {
+	min := policy.MinRetryInterval
+	if min <= 0 {
+		min = DefaultMinRetryInterval
+	}
+	max := policy.MaxRetryInterval
+	if max <= 0 {
+		max = DefaultMaxRetryInterval
+	}
+	if max < min {
+		max = min
+	}
+	var resp *http.Response
+	if attempt.Header != nil {
+		resp = &http.Response{StatusCode: attempt.StatusCode, Header: attempt.Header}
+	}
+	return core.IBMCloudSDKBackoffPolicy(min, max, retry, resp)
+}
+
+// RetryTransport is an http.RoundTripper that retries requests according to a RetryPolicy chosen
+// by the operationId of each request. Use EnableRetryPolicies to install one on a service.
+//
+// The number of retries is reported in the RetryCountHeader of the response.
+type RetryTransport struct {
+	// The transport that carries out each attempt.
+	Base http.RoundTripper
+
+	// The policy of the operations that have no policy of their own.
+	Policy *RetryPolicy
+
+	mutex      sync.RWMutex
+	operations map[string]*RetryPolicy
+}
+
+// NewRetryTransport returns a RetryTransport that carries out attempts with base, or with a default
+// transport if base is nil. If policy is nil, a default RetryPolicy is used.
+func NewRetryTransport(base http.RoundTripper, policy *RetryPolicy) *RetryTransport {
+	if base == nil {
+		base = core.DefaultHTTPClient().Transport
+	}
+	if policy == nil {
+		policy = &RetryPolicy{}
+	}
+	return &RetryTransport{
+		Base:       base,
+		Policy:     policy,
+		operations: make(map[string]*RetryPolicy),
+	}
+}
+
+// SetOperationPolicy sets the policy of the operation with the given operationId (e.g. "CreateResourceRecord").
+// A nil policy reverts the operation to the default policy of the transport.
+func (transport *RetryTransport) SetOperationPolicy(operationId string, policy *RetryPolicy) *RetryTransport {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+	if policy == nil {
+		delete(transport.operations, operationId)
+	} else {
+		transport.operations[operationId] = policy
+	}
+	return transport
+}
+
+// GetOperationPolicy returns the policy that applies to the operation with the given operationId.
+func (transport *RetryTransport) GetOperationPolicy(operationId string) *RetryPolicy {
+	transport.mutex.RLock()
+	defer transport.mutex.RUnlock()
+	if policy, ok := transport.operations[operationId]; ok {
+		return policy
+	}
+	return transport.Policy
+}
+
+// RoundTrip carries out the request, retrying it as directed by the policy of its operation.
+func (transport *RetryTransport) RoundTrip(req *http.Request) (resp *http.Response, err error) {
+	ctx := req.Context()
+	_, _, operationId := GetSdkAnalytics(req.Header)
+	policy := transport.GetOperationPolicy(operationId)
+
+	// Buffer the body so that it can be sent again.
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return
+		}
+	}
+
+	for retries := 0; ; retries++ {
+		attemptReq := req.Clone(ctx)
+		if body != nil {
+			attemptReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+			attemptReq.GetBody = func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+
+		resp, err = transport.Base.RoundTrip(attemptReq)
+		attempt := &RetryAttempt{
+			OperationId: operationId,
+			Attempt:     retries + 1,
+			Idempotent:  policy.IsIdempotent(req.Method),
+			Err:         err,
+		}
+		if resp != nil {
+			attempt.StatusCode = resp.StatusCode
+			attempt.Header = resp.Header
+			if resp.StatusCode >= 400 && resp.Body != nil {
+				var respBody []byte
+				respBody, err = ioutil.ReadAll(resp.Body)
+				resp.Body.Close()
+				if err != nil {
+					return nil, err
+				}
+				resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+				attempt.ErrorCodes = errorCodesFromBody(respBody)
+			}
+		}
+
+		if retries >= policy.maxRetries() || !policy.ShouldRetry(ctx, attempt) {
+			if resp != nil {
+				resp.Header.Set(RetryCountHeader, strconv.Itoa(retries))
+			}
+			return
+		}
+
+		timer := time.NewTimer(policy.backoff(retries, attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if resp != nil {
+				resp.Header.Set(RetryCountHeader, strconv.Itoa(retries))
+				return resp, nil
+			}
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+}
+
+// EnableRetryPolicies installs a RetryTransport with the given default policy on the service, replacing
+// any retries enabled with EnableRetries, and returns it so that per-operation policies can be set.
+func EnableRetryPolicies(service *core.BaseService, policy *RetryPolicy) *RetryTransport {
+	client := core.DefaultHTTPClient()
+	if service.Client != nil {
+		client.Timeout = service.Client.Timeout
+		client.Jar = service.Client.Jar
+		client.CheckRedirect = service.Client.CheckRedirect
+		switch base := service.Client.Transport.(type) {
+		case nil, *retryablehttp.RoundTripper:
+		case *RetryTransport:
+			client.Transport = base.Base
+		default:
+			client.Transport = base
+		}
+	}
+	transport := NewRetryTransport(client.Transport, policy)
+	client.Transport = transport
+	service.SetHTTPClient(client)
+	return transport
+}
+
+// GetRetryCount returns the number of times the request behind the response was retried.
+func GetRetryCount(response *core.DetailedResponse) int {
+	if response == nil {
+		return 0
+	}
+	count, _ := strconv.Atoi(response.GetHeaders().Get(RetryCountHeader))
+	return count
+}
+
+// CreateFunc makes one attempt at creating a resource.
+type CreateFunc func(ctx context.Context) (result interface{}, response *core.DetailedResponse, err error)
+
+// LookupFunc searches for the resource that a CreateFunc creates, and returns a nil result if it does not exist.
+type LookupFunc func(ctx context.Context) (result interface{}, response *core.DetailedResponse, err error)
+
+// RetryCreate invokes create, retrying it according to policy. Before each retry, and when the service
+// reports a conflict, lookup is invoked to find out whether an earlier attempt created the resource
+// after all; if so, the existing resource is returned instead of creating a duplicate. This makes
+// the retries of a non-idempotent create operation safe.
+func RetryCreate(ctx context.Context, policy *RetryPolicy, create CreateFunc, lookup LookupFunc) (result interface{}, response *core.DetailedResponse, err error) {
+	if policy == nil {
+		policy = &RetryPolicy{}
+	}
+	for retries := 0; ; retries++ {
+		result, response, err = create(ctx)
+		if err == nil {
+			setRetryCount(response, retries)
+			return
+		}
+
+		attempt := &RetryAttempt{
+			Attempt:    retries + 1,
+			Idempotent: true,
+		}
+		if response == nil {
+			attempt.Err = err
+		} else {
+			attempt.StatusCode = response.StatusCode
+			attempt.Header = response.Headers
+			if apiErr := AsAPIError(NewAPIError(response, err)); apiErr != nil {
+				attempt.ErrorCodes = apiErr.Codes()
+			}
+		}
+		retry := retries < policy.maxRetries() && policy.ShouldRetry(ctx, attempt)
+		if !retry && attempt.StatusCode != http.StatusConflict {
+			setRetryCount(response, retries)
+			return
+		}
+
+		existing, existingResponse, lookupErr := lookup(ctx)
+		if lookupErr == nil && !core.IsNil(existing) {
+			setRetryCount(existingResponse, retries)
+			return existing, existingResponse, nil
+		}
+		if !retry {
+			setRetryCount(response, retries)
+			return
+		}
+
+		timer := time.NewTimer(policy.backoff(retries, attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			setRetryCount(response, retries)
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func setRetryCount(response *core.DetailedResponse, retries int) {
+	if response == nil {
+		return
+	}
+	if response.Headers == nil {
+		response.Headers = http.Header{}
+	}
+	response.Headers.Set(RetryCountHeader, strconv.Itoa(retries))
+}
+
+// errorCodesFromBody returns the service-specific error codes found in a JSON error response body.
+func errorCodesFromBody(body []byte) (codes []string) {
+	var result map[string]interface{}
+	if json.Unmarshal(body, &result) != nil {
+		return
+	}
+	details, _ := decodeErrorEnvelope(result)
+	for _, detail := range details {
+		if detail.Code != "" {
+			codes = append(codes, detail.Code)
+		}
+	}
+	return
+}
@@ -0,0 +1,221 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+var fastRetryPolicy = &RetryPolicy{
+	MinRetryInterval: time.Millisecond,
+	MaxRetryInterval: 2 * time.Millisecond,
+}
+
+// newRetryTestService returns a service whose requests are answered with the given status codes
+// and bodies in turn, along with a pointer to the number of requests received.
+func newRetryTestService(t *testing.T, codes []int, bodies []string) (*core.BaseService, *int, func()) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		if req.Method == http.MethodPost {
+			assert.Equal(t, `{"name":"test"}`, strings.TrimSpace(string(body)))
+		}
+		i := requests
+		if i >= len(codes) {
+			i = len(codes) - 1
+		}
+		requests++
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(codes[i])
+		fmt.Fprint(res, bodies[i])
+	}))
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return service, &requests, server.Close
+}
+
+func invokeOperation(service *core.BaseService, method string, operationId string) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	_, err := builder.ResolveRequestURL(service.Options.URL, "/records", nil)
+	if err != nil {
+		return nil, err
+	}
+	for headerName, headerValue := range GetSdkHeaders("test_service", "V1", operationId) {
+		builder.AddHeader(headerName, headerValue)
+	}
+	if method == core.POST {
+		_, err = builder.SetBodyContentJSON(map[string]string{"name": "test"})
+		if err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	return service.Request(request, &result)
+}
+
+func TestRetryTransportIdempotency(t *testing.T) {
+	service, requests, closeServer := newRetryTestService(t,
+		[]int{503, 503, 200}, []string{`{}`, `{}`, `{"id": "1"}`})
+	defer closeServer()
+	EnableRetryPolicies(service, fastRetryPolicy)
+
+	response, err := invokeOperation(service, core.GET, "GetRecord")
+	assert.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, 3, *requests)
+	assert.Equal(t, 2, GetRetryCount(response))
+
+	// A create is not retried on a 5xx, since the record may have been created.
+	*requests = 0
+	response, err = invokeOperation(service, core.POST, "CreateRecord")
+	assert.NotNil(t, err)
+	assert.Equal(t, 503, response.StatusCode)
+	assert.Equal(t, 1, *requests)
+	assert.Equal(t, 0, GetRetryCount(response))
+}
+
+func TestRetryTransportErrorCodes(t *testing.T) {
+	service, requests, closeServer := newRetryTestService(t,
+		[]int{409, 429, 201},
+		[]string{`{"code": "resource_busy", "message": "busy"}`, `{"success": false, "errors": [{"code": 971, "message": "slow down"}]}`, `{"id": "1"}`})
+	defer closeServer()
+	transport := EnableRetryPolicies(service, fastRetryPolicy)
+	transport.SetOperationPolicy("CreateRecord", &RetryPolicy{
+		MinRetryInterval:    time.Millisecond,
+		MaxRetryInterval:    2 * time.Millisecond,
+		RetryableErrorCodes: DnsSvcsRetryableErrorCodes,
+	})
+
+	response, err := invokeOperation(service, core.POST, "CreateRecord")
+	assert.Nil(t, err)
+	assert.Equal(t, 201, response.StatusCode)
+	assert.Equal(t, 3, *requests)
+	assert.Equal(t, 2, GetRetryCount(response))
+}
+
+func TestRetryTransportRecordConflict(t *testing.T) {
+	service, requests, closeServer := newRetryTestService(t,
+		[]int{409, 201},
+		[]string{`{"code": "conflict", "message": "A type record conflict with other records"}`, `{"id": "1"}`})
+	defer closeServer()
+	transport := EnableRetryPolicies(service, fastRetryPolicy)
+	transport.SetOperationPolicy("CreateRecord", &RetryPolicy{
+		MinRetryInterval:    time.Millisecond,
+		MaxRetryInterval:    2 * time.Millisecond,
+		RetryableErrorCodes: DnsSvcsRetryableErrorCodes,
+	})
+
+	response, err := invokeOperation(service, core.POST, "CreateRecord")
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, response.StatusCode)
+	assert.Equal(t, 1, *requests)
+	assert.Equal(t, 0, GetRetryCount(response))
+}
+
+func TestRetryTransportClassifiers(t *testing.T) {
+	service, requests, closeServer := newRetryTestService(t,
+		[]int{500, 200}, []string{`{"errors": [{"code": 1003, "message": "bad"}]}`, `{}`})
+	defer closeServer()
+	var attempts []*RetryAttempt
+	EnableRetryPolicies(service, &RetryPolicy{
+		MaxRetries: 3,
+		Classifiers: []RetryClassifier{
+			func(attempt *RetryAttempt) (bool, bool) {
+				attempts = append(attempts, attempt)
+				return false, core.SliceContains(attempt.ErrorCodes, "1003")
+			},
+		},
+	})
+
+	response, err := invokeOperation(service, core.DELETE, "DeleteRecord")
+	assert.NotNil(t, err)
+	assert.Equal(t, 500, response.StatusCode)
+	assert.Equal(t, 1, *requests)
+	assert.Equal(t, 1, len(attempts))
+	assert.Equal(t, "DeleteRecord", attempts[0].OperationId)
+	assert.True(t, attempts[0].Idempotent)
+
+	// A negative MaxRetries disables retries.
+	*requests = 0
+	EnableRetryPolicies(service, &RetryPolicy{MaxRetries: -1})
+	_, err = invokeOperation(service, core.GET, "GetRecord")
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, *requests)
+}
+
+func TestRetryCreate(t *testing.T) {
+	lookups := 0
+	creates := 0
+	create := func(ctx context.Context) (interface{}, *core.DetailedResponse, error) {
+		creates++
+		return nil, &core.DetailedResponse{StatusCode: 502}, errors.New("Bad Gateway")
+	}
+	lookup := func(ctx context.Context) (interface{}, *core.DetailedResponse, error) {
+		lookups++
+		if lookups < 2 {
+			return nil, nil, nil
+		}
+		return "record-1", &core.DetailedResponse{StatusCode: 200}, nil
+	}
+
+	result, response, err := RetryCreate(context.Background(), fastRetryPolicy, create, lookup)
+	assert.Nil(t, err)
+	assert.Equal(t, "record-1", result)
+	assert.Equal(t, 2, creates)
+	assert.Equal(t, 1, GetRetryCount(response))
+
+	// A conflict that is not retryable still looks for the existing resource.
+	lookups, creates = 1, 0
+	result, _, err = RetryCreate(context.Background(), fastRetryPolicy,
+		func(ctx context.Context) (interface{}, *core.DetailedResponse, error) {
+			creates++
+			return nil, &core.DetailedResponse{StatusCode: 409}, errors.New("Conflict")
+		}, lookup)
+	assert.Nil(t, err)
+	assert.Equal(t, "record-1", result)
+	assert.Equal(t, 1, creates)
+
+	// An error that is neither retryable nor a conflict is returned as is.
+	lookups, creates = 0, 0
+	_, response, err = RetryCreate(context.Background(), fastRetryPolicy,
+		func(ctx context.Context) (interface{}, *core.DetailedResponse, error) {
+			creates++
+			return nil, &core.DetailedResponse{StatusCode: 400}, errors.New("Bad Request")
+		}, lookup)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+	assert.Equal(t, 0, lookups)
+	assert.Equal(t, 1, creates)
+}
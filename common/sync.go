@@ -0,0 +1,185 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// The actions of the changes of a sync plan.
+const (
+	SyncActionCreate = "create"
+	SyncActionUpdate = "update"
+	SyncActionDelete = "delete"
+)
+
+// DefaultSyncConcurrency is the default number of changes of a sync plan applied at the same time.
+const DefaultSyncConcurrency = 4
+
+// DefaultSyncMaxDeletions is the default number of deletions above which a sync plan is not applied.
+const DefaultSyncMaxDeletions = 10
+
+// ErrSyncMaxDeletions is returned, wrapped, when a sync plan is not applied because it deletes more
+// records than allowed.
+var ErrSyncMaxDeletions = errors.New("the sync plan exceeds the maximum number of deletions")
+
+// SyncError is returned when some of the changes of a sync plan could not be applied.
+type SyncError struct {
+	// The number of changes that were applied.
+	Applied int
+
+	// The errors of the changes that failed, in the order of the plan.
+	Errors []error
+
+	// The number of changes that were not attempted because an earlier phase of the plan failed.
+	Skipped int
+}
+
+func (e *SyncError) Error() string {
+	msg := fmt.Sprintf("%d of %d changes failed", len(e.Errors), e.Applied+len(e.Errors)+e.Skipped)
+	if e.Skipped > 0 {
+		msg += fmt.Sprintf(", %d not attempted", e.Skipped)
+	}
+	if len(e.Errors) > 0 {
+		msg += ": " + e.Errors[0].Error()
+	}
+	return msg
+}
+
+// Unwrap returns the first error, so that errors.Is and errors.As can inspect it.
+func (e *SyncError) Unwrap() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e.Errors[0]
+}
+
+// RunConcurrently invokes fn for each index in [0, count), with at most concurrency invocations in
+// progress at the same time (DefaultSyncConcurrency if less than one). It returns the error of each
+// invocation by index; the invocations that could not start before ctx was done get the error of ctx.
+func RunConcurrently(ctx context.Context, concurrency int, count int, fn func(ctx context.Context, index int) error) []error {
+	if concurrency < 1 {
+		concurrency = DefaultSyncConcurrency
+	}
+	errs := make([]error, count)
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		if ctx.Err() != nil {
+			errs[i] = ctx.Err()
+			continue
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			errs[index] = fn(ctx, index)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+// SyncThrottle paces the changes of a sync plan that are applied concurrently when the service rate
+// limits them: once a change is throttled, every change waits until the backoff of Policy, or the
+// Retry-After of the throttled response, has elapsed, instead of each one retrying on its own and
+// prolonging the throttling. The throttled change is then retried, up to the maximum number of
+// retries of Policy. The zero value uses the default RetryPolicy.
+type SyncThrottle struct {
+	// The policy that sets the maximum number of retries of a throttled change and the backoff.
+	Policy *RetryPolicy
+
+	mutex     sync.Mutex
+	until     time.Time
+	throttled int
+}
+
+// Do invokes fn once the current pause, if any, has elapsed, and again after a pause each time it
+// returns an error for which IsRateLimited is true.
+func (throttle *SyncThrottle) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	policy := throttle.Policy
+	if policy == nil {
+		policy = &RetryPolicy{}
+	}
+	for retries := 0; ; retries++ {
+		err := throttle.wait(ctx)
+		if err != nil {
+			return err
+		}
+		err = fn(ctx)
+		if !IsRateLimited(err) {
+			if err == nil {
+				throttle.mutex.Lock()
+				throttle.throttled = 0
+				throttle.mutex.Unlock()
+			}
+			return err
+		}
+		if retries >= policy.maxRetries() {
+			return err
+		}
+		throttle.pause(policy, err)
+	}
+}
+
+// wait waits until the current pause has elapsed or ctx is done.
+func (throttle *SyncThrottle) wait(ctx context.Context) error {
+	for {
+		throttle.mutex.Lock()
+		delay := time.Until(throttle.until)
+		throttle.mutex.Unlock()
+		if delay <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// pause extends the current pause by the backoff after a throttled response. The backoff grows with
+// the number of consecutive throttled responses.
+func (throttle *SyncThrottle) pause(policy *RetryPolicy, err error) {
+	attempt := &RetryAttempt{}
+	if apiErr := AsAPIError(err); apiErr != nil && apiErr.Response != nil {
+		attempt.StatusCode = apiErr.StatusCode
+		attempt.Header = apiErr.Response.Headers
+	}
+	throttle.mutex.Lock()
+	defer throttle.mutex.Unlock()
+	until := time.Now().Add(policy.backoff(throttle.throttled, attempt))
+	throttle.throttled++
+	if until.After(throttle.until) {
+		throttle.until = until
+	}
+}
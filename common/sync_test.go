@@ -0,0 +1,122 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	var running, maxRunning int32
+	errs := RunConcurrently(context.Background(), 2, 6, func(ctx context.Context, index int) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if index == 3 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	assert.Equal(t, int32(2), maxRunning)
+	assert.Equal(t, 6, len(errs))
+	for i, err := range errs {
+		if i == 3 {
+			assert.EqualError(t, err, "failed")
+		} else {
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func TestRunConcurrentlyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := RunConcurrently(ctx, 1, 3, func(ctx context.Context, index int) error {
+		cancel()
+		return nil
+	})
+	assert.Equal(t, []error{nil, context.Canceled, context.Canceled}, errs)
+}
+
+func TestSyncError(t *testing.T) {
+	cause := errors.New("conflict")
+	err := &SyncError{Applied: 2, Errors: []error{cause}, Skipped: 3}
+	assert.Equal(t, "1 of 6 changes failed, 3 not attempted: conflict", err.Error())
+	assert.True(t, errors.Is(err, cause))
+}
+
+func TestSyncThrottle(t *testing.T) {
+	throttled := func(retryAfter string) error {
+		response := &core.DetailedResponse{StatusCode: http.StatusTooManyRequests, Headers: http.Header{}}
+		if retryAfter != "" {
+			response.Headers.Set("Retry-After", retryAfter)
+		}
+		return NewAPIError(response, errors.New("Too Many Requests"))
+	}
+
+	throttle := &SyncThrottle{Policy: fastRetryPolicy}
+	var calls int32
+	errs := RunConcurrently(context.Background(), 3, 6, func(ctx context.Context, index int) error {
+		return throttle.Do(ctx, func(ctx context.Context) error {
+			if atomic.AddInt32(&calls, 1) <= 2 {
+				return throttled("0")
+			}
+			return nil
+		})
+	})
+	assert.Equal(t, make([]error, 6), errs)
+	assert.Equal(t, int32(8), calls)
+
+	// A change that is still throttled after the maximum number of retries fails.
+	calls = 0
+	throttle = &SyncThrottle{Policy: &RetryPolicy{MaxRetries: 2, MinRetryInterval: time.Millisecond, MaxRetryInterval: time.Millisecond}}
+	err := throttle.Do(context.Background(), func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return throttled("")
+	})
+	assert.True(t, IsRateLimited(err))
+	assert.Equal(t, int32(3), calls)
+
+	// Other errors are not retried, and a cancelled context stops the pause.
+	calls = 0
+	err = throttle.Do(context.Background(), func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return errors.New("Bad Request")
+	})
+	assert.EqualError(t, err, "Bad Request")
+	assert.Equal(t, int32(1), calls)
+
+	throttle = &SyncThrottle{}
+	throttle.pause(&RetryPolicy{}, throttled("60"))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = throttle.Do(ctx, func(ctx context.Context) error { return nil })
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
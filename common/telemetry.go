@@ -0,0 +1,362 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// TraceParentHeader is the W3C Trace Context header that propagates the trace of a request.
+const TraceParentHeader = "traceparent"
+
+// The attributes of the spans and of the latency measurements.
+const (
+	AttributeOperation  = "sdk.operation"
+	AttributeService    = "sdk.service"
+	AttributeRetryCount = "sdk.retry_count"
+	AttributeMethod     = "http.method"
+	AttributeURL        = "http.url"
+	AttributeStatusCode = "http.status_code"
+	AttributeInstance   = "ibm.instance"
+	AttributeZone       = "ibm.zone_id"
+	AttributeError      = "error"
+)
+
+// SpanContext identifies a span within a trace.
+type SpanContext struct {
+	// The trace ID, as 32 hex digits.
+	TraceID string
+
+	// The span ID, as 16 hex digits.
+	SpanID string
+
+	// Whether the trace is sampled.
+	Sampled bool
+}
+
+// IsValid reports whether the span context has a trace ID and a span ID.
+func (spanContext SpanContext) IsValid() bool {
+	return len(spanContext.TraceID) == 32 && len(spanContext.SpanID) == 16 &&
+		strings.Trim(spanContext.TraceID, "0") != "" && strings.Trim(spanContext.SpanID, "0") != ""
+}
+
+// TraceParent returns the span context in the format of the TraceParentHeader.
+func (spanContext SpanContext) TraceParent() string {
+	flags := "00"
+	if spanContext.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", spanContext.TraceID, spanContext.SpanID, flags)
+}
+
+// ParseTraceParent parses a value of the TraceParentHeader.
+func ParseTraceParent(traceParent string) (spanContext SpanContext, err error) {
+	fields := strings.Split(strings.TrimSpace(traceParent), "-")
+	if len(fields) < 4 || len(fields[0]) != 2 || fields[0] == "ff" || len(fields[3]) != 2 {
+		err = fmt.Errorf("invalid traceparent %q", traceParent)
+		return
+	}
+	flags, flagsErr := hex.DecodeString(fields[3])
+	_, traceErr := hex.DecodeString(fields[1])
+	_, spanErr := hex.DecodeString(fields[2])
+	if flagsErr != nil || traceErr != nil || spanErr != nil {
+		err = fmt.Errorf("invalid traceparent %q", traceParent)
+		return
+	}
+	spanContext = SpanContext{TraceID: fields[1], SpanID: fields[2], Sampled: flags[0]&1 == 1}
+	if !spanContext.IsValid() {
+		err = fmt.Errorf("invalid traceparent %q", traceParent)
+	}
+	return
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpanContext returns a context that makes the spans of the requests sent with it children
+// of the given span, for instance the current span of an OpenTelemetry tracer.
+func ContextWithSpanContext(ctx context.Context, spanContext SpanContext) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, spanContext)
+}
+
+// SpanContextFromContext returns the span context of ctx, if any.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	spanContext, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	return spanContext, ok
+}
+
+// SpanData is a finished span, which describes an operation invoked by a service.
+type SpanData struct {
+	// The name of the span, which is the service name, version and operationId of the operation,
+	// as passed to GetSdkHeaders (e.g. "dns_svcs/V1/ListResourceRecords").
+	Name string
+
+	SpanContext SpanContext
+
+	// The span of the caller, if any.
+	Parent SpanContext
+
+	StartTime time.Time
+	EndTime   time.Time
+
+	Attributes map[string]interface{}
+
+	// The error of the operation: a transport error, or an error status code.
+	Err error
+}
+
+// Duration returns the time the operation took.
+func (span *SpanData) Duration() time.Duration {
+	return span.EndTime.Sub(span.StartTime)
+}
+
+// SpanExporter receives the finished spans. The spans are only known to the exporter: to record them
+// with a tracer, such as an OpenTelemetry tracer, use a Tracer instead.
+type SpanExporter interface {
+	ExportSpan(span *SpanData)
+}
+
+// Tracer starts the spans of the operations, for instance with an OpenTelemetry tracer. The context of
+// the spans it starts is the one propagated in the TraceParentHeader, so that the spans of the services
+// are children of spans that the tracer records.
+type Tracer interface {
+	// Start starts the span of an operation, given its name, parent, start time and attributes.
+	Start(ctx context.Context, span *SpanData) TracerSpan
+}
+
+// TracerSpan is a span started by a Tracer.
+type TracerSpan interface {
+	// SpanContext returns the context of the span, which isn't propagated if it isn't valid.
+	SpanContext() SpanContext
+
+	// End ends the span, given its end time, its final attributes and the error of the operation.
+	End(span *SpanData)
+}
+
+// Histogram records measurements, such as a Float64Histogram of an OpenTelemetry meter.
+type Histogram interface {
+	Record(ctx context.Context, value float64, attributes map[string]interface{})
+}
+
+// TelemetryOptions configures the TelemetryMiddleware.
+type TelemetryOptions struct {
+	// Starts a span for every operation, if not nil.
+	Tracer Tracer
+
+	// Receives a span for every operation, if not nil.
+	SpanExporter SpanExporter
+
+	// Records the latency of every operation, in seconds, if not nil.
+	LatencyHistogram Histogram
+
+	// Whether to leave the TraceParentHeader out of the requests.
+	DisablePropagation bool
+}
+
+// TelemetryMiddleware returns middleware that makes a span for every request, and records its latency.
+// A span is a child of the span of the context of the request, if any; its context is propagated in
+// the TraceParentHeader of the request. The span is started with the Tracer of the options if any, and
+// otherwise given random IDs. The middleware must be installed in front of a RetryTransport,
+// as EnableMiddleware does, for the spans to cover all the attempts of a request and carry their number.
+func TelemetryMiddleware(options *TelemetryOptions) Middleware {
+	if options == nil {
+		options = &TelemetryOptions{}
+	}
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			serviceName, serviceVersion, operationId := GetSdkAnalytics(req.Header)
+			name := req.Method + " " + req.URL.Path
+			if operationId != "" {
+				name = serviceName + "/" + serviceVersion + "/" + operationId
+			}
+
+			parent, ok := SpanContextFromContext(req.Context())
+			if !ok {
+				parent, _ = ParseTraceParent(req.Header.Get(TraceParentHeader))
+			}
+			if !parent.IsValid() {
+				parent = SpanContext{}
+			}
+
+			span := &SpanData{
+				Name:      name,
+				Parent:    parent,
+				StartTime: time.Now(),
+				Attributes: map[string]interface{}{
+					AttributeService:   serviceName,
+					AttributeOperation: operationId,
+					AttributeMethod:    req.Method,
+					AttributeURL:       req.URL.String(),
+				},
+			}
+			for key, value := range resourceAttributes(req.URL) {
+				span.Attributes[key] = value
+			}
+			var tracerSpan TracerSpan
+			if options.Tracer != nil {
+				tracerSpan = options.Tracer.Start(req.Context(), span)
+				span.SpanContext = tracerSpan.SpanContext()
+			} else {
+				span.SpanContext = SpanContext{TraceID: parent.TraceID, SpanID: randomHex(8), Sampled: true}
+				if parent.IsValid() {
+					span.SpanContext.Sampled = parent.Sampled
+				} else {
+					span.SpanContext.TraceID = randomHex(16)
+				}
+			}
+			if !options.DisablePropagation && span.SpanContext.IsValid() {
+				req.Header.Set(TraceParentHeader, span.SpanContext.TraceParent())
+			}
+
+			resp, err := next(req)
+			span.EndTime = time.Now()
+			span.Err = err
+			if resp != nil {
+				span.Attributes[AttributeStatusCode] = resp.StatusCode
+				if retries, convErr := strconv.Atoi(resp.Header.Get(RetryCountHeader)); convErr == nil {
+					span.Attributes[AttributeRetryCount] = retries
+				}
+				if resp.StatusCode >= 400 && err == nil {
+					span.Err = fmt.Errorf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+				}
+			}
+			span.Attributes[AttributeError] = span.Err != nil
+
+			if tracerSpan != nil {
+				tracerSpan.End(span)
+			}
+			if options.SpanExporter != nil {
+				options.SpanExporter.ExportSpan(span)
+			}
+			if options.LatencyHistogram != nil {
+				attributes := map[string]interface{}{
+					AttributeService:   serviceName,
+					AttributeOperation: operationId,
+					AttributeError:     span.Err != nil,
+				}
+				if code, ok := span.Attributes[AttributeStatusCode]; ok {
+					attributes[AttributeStatusCode] = code
+				}
+				options.LatencyHistogram.Record(req.Context(), span.Duration().Seconds(), attributes)
+			}
+			return resp, err
+		}
+	}
+}
+
+// resourceAttributes returns the instance and the zone that the path of a request refers to: the CRN
+// and zone of the CIS APIs (/v1/{crn}/zones/{zone_identifier}), or the instance and DNS zone of the
+// DNS Services APIs (/instances/{instance_id}/dnszones/{dnszone_id}).
+func resourceAttributes(u *url.URL) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	segments := strings.Split(u.EscapedPath(), "/")
+	for i, segment := range segments {
+		segment, err := url.PathUnescape(segment)
+		if err != nil {
+			continue
+		}
+		var next string
+		if i+1 < len(segments) {
+			next, _ = url.PathUnescape(segments[i+1])
+		}
+		switch {
+		case strings.HasPrefix(segment, "crn:"):
+			attributes[AttributeInstance] = segment
+		case segment == "instances" && next != "":
+			attributes[AttributeInstance] = next
+		case (segment == "zones" || segment == "dnszones") && next != "" && attributes[AttributeInstance] != nil:
+			attributes[AttributeZone] = next
+		}
+	}
+	return attributes
+}
+
+// randomHex returns n random bytes as hex digits.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		// Fall back to the time, which is good enough to tell spans apart.
+		copy(b, strconv.FormatInt(time.Now().UnixNano(), 16))
+	}
+	return hex.EncodeToString(b)
+}
+
+// InMemoryExporter is a SpanExporter and a latency Histogram that keeps what it receives in memory,
+// for tests. It is safe for concurrent use.
+type InMemoryExporter struct {
+	mutex        sync.Mutex
+	spans        []*SpanData
+	measurements []Measurement
+}
+
+// Measurement is a value recorded by an InMemoryExporter.
+type Measurement struct {
+	Value      float64
+	Attributes map[string]interface{}
+}
+
+// ExportSpan keeps the span.
+func (exporter *InMemoryExporter) ExportSpan(span *SpanData) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	exporter.spans = append(exporter.spans, span)
+}
+
+// Record keeps the measurement.
+func (exporter *InMemoryExporter) Record(ctx context.Context, value float64, attributes map[string]interface{}) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	exporter.measurements = append(exporter.measurements, Measurement{Value: value, Attributes: attributes})
+}
+
+// Spans returns the spans received, in order.
+func (exporter *InMemoryExporter) Spans() []*SpanData {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	return append([]*SpanData(nil), exporter.spans...)
+}
+
+// Measurements returns the measurements recorded, in order.
+func (exporter *InMemoryExporter) Measurements() []Measurement {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	return append([]Measurement(nil), exporter.measurements...)
+}
+
+// Reset forgets the spans and measurements.
+func (exporter *InMemoryExporter) Reset() {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	exporter.spans = nil
+	exporter.measurements = nil
+}
+
+// EnableTelemetry installs the TelemetryMiddleware on the service with EnableMiddleware, and returns
+// the transport that runs it.
+func EnableTelemetry(service *core.BaseService, options *TelemetryOptions) *MiddlewareTransport {
+	return EnableMiddleware(service, TelemetryMiddleware(options))
+}
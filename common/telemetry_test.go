@@ -0,0 +1,203 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const telemetryCrn = "crn:v1:bluemix:public:internet-svcs:global:a/account-1:instance-1::"
+
+// newTelemetryTestService returns a service whose requests are answered with the given status codes
+// in turn, and a pointer to the traceparent headers the requests were sent with.
+func newTelemetryTestService(t *testing.T, codes ...int) (*core.BaseService, *[]string) {
+	var traceParents []string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		traceParents = append(traceParents, req.Header.Get(TraceParentHeader))
+		code := codes[0]
+		if len(codes) > 1 {
+			codes = codes[1:]
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(code)
+		fmt.Fprint(res, `{}`)
+	}))
+	t.Cleanup(server.Close)
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return service, &traceParents
+}
+
+func invokeTelemetryOperation(t *testing.T, ctx context.Context, service *core.BaseService, path string, operationId string) error {
+	builder := core.NewRequestBuilder(core.GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(service.Options.URL, path, nil)
+	require.Nil(t, err)
+	for headerName, headerValue := range GetSdkHeaders("zones_settings", "V1", operationId) {
+		builder.AddHeader(headerName, headerValue)
+	}
+	request, err := builder.Build()
+	require.Nil(t, err)
+	_, err = service.Request(request, nil)
+	return err
+}
+
+func TestTelemetrySpans(t *testing.T) {
+	service, traceParents := newTelemetryTestService(t, 503, 200)
+	EnableRetryPolicies(service, fastRetryPolicy)
+	exporter := &InMemoryExporter{}
+	EnableTelemetry(service, &TelemetryOptions{SpanExporter: exporter, LatencyHistogram: exporter})
+
+	path := "/v1/" + url.PathEscape(telemetryCrn) + "/zones/zone-1/settings/dnssec"
+	require.Nil(t, invokeTelemetryOperation(t, context.Background(), service, path, "GetZoneDnssec"))
+
+	spans := exporter.Spans()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "zones_settings/V1/GetZoneDnssec", span.Name)
+	assert.True(t, span.SpanContext.IsValid())
+	assert.False(t, span.Parent.IsValid())
+	assert.Nil(t, span.Err)
+	assert.Equal(t, 200, span.Attributes[AttributeStatusCode])
+	assert.Equal(t, 1, span.Attributes[AttributeRetryCount])
+	assert.Equal(t, telemetryCrn, span.Attributes[AttributeInstance])
+	assert.Equal(t, "zone-1", span.Attributes[AttributeZone])
+	assert.Equal(t, false, span.Attributes[AttributeError])
+	// Both attempts carry the context of the span.
+	assert.Equal(t, []string{span.SpanContext.TraceParent(), span.SpanContext.TraceParent()}, *traceParents)
+
+	measurements := exporter.Measurements()
+	require.Len(t, measurements, 1)
+	assert.Equal(t, span.Duration().Seconds(), measurements[0].Value)
+	assert.Equal(t, "GetZoneDnssec", measurements[0].Attributes[AttributeOperation])
+	assert.Equal(t, 200, measurements[0].Attributes[AttributeStatusCode])
+}
+
+func TestTelemetryParentAndErrors(t *testing.T) {
+	service, traceParents := newTelemetryTestService(t, 404)
+	exporter := &InMemoryExporter{}
+	EnableTelemetry(service, &TelemetryOptions{SpanExporter: exporter})
+
+	parent := SpanContext{TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7", Sampled: true}
+	ctx := ContextWithSpanContext(context.Background(), parent)
+	err := invokeTelemetryOperation(t, ctx, service, "/instances/instance-1/dnszones/zone-2", "GetDnszone")
+	assert.NotNil(t, err)
+
+	spans := exporter.Spans()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, parent, span.Parent)
+	assert.Equal(t, parent.TraceID, span.SpanContext.TraceID)
+	assert.NotEqual(t, parent.SpanID, span.SpanContext.SpanID)
+	assert.NotNil(t, span.Err)
+	assert.Equal(t, true, span.Attributes[AttributeError])
+	assert.Equal(t, 404, span.Attributes[AttributeStatusCode])
+	assert.Equal(t, "instance-1", span.Attributes[AttributeInstance])
+	assert.Equal(t, "zone-2", span.Attributes[AttributeZone])
+
+	received, err := ParseTraceParent((*traceParents)[0])
+	require.Nil(t, err)
+	assert.Equal(t, span.SpanContext, received)
+
+	exporter.Reset()
+	assert.Empty(t, exporter.Spans())
+}
+
+func TestTelemetryWithoutPropagation(t *testing.T) {
+	service, traceParents := newTelemetryTestService(t, 200)
+	exporter := &InMemoryExporter{}
+	EnableTelemetry(service, &TelemetryOptions{SpanExporter: exporter, DisablePropagation: true})
+	require.Nil(t, invokeTelemetryOperation(t, context.Background(), service, "/transit_gateways", "ListTransitGateways"))
+	assert.Equal(t, []string{""}, *traceParents)
+	assert.Len(t, exporter.Spans(), 1)
+}
+
+// testTracer is a Tracer that gives the spans it starts a fixed span ID, and keeps the spans it ends.
+type testTracer struct {
+	started []*SpanData
+	ended   []*SpanData
+}
+
+type testTracerSpan struct {
+	tracer      *testTracer
+	spanContext SpanContext
+}
+
+func (tracer *testTracer) Start(ctx context.Context, span *SpanData) TracerSpan {
+	tracer.started = append(tracer.started, span)
+	return &testTracerSpan{tracer: tracer, spanContext: SpanContext{TraceID: span.Parent.TraceID, SpanID: "b7ad6b7169203331", Sampled: true}}
+}
+
+func (span *testTracerSpan) SpanContext() SpanContext {
+	return span.spanContext
+}
+
+func (span *testTracerSpan) End(data *SpanData) {
+	span.tracer.ended = append(span.tracer.ended, data)
+}
+
+func TestTelemetryTracer(t *testing.T) {
+	service, traceParents := newTelemetryTestService(t, 200)
+	tracer := &testTracer{}
+	exporter := &InMemoryExporter{}
+	EnableTelemetry(service, &TelemetryOptions{Tracer: tracer, SpanExporter: exporter})
+
+	parent := SpanContext{TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7", Sampled: true}
+	ctx := ContextWithSpanContext(context.Background(), parent)
+	require.Nil(t, invokeTelemetryOperation(t, ctx, service, "/transit_gateways", "ListTransitGateways"))
+
+	require.Len(t, tracer.started, 1)
+	require.Len(t, tracer.ended, 1)
+	span := tracer.ended[0]
+	assert.Same(t, tracer.started[0], span)
+	assert.Equal(t, "zones_settings/V1/ListTransitGateways", span.Name)
+	assert.Equal(t, parent, span.Parent)
+	assert.Equal(t, 200, span.Attributes[AttributeStatusCode])
+	// The span of the tracer is the one propagated and exported.
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-b7ad6b7169203331-01", span.SpanContext.TraceParent())
+	assert.Equal(t, []string{span.SpanContext.TraceParent()}, *traceParents)
+	assert.Equal(t, []*SpanData{span}, exporter.Spans())
+}
+
+func TestParseTraceParent(t *testing.T) {
+	spanContext, err := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.Nil(t, err)
+	assert.Equal(t, SpanContext{TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7", Sampled: true}, spanContext)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", spanContext.TraceParent())
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-zzf067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	} {
+		_, err = ParseTraceParent(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
@@ -0,0 +1,189 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultWaitInitialInterval is the default interval between the first two polls of a waiter.
+	DefaultWaitInitialInterval = 5 * time.Second
+
+	// DefaultWaitMaxInterval is the default upper bound of the interval between two polls of a waiter.
+	DefaultWaitMaxInterval = 60 * time.Second
+
+	// DefaultWaitMultiplier is the default factor applied to the polling interval after each poll.
+	DefaultWaitMultiplier = 1.5
+)
+
+// WaitOptions controls how a waiter polls the service while it waits for a resource to reach a
+// desired state. Zero-valued fields take their default values. A nil *WaitOptions uses the defaults.
+type WaitOptions struct {
+	// The interval between the first and the second poll.
+	InitialInterval time.Duration
+
+	// The upper bound of the interval between two polls.
+	MaxInterval time.Duration
+
+	// The factor applied to the interval after each poll; 1 polls at a fixed interval.
+	Multiplier float64
+
+	// The maximum time to wait. If zero, the wait is only bounded by the deadline of the context.
+	Timeout time.Duration
+}
+
+// PollFunc checks the state of a resource once. It returns true when the wait is over, or an
+// error to abandon the wait.
+type PollFunc func(ctx context.Context) (done bool, err error)
+
+// StatusFunc retrieves a resource and returns its current status along with the resource itself.
+type StatusFunc func(ctx context.Context) (status string, resource interface{}, err error)
+
+// ResourceStateError is returned by a waiter when the resource reaches a terminal failure status,
+// or when the wait ends (see Err) before the resource reaches the desired status. Status and
+// Resource hold the last state observed by the waiter.
+type ResourceStateError struct {
+	// The kind of resource that was waited on (e.g. "gateway").
+	ResourceType string
+
+	// The identifier of the resource.
+	ID string
+
+	// The last status observed, if any.
+	Status string
+
+	// The last version of the resource retrieved from the service, if any.
+	Resource interface{}
+
+	// The error that ended the wait (e.g. context.DeadlineExceeded), or nil if the
+	// resource reached a terminal failure status.
+	Err error
+}
+
+func (e *ResourceStateError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s %s did not reach the desired status (last status: %q): %s", e.ResourceType, e.ID, e.Status, e.Err.Error())
+	}
+	return fmt.Sprintf("%s %s reached terminal status %q", e.ResourceType, e.ID, e.Status)
+}
+
+// Unwrap returns the error that ended the wait, if any.
+func (e *ResourceStateError) Unwrap() error {
+	return e.Err
+}
+
+// WaitFor invokes poll until it reports that the wait is over, it returns an error, or ctx is done.
+// The interval between polls grows according to options. If ctx is done first, the context error
+// is returned.
+func WaitFor(ctx context.Context, options *WaitOptions, poll PollFunc) error {
+	if options == nil {
+		options = &WaitOptions{}
+	}
+	interval := options.InitialInterval
+	if interval <= 0 {
+		interval = DefaultWaitInitialInterval
+	}
+	maxInterval := options.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxInterval
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+	multiplier := options.Multiplier
+	if multiplier < 1 {
+		multiplier = DefaultWaitMultiplier
+	}
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	for {
+		done, err := poll(ctx)
+		if err != nil {
+			// A request cut short by the context surfaces as a transport error; report the
+			// context error instead so that callers can test for it.
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * multiplier)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// WaitForStatus invokes getStatus until the resource reports one of the target statuses and returns
+// the last version of the resource. If one of the failure statuses is observed, or if the wait ends
+// first, a *ResourceStateError holding the last observed state is returned.
+func WaitForStatus(ctx context.Context, options *WaitOptions, resourceType string, id string, target []string, failure []string, getStatus StatusFunc) (resource interface{}, err error) {
+	var status string
+	err = WaitFor(ctx, options, func(ctx context.Context) (bool, error) {
+		currentStatus, currentResource, err := getStatus(ctx)
+		if err != nil {
+			return false, err
+		}
+		status, resource = currentStatus, currentResource
+		if containsStatus(failure, status) {
+			return false, &ResourceStateError{
+				ResourceType: resourceType,
+				ID:           id,
+				Status:       status,
+				Resource:     resource,
+			}
+		}
+		return containsStatus(target, status), nil
+	})
+	if err == context.DeadlineExceeded || err == context.Canceled {
+		err = &ResourceStateError{
+			ResourceType: resourceType,
+			ID:           id,
+			Status:       status,
+			Resource:     resource,
+			Err:          err,
+		}
+	}
+	return
+}
+
+func containsStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var fastWaitOptions = &WaitOptions{
+	InitialInterval: time.Millisecond,
+	MaxInterval:     2 * time.Millisecond,
+}
+
+func TestWaitFor(t *testing.T) {
+	polls := 0
+	err := WaitFor(context.Background(), fastWaitOptions, func(ctx context.Context) (bool, error) {
+		polls++
+		return polls == 3, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, polls)
+
+	pollErr := errors.New("poll failed")
+	err = WaitFor(context.Background(), fastWaitOptions, func(ctx context.Context) (bool, error) {
+		return false, pollErr
+	})
+	assert.Equal(t, pollErr, err)
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	options := &WaitOptions{
+		InitialInterval: time.Millisecond,
+		Timeout:         20 * time.Millisecond,
+	}
+	err := WaitFor(context.Background(), options, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = WaitFor(ctx, fastWaitOptions, func(ctx context.Context) (bool, error) {
+		return false, errors.New("request cancelled")
+	})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestWaitForStatus(t *testing.T) {
+	statuses := []string{"pending", "pending", "available"}
+	polls := 0
+	getStatus := func(ctx context.Context) (string, interface{}, error) {
+		status := statuses[polls]
+		polls++
+		return status, "resource-" + status, nil
+	}
+
+	resource, err := WaitForStatus(context.Background(), fastWaitOptions, "gateway", "gw-1", []string{"available"}, []string{"failed"}, getStatus)
+	assert.Nil(t, err)
+	assert.Equal(t, "resource-available", resource)
+
+	statuses = []string{"pending", "failed"}
+	polls = 0
+	resource, err = WaitForStatus(context.Background(), fastWaitOptions, "gateway", "gw-1", []string{"available"}, []string{"failed"}, getStatus)
+	assert.NotNil(t, err)
+	assert.Equal(t, "resource-failed", resource)
+
+	var stateErr *ResourceStateError
+	assert.True(t, errors.As(err, &stateErr))
+	assert.Equal(t, "failed", stateErr.Status)
+	assert.Equal(t, "gw-1", stateErr.ID)
+	assert.Nil(t, stateErr.Err)
+	assert.Contains(t, err.Error(), `gateway gw-1 reached terminal status "failed"`)
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	options := &WaitOptions{
+		InitialInterval: time.Millisecond,
+		Timeout:         20 * time.Millisecond,
+	}
+	_, err := WaitForStatus(context.Background(), options, "gateway", "gw-1", []string{"available"}, nil,
+		func(ctx context.Context) (string, interface{}, error) {
+			return "pending", nil, nil
+		})
+
+	var stateErr *ResourceStateError
+	assert.True(t, errors.As(err, &stateErr))
+	assert.Equal(t, "pending", stateErr.Status)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
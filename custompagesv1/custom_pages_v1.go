@@ -179,6 +179,24 @@ func (customPages *CustomPagesV1) DisableRetries() {
 	customPages.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (customPages *CustomPagesV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(customPages.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (customPages *CustomPagesV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(customPages.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (customPages *CustomPagesV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(customPages.Service, middleware...)
+}
+
 // ListInstanceCustomPages : List all custom pages for a given instance
 // List all custom pages for a given instance.
 func (customPages *CustomPagesV1) ListInstanceCustomPages(listInstanceCustomPagesOptions *ListInstanceCustomPagesOptions) (result *ListCustomPagesResp, response *core.DetailedResponse, err error) {
@@ -222,6 +240,7 @@ func (customPages *CustomPagesV1) ListInstanceCustomPagesWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListCustomPagesResp)
@@ -281,6 +300,7 @@ func (customPages *CustomPagesV1) GetInstanceCustomPageWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomPageSpecificResp)
@@ -353,6 +373,7 @@ func (customPages *CustomPagesV1) UpdateInstanceCustomPageWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomPageSpecificResp)
@@ -408,6 +429,7 @@ func (customPages *CustomPagesV1) ListZoneCustomPagesWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListCustomPagesResp)
@@ -468,6 +490,7 @@ func (customPages *CustomPagesV1) GetZoneCustomPageWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomPageSpecificResp)
@@ -541,6 +564,7 @@ func (customPages *CustomPagesV1) UpdateZoneCustomPageWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = customPages.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomPageSpecificResp)
@@ -0,0 +1,43 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package custompagesv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CustomPagesV1API is the interface of the operations of CustomPagesV1, through which they can be replaced
+// in unit tests, for instance with the mocks.CustomPagesV1 of the mocks package.
+type CustomPagesV1API interface {
+	ListInstanceCustomPages(listInstanceCustomPagesOptions *ListInstanceCustomPagesOptions) (result *ListCustomPagesResp, response *core.DetailedResponse, err error)
+	ListInstanceCustomPagesWithContext(ctx context.Context, listInstanceCustomPagesOptions *ListInstanceCustomPagesOptions) (result *ListCustomPagesResp, response *core.DetailedResponse, err error)
+	GetInstanceCustomPage(getInstanceCustomPageOptions *GetInstanceCustomPageOptions) (result *CustomPageSpecificResp, response *core.DetailedResponse, err error)
+	GetInstanceCustomPageWithContext(ctx context.Context, getInstanceCustomPageOptions *GetInstanceCustomPageOptions) (result *CustomPageSpecificResp, response *core.DetailedResponse, err error)
+	UpdateInstanceCustomPage(updateInstanceCustomPageOptions *UpdateInstanceCustomPageOptions) (result *CustomPageSpecificResp, response *core.DetailedResponse, err error)
+	UpdateInstanceCustomPageWithContext(ctx context.Context, updateInstanceCustomPageOptions *UpdateInstanceCustomPageOptions) (result *CustomPageSpecificResp, response *core.DetailedResponse, err error)
+	ListZoneCustomPages(listZoneCustomPagesOptions *ListZoneCustomPagesOptions) (result *ListCustomPagesResp, response *core.DetailedResponse, err error)
+	ListZoneCustomPagesWithContext(ctx context.Context, listZoneCustomPagesOptions *ListZoneCustomPagesOptions) (result *ListCustomPagesResp, response *core.DetailedResponse, err error)
+	GetZoneCustomPage(getZoneCustomPageOptions *GetZoneCustomPageOptions) (result *CustomPageSpecificResp, response *core.DetailedResponse, err error)
+	GetZoneCustomPageWithContext(ctx context.Context, getZoneCustomPageOptions *GetZoneCustomPageOptions) (result *CustomPageSpecificResp, response *core.DetailedResponse, err error)
+	UpdateZoneCustomPage(updateZoneCustomPageOptions *UpdateZoneCustomPageOptions) (result *CustomPageSpecificResp, response *core.DetailedResponse, err error)
+	UpdateZoneCustomPageWithContext(ctx context.Context, updateZoneCustomPageOptions *UpdateZoneCustomPageOptions) (result *CustomPageSpecificResp, response *core.DetailedResponse, err error)
+}
+
+// CustomPagesV1 implements CustomPagesV1API.
+var _ CustomPagesV1API = (*CustomPagesV1)(nil)
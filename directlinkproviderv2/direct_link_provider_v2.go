@@ -175,6 +175,24 @@ func (directLinkProvider *DirectLinkProviderV2) DisableRetries() {
 	directLinkProvider.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (directLinkProvider *DirectLinkProviderV2) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(directLinkProvider.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (directLinkProvider *DirectLinkProviderV2) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(directLinkProvider.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (directLinkProvider *DirectLinkProviderV2) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(directLinkProvider.Service, middleware...)
+}
+
 // ListProviderGateways : List gateways
 // List all Direct Link Connect gateways created by this provider.
 func (directLinkProvider *DirectLinkProviderV2) ListProviderGateways(listProviderGatewaysOptions *ListProviderGatewaysOptions) (result *ProviderGatewayCollection, response *core.DetailedResponse, err error) {
@@ -222,6 +240,7 @@ func (directLinkProvider *DirectLinkProviderV2) ListProviderGatewaysWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -316,6 +335,7 @@ func (directLinkProvider *DirectLinkProviderV2) CreateProviderGatewayWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -378,6 +398,7 @@ func (directLinkProvider *DirectLinkProviderV2) DeleteProviderGatewayWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -441,6 +462,7 @@ func (directLinkProvider *DirectLinkProviderV2) GetProviderGatewayWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -531,6 +553,7 @@ func (directLinkProvider *DirectLinkProviderV2) UpdateProviderGatewayWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -591,6 +614,7 @@ func (directLinkProvider *DirectLinkProviderV2) ListProviderPortsWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -653,6 +677,7 @@ func (directLinkProvider *DirectLinkProviderV2) GetProviderPortWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = directLinkProvider.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -176,6 +176,24 @@ func (directLink *DirectLinkV1) DisableRetries() {
 	directLink.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (directLink *DirectLinkV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(directLink.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (directLink *DirectLinkV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(directLink.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (directLink *DirectLinkV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(directLink.Service, middleware...)
+}
+
 // ListGateways : List gateways
 // List all Direct Link gateways in this account.  Gateways in other accounts with connections to networks in this
 // account are also returned.
@@ -218,6 +236,7 @@ func (directLink *DirectLinkV1) ListGatewaysWithContext(ctx context.Context, lis
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -282,6 +301,7 @@ func (directLink *DirectLinkV1) CreateGatewayWithContext(ctx context.Context, cr
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -341,6 +361,9 @@ func (directLink *DirectLinkV1) DeleteGatewayWithContext(ctx context.Context, de
 	}
 
 	response, err = directLink.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -394,6 +417,7 @@ func (directLink *DirectLinkV1) GetGatewayWithContext(ctx context.Context, getGa
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -505,6 +529,7 @@ func (directLink *DirectLinkV1) UpdateGatewayWithContext(ctx context.Context, up
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -601,6 +626,7 @@ func (directLink *DirectLinkV1) CreateGatewayActionWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -661,6 +687,9 @@ func (directLink *DirectLinkV1) ListGatewayCompletionNoticeWithContext(ctx conte
 	}
 
 	response, err = directLink.Service.Request(request, &result)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -720,6 +749,9 @@ func (directLink *DirectLinkV1) CreateGatewayCompletionNoticeWithContext(ctx con
 	}
 
 	response, err = directLink.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -771,6 +803,9 @@ func (directLink *DirectLinkV1) ListGatewayLetterOfAuthorizationWithContext(ctx
 	}
 
 	response, err = directLink.Service.Request(request, &result)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -826,6 +861,7 @@ func (directLink *DirectLinkV1) GetGatewayStatisticsWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -891,6 +927,7 @@ func (directLink *DirectLinkV1) GetGatewayStatusWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -953,6 +990,7 @@ func (directLink *DirectLinkV1) ListOfferingTypeLocationsWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1016,6 +1054,7 @@ func (directLink *DirectLinkV1) ListOfferingTypeLocationCrossConnectRoutersWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1078,6 +1117,7 @@ func (directLink *DirectLinkV1) ListOfferingTypeSpeedsWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1142,6 +1182,7 @@ func (directLink *DirectLinkV1) ListPortsWithContext(ctx context.Context, listPo
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1204,6 +1245,7 @@ func (directLink *DirectLinkV1) GetPortWithContext(ctx context.Context, getPortO
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1267,6 +1309,7 @@ func (directLink *DirectLinkV1) ListGatewayVirtualConnectionsWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1345,6 +1388,7 @@ func (directLink *DirectLinkV1) CreateGatewayVirtualConnectionWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1405,6 +1449,9 @@ func (directLink *DirectLinkV1) DeleteGatewayVirtualConnectionWithContext(ctx co
 	}
 
 	response, err = directLink.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -1459,6 +1506,7 @@ func (directLink *DirectLinkV1) GetGatewayVirtualConnectionWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1535,6 +1583,7 @@ func (directLink *DirectLinkV1) UpdateGatewayVirtualConnectionWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = directLink.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -4427,7 +4476,10 @@ func (resp *PortCollection) GetNextStart() (*string, error) {
 	if core.IsNil(resp.Next) {
 		return nil, nil
 	}
-	return resp.Next.Start, nil
+	if resp.Next.Start != nil {
+		return resp.Next.Start, nil
+	}
+	return core.GetQueryParam(resp.Next.Href, "start")
 }
 
 // PortsPaginatedCollectionFirst : A reference to the first page of resources.
@@ -5732,3 +5784,97 @@ func UnmarshalGatewayTemplateGatewayTypeDedicatedTemplate(m map[string]json.RawM
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// PortsPager can be used to simplify the use of the "ListPorts" method.
+type PortsPager struct {
+	hasNext     bool
+	options     *ListPortsOptions
+	client      *DirectLinkV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewPortsPager returns a new PortsPager instance.
+func (directLink *DirectLinkV1) NewPortsPager(options *ListPortsOptions) (pager *PortsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Start != nil && *options.Start != "" {
+		err = fmt.Errorf("the 'options.Start' field should not be set")
+		return
+	}
+
+	var optionsCopy ListPortsOptions = *options
+	pager = &PortsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  directLink,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *PortsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *PortsPager) GetNextWithContext(ctx context.Context) (page []Port, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.ListPortsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextStart()
+	if err != nil {
+		return
+	}
+	// Stop if the service hands back the token of the page just retrieved, rather than loop on it.
+	if next != nil && (*next == "" || (pager.options.Start != nil && *next == *pager.options.Start)) {
+		next = nil
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Ports
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *PortsPager) GetAllWithContext(ctx context.Context) (allItems []Port, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []Port
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *PortsPager) GetNext() (page []Port, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *PortsPager) GetAll() (allItems []Port, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
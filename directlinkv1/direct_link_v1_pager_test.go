@@ -0,0 +1,84 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DirectLinkV1 pagers`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+	version := "testString"
+
+	Describe(`PortsPager`, func() {
+		Context(`Using mock server endpoint with two pages of results`, func() {
+			BeforeEach(func() {
+				requestCount = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					requestCount++
+					Expect(req.URL.EscapedPath()).To(Equal("/ports"))
+					Expect(req.URL.Query()["location_name"]).To(Equal([]string{"dal03"}))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					if requestCount == 1 {
+						Expect(req.URL.Query()["start"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"first": {"href": "https://directlink.cloud.ibm.com/v1/ports?limit=1"}, "limit": 1, "next": {"href": "https://directlink.cloud.ibm.com/v1/ports?start=port-token&limit=1"}, "total_count": 2, "ports": [{"id": "port-1", "label": "XCR-FRK-CS-SEC-01", "location_name": "dal03"}]}`)
+					} else {
+						Expect(req.URL.Query()["start"]).To(Equal([]string{"port-token"}))
+						fmt.Fprintf(res, "%s", `{"first": {"href": "https://directlink.cloud.ibm.com/v1/ports?limit=1"}, "limit": 1, "total_count": 2, "ports": [{"id": "port-2", "label": "XCR-FRK-CS-SEC-02", "location_name": "dal03"}]}`)
+					}
+				}))
+			})
+			It(`Use PortsPager.GetAll successfully`, func() {
+				directLinkService, serviceErr := directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Version:       core.StringPtr(version),
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(directLinkService).ToNot(BeNil())
+
+				listPortsOptionsModel := directLinkService.NewListPortsOptions()
+				listPortsOptionsModel.SetLocationName("dal03")
+				listPortsOptionsModel.SetLimit(1)
+
+				pager, err := directLinkService.NewPortsPager(listPortsOptionsModel)
+				Expect(err).To(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+				Expect(*allResults[1].ID).To(Equal("port-2"))
+				Expect(requestCount).To(Equal(2))
+				Expect(pager.HasNext()).To(BeFalse())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+})
@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// GatewayFailureOperationalStatuses are the gateway operational statuses from which a gateway
+// will not become provisioned without further action.
+var GatewayFailureOperationalStatuses = []string{
+	Gateway_OperationalStatus_CreateRejected,
+	Gateway_OperationalStatus_LoaRejected,
+	Gateway_OperationalStatus_CompletionNoticeRejected,
+	Gateway_OperationalStatus_DeletePending,
+}
+
+// VirtualConnectionFailureStatuses are the virtual connection statuses from which a virtual
+// connection will not become attached.
+var VirtualConnectionFailureStatuses = []string{
+	GatewayVirtualConnection_Status_Rejected,
+	GatewayVirtualConnection_Status_Expired,
+	GatewayVirtualConnection_Status_Deleting,
+	GatewayVirtualConnection_Status_DetachedByNetwork,
+	GatewayVirtualConnection_Status_DetachedByNetworkPending,
+}
+
+// WaitForGatewayProvisioned polls GetGateway until the operational status of the gateway is "provisioned",
+// typically after CreateGateway or UpdateGateway. If the gateway reaches one of the
+// GatewayFailureOperationalStatuses, or if the wait ends first, a *common.ResourceStateError holding the
+// last observed gateway is returned.
+func (directLink *DirectLinkV1) WaitForGatewayProvisioned(ctx context.Context, id string, waitOptions *common.WaitOptions) (result *Gateway, err error) {
+	getGatewayOptions := directLink.NewGetGatewayOptions(id)
+	_, err = common.WaitForStatus(ctx, waitOptions, "gateway", id,
+		[]string{Gateway_OperationalStatus_Provisioned}, GatewayFailureOperationalStatuses,
+		func(ctx context.Context) (string, interface{}, error) {
+			gateway, _, err := directLink.GetGatewayWithContext(ctx, getGatewayOptions)
+			if err != nil {
+				return "", nil, err
+			}
+			result = gateway
+			return core.StringNilMapper(gateway.OperationalStatus), gateway, nil
+		})
+	return
+}
+
+// WaitForGatewayDeleted polls GetGateway until the gateway no longer exists, typically after DeleteGateway.
+func (directLink *DirectLinkV1) WaitForGatewayDeleted(ctx context.Context, id string, waitOptions *common.WaitOptions) (err error) {
+	getGatewayOptions := directLink.NewGetGatewayOptions(id)
+	var last *Gateway
+	err = common.WaitFor(ctx, waitOptions, func(ctx context.Context) (bool, error) {
+		gateway, _, err := directLink.GetGatewayWithContext(ctx, getGatewayOptions)
+		if common.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		last = gateway
+		return false, nil
+	})
+	if err == context.DeadlineExceeded || err == context.Canceled {
+		stateErr := &common.ResourceStateError{
+			ResourceType: "gateway",
+			ID:           id,
+			Status:       gatewayOperationalStatus(last),
+			Err:          err,
+		}
+		if last != nil {
+			stateErr.Resource = last
+		}
+		err = stateErr
+	}
+	return
+}
+
+// WaitForVirtualConnectionAttached polls GetGatewayVirtualConnection until the status of the virtual connection
+// is "attached", typically after CreateGatewayVirtualConnection. If the virtual connection reaches one of the
+// VirtualConnectionFailureStatuses, or if the wait ends first, a *common.ResourceStateError holding the last
+// observed virtual connection is returned.
+func (directLink *DirectLinkV1) WaitForVirtualConnectionAttached(ctx context.Context, gatewayID string, id string, waitOptions *common.WaitOptions) (result *GatewayVirtualConnection, err error) {
+	getGatewayVirtualConnectionOptions := directLink.NewGetGatewayVirtualConnectionOptions(gatewayID, id)
+	_, err = common.WaitForStatus(ctx, waitOptions, "virtual connection", id,
+		[]string{GatewayVirtualConnection_Status_Attached}, VirtualConnectionFailureStatuses,
+		func(ctx context.Context) (string, interface{}, error) {
+			virtualConnection, _, err := directLink.GetGatewayVirtualConnectionWithContext(ctx, getGatewayVirtualConnectionOptions)
+			if err != nil {
+				return "", nil, err
+			}
+			result = virtualConnection
+			return core.StringNilMapper(virtualConnection.Status), virtualConnection, nil
+		})
+	return
+}
+
+// WaitForVirtualConnectionDeleted polls GetGatewayVirtualConnection until the virtual connection no longer exists,
+// typically after DeleteGatewayVirtualConnection.
+func (directLink *DirectLinkV1) WaitForVirtualConnectionDeleted(ctx context.Context, gatewayID string, id string, waitOptions *common.WaitOptions) (err error) {
+	getGatewayVirtualConnectionOptions := directLink.NewGetGatewayVirtualConnectionOptions(gatewayID, id)
+	var last *GatewayVirtualConnection
+	err = common.WaitFor(ctx, waitOptions, func(ctx context.Context) (bool, error) {
+		virtualConnection, _, err := directLink.GetGatewayVirtualConnectionWithContext(ctx, getGatewayVirtualConnectionOptions)
+		if common.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		last = virtualConnection
+		return false, nil
+	})
+	if err == context.DeadlineExceeded || err == context.Canceled {
+		stateErr := &common.ResourceStateError{
+			ResourceType: "virtual connection",
+			ID:           id,
+			Status:       virtualConnectionStatus(last),
+			Err:          err,
+		}
+		if last != nil {
+			stateErr.Resource = last
+		}
+		err = stateErr
+	}
+	return
+}
+
+func gatewayOperationalStatus(gateway *Gateway) string {
+	if gateway == nil {
+		return ""
+	}
+	return core.StringNilMapper(gateway.OperationalStatus)
+}
+
+func virtualConnectionStatus(virtualConnection *GatewayVirtualConnection) string {
+	if virtualConnection == nil {
+		return ""
+	}
+	return core.StringNilMapper(virtualConnection.Status)
+}
@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package directlinkv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DirectLinkV1 waiters`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+	version := "testString"
+	waitOptions := &common.WaitOptions{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     2 * time.Millisecond,
+	}
+
+	// serveStatuses answers each request with the next of the given status codes and
+	// response bodies, repeating the last one once the list is exhausted.
+	serveStatuses := func(path string, codes []int, bodies []string) {
+		requestCount = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal(path))
+			Expect(req.Method).To(Equal("GET"))
+			i := requestCount
+			if i >= len(codes) {
+				i = len(codes) - 1
+			}
+			requestCount++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(codes[i])
+			fmt.Fprintf(res, "%s", bodies[i])
+		}))
+	}
+
+	newService := func() *directlinkv1.DirectLinkV1 {
+		directLinkService, serviceErr := directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr(version),
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(directLinkService).ToNot(BeNil())
+		return directLinkService
+	}
+
+	gateway := func(status string) string {
+		return fmt.Sprintf(`{"id": "gw-1", "name": "myGateway", "type": "connect", "operational_status": "%s"}`, status)
+	}
+	virtualConnection := func(status string) string {
+		return fmt.Sprintf(`{"id": "vc-1", "name": "newVC", "type": "vpc", "status": "%s"}`, status)
+	}
+	notFound := `{"errors": [{"code": "not_found", "message": "Gateway not found"}], "trace": "abc"}`
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`WaitForGatewayProvisioned`, func() {
+		It(`Invoke WaitForGatewayProvisioned successfully`, func() {
+			serveStatuses("/gateways/gw-1", []int{200, 200, 200},
+				[]string{gateway("create_pending"), gateway("configuring"), gateway("provisioned")})
+
+			result, err := newService().WaitForGatewayProvisioned(context.Background(), "gw-1", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*result.OperationalStatus).To(Equal(directlinkv1.Gateway_OperationalStatus_Provisioned))
+			Expect(requestCount).To(Equal(3))
+		})
+		It(`Invoke WaitForGatewayProvisioned with error: terminal failure status`, func() {
+			serveStatuses("/gateways/gw-1", []int{200, 200},
+				[]string{gateway("create_pending"), gateway("create_rejected")})
+
+			result, err := newService().WaitForGatewayProvisioned(context.Background(), "gw-1", waitOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(*result.OperationalStatus).To(Equal(directlinkv1.Gateway_OperationalStatus_CreateRejected))
+
+			var stateErr *common.ResourceStateError
+			Expect(errors.As(err, &stateErr)).To(BeTrue())
+			Expect(stateErr.Status).To(Equal("create_rejected"))
+			Expect(stateErr.Resource).To(Equal(result))
+		})
+		It(`Invoke WaitForGatewayProvisioned with error: context deadline`, func() {
+			serveStatuses("/gateways/gw-1", []int{200}, []string{gateway("create_pending")})
+
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancelFunc()
+			_, err := newService().WaitForGatewayProvisioned(ctx, "gw-1", waitOptions)
+
+			var stateErr *common.ResourceStateError
+			Expect(errors.As(err, &stateErr)).To(BeTrue())
+			Expect(stateErr.Status).To(Equal("create_pending"))
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+	})
+	Describe(`WaitForGatewayDeleted`, func() {
+		It(`Invoke WaitForGatewayDeleted successfully`, func() {
+			serveStatuses("/gateways/gw-1", []int{200, 404}, []string{gateway("delete_pending"), notFound})
+
+			err := newService().WaitForGatewayDeleted(context.Background(), "gw-1", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(requestCount).To(Equal(2))
+		})
+		It(`Invoke WaitForGatewayDeleted with error: request error`, func() {
+			serveStatuses("/gateways/gw-1", []int{403}, []string{`{"errors": [{"code": "forbidden", "message": "Forbidden"}]}`})
+
+			err := newService().WaitForGatewayDeleted(context.Background(), "gw-1", waitOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(requestCount).To(Equal(1))
+		})
+	})
+	Describe(`WaitForVirtualConnectionAttached`, func() {
+		It(`Invoke WaitForVirtualConnectionAttached successfully`, func() {
+			serveStatuses("/gateways/gw-1/virtual_connections/vc-1", []int{200, 200},
+				[]string{virtualConnection("pending"), virtualConnection("attached")})
+
+			result, err := newService().WaitForVirtualConnectionAttached(context.Background(), "gw-1", "vc-1", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*result.Status).To(Equal(directlinkv1.GatewayVirtualConnection_Status_Attached))
+		})
+		It(`Invoke WaitForVirtualConnectionAttached with error: terminal failure status`, func() {
+			serveStatuses("/gateways/gw-1/virtual_connections/vc-1", []int{200},
+				[]string{virtualConnection("rejected")})
+
+			_, err := newService().WaitForVirtualConnectionAttached(context.Background(), "gw-1", "vc-1", waitOptions)
+
+			var stateErr *common.ResourceStateError
+			Expect(errors.As(err, &stateErr)).To(BeTrue())
+			Expect(stateErr.Status).To(Equal("rejected"))
+			Expect(stateErr.ResourceType).To(Equal("virtual connection"))
+		})
+	})
+	Describe(`WaitForVirtualConnectionDeleted`, func() {
+		It(`Invoke WaitForVirtualConnectionDeleted successfully`, func() {
+			serveStatuses("/gateways/gw-1/virtual_connections/vc-1", []int{200, 404},
+				[]string{virtualConnection("deleting"), notFound})
+
+			err := newService().WaitForVirtualConnectionDeleted(context.Background(), "gw-1", "vc-1", waitOptions)
+			Expect(err).To(BeNil())
+		})
+	})
+})
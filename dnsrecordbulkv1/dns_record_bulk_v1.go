@@ -179,6 +179,24 @@ func (dnsRecordBulk *DnsRecordBulkV1) DisableRetries() {
 	dnsRecordBulk.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (dnsRecordBulk *DnsRecordBulkV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(dnsRecordBulk.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (dnsRecordBulk *DnsRecordBulkV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(dnsRecordBulk.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (dnsRecordBulk *DnsRecordBulkV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(dnsRecordBulk.Service, middleware...)
+}
+
 // GetDnsRecordsBulk : Export zone file
 // Export zone file.
 func (dnsRecordBulk *DnsRecordBulkV1) GetDnsRecordsBulk(getDnsRecordsBulkOptions *GetDnsRecordsBulkOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
@@ -221,6 +239,9 @@ func (dnsRecordBulk *DnsRecordBulkV1) GetDnsRecordsBulkWithContext(ctx context.C
 	}
 
 	response, err = dnsRecordBulk.Service.Request(request, &result)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -282,6 +303,7 @@ func (dnsRecordBulk *DnsRecordBulkV1) PostDnsRecordsBulkWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecordBulk.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnsRecordsObject)
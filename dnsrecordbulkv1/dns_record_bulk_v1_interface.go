@@ -0,0 +1,36 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordbulkv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DnsRecordBulkV1API is the interface of the operations of DnsRecordBulkV1, through which they can be replaced
+// in unit tests, for instance with the mocks.DnsRecordBulkV1 of the mocks package.
+type DnsRecordBulkV1API interface {
+	GetDnsRecordsBulk(getDnsRecordsBulkOptions *GetDnsRecordsBulkOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	GetDnsRecordsBulkWithContext(ctx context.Context, getDnsRecordsBulkOptions *GetDnsRecordsBulkOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	PostDnsRecordsBulk(postDnsRecordsBulkOptions *PostDnsRecordsBulkOptions) (result *DnsRecordsObject, response *core.DetailedResponse, err error)
+	PostDnsRecordsBulkWithContext(ctx context.Context, postDnsRecordsBulkOptions *PostDnsRecordsBulkOptions) (result *DnsRecordsObject, response *core.DetailedResponse, err error)
+}
+
+// DnsRecordBulkV1 implements DnsRecordBulkV1API.
+var _ DnsRecordBulkV1API = (*DnsRecordBulkV1)(nil)
@@ -178,6 +178,24 @@ func (dnsRecords *DnsRecordsV1) DisableRetries() {
 	dnsRecords.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (dnsRecords *DnsRecordsV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(dnsRecords.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (dnsRecords *DnsRecordsV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(dnsRecords.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (dnsRecords *DnsRecordsV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(dnsRecords.Service, middleware...)
+}
+
 // ListAllDnsRecords : List all DNS records
 // List all DNS records for a given zone of a service instance.
 func (dnsRecords *DnsRecordsV1) ListAllDnsRecords(listAllDnsRecordsOptions *ListAllDnsRecordsOptions) (result *ListDnsrecordsResp, response *core.DetailedResponse, err error) {
@@ -247,6 +265,7 @@ func (dnsRecords *DnsRecordsV1) ListAllDnsRecordsWithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListDnsrecordsResp)
@@ -327,6 +346,7 @@ func (dnsRecords *DnsRecordsV1) CreateDnsRecordWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnsrecordResp)
@@ -387,6 +407,7 @@ func (dnsRecords *DnsRecordsV1) DeleteDnsRecordWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteDnsrecordResp)
@@ -447,6 +468,7 @@ func (dnsRecords *DnsRecordsV1) GetDnsRecordWithContext(ctx context.Context, get
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnsrecordResp)
@@ -535,6 +557,7 @@ func (dnsRecords *DnsRecordsV1) UpdateDnsRecordWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnsrecordResp)
@@ -1198,3 +1221,97 @@ func UnmarshalResultInfo(m map[string]json.RawMessage, result interface{}) (err
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// DnsRecordsPager can be used to simplify the use of the "ListAllDnsRecords" method.
+type DnsRecordsPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListAllDnsRecordsOptions
+	client   *DnsRecordsV1
+}
+
+// NewDnsRecordsPager returns a new DnsRecordsPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (dnsRecords *DnsRecordsV1) NewDnsRecordsPager(options *ListAllDnsRecordsOptions) (pager *DnsRecordsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListAllDnsRecordsOptions = *options
+	pager = &DnsRecordsPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   dnsRecords,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *DnsRecordsPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *DnsRecordsPager) GetNextWithContext(ctx context.Context) (page []DnsrecordDetails, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListAllDnsRecordsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *DnsRecordsPager) GetAllWithContext(ctx context.Context) (allItems []DnsrecordDetails, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []DnsrecordDetails
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *DnsRecordsPager) GetNext() (page []DnsrecordDetails, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *DnsRecordsPager) GetAll() (allItems []DnsrecordDetails, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
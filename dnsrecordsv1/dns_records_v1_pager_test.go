@@ -0,0 +1,155 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordsv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DnsRecordsV1 pagers`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+	crn := "testString"
+	zoneIdentifier := "testString"
+	listAllDnsRecordsPath := "/v1/testString/zones/testString/dns_records"
+
+	newService := func() *dnsrecordsv1.DnsRecordsV1 {
+		dnsRecordsService, serviceErr := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+			URL:            testServer.URL,
+			Authenticator:  &core.NoAuthAuthenticator{},
+			Crn:            core.StringPtr(crn),
+			ZoneIdentifier: core.StringPtr(zoneIdentifier),
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(dnsRecordsService).ToNot(BeNil())
+		return dnsRecordsService
+	}
+
+	Describe(`DnsRecordsPager`, func() {
+		Context(`Using mock server endpoint with three pages of results`, func() {
+			BeforeEach(func() {
+				requestCount = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					requestCount++
+					Expect(req.URL.EscapedPath()).To(Equal(listAllDnsRecordsPath))
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.URL.Query()["type"]).To(Equal([]string{"A"}))
+					Expect(req.URL.Query()["per_page"]).To(Equal([]string{"2"}))
+					Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(requestCount)}))
+
+					page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+					total := 5
+					count := total - (page-1)*2
+					if count > 2 {
+						count = 2
+					}
+					records := ""
+					for i := 0; i < count; i++ {
+						if i > 0 {
+							records += ","
+						}
+						records += fmt.Sprintf(`{"id": "record-%d", "name": "host%d.example.com", "type": "A", "content": "1.2.3.4"}`, (page-1)*2+i, (page-1)*2+i)
+					}
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"success": true, "errors": [], "messages": [], "result": [%s], "result_info": {"page": %d, "per_page": 2, "count": %d, "total_count": %d}}`, records, page, count, total)
+				}))
+			})
+			It(`Use DnsRecordsPager.GetNext successfully`, func() {
+				dnsRecordsService := newService()
+
+				listAllDnsRecordsOptionsModel := dnsRecordsService.NewListAllDnsRecordsOptions()
+				listAllDnsRecordsOptionsModel.SetType("A")
+				listAllDnsRecordsOptionsModel.SetPerPage(2)
+
+				pager, err := dnsRecordsService.NewDnsRecordsPager(listAllDnsRecordsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []dnsrecordsv1.DnsrecordDetails
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(5))
+				Expect(*allResults[4].ID).To(Equal("record-4"))
+				Expect(requestCount).To(Equal(3))
+
+				_, err = pager.GetNext()
+				Expect(err).ToNot(BeNil())
+			})
+			It(`Use DnsRecordsPager.GetAll successfully`, func() {
+				dnsRecordsService := newService()
+
+				listAllDnsRecordsOptionsModel := dnsRecordsService.NewListAllDnsRecordsOptions()
+				listAllDnsRecordsOptionsModel.SetType("A")
+				listAllDnsRecordsOptionsModel.SetPerPage(2)
+
+				pager, err := dnsRecordsService.NewDnsRecordsPager(listAllDnsRecordsOptionsModel)
+				Expect(err).To(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(len(allResults)).To(Equal(5))
+				Expect(listAllDnsRecordsOptionsModel.Page).To(BeNil())
+			})
+			It(`Invoke DnsRecordsPager.GetAllWithContext with a cancelled context`, func() {
+				dnsRecordsService := newService()
+
+				pager, err := dnsRecordsService.NewDnsRecordsPager(dnsRecordsService.NewListAllDnsRecordsOptions().SetPerPage(2))
+				Expect(err).To(BeNil())
+
+				ctx, cancelFunc := context.WithCancel(context.Background())
+				cancelFunc()
+				allResults, err := pager.GetAllWithContext(ctx)
+				Expect(err).To(Equal(context.Canceled))
+				Expect(allResults).To(BeNil())
+				Expect(requestCount).To(Equal(0))
+			})
+			It(`Invoke NewDnsRecordsPager with error: invalid options`, func() {
+				dnsRecordsService := newService()
+
+				pager, err := dnsRecordsService.NewDnsRecordsPager(dnsRecordsService.NewListAllDnsRecordsOptions().SetPage(3))
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+
+				pager, err = dnsRecordsService.NewDnsRecordsPager(dnsRecordsService.NewListAllDnsRecordsOptions().SetPerPage(0))
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+
+				pager, err = dnsRecordsService.NewDnsRecordsPager(nil)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+})
@@ -0,0 +1,698 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordsv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RecordDataIntf : The typed data of a DNS record. Each record type has its own implementation, which
+// produces the Content, Priority and Data fields expected by the service for that type.
+// - ARecordData
+// - AaaaRecordData
+// - CaaRecordData
+// - CnameRecordData
+// - LocRecordData
+// - MxRecordData
+// - NsRecordData
+// - SpfRecordData
+// - SrvRecordData
+// - TxtRecordData
+type RecordDataIntf interface {
+	// RecordType returns the type of the record (one of the CreateDnsRecordOptions_Type_* constants).
+	RecordType() string
+
+	// Validate returns an error if the data is not valid for the type of the record.
+	Validate() error
+
+	// payload returns the content, priority and data fields of a request for the record.
+	payload() (content *string, priority *int64, data map[string]interface{})
+}
+
+// ARecordData : The data of an A record.
+type ARecordData struct {
+	// IPv4 address.
+	IP string
+}
+
+// NewARecordData : Instantiate ARecordData
+func (*DnsRecordsV1) NewARecordData(ip string) (_model *ARecordData, err error) {
+	_model = &ARecordData{IP: ip}
+	err = _model.Validate()
+	return
+}
+
+// RecordType returns CreateDnsRecordOptions_Type_A.
+func (*ARecordData) RecordType() string {
+	return CreateDnsRecordOptions_Type_A
+}
+
+// Validate returns an error if the IP address is not an IPv4 address.
+func (data *ARecordData) Validate() error {
+	if ip := net.ParseIP(data.IP); ip == nil || ip.To4() == nil || strings.Contains(data.IP, ":") {
+		return fmt.Errorf("A record: %q is not an IPv4 address", data.IP)
+	}
+	return nil
+}
+
+func (data *ARecordData) payload() (*string, *int64, map[string]interface{}) {
+	return core.StringPtr(data.IP), nil, nil
+}
+
+// AaaaRecordData : The data of an AAAA record.
+type AaaaRecordData struct {
+	// IPv6 address.
+	IP string
+}
+
+// NewAaaaRecordData : Instantiate AaaaRecordData
+func (*DnsRecordsV1) NewAaaaRecordData(ip string) (_model *AaaaRecordData, err error) {
+	_model = &AaaaRecordData{IP: ip}
+	err = _model.Validate()
+	return
+}
+
+// RecordType returns CreateDnsRecordOptions_Type_Aaaa.
+func (*AaaaRecordData) RecordType() string {
+	return CreateDnsRecordOptions_Type_Aaaa
+}
+
+// Validate returns an error if the IP address is not an IPv6 address.
+func (data *AaaaRecordData) Validate() error {
+	if ip := net.ParseIP(data.IP); ip == nil || !strings.Contains(data.IP, ":") {
+		return fmt.Errorf("AAAA record: %q is not an IPv6 address", data.IP)
+	}
+	return nil
+}
+
+func (data *AaaaRecordData) payload() (*string, *int64, map[string]interface{}) {
+	return core.StringPtr(data.IP), nil, nil
+}
+
+// Constants associated with the CaaRecordData.Tag property.
+const (
+	CaaRecordData_Tag_Iodef     = "iodef"
+	CaaRecordData_Tag_Issue     = "issue"
+	CaaRecordData_Tag_Issuewild = "issuewild"
+)
+
+// CaaRecordData : The data of a CAA record.
+type CaaRecordData struct {
+	// Flags, from 0 to 255 (128 marks the property as critical).
+	Flags int64
+
+	// Property tag (one of the CaaRecordData_Tag_* constants).
+	Tag string
+
+	// Property value, e.g. the domain of a certificate authority, or the URL at which to report violations.
+	Value string
+}
+
+// NewCaaRecordData : Instantiate CaaRecordData
+func (*DnsRecordsV1) NewCaaRecordData(flags int64, tag string, value string) (_model *CaaRecordData, err error) {
+	_model = &CaaRecordData{Flags: flags, Tag: tag, Value: value}
+	err = _model.Validate()
+	return
+}
+
+// RecordType returns CreateDnsRecordOptions_Type_Caa.
+func (*CaaRecordData) RecordType() string {
+	return CreateDnsRecordOptions_Type_Caa
+}
+
+// Validate returns an error if the flags are out of range, if the tag is unknown, or if the value is empty.
+func (data *CaaRecordData) Validate() error {
+	if data.Flags < 0 || data.Flags > 255 {
+		return fmt.Errorf("CAA record: flags %d is not between 0 and 255", data.Flags)
+	}
+	switch data.Tag {
+	case CaaRecordData_Tag_Issue, CaaRecordData_Tag_Issuewild, CaaRecordData_Tag_Iodef:
+	default:
+		return fmt.Errorf("CAA record: tag %q is not one of issue, issuewild and iodef", data.Tag)
+	}
+	if data.Value == "" {
+		return fmt.Errorf("CAA record: value is required")
+	}
+	return nil
+}
+
+func (data *CaaRecordData) payload() (*string, *int64, map[string]interface{}) {
+	return nil, nil, map[string]interface{}{"flags": data.Flags, "tag": data.Tag, "value": data.Value}
+}
+
+// CnameRecordData : The data of a CNAME record.
+type CnameRecordData struct {
+	// Canonical name of the alias.
+	Target string
+}
+
+// NewCnameRecordData : Instantiate CnameRecordData
+func (*DnsRecordsV1) NewCnameRecordData(target string) (_model *CnameRecordData, err error) {
+	_model = &CnameRecordData{Target: target}
+	err = _model.Validate()
+	return
+}
+
+// RecordType returns CreateDnsRecordOptions_Type_Cname.
+func (*CnameRecordData) RecordType() string {
+	return CreateDnsRecordOptions_Type_Cname
+}
+
+// Validate returns an error if the target is not a valid host name.
+func (data *CnameRecordData) Validate() error {
+	return validateHostname("CNAME record", "target", data.Target)
+}
+
+func (data *CnameRecordData) payload() (*string, *int64, map[string]interface{}) {
+	return core.StringPtr(data.Target), nil, nil
+}
+
+// Constants associated with the LocRecordData.LatDirection and LocRecordData.LongDirection properties.
+const (
+	LocRecordData_LatDirection_N  = "N"
+	LocRecordData_LatDirection_S  = "S"
+	LocRecordData_LongDirection_E = "E"
+	LocRecordData_LongDirection_W = "W"
+)
+
+// LocRecordData : The data of a LOC record (RFC 1876).
+type LocRecordData struct {
+	// Latitude: degrees (0 to 90), minutes (0 to 59), seconds (0 to 59.999) and direction (N or S).
+	LatDegrees   int64
+	LatMinutes   int64
+	LatSeconds   float64
+	LatDirection string
+
+	// Longitude: degrees (0 to 180), minutes (0 to 59), seconds (0 to 59.999) and direction (E or W).
+	LongDegrees   int64
+	LongMinutes   int64
+	LongSeconds   float64
+	LongDirection string
+
+	// Altitude in meters, from -100000 to 42849672.95.
+	Altitude float64
+
+	// Diameter of the sphere enclosing the location, and horizontal and vertical precision, in meters,
+	// from 0 to 90000000.
+	Size          float64
+	PrecisionHorz float64
+	PrecisionVert float64
+}
+
+// NewLocRecordData : Instantiate LocRecordData, with the default size (1m) and precisions (10000m
+// horizontally, 10m vertically) of RFC 1876.
+func (*DnsRecordsV1) NewLocRecordData(latDegrees int64, latMinutes int64, latSeconds float64, latDirection string, longDegrees int64, longMinutes int64, longSeconds float64, longDirection string, altitude float64) (_model *LocRecordData, err error) {
+	_model = &LocRecordData{
+		LatDegrees:    latDegrees,
+		LatMinutes:    latMinutes,
+		LatSeconds:    latSeconds,
+		LatDirection:  latDirection,
+		LongDegrees:   longDegrees,
+		LongMinutes:   longMinutes,
+		LongSeconds:   longSeconds,
+		LongDirection: longDirection,
+		Altitude:      altitude,
+		Size:          1,
+		PrecisionHorz: 10000,
+		PrecisionVert: 10,
+	}
+	err = _model.Validate()
+	return
+}
+
+// RecordType returns CreateDnsRecordOptions_Type_Loc.
+func (*LocRecordData) RecordType() string {
+	return CreateDnsRecordOptions_Type_Loc
+}
+
+// Validate returns an error if a coordinate, the altitude, the size or a precision is out of range, or
+// if a direction is unknown.
+func (data *LocRecordData) Validate() error {
+	checks := []struct {
+		name     string
+		value    float64
+		min, max float64
+	}{
+		{"lat_degrees", float64(data.LatDegrees), 0, 90},
+		{"lat_minutes", float64(data.LatMinutes), 0, 59},
+		{"lat_seconds", data.LatSeconds, 0, 59.999},
+		{"long_degrees", float64(data.LongDegrees), 0, 180},
+		{"long_minutes", float64(data.LongMinutes), 0, 59},
+		{"long_seconds", data.LongSeconds, 0, 59.999},
+		{"altitude", data.Altitude, -100000, 42849672.95},
+		{"size", data.Size, 0, 90000000},
+		{"precision_horz", data.PrecisionHorz, 0, 90000000},
+		{"precision_vert", data.PrecisionVert, 0, 90000000},
+	}
+	for _, check := range checks {
+		if check.value < check.min || check.value > check.max {
+			return fmt.Errorf("LOC record: %s %v is not between %v and %v", check.name, check.value, check.min, check.max)
+		}
+	}
+	if data.LatDirection != LocRecordData_LatDirection_N && data.LatDirection != LocRecordData_LatDirection_S {
+		return fmt.Errorf("LOC record: lat_direction %q is not N or S", data.LatDirection)
+	}
+	if data.LongDirection != LocRecordData_LongDirection_E && data.LongDirection != LocRecordData_LongDirection_W {
+		return fmt.Errorf("LOC record: long_direction %q is not E or W", data.LongDirection)
+	}
+	return nil
+}
+
+func (data *LocRecordData) payload() (*string, *int64, map[string]interface{}) {
+	return nil, nil, map[string]interface{}{
+		"lat_degrees":    data.LatDegrees,
+		"lat_minutes":    data.LatMinutes,
+		"lat_seconds":    data.LatSeconds,
+		"lat_direction":  data.LatDirection,
+		"long_degrees":   data.LongDegrees,
+		"long_minutes":   data.LongMinutes,
+		"long_seconds":   data.LongSeconds,
+		"long_direction": data.LongDirection,
+		"altitude":       data.Altitude,
+		"size":           data.Size,
+		"precision_horz": data.PrecisionHorz,
+		"precision_vert": data.PrecisionVert,
+	}
+}
+
+// MxRecordData : The data of an MX record.
+type MxRecordData struct {
+	// Name of the mail exchange.
+	Exchange string
+
+	// Priority of the mail exchange, from 0 to 65535 (lowest first).
+	Priority int64
+}
+
+// NewMxRecordData : Instantiate MxRecordData
+func (*DnsRecordsV1) NewMxRecordData(exchange string, priority int64) (_model *MxRecordData, err error) {
+	_model = &MxRecordData{Exchange: exchange, Priority: priority}
+	err = _model.Validate()
+	return
+}
+
+// RecordType returns CreateDnsRecordOptions_Type_Mx.
+func (*MxRecordData) RecordType() string {
+	return CreateDnsRecordOptions_Type_Mx
+}
+
+// Validate returns an error if the priority is out of range, or if the exchange is not a valid host name.
+func (data *MxRecordData) Validate() error {
+	if data.Priority < 0 || data.Priority > 65535 {
+		return fmt.Errorf("MX record: priority %d is not between 0 and 65535", data.Priority)
+	}
+	return validateHostname("MX record", "exchange", data.Exchange)
+}
+
+func (data *MxRecordData) payload() (*string, *int64, map[string]interface{}) {
+	return core.StringPtr(data.Exchange), core.Int64Ptr(data.Priority), nil
+}
+
+// NsRecordData : The data of an NS record.
+type NsRecordData struct {
+	// Name of the name server.
+	NameServer string
+}
+
+// NewNsRecordData : Instantiate NsRecordData
+func (*DnsRecordsV1) NewNsRecordData(nameServer string) (_model *NsRecordData, err error) {
+	_model = &NsRecordData{NameServer: nameServer}
+	err = _model.Validate()
+	return
+}
+
+// RecordType returns CreateDnsRecordOptions_Type_Ns.
+func (*NsRecordData) RecordType() string {
+	return CreateDnsRecordOptions_Type_Ns
+}
+
+// Validate returns an error if the name server is not a valid host name.
+func (data *NsRecordData) Validate() error {
+	return validateHostname("NS record", "name server", data.NameServer)
+}
+
+func (data *NsRecordData) payload() (*string, *int64, map[string]interface{}) {
+	return core.StringPtr(data.NameServer), nil, nil
+}
+
+// SpfRecordData : The data of an SPF record.
+type SpfRecordData struct {
+	// SPF policy, e.g. "v=spf1 include:_spf.example.com ~all".
+	Text string
+}
+
+// NewSpfRecordData : Instantiate SpfRecordData
+func (*DnsRecordsV1) NewSpfRecordData(text string) (_model *SpfRecordData, err error) {
+	_model = &SpfRecordData{Text: text}
+	err = _model.Validate()
+	return
+}
+
+// RecordType returns CreateDnsRecordOptions_Type_Spf.
+func (*SpfRecordData) RecordType() string {
+	return CreateDnsRecordOptions_Type_Spf
+}
+
+// Validate returns an error if the text does not start with v=spf1.
+func (data *SpfRecordData) Validate() error {
+	if !strings.HasPrefix(data.Text, "v=spf1") {
+		return fmt.Errorf("SPF record: %q does not start with v=spf1", data.Text)
+	}
+	return nil
+}
+
+func (data *SpfRecordData) payload() (*string, *int64, map[string]interface{}) {
+	return core.StringPtr(data.Text), nil, nil
+}
+
+// Constants associated with the SrvRecordData.Proto property.
+const (
+	SrvRecordData_Proto_Tcp = "_tcp"
+	SrvRecordData_Proto_Tls = "_tls"
+	SrvRecordData_Proto_Udp = "_udp"
+)
+
+// SrvRecordData : The data of an SRV record.
+type SrvRecordData struct {
+	// Symbolic name of the service, starting with an underscore (e.g. "_sip").
+	Service string
+
+	// Transport protocol of the service (one of the SrvRecordData_Proto_* constants).
+	Proto string
+
+	// Domain name to which the record applies (e.g. "example.com").
+	Name string
+
+	// Priority and weight of the target, from 0 to 65535.
+	Priority int64
+	Weight   int64
+
+	// Port of the service, from 0 to 65535.
+	Port int64
+
+	// Name of the host providing the service.
+	Target string
+}
+
+// NewSrvRecordData : Instantiate SrvRecordData
+func (*DnsRecordsV1) NewSrvRecordData(service string, proto string, name string, priority int64, weight int64, port int64, target string) (_model *SrvRecordData, err error) {
+	_model = &SrvRecordData{
+		Service:  service,
+		Proto:    proto,
+		Name:     name,
+		Priority: priority,
+		Weight:   weight,
+		Port:     port,
+		Target:   target,
+	}
+	err = _model.Validate()
+	return
+}
+
+// RecordType returns CreateDnsRecordOptions_Type_Srv.
+func (*SrvRecordData) RecordType() string {
+	return CreateDnsRecordOptions_Type_Srv
+}
+
+// Validate returns an error if the service or the proto is invalid, if the priority, weight or port is
+// out of range, or if the name or the target is not a valid host name.
+func (data *SrvRecordData) Validate() error {
+	if !strings.HasPrefix(data.Service, "_") || len(data.Service) < 2 {
+		return fmt.Errorf("SRV record: service %q does not start with an underscore", data.Service)
+	}
+	switch data.Proto {
+	case SrvRecordData_Proto_Tcp, SrvRecordData_Proto_Udp, SrvRecordData_Proto_Tls:
+	default:
+		return fmt.Errorf("SRV record: proto %q is not one of _tcp, _udp and _tls", data.Proto)
+	}
+	for _, field := range []struct {
+		name  string
+		value int64
+	}{{"priority", data.Priority}, {"weight", data.Weight}, {"port", data.Port}} {
+		if field.value < 0 || field.value > 65535 {
+			return fmt.Errorf("SRV record: %s %d is not between 0 and 65535", field.name, field.value)
+		}
+	}
+	if err := validateHostname("SRV record", "name", data.Name); err != nil {
+		return err
+	}
+	return validateHostname("SRV record", "target", data.Target)
+}
+
+func (data *SrvRecordData) payload() (*string, *int64, map[string]interface{}) {
+	return nil, nil, map[string]interface{}{
+		"service":  data.Service,
+		"proto":    data.Proto,
+		"name":     data.Name,
+		"priority": data.Priority,
+		"weight":   data.Weight,
+		"port":     data.Port,
+		"target":   data.Target,
+	}
+}
+
+// TxtRecordData : The data of a TXT record.
+type TxtRecordData struct {
+	// Text of the record.
+	Text string
+}
+
+// NewTxtRecordData : Instantiate TxtRecordData
+func (*DnsRecordsV1) NewTxtRecordData(text string) (_model *TxtRecordData, err error) {
+	_model = &TxtRecordData{Text: text}
+	err = _model.Validate()
+	return
+}
+
+// RecordType returns CreateDnsRecordOptions_Type_Txt.
+func (*TxtRecordData) RecordType() string {
+	return CreateDnsRecordOptions_Type_Txt
+}
+
+// Validate returns an error if the text is empty.
+func (data *TxtRecordData) Validate() error {
+	if data.Text == "" {
+		return fmt.Errorf("TXT record: text is required")
+	}
+	return nil
+}
+
+func (data *TxtRecordData) payload() (*string, *int64, map[string]interface{}) {
+	return core.StringPtr(data.Text), nil, nil
+}
+
+// NewCreateDnsRecordOptionsForRecordData : Instantiate CreateDnsRecordOptions for a record with the
+// given name and data. The data is validated, so that invalid data is rejected before any request.
+func (*DnsRecordsV1) NewCreateDnsRecordOptionsForRecordData(name string, recordData RecordDataIntf) (options *CreateDnsRecordOptions, err error) {
+	err = validateRecordData(recordData)
+	if err != nil {
+		return
+	}
+	content, priority, data := recordData.payload()
+	options = &CreateDnsRecordOptions{
+		Name:     core.StringPtr(name),
+		Type:     core.StringPtr(recordData.RecordType()),
+		Content:  content,
+		Priority: priority,
+	}
+	if data != nil {
+		options.Data = data
+	}
+	return
+}
+
+// NewUpdateDnsRecordOptionsForRecordData : Instantiate UpdateDnsRecordOptions that replace a record
+// with one with the given name and data. The data is validated, so that invalid data is rejected
+// before any request.
+func (*DnsRecordsV1) NewUpdateDnsRecordOptionsForRecordData(dnsrecordIdentifier string, name string, recordData RecordDataIntf) (options *UpdateDnsRecordOptions, err error) {
+	err = validateRecordData(recordData)
+	if err != nil {
+		return
+	}
+	content, priority, data := recordData.payload()
+	options = &UpdateDnsRecordOptions{
+		DnsrecordIdentifier: core.StringPtr(dnsrecordIdentifier),
+		Name:                core.StringPtr(name),
+		Type:                core.StringPtr(recordData.RecordType()),
+		Content:             content,
+		Priority:            priority,
+	}
+	if data != nil {
+		options.Data = data
+	}
+	return
+}
+
+// GetRecordData returns the typed data of the record, or an error if the record has an unsupported
+// type or invalid data.
+func (dnsrecordDetails *DnsrecordDetails) GetRecordData() (recordData RecordDataIntf, err error) {
+	content := core.StringNilMapper(dnsrecordDetails.Content)
+	data, _ := dnsrecordDetails.Data.(map[string]interface{})
+	fields := recordDataFields{data: data}
+
+	switch recordType := strings.ToUpper(core.StringNilMapper(dnsrecordDetails.Type)); recordType {
+	case CreateDnsRecordOptions_Type_A:
+		recordData = &ARecordData{IP: content}
+	case CreateDnsRecordOptions_Type_Aaaa:
+		recordData = &AaaaRecordData{IP: content}
+	case CreateDnsRecordOptions_Type_Cname:
+		recordData = &CnameRecordData{Target: content}
+	case CreateDnsRecordOptions_Type_Ns:
+		recordData = &NsRecordData{NameServer: content}
+	case CreateDnsRecordOptions_Type_Spf:
+		recordData = &SpfRecordData{Text: content}
+	case CreateDnsRecordOptions_Type_Txt:
+		recordData = &TxtRecordData{Text: content}
+	case CreateDnsRecordOptions_Type_Mx:
+		mx := &MxRecordData{Exchange: content}
+		if dnsrecordDetails.Priority != nil {
+			mx.Priority = *dnsrecordDetails.Priority
+		}
+		recordData = mx
+	case CreateDnsRecordOptions_Type_Caa:
+		recordData = &CaaRecordData{
+			Flags: fields.int64("flags"),
+			Tag:   fields.string("tag"),
+			Value: fields.string("value"),
+		}
+	case CreateDnsRecordOptions_Type_Srv:
+		recordData = &SrvRecordData{
+			Service:  fields.string("service"),
+			Proto:    fields.string("proto"),
+			Name:     fields.string("name"),
+			Priority: fields.int64("priority"),
+			Weight:   fields.int64("weight"),
+			Port:     fields.int64("port"),
+			Target:   fields.string("target"),
+		}
+	case CreateDnsRecordOptions_Type_Loc:
+		recordData = &LocRecordData{
+			LatDegrees:    fields.int64("lat_degrees"),
+			LatMinutes:    fields.int64("lat_minutes"),
+			LatSeconds:    fields.float64("lat_seconds"),
+			LatDirection:  fields.string("lat_direction"),
+			LongDegrees:   fields.int64("long_degrees"),
+			LongMinutes:   fields.int64("long_minutes"),
+			LongSeconds:   fields.float64("long_seconds"),
+			LongDirection: fields.string("long_direction"),
+			Altitude:      fields.float64("altitude"),
+			Size:          fields.float64("size"),
+			PrecisionHorz: fields.float64("precision_horz"),
+			PrecisionVert: fields.float64("precision_vert"),
+		}
+	default:
+		err = fmt.Errorf("unsupported record type %q", recordType)
+		return
+	}
+	if fields.err != nil {
+		err = fmt.Errorf("%s record: %s", recordData.RecordType(), fields.err.Error())
+		recordData = nil
+		return
+	}
+	err = recordData.Validate()
+	if err != nil {
+		recordData = nil
+	}
+	return
+}
+
+// validateRecordData returns an error if recordData is nil or not valid.
+func validateRecordData(recordData RecordDataIntf) error {
+	err := core.ValidateNotNil(recordData, "recordData cannot be nil")
+	if err != nil {
+		return err
+	}
+	return recordData.Validate()
+}
+
+// validateHostname returns an error if name is not a valid host name; a trailing dot is allowed, as
+// are underscores, which appear in the names of service records.
+func validateHostname(recordType string, field string, name string) error {
+	trimmed := strings.TrimSuffix(name, ".")
+	if trimmed == "" || len(trimmed) > 253 {
+		return fmt.Errorf("%s: %s %q is not a valid host name", recordType, field, name)
+	}
+	for _, label := range strings.Split(trimmed, ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return fmt.Errorf("%s: %s %q is not a valid host name", recordType, field, name)
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return fmt.Errorf("%s: %s %q is not a valid host name", recordType, field, name)
+			}
+		}
+	}
+	return nil
+}
+
+// recordDataFields reads the fields of the data of a record, remembering the first field with an
+// unexpected type.
+type recordDataFields struct {
+	data map[string]interface{}
+	err  error
+}
+
+func (fields *recordDataFields) string(key string) string {
+	switch value := fields.data[key].(type) {
+	case nil:
+	case string:
+		return value
+	default:
+		fields.fail(key, value)
+	}
+	return ""
+}
+
+func (fields *recordDataFields) float64(key string) float64 {
+	switch value := fields.data[key].(type) {
+	case nil:
+	case float64:
+		return value
+	case int64:
+		return float64(value)
+	case int:
+		return float64(value)
+	case json.Number:
+		number, err := value.Float64()
+		if err == nil {
+			return number
+		}
+		fields.fail(key, value)
+	default:
+		fields.fail(key, value)
+	}
+	return 0
+}
+
+func (fields *recordDataFields) int64(key string) int64 {
+	number := fields.float64(key)
+	if number != float64(int64(number)) {
+		fields.fail(key, number)
+	}
+	return int64(number)
+}
+
+func (fields *recordDataFields) fail(key string, value interface{}) {
+	if fields.err == nil {
+		fields.err = fmt.Errorf("%s %v has an unexpected type %T", key, value, value)
+	}
+}
@@ -0,0 +1,155 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordsv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DnsRecordsV1 record data`, func() {
+	dnsRecordsService, _ := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+		URL:            "http://dnsrecordsv1",
+		Authenticator:  &core.NoAuthAuthenticator{},
+		Crn:            core.StringPtr("crn-1"),
+		ZoneIdentifier: core.StringPtr("zone-1"),
+	})
+
+	// roundTrip returns the record data decoded from the record the service would return for the
+	// create options, as they are serialized.
+	roundTrip := func(options *dnsrecordsv1.CreateDnsRecordOptions) dnsrecordsv1.RecordDataIntf {
+		buf, err := json.Marshal(options)
+		Expect(err).To(BeNil())
+		details := new(dnsrecordsv1.DnsrecordDetails)
+		Expect(json.Unmarshal(buf, details)).To(Succeed())
+		recordData, err := details.GetRecordData()
+		Expect(err).To(BeNil())
+		return recordData
+	}
+
+	Describe(`Record data constructors`, func() {
+		It(`Build the content and data of each record type`, func() {
+			a, err := dnsRecordsService.NewARecordData("10.0.0.1")
+			Expect(err).To(BeNil())
+			options, err := dnsRecordsService.NewCreateDnsRecordOptionsForRecordData("www", a)
+			Expect(err).To(BeNil())
+			Expect(*options.Type).To(Equal("A"))
+			Expect(*options.Content).To(Equal("10.0.0.1"))
+			Expect(options.Data).To(BeNil())
+			Expect(roundTrip(options)).To(Equal(a))
+
+			mx, err := dnsRecordsService.NewMxRecordData("mail.example.com", 10)
+			Expect(err).To(BeNil())
+			options, err = dnsRecordsService.NewCreateDnsRecordOptionsForRecordData("@", mx)
+			Expect(err).To(BeNil())
+			Expect(*options.Priority).To(Equal(int64(10)))
+			Expect(roundTrip(options)).To(Equal(mx))
+
+			caa, err := dnsRecordsService.NewCaaRecordData(0, dnsrecordsv1.CaaRecordData_Tag_Issue, "letsencrypt.org")
+			Expect(err).To(BeNil())
+			options, err = dnsRecordsService.NewCreateDnsRecordOptionsForRecordData("@", caa)
+			Expect(err).To(BeNil())
+			Expect(options.Content).To(BeNil())
+			Expect(options.Data).To(Equal(map[string]interface{}{"flags": int64(0), "tag": "issue", "value": "letsencrypt.org"}))
+			Expect(roundTrip(options)).To(Equal(caa))
+
+			srv, err := dnsRecordsService.NewSrvRecordData("_sip", dnsrecordsv1.SrvRecordData_Proto_Udp, "example.com", 1, 5, 5060, "sip.example.com")
+			Expect(err).To(BeNil())
+			options, err = dnsRecordsService.NewCreateDnsRecordOptionsForRecordData("_sip._udp.example.com", srv)
+			Expect(err).To(BeNil())
+			Expect(options.Data).To(HaveKeyWithValue("port", int64(5060)))
+			Expect(roundTrip(options)).To(Equal(srv))
+
+			loc, err := dnsRecordsService.NewLocRecordData(52, 22, 23.5, "N", 4, 53, 32, "E", -2)
+			Expect(err).To(BeNil())
+			options, err = dnsRecordsService.NewCreateDnsRecordOptionsForRecordData("office", loc)
+			Expect(err).To(BeNil())
+			Expect(options.Data).To(HaveKeyWithValue("lat_seconds", 23.5))
+			Expect(options.Data).To(HaveKeyWithValue("precision_horz", float64(10000)))
+			Expect(roundTrip(options)).To(Equal(loc))
+
+			txt, err := dnsRecordsService.NewTxtRecordData("hello")
+			Expect(err).To(BeNil())
+			update, err := dnsRecordsService.NewUpdateDnsRecordOptionsForRecordData("r-1", "txt", txt)
+			Expect(err).To(BeNil())
+			Expect(*update.DnsrecordIdentifier).To(Equal("r-1"))
+			Expect(*update.Type).To(Equal("TXT"))
+			Expect(*update.Content).To(Equal("hello"))
+		})
+		It(`Reject invalid data`, func() {
+			var err error
+			_, err = dnsRecordsService.NewARecordData("2001:db8::1")
+			Expect(err).To(MatchError(`A record: "2001:db8::1" is not an IPv4 address`))
+			_, err = dnsRecordsService.NewAaaaRecordData("10.0.0.1")
+			Expect(err).ToNot(BeNil())
+			_, err = dnsRecordsService.NewCnameRecordData("bad name.example.com")
+			Expect(err).To(MatchError(`CNAME record: target "bad name.example.com" is not a valid host name`))
+			_, err = dnsRecordsService.NewMxRecordData("mail.example.com", 70000)
+			Expect(err).To(MatchError("MX record: priority 70000 is not between 0 and 65535"))
+			_, err = dnsRecordsService.NewNsRecordData("")
+			Expect(err).ToNot(BeNil())
+			_, err = dnsRecordsService.NewSpfRecordData("include:_spf.example.com")
+			Expect(err).ToNot(BeNil())
+			_, err = dnsRecordsService.NewTxtRecordData("")
+			Expect(err).ToNot(BeNil())
+			_, err = dnsRecordsService.NewCaaRecordData(0, "issuer", "letsencrypt.org")
+			Expect(err).To(MatchError(`CAA record: tag "issuer" is not one of issue, issuewild and iodef`))
+			_, err = dnsRecordsService.NewSrvRecordData("sip", "_udp", "example.com", 1, 5, 5060, "sip.example.com")
+			Expect(err).ToNot(BeNil())
+			_, err = dnsRecordsService.NewSrvRecordData("_sip", "_sctp", "example.com", 1, 5, 5060, "sip.example.com")
+			Expect(err).ToNot(BeNil())
+			_, err = dnsRecordsService.NewLocRecordData(91, 0, 0, "N", 0, 0, 0, "E", 0)
+			Expect(err).To(MatchError("LOC record: lat_degrees 91 is not between 0 and 90"))
+			_, err = dnsRecordsService.NewLocRecordData(1, 0, 0, "E", 0, 0, 0, "E", 0)
+			Expect(err).ToNot(BeNil())
+
+			_, err = dnsRecordsService.NewCreateDnsRecordOptionsForRecordData("www", &dnsrecordsv1.ARecordData{IP: "300.0.0.1"})
+			Expect(err).ToNot(BeNil())
+			_, err = dnsRecordsService.NewCreateDnsRecordOptionsForRecordData("www", nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`GetRecordData()`, func() {
+		It(`Decodes the records returned by the service`, func() {
+			details := &dnsrecordsv1.DnsrecordDetails{
+				Type:    core.StringPtr("SRV"),
+				Content: core.StringPtr("5 5060 sip.example.com"),
+				Data: map[string]interface{}{
+					"service": "_sip", "proto": "_tcp", "name": "example.com", "priority": float64(1), "weight": float64(5), "port": float64(5060), "target": "sip.example.com",
+				},
+			}
+			recordData, err := details.GetRecordData()
+			Expect(err).To(BeNil())
+			Expect(recordData).To(Equal(&dnsrecordsv1.SrvRecordData{
+				Service: "_sip", Proto: "_tcp", Name: "example.com", Priority: 1, Weight: 5, Port: 5060, Target: "sip.example.com",
+			}))
+
+			details = &dnsrecordsv1.DnsrecordDetails{Type: core.StringPtr("CAA"), Data: map[string]interface{}{"flags": "x", "tag": "issue", "value": "ca"}}
+			_, err = details.GetRecordData()
+			Expect(err).To(MatchError("CAA record: flags x has an unexpected type string"))
+
+			details = &dnsrecordsv1.DnsrecordDetails{Type: core.StringPtr("PTR"), Content: core.StringPtr("www.example.com")}
+			_, err = details.GetRecordData()
+			Expect(err).To(MatchError(`unsupported record type "PTR"`))
+		})
+	})
+})
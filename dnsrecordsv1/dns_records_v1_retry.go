@@ -0,0 +1,117 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// CreateDnsRecordWithRetry creates a DNS record like CreateDnsRecordWithContext, retrying according to
+// policy (a default policy if nil). Because creating a record is not idempotent, before each retry, and
+// when the service reports a conflict, the records of the zone are searched for one with the same type,
+// name and content (or data); if an earlier attempt created it, that record is returned instead of
+// creating a duplicate. The number of retries is reported by common.GetRetryCount.
+func (dnsRecords *DnsRecordsV1) CreateDnsRecordWithRetry(ctx context.Context, createDnsRecordOptions *CreateDnsRecordOptions, policy *common.RetryPolicy) (result *DnsrecordResp, response *core.DetailedResponse, err error) {
+	created, response, err := common.RetryCreate(ctx, policy,
+		func(ctx context.Context) (interface{}, *core.DetailedResponse, error) {
+			return dnsRecords.CreateDnsRecordWithContext(ctx, createDnsRecordOptions)
+		},
+		func(ctx context.Context) (interface{}, *core.DetailedResponse, error) {
+			return dnsRecords.findDnsRecord(ctx, createDnsRecordOptions)
+		})
+	if record, ok := created.(*DnsrecordResp); ok && record != nil {
+		result = record
+	}
+	return
+}
+
+// findDnsRecord returns the record described by createDnsRecordOptions, or nil if it does not exist.
+func (dnsRecords *DnsRecordsV1) findDnsRecord(ctx context.Context, createDnsRecordOptions *CreateDnsRecordOptions) (result *DnsrecordResp, response *core.DetailedResponse, err error) {
+	if createDnsRecordOptions == nil || createDnsRecordOptions.Type == nil {
+		return
+	}
+
+	var data map[string]interface{}
+	if createDnsRecordOptions.Data != nil {
+		var buf []byte
+		buf, err = json.Marshal(createDnsRecordOptions.Data)
+		if err != nil {
+			return
+		}
+		err = json.Unmarshal(buf, &data)
+		if err != nil {
+			return
+		}
+	}
+
+	listAllDnsRecordsOptions := dnsRecords.NewListAllDnsRecordsOptions()
+	listAllDnsRecordsOptions.Type = createDnsRecordOptions.Type
+	listAllDnsRecordsOptions.Content = createDnsRecordOptions.Content
+	pager, err := dnsRecords.NewDnsRecordsPager(listAllDnsRecordsOptions)
+	if err != nil {
+		return
+	}
+	records, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	for _, record := range records {
+		if !recordNameMatches(core.StringNilMapper(createDnsRecordOptions.Name), core.StringNilMapper(record.Name), core.StringNilMapper(record.ZoneName)) {
+			continue
+		}
+		if createDnsRecordOptions.Content != nil && !strings.EqualFold(*createDnsRecordOptions.Content, core.StringNilMapper(record.Content)) {
+			continue
+		}
+		if createDnsRecordOptions.Priority != nil && (record.Priority == nil || *record.Priority != *createDnsRecordOptions.Priority) {
+			continue
+		}
+		if !dataMatches(data, record.Data) {
+			continue
+		}
+		return dnsRecords.GetDnsRecordWithContext(ctx, dnsRecords.NewGetDnsRecordOptions(*record.ID))
+	}
+	return
+}
+
+// recordNameMatches returns true if the name of a record, as reported by the service, is the
+// possibly relative name with which it was created.
+func recordNameMatches(name string, recordName string, zoneName string) bool {
+	name = strings.TrimSuffix(name, ".")
+	recordName = strings.TrimSuffix(recordName, ".")
+	if name == "@" {
+		name = zoneName
+	}
+	return strings.EqualFold(recordName, name) || strings.EqualFold(recordName, name+"."+zoneName)
+}
+
+// dataMatches returns true if every field of the requested data has the same value in the data of a record.
+func dataMatches(requested map[string]interface{}, actual interface{}) bool {
+	actualMap, _ := actual.(map[string]interface{})
+	for key, value := range requested {
+		actualValue, ok := actualMap[key]
+		if !ok || !strings.EqualFold(fmt.Sprint(value), fmt.Sprint(actualValue)) {
+			return false
+		}
+	}
+	return true
+}
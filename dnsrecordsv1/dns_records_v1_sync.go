@@ -0,0 +1,632 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordsv1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// AutomaticTTL is the TTL value with which CIS lets the TTL of a record be chosen automatically. It is
+// the TTL of every proxied record.
+const AutomaticTTL = 1
+
+// SyncDnsRecord : A record of the desired state of a CIS zone.
+type SyncDnsRecord struct {
+	// Name of the record, fully qualified or relative to the zone ("@" for the zone itself). The name of
+	// an SRV record may be omitted, in which case it is built from the service, proto and name of its data.
+	Name string
+
+	// Type of the record (one of the CreateDnsRecordOptions_Type_* constants).
+	Type string
+
+	// Time to live in second, AutomaticTTL, or zero to leave the TTL of a new record to the service, and
+	// the TTL of an existing record unchanged. The TTL of a proxied record is always automatic.
+	TTL int64
+
+	// Content of the record, for the types other than LOC, SRV and CAA.
+	Content string
+
+	// Priority of an MX record.
+	Priority *int64
+
+	// Whether the traffic to the record is proxied through CIS; only A, AAAA and CNAME records can be
+	// proxied, the other ones are DNS-only.
+	Proxied bool
+
+	// Data of a LOC, SRV or CAA record, with the fields returned by the service (e.g. "service", "proto",
+	// "name", "priority", "weight", "port" and "target" for an SRV record).
+	Data map[string]interface{}
+}
+
+// SyncDnsRecordsOptions : The PlanDnsRecordSync options.
+type SyncDnsRecordsOptions struct {
+	// The desired records of the zone.
+	Records []SyncDnsRecord `json:"records"`
+
+	// The name of the zone, used to qualify relative record names. If not set, it is taken from the
+	// records of the zone.
+	ZoneName *string `json:"zone_name,omitempty"`
+
+	// The types of the records that are never created, updated or deleted.
+	ProtectedTypes []string `json:"protected_types,omitempty"`
+
+	// The maximum number of records that applying the plan may delete (common.DefaultSyncMaxDeletions if nil).
+	MaxDeletions *int64 `json:"max_deletions,omitempty"`
+}
+
+// NewSyncDnsRecordsOptions : Instantiate SyncDnsRecordsOptions
+func (*DnsRecordsV1) NewSyncDnsRecordsOptions(records []SyncDnsRecord) *SyncDnsRecordsOptions {
+	return &SyncDnsRecordsOptions{
+		Records: records,
+	}
+}
+
+// SetZoneName : Allow user to set ZoneName
+func (options *SyncDnsRecordsOptions) SetZoneName(zoneName string) *SyncDnsRecordsOptions {
+	options.ZoneName = core.StringPtr(zoneName)
+	return options
+}
+
+// SetProtectedTypes : Allow user to set ProtectedTypes
+func (options *SyncDnsRecordsOptions) SetProtectedTypes(protectedTypes []string) *SyncDnsRecordsOptions {
+	options.ProtectedTypes = protectedTypes
+	return options
+}
+
+// SetMaxDeletions : Allow user to set MaxDeletions
+func (options *SyncDnsRecordsOptions) SetMaxDeletions(maxDeletions int64) *SyncDnsRecordsOptions {
+	options.MaxDeletions = core.Int64Ptr(maxDeletions)
+	return options
+}
+
+// DnsRecordChange : A change of a DnsRecordSyncPlan.
+type DnsRecordChange struct {
+	// The action of the change (one of the common.SyncAction* constants).
+	Action string
+
+	// The desired record, for a create or an update.
+	Desired *SyncDnsRecord
+
+	// The record of the zone, for an update or a delete.
+	Current *DnsrecordDetails
+
+	// The record returned by the service once a create or an update is applied.
+	Result *DnsrecordDetails
+
+	// Whether the change was applied, and the error if it failed.
+	Applied bool
+	Err     error
+
+	desired *syncDnsRecord
+	current *syncDnsRecord
+}
+
+// String returns the change as a line of a diff: the record to create prefixed with "+", the record to
+// delete prefixed with "-", or the record to update prefixed with "~" followed by the changed fields.
+func (change *DnsRecordChange) String() string {
+	switch change.Action {
+	case common.SyncActionCreate:
+		return "+ " + change.desired.String()
+	case common.SyncActionUpdate:
+		var diffs []string
+		if change.current.value() != change.desired.value() {
+			diffs = append(diffs, fmt.Sprintf("%s -> %s", change.current.value(), change.desired.value()))
+		}
+		if change.desired.ttl != 0 && change.current.ttl != change.desired.ttl {
+			diffs = append(diffs, fmt.Sprintf("ttl %s -> %s", ttlString(change.current.ttl), ttlString(change.desired.ttl)))
+		}
+		if change.current.proxied != change.desired.proxied {
+			diffs = append(diffs, fmt.Sprintf("%s -> %s", proxyString(change.current.proxied), proxyString(change.desired.proxied)))
+		}
+		return fmt.Sprintf("~ %s. %s (%s)", change.current.name, change.current.recordType, strings.Join(diffs, ", "))
+	default:
+		return "- " + change.current.String()
+	}
+}
+
+// DnsRecordSyncPlan : The changes that bring the records of a CIS zone to their desired state.
+type DnsRecordSyncPlan struct {
+	// The name of the zone.
+	ZoneName string
+
+	// The changes, sorted by record name and type.
+	Changes []*DnsRecordChange
+
+	// The number of desired records that are already present.
+	Unchanged int
+
+	// The records of the zone that are left alone because their type is protected.
+	Ignored []DnsrecordDetails
+
+	// The maximum number of records that applying the plan may delete.
+	MaxDeletions int64
+}
+
+// IsEmpty returns true if the zone is already in its desired state.
+func (plan *DnsRecordSyncPlan) IsEmpty() bool {
+	return len(plan.Changes) == 0
+}
+
+// Count returns the number of changes with the given action.
+func (plan *DnsRecordSyncPlan) Count(action string) (count int) {
+	for _, change := range plan.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return
+}
+
+// String returns the plan as a human-readable diff: a summary line followed by one line per change.
+func (plan *DnsRecordSyncPlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Zone %s: %d to create, %d to update, %d to delete, %d unchanged, %d ignored\n",
+		plan.ZoneName, plan.Count(common.SyncActionCreate), plan.Count(common.SyncActionUpdate),
+		plan.Count(common.SyncActionDelete), plan.Unchanged, len(plan.Ignored))
+	for _, change := range plan.Changes {
+		b.WriteString(change.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// PlanDnsRecordSync computes the changes that bring the records of the zone to the desired records.
+// Records are identified by their name, type, content, priority and data: a desired record that is
+// present is left alone, or updated if its TTL or proxy status differs; the remaining desired records
+// update the remaining records of the same name and type, are created, or cause them to be deleted.
+// The plan can be printed as a diff, and applied with ApplyDnsRecordSyncPlan.
+func (dnsRecords *DnsRecordsV1) PlanDnsRecordSync(ctx context.Context, syncDnsRecordsOptions *SyncDnsRecordsOptions) (plan *DnsRecordSyncPlan, err error) {
+	err = core.ValidateNotNil(syncDnsRecordsOptions, "syncDnsRecordsOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	protected := map[string]bool{}
+	for _, recordType := range syncDnsRecordsOptions.ProtectedTypes {
+		protected[strings.ToUpper(recordType)] = true
+	}
+
+	pager, err := dnsRecords.NewDnsRecordsPager(dnsRecords.NewListAllDnsRecordsOptions())
+	if err != nil {
+		return
+	}
+	records, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+
+	plan = &DnsRecordSyncPlan{
+		ZoneName:     strings.ToLower(strings.TrimSuffix(core.StringNilMapper(syncDnsRecordsOptions.ZoneName), ".")),
+		MaxDeletions: common.DefaultSyncMaxDeletions,
+	}
+	if syncDnsRecordsOptions.MaxDeletions != nil {
+		plan.MaxDeletions = *syncDnsRecordsOptions.MaxDeletions
+	}
+	for i := 0; plan.ZoneName == "" && i < len(records); i++ {
+		plan.ZoneName = strings.ToLower(core.StringNilMapper(records[i].ZoneName))
+	}
+
+	// Normalize the desired records.
+	var desired []*syncDnsRecord
+	desiredKeys := map[string]bool{}
+	for i := range syncDnsRecordsOptions.Records {
+		source := &syncDnsRecordsOptions.Records[i]
+		if source.Type == "" {
+			err = fmt.Errorf("desired record %d: type is required", i)
+			return
+		}
+		if protected[strings.ToUpper(source.Type)] {
+			err = fmt.Errorf("desired record %s %s: the %s type is protected", source.Name, source.Type, strings.ToUpper(source.Type))
+			return
+		}
+		var record *syncDnsRecord
+		record, err = newDesiredSyncDnsRecord(source, plan.ZoneName)
+		if err != nil {
+			return
+		}
+		if desiredKeys[record.key] {
+			err = fmt.Errorf("desired record %s is duplicated", record)
+			return
+		}
+		desiredKeys[record.key] = true
+		desired = append(desired, record)
+	}
+
+	var current []*syncDnsRecord
+	for i := range records {
+		if protected[strings.ToUpper(core.StringNilMapper(records[i].Type))] {
+			plan.Ignored = append(plan.Ignored, records[i])
+			continue
+		}
+		current = append(current, newCurrentSyncDnsRecord(&records[i]))
+	}
+
+	// Leave alone the desired records that are present, updating their TTL and proxy status if needed.
+	currentByKey := map[string][]*syncDnsRecord{}
+	for _, record := range current {
+		currentByKey[record.key] = append(currentByKey[record.key], record)
+	}
+	var remaining []*syncDnsRecord
+	for _, record := range desired {
+		matches := currentByKey[record.key]
+		if len(matches) == 0 {
+			remaining = append(remaining, record)
+			continue
+		}
+		match := matches[0]
+		currentByKey[record.key] = matches[1:]
+		match.matched = true
+		if record.proxied != match.proxied || (record.ttl != 0 && record.ttl != match.ttl) {
+			plan.addChange(common.SyncActionUpdate, record, match)
+		} else {
+			plan.Unchanged++
+		}
+	}
+
+	// Pair the remaining desired records with the remaining records of the same name and type.
+	currentByName := map[string][]*syncDnsRecord{}
+	for _, record := range current {
+		if !record.matched {
+			nameKey := record.name + " " + record.recordType
+			currentByName[nameKey] = append(currentByName[nameKey], record)
+		}
+	}
+	for _, record := range remaining {
+		nameKey := record.name + " " + record.recordType
+		if candidates := currentByName[nameKey]; len(candidates) > 0 {
+			currentByName[nameKey] = candidates[1:]
+			plan.addChange(common.SyncActionUpdate, record, candidates[0])
+		} else {
+			plan.addChange(common.SyncActionCreate, record, nil)
+		}
+	}
+	for _, candidates := range currentByName {
+		for _, record := range candidates {
+			plan.addChange(common.SyncActionDelete, nil, record)
+		}
+	}
+
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		a, b := plan.Changes[i].record(), plan.Changes[j].record()
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		if a.recordType != b.recordType {
+			return a.recordType < b.recordType
+		}
+		return a.key < b.key
+	})
+	return
+}
+
+// ApplyDnsRecordSyncPlan applies the changes of a plan computed by PlanDnsRecordSync, with at most
+// concurrency changes in progress at the same time (common.DefaultSyncConcurrency if less than one).
+// Nothing is applied if the plan deletes more records than its MaxDeletions, in which case the error
+// wraps common.ErrSyncMaxDeletions. Deletes are applied first, then updates, then creates; if a change
+// fails, the changes of the later steps are not attempted. Because CreateDnsRecord cannot set the proxy
+// status, a proxied record is created DNS-only, then updated. The outcome of each change is recorded
+// in the plan, and a *common.SyncError is returned if any change failed.
+func (dnsRecords *DnsRecordsV1) ApplyDnsRecordSyncPlan(ctx context.Context, plan *DnsRecordSyncPlan, concurrency int) error {
+	err := core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		return err
+	}
+	if deletions := plan.Count(common.SyncActionDelete); int64(deletions) > plan.MaxDeletions {
+		return fmt.Errorf("%w: %d records would be deleted, the maximum is %d", common.ErrSyncMaxDeletions, deletions, plan.MaxDeletions)
+	}
+
+	syncErr := &common.SyncError{}
+	for _, action := range []string{common.SyncActionDelete, common.SyncActionUpdate, common.SyncActionCreate} {
+		var changes []*DnsRecordChange
+		for _, change := range plan.Changes {
+			if change.Action == action && !change.Applied {
+				changes = append(changes, change)
+			}
+		}
+		if len(syncErr.Errors) > 0 {
+			syncErr.Skipped += len(changes)
+			continue
+		}
+		errs := common.RunConcurrently(ctx, concurrency, len(changes), func(ctx context.Context, index int) error {
+			return dnsRecords.applyDnsRecordChange(ctx, changes[index])
+		})
+		for i, changeErr := range errs {
+			if changeErr != nil {
+				changes[i].Err = changeErr
+				syncErr.Errors = append(syncErr.Errors, fmt.Errorf("%s: %w", changes[i], changeErr))
+			} else {
+				changes[i].Applied = true
+				syncErr.Applied++
+			}
+		}
+	}
+	if len(syncErr.Errors) > 0 {
+		return syncErr
+	}
+	return nil
+}
+
+// applyDnsRecordChange applies one change of a plan.
+func (dnsRecords *DnsRecordsV1) applyDnsRecordChange(ctx context.Context, change *DnsRecordChange) (err error) {
+	var result *DnsrecordResp
+	switch change.Action {
+	case common.SyncActionCreate:
+		desired := change.Desired
+		options := dnsRecords.NewCreateDnsRecordOptions()
+		options.SetName(change.desired.name)
+		options.SetType(change.desired.recordType)
+		if change.desired.ttl != 0 {
+			options.SetTTL(change.desired.ttl)
+		}
+		if desired.Content != "" {
+			options.SetContent(desired.Content)
+		}
+		if desired.Priority != nil {
+			options.SetPriority(*desired.Priority)
+		}
+		if desired.Data != nil {
+			options.SetData(desired.Data)
+		}
+		result, _, err = dnsRecords.CreateDnsRecordWithContext(ctx, options)
+		if err != nil || result == nil || result.Result == nil {
+			return
+		}
+		change.Result = result.Result
+		if desired.Proxied {
+			result, _, err = dnsRecords.UpdateDnsRecordWithContext(ctx, newUpdateDnsRecordOptions(change.desired, result.Result))
+		}
+	case common.SyncActionUpdate:
+		result, _, err = dnsRecords.UpdateDnsRecordWithContext(ctx, newUpdateDnsRecordOptions(change.desired, change.Current))
+	case common.SyncActionDelete:
+		_, _, err = dnsRecords.DeleteDnsRecordWithContext(ctx, dnsRecords.NewDeleteDnsRecordOptions(core.StringNilMapper(change.Current.ID)))
+		if common.IsNotFound(err) {
+			err = nil
+		}
+	default:
+		err = fmt.Errorf("unknown sync action %q", change.Action)
+	}
+	if err == nil && result != nil && result.Result != nil {
+		change.Result = result.Result
+	}
+	return
+}
+
+// newUpdateDnsRecordOptions returns the options to replace a record of the zone with a desired record.
+func newUpdateDnsRecordOptions(desired *syncDnsRecord, current *DnsrecordDetails) *UpdateDnsRecordOptions {
+	options := &UpdateDnsRecordOptions{
+		DnsrecordIdentifier: current.ID,
+		Name:                core.StringPtr(desired.name),
+		Type:                core.StringPtr(desired.recordType),
+		TTL:                 current.TTL,
+		Proxied:             core.BoolPtr(desired.proxied),
+		Priority:            desired.desired.Priority,
+	}
+	if desired.ttl != 0 {
+		options.TTL = core.Int64Ptr(desired.ttl)
+	}
+	if desired.desired.Content != "" {
+		options.Content = core.StringPtr(desired.desired.Content)
+	}
+	if desired.desired.Data != nil {
+		options.Data = desired.desired.Data
+	}
+	return options
+}
+
+// addChange adds a change to the plan.
+func (plan *DnsRecordSyncPlan) addChange(action string, desired *syncDnsRecord, current *syncDnsRecord) {
+	change := &DnsRecordChange{
+		Action:  action,
+		desired: desired,
+		current: current,
+	}
+	if desired != nil {
+		change.Desired = desired.desired
+	}
+	if current != nil {
+		change.Current = current.source
+	}
+	plan.Changes = append(plan.Changes, change)
+}
+
+// record returns the record the change is about.
+func (change *DnsRecordChange) record() *syncDnsRecord {
+	if change.desired != nil {
+		return change.desired
+	}
+	return change.current
+}
+
+// syncDnsRecord is the normalized form of a desired record or of a record of the zone, used to compare them.
+type syncDnsRecord struct {
+	// The fully qualified name in lowercase, without trailing dot.
+	name       string
+	recordType string
+	ttl        int64
+	content    string
+	priority   string
+	data       string
+	proxied    bool
+	key        string
+
+	desired *SyncDnsRecord
+	source  *DnsrecordDetails
+	matched bool
+}
+
+// String returns the record as a line of a zone file, followed by its proxy status if it can be proxied.
+func (record *syncDnsRecord) String() string {
+	ttl := ""
+	if record.ttl != 0 {
+		ttl = ttlString(record.ttl) + " "
+	}
+	line := fmt.Sprintf("%s. %sIN %s %s", record.name, ttl, record.recordType, record.value())
+	if isProxiable(record.recordType) {
+		line += " (" + proxyString(record.proxied) + ")"
+	}
+	return line
+}
+
+// value returns the priority, content and data of the record.
+func (record *syncDnsRecord) value() string {
+	var fields []string
+	for _, field := range []string{record.priority, record.content, record.data} {
+		if field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return strings.Join(fields, " ")
+}
+
+func newDesiredSyncDnsRecord(desired *SyncDnsRecord, zoneName string) (record *syncDnsRecord, err error) {
+	recordType := strings.ToUpper(desired.Type)
+	if desired.Proxied && !isProxiable(recordType) {
+		err = fmt.Errorf("desired record %s %s: only A, AAAA and CNAME records can be proxied", desired.Name, recordType)
+		return
+	}
+
+	name := strings.TrimSuffix(desired.Name, ".")
+	if name == "" && recordType == CreateDnsRecordOptions_Type_Srv && desired.Data != nil {
+		name = strings.TrimSuffix(fmt.Sprintf("%v.%v.%v", desired.Data["service"], desired.Data["proto"], desired.Data["name"]), ".")
+	}
+	if name == "" {
+		err = fmt.Errorf("desired %s record: name is required", recordType)
+		return
+	}
+	switch {
+	case zoneName == "":
+		if name == "@" || !strings.Contains(name, ".") {
+			err = fmt.Errorf("desired record %s %s: the zone name is required to qualify relative names", name, recordType)
+			return
+		}
+	case name == "@":
+		name = zoneName
+	case !strings.EqualFold(name, zoneName) && !strings.HasSuffix(strings.ToLower(name), "."+zoneName):
+		name = name + "." + zoneName
+	}
+
+	ttl := desired.TTL
+	if desired.Proxied {
+		ttl = AutomaticTTL
+	}
+	record = newSyncDnsRecord(name, recordType, ttl, desired.Content, desired.Priority, desired.Data, desired.Proxied)
+	record.desired = desired
+	return
+}
+
+func newCurrentSyncDnsRecord(current *DnsrecordDetails) *syncDnsRecord {
+	recordType := strings.ToUpper(core.StringNilMapper(current.Type))
+	data, _ := current.Data.(map[string]interface{})
+	ttl := int64(0)
+	if current.TTL != nil {
+		ttl = *current.TTL
+	}
+	record := newSyncDnsRecord(strings.TrimSuffix(core.StringNilMapper(current.Name), "."), recordType, ttl,
+		core.StringNilMapper(current.Content), current.Priority, data, current.Proxied != nil && *current.Proxied)
+	record.source = current
+	return record
+}
+
+func newSyncDnsRecord(name string, recordType string, ttl int64, content string, priority *int64, data map[string]interface{}, proxied bool) *syncDnsRecord {
+	record := &syncDnsRecord{
+		name:       strings.ToLower(name),
+		recordType: recordType,
+		ttl:        ttl,
+		proxied:    proxied,
+	}
+	if data != nil {
+		// The content of a record with data is computed by the service from its data.
+		record.data = canonicalData(data)
+	} else {
+		record.content = canonicalContent(recordType, content)
+	}
+	if priority != nil && recordType == CreateDnsRecordOptions_Type_Mx {
+		record.priority = strconv.FormatInt(*priority, 10)
+	}
+	record.key = strings.Join([]string{record.name, record.recordType, record.priority, record.content, record.data}, " ")
+	return record
+}
+
+// canonicalContent returns the form of the content of a record used to compare records: IP addresses
+// in their canonical form, and names in lowercase without trailing dot.
+func canonicalContent(recordType string, content string) string {
+	switch recordType {
+	case CreateDnsRecordOptions_Type_A, CreateDnsRecordOptions_Type_Aaaa:
+		if ip := net.ParseIP(content); ip != nil {
+			return ip.String()
+		}
+	case CreateDnsRecordOptions_Type_Cname, CreateDnsRecordOptions_Type_Mx, CreateDnsRecordOptions_Type_Ns:
+		return strings.ToLower(strings.TrimSuffix(content, "."))
+	case CreateDnsRecordOptions_Type_Txt, CreateDnsRecordOptions_Type_Spf:
+		return strconv.Quote(content)
+	}
+	return content
+}
+
+// canonicalData returns the form of the data of a record used to compare records: its fields sorted by
+// name, with JSON numbers formatted as integers when possible.
+func canonicalData(data map[string]interface{}) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		value := data[key]
+		switch number := value.(type) {
+		case float64:
+			if number == float64(int64(number)) {
+				value = int64(number)
+			}
+		case int:
+			value = int64(number)
+		}
+		text := fmt.Sprint(value)
+		if _, isString := value.(string); isString {
+			text = strconv.Quote(text)
+		}
+		keys[i] = key + "=" + text
+	}
+	return strings.Join(keys, " ")
+}
+
+// isProxiable returns true if records of the given type can be proxied.
+func isProxiable(recordType string) bool {
+	return recordType == CreateDnsRecordOptions_Type_A || recordType == CreateDnsRecordOptions_Type_Aaaa ||
+		recordType == CreateDnsRecordOptions_Type_Cname
+}
+
+func ttlString(ttl int64) string {
+	if ttl == AutomaticTTL {
+		return "auto"
+	}
+	return strconv.FormatInt(ttl, 10)
+}
+
+func proxyString(proxied bool) string {
+	if proxied {
+		return "proxied"
+	}
+	return "dns-only"
+}
@@ -0,0 +1,162 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnsrecordsv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DnsRecordsV1 record sync`, func() {
+	var testServer *httptest.Server
+	var dnsRecordsService *dnsrecordsv1.DnsRecordsV1
+	var requests []string
+	var requestBodies []map[string]interface{}
+	var lock sync.Mutex
+	recordsPath := "/v1/crn-1/zones/zone-1/dns_records"
+	existingRecords := `[
+		{"id": "r-www", "name": "www.example.com", "type": "A", "content": "1.2.3.4", "proxied": true, "proxiable": true, "ttl": 1, "zone_name": "example.com"},
+		{"id": "r-api", "name": "api.example.com", "type": "CNAME", "content": "www.example.com", "proxied": false, "proxiable": true, "ttl": 300, "zone_name": "example.com"},
+		{"id": "r-mx", "name": "example.com", "type": "MX", "content": "mail.example.com", "priority": 10, "ttl": 300, "zone_name": "example.com"},
+		{"id": "r-srv", "name": "_sip._udp.example.com", "type": "SRV", "content": "5 5060 sip.example.com", "ttl": 300, "zone_name": "example.com",
+			"data": {"service": "_sip", "proto": "_udp", "name": "example.com", "priority": 1, "weight": 5, "port": 5060, "target": "sip.example.com"}},
+		{"id": "r-old", "name": "old.example.com", "type": "A", "content": "1.2.3.9", "proxied": false, "ttl": 300, "zone_name": "example.com"},
+		{"id": "r-ns", "name": "sub.example.com", "type": "NS", "content": "ns1.example.net", "ttl": 300, "zone_name": "example.com"}
+	]`
+
+	BeforeEach(func() {
+		requests = nil
+		requestBodies = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == "GET" && req.URL.EscapedPath() == recordsPath {
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"success": true, "errors": [], "messages": [], "result": %s, "result_info": {"page": 1, "per_page": 20, "count": 6, "total_count": 6}}`, existingRecords)
+				return
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			requests = append(requests, req.Method+" "+req.URL.EscapedPath())
+			var body map[string]interface{}
+			buf, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(buf, &body)
+			requestBodies = append(requestBodies, body)
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `{"success": true, "errors": [], "messages": [], "result": {"id": "r-new", "name": "new.example.com", "type": "A", "ttl": 300}}`)
+		}))
+		var serviceErr error
+		dnsRecordsService, serviceErr = dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+			URL:            testServer.URL,
+			Authenticator:  &core.NoAuthAuthenticator{},
+			Crn:            core.StringPtr("crn-1"),
+			ZoneIdentifier: core.StringPtr("zone-1"),
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	desiredRecords := func() []dnsrecordsv1.SyncDnsRecord {
+		return []dnsrecordsv1.SyncDnsRecord{
+			{Name: "www", Type: "A", Content: "1.2.3.4", Proxied: true},
+			{Name: "api.example.com", Type: "CNAME", Content: "www.example.com.", Proxied: true},
+			{Name: "@", Type: "MX", Content: "mail.example.com", Priority: core.Int64Ptr(20), TTL: 300},
+			{Type: "SRV", TTL: 300, Data: map[string]interface{}{
+				"service": "_sip", "proto": "_udp", "name": "example.com", "priority": 1, "weight": 5, "port": 5060, "target": "sip.example.com",
+			}},
+			{Name: "shop", Type: "A", Content: "1.2.3.5", Proxied: true},
+		}
+	}
+
+	Describe(`PlanDnsRecordSync(syncDnsRecordsOptions *SyncDnsRecordsOptions)`, func() {
+		It(`Plans creates, updates and deletes as a diff`, func() {
+			options := dnsRecordsService.NewSyncDnsRecordsOptions(desiredRecords()).SetProtectedTypes([]string{"NS"})
+			plan, err := dnsRecordsService.PlanDnsRecordSync(context.Background(), options)
+			Expect(err).To(BeNil())
+			Expect(plan.String()).To(Equal(`Zone example.com: 1 to create, 2 to update, 1 to delete, 2 unchanged, 1 ignored
+~ api.example.com. CNAME (ttl 300 -> auto, dns-only -> proxied)
+~ example.com. MX (10 mail.example.com -> 20 mail.example.com)
+- old.example.com. 300 IN A 1.2.3.9 (dns-only)
++ shop.example.com. auto IN A 1.2.3.5 (proxied)
+`))
+			Expect(plan.MaxDeletions).To(Equal(int64(common.DefaultSyncMaxDeletions)))
+			Expect(requests).To(BeEmpty())
+		})
+		It(`Rejects invalid desired records`, func() {
+			records := []dnsrecordsv1.SyncDnsRecord{{Name: "@", Type: "MX", Content: "mail.example.com", Proxied: true}}
+			_, err := dnsRecordsService.PlanDnsRecordSync(context.Background(), dnsRecordsService.NewSyncDnsRecordsOptions(records))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("only A, AAAA and CNAME records can be proxied"))
+
+			records = []dnsrecordsv1.SyncDnsRecord{{Name: "sub", Type: "NS", Content: "ns1.example.net"}}
+			_, err = dnsRecordsService.PlanDnsRecordSync(context.Background(), dnsRecordsService.NewSyncDnsRecordsOptions(records).SetProtectedTypes([]string{"ns"}))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("the NS type is protected"))
+		})
+	})
+
+	Describe(`ApplyDnsRecordSyncPlan(plan *DnsRecordSyncPlan, concurrency int)`, func() {
+		It(`Applies the plan, then creates proxied records DNS-only before updating them`, func() {
+			options := dnsRecordsService.NewSyncDnsRecordsOptions(desiredRecords()).SetProtectedTypes([]string{"NS"})
+			plan, err := dnsRecordsService.PlanDnsRecordSync(context.Background(), options)
+			Expect(err).To(BeNil())
+
+			err = dnsRecordsService.ApplyDnsRecordSyncPlan(context.Background(), plan, 1)
+			Expect(err).To(BeNil())
+			Expect(requests).To(Equal([]string{
+				"DELETE " + recordsPath + "/r-old",
+				"PUT " + recordsPath + "/r-api",
+				"PUT " + recordsPath + "/r-mx",
+				"POST " + recordsPath,
+				"PUT " + recordsPath + "/r-new",
+			}))
+			Expect(requestBodies[1]).To(Equal(map[string]interface{}{
+				"name": "api.example.com", "type": "CNAME", "content": "www.example.com.", "ttl": float64(1), "proxied": true,
+			}))
+			Expect(requestBodies[2]["priority"]).To(Equal(float64(20)))
+			Expect(requestBodies[3]).To(Equal(map[string]interface{}{"name": "shop.example.com", "type": "A", "content": "1.2.3.5", "ttl": float64(1)}))
+			Expect(requestBodies[4]["proxied"]).To(BeTrue())
+			Expect(*plan.Changes[3].Result.ID).To(Equal("r-new"))
+		})
+		It(`Refuses a plan that deletes too many records`, func() {
+			options := dnsRecordsService.NewSyncDnsRecordsOptions(nil).SetMaxDeletions(3)
+			plan, err := dnsRecordsService.PlanDnsRecordSync(context.Background(), options)
+			Expect(err).To(BeNil())
+			Expect(plan.Count(common.SyncActionDelete)).To(Equal(6))
+
+			err = dnsRecordsService.ApplyDnsRecordSyncPlan(context.Background(), plan, 0)
+			Expect(errors.Is(err, common.ErrSyncMaxDeletions)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("6 records would be deleted, the maximum is 3"))
+			Expect(requests).To(BeEmpty())
+		})
+	})
+})
@@ -161,6 +161,24 @@ func (dnsSvcs *DnsSvcsV1) DisableRetries() {
 	dnsSvcs.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (dnsSvcs *DnsSvcsV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(dnsSvcs.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (dnsSvcs *DnsSvcsV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(dnsSvcs.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (dnsSvcs *DnsSvcsV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(dnsSvcs.Service, middleware...)
+}
+
 // ListDnszones : List DNS zones
 // List the DNS zones for a given service instance.
 func (dnsSvcs *DnsSvcsV1) ListDnszones(listDnszonesOptions *ListDnszonesOptions) (result *ListDnszones, response *core.DetailedResponse, err error) {
@@ -218,6 +236,7 @@ func (dnsSvcs *DnsSvcsV1) ListDnszonesWithContext(ctx context.Context, listDnszo
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -297,6 +316,7 @@ func (dnsSvcs *DnsSvcsV1) CreateDnszoneWithContext(ctx context.Context, createDn
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -358,6 +378,9 @@ func (dnsSvcs *DnsSvcsV1) DeleteDnszoneWithContext(ctx context.Context, deleteDn
 	}
 
 	response, err = dnsSvcs.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -413,6 +436,7 @@ func (dnsSvcs *DnsSvcsV1) GetDnszoneWithContext(ctx context.Context, getDnszoneO
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -490,6 +514,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateDnszoneWithContext(ctx context.Context, updateDn
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -561,6 +586,7 @@ func (dnsSvcs *DnsSvcsV1) ListResourceRecordsWithContext(ctx context.Context, li
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -650,6 +676,7 @@ func (dnsSvcs *DnsSvcsV1) CreateResourceRecordWithContext(ctx context.Context, c
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -712,6 +739,9 @@ func (dnsSvcs *DnsSvcsV1) DeleteResourceRecordWithContext(ctx context.Context, d
 	}
 
 	response, err = dnsSvcs.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -768,6 +798,7 @@ func (dnsSvcs *DnsSvcsV1) GetResourceRecordWithContext(ctx context.Context, getR
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -855,6 +886,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateResourceRecordWithContext(ctx context.Context, u
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -917,6 +949,9 @@ func (dnsSvcs *DnsSvcsV1) ExportResourceRecordsWithContext(ctx context.Context,
 	}
 
 	response, err = dnsSvcs.Service.Request(request, &result)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -981,6 +1016,7 @@ func (dnsSvcs *DnsSvcsV1) ImportResourceRecordsWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1045,6 +1081,7 @@ func (dnsSvcs *DnsSvcsV1) ListPermittedNetworksWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1122,6 +1159,7 @@ func (dnsSvcs *DnsSvcsV1) CreatePermittedNetworkWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1187,6 +1225,7 @@ func (dnsSvcs *DnsSvcsV1) DeletePermittedNetworkWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1252,6 +1291,7 @@ func (dnsSvcs *DnsSvcsV1) GetPermittedNetworkWithContext(ctx context.Context, ge
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1323,6 +1363,7 @@ func (dnsSvcs *DnsSvcsV1) ListLoadBalancersWithContext(ctx context.Context, list
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1415,6 +1456,7 @@ func (dnsSvcs *DnsSvcsV1) CreateLoadBalancerWithContext(ctx context.Context, cre
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1477,6 +1519,9 @@ func (dnsSvcs *DnsSvcsV1) DeleteLoadBalancerWithContext(ctx context.Context, del
 	}
 
 	response, err = dnsSvcs.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -1533,6 +1578,7 @@ func (dnsSvcs *DnsSvcsV1) GetLoadBalancerWithContext(ctx context.Context, getLoa
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1626,6 +1672,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateLoadBalancerWithContext(ctx context.Context, upd
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1696,6 +1743,7 @@ func (dnsSvcs *DnsSvcsV1) ListPoolsWithContext(ctx context.Context, listPoolsOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1793,6 +1841,7 @@ func (dnsSvcs *DnsSvcsV1) CreatePoolWithContext(ctx context.Context, createPoolO
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1854,6 +1903,9 @@ func (dnsSvcs *DnsSvcsV1) DeletePoolWithContext(ctx context.Context, deletePoolO
 	}
 
 	response, err = dnsSvcs.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -1909,6 +1961,7 @@ func (dnsSvcs *DnsSvcsV1) GetPoolWithContext(ctx context.Context, getPoolOptions
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2007,6 +2060,7 @@ func (dnsSvcs *DnsSvcsV1) UpdatePoolWithContext(ctx context.Context, updatePoolO
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2077,6 +2131,7 @@ func (dnsSvcs *DnsSvcsV1) ListMonitorsWithContext(ctx context.Context, listMonit
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2186,6 +2241,7 @@ func (dnsSvcs *DnsSvcsV1) CreateMonitorWithContext(ctx context.Context, createMo
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2247,6 +2303,9 @@ func (dnsSvcs *DnsSvcsV1) DeleteMonitorWithContext(ctx context.Context, deleteMo
 	}
 
 	response, err = dnsSvcs.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -2302,6 +2361,7 @@ func (dnsSvcs *DnsSvcsV1) GetMonitorWithContext(ctx context.Context, getMonitorO
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2412,6 +2472,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateMonitorWithContext(ctx context.Context, updateMo
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2475,6 +2536,7 @@ func (dnsSvcs *DnsSvcsV1) ListCustomResolversWithContext(ctx context.Context, li
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2554,6 +2616,7 @@ func (dnsSvcs *DnsSvcsV1) CreateCustomResolverWithContext(ctx context.Context, c
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2615,6 +2678,9 @@ func (dnsSvcs *DnsSvcsV1) DeleteCustomResolverWithContext(ctx context.Context, d
 	}
 
 	response, err = dnsSvcs.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -2670,6 +2736,7 @@ func (dnsSvcs *DnsSvcsV1) GetCustomResolverWithContext(ctx context.Context, getC
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2750,6 +2817,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateCustomResolverWithContext(ctx context.Context, u
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2824,6 +2892,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateCrLocationsOrderWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2901,6 +2970,7 @@ func (dnsSvcs *DnsSvcsV1) AddCustomResolverLocationWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2979,6 +3049,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateCustomResolverLocationWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3041,6 +3112,9 @@ func (dnsSvcs *DnsSvcsV1) DeleteCustomResolverLocationWithContext(ctx context.Co
 	}
 
 	response, err = dnsSvcs.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -3096,6 +3170,7 @@ func (dnsSvcs *DnsSvcsV1) ListForwardingRulesWithContext(ctx context.Context, li
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3179,6 +3254,7 @@ func (dnsSvcs *DnsSvcsV1) CreateForwardingRuleWithContext(ctx context.Context, c
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3241,6 +3317,9 @@ func (dnsSvcs *DnsSvcsV1) DeleteForwardingRuleWithContext(ctx context.Context, d
 	}
 
 	response, err = dnsSvcs.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -3297,6 +3376,7 @@ func (dnsSvcs *DnsSvcsV1) GetForwardingRuleWithContext(ctx context.Context, getF
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3378,6 +3458,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateForwardingRuleWithContext(ctx context.Context, u
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3461,6 +3542,7 @@ func (dnsSvcs *DnsSvcsV1) CreateSecondaryZoneWithContext(ctx context.Context, cr
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3532,6 +3614,7 @@ func (dnsSvcs *DnsSvcsV1) ListSecondaryZonesWithContext(ctx context.Context, lis
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3597,6 +3680,7 @@ func (dnsSvcs *DnsSvcsV1) GetSecondaryZoneWithContext(ctx context.Context, getSe
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3678,6 +3762,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateSecondaryZoneWithContext(ctx context.Context, up
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3740,6 +3825,9 @@ func (dnsSvcs *DnsSvcsV1) DeleteSecondaryZoneWithContext(ctx context.Context, de
 	}
 
 	response, err = dnsSvcs.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -3801,6 +3889,7 @@ func (dnsSvcs *DnsSvcsV1) ListLinkedZonesWithContext(ctx context.Context, listLi
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3883,6 +3972,7 @@ func (dnsSvcs *DnsSvcsV1) CreateLinkedZoneWithContext(ctx context.Context, creat
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -3947,6 +4037,7 @@ func (dnsSvcs *DnsSvcsV1) GetLinkedZoneWithContext(ctx context.Context, getLinke
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -4024,6 +4115,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateLinkedZoneWithContext(ctx context.Context, updat
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -4085,6 +4177,9 @@ func (dnsSvcs *DnsSvcsV1) DeleteLinkedZoneWithContext(ctx context.Context, delet
 	}
 
 	response, err = dnsSvcs.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -4147,6 +4242,7 @@ func (dnsSvcs *DnsSvcsV1) ListDnszoneAccessRequestsWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -4212,6 +4308,7 @@ func (dnsSvcs *DnsSvcsV1) GetDnszoneAccessRequestWithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -4287,6 +4384,7 @@ func (dnsSvcs *DnsSvcsV1) UpdateDnszoneAccessRequestWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -4351,6 +4449,7 @@ func (dnsSvcs *DnsSvcsV1) ListLinkedPermittedNetworksWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -4428,6 +4527,7 @@ func (dnsSvcs *DnsSvcsV1) CreateLzPermittedNetworkWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -4493,6 +4593,7 @@ func (dnsSvcs *DnsSvcsV1) DeleteLzPermittedNetworkWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -4558,6 +4659,7 @@ func (dnsSvcs *DnsSvcsV1) GetLinkedPermittedNetworkWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsSvcs.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -11810,3 +11912,1045 @@ func UnmarshalResourceRecordUpdateInputRdataRdataTxtRecord(m map[string]json.Raw
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// nextPageOffset returns the offset of the page that follows a page of "count" items
+// retrieved at "offset", or nil if there are no more pages. The "next" link returned by
+// the service is followed, but an empty page, a link that does not move forward or an
+// offset beyond the total count all end the walk, so that resources removed while
+// paging cannot cause the pager to loop.
+func nextPageOffset(offset *int64, next *int64, count int, totalCount *int64) *int64 {
+	if next == nil || count == 0 {
+		return nil
+	}
+	var current int64
+	if offset != nil {
+		current = *offset
+	}
+	if *next <= current {
+		return nil
+	}
+	if totalCount != nil && *next >= *totalCount {
+		return nil
+	}
+	return next
+}
+
+// DnszonesPager can be used to simplify the use of the "ListDnszones" method.
+type DnszonesPager struct {
+	hasNext     bool
+	options     *ListDnszonesOptions
+	client      *DnsSvcsV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewDnszonesPager returns a new DnszonesPager instance.
+func (dnsSvcs *DnsSvcsV1) NewDnszonesPager(options *ListDnszonesOptions) (pager *DnszonesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy ListDnszonesOptions = *options
+	pager = &DnszonesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *DnszonesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *DnszonesPager) GetNextWithContext(ctx context.Context) (page []Dnszone, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListDnszonesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextOffset()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = nextPageOffset(pager.options.Offset, next, len(result.Dnszones), result.TotalCount)
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Dnszones
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *DnszonesPager) GetAllWithContext(ctx context.Context) (allItems []Dnszone, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []Dnszone
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *DnszonesPager) GetNext() (page []Dnszone, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *DnszonesPager) GetAll() (allItems []Dnszone, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// ResourceRecordsPager can be used to simplify the use of the "ListResourceRecords" method.
+type ResourceRecordsPager struct {
+	hasNext     bool
+	options     *ListResourceRecordsOptions
+	client      *DnsSvcsV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewResourceRecordsPager returns a new ResourceRecordsPager instance.
+func (dnsSvcs *DnsSvcsV1) NewResourceRecordsPager(options *ListResourceRecordsOptions) (pager *ResourceRecordsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy ListResourceRecordsOptions = *options
+	pager = &ResourceRecordsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ResourceRecordsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ResourceRecordsPager) GetNextWithContext(ctx context.Context) (page []ResourceRecord, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListResourceRecordsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextOffset()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = nextPageOffset(pager.options.Offset, next, len(result.ResourceRecords), result.TotalCount)
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.ResourceRecords
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ResourceRecordsPager) GetAllWithContext(ctx context.Context) (allItems []ResourceRecord, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []ResourceRecord
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ResourceRecordsPager) GetNext() (page []ResourceRecord, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ResourceRecordsPager) GetAll() (allItems []ResourceRecord, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// PermittedNetworksPager can be used to simplify the use of the "ListPermittedNetworks" method.
+type PermittedNetworksPager struct {
+	hasNext bool
+	options *ListPermittedNetworksOptions
+	client  *DnsSvcsV1
+}
+
+// NewPermittedNetworksPager returns a new PermittedNetworksPager instance.
+func (dnsSvcs *DnsSvcsV1) NewPermittedNetworksPager(options *ListPermittedNetworksOptions) (pager *PermittedNetworksPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListPermittedNetworksOptions = *options
+	pager = &PermittedNetworksPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *PermittedNetworksPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *PermittedNetworksPager) GetNextWithContext(ctx context.Context) (page []PermittedNetwork, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	result, _, err := pager.client.ListPermittedNetworksWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	// The "ListPermittedNetworks" operation is not paginated, so the first page holds every result.
+	pager.hasNext = false
+	if result != nil {
+		page = result.PermittedNetworks
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *PermittedNetworksPager) GetAllWithContext(ctx context.Context) (allItems []PermittedNetwork, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []PermittedNetwork
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *PermittedNetworksPager) GetNext() (page []PermittedNetwork, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *PermittedNetworksPager) GetAll() (allItems []PermittedNetwork, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// LoadBalancersPager can be used to simplify the use of the "ListLoadBalancers" method.
+type LoadBalancersPager struct {
+	hasNext     bool
+	options     *ListLoadBalancersOptions
+	client      *DnsSvcsV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewLoadBalancersPager returns a new LoadBalancersPager instance.
+func (dnsSvcs *DnsSvcsV1) NewLoadBalancersPager(options *ListLoadBalancersOptions) (pager *LoadBalancersPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy ListLoadBalancersOptions = *options
+	pager = &LoadBalancersPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *LoadBalancersPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *LoadBalancersPager) GetNextWithContext(ctx context.Context) (page []LoadBalancer, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListLoadBalancersWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextOffset()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = nextPageOffset(pager.options.Offset, next, len(result.LoadBalancers), result.TotalCount)
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.LoadBalancers
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *LoadBalancersPager) GetAllWithContext(ctx context.Context) (allItems []LoadBalancer, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []LoadBalancer
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *LoadBalancersPager) GetNext() (page []LoadBalancer, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *LoadBalancersPager) GetAll() (allItems []LoadBalancer, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// PoolsPager can be used to simplify the use of the "ListPools" method.
+type PoolsPager struct {
+	hasNext     bool
+	options     *ListPoolsOptions
+	client      *DnsSvcsV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewPoolsPager returns a new PoolsPager instance.
+func (dnsSvcs *DnsSvcsV1) NewPoolsPager(options *ListPoolsOptions) (pager *PoolsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy ListPoolsOptions = *options
+	pager = &PoolsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *PoolsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *PoolsPager) GetNextWithContext(ctx context.Context) (page []Pool, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListPoolsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextOffset()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = nextPageOffset(pager.options.Offset, next, len(result.Pools), result.TotalCount)
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Pools
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *PoolsPager) GetAllWithContext(ctx context.Context) (allItems []Pool, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []Pool
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *PoolsPager) GetNext() (page []Pool, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *PoolsPager) GetAll() (allItems []Pool, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// MonitorsPager can be used to simplify the use of the "ListMonitors" method.
+type MonitorsPager struct {
+	hasNext     bool
+	options     *ListMonitorsOptions
+	client      *DnsSvcsV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewMonitorsPager returns a new MonitorsPager instance.
+func (dnsSvcs *DnsSvcsV1) NewMonitorsPager(options *ListMonitorsOptions) (pager *MonitorsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy ListMonitorsOptions = *options
+	pager = &MonitorsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *MonitorsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *MonitorsPager) GetNextWithContext(ctx context.Context) (page []Monitor, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListMonitorsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextOffset()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = nextPageOffset(pager.options.Offset, next, len(result.Monitors), result.TotalCount)
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Monitors
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *MonitorsPager) GetAllWithContext(ctx context.Context) (allItems []Monitor, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []Monitor
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *MonitorsPager) GetNext() (page []Monitor, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *MonitorsPager) GetAll() (allItems []Monitor, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// CustomResolversPager can be used to simplify the use of the "ListCustomResolvers" method.
+type CustomResolversPager struct {
+	hasNext bool
+	options *ListCustomResolversOptions
+	client  *DnsSvcsV1
+}
+
+// NewCustomResolversPager returns a new CustomResolversPager instance.
+func (dnsSvcs *DnsSvcsV1) NewCustomResolversPager(options *ListCustomResolversOptions) (pager *CustomResolversPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListCustomResolversOptions = *options
+	pager = &CustomResolversPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *CustomResolversPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *CustomResolversPager) GetNextWithContext(ctx context.Context) (page []CustomResolver, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	result, _, err := pager.client.ListCustomResolversWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	// The "ListCustomResolvers" operation is not paginated, so the first page holds every result.
+	pager.hasNext = false
+	if result != nil {
+		page = result.CustomResolvers
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *CustomResolversPager) GetAllWithContext(ctx context.Context) (allItems []CustomResolver, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []CustomResolver
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *CustomResolversPager) GetNext() (page []CustomResolver, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *CustomResolversPager) GetAll() (allItems []CustomResolver, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// ForwardingRulesPager can be used to simplify the use of the "ListForwardingRules" method.
+type ForwardingRulesPager struct {
+	hasNext bool
+	options *ListForwardingRulesOptions
+	client  *DnsSvcsV1
+}
+
+// NewForwardingRulesPager returns a new ForwardingRulesPager instance.
+func (dnsSvcs *DnsSvcsV1) NewForwardingRulesPager(options *ListForwardingRulesOptions) (pager *ForwardingRulesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListForwardingRulesOptions = *options
+	pager = &ForwardingRulesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ForwardingRulesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ForwardingRulesPager) GetNextWithContext(ctx context.Context) (page []ForwardingRule, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	result, _, err := pager.client.ListForwardingRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	// The "ListForwardingRules" operation is not paginated, so the first page holds every result.
+	pager.hasNext = false
+	if result != nil {
+		page = result.ForwardingRules
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ForwardingRulesPager) GetAllWithContext(ctx context.Context) (allItems []ForwardingRule, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []ForwardingRule
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ForwardingRulesPager) GetNext() (page []ForwardingRule, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ForwardingRulesPager) GetAll() (allItems []ForwardingRule, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// SecondaryZonesPager can be used to simplify the use of the "ListSecondaryZones" method.
+type SecondaryZonesPager struct {
+	hasNext     bool
+	options     *ListSecondaryZonesOptions
+	client      *DnsSvcsV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewSecondaryZonesPager returns a new SecondaryZonesPager instance.
+func (dnsSvcs *DnsSvcsV1) NewSecondaryZonesPager(options *ListSecondaryZonesOptions) (pager *SecondaryZonesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy ListSecondaryZonesOptions = *options
+	pager = &SecondaryZonesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *SecondaryZonesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *SecondaryZonesPager) GetNextWithContext(ctx context.Context) (page []SecondaryZone, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListSecondaryZonesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextOffset()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = nextPageOffset(pager.options.Offset, next, len(result.SecondaryZones), result.TotalCount)
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.SecondaryZones
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *SecondaryZonesPager) GetAllWithContext(ctx context.Context) (allItems []SecondaryZone, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []SecondaryZone
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *SecondaryZonesPager) GetNext() (page []SecondaryZone, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *SecondaryZonesPager) GetAll() (allItems []SecondaryZone, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// LinkedZonesPager can be used to simplify the use of the "ListLinkedZones" method.
+type LinkedZonesPager struct {
+	hasNext     bool
+	options     *ListLinkedZonesOptions
+	client      *DnsSvcsV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewLinkedZonesPager returns a new LinkedZonesPager instance.
+func (dnsSvcs *DnsSvcsV1) NewLinkedZonesPager(options *ListLinkedZonesOptions) (pager *LinkedZonesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy ListLinkedZonesOptions = *options
+	pager = &LinkedZonesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *LinkedZonesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *LinkedZonesPager) GetNextWithContext(ctx context.Context) (page []LinkedDnszone, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListLinkedZonesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextOffset()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = nextPageOffset(pager.options.Offset, next, len(result.LinkedDnszones), result.TotalCount)
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.LinkedDnszones
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *LinkedZonesPager) GetAllWithContext(ctx context.Context) (allItems []LinkedDnszone, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []LinkedDnszone
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *LinkedZonesPager) GetNext() (page []LinkedDnszone, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *LinkedZonesPager) GetAll() (allItems []LinkedDnszone, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// DnszoneAccessRequestsPager can be used to simplify the use of the "ListDnszoneAccessRequests" method.
+type DnszoneAccessRequestsPager struct {
+	hasNext     bool
+	options     *ListDnszoneAccessRequestsOptions
+	client      *DnsSvcsV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewDnszoneAccessRequestsPager returns a new DnszoneAccessRequestsPager instance.
+func (dnsSvcs *DnsSvcsV1) NewDnszoneAccessRequestsPager(options *ListDnszoneAccessRequestsOptions) (pager *DnszoneAccessRequestsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy ListDnszoneAccessRequestsOptions = *options
+	pager = &DnszoneAccessRequestsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *DnszoneAccessRequestsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *DnszoneAccessRequestsPager) GetNextWithContext(ctx context.Context) (page []AccessRequest, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListDnszoneAccessRequestsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextOffset()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = nextPageOffset(pager.options.Offset, next, len(result.AccessRequests), result.TotalCount)
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.AccessRequests
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *DnszoneAccessRequestsPager) GetAllWithContext(ctx context.Context) (allItems []AccessRequest, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []AccessRequest
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *DnszoneAccessRequestsPager) GetNext() (page []AccessRequest, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *DnszoneAccessRequestsPager) GetAll() (allItems []AccessRequest, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// LinkedPermittedNetworksPager can be used to simplify the use of the "ListLinkedPermittedNetworks" method.
+type LinkedPermittedNetworksPager struct {
+	hasNext bool
+	options *ListLinkedPermittedNetworksOptions
+	client  *DnsSvcsV1
+}
+
+// NewLinkedPermittedNetworksPager returns a new LinkedPermittedNetworksPager instance.
+func (dnsSvcs *DnsSvcsV1) NewLinkedPermittedNetworksPager(options *ListLinkedPermittedNetworksOptions) (pager *LinkedPermittedNetworksPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListLinkedPermittedNetworksOptions = *options
+	pager = &LinkedPermittedNetworksPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  dnsSvcs,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *LinkedPermittedNetworksPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *LinkedPermittedNetworksPager) GetNextWithContext(ctx context.Context) (page []PermittedNetwork, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	result, _, err := pager.client.ListLinkedPermittedNetworksWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	// The "ListLinkedPermittedNetworks" operation is not paginated, so the first page holds every result.
+	pager.hasNext = false
+	if result != nil {
+		page = result.PermittedNetworks
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *LinkedPermittedNetworksPager) GetAllWithContext(ctx context.Context) (allItems []PermittedNetwork, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []PermittedNetwork
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *LinkedPermittedNetworksPager) GetNext() (page []PermittedNetwork, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *LinkedPermittedNetworksPager) GetAll() (allItems []PermittedNetwork, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -0,0 +1,270 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnssvcsv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DnsSvcsV1 pagers`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+
+	// listResourceRecordsPage writes one page of a collection of "total" resource records,
+	// starting at the "offset" query parameter of the request and holding at most "limit" records.
+	listResourceRecordsPage := func(res http.ResponseWriter, req *http.Request, total int, limit int) {
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+		count := total - offset
+		if count > limit {
+			count = limit
+		}
+		if count < 0 {
+			count = 0
+		}
+		records := ""
+		for i := 0; i < count; i++ {
+			if i > 0 {
+				records += ","
+			}
+			records += fmt.Sprintf(`{"id": "record-%d", "name": "www%d.example.com", "type": "A"}`, offset+i, offset+i)
+		}
+		next := ""
+		if offset+count < total {
+			next = fmt.Sprintf(`, "next": {"href": "https://api.dns-svcs.cloud.ibm.com/v1/instances/i/dnszones/z/resource_records?offset=%d&limit=%d"}`, offset+count, limit)
+		}
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprintf(res, `{"resource_records": [%s], "offset": %d, "limit": %d, "count": %d, "total_count": %d, "first": {"href": "first"}, "last": {"href": "last"}%s}`,
+			records, offset, limit, count, total, next)
+	}
+
+	newService := func() *dnssvcsv1.DnsSvcsV1 {
+		dnsSvcsService, serviceErr := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(dnsSvcsService).ToNot(BeNil())
+		return dnsSvcsService
+	}
+
+	Describe(`ResourceRecordsPager`, func() {
+		Context(`Using mock server endpoint with three pages of results`, func() {
+			BeforeEach(func() {
+				requestCount = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					requestCount++
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.URL.EscapedPath()).To(Equal("/instances/testString/dnszones/testString/resource_records"))
+					Expect(req.URL.Query().Get("limit")).To(Equal("2"))
+					listResourceRecordsPage(res, req, 5, 2)
+				}))
+			})
+			It(`Use ResourceRecordsPager.GetNext successfully`, func() {
+				dnsSvcsService := newService()
+
+				listResourceRecordsOptionsModel := dnsSvcsService.NewListResourceRecordsOptions("testString", "testString")
+				listResourceRecordsOptionsModel.SetLimit(2)
+
+				pager, err := dnsSvcsService.NewResourceRecordsPager(listResourceRecordsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []dnssvcsv1.ResourceRecord
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(5))
+				Expect(*allResults[4].ID).To(Equal("record-4"))
+				Expect(requestCount).To(Equal(3))
+
+				_, err = pager.GetNext()
+				Expect(err).ToNot(BeNil())
+			})
+			It(`Use ResourceRecordsPager.GetAll successfully`, func() {
+				dnsSvcsService := newService()
+
+				listResourceRecordsOptionsModel := dnsSvcsService.NewListResourceRecordsOptions("testString", "testString")
+				listResourceRecordsOptionsModel.SetLimit(2)
+
+				pager, err := dnsSvcsService.NewResourceRecordsPager(listResourceRecordsOptionsModel)
+				Expect(err).To(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(len(allResults)).To(Equal(5))
+				Expect(pager.HasNext()).To(BeFalse())
+
+				// The caller's options must not be modified by the pager.
+				Expect(listResourceRecordsOptionsModel.Offset).To(BeNil())
+			})
+			It(`Invoke ResourceRecordsPager.GetAllWithContext with a cancelled context`, func() {
+				dnsSvcsService := newService()
+
+				listResourceRecordsOptionsModel := dnsSvcsService.NewListResourceRecordsOptions("testString", "testString")
+				listResourceRecordsOptionsModel.SetLimit(2)
+
+				pager, err := dnsSvcsService.NewResourceRecordsPager(listResourceRecordsOptionsModel)
+				Expect(err).To(BeNil())
+
+				ctx, cancelFunc := context.WithCancel(context.Background())
+				cancelFunc()
+				allResults, err := pager.GetAllWithContext(ctx)
+				Expect(err).To(Equal(context.Canceled))
+				Expect(allResults).To(BeNil())
+				Expect(requestCount).To(Equal(0))
+			})
+			It(`Invoke NewResourceRecordsPager with error: Offset is set`, func() {
+				dnsSvcsService := newService()
+
+				listResourceRecordsOptionsModel := dnsSvcsService.NewListResourceRecordsOptions("testString", "testString")
+				listResourceRecordsOptionsModel.SetOffset(4)
+
+				pager, err := dnsSvcsService.NewResourceRecordsPager(listResourceRecordsOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+
+				pager, err = dnsSvcsService.NewResourceRecordsPager(nil)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+		Context(`Using mock server endpoint where records are deleted while paging`, func() {
+			BeforeEach(func() {
+				requestCount = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					requestCount++
+					if requestCount == 1 {
+						// The first page reports 6 records and a "next" link.
+						listResourceRecordsPage(res, req, 6, 3)
+						return
+					}
+					// Only 2 records remain by the time the second page is requested, so the
+					// service returns an empty page that still carries a stale "next" link.
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"resource_records": [], "offset": 3, "limit": 3, "count": 0, "total_count": 2, "first": {"href": "first"}, "last": {"href": "last"}, "next": {"href": "https://api.dns-svcs.cloud.ibm.com/v1?offset=6&limit=3"}}`)
+				}))
+			})
+			It(`Use ResourceRecordsPager.GetAll successfully`, func() {
+				dnsSvcsService := newService()
+
+				listResourceRecordsOptionsModel := dnsSvcsService.NewListResourceRecordsOptions("testString", "testString")
+				listResourceRecordsOptionsModel.SetLimit(3)
+
+				pager, err := dnsSvcsService.NewResourceRecordsPager(listResourceRecordsOptionsModel)
+				Expect(err).To(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(len(allResults)).To(Equal(3))
+				Expect(requestCount).To(Equal(2))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`DnszonesPager`, func() {
+		Context(`Using mock server endpoint with two pages of results`, func() {
+			BeforeEach(func() {
+				requestCount = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					requestCount++
+					Expect(req.URL.EscapedPath()).To(Equal("/instances/testString/dnszones"))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					if requestCount == 1 {
+						Expect(req.URL.Query()["offset"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"dnszones": [{"id": "zone-1", "name": "example.com"}], "offset": 0, "limit": 1, "count": 1, "total_count": 2, "first": {"href": "first"}, "last": {"href": "last"}, "next": {"href": "https://api.dns-svcs.cloud.ibm.com/v1/instances/testString/dnszones?offset=1&limit=1"}}`)
+					} else {
+						Expect(req.URL.Query().Get("offset")).To(Equal("1"))
+						fmt.Fprintf(res, "%s", `{"dnszones": [{"id": "zone-2", "name": "example.org"}], "offset": 1, "limit": 1, "count": 1, "total_count": 2, "first": {"href": "first"}, "last": {"href": "last"}}`)
+					}
+				}))
+			})
+			It(`Use DnszonesPager.GetAll successfully`, func() {
+				dnsSvcsService := newService()
+
+				pager, err := dnsSvcsService.NewDnszonesPager(dnsSvcsService.NewListDnszonesOptions("testString"))
+				Expect(err).To(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+				Expect(*allResults[0].ID).To(Equal("zone-1"))
+				Expect(*allResults[1].ID).To(Equal("zone-2"))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`CustomResolversPager`, func() {
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				requestCount = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					requestCount++
+					Expect(req.URL.EscapedPath()).To(Equal("/instances/testString/custom_resolvers"))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"custom_resolvers": [{"id": "resolver-1"}, {"id": "resolver-2"}]}`)
+				}))
+			})
+			It(`Use CustomResolversPager.GetAll successfully`, func() {
+				dnsSvcsService := newService()
+
+				pager, err := dnsSvcsService.NewCustomResolversPager(dnsSvcsService.NewListCustomResolversOptions("testString"))
+				Expect(err).To(BeNil())
+				Expect(pager.HasNext()).To(BeTrue())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+				Expect(pager.HasNext()).To(BeFalse())
+				Expect(requestCount).To(Equal(1))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+})
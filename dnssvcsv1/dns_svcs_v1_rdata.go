@@ -0,0 +1,377 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnssvcsv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MaxTxtCharacterString is the maximum length, in bytes, of one character-string of a TXT record.
+const MaxTxtCharacterString = 255
+
+var (
+	srvServicePattern  = regexp.MustCompile(`^_[A-Za-z0-9][A-Za-z0-9-]{0,62}$`)
+	srvProtocolPattern = regexp.MustCompile(`^_(tcp|udp|tls)$`)
+	hostnameLabel      = regexp.MustCompile(`^[A-Za-z0-9_]([A-Za-z0-9_-]{0,61}[A-Za-z0-9_])?$`)
+)
+
+// Validate returns an error if the IP address is not an IPv4 address.
+func (rdata *ResourceRecordInputRdataRdataARecord) Validate() error {
+	ip := core.StringNilMapper(rdata.Ip)
+	if parsed := net.ParseIP(ip); parsed == nil || parsed.To4() == nil || strings.Contains(ip, ":") {
+		return fmt.Errorf("A record: ip %q is not an IPv4 address", ip)
+	}
+	return nil
+}
+
+// Validate returns an error if the IP address is not an IPv6 address.
+func (rdata *ResourceRecordInputRdataRdataAaaaRecord) Validate() error {
+	ip := core.StringNilMapper(rdata.Ip)
+	if parsed := net.ParseIP(ip); parsed == nil || !strings.Contains(ip, ":") {
+		return fmt.Errorf("AAAA record: ip %q is not an IPv6 address", ip)
+	}
+	return nil
+}
+
+// Validate returns an error if the canonical name is not a valid domain name.
+func (rdata *ResourceRecordInputRdataRdataCnameRecord) Validate() error {
+	return validateDomainName("CNAME record", "cname", core.StringNilMapper(rdata.Cname))
+}
+
+// Validate returns an error if the exchange is not a valid domain name, or if the preference is out of range.
+func (rdata *ResourceRecordInputRdataRdataMxRecord) Validate() error {
+	if err := validateUint16("MX record", "preference", rdata.Preference); err != nil {
+		return err
+	}
+	return validateDomainName("MX record", "exchange", core.StringNilMapper(rdata.Exchange))
+}
+
+// Validate returns an error if the host name is not a valid domain name.
+func (rdata *ResourceRecordInputRdataRdataPtrRecord) Validate() error {
+	return validateDomainName("PTR record", "ptrdname", core.StringNilMapper(rdata.Ptrdname))
+}
+
+// Validate returns an error if the target is not a valid domain name, or if the priority, weight or
+// port is out of range.
+func (rdata *ResourceRecordInputRdataRdataSrvRecord) Validate() error {
+	for _, field := range []struct {
+		name  string
+		value *int64
+	}{{"priority", rdata.Priority}, {"weight", rdata.Weight}, {"port", rdata.Port}} {
+		if err := validateUint16("SRV record", field.name, field.value); err != nil {
+			return err
+		}
+	}
+	return validateDomainName("SRV record", "target", core.StringNilMapper(rdata.Target))
+}
+
+// Validate returns an error if the text is empty, or if one of its character-strings is longer than
+// MaxTxtCharacterString bytes. A text made of quoted strings (e.g. `"v=DKIM1; k=rsa; " "p=MIIB..."`)
+// is validated string by string; ChunkTxtRecordText splits a longer text into such strings.
+func (rdata *ResourceRecordInputRdataRdataTxtRecord) Validate() error {
+	text := core.StringNilMapper(rdata.Text)
+	if text == "" {
+		return fmt.Errorf("TXT record: text is required")
+	}
+	strs, quoted := splitQuotedStrings(text)
+	if !quoted {
+		strs = []string{text}
+	}
+	for _, str := range strs {
+		if len(str) > MaxTxtCharacterString {
+			return fmt.Errorf("TXT record: text has a character-string of %d bytes, longer than %d bytes; use ChunkTxtRecordText to split it",
+				len(str), MaxTxtCharacterString)
+		}
+	}
+	return nil
+}
+
+// ChunkTxtRecordText returns the text of a TXT record as a sequence of quoted character-strings of at most
+// MaxTxtCharacterString bytes each, that passes the validation of ResourceRecordInputRdataRdataTxtRecord.
+// Quotes and backslashes are escaped, and multi-byte characters are not split.
+func ChunkTxtRecordText(text string) string {
+	var chunks []string
+	for {
+		var b strings.Builder
+		size := 0
+		for len(text) > 0 {
+			r, n := utf8.DecodeRuneInString(text)
+			escaped := string(r)
+			if r == '"' || r == '\\' {
+				escaped = `\` + escaped
+			}
+			// The length of a character-string is that of the unescaped text.
+			if size+n > MaxTxtCharacterString {
+				break
+			}
+			b.WriteString(escaped)
+			size += n
+			text = text[n:]
+		}
+		chunks = append(chunks, `"`+b.String()+`"`)
+		if text == "" {
+			break
+		}
+	}
+	return strings.Join(chunks, " ")
+}
+
+// ValidateRecord returns an error if the options describe an invalid record: if the type of the rdata
+// does not match the type of the record, if the rdata is not valid, if the name of a PTR record is not
+// an IP address or a reverse zone name, or if the service or protocol of an SRV record is not of the
+// form "_service" and "_tcp", "_udp" or "_tls". It does not send any request.
+func (_options *CreateResourceRecordOptions) ValidateRecord() error {
+	recordType := strings.ToUpper(core.StringNilMapper(_options.Type))
+	if _options.Rdata == nil {
+		return fmt.Errorf("%s record: rdata is required", recordType)
+	}
+	if rdataType := inputRdataType(_options.Rdata); rdataType != recordType {
+		return fmt.Errorf("%s record: the rdata is of a %s record", recordType, rdataType)
+	}
+	if validator, ok := _options.Rdata.(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
+
+	name := core.StringNilMapper(_options.Name)
+	switch recordType {
+	case ResourceRecord_Type_Ptr:
+		if net.ParseIP(name) == nil && !isReverseZoneName(name) {
+			return fmt.Errorf("PTR record: name %q is neither an IP address nor a name in in-addr.arpa or ip6.arpa", name)
+		}
+	case ResourceRecord_Type_Srv:
+		if !srvServicePattern.MatchString(core.StringNilMapper(_options.Service)) {
+			return fmt.Errorf("SRV record: service %q is not of the form _service", core.StringNilMapper(_options.Service))
+		}
+		if !srvProtocolPattern.MatchString(strings.ToLower(core.StringNilMapper(_options.Protocol))) {
+			return fmt.Errorf("SRV record: protocol %q is not one of _tcp, _udp and _tls", core.StringNilMapper(_options.Protocol))
+		}
+	}
+	if name != "@" && name != "" && net.ParseIP(name) == nil {
+		return validateDomainName(recordType+" record", "name", strings.TrimPrefix(name, "*."))
+	}
+	return nil
+}
+
+// NormalizeRdata returns a copy of rdata in canonical form, so that two rdata that denote the same
+// data are equal: domain names in lowercase without trailing dot, and IP addresses in their canonical
+// form (e.g. IPv6 addresses compressed and in lowercase, IPv4-mapped ones kept in IPv6 form). TXT
+// text is left unchanged. Rdata of an unknown type is returned as is.
+func NormalizeRdata(rdata ResourceRecordInputRdataIntf) ResourceRecordInputRdataIntf {
+	switch rdata := rdata.(type) {
+	case *ResourceRecordInputRdataRdataARecord:
+		return &ResourceRecordInputRdataRdataARecord{Ip: normalizeIP(rdata.Ip)}
+	case *ResourceRecordInputRdataRdataAaaaRecord:
+		return &ResourceRecordInputRdataRdataAaaaRecord{Ip: normalizeIPv6(rdata.Ip)}
+	case *ResourceRecordInputRdataRdataCnameRecord:
+		return &ResourceRecordInputRdataRdataCnameRecord{Cname: normalizeDomainName(rdata.Cname)}
+	case *ResourceRecordInputRdataRdataMxRecord:
+		return &ResourceRecordInputRdataRdataMxRecord{Exchange: normalizeDomainName(rdata.Exchange), Preference: rdata.Preference}
+	case *ResourceRecordInputRdataRdataPtrRecord:
+		return &ResourceRecordInputRdataRdataPtrRecord{Ptrdname: normalizeDomainName(rdata.Ptrdname)}
+	case *ResourceRecordInputRdataRdataSrvRecord:
+		return &ResourceRecordInputRdataRdataSrvRecord{
+			Port:     rdata.Port,
+			Priority: rdata.Priority,
+			Target:   normalizeDomainName(rdata.Target),
+			Weight:   rdata.Weight,
+		}
+	case *ResourceRecordInputRdataRdataTxtRecord:
+		return &ResourceRecordInputRdataRdataTxtRecord{Text: rdata.Text}
+	}
+	return rdata
+}
+
+// GetNormalizedRdata returns the rdata of a record retrieved from the service as the typed rdata of a
+// record of its type, normalized by NormalizeRdata, so that it can be compared with desired rdata.
+func (resourceRecord *ResourceRecord) GetNormalizedRdata() (rdata ResourceRecordInputRdataIntf, err error) {
+	switch strings.ToUpper(core.StringNilMapper(resourceRecord.Type)) {
+	case ResourceRecord_Type_A:
+		rdata = new(ResourceRecordInputRdataRdataARecord)
+	case ResourceRecord_Type_Aaaa:
+		rdata = new(ResourceRecordInputRdataRdataAaaaRecord)
+	case ResourceRecord_Type_Cname:
+		rdata = new(ResourceRecordInputRdataRdataCnameRecord)
+	case ResourceRecord_Type_Mx:
+		rdata = new(ResourceRecordInputRdataRdataMxRecord)
+	case ResourceRecord_Type_Ptr:
+		rdata = new(ResourceRecordInputRdataRdataPtrRecord)
+	case ResourceRecord_Type_Srv:
+		rdata = new(ResourceRecordInputRdataRdataSrvRecord)
+	case ResourceRecord_Type_Txt:
+		rdata = new(ResourceRecordInputRdataRdataTxtRecord)
+	default:
+		err = fmt.Errorf("unsupported record type %q", core.StringNilMapper(resourceRecord.Type))
+		return
+	}
+	buf, err := json.Marshal(resourceRecord.Rdata)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(buf, rdata)
+	if err != nil {
+		rdata = nil
+		return
+	}
+	rdata = NormalizeRdata(rdata)
+	return
+}
+
+// inputRdataType returns the type of record of the rdata.
+func inputRdataType(rdata ResourceRecordInputRdataIntf) string {
+	switch rdata.(type) {
+	case *ResourceRecordInputRdataRdataARecord:
+		return ResourceRecord_Type_A
+	case *ResourceRecordInputRdataRdataAaaaRecord:
+		return ResourceRecord_Type_Aaaa
+	case *ResourceRecordInputRdataRdataCnameRecord:
+		return ResourceRecord_Type_Cname
+	case *ResourceRecordInputRdataRdataMxRecord:
+		return ResourceRecord_Type_Mx
+	case *ResourceRecordInputRdataRdataPtrRecord:
+		return ResourceRecord_Type_Ptr
+	case *ResourceRecordInputRdataRdataSrvRecord:
+		return ResourceRecord_Type_Srv
+	case *ResourceRecordInputRdataRdataTxtRecord:
+		return ResourceRecord_Type_Txt
+	}
+	return fmt.Sprintf("%T", rdata)
+}
+
+// validateDomainName returns an error if name is not a valid domain name; a trailing dot is allowed, as
+// are underscores, which appear in the names of service records.
+func validateDomainName(recordType string, field string, name string) error {
+	trimmed := strings.TrimSuffix(name, ".")
+	if trimmed == "" || len(trimmed) > 253 {
+		return fmt.Errorf("%s: %s %q is not a valid domain name", recordType, field, name)
+	}
+	for _, label := range strings.Split(trimmed, ".") {
+		if !hostnameLabel.MatchString(label) {
+			return fmt.Errorf("%s: %s %q is not a valid domain name", recordType, field, name)
+		}
+	}
+	return nil
+}
+
+// validateUint16 returns an error if a required field is missing or out of the range of a 16-bit integer.
+func validateUint16(recordType string, field string, value *int64) error {
+	if value == nil {
+		return fmt.Errorf("%s: %s is required", recordType, field)
+	}
+	if *value < 0 || *value > 65535 {
+		return fmt.Errorf("%s: %s %d is not between 0 and 65535", recordType, field, *value)
+	}
+	return nil
+}
+
+// isReverseZoneName returns true if name is in the in-addr.arpa or ip6.arpa reverse zones.
+func isReverseZoneName(name string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	return strings.HasSuffix(name, ".in-addr.arpa") || strings.HasSuffix(name, ".ip6.arpa")
+}
+
+// splitQuotedStrings returns the strings of a text made of quoted strings separated by spaces, unescaped,
+// and false if the text is not made of quoted strings.
+func splitQuotedStrings(text string) (strs []string, ok bool) {
+	text = strings.TrimSpace(text)
+	for text != "" {
+		if text[0] != '"' {
+			return nil, false
+		}
+		end := 1
+		for end < len(text) && text[end] != '"' {
+			if text[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(text) {
+			return nil, false
+		}
+		strs = append(strs, unescapeText(text[1:end]))
+		text = strings.TrimLeft(text[end+1:], " \t")
+	}
+	return strs, len(strs) > 0
+}
+
+// unescapeText returns a quoted string of a zone file without its escapes: \X stands for X, and \DDD
+// for the byte with decimal value DDD.
+func unescapeText(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' || i+1 == len(text) {
+			b.WriteByte(text[i])
+			continue
+		}
+		if i+3 < len(text) && isDigits(text[i+1:i+4]) {
+			value, _ := strconv.Atoi(text[i+1 : i+4])
+			b.WriteByte(byte(value))
+			i += 3
+			continue
+		}
+		i++
+		b.WriteByte(text[i])
+	}
+	return b.String()
+}
+
+func isDigits(text string) bool {
+	for i := 0; i < len(text); i++ {
+		if text[i] < '0' || text[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeIP(ip *string) *string {
+	if ip == nil {
+		return nil
+	}
+	if parsed := net.ParseIP(*ip); parsed != nil {
+		return core.StringPtr(parsed.String())
+	}
+	return core.StringPtr(*ip)
+}
+
+// normalizeIPv6 is normalizeIP for an IPv6 address, which keeps an IPv4-mapped address in IPv6 form
+// (e.g. "::ffff:192.0.2.1").
+func normalizeIPv6(ip *string) *string {
+	if ip == nil {
+		return nil
+	}
+	if parsed := net.ParseIP(*ip); parsed != nil && parsed.To4() != nil {
+		return core.StringPtr("::ffff:" + parsed.To4().String())
+	}
+	return normalizeIP(ip)
+}
+
+func normalizeDomainName(name *string) *string {
+	if name == nil {
+		return nil
+	}
+	return core.StringPtr(strings.ToLower(strings.TrimSuffix(*name, ".")))
+}
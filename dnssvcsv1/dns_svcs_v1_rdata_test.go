@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnssvcsv1_test
+
+import (
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DnsSvcsV1 rdata validation`, func() {
+	Describe(`Validate()`, func() {
+		It(`Accepts valid rdata`, func() {
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.1")}).Validate()).To(Succeed())
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord{Ip: core.StringPtr("2001:DB8:0:0:0:0:0:1")}).Validate()).To(Succeed())
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: core.StringPtr("www.example.com.")}).Validate()).To(Succeed())
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataMxRecord{Exchange: core.StringPtr("mail.example.com"), Preference: core.Int64Ptr(10)}).Validate()).To(Succeed())
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataPtrRecord{Ptrdname: core.StringPtr("www.example.com")}).Validate()).To(Succeed())
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord{
+				Priority: core.Int64Ptr(1), Weight: core.Int64Ptr(5), Port: core.Int64Ptr(5060), Target: core.StringPtr("sip.example.com"),
+			}).Validate()).To(Succeed())
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr(ChunkedText())}).Validate()).To(Succeed())
+		})
+		It(`Rejects invalid rdata`, func() {
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.256")}).Validate()).
+				To(MatchError(`A record: ip "10.0.0.256" is not an IPv4 address`))
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("::ffff:10.0.0.1")}).Validate()).ToNot(Succeed())
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord{Ip: core.StringPtr("10.0.0.1")}).Validate()).ToNot(Succeed())
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: core.StringPtr("www..example.com")}).Validate()).
+				To(MatchError(`CNAME record: cname "www..example.com" is not a valid domain name`))
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataMxRecord{Exchange: core.StringPtr("mail.example.com")}).Validate()).
+				To(MatchError("MX record: preference is required"))
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataPtrRecord{Ptrdname: core.StringPtr("-www.example.com")}).Validate()).ToNot(Succeed())
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord{
+				Priority: core.Int64Ptr(1), Weight: core.Int64Ptr(5), Port: core.Int64Ptr(65536), Target: core.StringPtr("sip.example.com"),
+			}).Validate()).To(MatchError("SRV record: port 65536 is not between 0 and 65535"))
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr("")}).Validate()).ToNot(Succeed())
+			Expect((&dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr(strings.Repeat("a", 256))}).Validate()).
+				To(MatchError("TXT record: text has a character-string of 256 bytes, longer than 255 bytes; use ChunkTxtRecordText to split it"))
+		})
+	})
+
+	Describe(`ChunkTxtRecordText(text string)`, func() {
+		It(`Splits the text into quoted strings of at most 255 bytes`, func() {
+			Expect(dnssvcsv1.ChunkTxtRecordText(`say "hi"`)).To(Equal(`"say \"hi\""`))
+			chunked := dnssvcsv1.ChunkTxtRecordText(strings.Repeat("é", 200))
+			Expect(chunked).To(Equal(`"` + strings.Repeat("é", 127) + `" "` + strings.Repeat("é", 73) + `"`))
+		})
+	})
+
+	Describe(`ValidateRecord()`, func() {
+		It(`Validates the record described by the options`, func() {
+			options := &dnssvcsv1.CreateResourceRecordOptions{
+				Name:     core.StringPtr("voice.example.com"),
+				Type:     core.StringPtr("SRV"),
+				Service:  core.StringPtr("_sip"),
+				Protocol: core.StringPtr("_udp"),
+				Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord{
+					Priority: core.Int64Ptr(1), Weight: core.Int64Ptr(5), Port: core.Int64Ptr(5060), Target: core.StringPtr("sip.example.com"),
+				},
+			}
+			Expect(options.ValidateRecord()).To(Succeed())
+			options.Protocol = core.StringPtr("udp")
+			Expect(options.ValidateRecord()).To(MatchError(`SRV record: protocol "udp" is not one of _tcp, _udp and _tls`))
+			options.Protocol = core.StringPtr("_udp")
+			options.Service = core.StringPtr("sip")
+			Expect(options.ValidateRecord()).To(MatchError(`SRV record: service "sip" is not of the form _service`))
+
+			options = &dnssvcsv1.CreateResourceRecordOptions{
+				Name:  core.StringPtr("www.example.com"),
+				Type:  core.StringPtr("A"),
+				Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord{Ip: core.StringPtr("2001:db8::1")},
+			}
+			Expect(options.ValidateRecord()).To(MatchError("A record: the rdata is of a AAAA record"))
+
+			ptr := &dnssvcsv1.ResourceRecordInputRdataRdataPtrRecord{Ptrdname: core.StringPtr("www.example.com")}
+			options = &dnssvcsv1.CreateResourceRecordOptions{Name: core.StringPtr("10.0.0.1"), Type: core.StringPtr("PTR"), Rdata: ptr}
+			Expect(options.ValidateRecord()).To(Succeed())
+			options.Name = core.StringPtr("1.0.0.10.in-addr.arpa.")
+			Expect(options.ValidateRecord()).To(Succeed())
+			options.Name = core.StringPtr("www.example.com")
+			Expect(options.ValidateRecord()).To(MatchError(`PTR record: name "www.example.com" is neither an IP address nor a name in in-addr.arpa or ip6.arpa`))
+
+			options = &dnssvcsv1.CreateResourceRecordOptions{
+				Name:  core.StringPtr("*.apps.example.com"),
+				Type:  core.StringPtr("CNAME"),
+				Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: core.StringPtr("router.example.com")},
+			}
+			Expect(options.ValidateRecord()).To(Succeed())
+		})
+	})
+
+	Describe(`NormalizeRdata(rdata ResourceRecordInputRdataIntf)`, func() {
+		It(`Returns rdata in canonical form`, func() {
+			Expect(dnssvcsv1.NormalizeRdata(&dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord{Ip: core.StringPtr("2001:DB8:0:0:0:0:0:1")})).
+				To(Equal(&dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord{Ip: core.StringPtr("2001:db8::1")}))
+			Expect(dnssvcsv1.NormalizeRdata(&dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord{Ip: core.StringPtr("::FFFF:C000:0201")})).
+				To(Equal(&dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord{Ip: core.StringPtr("::ffff:192.0.2.1")}))
+			Expect(dnssvcsv1.NormalizeRdata(&dnssvcsv1.ResourceRecordInputRdataRdataMxRecord{Exchange: core.StringPtr("Mail.Example.com."), Preference: core.Int64Ptr(10)})).
+				To(Equal(&dnssvcsv1.ResourceRecordInputRdataRdataMxRecord{Exchange: core.StringPtr("mail.example.com"), Preference: core.Int64Ptr(10)}))
+			Expect(dnssvcsv1.NormalizeRdata(&dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr("Hello.")})).
+				To(Equal(&dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr("Hello.")}))
+		})
+		It(`Makes listed records comparable with desired rdata`, func() {
+			record := &dnssvcsv1.ResourceRecord{
+				Type:  core.StringPtr("SRV"),
+				Rdata: map[string]interface{}{"priority": float64(1), "weight": float64(5), "port": float64(5060), "target": "SIP.example.com"},
+			}
+			rdata, err := record.GetNormalizedRdata()
+			Expect(err).To(BeNil())
+			desired := &dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord{
+				Priority: core.Int64Ptr(1), Weight: core.Int64Ptr(5), Port: core.Int64Ptr(5060), Target: core.StringPtr("sip.example.com."),
+			}
+			Expect(rdata).To(Equal(dnssvcsv1.NormalizeRdata(desired)))
+
+			_, err = (&dnssvcsv1.ResourceRecord{Type: core.StringPtr("SOA")}).GetNormalizedRdata()
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
+
+// ChunkedText returns a DKIM-like text longer than a character-string, split by ChunkTxtRecordText.
+func ChunkedText() string {
+	return dnssvcsv1.ChunkTxtRecordText("v=DKIM1; k=rsa; p=" + strings.Repeat("A", 400))
+}
@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnssvcsv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// CreateResourceRecordWithRetry creates a resource record like CreateResourceRecordWithContext, retrying
+// according to policy (a default policy if nil). Because creating a record is not idempotent, before each
+// retry, and when the service reports a conflict, the records of the zone are searched for one with the
+// same name, type and rdata; if an earlier attempt created it, that record is returned instead of
+// creating a duplicate. The number of retries is reported by common.GetRetryCount.
+func (dnsSvcs *DnsSvcsV1) CreateResourceRecordWithRetry(ctx context.Context, createResourceRecordOptions *CreateResourceRecordOptions, policy *common.RetryPolicy) (result *ResourceRecord, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createResourceRecordOptions, "createResourceRecordOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	created, response, err := common.RetryCreate(ctx, policy,
+		func(ctx context.Context) (interface{}, *core.DetailedResponse, error) {
+			return dnsSvcs.CreateResourceRecordWithContext(ctx, createResourceRecordOptions)
+		},
+		func(ctx context.Context) (interface{}, *core.DetailedResponse, error) {
+			return dnsSvcs.findResourceRecord(ctx, createResourceRecordOptions)
+		})
+	if record, ok := created.(*ResourceRecord); ok && record != nil {
+		result = record
+	}
+	return
+}
+
+// findResourceRecord returns the record described by createResourceRecordOptions, or nil if it does not exist.
+func (dnsSvcs *DnsSvcsV1) findResourceRecord(ctx context.Context, createResourceRecordOptions *CreateResourceRecordOptions) (result *ResourceRecord, response *core.DetailedResponse, err error) {
+	instanceID := core.StringNilMapper(createResourceRecordOptions.InstanceID)
+	dnszoneID := core.StringNilMapper(createResourceRecordOptions.DnszoneID)
+
+	zone, _, err := dnsSvcs.GetDnszoneWithContext(ctx, dnsSvcs.NewGetDnszoneOptions(instanceID, dnszoneID))
+	if err != nil {
+		return
+	}
+	name := qualifyRecordName(core.StringNilMapper(createResourceRecordOptions.Name), core.StringNilMapper(zone.Name))
+
+	rdata, err := rdataMap(createResourceRecordOptions.Rdata)
+	if err != nil {
+		return
+	}
+
+	pager, err := dnsSvcs.NewResourceRecordsPager(dnsSvcs.NewListResourceRecordsOptions(instanceID, dnszoneID))
+	if err != nil {
+		return
+	}
+	records, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	for _, record := range records {
+		if !strings.EqualFold(core.StringNilMapper(record.Type), core.StringNilMapper(createResourceRecordOptions.Type)) {
+			continue
+		}
+		recordName := strings.TrimSuffix(core.StringNilMapper(record.Name), ".")
+		if !strings.EqualFold(recordName, name) && !strings.EqualFold(recordName, srvRecordName(createResourceRecordOptions, name)) {
+			continue
+		}
+		if !rdataMatches(rdata, record.Rdata) {
+			continue
+		}
+		return dnsSvcs.GetResourceRecordWithContext(ctx, dnsSvcs.NewGetResourceRecordOptions(instanceID, dnszoneID, *record.ID))
+	}
+	return
+}
+
+// qualifyRecordName returns the fully qualified form of a record name that may be relative to the zone.
+func qualifyRecordName(name string, zoneName string) string {
+	name = strings.TrimSuffix(name, ".")
+	zoneName = strings.TrimSuffix(zoneName, ".")
+	if name == "" || name == "@" {
+		return zoneName
+	}
+	lowerName, lowerZoneName := strings.ToLower(name), strings.ToLower(zoneName)
+	if lowerZoneName == "" || lowerName == lowerZoneName || strings.HasSuffix(lowerName, "."+lowerZoneName) {
+		return name
+	}
+	return name + "." + zoneName
+}
+
+// srvRecordName returns the name under which the service reports an SRV record, which is prefixed
+// with its service and protocol.
+func srvRecordName(createResourceRecordOptions *CreateResourceRecordOptions, name string) string {
+	if createResourceRecordOptions.Service == nil || createResourceRecordOptions.Protocol == nil {
+		return name
+	}
+	return fmt.Sprintf("%s.%s.%s", *createResourceRecordOptions.Service, *createResourceRecordOptions.Protocol, name)
+}
+
+// rdataMatches returns true if every field of the requested rdata has the same value in the rdata of a record.
+func rdataMatches(requested map[string]interface{}, actual interface{}) bool {
+	actualMap, _ := actual.(map[string]interface{})
+	for key, value := range requested {
+		actualValue, ok := actualMap[key]
+		if !ok {
+			return false
+		}
+		if !strings.EqualFold(strings.TrimSuffix(fmt.Sprint(value), "."), strings.TrimSuffix(fmt.Sprint(actualValue), ".")) {
+			return false
+		}
+	}
+	return true
+}
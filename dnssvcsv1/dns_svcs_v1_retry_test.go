@@ -0,0 +1,141 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnssvcsv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DnsSvcsV1 retries`, func() {
+	var testServer *httptest.Server
+	var createCount int
+	var createCodes []int
+	var existingRecords string
+	retryPolicy := &common.RetryPolicy{
+		MinRetryInterval: time.Millisecond,
+		MaxRetryInterval: 2 * time.Millisecond,
+	}
+	recordPath := "/instances/i-1/dnszones/z-1/resource_records"
+	record := `{"id": "r-1", "name": "www.example.com", "type": "A", "ttl": 900, "rdata": {"ip": "10.0.0.1"}}`
+
+	BeforeEach(func() {
+		createCount = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "POST" && req.URL.EscapedPath() == recordPath:
+				code := createCodes[createCount]
+				createCount++
+				res.WriteHeader(code)
+				if code == 200 {
+					fmt.Fprintf(res, "%s", record)
+				} else {
+					fmt.Fprintf(res, `{"code": %d, "message": "Internal error", "errors": [{"code": "internal_server_error", "message": "Internal error"}]}`, code)
+				}
+			case req.Method == "GET" && req.URL.EscapedPath() == "/instances/i-1/dnszones/z-1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "z-1", "name": "example.com", "state": "active"}`)
+			case req.Method == "GET" && req.URL.EscapedPath() == recordPath:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"resource_records": [%s], "offset": 0, "limit": 200, "count": 1, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}}`, existingRecords)
+			case req.Method == "GET" && req.URL.EscapedPath() == recordPath+"/r-1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", record)
+			default:
+				Fail("unexpected request: " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newCreateOptions := func(service *dnssvcsv1.DnsSvcsV1) *dnssvcsv1.CreateResourceRecordOptions {
+		createResourceRecordOptions := service.NewCreateResourceRecordOptions("i-1", "z-1")
+		createResourceRecordOptions.SetName("www")
+		createResourceRecordOptions.SetType(dnssvcsv1.CreateResourceRecordOptions_Type_A)
+		createResourceRecordOptions.SetRdata(&dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.1")})
+		return createResourceRecordOptions
+	}
+
+	Describe(`CreateResourceRecordWithRetry`, func() {
+		It(`Invoke CreateResourceRecordWithRetry returning the record created by a failed attempt`, func() {
+			createCodes = []int{502}
+			existingRecords = record
+
+			dnsSvcsService, serviceErr := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, response, err := dnsSvcsService.CreateResourceRecordWithRetry(context.Background(), newCreateOptions(dnsSvcsService), retryPolicy)
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(*result.ID).To(Equal("r-1"))
+			Expect(createCount).To(Equal(1))
+			Expect(common.GetRetryCount(response)).To(Equal(0))
+		})
+		It(`Invoke CreateResourceRecordWithRetry retrying the create`, func() {
+			createCodes = []int{502, 503, 200}
+			existingRecords = `{"id": "r-2", "name": "www.example.com", "type": "A", "rdata": {"ip": "10.0.0.2"}}`
+
+			dnsSvcsService, serviceErr := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, response, err := dnsSvcsService.CreateResourceRecordWithRetry(context.Background(), newCreateOptions(dnsSvcsService), retryPolicy)
+			Expect(err).To(BeNil())
+			Expect(*result.ID).To(Equal("r-1"))
+			Expect(createCount).To(Equal(3))
+			Expect(common.GetRetryCount(response)).To(Equal(2))
+		})
+		It(`Invoke CreateResourceRecordWithRetry with error: retries exhausted`, func() {
+			createCodes = []int{500, 500}
+			existingRecords = ""
+
+			dnsSvcsService, serviceErr := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			policy := *retryPolicy
+			policy.MaxRetries = 1
+			result, response, err := dnsSvcsService.CreateResourceRecordWithRetry(context.Background(), newCreateOptions(dnsSvcsService), &policy)
+			Expect(err).ToNot(BeNil())
+			Expect(result).To(BeNil())
+			Expect(response.StatusCode).To(Equal(500))
+			Expect(createCount).To(Equal(2))
+			Expect(common.GetRetryCount(response)).To(Equal(1))
+		})
+	})
+})
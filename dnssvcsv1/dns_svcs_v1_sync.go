@@ -0,0 +1,710 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnssvcsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// SyncOwnershipRecordPrefix is the label prepended to a record name to name the TXT record that marks
+// the name as managed, when a sync uses an ownership marker.
+const SyncOwnershipRecordPrefix = "_sync-owner."
+
+// DefaultSyncProtectedTypes are the types of the records that a sync leaves alone by default.
+var DefaultSyncProtectedTypes = []string{"SOA", "NS"}
+
+// SyncResourceRecord : A record of the desired state of a zone.
+type SyncResourceRecord struct {
+	// Name of the record, fully qualified or relative to the zone ("@" for the zone itself). The name of
+	// an SRV record does not include its service and protocol.
+	Name string
+
+	// Type of the record (one of the ResourceRecord_Type_* constants).
+	Type string
+
+	// Time to live in second. Zero leaves the TTL of a new record to the service, and the TTL of an
+	// existing record unchanged.
+	TTL int64
+
+	// Content of the record.
+	Rdata ResourceRecordInputRdataIntf
+
+	// Only used for SRV record.
+	Service string
+
+	// Only used for SRV record.
+	Protocol string
+}
+
+// SyncResourceRecordsOptions : The PlanResourceRecordSync options.
+type SyncResourceRecordsOptions struct {
+	// The unique identifier of a service instance.
+	InstanceID *string `json:"instance_id" validate:"required,ne="`
+
+	// The unique identifier of a DNS zone.
+	DnszoneID *string `json:"dnszone_id" validate:"required,ne="`
+
+	// The desired records of the zone.
+	Records []SyncResourceRecord `json:"records"`
+
+	// The types of the records that are never created, updated or deleted (DefaultSyncProtectedTypes if nil).
+	ProtectedTypes []string `json:"protected_types,omitempty"`
+
+	// If set, only the records whose name is marked as managed, by a TXT record named with the
+	// SyncOwnershipRecordPrefix and holding this text, are updated or deleted. The markers are created
+	// and deleted along with the records they mark. A name that already holds records but is not marked
+	// is never marked, and its missing desired records are reported as conflicts instead of created.
+	OwnershipMarker *string `json:"ownership_marker,omitempty"`
+}
+
+// NewSyncResourceRecordsOptions : Instantiate SyncResourceRecordsOptions
+func (*DnsSvcsV1) NewSyncResourceRecordsOptions(instanceID string, dnszoneID string, records []SyncResourceRecord) *SyncResourceRecordsOptions {
+	return &SyncResourceRecordsOptions{
+		InstanceID: core.StringPtr(instanceID),
+		DnszoneID:  core.StringPtr(dnszoneID),
+		Records:    records,
+	}
+}
+
+// SetProtectedTypes : Allow user to set ProtectedTypes
+func (_options *SyncResourceRecordsOptions) SetProtectedTypes(protectedTypes []string) *SyncResourceRecordsOptions {
+	_options.ProtectedTypes = protectedTypes
+	return _options
+}
+
+// SetOwnershipMarker : Allow user to set OwnershipMarker
+func (_options *SyncResourceRecordsOptions) SetOwnershipMarker(ownershipMarker string) *SyncResourceRecordsOptions {
+	_options.OwnershipMarker = core.StringPtr(ownershipMarker)
+	return _options
+}
+
+// ResourceRecordChange : A change of a ResourceRecordSyncPlan.
+type ResourceRecordChange struct {
+	// The action of the change (one of the common.SyncAction* constants).
+	Action string
+
+	// The desired record, for a create or an update.
+	Desired *SyncResourceRecord
+
+	// The record of the zone, for an update or a delete.
+	Current *ResourceRecord
+
+	// The record returned by the service once a create or an update is applied.
+	Result *ResourceRecord
+
+	// Whether the change was applied, and the error if it failed.
+	Applied bool
+	Err     error
+
+	desired *syncRecord
+	current *syncRecord
+}
+
+// String returns a line describing the change: the record to create prefixed with "+", the record to
+// delete prefixed with "-", or the record to update prefixed with "~" followed by its new version.
+func (change *ResourceRecordChange) String() string {
+	switch change.Action {
+	case common.SyncActionCreate:
+		return "+ " + change.desired.String()
+	case common.SyncActionUpdate:
+		return "~ " + change.current.String() + " => " + change.desired.String()
+	default:
+		return "- " + change.current.String()
+	}
+}
+
+// phase returns the rank of the change in the order the changes of a plan are applied: deletes first,
+// so that a record is never created next to the one it replaces, then updates, then creates. Ownership
+// markers are deleted after, and created before, the records they mark, so that managed records are
+// never left unmarked.
+func (change *ResourceRecordChange) phase() int {
+	switch change.Action {
+	case common.SyncActionDelete:
+		if change.current.marker {
+			return 1
+		}
+		return 0
+	case common.SyncActionUpdate:
+		return 2
+	default:
+		if change.desired.marker {
+			return 3
+		}
+		return 4
+	}
+}
+
+// ResourceRecordSyncPlan : The changes that bring the records of a zone to their desired state.
+type ResourceRecordSyncPlan struct {
+	// The unique identifier of the service instance.
+	InstanceID string
+
+	// The unique identifier of the DNS zone.
+	DnszoneID string
+
+	// The name of the DNS zone.
+	ZoneName string
+
+	// The changes, sorted by record name and type.
+	Changes []*ResourceRecordChange
+
+	// The number of desired records that are already present.
+	Unchanged int
+
+	// The records of the zone that are left alone because their type is protected, or because their
+	// name is not marked as managed.
+	Ignored []ResourceRecord
+
+	// The desired records that are not created because their name holds records that are not managed.
+	Conflicts []SyncResourceRecord
+
+	conflicts []*syncRecord
+}
+
+// IsEmpty returns true if the zone is already in its desired state.
+func (plan *ResourceRecordSyncPlan) IsEmpty() bool {
+	return len(plan.Changes) == 0
+}
+
+// Count returns the number of changes with the given action.
+func (plan *ResourceRecordSyncPlan) Count(action string) (count int) {
+	for _, change := range plan.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return
+}
+
+// String returns the plan in a form suitable for a dry run: a summary line followed by one line per change,
+// and one line per conflict prefixed with "!".
+func (plan *ResourceRecordSyncPlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Zone %s (%s): %d to create, %d to update, %d to delete, %d unchanged, %d ignored, %d in conflict\n",
+		plan.ZoneName, plan.DnszoneID, plan.Count(common.SyncActionCreate), plan.Count(common.SyncActionUpdate),
+		plan.Count(common.SyncActionDelete), plan.Unchanged, len(plan.Ignored), len(plan.Conflicts))
+	for _, change := range plan.Changes {
+		b.WriteString(change.String())
+		b.WriteByte('\n')
+	}
+	for _, record := range plan.conflicts {
+		b.WriteString("! " + record.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// PlanResourceRecordSync computes the changes that bring the records of a zone to the desired records.
+// Records are identified by their name, type and rdata: a desired record that is present is left alone,
+// or updated if its TTL differs; the remaining desired records update the remaining managed records of
+// the same name and type, are created, or cause them to be deleted. With an ownership marker, the desired
+// records missing at a name that holds records of another owner are reported as conflicts, since the
+// sync could never update or delete them once created. The desired records are validated
+// like by CreateResourceRecordOptions.ValidateRecord, and rdata is compared in the canonical form of
+// NormalizeRdata. The plan can be printed as a dry run, and applied with ApplyResourceRecordSyncPlan.
+func (dnsSvcs *DnsSvcsV1) PlanResourceRecordSync(ctx context.Context, syncResourceRecordsOptions *SyncResourceRecordsOptions) (plan *ResourceRecordSyncPlan, err error) {
+	err = core.ValidateNotNil(syncResourceRecordsOptions, "syncResourceRecordsOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(syncResourceRecordsOptions, "syncResourceRecordsOptions")
+	if err != nil {
+		return
+	}
+	instanceID := *syncResourceRecordsOptions.InstanceID
+	dnszoneID := *syncResourceRecordsOptions.DnszoneID
+
+	protectedTypes := syncResourceRecordsOptions.ProtectedTypes
+	if protectedTypes == nil {
+		protectedTypes = DefaultSyncProtectedTypes
+	}
+	protected := map[string]bool{}
+	for _, recordType := range protectedTypes {
+		protected[strings.ToUpper(recordType)] = true
+	}
+	marker := core.StringNilMapper(syncResourceRecordsOptions.OwnershipMarker)
+
+	zone, _, err := dnsSvcs.GetDnszoneWithContext(ctx, dnsSvcs.NewGetDnszoneOptions(instanceID, dnszoneID))
+	if err != nil {
+		return
+	}
+	plan = &ResourceRecordSyncPlan{
+		InstanceID: instanceID,
+		DnszoneID:  dnszoneID,
+		ZoneName:   strings.TrimSuffix(core.StringNilMapper(zone.Name), "."),
+	}
+
+	// Normalize the desired records.
+	var desired []*syncRecord
+	desiredKeys := map[string]bool{}
+	addDesired := func(record *syncRecord) {
+		if !desiredKeys[record.key] {
+			desiredKeys[record.key] = true
+			desired = append(desired, record)
+		}
+	}
+	for i := range syncResourceRecordsOptions.Records {
+		source := &syncResourceRecordsOptions.Records[i]
+		if source.Name == "" || source.Type == "" {
+			err = fmt.Errorf("desired record %d: name and type are required", i)
+			return
+		}
+		if protected[strings.ToUpper(source.Type)] {
+			err = fmt.Errorf("desired record %s %s: the %s type is protected", source.Name, source.Type, strings.ToUpper(source.Type))
+			return
+		}
+		err = (&CreateResourceRecordOptions{
+			Name:     core.StringPtr(source.Name),
+			Type:     core.StringPtr(strings.ToUpper(source.Type)),
+			Rdata:    source.Rdata,
+			Service:  core.StringPtr(source.Service),
+			Protocol: core.StringPtr(source.Protocol),
+		}).ValidateRecord()
+		if err != nil {
+			return
+		}
+		var record *syncRecord
+		record, err = newDesiredSyncRecord(source, plan.ZoneName)
+		if err != nil {
+			return
+		}
+		if desiredKeys[record.key] {
+			err = fmt.Errorf("desired record %s is duplicated", record)
+			return
+		}
+		addDesired(record)
+	}
+
+	// Retrieve the records of the zone, and find out which ones may be changed.
+	pager, err := dnsSvcs.NewResourceRecordsPager(dnsSvcs.NewListResourceRecordsOptions(instanceID, dnszoneID))
+	if err != nil {
+		return
+	}
+	records, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	var current []*syncRecord
+	managedNames := map[string]bool{}
+	for i := range records {
+		record := newCurrentSyncRecord(&records[i])
+		if protected[record.recordType] {
+			plan.Ignored = append(plan.Ignored, records[i])
+			continue
+		}
+		if marker != "" && record.recordType == ResourceRecord_Type_Txt && strings.HasPrefix(record.owner, SyncOwnershipRecordPrefix) &&
+			record.rdata["text"] == marker {
+			record.marker = true
+			managedNames[strings.TrimPrefix(record.owner, SyncOwnershipRecordPrefix)] = true
+		}
+		current = append(current, record)
+	}
+	unmarkedNames := map[string]bool{}
+	for _, record := range current {
+		record.managed = marker == "" || record.marker || managedNames[record.owner]
+		if !record.managed {
+			unmarkedNames[record.owner] = true
+		}
+	}
+
+	// Mark the names of the desired records, except the names that hold records that are not managed:
+	// marking them would hand these records over to the sync.
+	if marker != "" {
+		for _, record := range append([]*syncRecord(nil), desired...) {
+			if record.marker || unmarkedNames[record.owner] {
+				continue
+			}
+			var markerRecord *syncRecord
+			markerRecord, err = newDesiredSyncRecord(&SyncResourceRecord{
+				Name:  SyncOwnershipRecordPrefix + record.owner,
+				Type:  ResourceRecord_Type_Txt,
+				Rdata: &ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr(marker)},
+			}, plan.ZoneName)
+			if err != nil {
+				return
+			}
+			markerRecord.marker = true
+			addDesired(markerRecord)
+		}
+	}
+
+	// Leave alone the desired records that are present, updating their TTL if needed.
+	currentByKey := map[string][]*syncRecord{}
+	for _, record := range current {
+		currentByKey[record.key] = append(currentByKey[record.key], record)
+	}
+	var remaining []*syncRecord
+	for _, record := range desired {
+		matches := currentByKey[record.key]
+		if len(matches) == 0 {
+			remaining = append(remaining, record)
+			continue
+		}
+		match := matches[0]
+		currentByKey[record.key] = matches[1:]
+		match.matched = true
+		if record.ttl > 0 && record.ttl != match.ttl && match.managed {
+			plan.addChange(common.SyncActionUpdate, record, match)
+		} else {
+			plan.Unchanged++
+		}
+	}
+
+	// Pair the remaining desired records with the remaining managed records of the same name and type.
+	currentByName := map[string][]*syncRecord{}
+	for _, record := range current {
+		if record.matched {
+			continue
+		}
+		if !record.managed {
+			plan.Ignored = append(plan.Ignored, *record.source)
+			continue
+		}
+		nameKey := record.owner + " " + record.recordType
+		currentByName[nameKey] = append(currentByName[nameKey], record)
+	}
+	for _, record := range remaining {
+		if unmarkedNames[record.owner] {
+			plan.Conflicts = append(plan.Conflicts, *record.desired)
+			plan.conflicts = append(plan.conflicts, record)
+			continue
+		}
+		nameKey := record.owner + " " + record.recordType
+		if candidates := currentByName[nameKey]; len(candidates) > 0 {
+			currentByName[nameKey] = candidates[1:]
+			plan.addChange(common.SyncActionUpdate, record, candidates[0])
+		} else {
+			plan.addChange(common.SyncActionCreate, record, nil)
+		}
+	}
+	for _, candidates := range currentByName {
+		for _, record := range candidates {
+			plan.addChange(common.SyncActionDelete, nil, record)
+		}
+	}
+
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		a, b := plan.Changes[i].record(), plan.Changes[j].record()
+		if a.owner != b.owner {
+			return a.owner < b.owner
+		}
+		if a.recordType != b.recordType {
+			return a.recordType < b.recordType
+		}
+		return a.rdataKey < b.rdataKey
+	})
+	return
+}
+
+// ApplyResourceRecordSyncPlan applies the changes of a plan computed by PlanResourceRecordSync, with at
+// most concurrency changes in progress at the same time (common.DefaultSyncConcurrency if less than one).
+// Deletes are applied first, then updates, then creates; if a change fails, the changes of the later
+// steps are not attempted. The outcome of each change is recorded in the plan, and a *common.SyncError
+// is returned if any change failed.
+func (dnsSvcs *DnsSvcsV1) ApplyResourceRecordSyncPlan(ctx context.Context, plan *ResourceRecordSyncPlan, concurrency int) error {
+	err := core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		return err
+	}
+
+	phases := map[int][]*ResourceRecordChange{}
+	for _, change := range plan.Changes {
+		if !change.Applied {
+			phases[change.phase()] = append(phases[change.phase()], change)
+		}
+	}
+	syncErr := &common.SyncError{}
+	for phase := 0; phase <= 4; phase++ {
+		changes := phases[phase]
+		if len(syncErr.Errors) > 0 {
+			syncErr.Skipped += len(changes)
+			continue
+		}
+		errs := common.RunConcurrently(ctx, concurrency, len(changes), func(ctx context.Context, index int) error {
+			return dnsSvcs.applyResourceRecordChange(ctx, plan, changes[index])
+		})
+		for i, changeErr := range errs {
+			if changeErr != nil {
+				changes[i].Err = changeErr
+				syncErr.Errors = append(syncErr.Errors, fmt.Errorf("%s: %w", changes[i], changeErr))
+			} else {
+				changes[i].Applied = true
+				syncErr.Applied++
+			}
+		}
+	}
+	if len(syncErr.Errors) > 0 {
+		return syncErr
+	}
+	return nil
+}
+
+// applyResourceRecordChange applies one change of a plan.
+func (dnsSvcs *DnsSvcsV1) applyResourceRecordChange(ctx context.Context, plan *ResourceRecordSyncPlan, change *ResourceRecordChange) (err error) {
+	switch change.Action {
+	case common.SyncActionCreate:
+		options := dnsSvcs.NewCreateResourceRecordOptions(plan.InstanceID, plan.DnszoneID)
+		options.SetName(qualifyRecordName(change.Desired.Name, plan.ZoneName))
+		options.SetType(strings.ToUpper(change.Desired.Type))
+		options.SetRdata(change.Desired.Rdata)
+		if change.Desired.TTL > 0 {
+			options.SetTTL(change.Desired.TTL)
+		}
+		if change.Desired.Service != "" {
+			options.SetService(change.Desired.Service)
+		}
+		if change.Desired.Protocol != "" {
+			options.SetProtocol(change.Desired.Protocol)
+		}
+		change.Result, _, err = dnsSvcs.CreateResourceRecordWithContext(ctx, options)
+	case common.SyncActionUpdate:
+		var rdata ResourceRecordUpdateInputRdataIntf
+		rdata, err = newUpdateRdata(change.desired.recordType, change.desired.rdata)
+		if err != nil {
+			return
+		}
+		options := dnsSvcs.NewUpdateResourceRecordOptions(plan.InstanceID, plan.DnszoneID, core.StringNilMapper(change.Current.ID))
+		options.SetName(qualifyRecordName(change.Desired.Name, plan.ZoneName))
+		options.SetRdata(rdata)
+		if change.Desired.TTL > 0 {
+			options.SetTTL(change.Desired.TTL)
+		}
+		if change.Desired.Service != "" {
+			options.SetService(change.Desired.Service)
+		}
+		if change.Desired.Protocol != "" {
+			options.SetProtocol(change.Desired.Protocol)
+		}
+		change.Result, _, err = dnsSvcs.UpdateResourceRecordWithContext(ctx, options)
+	case common.SyncActionDelete:
+		_, err = dnsSvcs.DeleteResourceRecordWithContext(ctx,
+			dnsSvcs.NewDeleteResourceRecordOptions(plan.InstanceID, plan.DnszoneID, core.StringNilMapper(change.Current.ID)))
+		if common.IsNotFound(err) {
+			err = nil
+		}
+	default:
+		err = fmt.Errorf("unknown sync action %q", change.Action)
+	}
+	return
+}
+
+// addChange adds a change to the plan.
+func (plan *ResourceRecordSyncPlan) addChange(action string, desired *syncRecord, current *syncRecord) {
+	change := &ResourceRecordChange{
+		Action:  action,
+		desired: desired,
+		current: current,
+	}
+	if desired != nil {
+		change.Desired = desired.desired
+	}
+	if current != nil {
+		change.Current = current.source
+	}
+	plan.Changes = append(plan.Changes, change)
+}
+
+// record returns the record the change is about.
+func (change *ResourceRecordChange) record() *syncRecord {
+	if change.desired != nil {
+		return change.desired
+	}
+	return change.current
+}
+
+// syncRecord is the normalized form of a desired record or of a record of the zone, used to compare them.
+type syncRecord struct {
+	// The fully qualified owner name in lowercase, without trailing dot, including the service and
+	// protocol of an SRV record.
+	owner      string
+	recordType string
+	ttl        int64
+	rdata      map[string]interface{}
+	rdataKey   string
+	key        string
+
+	desired *SyncResourceRecord
+	source  *ResourceRecord
+
+	// Whether the record is an ownership marker, whether it may be changed and whether it matches a desired record.
+	marker  bool
+	managed bool
+	matched bool
+}
+
+// String returns the record as a line of a zone file.
+func (record *syncRecord) String() string {
+	ttl := ""
+	if record.ttl > 0 {
+		ttl = strconv.FormatInt(record.ttl, 10) + " "
+	}
+	return fmt.Sprintf("%s. %sIN %s %s", record.owner, ttl, record.recordType, rdataString(record.recordType, record.rdata))
+}
+
+func newDesiredSyncRecord(desired *SyncResourceRecord, zoneName string) (record *syncRecord, err error) {
+	rdata, err := rdataMap(NormalizeRdata(desired.Rdata))
+	if err != nil {
+		return
+	}
+	owner := strings.ToLower(qualifyRecordName(desired.Name, zoneName))
+	if desired.Service != "" && desired.Protocol != "" {
+		owner = strings.ToLower(desired.Service+"."+desired.Protocol+".") + owner
+	}
+	record = newSyncRecord(owner, desired.Type, desired.TTL, rdata)
+	record.desired = desired
+	return
+}
+
+func newCurrentSyncRecord(current *ResourceRecord) *syncRecord {
+	owner := strings.ToLower(strings.TrimSuffix(core.StringNilMapper(current.Name), "."))
+	if current.Service != nil && current.Protocol != nil {
+		prefix := strings.ToLower(*current.Service + "." + *current.Protocol + ".")
+		if !strings.HasPrefix(owner, prefix) {
+			owner = prefix + owner
+		}
+	}
+	rdata, _ := current.Rdata.(map[string]interface{})
+	if normalized, err := current.GetNormalizedRdata(); err == nil {
+		if normalizedMap, err := rdataMap(normalized); err == nil {
+			rdata = normalizedMap
+		}
+	}
+	ttl := int64(0)
+	if current.TTL != nil {
+		ttl = *current.TTL
+	}
+	record := newSyncRecord(owner, core.StringNilMapper(current.Type), ttl, rdata)
+	record.source = current
+	return record
+}
+
+func newSyncRecord(owner string, recordType string, ttl int64, rdata map[string]interface{}) *syncRecord {
+	record := &syncRecord{
+		owner:      owner,
+		recordType: strings.ToUpper(recordType),
+		ttl:        ttl,
+		rdata:      rdata,
+	}
+	record.rdataKey = canonicalRdata(rdata)
+	record.key = record.owner + " " + record.recordType + " " + record.rdataKey
+	return record
+}
+
+// canonicalRdata returns the form of rdata used to compare records: its fields sorted by name.
+func canonicalRdata(rdata map[string]interface{}) string {
+	keys := make([]string, 0, len(rdata))
+	for key := range rdata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		keys[i] = key + "=" + canonicalRdataValue(rdata[key])
+	}
+	return strings.Join(keys, " ")
+}
+
+// canonicalRdataValue returns the form of a field of normalized rdata (see NormalizeRdata) used to
+// compare records.
+func canonicalRdataValue(value interface{}) string {
+	if text, ok := value.(string); ok {
+		return strconv.Quote(text)
+	}
+	return rdataValueString(value)
+}
+
+// rdataValueString returns a field of rdata as a string; JSON numbers are formatted as integers.
+func rdataValueString(value interface{}) string {
+	if number, ok := value.(float64); ok && number == float64(int64(number)) {
+		return strconv.FormatInt(int64(number), 10)
+	}
+	return fmt.Sprint(value)
+}
+
+// rdataString returns rdata in zone file presentation format.
+func rdataString(recordType string, rdata map[string]interface{}) string {
+	field := func(key string) string {
+		return rdataValueString(rdata[key])
+	}
+	name := func(key string) string {
+		return strings.TrimSuffix(field(key), ".") + "."
+	}
+	switch recordType {
+	case ResourceRecord_Type_A, ResourceRecord_Type_Aaaa:
+		return field("ip")
+	case ResourceRecord_Type_Cname:
+		return name("cname")
+	case ResourceRecord_Type_Ptr:
+		return name("ptrdname")
+	case ResourceRecord_Type_Mx:
+		return field("preference") + " " + name("exchange")
+	case ResourceRecord_Type_Srv:
+		return field("priority") + " " + field("weight") + " " + field("port") + " " + name("target")
+	case ResourceRecord_Type_Txt:
+		return strconv.Quote(field("text"))
+	}
+	return canonicalRdata(rdata)
+}
+
+// rdataMap returns the JSON form of the rdata of a request.
+func rdataMap(rdata interface{}) (result map[string]interface{}, err error) {
+	if rdata == nil {
+		return
+	}
+	buf, err := json.Marshal(rdata)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(buf, &result)
+	return
+}
+
+// newUpdateRdata returns the rdata of an update request for a record of the given type.
+func newUpdateRdata(recordType string, rdata map[string]interface{}) (result ResourceRecordUpdateInputRdataIntf, err error) {
+	switch recordType {
+	case ResourceRecord_Type_A:
+		result = &ResourceRecordUpdateInputRdataRdataARecord{}
+	case ResourceRecord_Type_Aaaa:
+		result = &ResourceRecordUpdateInputRdataRdataAaaaRecord{}
+	case ResourceRecord_Type_Cname:
+		result = &ResourceRecordUpdateInputRdataRdataCnameRecord{}
+	case ResourceRecord_Type_Mx:
+		result = &ResourceRecordUpdateInputRdataRdataMxRecord{}
+	case ResourceRecord_Type_Ptr:
+		result = &ResourceRecordUpdateInputRdataRdataPtrRecord{}
+	case ResourceRecord_Type_Srv:
+		result = &ResourceRecordUpdateInputRdataRdataSrvRecord{}
+	case ResourceRecord_Type_Txt:
+		result = &ResourceRecordUpdateInputRdataRdataTxtRecord{}
+	default:
+		err = fmt.Errorf("records of type %s cannot be updated", recordType)
+		return
+	}
+	buf, err := json.Marshal(rdata)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(buf, result)
+	return
+}
@@ -0,0 +1,230 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dnssvcsv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/networking-go-sdk/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DnsSvcsV1 record sync`, func() {
+	var testServer *httptest.Server
+	var dnsSvcsService *dnssvcsv1.DnsSvcsV1
+	var requests []string
+	var requestBodies []map[string]interface{}
+	var failDeletes bool
+	var lock sync.Mutex
+	recordPath := "/instances/i-1/dnszones/z-1/resource_records"
+	existingRecords := `[
+		{"id": "r-soa", "name": "example.com", "type": "SOA", "ttl": 3600, "rdata": {"mname": "ns1.dns.ibm.com"}},
+		{"id": "r-www", "name": "www.example.com", "type": "A", "ttl": 300, "rdata": {"ip": "10.0.0.1"}},
+		{"id": "r-www-owner", "name": "_sync-owner.www.example.com", "type": "TXT", "ttl": 300, "rdata": {"text": "team-a"}},
+		{"id": "r-mail", "name": "example.com", "type": "MX", "ttl": 300, "rdata": {"exchange": "mail1.example.com", "preference": 10}},
+		{"id": "r-apex-owner", "name": "_sync-owner.example.com", "type": "TXT", "ttl": 300, "rdata": {"text": "team-a"}},
+		{"id": "r-old", "name": "old.example.com", "type": "A", "ttl": 300, "rdata": {"ip": "10.0.0.9"}},
+		{"id": "r-old-owner", "name": "_sync-owner.old.example.com", "type": "TXT", "ttl": 300, "rdata": {"text": "team-a"}},
+		{"id": "r-legacy", "name": "legacy.example.com", "type": "A", "ttl": 300, "rdata": {"ip": "10.0.0.5"}},
+		{"id": "r-other", "name": "other.example.com", "type": "A", "ttl": 300, "rdata": {"ip": "10.0.0.6"}},
+		{"id": "r-other-owner", "name": "_sync-owner.other.example.com", "type": "TXT", "ttl": 300, "rdata": {"text": "team-b"}}
+	]`
+
+	BeforeEach(func() {
+		requests = nil
+		requestBodies = nil
+		failDeletes = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/instances/i-1/dnszones/z-1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "z-1", "name": "example.com", "state": "active"}`)
+			case req.Method == "GET" && req.URL.EscapedPath() == recordPath:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"resource_records": %s, "offset": 0, "limit": 200, "count": 10, "total_count": 10, "first": {"href": "first"}, "last": {"href": "last"}}`, existingRecords)
+			default:
+				lock.Lock()
+				defer lock.Unlock()
+				requests = append(requests, req.Method+" "+req.URL.EscapedPath())
+				var body map[string]interface{}
+				buf, _ := ioutil.ReadAll(req.Body)
+				_ = json.Unmarshal(buf, &body)
+				requestBodies = append(requestBodies, body)
+				if req.Method == "DELETE" && failDeletes {
+					res.WriteHeader(409)
+					fmt.Fprintf(res, "%s", `{"code": "conflict", "message": "The zone is being updated."}`)
+					return
+				}
+				if req.Method == "DELETE" {
+					res.WriteHeader(204)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "r-new", "name": "new.example.com", "type": "A"}`)
+			}
+		}))
+		var serviceErr error
+		dnsSvcsService, serviceErr = dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	desiredRecords := func() []dnssvcsv1.SyncResourceRecord {
+		return []dnssvcsv1.SyncResourceRecord{
+			{Name: "www", Type: "A", TTL: 600, Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.1")}},
+			{Name: "@", Type: "MX", TTL: 300, Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataMxRecord{Exchange: core.StringPtr("mail2.example.com"), Preference: core.Int64Ptr(10)}},
+			{Name: "api.example.com", Type: "CNAME", TTL: 300, Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: core.StringPtr("www.example.com")}},
+			{Name: "legacy", Type: "A", Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.5")}},
+		}
+	}
+
+	Describe(`PlanResourceRecordSync(syncResourceRecordsOptions *SyncResourceRecordsOptions)`, func() {
+		It(`Plans creates, updates and deletes of the managed records`, func() {
+			options := dnsSvcsService.NewSyncResourceRecordsOptions("i-1", "z-1", desiredRecords()).SetOwnershipMarker("team-a")
+			plan, err := dnsSvcsService.PlanResourceRecordSync(context.Background(), options)
+			Expect(err).To(BeNil())
+			Expect(plan.String()).To(Equal(`Zone example.com (z-1): 2 to create, 2 to update, 2 to delete, 3 unchanged, 3 ignored, 0 in conflict
++ _sync-owner.api.example.com. IN TXT "team-a"
+- _sync-owner.old.example.com. 300 IN TXT "team-a"
++ api.example.com. 300 IN CNAME www.example.com.
+~ example.com. 300 IN MX 10 mail1.example.com. => example.com. 300 IN MX 10 mail2.example.com.
+- old.example.com. 300 IN A 10.0.0.9
+~ www.example.com. 300 IN A 10.0.0.1 => www.example.com. 600 IN A 10.0.0.1
+`))
+			Expect(plan.IsEmpty()).To(BeFalse())
+			Expect(requests).To(BeEmpty())
+
+			ignored := []string{}
+			for _, record := range plan.Ignored {
+				ignored = append(ignored, *record.ID)
+			}
+			Expect(ignored).To(ConsistOf("r-soa", "r-other", "r-other-owner"))
+		})
+		It(`Manages every record without an ownership marker`, func() {
+			plan, err := dnsSvcsService.PlanResourceRecordSync(context.Background(),
+				dnsSvcsService.NewSyncResourceRecordsOptions("i-1", "z-1", desiredRecords()))
+			Expect(err).To(BeNil())
+			Expect(plan.Count(common.SyncActionDelete)).To(Equal(6))
+			Expect(plan.Ignored).To(HaveLen(1))
+		})
+		It(`Rejects protected and duplicated desired records`, func() {
+			records := append(desiredRecords(), dnssvcsv1.SyncResourceRecord{Name: "@", Type: "NS", Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: core.StringPtr("ns")}})
+			_, err := dnsSvcsService.PlanResourceRecordSync(context.Background(), dnsSvcsService.NewSyncResourceRecordsOptions("i-1", "z-1", records))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("the NS type is protected"))
+
+			records = append(desiredRecords(), dnssvcsv1.SyncResourceRecord{Name: "WWW.example.com.", Type: "a", Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.1")}})
+			_, err = dnsSvcsService.PlanResourceRecordSync(context.Background(), dnsSvcsService.NewSyncResourceRecordsOptions("i-1", "z-1", records))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("is duplicated"))
+		})
+	})
+
+	Describe(`ApplyResourceRecordSyncPlan(plan *ResourceRecordSyncPlan, concurrency int)`, func() {
+		It(`Applies deletes, then updates, then creates`, func() {
+			options := dnsSvcsService.NewSyncResourceRecordsOptions("i-1", "z-1", desiredRecords()).SetOwnershipMarker("team-a")
+			plan, err := dnsSvcsService.PlanResourceRecordSync(context.Background(), options)
+			Expect(err).To(BeNil())
+
+			err = dnsSvcsService.ApplyResourceRecordSyncPlan(context.Background(), plan, 1)
+			Expect(err).To(BeNil())
+			Expect(requests).To(Equal([]string{
+				"DELETE " + recordPath + "/r-old",
+				"DELETE " + recordPath + "/r-old-owner",
+				"PUT " + recordPath + "/r-mail",
+				"PUT " + recordPath + "/r-www",
+				"POST " + recordPath,
+				"POST " + recordPath,
+			}))
+			Expect(requestBodies[2]).To(Equal(map[string]interface{}{
+				"name": "example.com", "ttl": float64(300), "rdata": map[string]interface{}{"exchange": "mail2.example.com", "preference": float64(10)},
+			}))
+			Expect(requestBodies[4]["name"]).To(Equal("_sync-owner.api.example.com"))
+			Expect(requestBodies[5]["name"]).To(Equal("api.example.com"))
+			for _, change := range plan.Changes {
+				Expect(change.Applied).To(BeTrue())
+			}
+		})
+		It(`Reports the missing desired records at a name of another owner as conflicts`, func() {
+			server := httptest.NewServer(fakes.NewDnsSvcsServer())
+			defer server.Close()
+			service, err := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+			zone, _, err := service.CreateDnszone(service.NewCreateDnszoneOptions("i-1").SetName("example.com"))
+			Expect(err).To(BeNil())
+			_, _, err = service.CreateResourceRecord(service.NewCreateResourceRecordOptions("i-1", *zone.ID).
+				SetName("www").SetType("TXT").SetRdata(&dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr("team-b")}))
+			Expect(err).To(BeNil())
+
+			www := dnssvcsv1.SyncResourceRecord{Name: "www", Type: "A", Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr("10.0.0.1")}}
+			plan, err := service.PlanResourceRecordSync(context.Background(),
+				service.NewSyncResourceRecordsOptions("i-1", *zone.ID, []dnssvcsv1.SyncResourceRecord{www}).SetOwnershipMarker("team-a"))
+			Expect(err).To(BeNil())
+			Expect(plan.IsEmpty()).To(BeTrue())
+			Expect(plan.Conflicts).To(Equal([]dnssvcsv1.SyncResourceRecord{www}))
+			Expect(plan.String()).To(HaveSuffix("1 in conflict\n! www.example.com. IN A 10.0.0.1\n"))
+			Expect(service.ApplyResourceRecordSyncPlan(context.Background(), plan, 0)).To(BeNil())
+
+			list, _, err := service.ListResourceRecords(service.NewListResourceRecordsOptions("i-1", *zone.ID))
+			Expect(err).To(BeNil())
+			var records []string
+			for _, record := range list.ResourceRecords {
+				rdata, _ := json.Marshal(record.Rdata)
+				records = append(records, *record.Name+" "+*record.Type+" "+string(rdata))
+			}
+			Expect(records).To(ConsistOf(`www.example.com TXT {"text":"team-b"}`))
+		})
+		It(`Stops after a failed step`, func() {
+			failDeletes = true
+			options := dnsSvcsService.NewSyncResourceRecordsOptions("i-1", "z-1", desiredRecords()).SetOwnershipMarker("team-a")
+			plan, err := dnsSvcsService.PlanResourceRecordSync(context.Background(), options)
+			Expect(err).To(BeNil())
+
+			err = dnsSvcsService.ApplyResourceRecordSyncPlan(context.Background(), plan, 0)
+			Expect(err).ToNot(BeNil())
+			var syncErr *common.SyncError
+			Expect(errors.As(err, &syncErr)).To(BeTrue())
+			Expect(syncErr.Applied).To(Equal(0))
+			Expect(syncErr.Errors).To(HaveLen(1))
+			Expect(syncErr.Skipped).To(Equal(5))
+			Expect(common.IsConflict(err)).To(BeTrue())
+			Expect(requests).To(Equal([]string{"DELETE " + recordPath + "/r-old"}))
+		})
+	})
+})
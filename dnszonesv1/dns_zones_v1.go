@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	common "github.com/IBM/networking-go-sdk/common"
@@ -106,6 +107,35 @@ func (dnsZones *DnsZonesV1) SetServiceURL(url string) error {
 	return dnsZones.Service.SetServiceURL(url)
 }
 
+// EnableRetries enables automatic retries for requests invoked for this service instance.
+// If either parameter is specified as 0, then a default value is used instead.
+func (dnsZones *DnsZonesV1) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
+	dnsZones.Service.EnableRetries(maxRetries, maxRetryInterval)
+}
+
+// DisableRetries disables automatic retries for requests invoked for this service instance.
+func (dnsZones *DnsZonesV1) DisableRetries() {
+	dnsZones.Service.DisableRetries()
+}
+
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (dnsZones *DnsZonesV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(dnsZones.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (dnsZones *DnsZonesV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(dnsZones.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (dnsZones *DnsZonesV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(dnsZones.Service, middleware...)
+}
+
 // ListDnszones : List DNS zones
 // List the DNS zones for a given service instance.
 func (dnsZones *DnsZonesV1) ListDnszones(listDnszonesOptions *ListDnszonesOptions) (result *ListDnszones, response *core.DetailedResponse, err error) {
@@ -158,6 +188,7 @@ func (dnsZones *DnsZonesV1) ListDnszones(listDnszonesOptions *ListDnszonesOption
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListDnszones)
@@ -227,6 +258,7 @@ func (dnsZones *DnsZonesV1) CreateDnszone(createDnszoneOptions *CreateDnszoneOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnszone)
@@ -277,6 +309,9 @@ func (dnsZones *DnsZonesV1) DeleteDnszone(deleteDnszoneOptions *DeleteDnszoneOpt
 	}
 
 	response, err = dnsZones.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -323,6 +358,7 @@ func (dnsZones *DnsZonesV1) GetDnszone(getDnszoneOptions *GetDnszoneOptions) (re
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnszone)
@@ -389,6 +425,7 @@ func (dnsZones *DnsZonesV1) UpdateDnszone(updateDnszoneOptions *UpdateDnszoneOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = dnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDnszone)
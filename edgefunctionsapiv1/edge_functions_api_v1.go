@@ -180,6 +180,24 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) DisableRetries() {
 	edgeFunctionsApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (edgeFunctionsApi *EdgeFunctionsApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(edgeFunctionsApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (edgeFunctionsApi *EdgeFunctionsApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(edgeFunctionsApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (edgeFunctionsApi *EdgeFunctionsApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(edgeFunctionsApi.Service, middleware...)
+}
+
 // ListEdgeFunctionsActions : Get all edge functions scripts for a given instance
 // Get all edge functions scripts for a given instance.
 func (edgeFunctionsApi *EdgeFunctionsApiV1) ListEdgeFunctionsActions(listEdgeFunctionsActionsOptions *ListEdgeFunctionsActionsOptions) (result *ListEdgeFunctionsActionsResp, response *core.DetailedResponse, err error) {
@@ -226,6 +244,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) ListEdgeFunctionsActionsWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListEdgeFunctionsActionsResp)
@@ -294,6 +313,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) UpdateEdgeFunctionsActionWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalGetEdgeFunctionsActionResp)
@@ -354,6 +374,9 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) GetEdgeFunctionsActionWithContext(ct
 	}
 
 	response, err = edgeFunctionsApi.Service.Request(request, &result)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -409,6 +432,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) DeleteEdgeFunctionsActionWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteEdgeFunctionsActionResp)
@@ -480,6 +504,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) CreateEdgeFunctionsTriggerWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCreateEdgeFunctionsTriggerResp)
@@ -538,6 +563,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) ListEdgeFunctionsTriggersWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListEdgeFunctionsTriggersResp)
@@ -601,6 +627,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) GetEdgeFunctionsTriggerWithContext(c
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalGetEdgeFunctionsTriggerResp)
@@ -677,6 +704,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) UpdateEdgeFunctionsTriggerWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalGetEdgeFunctionsTriggerResp)
@@ -740,6 +768,7 @@ func (edgeFunctionsApi *EdgeFunctionsApiV1) DeleteEdgeFunctionsTriggerWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = edgeFunctionsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCreateEdgeFunctionsTriggerResp)
@@ -0,0 +1,50 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgefunctionsapiv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// EdgeFunctionsApiV1API is the interface of the operations of EdgeFunctionsApiV1, through which they can be replaced
+// in unit tests, for instance with the mocks.EdgeFunctionsApiV1 of the mocks package.
+type EdgeFunctionsApiV1API interface {
+	ListEdgeFunctionsActions(listEdgeFunctionsActionsOptions *ListEdgeFunctionsActionsOptions) (result *ListEdgeFunctionsActionsResp, response *core.DetailedResponse, err error)
+	ListEdgeFunctionsActionsWithContext(ctx context.Context, listEdgeFunctionsActionsOptions *ListEdgeFunctionsActionsOptions) (result *ListEdgeFunctionsActionsResp, response *core.DetailedResponse, err error)
+	UpdateEdgeFunctionsAction(updateEdgeFunctionsActionOptions *UpdateEdgeFunctionsActionOptions) (result *GetEdgeFunctionsActionResp, response *core.DetailedResponse, err error)
+	UpdateEdgeFunctionsActionWithContext(ctx context.Context, updateEdgeFunctionsActionOptions *UpdateEdgeFunctionsActionOptions) (result *GetEdgeFunctionsActionResp, response *core.DetailedResponse, err error)
+	GetEdgeFunctionsAction(getEdgeFunctionsActionOptions *GetEdgeFunctionsActionOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	GetEdgeFunctionsActionWithContext(ctx context.Context, getEdgeFunctionsActionOptions *GetEdgeFunctionsActionOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	DeleteEdgeFunctionsAction(deleteEdgeFunctionsActionOptions *DeleteEdgeFunctionsActionOptions) (result *DeleteEdgeFunctionsActionResp, response *core.DetailedResponse, err error)
+	DeleteEdgeFunctionsActionWithContext(ctx context.Context, deleteEdgeFunctionsActionOptions *DeleteEdgeFunctionsActionOptions) (result *DeleteEdgeFunctionsActionResp, response *core.DetailedResponse, err error)
+	CreateEdgeFunctionsTrigger(createEdgeFunctionsTriggerOptions *CreateEdgeFunctionsTriggerOptions) (result *CreateEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error)
+	CreateEdgeFunctionsTriggerWithContext(ctx context.Context, createEdgeFunctionsTriggerOptions *CreateEdgeFunctionsTriggerOptions) (result *CreateEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error)
+	ListEdgeFunctionsTriggers(listEdgeFunctionsTriggersOptions *ListEdgeFunctionsTriggersOptions) (result *ListEdgeFunctionsTriggersResp, response *core.DetailedResponse, err error)
+	ListEdgeFunctionsTriggersWithContext(ctx context.Context, listEdgeFunctionsTriggersOptions *ListEdgeFunctionsTriggersOptions) (result *ListEdgeFunctionsTriggersResp, response *core.DetailedResponse, err error)
+	GetEdgeFunctionsTrigger(getEdgeFunctionsTriggerOptions *GetEdgeFunctionsTriggerOptions) (result *GetEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error)
+	GetEdgeFunctionsTriggerWithContext(ctx context.Context, getEdgeFunctionsTriggerOptions *GetEdgeFunctionsTriggerOptions) (result *GetEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error)
+	UpdateEdgeFunctionsTrigger(updateEdgeFunctionsTriggerOptions *UpdateEdgeFunctionsTriggerOptions) (result *GetEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error)
+	UpdateEdgeFunctionsTriggerWithContext(ctx context.Context, updateEdgeFunctionsTriggerOptions *UpdateEdgeFunctionsTriggerOptions) (result *GetEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error)
+	DeleteEdgeFunctionsTrigger(deleteEdgeFunctionsTriggerOptions *DeleteEdgeFunctionsTriggerOptions) (result *CreateEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error)
+	DeleteEdgeFunctionsTriggerWithContext(ctx context.Context, deleteEdgeFunctionsTriggerOptions *DeleteEdgeFunctionsTriggerOptions) (result *CreateEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error)
+}
+
+// EdgeFunctionsApiV1 implements EdgeFunctionsApiV1API.
+var _ EdgeFunctionsApiV1API = (*EdgeFunctionsApiV1)(nil)
@@ -0,0 +1,559 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakes
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// The default and maximum number of items per page of the paginated lists of the CIS fake.
+	cisDefaultPerPage = 20
+	cisMaxPerPage     = 1000
+
+	// The TTL of DNS records that is automatic.
+	cisAutomaticTTL = 1
+)
+
+// The numeric CIS error codes returned for the errors of the fake.
+var cisErrorCodes = map[string]int{
+	"invalid_request":    1004,
+	"not_found":          7003,
+	"method_not_allowed": 7001,
+	"not_implemented":    7000,
+	"already_exists":     81057,
+	"cname_conflict":     81053,
+	"resource_in_use":    1005,
+}
+
+// The DNS record types, and the ones whose record data is given in the data field instead of content.
+var (
+	cisDnsRecordTypes     = []string{"A", "AAAA", "CAA", "CNAME", "LOC", "MX", "NS", "PTR", "SPF", "SRV", "TXT"}
+	cisDnsRecordDataTypes = []string{"CAA", "LOC", "SRV"}
+)
+
+// CisServer is an in-memory fake of the CIS (Cloud Internet Services) API, served by zonesv1,
+// dnsrecordsv1, firewallrulesv1, filtersv1, firewallaccessrulesv1, zonefirewallaccessrulesv1,
+// useragentblockingrulesv1, zonelockdownv1, zoneratelimitsv1 and pageruleapiv1. Service instances
+// don't need to be created: every CRN designates an instance that exists, and is initially empty.
+// Zones are created in the pending status, and become active when their activation check is requested.
+//
+// Responses use the {success, errors, messages, result, result_info} envelope of CIS, lists honor the
+// page and per_page query parameters, and the filter and order parameters of the DNS records, access
+// rules and page rules lists. Errors are reported with numeric codes modeled on those of CIS.
+type CisServer struct {
+	srv *server
+}
+
+// NewCisServer returns a new, empty fake of the CIS API.
+func NewCisServer() *CisServer {
+	s := &CisServer{srv: newServer(cisEnvelope{})}
+	s.srv.newID = func() string {
+		return strings.Replace(uuid.New().String(), "-", "", -1)
+	}
+
+	s.srv.resources = []*resource{
+		{
+			path:          "/v1/*/zones",
+			paginated:     true,
+			required:      []string{"name"},
+			unique:        []string{"name"},
+			immutable:     []string{"name", "status", "name_servers", "original_name_servers", "original_registrar", "original_dnshost"},
+			updateMethods: []string{http.MethodPatch},
+			deleteStatus:  http.StatusOK,
+			deleteResult:  cisDeleteResult,
+			create:        s.createZone,
+		},
+		{
+			path:          "/v1/*/zones/*/dns_records",
+			paginated:     true,
+			filters:       []string{"type", "name", "content"},
+			orders:        []string{"type", "name", "content", "ttl", "proxied"},
+			required:      []string{"type"},
+			unique:        []string{"name", "type", "content"},
+			updateMethods: []string{http.MethodPut},
+			deleteStatus:  http.StatusOK,
+			deleteResult:  cisDeleteResult,
+			create:        s.createDnsRecord,
+			update:        s.updateDnsRecord,
+		},
+		{
+			path:          "/v1/*/zones/*/firewall/rules",
+			paginated:     true,
+			bulk:          true,
+			required:      []string{"filter.id", "action"},
+			unique:        []string{"filter.id"},
+			updateMethods: []string{http.MethodPut},
+			deleteStatus:  http.StatusOK,
+			deleteResult:  cisDeleteResult,
+			create:        s.createFirewallRule,
+			update:        s.updateFirewallRule,
+			render:        s.renderFirewallRule,
+		},
+		{
+			path:          "/v1/*/zones/*/filters",
+			paginated:     true,
+			bulk:          true,
+			required:      []string{"expression"},
+			updateMethods: []string{http.MethodPut},
+			deleteStatus:  http.StatusOK,
+			deleteResult:  cisDeleteResult,
+			create:        s.createFilter,
+			update:        s.updateFilter,
+			delete:        s.deleteFilter,
+		},
+		{
+			path:          "/v1/*/zones/*/firewall/access_rules/rules",
+			paginated:     true,
+			filters:       []string{"notes", "mode", "configuration.target", "configuration.value"},
+			orders:        []string{"configuration.target", "configuration.value", "mode"},
+			required:      []string{"mode", "configuration.target", "configuration.value"},
+			unique:        []string{"configuration.target", "configuration.value"},
+			immutable:     []string{"configuration", "allowed_modes", "scope"},
+			updateMethods: []string{http.MethodPatch},
+			deleteStatus:  http.StatusOK,
+			deleteResult:  cisDeleteResult,
+			create:        s.createAccessRule,
+			update:        s.updateAccessRule,
+		},
+		{
+			path:          "/v1/*/firewall/access_rules/rules",
+			paginated:     true,
+			filters:       []string{"notes", "mode", "configuration.target", "configuration.value"},
+			orders:        []string{"configuration.target", "configuration.value", "mode"},
+			required:      []string{"mode", "configuration.target", "configuration.value"},
+			unique:        []string{"configuration.target", "configuration.value"},
+			immutable:     []string{"configuration", "allowed_modes", "scope"},
+			updateMethods: []string{http.MethodPatch},
+			deleteStatus:  http.StatusOK,
+			deleteResult:  cisDeleteResult,
+			create:        s.createAccessRule,
+			update:        s.updateAccessRule,
+		},
+		{
+			path:          "/v1/*/zones/*/firewall/ua_rules",
+			paginated:     true,
+			required:      []string{"mode", "configuration.target", "configuration.value"},
+			unique:        []string{"configuration.value"},
+			updateMethods: []string{http.MethodPut},
+			deleteStatus:  http.StatusOK,
+			deleteResult:  cisDeleteResult,
+			create:        s.createUserAgentRule,
+			update:        s.updateUserAgentRule,
+		},
+		{
+			path:          "/v1/*/zones/*/firewall/lockdowns",
+			paginated:     true,
+			required:      []string{"urls", "configurations"},
+			updateMethods: []string{http.MethodPut},
+			deleteStatus:  http.StatusOK,
+			deleteResult:  cisDeleteResult,
+			create:        s.createLockdown,
+			update:        s.updateLockdown,
+		},
+		{
+			path:          "/v1/*/zones/*/rate_limits",
+			paginated:     true,
+			required:      []string{"threshold", "period", "match", "action.mode"},
+			updateMethods: []string{http.MethodPut},
+			deleteStatus:  http.StatusOK,
+			deleteResult:  cisDeleteResult,
+			create:        s.createRateLimit,
+			update:        s.updateRateLimit,
+		},
+		{
+			path:          "/v1/*/zones/*/pagerules",
+			filters:       []string{"status"},
+			orders:        []string{"status", "priority"},
+			required:      []string{"targets", "actions"},
+			updateMethods: []string{http.MethodPatch, http.MethodPut},
+			deleteStatus:  http.StatusOK,
+			deleteResult:  cisDeleteResult,
+			create:        s.createPageRule,
+			update:        s.updatePageRule,
+		},
+	}
+	s.srv.actions = []*action{
+		{method: http.MethodPut, path: "/v1/*/zones/*/activation_check", handler: s.checkZoneActivation},
+	}
+	return s
+}
+
+// ServeHTTP serves a request to the CIS API.
+func (s *CisServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.srv.ServeHTTP(w, r)
+}
+
+func (s *CisServer) createZone(req *request, item object) *apiError {
+	name := strings.ToLower(strings.TrimSuffix(stringValue(item, "name"), "."))
+	if !strings.Contains(name, ".") {
+		return errBadRequest("name %q is not a valid domain name", name)
+	}
+	item["name"] = name
+	item["status"] = "pending"
+	item["paused"] = false
+	item["name_servers"] = []string{"ns1.fake.cis.cloud.ibm.com", "ns2.fake.cis.cloud.ibm.com"}
+	return nil
+}
+
+// checkZoneActivation activates a zone.
+func (s *CisServer) checkZoneActivation(req *request) (int, interface{}, *apiError) {
+	zone, ok := s.srv.get("/v1/" + req.params[0] + "/zones/" + req.params[1])
+	if !ok {
+		return 0, nil, errNotFound("zone %s was not found", req.params[1])
+	}
+	zone["status"] = "active"
+	zone["modified_on"] = s.srv.timestamp()
+	return http.StatusOK, object{"id": zone["id"]}, nil
+}
+
+func (s *CisServer) createDnsRecord(req *request, item object) *apiError {
+	setDefaults(item, object{"ttl": cisAutomaticTTL, "proxied": false})
+	return s.completeDnsRecord(req, item)
+}
+
+func (s *CisServer) updateDnsRecord(req *request, item object, changes object) *apiError {
+	return s.completeDnsRecord(req, item)
+}
+
+// completeDnsRecord validates a DNS record, and sets its fields derived from the others.
+func (s *CisServer) completeDnsRecord(req *request, item object) *apiError {
+	recordType := strings.ToUpper(stringValue(item, "type"))
+	item["type"] = recordType
+	if !containsString(cisDnsRecordTypes, recordType) {
+		return errBadRequest("type %q is not one of %s", recordType, strings.Join(cisDnsRecordTypes, ", "))
+	}
+	zoneName := stringValue(req.parent, "name")
+	name := stringValue(item, "name")
+	if containsString(cisDnsRecordDataTypes, recordType) {
+		data, ok := item["data"].(map[string]interface{})
+		if !ok {
+			return errBadRequest("data is required for %s records", recordType)
+		}
+		item["content"] = cisDnsRecordContent(recordType, data)
+		if recordType == "SRV" {
+			item["priority"] = data["priority"]
+			if name == "" {
+				name = fmt.Sprintf("%v.%v.%v", data["service"], data["proto"], data["name"])
+			}
+		}
+	} else if stringValue(item, "content") == "" {
+		return errBadRequest("content is required for %s records", recordType)
+	}
+	if name == "" {
+		return errBadRequest("name is required")
+	}
+	item["name"] = qualifiedName(name, zoneName)
+	item["zone_id"] = req.parent["id"]
+	item["zone_name"] = zoneName
+
+	switch recordType {
+	case "A", "AAAA":
+		ip := net.ParseIP(stringValue(item, "content"))
+		if ip == nil || (ip.To4() != nil) != (recordType == "A") {
+			return errBadRequest("content %q is not a valid address for %s records", item["content"], recordType)
+		}
+	case "MX":
+		if item["priority"] == nil {
+			return errBadRequest("priority is required for MX records")
+		}
+	}
+	proxiable := recordType == "A" || recordType == "AAAA" || recordType == "CNAME"
+	item["proxiable"] = proxiable
+	if item["proxied"] == true {
+		if !proxiable {
+			return errBadRequest("%s records can't be proxied", recordType)
+		}
+		item["ttl"] = cisAutomaticTTL
+	}
+
+	for _, other := range s.srv.list(req.collectionPath) {
+		if other["id"] != item["id"] && equalValues(other["name"], item["name"]) &&
+			(recordType == "CNAME" || other["type"] == "CNAME") && !(other["type"] == recordType && equalValues(other["content"], item["content"])) {
+			return newAPIError(http.StatusBadRequest, "cname_conflict", "a CNAME record can't share its name %s with other records", item["name"])
+		}
+	}
+	return nil
+}
+
+// cisDnsRecordContent returns the content of a record whose data is given in the data field.
+func cisDnsRecordContent(recordType string, data object) string {
+	switch recordType {
+	case "CAA":
+		return fmt.Sprintf("%v %v %q", data["flags"], data["tag"], data["value"])
+	case "SRV":
+		return fmt.Sprintf("%v %v %v", data["weight"], data["port"], data["target"])
+	}
+	var fields []string
+	for _, field := range []string{"lat_degrees", "lat_minutes", "lat_seconds", "lat_direction", "long_degrees", "long_minutes", "long_seconds", "long_direction"} {
+		fields = append(fields, fmt.Sprint(data[field]))
+	}
+	for _, field := range []string{"altitude", "size", "precision_horz", "precision_vert"} {
+		fields = append(fields, fmt.Sprint(data[field])+"m")
+	}
+	return strings.Join(fields, " ")
+}
+
+func (s *CisServer) createFirewallRule(req *request, item object) *apiError {
+	setDefaults(item, object{"paused": false, "description": ""})
+	return s.checkFirewallRule(req, item)
+}
+
+func (s *CisServer) updateFirewallRule(req *request, item object, changes object) *apiError {
+	return s.checkFirewallRule(req, item)
+}
+
+func (s *CisServer) checkFirewallRule(req *request, item object) *apiError {
+	switch item["action"] {
+	case "allow", "block", "challenge", "js_challenge", "log":
+	default:
+		return errBadRequest("action %q is not one of allow, block, challenge, js_challenge and log", item["action"])
+	}
+	filterID := stringValue(item, "filter.id")
+	if _, ok := s.srv.get(cisZonePath(req.collectionPath) + "/filters/" + filterID); !ok {
+		return errBadRequest("filter %s was not found", filterID)
+	}
+	item["filter"] = object{"id": filterID}
+	return nil
+}
+
+// renderFirewallRule returns a firewall rule with its filter.
+func (s *CisServer) renderFirewallRule(itemPath string, item object) object {
+	rendered := object{}
+	for key, value := range item {
+		rendered[key] = value
+	}
+	if filter, ok := s.srv.get(cisZonePath(itemPath) + "/filters/" + stringValue(item, "filter.id")); ok {
+		rendered["filter"] = object{
+			"id":          filter["id"],
+			"paused":      filter["paused"],
+			"description": filter["description"],
+			"expression":  filter["expression"],
+		}
+	}
+	return rendered
+}
+
+func (s *CisServer) createFilter(req *request, item object) *apiError {
+	setDefaults(item, object{"paused": false, "description": ""})
+	return nil
+}
+
+func (s *CisServer) updateFilter(req *request, item object, changes object) *apiError {
+	if isEmpty(item["expression"]) {
+		return errBadRequest("expression is required")
+	}
+	return nil
+}
+
+func (s *CisServer) deleteFilter(req *request, item object) *apiError {
+	for _, rule := range s.srv.list(cisZonePath(req.collectionPath) + "/firewall/rules") {
+		if stringValue(rule, "filter.id") == item["id"] {
+			return errInUse("filter %s is used by firewall rule %s", item["id"], rule["id"])
+		}
+	}
+	return nil
+}
+
+func (s *CisServer) createAccessRule(req *request, item object) *apiError {
+	switch target := stringValue(item, "configuration.target"); target {
+	case "ip", "ip_range", "asn", "country":
+	default:
+		return errBadRequest("configuration.target %q is not one of ip, ip_range, asn and country", target)
+	}
+	setDefaults(item, object{"notes": ""})
+	item["allowed_modes"] = []string{"block", "challenge", "whitelist", "js_challenge"}
+	if req.parent != nil {
+		item["scope"] = object{"type": "zone"}
+	} else {
+		item["scope"] = object{"type": "account"}
+	}
+	return s.checkAccessRuleMode(item)
+}
+
+func (s *CisServer) updateAccessRule(req *request, item object, changes object) *apiError {
+	return s.checkAccessRuleMode(item)
+}
+
+func (s *CisServer) checkAccessRuleMode(item object) *apiError {
+	switch item["mode"] {
+	case "block", "challenge", "whitelist", "js_challenge":
+		return nil
+	}
+	return errBadRequest("mode %q is not one of block, challenge, whitelist and js_challenge", item["mode"])
+}
+
+func (s *CisServer) createUserAgentRule(req *request, item object) *apiError {
+	setDefaults(item, object{"paused": false, "description": ""})
+	return s.updateUserAgentRule(req, item, nil)
+}
+
+func (s *CisServer) updateUserAgentRule(req *request, item object, changes object) *apiError {
+	if target := stringValue(item, "configuration.target"); target != "ua" {
+		return errBadRequest("configuration.target %q is not ua", target)
+	}
+	switch item["mode"] {
+	case "block", "challenge", "js_challenge":
+		return nil
+	}
+	return errBadRequest("mode %q is not one of block, challenge and js_challenge", item["mode"])
+}
+
+func (s *CisServer) createLockdown(req *request, item object) *apiError {
+	setDefaults(item, object{"paused": false, "description": ""})
+	return s.updateLockdown(req, item, nil)
+}
+
+func (s *CisServer) updateLockdown(req *request, item object, changes object) *apiError {
+	configurations, ok := item["configurations"].([]interface{})
+	if !ok {
+		return errBadRequest("configurations is not an array")
+	}
+	for i, value := range configurations {
+		configuration, ok := value.(map[string]interface{})
+		if !ok {
+			return errBadRequest("configurations[%d] is not an object", i)
+		}
+		switch target := stringValue(configuration, "target"); target {
+		case "ip", "ip_range":
+		default:
+			return errBadRequest("configurations[%d].target %q is not one of ip and ip_range", i, target)
+		}
+	}
+	return nil
+}
+
+func (s *CisServer) createRateLimit(req *request, item object) *apiError {
+	setDefaults(item, object{"disabled": false, "description": "", "bypass": []interface{}{}})
+	return s.updateRateLimit(req, item, nil)
+}
+
+func (s *CisServer) updateRateLimit(req *request, item object, changes object) *apiError {
+	switch mode := stringValue(item, "action.mode"); mode {
+	case "simulate", "ban", "challenge", "js_challenge":
+	default:
+		return errBadRequest("action.mode %q is not one of simulate, ban, challenge and js_challenge", mode)
+	}
+	return nil
+}
+
+func (s *CisServer) createPageRule(req *request, item object) *apiError {
+	setDefaults(item, object{"status": "disabled", "priority": 1})
+	return s.updatePageRule(req, item, nil)
+}
+
+func (s *CisServer) updatePageRule(req *request, item object, changes object) *apiError {
+	switch item["status"] {
+	case "active", "disabled":
+		return nil
+	}
+	return errBadRequest("status %q is not one of active and disabled", item["status"])
+}
+
+// cisZonePath returns the path of the zone of a path below it.
+func cisZonePath(path string) string {
+	return "/" + strings.Join(splitPath(path)[:4], "/")
+}
+
+func cisDeleteResult(item object) interface{} {
+	return object{"id": item["id"]}
+}
+
+// cisEnvelope writes responses in the format of CIS.
+type cisEnvelope struct{}
+
+func (cisEnvelope) writeResult(w http.ResponseWriter, r *http.Request, status int, result interface{}) {
+	w.Header().Set("CF-Ray", cisRay())
+	writeJSON(w, status, object{"success": true, "errors": []interface{}{}, "messages": []interface{}{}, "result": result})
+}
+
+func (env cisEnvelope) writeList(w http.ResponseWriter, r *http.Request, res *resource, items []object) {
+	if items == nil {
+		items = []object{}
+	}
+	body := object{"success": true, "errors": []interface{}{}, "messages": []interface{}{}}
+	if res.paginated {
+		page, perPage, err := cisPage(r)
+		if err != nil {
+			env.writeError(w, r, err)
+			return
+		}
+		total := len(items)
+		if offset := (page - 1) * perPage; offset < total {
+			items = items[offset:]
+		} else {
+			items = items[:0]
+		}
+		if len(items) > perPage {
+			items = items[:perPage]
+		}
+		body["result_info"] = object{
+			"page":        page,
+			"per_page":    perPage,
+			"count":       len(items),
+			"total_count": total,
+			"total_pages": int(math.Ceil(float64(total) / float64(perPage))),
+		}
+	}
+	body["result"] = items
+	w.Header().Set("CF-Ray", cisRay())
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (cisEnvelope) writeError(w http.ResponseWriter, r *http.Request, err *apiError) {
+	code, ok := cisErrorCodes[err.code]
+	if !ok {
+		code = 1000
+	}
+	w.Header().Set("CF-Ray", cisRay())
+	writeJSON(w, err.status, object{
+		"success":  false,
+		"errors":   []object{{"code": code, "message": err.message}},
+		"messages": []interface{}{},
+		"result":   nil,
+	})
+}
+
+// cisPage returns the page and per_page query parameters of a list request.
+func cisPage(r *http.Request) (page int, perPage int, err *apiError) {
+	page, perPage = 1, cisDefaultPerPage
+	query := r.URL.Query()
+	var convErr error
+	if value := query.Get("page"); value != "" {
+		if page, convErr = strconv.Atoi(value); convErr != nil || page < 1 {
+			return 0, 0, errBadRequest("page %q is not a positive integer", value)
+		}
+	}
+	if value := query.Get("per_page"); value != "" {
+		if perPage, convErr = strconv.Atoi(value); convErr != nil || perPage < 1 || perPage > cisMaxPerPage {
+			return 0, 0, errBadRequest("per_page %q is not an integer between 1 and %d", value, cisMaxPerPage)
+		}
+	}
+	return
+}
+
+// cisRay returns a new ray ID, which identifies a request to CIS.
+func cisRay() string {
+	return strings.Replace(uuid.New().String(), "-", "", -1)[:16] + "-FAKE"
+}
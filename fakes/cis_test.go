@@ -0,0 +1,439 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakes
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/IBM/networking-go-sdk/firewallaccessrulesv1"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/pageruleapiv1"
+	"github.com/IBM/networking-go-sdk/useragentblockingrulesv1"
+	"github.com/IBM/networking-go-sdk/zonefirewallaccessrulesv1"
+	"github.com/IBM/networking-go-sdk/zonelockdownv1"
+	"github.com/IBM/networking-go-sdk/zoneratelimitsv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cisCrn = "crn:v1:bluemix:public:internet-svcs:global:a/fake-account:instance-1::"
+
+// newCis returns the URL of a new fake, and a zone created in it.
+func newCis(t *testing.T) (url string, zone *zonesv1.ZoneDetails) {
+	server := httptest.NewServer(NewCisServer())
+	t.Cleanup(server.Close)
+	service, err := zonesv1.NewZonesV1(&zonesv1.ZonesV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Crn:           core.StringPtr(cisCrn),
+	})
+	require.Nil(t, err)
+	result, _, err := service.CreateZone(service.NewCreateZoneOptions().SetName("example.com"))
+	require.Nil(t, err)
+	return server.URL, result.Result
+}
+
+func TestCisServerZones(t *testing.T) {
+	url, zone := newCis(t)
+	service, _ := zonesv1.NewZonesV1(&zonesv1.ZonesV1Options{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Crn:           core.StringPtr(cisCrn),
+	})
+	assert.Equal(t, "pending", *zone.Status)
+	assert.Len(t, *zone.ID, 32)
+
+	_, response, err := service.CreateZone(service.NewCreateZoneOptions().SetName("Example.com"))
+	assert.True(t, common.IsConflict(err))
+	apiErr := common.AsAPIError(err)
+	require.NotNil(t, apiErr)
+	assert.True(t, apiErr.HasCode("81057"))
+	assert.NotEmpty(t, apiErr.Trace)
+	assert.Equal(t, 409, response.StatusCode)
+
+	for i := 2; i <= 5; i++ {
+		_, _, err = service.CreateZone(service.NewCreateZoneOptions().SetName(fmt.Sprintf("example-%d.com", i)))
+		require.Nil(t, err)
+	}
+	list, _, err := service.ListZones(service.NewListZonesOptions().SetPage(3).SetPerPage(2))
+	require.Nil(t, err)
+	require.Len(t, list.Result, 1)
+	assert.Equal(t, "example-5.com", *list.Result[0].Name)
+	assert.Equal(t, int64(5), *list.ResultInfo.TotalCount)
+	_, _, err = service.ListZones(service.NewListZonesOptions().SetPerPage(1001))
+	assert.Equal(t, 400, common.AsAPIError(err).StatusCode)
+
+	_, _, err = service.ZoneActivationCheck(service.NewZoneActivationCheckOptions(*zone.ID))
+	require.Nil(t, err)
+	updated, _, err := service.UpdateZone(service.NewUpdateZoneOptions(*zone.ID).SetPaused(true))
+	require.Nil(t, err)
+	assert.Equal(t, "active", *updated.Result.Status)
+	assert.True(t, *updated.Result.Paused)
+
+	_, _, err = service.DeleteZone(service.NewDeleteZoneOptions(*zone.ID))
+	require.Nil(t, err)
+	_, _, err = service.GetZone(service.NewGetZoneOptions(*zone.ID))
+	assert.True(t, common.IsNotFound(err))
+}
+
+func TestCisServerDnsRecords(t *testing.T) {
+	url, zone := newCis(t)
+	service, err := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+		URL:            url,
+		Authenticator:  &core.NoAuthAuthenticator{},
+		Crn:            core.StringPtr(cisCrn),
+		ZoneIdentifier: zone.ID,
+	})
+	require.Nil(t, err)
+
+	for i := 1; i <= 25; i++ {
+		record, _, err := service.CreateDnsRecord(service.NewCreateDnsRecordOptions().
+			SetType(dnsrecordsv1.CreateDnsRecordOptions_Type_A).SetName(fmt.Sprintf("host-%02d", i)).SetContent(fmt.Sprintf("10.0.0.%d", i)))
+		require.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("host-%02d.example.com", i), *record.Result.Name)
+		assert.Equal(t, int64(1), *record.Result.TTL)
+		assert.True(t, *record.Result.Proxiable)
+	}
+	list, _, err := service.ListAllDnsRecords(service.NewListAllDnsRecordsOptions())
+	require.Nil(t, err)
+	assert.Len(t, list.Result, 20)
+	assert.Equal(t, int64(25), *list.ResultInfo.TotalCount)
+
+	pager, err := service.NewDnsRecordsPager(service.NewListAllDnsRecordsOptions().SetPerPage(10).SetOrder("name").SetDirection("desc"))
+	require.Nil(t, err)
+	records, err := pager.GetAll()
+	require.Nil(t, err)
+	require.Len(t, records, 25)
+	assert.Equal(t, "host-25.example.com", *records[0].Name)
+
+	list, _, err = service.ListAllDnsRecords(service.NewListAllDnsRecordsOptions().SetContent("10.0.0.7"))
+	require.Nil(t, err)
+	require.Len(t, list.Result, 1)
+	record := list.Result[0]
+
+	_, _, err = service.CreateDnsRecord(service.NewCreateDnsRecordOptions().
+		SetType(dnsrecordsv1.CreateDnsRecordOptions_Type_Cname).SetName("host-07").SetContent("www.example.org"))
+	apiErr := common.AsAPIError(err)
+	require.NotNil(t, apiErr)
+	assert.True(t, apiErr.HasCode("81053"))
+	_, _, err = service.CreateDnsRecord(service.NewCreateDnsRecordOptions().
+		SetType(dnsrecordsv1.CreateDnsRecordOptions_Type_A).SetName("host-08").SetContent("10.0.0.256"))
+	assert.Equal(t, 400, common.AsAPIError(err).StatusCode)
+
+	updated, _, err := service.UpdateDnsRecord(service.NewUpdateDnsRecordOptions(*record.ID).
+		SetType(dnsrecordsv1.UpdateDnsRecordOptions_Type_A).SetName("host-07").SetContent("10.0.1.7").SetTTL(300))
+	require.Nil(t, err)
+	assert.Equal(t, "10.0.1.7", *updated.Result.Content)
+	assert.Equal(t, int64(300), *updated.Result.TTL)
+
+	srv, _, err := service.CreateDnsRecord(service.NewCreateDnsRecordOptions().
+		SetType(dnsrecordsv1.CreateDnsRecordOptions_Type_Srv).SetData(map[string]interface{}{
+		"service": "_sip", "proto": "_udp", "name": "example.com", "priority": 10, "weight": 5, "port": 5060, "target": "sip.example.com",
+	}))
+	require.Nil(t, err)
+	assert.Equal(t, "_sip._udp.example.com", *srv.Result.Name)
+	assert.Equal(t, "5 5060 sip.example.com", *srv.Result.Content)
+	assert.Equal(t, int64(10), *srv.Result.Priority)
+
+	_, _, err = service.DeleteDnsRecord(service.NewDeleteDnsRecordOptions(*record.ID))
+	require.Nil(t, err)
+	_, _, err = service.GetDnsRecord(service.NewGetDnsRecordOptions(*record.ID))
+	assert.True(t, common.IsNotFound(err))
+}
+
+func TestCisServerFirewallRules(t *testing.T) {
+	url, zone := newCis(t)
+	filters, err := filtersv1.NewFiltersV1(&filtersv1.FiltersV1Options{URL: url, Authenticator: &core.NoAuthAuthenticator{}})
+	require.Nil(t, err)
+	rules, err := firewallrulesv1.NewFirewallRulesV1(&firewallrulesv1.FirewallRulesV1Options{URL: url, Authenticator: &core.NoAuthAuthenticator{}})
+	require.Nil(t, err)
+
+	var inputs []filtersv1.FilterInput
+	for _, expression := range []string{`ip.src eq 10.0.0.1`, `http.host eq "example.com"`} {
+		input, _ := filters.NewFilterInput(expression)
+		inputs = append(inputs, *input)
+	}
+	created, _, err := filters.CreateFilter(filters.NewCreateFilterOptions("token", cisCrn, *zone.ID).SetFilterInput(inputs))
+	require.Nil(t, err)
+	require.Len(t, created.Result, 2)
+	filterID := *created.Result[0].ID
+
+	filter, _ := rules.NewFirewallRuleInputWithFilterIdFilter(filterID)
+	input, _ := rules.NewFirewallRuleInputWithFilterID(filter, firewallrulesv1.FirewallRuleInputWithFilterID_Action_Block)
+	createdRules, _, err := rules.CreateFirewallRules(rules.NewCreateFirewallRulesOptions("token", cisCrn, *zone.ID).
+		SetFirewallRuleInputWithFilterID([]firewallrulesv1.FirewallRuleInputWithFilterID{*input}))
+	require.Nil(t, err)
+	require.Len(t, createdRules.Result, 1)
+	rule := createdRules.Result[0]
+	assert.Equal(t, `ip.src eq 10.0.0.1`, *rule.Filter.Expression)
+	_, _, err = rules.CreateFirewallRules(rules.NewCreateFirewallRulesOptions("token", cisCrn, *zone.ID).
+		SetFirewallRuleInputWithFilterID([]firewallrulesv1.FirewallRuleInputWithFilterID{*input}))
+	assert.True(t, common.IsConflict(err))
+
+	missing, _ := rules.NewFirewallRuleInputWithFilterIdFilter("missing")
+	input.Filter = missing
+	_, _, err = rules.CreateFirewallRules(rules.NewCreateFirewallRulesOptions("token", cisCrn, *zone.ID).
+		SetFirewallRuleInputWithFilterID([]firewallrulesv1.FirewallRuleInputWithFilterID{*input}))
+	assert.Equal(t, 400, common.AsAPIError(err).StatusCode)
+
+	_, _, err = filters.DeleteFilter(filters.NewDeleteFilterOptions("token", cisCrn, *zone.ID, filterID))
+	assert.True(t, common.IsConflict(err))
+	_, _, err = rules.DeleteFirewallRules(rules.NewDeleteFirewallRulesOptions("token", cisCrn, *zone.ID, *rule.ID))
+	require.Nil(t, err)
+	deleted, _, err := filters.DeleteFilters(filters.NewDeleteFiltersOptions("token", cisCrn, *zone.ID,
+		*created.Result[0].ID+","+*created.Result[1].ID))
+	require.Nil(t, err)
+	assert.Len(t, deleted.Result, 2)
+	list, _, err := filters.ListAllFilters(filters.NewListAllFiltersOptions("token", cisCrn, *zone.ID))
+	require.Nil(t, err)
+	assert.Empty(t, list.Result)
+}
+
+func TestCisServerAccessRules(t *testing.T) {
+	url, zone := newCis(t)
+	service, err := zonefirewallaccessrulesv1.NewZoneFirewallAccessRulesV1(&zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1Options{
+		URL:            url,
+		Authenticator:  &core.NoAuthAuthenticator{},
+		Crn:            core.StringPtr(cisCrn),
+		ZoneIdentifier: zone.ID,
+	})
+	require.Nil(t, err)
+
+	configuration, _ := service.NewZoneAccessRuleInputConfiguration("ip", "10.0.0.1")
+	options := service.NewCreateZoneAccessRuleOptions().SetMode("block").SetConfiguration(configuration)
+	created, _, err := service.CreateZoneAccessRule(options)
+	require.Nil(t, err)
+	assert.Equal(t, "zone", *created.Result.Scope.Type)
+	_, _, err = service.CreateZoneAccessRule(options)
+	assert.True(t, common.IsConflict(err))
+
+	updated, _, err := service.UpdateZoneAccessRule(service.NewUpdateZoneAccessRuleOptions(*created.Result.ID).SetMode("challenge").SetNotes("updated"))
+	require.Nil(t, err)
+	assert.Equal(t, "challenge", *updated.Result.Mode)
+	assert.Equal(t, "10.0.0.1", *updated.Result.Configuration.Value)
+
+	list, _, err := service.ListAllZoneAccessRules(service.NewListAllZoneAccessRulesOptions().SetMode("challenge"))
+	require.Nil(t, err)
+	assert.Len(t, list.Result, 1)
+}
+
+func TestCisServerAccountAccessRules(t *testing.T) {
+	url, _ := newCis(t)
+	service, err := firewallaccessrulesv1.NewFirewallAccessRulesV1(&firewallaccessrulesv1.FirewallAccessRulesV1Options{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Crn:           core.StringPtr(cisCrn),
+	})
+	require.Nil(t, err)
+
+	configuration, _ := service.NewAccountAccessRuleInputConfiguration("ip_range", "10.0.0.0/24")
+	options := service.NewCreateAccountAccessRuleOptions().SetMode("block").SetNotes("deny-list").SetConfiguration(configuration)
+	created, _, err := service.CreateAccountAccessRule(options)
+	require.Nil(t, err)
+	assert.Equal(t, "account", *created.Result.Scope.Type)
+	_, _, err = service.CreateAccountAccessRule(options)
+	assert.True(t, common.IsConflict(err))
+
+	list, _, err := service.ListAllAccountAccessRules(service.NewListAllAccountAccessRulesOptions().SetNotes("deny-list"))
+	require.Nil(t, err)
+	assert.Len(t, list.Result, 1)
+
+	_, _, err = service.DeleteAccountAccessRule(service.NewDeleteAccountAccessRuleOptions(*created.Result.ID))
+	require.Nil(t, err)
+	_, _, err = service.GetAccountAccessRule(service.NewGetAccountAccessRuleOptions(*created.Result.ID))
+	assert.True(t, common.IsNotFound(err))
+}
+
+func TestCisServerUserAgentRules(t *testing.T) {
+	url, zone := newCis(t)
+	service, err := useragentblockingrulesv1.NewUserAgentBlockingRulesV1(&useragentblockingrulesv1.UserAgentBlockingRulesV1Options{
+		URL:            url,
+		Authenticator:  &core.NoAuthAuthenticator{},
+		Crn:            core.StringPtr(cisCrn),
+		ZoneIdentifier: zone.ID,
+	})
+	require.Nil(t, err)
+
+	configuration, _ := service.NewUseragentRuleInputConfiguration("ua", "BadBot/1.0")
+	options := service.NewCreateZoneUserAgentRuleOptions().SetMode("block").SetConfiguration(configuration)
+	created, _, err := service.CreateZoneUserAgentRule(options)
+	require.Nil(t, err)
+	assert.False(t, *created.Result.Paused)
+	_, _, err = service.CreateZoneUserAgentRule(options)
+	assert.True(t, common.IsConflict(err))
+
+	_, response, err := service.UpdateUserAgentRule(service.NewUpdateUserAgentRuleOptions(*created.Result.ID).
+		SetMode("allow").SetConfiguration(configuration))
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+}
+
+func TestCisServerLockdowns(t *testing.T) {
+	serverURL, zone := newCis(t)
+	service, err := zonelockdownv1.NewZoneLockdownV1(&zonelockdownv1.ZoneLockdownV1Options{
+		URL:            serverURL,
+		Authenticator:  &core.NoAuthAuthenticator{},
+		Crn:            core.StringPtr(cisCrn),
+		ZoneIdentifier: zone.ID,
+	})
+	require.Nil(t, err)
+
+	configuration, _ := service.NewLockdownInputConfigurationsItem("ip", "10.0.0.1")
+	created, _, err := service.CreateZoneLockdownRule(service.NewCreateZoneLockdownRuleOptions().
+		SetUrls([]string{"example.com/admin"}).SetConfigurations([]zonelockdownv1.LockdownInputConfigurationsItem{*configuration}))
+	require.Nil(t, err)
+	assert.False(t, *created.Result.Paused)
+	assert.Equal(t, "", *created.Result.Description)
+
+	updated, _, err := service.UpdateLockdownRule(service.NewUpdateLockdownRuleOptions(*created.Result.ID).
+		SetUrls([]string{"example.com/admin/*"}).SetConfigurations([]zonelockdownv1.LockdownInputConfigurationsItem{*configuration}).
+		SetPaused(true))
+	require.Nil(t, err)
+	assert.True(t, *updated.Result.Paused)
+	assert.Equal(t, []string{"example.com/admin/*"}, updated.Result.Urls)
+
+	invalid, _ := service.NewLockdownInputConfigurationsItem("asn", "AS13335")
+	_, response, err := service.CreateZoneLockdownRule(service.NewCreateZoneLockdownRuleOptions().
+		SetUrls([]string{"example.com/"}).SetConfigurations([]zonelockdownv1.LockdownInputConfigurationsItem{*invalid}))
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+
+	// Configurations that are not objects are rejected rather than crashing the handler.
+	path := "/v1/" + url.PathEscape(cisCrn) + "/zones/" + *zone.ID + "/firewall/lockdowns"
+	for _, body := range []string{`{"urls": ["example.com/"], "configurations": ["x"]}`, `{"urls": ["example.com/"], "configurations": "x"}`} {
+		resp, err := http.Post(serverURL+path, "application/json", strings.NewReader(body))
+		require.Nil(t, err)
+		resp.Body.Close()
+		assert.Equal(t, 400, resp.StatusCode, body)
+	}
+
+	list, _, err := service.ListAllZoneLockownRules(service.NewListAllZoneLockownRulesOptions())
+	require.Nil(t, err)
+	assert.Len(t, list.Result, 1)
+	_, _, err = service.DeleteZoneLockdownRule(service.NewDeleteZoneLockdownRuleOptions(*created.Result.ID))
+	require.Nil(t, err)
+	_, _, err = service.GetLockdown(service.NewGetLockdownOptions(*created.Result.ID))
+	assert.True(t, common.IsNotFound(err))
+}
+
+func TestCisServerRateLimits(t *testing.T) {
+	serverURL, zone := newCis(t)
+	service, err := zoneratelimitsv1.NewZoneRateLimitsV1(&zoneratelimitsv1.ZoneRateLimitsV1Options{
+		URL:            serverURL,
+		Authenticator:  &core.NoAuthAuthenticator{},
+		Crn:            core.StringPtr(cisCrn),
+		ZoneIdentifier: zone.ID,
+	})
+	require.Nil(t, err)
+
+	action, _ := service.NewRatelimitInputAction("ban")
+	match := &zoneratelimitsv1.RatelimitInputMatch{Request: &zoneratelimitsv1.RatelimitInputMatchRequest{URL: core.StringPtr("example.com/login")}}
+	created, _, err := service.CreateZoneRateLimits(service.NewCreateZoneRateLimitsOptions().
+		SetThreshold(10).SetPeriod(60).SetAction(action).SetMatch(match))
+	require.Nil(t, err)
+	assert.False(t, *created.Result.Disabled)
+	assert.Empty(t, created.Result.Bypass)
+
+	updated, _, err := service.UpdateRateLimit(service.NewUpdateRateLimitOptions(*created.Result.ID).
+		SetThreshold(20).SetPeriod(60).SetAction(action).SetMatch(match).SetDisabled(true))
+	require.Nil(t, err)
+	assert.Equal(t, int64(20), *updated.Result.Threshold)
+	assert.True(t, *updated.Result.Disabled)
+
+	invalid, _ := service.NewRatelimitInputAction("allow")
+	_, response, err := service.UpdateRateLimit(service.NewUpdateRateLimitOptions(*created.Result.ID).
+		SetThreshold(20).SetPeriod(60).SetAction(invalid).SetMatch(match))
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+	_, response, err = service.CreateZoneRateLimits(service.NewCreateZoneRateLimitsOptions().SetThreshold(10).SetPeriod(60).SetMatch(match))
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+
+	list, _, err := service.ListAllZoneRateLimits(service.NewListAllZoneRateLimitsOptions())
+	require.Nil(t, err)
+	assert.Len(t, list.Result, 1)
+	_, _, err = service.DeleteZoneRateLimit(service.NewDeleteZoneRateLimitOptions(*created.Result.ID))
+	require.Nil(t, err)
+	_, _, err = service.GetRateLimit(service.NewGetRateLimitOptions(*created.Result.ID))
+	assert.True(t, common.IsNotFound(err))
+}
+
+func TestCisServerPageRules(t *testing.T) {
+	serverURL, zone := newCis(t)
+	service, err := pageruleapiv1.NewPageRuleApiV1(&pageruleapiv1.PageRuleApiV1Options{
+		URL:           serverURL,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Crn:           core.StringPtr(cisCrn),
+		ZoneID:        zone.ID,
+	})
+	require.Nil(t, err)
+
+	constraint, _ := service.NewTargetsItemConstraint("matches", "example.com/images/*")
+	target, _ := service.NewTargetsItem("url", constraint)
+	targets := []pageruleapiv1.TargetsItem{*target}
+	actions := []pageruleapiv1.PageRulesBodyActionsItemIntf{&pageruleapiv1.PageRulesBodyActionsItemActionsSecurityLevel{
+		ID:    core.StringPtr("security_level"),
+		Value: core.StringPtr("high"),
+	}}
+	created, _, err := service.CreatePageRule(service.NewCreatePageRuleOptions().SetTargets(targets).SetActions(actions))
+	require.Nil(t, err)
+	assert.Equal(t, "disabled", *created.Result.Status)
+	assert.Equal(t, int64(1), *created.Result.Priority)
+	second, _, err := service.CreatePageRule(service.NewCreatePageRuleOptions().SetTargets(targets).SetActions(actions).
+		SetStatus("active").SetPriority(2))
+	require.Nil(t, err)
+
+	changed, _, err := service.ChangePageRule(service.NewChangePageRuleOptions(*created.Result.ID).SetStatus("active"))
+	require.Nil(t, err)
+	assert.Equal(t, "active", *changed.Result.Status)
+	assert.Len(t, changed.Result.Targets, 1)
+	updated, _, err := service.UpdatePageRule(service.NewUpdatePageRuleOptions(*second.Result.ID).
+		SetTargets(targets).SetActions(actions).SetStatus("disabled").SetPriority(3))
+	require.Nil(t, err)
+	assert.Equal(t, "disabled", *updated.Result.Status)
+
+	_, response, err := service.ChangePageRule(service.NewChangePageRuleOptions(*created.Result.ID).SetStatus("paused"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+	_, response, err = service.CreatePageRule(service.NewCreatePageRuleOptions().SetTargets(targets))
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+
+	list, _, err := service.ListPageRules(service.NewListPageRulesOptions().SetStatus("active"))
+	require.Nil(t, err)
+	require.Len(t, list.Result, 1)
+	assert.Equal(t, *created.Result.ID, *list.Result[0].ID)
+	list, _, err = service.ListPageRules(service.NewListPageRulesOptions().SetOrder("priority").SetDirection("desc"))
+	require.Nil(t, err)
+	require.Len(t, list.Result, 2)
+	assert.Equal(t, *second.Result.ID, *list.Result[0].ID)
+
+	_, _, err = service.DeletePageRule(service.NewDeletePageRuleOptions(*created.Result.ID))
+	require.Nil(t, err)
+	_, _, err = service.GetPageRule(service.NewGetPageRuleOptions(*created.Result.ID))
+	assert.True(t, common.IsNotFound(err))
+}
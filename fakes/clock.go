@@ -0,0 +1,134 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakes
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is a clock that only moves when it is told to. Set it as the clock of a fake whose resources
+// move through asynchronous states, to control when their transitions take place:
+//
+//   clock := fakes.NewClock(time.Now())
+//   fake := fakes.NewTransitGatewayServer()
+//   fake.SetClock(clock)
+//   fake.SetTransitionDelay(time.Minute)
+//
+//   // Create a transit gateway: it is "pending".
+//   clock.Advance(time.Minute)
+//   // It is now "available".
+//
+// A Clock is safe for concurrent use.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock returns a clock stopped at the given time.
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// Now returns the time of the clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// transition is a change of a resource that takes place at a given time.
+type transition struct {
+	at       time.Time
+	itemPath string
+
+	// The changes applied to the resource, or nil to remove it.
+	changes object
+}
+
+// setClock makes the server take the time from a clock, or from the system if clock is nil.
+func (s *server) setClock(clock *Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if clock == nil {
+		s.now = time.Now
+	} else {
+		s.now = clock.Now
+	}
+}
+
+// setDelay sets the delay after which the transitions scheduled from now on take place.
+func (s *server) setDelay(delay time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delay = delay
+}
+
+// schedule schedules changes of the resource at a path, the first after the transition delay and each
+// of the others one delay after the previous one. Changes that are nil remove the resource.
+func (s *server) schedule(itemPath string, steps ...object) {
+	at := s.now()
+	for _, changes := range steps {
+		at = at.Add(s.delay)
+		s.transitions = append(s.transitions, &transition{at: at, itemPath: itemPath, changes: changes})
+	}
+}
+
+// unschedule cancels the transitions of the resource at a path that haven't taken place yet.
+func (s *server) unschedule(itemPath string) {
+	var transitions []*transition
+	for _, t := range s.transitions {
+		if t.itemPath != itemPath {
+			transitions = append(transitions, t)
+		}
+	}
+	s.transitions = transitions
+}
+
+// applyTransitions applies the transitions whose time has come, in order, skipping those of resources
+// that no longer exist.
+func (s *server) applyTransitions() {
+	sort.SliceStable(s.transitions, func(i, j int) bool {
+		return s.transitions[i].at.Before(s.transitions[j].at)
+	})
+	now := s.now()
+	n := 0
+	for ; n < len(s.transitions) && !s.transitions[n].at.After(now); n++ {
+		t := s.transitions[n]
+		item, ok := s.get(t.itemPath)
+		if !ok {
+			continue
+		}
+		if t.changes == nil {
+			s.remove(t.itemPath)
+			continue
+		}
+		for key, value := range t.changes {
+			item[key] = value
+		}
+		item[s.modifiedField] = t.at.UTC().Format(time.RFC3339)
+	}
+	s.transitions = s.transitions[n:]
+}
@@ -0,0 +1,338 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakes
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// The default and maximum number of ports per page of the Direct Link fake.
+	directLinkDefaultLimit = 100
+	directLinkMaxLimit     = 100
+
+	// The BGP ASN of the IBM side of gateways.
+	directLinkIbmBgpAsn = 13884
+)
+
+// The display names of the locations of the Direct Link fake.
+var directLinkLocations = map[string]string{
+	"dal03": "Dallas 03",
+	"wdc04": "Washington DC 04",
+	"fra02": "Frankfurt 02",
+}
+
+// DirectLinkServer is an in-memory fake of the Direct Link API, served by directlinkv1. It covers
+// gateways, their virtual connections, ports, and the status and statistics of gateways. The fake has
+// a port in each of its locations, dal03, wdc04 and fra02.
+//
+// Like the service, the fake provisions and deletes resources asynchronously: gateways are created
+// "create_pending", then are "configuring" and finally "provisioned", with their link up and their BGP
+// session established; changing the speed of a gateway makes it "configuring" again. Virtual connections
+// are created "pending" and become "attached", and deleted virtual connections are "deleting" before
+// they disappear. Deleted gateways disappear after the transition delay. Each transition takes place
+// once the transition delay has elapsed on the clock of the fake; see SetClock and SetTransitionDelay.
+//
+// Requests must have the version query parameter. The list of ports honors the limit and start query
+// parameters.
+type DirectLinkServer struct {
+	srv *server
+}
+
+// NewDirectLinkServer returns a new fake of the Direct Link API, without gateways. Its clock is the
+// system clock, and its transition delay is zero: resources reach their next state by the next request.
+func NewDirectLinkServer() *DirectLinkServer {
+	s := &DirectLinkServer{srv: newServer(startEnvelope{
+		defaultLimit: directLinkDefaultLimit,
+		maxLimit:     directLinkMaxLimit,
+		totalCount:   true,
+		moreInfo:     "https://cloud.ibm.com/docs/dl",
+	})}
+	s.srv.createdField = "created_at"
+	s.srv.modifiedField = "updated_at"
+
+	s.srv.resources = []*resource{
+		{
+			path:          "/gateways",
+			listKey:       "gateways",
+			required:      []string{"name", "type", "speed_mbps", "bgp_asn", "global", "metered"},
+			unique:        []string{"name"},
+			immutable:     []string{"type", "crn", "location_name", "location_display_name", "port", "operational_status", "link_status", "bgp_status", "resource_group"},
+			updateMethods: []string{http.MethodPatch},
+			deleting:      object{},
+			create:        s.createGateway,
+			created:       s.gatewayCreated,
+			update:        s.updateGateway,
+			delete:        s.deleteGateway,
+		},
+		{
+			path:          "/gateways/*/virtual_connections",
+			listKey:       "virtual_connections",
+			required:      []string{"name", "type"},
+			unique:        []string{"name"},
+			immutable:     []string{"type", "network_id", "network_account", "status"},
+			updateMethods: []string{http.MethodPatch},
+			deleting:      object{"status": "deleting"},
+			create:        s.createVirtualConnection,
+			created:       s.virtualConnectionCreated,
+		},
+		{
+			path:      "/ports",
+			listKey:   "ports",
+			paginated: true,
+			filters:   []string{"location_name"},
+			noCreate:  true,
+			noDelete:  true,
+			render:    s.renderPort,
+		},
+	}
+	s.srv.actions = []*action{
+		{method: http.MethodGet, path: "/gateways/*/status", handler: s.getGatewayStatus},
+		{method: http.MethodGet, path: "/gateways/*/statistics", handler: s.getGatewayStatistics},
+		{method: http.MethodPost, path: "/gateways/*/actions", handler: notImplemented},
+		{method: http.MethodGet, path: "/gateways/*/letter_of_authorization", handler: notImplemented},
+	}
+
+	for _, location := range []string{"dal03", "wdc04", "fra02"} {
+		s.srv.insert("/ports", object{
+			"label":                 "XCR-" + strings.ToUpper(location) + "-FAKE-01",
+			"location_name":         location,
+			"location_display_name": directLinkLocations[location],
+			"provider_name":         "fake_provider",
+			"supported_link_speeds": []int{50, 100, 200, 500, 1000, 2000, 5000, 10000},
+		})
+	}
+	return s
+}
+
+// SetClock makes the fake take the time from a clock, or from the system clock if clock is nil.
+func (s *DirectLinkServer) SetClock(clock *Clock) {
+	s.srv.setClock(clock)
+}
+
+// SetTransitionDelay sets the time that the transitions of resources started from now on take.
+func (s *DirectLinkServer) SetTransitionDelay(delay time.Duration) {
+	s.srv.setDelay(delay)
+}
+
+// ServeHTTP serves a request to the Direct Link API.
+func (s *DirectLinkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !checkVersion(s.srv, w, r) {
+		return
+	}
+	s.srv.ServeHTTP(w, r)
+}
+
+func (s *DirectLinkServer) createGateway(req *request, item object) *apiError {
+	switch item["type"] {
+	case "dedicated":
+		for _, field := range []string{"carrier_name", "cross_connect_router", "customer_name", "location_name"} {
+			if stringValue(item, field) == "" {
+				return errBadRequest("%s is required for dedicated gateways", field)
+			}
+		}
+		location := stringValue(item, "location_name")
+		if directLinkLocations[location] == "" {
+			return errBadRequest("location_name %q is not a location of Direct Link", location)
+		}
+		item["location_display_name"] = directLinkLocations[location]
+	case "connect":
+		portID := stringValue(item, "port.id")
+		port, ok := s.srv.get("/ports/" + portID)
+		if !ok {
+			return errBadRequest("port %q was not found", portID)
+		}
+		item["port"] = object{"id": portID}
+		item["location_name"] = port["location_name"]
+		item["location_display_name"] = port["location_display_name"]
+	default:
+		return errBadRequest("type %q is not one of dedicated and connect", item["type"])
+	}
+	if err := s.checkSpeed(item); err != nil {
+		return err
+	}
+	setDefaults(item, object{
+		"bgp_cer_cidr": "169.254.0.30/30",
+		"bgp_ibm_cidr": "169.254.0.29/30",
+	})
+	item["bgp_ibm_asn"] = directLinkIbmBgpAsn
+	item["operational_status"] = "create_pending"
+	item["link_status"] = "down"
+	item["bgp_status"] = "idle"
+	item["resource_group"] = resourceGroupReference(item)
+	return nil
+}
+
+func (s *DirectLinkServer) gatewayCreated(req *request, item object) {
+	id := item["id"].(string)
+	item["crn"] = "crn:v1:bluemix:public:directlink:" + stringValue(item, "location_name") + ":a/fake-account::" + stringValue(item, "type") + ":" + id
+	s.scheduleProvisioning("/gateways/" + id)
+}
+
+// scheduleProvisioning schedules the configuration of a gateway.
+func (s *DirectLinkServer) scheduleProvisioning(itemPath string) {
+	now := s.srv.timestamp()
+	s.srv.schedule(itemPath,
+		object{"operational_status": "configuring"},
+		object{
+			"operational_status":     "provisioned",
+			"link_status":            "up",
+			"link_status_updated_at": now,
+			"bgp_status":             "established",
+			"bgp_status_updated_at":  now,
+		})
+}
+
+func (s *DirectLinkServer) updateGateway(req *request, item object, changes object) *apiError {
+	itemPath := req.collectionPath + "/" + item["id"].(string)
+	if original, _ := s.srv.get(itemPath); changes["speed_mbps"] == nil || equalValues(changes["speed_mbps"], original["speed_mbps"]) {
+		return nil
+	}
+	if err := s.checkSpeed(item); err != nil {
+		return err
+	}
+	if item["operational_status"] != "provisioned" {
+		return newAPIError(http.StatusConflict, "invalid_state", "the speed of gateway %s can only be changed once it is provisioned", item["id"])
+	}
+	s.srv.unschedule(itemPath)
+	item["operational_status"] = "configuring"
+	s.srv.schedule(itemPath, object{"operational_status": "provisioned"})
+	return nil
+}
+
+// checkSpeed checks that the speed of a gateway is supported by its port, or by the fake.
+func (s *DirectLinkServer) checkSpeed(item object) *apiError {
+	speed, _ := toFloat(item["speed_mbps"])
+	port, _ := s.srv.get("/ports/" + stringValue(item, "port.id"))
+	if port == nil {
+		port, _ = s.srv.get("/ports/" + s.srv.list("/ports")[0]["id"].(string))
+	}
+	for _, supported := range port["supported_link_speeds"].([]int) {
+		if float64(supported) == speed {
+			return nil
+		}
+	}
+	return errBadRequest("speed_mbps %v is not a supported link speed", item["speed_mbps"])
+}
+
+func (s *DirectLinkServer) deleteGateway(req *request, item object) *apiError {
+	if connections := s.srv.list(req.collectionPath + "/" + item["id"].(string) + "/virtual_connections"); len(connections) > 0 {
+		return errInUse("gateway %s has %d virtual connections, which must be deleted first", item["id"], len(connections))
+	}
+	return nil
+}
+
+func (s *DirectLinkServer) createVirtualConnection(req *request, item object) *apiError {
+	switch networkType := stringValue(item, "type"); networkType {
+	case "vpc", "transit":
+		if !strings.HasPrefix(stringValue(item, "network_id"), "crn:") {
+			return errBadRequest("network_id must be the CRN of the network of %s virtual connections", networkType)
+		}
+	case "classic":
+	default:
+		return errBadRequest("type %q is not one of classic, transit and vpc", networkType)
+	}
+	for _, other := range s.srv.list(req.collectionPath) {
+		if other["type"] == item["type"] && equalValues(other["network_id"], item["network_id"]) {
+			return errConflict("the gateway already has a virtual connection to this %s network", item["type"])
+		}
+	}
+	item["status"] = "pending"
+	return nil
+}
+
+func (s *DirectLinkServer) virtualConnectionCreated(req *request, item object) {
+	s.srv.schedule(req.collectionPath+"/"+item["id"].(string), object{"status": "attached"})
+}
+
+// renderPort returns a port with the number of gateways using it.
+func (s *DirectLinkServer) renderPort(itemPath string, item object) object {
+	rendered := object{}
+	for key, value := range item {
+		rendered[key] = value
+	}
+	count := 0
+	for _, gateway := range s.srv.list("/gateways") {
+		if stringValue(gateway, "port.id") == item["id"] {
+			count++
+		}
+	}
+	rendered["direct_link_count"] = count
+	return rendered
+}
+
+// getGatewayStatus returns the link, BGP and BFD status of a gateway, or the one of the type query
+// parameter.
+func (s *DirectLinkServer) getGatewayStatus(req *request) (int, interface{}, *apiError) {
+	gateway, ok := s.srv.get("/gateways/" + req.params[0])
+	if !ok {
+		return 0, nil, errNotFound("gateway %s was not found", req.params[0])
+	}
+	bfd := "not_available"
+	if gateway["bfd_config"] != nil {
+		bfd = "down"
+		if gateway["link_status"] == "up" {
+			bfd = "up"
+		}
+	}
+	statuses := []object{
+		{"type": "link", "value": gateway["link_status"], "updated_at": statusUpdatedAt(gateway, "link_status_updated_at")},
+		{"type": "bgp", "value": gateway["bgp_status"], "updated_at": statusUpdatedAt(gateway, "bgp_status_updated_at")},
+		{"type": "bfd", "value": bfd, "updated_at": gateway["updated_at"]},
+	}
+	if statusType := req.URL.Query().Get("type"); statusType != "" {
+		var filtered []object
+		for _, status := range statuses {
+			if status["type"] == statusType {
+				filtered = append(filtered, status)
+			}
+		}
+		if filtered == nil {
+			return 0, nil, errBadRequest("type %q is not one of link, bgp and bfd", statusType)
+		}
+		statuses = filtered
+	}
+	return http.StatusOK, object{"status": statuses}, nil
+}
+
+func statusUpdatedAt(gateway object, field string) interface{} {
+	if gateway[field] != nil {
+		return gateway[field]
+	}
+	return gateway["created_at"]
+}
+
+// getGatewayStatistics returns the statistics of the type query parameter for a gateway. The fake has
+// no real statistics, and returns a placeholder.
+func (s *DirectLinkServer) getGatewayStatistics(req *request) (int, interface{}, *apiError) {
+	if _, ok := s.srv.get("/gateways/" + req.params[0]); !ok {
+		return 0, nil, errNotFound("gateway %s was not found", req.params[0])
+	}
+	statisticType := req.URL.Query().Get("type")
+	switch statisticType {
+	case "macsec_mka_session", "macsec_policy", "macsec_mka_statistics", "bfd_session":
+	default:
+		return 0, nil, errBadRequest("type %q is not one of macsec_mka_session, macsec_policy, macsec_mka_statistics and bfd_session", statisticType)
+	}
+	return http.StatusOK, object{"statistics": []object{{
+		"type":       statisticType,
+		"created_at": s.srv.timestamp(),
+		"data":       "statistics of type " + statisticType + " are not available from the fake",
+	}}}, nil
+}
@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakes
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDirectLink returns a DirectLinkV1 pointed at a new fake, and the clock of the fake, whose
+// transition delay is a minute.
+func newDirectLink(t *testing.T) (*directlinkv1.DirectLinkV1, *Clock) {
+	fake := NewDirectLinkServer()
+	clock := NewClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	fake.SetClock(clock)
+	fake.SetTransitionDelay(time.Minute)
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	service, err := directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Version:       core.StringPtr("2022-01-01"),
+	})
+	require.Nil(t, err)
+	return service, clock
+}
+
+func TestDirectLinkServerGateways(t *testing.T) {
+	service, clock := newDirectLink(t)
+	ctx := context.Background()
+
+	ports, err := service.NewPortsPager(service.NewListPortsOptions().SetLimit(2))
+	require.Nil(t, err)
+	allPorts, err := ports.GetAll()
+	require.Nil(t, err)
+	require.Len(t, allPorts, 3)
+	list, _, err := service.ListPorts(service.NewListPortsOptions().SetLocationName("wdc04"))
+	require.Nil(t, err)
+	require.Len(t, list.Ports, 1)
+	assert.Equal(t, int64(1), *list.TotalCount)
+	port := &list.Ports[0]
+
+	portIdentity, _ := service.NewGatewayPortIdentity(*port.ID)
+	template, _ := service.NewGatewayTemplateGatewayTypeConnectTemplate(64999, false, false, "gateway-1", 1000, "connect", portIdentity)
+	gateway, _, err := service.CreateGateway(service.NewCreateGatewayOptions(template))
+	require.Nil(t, err)
+	assert.Equal(t, directlinkv1.Gateway_OperationalStatus_CreatePending, *gateway.OperationalStatus)
+	assert.Equal(t, "wdc04", *gateway.LocationName)
+	assert.Equal(t, "Washington DC 04", *gateway.LocationDisplayName)
+
+	_, err = service.WaitForGatewayProvisioned(ctx, *gateway.ID, fastWait)
+	var stateErr *common.ResourceStateError
+	require.True(t, errors.As(err, &stateErr))
+	assert.Equal(t, directlinkv1.Gateway_OperationalStatus_CreatePending, stateErr.Status)
+	clock.Advance(time.Minute)
+	gateway, _, err = service.GetGateway(service.NewGetGatewayOptions(*gateway.ID))
+	require.Nil(t, err)
+	assert.Equal(t, directlinkv1.Gateway_OperationalStatus_Configuring, *gateway.OperationalStatus)
+	clock.Advance(time.Minute)
+	gateway, err = service.WaitForGatewayProvisioned(ctx, *gateway.ID, fastWait)
+	require.Nil(t, err)
+	assert.Equal(t, directlinkv1.Gateway_LinkStatus_Up, *gateway.LinkStatus)
+	port, _, err = service.GetPort(service.NewGetPortOptions(*port.ID))
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), *port.DirectLinkCount)
+
+	status, _, err := service.GetGatewayStatus(service.NewGetGatewayStatusOptions(*gateway.ID).SetType("bgp"))
+	require.Nil(t, err)
+	require.Len(t, status.Status, 1)
+	assert.Equal(t, directlinkv1.GatewayStatus_Value_Established, *status.Status[0].(*directlinkv1.GatewayStatus).Value)
+	statistics, _, err := service.GetGatewayStatistics(service.NewGetGatewayStatisticsOptions(*gateway.ID, "bfd_session"))
+	require.Nil(t, err)
+	assert.Len(t, statistics.Statistics, 1)
+
+	gateway, _, err = service.UpdateGateway(service.NewUpdateGatewayOptions(*gateway.ID).SetSpeedMbps(2000))
+	require.Nil(t, err)
+	assert.Equal(t, directlinkv1.Gateway_OperationalStatus_Configuring, *gateway.OperationalStatus)
+	_, _, err = service.UpdateGateway(service.NewUpdateGatewayOptions(*gateway.ID).SetSpeedMbps(3000))
+	assert.Equal(t, 400, common.AsAPIError(err).StatusCode)
+	clock.Advance(time.Minute)
+	gateway, err = service.WaitForGatewayProvisioned(ctx, *gateway.ID, fastWait)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2000), *gateway.SpeedMbps)
+
+	dedicated, _ := service.NewGatewayTemplateGatewayTypeDedicatedTemplate(64999, false, false, "gateway-2", 1000, "dedicated",
+		"carrier", "LAB-xcr01.dal03", "customer", "dal03")
+	_, _, err = service.CreateGateway(service.NewCreateGatewayOptions(dedicated))
+	require.Nil(t, err)
+	dedicated.Name = core.StringPtr("gateway-1")
+	_, _, err = service.CreateGateway(service.NewCreateGatewayOptions(dedicated))
+	assert.True(t, common.IsConflict(err))
+
+	_, err = service.DeleteGateway(service.NewDeleteGatewayOptions(*gateway.ID))
+	require.Nil(t, err)
+	clock.Advance(time.Minute)
+	assert.Nil(t, service.WaitForGatewayDeleted(ctx, *gateway.ID, fastWait))
+}
+
+func TestDirectLinkServerVirtualConnections(t *testing.T) {
+	service, clock := newDirectLink(t)
+	ctx := context.Background()
+	dedicated, _ := service.NewGatewayTemplateGatewayTypeDedicatedTemplate(64999, false, false, "gateway-1", 1000, "dedicated",
+		"carrier", "LAB-xcr01.dal03", "customer", "dal03")
+	gateway, _, err := service.CreateGateway(service.NewCreateGatewayOptions(dedicated))
+	require.Nil(t, err)
+
+	connection, _, err := service.CreateGatewayVirtualConnection(service.NewCreateGatewayVirtualConnectionOptions(*gateway.ID, "vpc-1", "vpc").
+		SetNetworkID("crn:v1:bluemix:public:is:us-south:a/fake-account::vpc:vpc-1"))
+	require.Nil(t, err)
+	assert.Equal(t, directlinkv1.GatewayVirtualConnection_Status_Pending, *connection.Status)
+	_, _, err = service.CreateGatewayVirtualConnection(service.NewCreateGatewayVirtualConnectionOptions(*gateway.ID, "vpc-2", "vpc"))
+	assert.Equal(t, 400, common.AsAPIError(err).StatusCode)
+	clock.Advance(time.Minute)
+	connection, err = service.WaitForVirtualConnectionAttached(ctx, *gateway.ID, *connection.ID, fastWait)
+	require.Nil(t, err)
+	assert.Equal(t, directlinkv1.GatewayVirtualConnection_Status_Attached, *connection.Status)
+
+	_, err = service.DeleteGateway(service.NewDeleteGatewayOptions(*gateway.ID))
+	assert.True(t, common.IsConflict(err))
+	_, err = service.DeleteGatewayVirtualConnection(service.NewDeleteGatewayVirtualConnectionOptions(*gateway.ID, *connection.ID))
+	require.Nil(t, err)
+	connection, _, err = service.GetGatewayVirtualConnection(service.NewGetGatewayVirtualConnectionOptions(*gateway.ID, *connection.ID))
+	require.Nil(t, err)
+	assert.Equal(t, directlinkv1.GatewayVirtualConnection_Status_Deleting, *connection.Status)
+	clock.Advance(time.Minute)
+	assert.Nil(t, service.WaitForVirtualConnectionDeleted(ctx, *gateway.ID, *connection.ID, fastWait))
+	_, err = service.DeleteGateway(service.NewDeleteGatewayOptions(*gateway.ID))
+	assert.Nil(t, err)
+}
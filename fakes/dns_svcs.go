@@ -0,0 +1,734 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakes
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// The default and maximum limit of the paginated lists of the DNS Services fake.
+	dnsSvcsDefaultLimit = 200
+	dnsSvcsMaxLimit     = 1000
+
+	// The default TTL of resource records.
+	dnsSvcsDefaultTTL = 900
+)
+
+// The rdata fields required for each resource record type.
+var dnsSvcsRdataFields = map[string][]string{
+	"A":     {"ip"},
+	"AAAA":  {"ip"},
+	"CNAME": {"cname"},
+	"MX":    {"exchange", "preference"},
+	"PTR":   {"ptrdname"},
+	"SRV":   {"priority", "weight", "port", "target"},
+	"TXT":   {"text"},
+}
+
+// DnsSvcsServer is an in-memory fake of the DNS Services API, served by dnssvcsv1.DnsSvcsV1. It
+// implements DNS zones, resource records, permitted networks, global load balancers with their pools
+// and monitors, custom resolvers with their locations, forwarding rules and secondary zones, and
+// linked zones with their access requests and permitted networks. Service instances don't need to be
+// created: every instance ID designates an instance that exists, and is initially empty.
+//
+// Lists honor the offset and limit query parameters, and errors are returned in the error envelope of
+// DNS Services, with the X-Correlation-ID of the request as trace. The import and export of resource
+// records are not implemented.
+type DnsSvcsServer struct {
+	srv *server
+
+	// The number of locations created, used to assign their DNS server IPs.
+	locations int
+}
+
+// NewDnsSvcsServer returns a new, empty fake of the DNS Services API.
+func NewDnsSvcsServer() *DnsSvcsServer {
+	s := &DnsSvcsServer{srv: newServer(dnsSvcsEnvelope{})}
+
+	customResolvers := &resource{
+		path:          "/instances/*/custom_resolvers",
+		listKey:       "custom_resolvers",
+		required:      []string{"name"},
+		immutable:     []string{"locations", "health"},
+		updateMethods: []string{http.MethodPatch},
+		create:        s.createCustomResolver,
+		created:       s.customResolverCreated,
+		render:        s.renderCustomResolver,
+	}
+	s.srv.resources = []*resource{
+		{
+			path:          "/instances/*/dnszones",
+			listKey:       "dnszones",
+			paginated:     true,
+			required:      []string{"name"},
+			unique:        []string{"name"},
+			immutable:     []string{"name", "instance_id", "state"},
+			updateMethods: []string{http.MethodPatch},
+			create:        s.createDnszone,
+		},
+		{
+			path:          "/instances/*/dnszones/*/resource_records",
+			listKey:       "resource_records",
+			paginated:     true,
+			required:      []string{"name", "type", "rdata"},
+			unique:        []string{"name", "type", "rdata"},
+			immutable:     []string{"type"},
+			updateMethods: []string{http.MethodPut},
+			create:        s.createResourceRecord,
+			update:        s.updateResourceRecord,
+		},
+		s.permittedNetworks("/instances/*/dnszones/*/permitted_networks", "active", "pending_network_add"),
+		{
+			path:          "/instances/*/dnszones/*/load_balancers",
+			listKey:       "load_balancers",
+			paginated:     true,
+			required:      []string{"name", "fallback_pool", "default_pools"},
+			unique:        []string{"name"},
+			immutable:     []string{"health"},
+			updateMethods: []string{http.MethodPut},
+			create:        s.createLoadBalancer,
+			update:        s.updateLoadBalancer,
+		},
+		{
+			path:          "/instances/*/dnszones/*/access_requests",
+			listKey:       "access_requests",
+			paginated:     true,
+			immutable:     []string{"requestor", "zone_id", "zone_name", "state", "pending_expires_at"},
+			updateMethods: []string{http.MethodPatch},
+			noCreate:      true,
+			noDelete:      true,
+			update:        s.updateAccessRequest,
+		},
+		{
+			path:          "/instances/*/pools",
+			listKey:       "pools",
+			paginated:     true,
+			required:      []string{"name", "origins"},
+			immutable:     []string{"health"},
+			updateMethods: []string{http.MethodPut},
+			create:        s.createPool,
+			update:        s.updatePool,
+			delete:        s.deletePool,
+		},
+		{
+			path:          "/instances/*/monitors",
+			listKey:       "monitors",
+			paginated:     true,
+			required:      []string{"name"},
+			updateMethods: []string{http.MethodPut},
+			create:        s.createMonitor,
+			delete:        s.deleteMonitor,
+		},
+		customResolvers,
+		{
+			path:          "/instances/*/custom_resolvers/*/locations",
+			listKey:       "locations",
+			required:      []string{"subnet_crn"},
+			unique:        []string{"subnet_crn"},
+			immutable:     []string{"healthy", "dns_server_ip"},
+			updateMethods: []string{http.MethodPatch},
+			create:        s.createLocation,
+		},
+		{
+			path:          "/instances/*/custom_resolvers/*/forwarding_rules",
+			listKey:       "forwarding_rules",
+			required:      []string{"type", "match", "forward_to"},
+			unique:        []string{"match"},
+			immutable:     []string{"type"},
+			updateMethods: []string{http.MethodPatch},
+			create:        s.createForwardingRule,
+			delete:        s.deleteForwardingRule,
+		},
+		{
+			path:          "/instances/*/custom_resolvers/*/secondary_zones",
+			listKey:       "secondary_zones",
+			paginated:     true,
+			required:      []string{"zone", "transfer_from"},
+			unique:        []string{"zone"},
+			immutable:     []string{"zone"},
+			updateMethods: []string{http.MethodPatch},
+			create:        s.createSecondaryZone,
+		},
+		{
+			path:          "/instances/*/linked_dnszones",
+			listKey:       "linked_dnszones",
+			paginated:     true,
+			required:      []string{"owner_instance_id", "owner_zone_id"},
+			immutable:     []string{"instance_id", "name", "linked_to", "state", "approval_required_before"},
+			updateMethods: []string{http.MethodPatch},
+			create:        s.createLinkedZone,
+			created:       s.linkedZoneCreated,
+		},
+		s.permittedNetworks("/instances/*/linked_dnszones/*/permitted_networks", "ACTIVE", "PENDING_NETWORK_ADD"),
+	}
+	s.srv.actions = []*action{
+		{
+			method: http.MethodPut,
+			path:   "/instances/*/custom_resolvers/*/locations_order",
+			handler: func(req *request) (int, interface{}, *apiError) {
+				return s.updateLocationsOrder(req, customResolvers)
+			},
+		},
+		{method: http.MethodGet, path: "/instances/*/dnszones/*/export_resource_records", handler: notImplemented},
+		{method: http.MethodPost, path: "/instances/*/dnszones/*/import_resource_records", handler: notImplemented},
+	}
+	return s
+}
+
+// ServeHTTP serves a request to the DNS Services API.
+func (s *DnsSvcsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.srv.ServeHTTP(w, r)
+}
+
+func (s *DnsSvcsServer) createDnszone(req *request, item object) *apiError {
+	name := strings.ToLower(strings.TrimSuffix(stringValue(item, "name"), "."))
+	if strings.Count(name, ".") == 0 {
+		return errBadRequest("name %q is not a valid domain name", name)
+	}
+	item["name"] = name
+	item["instance_id"] = req.params[0]
+	item["state"] = "pending_network_add"
+	return nil
+}
+
+func (s *DnsSvcsServer) createResourceRecord(req *request, item object) *apiError {
+	recordType := strings.ToUpper(stringValue(item, "type"))
+	item["type"] = recordType
+	if _, ok := dnsSvcsRdataFields[recordType]; !ok {
+		return errBadRequest("type %q is not a supported resource record type", recordType)
+	}
+	if recordType == "SRV" && (stringValue(item, "service") == "" || stringValue(item, "protocol") == "") {
+		return errBadRequest("service and protocol are required for SRV records")
+	}
+	if item["ttl"] == nil {
+		item["ttl"] = dnsSvcsDefaultTTL
+	}
+	item["name"] = s.recordName(req, item, stringValue(item, "name"))
+	return s.checkResourceRecord(req, item)
+}
+
+func (s *DnsSvcsServer) updateResourceRecord(req *request, item object, changes object) *apiError {
+	if changes["name"] != nil || changes["service"] != nil || changes["protocol"] != nil {
+		name, _ := changes["name"].(string)
+		if name == "" {
+			name = s.baseRecordName(req, item)
+		}
+		item["name"] = s.recordName(req, item, name)
+	}
+	return s.checkResourceRecord(req, item)
+}
+
+// recordName returns the name of a record in the zone, prefixed with the service and protocol of an
+// SRV record.
+func (s *DnsSvcsServer) recordName(req *request, item object, name string) string {
+	name = qualifiedName(name, stringValue(req.parent, "name"))
+	if item["type"] == "SRV" {
+		name = strings.ToLower(stringValue(item, "service")+"."+stringValue(item, "protocol")+".") + name
+	}
+	return name
+}
+
+// baseRecordName returns the name of a record without the service and protocol of an SRV record.
+func (s *DnsSvcsServer) baseRecordName(req *request, item object) string {
+	name := stringValue(item, "name")
+	if item["type"] == "SRV" {
+		if parts := strings.SplitN(name, ".", 3); len(parts) == 3 {
+			name = parts[2]
+		}
+	}
+	return name
+}
+
+func (s *DnsSvcsServer) checkResourceRecord(req *request, item object) *apiError {
+	recordType := item["type"].(string)
+	rdata, ok := item["rdata"].(map[string]interface{})
+	if !ok {
+		return errBadRequest("rdata is not an object")
+	}
+	for _, field := range dnsSvcsRdataFields[recordType] {
+		if isEmpty(rdata[field]) {
+			return errBadRequest("rdata.%s is required for %s records", field, recordType)
+		}
+	}
+	if recordType == "A" || recordType == "AAAA" {
+		ip := net.ParseIP(stringValue(rdata, "ip"))
+		if ip == nil || (ip.To4() != nil) != (recordType == "A") {
+			return errBadRequest("rdata.ip %q is not a valid address for %s records", rdata["ip"], recordType)
+		}
+	}
+	for _, other := range s.srv.list(req.collectionPath) {
+		if other["id"] != item["id"] && equalValues(other["name"], item["name"]) &&
+			(recordType == "CNAME" || other["type"] == "CNAME") && !equalValues(other["rdata"], item["rdata"]) {
+			return newAPIError(http.StatusConflict, "cname_conflict", "a CNAME record can't share its name %s with other records", item["name"])
+		}
+	}
+	return nil
+}
+
+// permittedNetworks returns the permitted networks of the resources of a collection, whose state
+// becomes activeState when a first network is added, and pendingState when the last one is removed.
+func (s *DnsSvcsServer) permittedNetworks(path string, activeState string, pendingState string) *resource {
+	return &resource{
+		path:         path,
+		listKey:      "permitted_networks",
+		required:     []string{"type", "permitted_network.vpc_crn"},
+		unique:       []string{"permitted_network.vpc_crn"},
+		deleteStatus: http.StatusAccepted,
+		deleteResult: func(item object) interface{} { return item },
+		create: func(req *request, item object) *apiError {
+			if item["type"] != "vpc" {
+				return errBadRequest("type %q is not a supported permitted network type", item["type"])
+			}
+			switch req.parent["state"] {
+			case activeState, pendingState:
+			default:
+				return newAPIError(http.StatusConflict, "invalid_state", "networks can't be added to a zone in the %s state", req.parent["state"])
+			}
+			item["state"] = "ACTIVE"
+			return nil
+		},
+		created: func(req *request, item object) {
+			req.parent["state"] = activeState
+		},
+		delete: func(req *request, item object) *apiError {
+			item["state"] = "REMOVAL_IN_PROGRESS"
+			return nil
+		},
+		deleted: func(req *request, item object) {
+			if len(s.srv.list(req.collectionPath)) == 0 {
+				req.parent["state"] = pendingState
+			}
+		},
+	}
+}
+
+func (s *DnsSvcsServer) createLoadBalancer(req *request, item object) *apiError {
+	item["name"] = qualifiedName(stringValue(item, "name"), stringValue(req.parent, "name"))
+	setDefaults(item, object{"enabled": true, "ttl": 60})
+	item["health"] = "HEALTHY"
+	return s.checkLoadBalancerPools(req, item)
+}
+
+func (s *DnsSvcsServer) updateLoadBalancer(req *request, item object, changes object) *apiError {
+	if changes["name"] != nil {
+		item["name"] = qualifiedName(stringValue(item, "name"), stringValue(req.parent, "name"))
+	}
+	return s.checkLoadBalancerPools(req, item)
+}
+
+func (s *DnsSvcsServer) checkLoadBalancerPools(req *request, item object) *apiError {
+	for _, poolID := range loadBalancerPools(item) {
+		if _, ok := s.srv.get("/instances/" + req.params[0] + "/pools/" + poolID); !ok {
+			return errNotFound("pool %s was not found", poolID)
+		}
+	}
+	return nil
+}
+
+// loadBalancerPools returns the IDs of the pools a load balancer uses.
+func loadBalancerPools(item object) (poolIDs []string) {
+	poolIDs = append(poolIDs, stringValue(item, "fallback_pool"))
+	poolIDs = append(poolIDs, stringSlice(item["default_pools"])...)
+	if azPools, ok := item["az_pools"].([]interface{}); ok {
+		for _, azPool := range azPools {
+			if azPool, ok := azPool.(map[string]interface{}); ok {
+				poolIDs = append(poolIDs, stringSlice(azPool["pools"])...)
+			}
+		}
+	}
+	return
+}
+
+func (s *DnsSvcsServer) createPool(req *request, item object) *apiError {
+	setDefaults(item, object{"enabled": true, "healthy_origins_threshold": 1})
+	item["health"] = "HEALTHY"
+	return s.checkPool(req, item)
+}
+
+func (s *DnsSvcsServer) updatePool(req *request, item object, changes object) *apiError {
+	return s.checkPool(req, item)
+}
+
+func (s *DnsSvcsServer) checkPool(req *request, item object) *apiError {
+	origins, ok := item["origins"].([]interface{})
+	if !ok || len(origins) == 0 {
+		return errBadRequest("origins is required")
+	}
+	for i, origin := range origins {
+		origin, ok := origin.(map[string]interface{})
+		if !ok || stringValue(origin, "name") == "" || stringValue(origin, "address") == "" {
+			return errBadRequest("origins[%d] must have a name and an address", i)
+		}
+		setDefaults(origin, object{"enabled": true})
+		origin["health"] = true
+	}
+	if monitorID := stringValue(item, "monitor"); monitorID != "" {
+		if _, ok := s.srv.get("/instances/" + req.params[0] + "/monitors/" + monitorID); !ok {
+			return errNotFound("monitor %s was not found", monitorID)
+		}
+	}
+	return nil
+}
+
+func (s *DnsSvcsServer) deletePool(req *request, item object) *apiError {
+	for _, loadBalancer := range s.srv.listAll("/instances/" + req.params[0] + "/dnszones/*/load_balancers") {
+		for _, poolID := range loadBalancerPools(loadBalancer) {
+			if poolID == item["id"] {
+				return errInUse("pool %s is used by load balancer %s", poolID, loadBalancer["id"])
+			}
+		}
+	}
+	return nil
+}
+
+func (s *DnsSvcsServer) createMonitor(req *request, item object) *apiError {
+	monitorType := strings.ToUpper(stringValue(item, "type"))
+	if monitorType == "" {
+		monitorType = "HTTP"
+	}
+	item["type"] = monitorType
+	defaults := object{"interval": 60, "retries": 1, "timeout": 5}
+	switch monitorType {
+	case "HTTP", "HTTPS":
+		defaults["port"] = 80
+		if monitorType == "HTTPS" {
+			defaults["port"] = 443
+		}
+		defaults["method"] = "GET"
+		defaults["path"] = "/"
+		defaults["expected_codes"] = "200"
+		defaults["allow_insecure"] = false
+	case "TCP":
+		if item["port"] == nil {
+			return errBadRequest("port is required for TCP monitors")
+		}
+	default:
+		return errBadRequest("type %q is not one of HTTP, HTTPS and TCP", monitorType)
+	}
+	setDefaults(item, defaults)
+	return nil
+}
+
+func (s *DnsSvcsServer) deleteMonitor(req *request, item object) *apiError {
+	for _, pool := range s.srv.list("/instances/" + req.params[0] + "/pools") {
+		if pool["monitor"] == item["id"] {
+			return errInUse("monitor %s is used by pool %s", item["id"], pool["id"])
+		}
+	}
+	return nil
+}
+
+func (s *DnsSvcsServer) createCustomResolver(req *request, item object) *apiError {
+	locations, _ := item["locations"].([]interface{})
+	for i, location := range locations {
+		location, ok := location.(map[string]interface{})
+		if !ok || stringValue(location, "subnet_crn") == "" {
+			return errBadRequest("locations[%d].subnet_crn is required", i)
+		}
+	}
+	setDefaults(item, object{"enabled": false})
+	item["health"] = "HEALTHY"
+	return nil
+}
+
+// customResolverCreated adds the locations given when a custom resolver is created, and its default
+// forwarding rule.
+func (s *DnsSvcsServer) customResolverCreated(req *request, item object) {
+	itemPath := req.collectionPath + "/" + item["id"].(string)
+	locations, _ := item["locations"].([]interface{})
+	delete(item, "locations")
+	for _, location := range locations {
+		location := location.(map[string]interface{})
+		_ = s.createLocation(req, location)
+		s.srv.insert(itemPath+"/locations", location)
+	}
+	s.srv.insert(itemPath+"/forwarding_rules", object{
+		"type":        "default",
+		"description": "Default forwarding rule",
+		"match":       "",
+		"forward_to":  []interface{}{},
+	})
+}
+
+// renderCustomResolver returns a custom resolver with its locations.
+func (s *DnsSvcsServer) renderCustomResolver(itemPath string, item object) object {
+	rendered := object{}
+	for key, value := range item {
+		rendered[key] = value
+	}
+	locations := []object{}
+	for _, location := range s.srv.list(itemPath + "/locations") {
+		locations = append(locations, location)
+	}
+	rendered["locations"] = locations
+	return rendered
+}
+
+func (s *DnsSvcsServer) createLocation(req *request, item object) *apiError {
+	setDefaults(item, object{"enabled": false})
+	item["healthy"] = true
+	item["dns_server_ip"] = fmt.Sprintf("10.10.%d.%d", s.locations/254%256, s.locations%254+1)
+	s.locations++
+	return nil
+}
+
+func (s *DnsSvcsServer) updateLocationsOrder(req *request, customResolvers *resource) (int, interface{}, *apiError) {
+	resolverPath := "/instances/" + req.params[0] + "/custom_resolvers/" + req.params[1]
+	resolver, ok := s.srv.get(resolverPath)
+	if !ok {
+		return 0, nil, errNotFound("custom_resolver %s was not found", req.params[1])
+	}
+	body, err := decodeBody(req.Request)
+	if err != nil {
+		return 0, nil, err
+	}
+	order := stringSlice(body["locations"])
+	c := s.srv.collections[resolverPath+"/locations"]
+	if c == nil || len(order) != len(c.ids) {
+		return 0, nil, errBadRequest("locations must list each location of the custom resolver once")
+	}
+	for _, id := range order {
+		if _, ok := c.items[id]; !ok {
+			return 0, nil, errBadRequest("locations must list each location of the custom resolver once")
+		}
+	}
+	c.ids = order
+	resolver["modified_on"] = s.srv.timestamp()
+	return http.StatusOK, customResolvers.render(resolverPath, resolver), nil
+}
+
+func (s *DnsSvcsServer) createForwardingRule(req *request, item object) *apiError {
+	if item["type"] != "zone" {
+		return errBadRequest("type %q is not a supported forwarding rule type", item["type"])
+	}
+	item["match"] = strings.ToLower(strings.TrimSuffix(stringValue(item, "match"), "."))
+	return nil
+}
+
+func (s *DnsSvcsServer) deleteForwardingRule(req *request, item object) *apiError {
+	if item["type"] == "default" {
+		return errBadRequest("the default forwarding rule can't be deleted")
+	}
+	return nil
+}
+
+func (s *DnsSvcsServer) createSecondaryZone(req *request, item object) *apiError {
+	item["zone"] = strings.ToLower(strings.TrimSuffix(stringValue(item, "zone"), "."))
+	setDefaults(item, object{"enabled": false})
+	return nil
+}
+
+func (s *DnsSvcsServer) createLinkedZone(req *request, item object) *apiError {
+	ownerInstanceID, ownerZoneID := stringValue(item, "owner_instance_id"), stringValue(item, "owner_zone_id")
+	ownerZone, ok := s.srv.get("/instances/" + ownerInstanceID + "/dnszones/" + ownerZoneID)
+	if !ok {
+		return errNotFound("dnszone %s of instance %s was not found", ownerZoneID, ownerInstanceID)
+	}
+	delete(item, "owner_instance_id")
+	delete(item, "owner_zone_id")
+	item["instance_id"] = req.params[0]
+	item["name"] = ownerZone["name"]
+	item["linked_to"] = object{"instance_crn": dnsSvcsInstanceCrn(ownerInstanceID), "zone_id": ownerZoneID}
+	item["state"] = "PENDING_APPROVAL"
+	item["approval_required_before"] = s.srv.now().Add(7 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	return nil
+}
+
+// linkedZoneCreated adds the request to access the owner zone of a linked zone.
+func (s *DnsSvcsServer) linkedZoneCreated(req *request, item object) {
+	ownerInstanceID := strings.Split(stringValue(item, "linked_to.instance_crn"), ":")[7]
+	zoneID := stringValue(item, "linked_to.zone_id")
+	s.srv.insert("/instances/"+ownerInstanceID+"/dnszones/"+zoneID+"/access_requests", object{
+		"requestor": object{
+			"account_id":     "fake-account",
+			"instance_id":    req.params[0],
+			"linked_zone_id": item["id"],
+		},
+		"zone_id":            zoneID,
+		"zone_name":          item["name"],
+		"state":              "PENDING",
+		"pending_expires_at": item["approval_required_before"],
+	})
+}
+
+func (s *DnsSvcsServer) updateAccessRequest(req *request, item object, changes object) *apiError {
+	action := stringValue(changes, "action")
+	delete(item, "action")
+	var state, linkedZoneState string
+	switch {
+	case action == "APPROVE" && item["state"] == "PENDING":
+		state, linkedZoneState = "APPROVED", "PENDING_NETWORK_ADD"
+	case action == "REJECT" && item["state"] == "PENDING":
+		state, linkedZoneState = "REJECTED", "APPROVAL_REJECTED"
+	case action == "REVOKE" && item["state"] == "APPROVED":
+		state, linkedZoneState = "REVOKED", "APPROVAL_REVOKED"
+	default:
+		return errBadRequest("action %q is not valid for an access request in the %s state", action, item["state"])
+	}
+	item["state"] = state
+	linkedZonePath := "/instances/" + stringValue(item, "requestor.instance_id") + "/linked_dnszones/" + stringValue(item, "requestor.linked_zone_id")
+	if linkedZone, ok := s.srv.get(linkedZonePath); ok {
+		linkedZone["state"] = linkedZoneState
+		linkedZone["modified_on"] = s.srv.timestamp()
+		if state == "REVOKED" {
+			delete(s.srv.collections, linkedZonePath+"/permitted_networks")
+		}
+	}
+	return nil
+}
+
+func notImplemented(req *request) (int, interface{}, *apiError) {
+	return 0, nil, newAPIError(http.StatusNotImplemented, "not_implemented", "%s %s is not implemented by the fake", req.Method, req.URL.Path)
+}
+
+// dnsSvcsEnvelope writes responses in the format of DNS Services.
+type dnsSvcsEnvelope struct{}
+
+func (dnsSvcsEnvelope) writeResult(w http.ResponseWriter, r *http.Request, status int, result interface{}) {
+	w.Header().Set("X-Correlation-ID", dnsSvcsTrace(r))
+	writeJSON(w, status, result)
+}
+
+func (env dnsSvcsEnvelope) writeList(w http.ResponseWriter, r *http.Request, res *resource, items []object) {
+	if items == nil {
+		items = []object{}
+	}
+	body := object{}
+	if res.paginated {
+		offset, limit, err := dnsSvcsPage(r)
+		if err != nil {
+			env.writeError(w, r, err)
+			return
+		}
+		total := len(items)
+		href := func(offset int) object {
+			u := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path}
+			if r.TLS != nil {
+				u.Scheme = "https"
+			}
+			u.RawQuery = url.Values{"offset": {strconv.Itoa(offset)}, "limit": {strconv.Itoa(limit)}}.Encode()
+			return object{"href": u.String()}
+		}
+		if offset < total {
+			items = items[offset:]
+		} else {
+			items = items[:0]
+		}
+		if len(items) > limit {
+			items = items[:limit]
+		}
+		body["offset"] = offset
+		body["limit"] = limit
+		body["count"] = len(items)
+		body["total_count"] = total
+		body["first"] = href(0)
+		body["last"] = href(0)
+		if total > 0 {
+			body["last"] = href((total - 1) / limit * limit)
+		}
+		if offset > 0 {
+			previous := offset - limit
+			if previous < 0 {
+				previous = 0
+			}
+			body["previous"] = href(previous)
+		}
+		if offset+limit < total {
+			body["next"] = href(offset + limit)
+		}
+	}
+	body[res.listKey] = items
+	env.writeResult(w, r, http.StatusOK, body)
+}
+
+func (dnsSvcsEnvelope) writeError(w http.ResponseWriter, r *http.Request, err *apiError) {
+	trace := dnsSvcsTrace(r)
+	w.Header().Set("X-Correlation-ID", trace)
+	writeJSON(w, err.status, object{"code": err.code, "message": err.message, "trace": trace})
+}
+
+// dnsSvcsPage returns the offset and limit query parameters of a list request.
+func dnsSvcsPage(r *http.Request) (offset int, limit int, err *apiError) {
+	offset, limit = 0, dnsSvcsDefaultLimit
+	query := r.URL.Query()
+	var convErr error
+	if value := query.Get("offset"); value != "" {
+		if offset, convErr = strconv.Atoi(value); convErr != nil || offset < 0 {
+			return 0, 0, errBadRequest("offset %q is not a non-negative integer", value)
+		}
+	}
+	if value := query.Get("limit"); value != "" {
+		if limit, convErr = strconv.Atoi(value); convErr != nil || limit < 1 || limit > dnsSvcsMaxLimit {
+			return 0, 0, errBadRequest("limit %q is not an integer between 1 and %d", value, dnsSvcsMaxLimit)
+		}
+	}
+	return
+}
+
+// dnsSvcsTrace returns the X-Correlation-ID of a request, or a new one.
+func dnsSvcsTrace(r *http.Request) string {
+	if trace := r.Header.Get("X-Correlation-ID"); trace != "" {
+		return trace
+	}
+	return uuid.New().String()
+}
+
+func dnsSvcsInstanceCrn(instanceID string) string {
+	return "crn:v1:bluemix:public:dns-svcs:global:a/fake-account:" + instanceID + "::"
+}
+
+// qualifiedName returns a name relative to a zone, or "@", as a fully qualified name without the
+// trailing dot.
+func qualifiedName(name string, zoneName string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	switch {
+	case name == "" || name == "@":
+		return zoneName
+	case name == zoneName || strings.HasSuffix(name, "."+zoneName):
+		return name
+	}
+	return name + "." + zoneName
+}
+
+// setDefaults sets the fields of an object that are not set to their default values.
+func setDefaults(item object, defaults object) {
+	for key, value := range defaults {
+		if item[key] == nil {
+			item[key] = value
+		}
+	}
+}
+
+// stringSlice returns the strings of a JSON array.
+func stringSlice(value interface{}) (strs []string) {
+	values, _ := value.([]interface{})
+	for _, value := range values {
+		if str, ok := value.(string); ok {
+			strs = append(strs, str)
+		}
+	}
+	return
+}
@@ -0,0 +1,290 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakes
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDnsSvcs returns a DnsSvcsV1 pointed at a new fake.
+func newDnsSvcs(t *testing.T) *dnssvcsv1.DnsSvcsV1 {
+	server := httptest.NewServer(NewDnsSvcsServer())
+	t.Cleanup(server.Close)
+	service, err := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return service
+}
+
+func createZone(t *testing.T, service *dnssvcsv1.DnsSvcsV1, instanceID string, name string) *dnssvcsv1.Dnszone {
+	zone, _, err := service.CreateDnszone(service.NewCreateDnszoneOptions(instanceID).SetName(name))
+	require.Nil(t, err)
+	return zone
+}
+
+func createARecord(service *dnssvcsv1.DnsSvcsV1, zone *dnssvcsv1.Dnszone, name string, ip string) (*dnssvcsv1.ResourceRecord, error) {
+	rdata, _ := service.NewResourceRecordInputRdataRdataARecord(ip)
+	record, _, err := service.CreateResourceRecord(service.NewCreateResourceRecordOptions(*zone.InstanceID, *zone.ID).
+		SetName(name).SetType(dnssvcsv1.CreateResourceRecordOptions_Type_A).SetRdata(rdata))
+	return record, err
+}
+
+func TestDnsSvcsServerZones(t *testing.T) {
+	service := newDnsSvcs(t)
+	zone := createZone(t, service, "instance-1", "Example.com.")
+	assert.Equal(t, "example.com", *zone.Name)
+	assert.Equal(t, "instance-1", *zone.InstanceID)
+	assert.Equal(t, dnssvcsv1.Dnszone_State_PendingNetworkAdd, *zone.State)
+
+	_, _, err := service.CreateDnszone(service.NewCreateDnszoneOptions("instance-1").SetName("example.com"))
+	assert.True(t, common.IsConflict(err))
+	createZone(t, service, "instance-2", "example.com")
+
+	vpc, _ := service.NewPermittedNetworkVpc("crn:v1:bluemix:public:is:us-south:a/account::vpc:vpc-1")
+	network, _, err := service.CreatePermittedNetwork(service.NewCreatePermittedNetworkOptions("instance-1", *zone.ID).
+		SetType(dnssvcsv1.CreatePermittedNetworkOptions_Type_Vpc).SetPermittedNetwork(vpc))
+	require.Nil(t, err)
+	assert.Equal(t, dnssvcsv1.PermittedNetwork_State_Active, *network.State)
+	zone, _, err = service.GetDnszone(service.NewGetDnszoneOptions("instance-1", *zone.ID))
+	require.Nil(t, err)
+	assert.Equal(t, dnssvcsv1.Dnszone_State_Active, *zone.State)
+
+	network, response, err := service.DeletePermittedNetwork(service.NewDeletePermittedNetworkOptions("instance-1", *zone.ID, *network.ID))
+	require.Nil(t, err)
+	assert.Equal(t, 202, response.StatusCode)
+	assert.Equal(t, dnssvcsv1.PermittedNetwork_State_RemovalInProgress, *network.State)
+	zone, _, _ = service.GetDnszone(service.NewGetDnszoneOptions("instance-1", *zone.ID))
+	assert.Equal(t, dnssvcsv1.Dnszone_State_PendingNetworkAdd, *zone.State)
+
+	zone, _, err = service.UpdateDnszone(service.NewUpdateDnszoneOptions("instance-1", *zone.ID).SetDescription("updated"))
+	require.Nil(t, err)
+	assert.Equal(t, "updated", *zone.Description)
+
+	_, err = createARecord(service, zone, "www", "10.0.0.1")
+	require.Nil(t, err)
+	_, err = service.DeleteDnszone(service.NewDeleteDnszoneOptions("instance-1", *zone.ID))
+	require.Nil(t, err)
+	_, _, err = service.ListResourceRecords(service.NewListResourceRecordsOptions("instance-1", *zone.ID))
+	assert.True(t, common.IsNotFound(err))
+
+	zones, _, err := service.ListDnszones(service.NewListDnszonesOptions("instance-1"))
+	require.Nil(t, err)
+	assert.Empty(t, zones.Dnszones)
+}
+
+func TestDnsSvcsServerResourceRecords(t *testing.T) {
+	service := newDnsSvcs(t)
+	zone := createZone(t, service, "instance-1", "example.com")
+	for i := 1; i <= 5; i++ {
+		record, err := createARecord(service, zone, fmt.Sprintf("host-%d", i), fmt.Sprintf("10.0.0.%d", i))
+		require.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("host-%d.example.com", i), *record.Name)
+		assert.Equal(t, int64(900), *record.TTL)
+	}
+
+	list, _, err := service.ListResourceRecords(service.NewListResourceRecordsOptions("instance-1", *zone.ID).SetOffset(4).SetLimit(2))
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), *list.Count)
+	assert.Equal(t, int64(5), *list.TotalCount)
+	assert.Nil(t, list.Next)
+	offset, err := list.GetNextOffset()
+	assert.Nil(t, err)
+	assert.Nil(t, offset)
+
+	pager, err := service.NewResourceRecordsPager(service.NewListResourceRecordsOptions("instance-1", *zone.ID).SetLimit(2))
+	require.Nil(t, err)
+	records, err := pager.GetAll()
+	require.Nil(t, err)
+	require.Len(t, records, 5)
+	assert.Equal(t, "host-5.example.com", *records[4].Name)
+
+	_, err = createARecord(service, zone, "host-1.example.com.", "10.0.0.1")
+	assert.True(t, common.IsConflict(err))
+	_, err = createARecord(service, zone, "bad", "2001:db8::1")
+	assert.Equal(t, 400, common.AsAPIError(err).StatusCode)
+
+	cname, _ := service.NewResourceRecordInputRdataRdataCnameRecord("host-2.example.com")
+	_, _, err = service.CreateResourceRecord(service.NewCreateResourceRecordOptions("instance-1", *zone.ID).
+		SetName("host-1").SetType(dnssvcsv1.CreateResourceRecordOptions_Type_Cname).SetRdata(cname))
+	assert.True(t, common.AsAPIError(err).HasCode("cname_conflict"))
+
+	srvRdata, _ := service.NewResourceRecordInputRdataRdataSrvRecord(5060, 1, "sip.example.com", 5)
+	srv, _, err := service.CreateResourceRecord(service.NewCreateResourceRecordOptions("instance-1", *zone.ID).
+		SetName("voice").SetType(dnssvcsv1.CreateResourceRecordOptions_Type_Srv).SetRdata(srvRdata).
+		SetService("_sip").SetProtocol("_udp").SetTTL(300))
+	require.Nil(t, err)
+	assert.Equal(t, "_sip._udp.voice.example.com", *srv.Name)
+
+	updateRdata, _ := service.NewResourceRecordUpdateInputRdataRdataSrvRecord(5061, 1, "sip.example.com", 5)
+	srv, _, err = service.UpdateResourceRecord(service.NewUpdateResourceRecordOptions("instance-1", *zone.ID, *srv.ID).
+		SetName("phone").SetService("_sip").SetProtocol("_tcp").SetRdata(updateRdata))
+	require.Nil(t, err)
+	assert.Equal(t, "_sip._tcp.phone.example.com", *srv.Name)
+	assert.Equal(t, int64(300), *srv.TTL)
+	assert.Equal(t, float64(5061), srv.Rdata.(map[string]interface{})["port"])
+
+	_, _, err = service.GetResourceRecord(service.NewGetResourceRecordOptions("instance-1", *zone.ID, "missing").SetXCorrelationID("trace-1"))
+	apiErr := common.AsAPIError(err)
+	require.NotNil(t, apiErr)
+	assert.Equal(t, 404, apiErr.StatusCode)
+	assert.Equal(t, []string{"not_found"}, apiErr.Codes())
+	assert.Equal(t, "trace-1", apiErr.Trace)
+}
+
+func TestDnsSvcsServerRecordSync(t *testing.T) {
+	service := newDnsSvcs(t)
+	zone := createZone(t, service, "instance-1", "example.com")
+	_, err := createARecord(service, zone, "old", "10.0.0.9")
+	require.Nil(t, err)
+
+	a, _ := service.NewResourceRecordInputRdataRdataARecord("10.0.0.1")
+	options := service.NewSyncResourceRecordsOptions("instance-1", *zone.ID, []dnssvcsv1.SyncResourceRecord{
+		{Name: "www", Type: "A", TTL: 300, Rdata: a},
+	})
+	plan, err := service.PlanResourceRecordSync(context.Background(), options)
+	require.Nil(t, err)
+	require.Nil(t, service.ApplyResourceRecordSyncPlan(context.Background(), plan, 0))
+
+	plan, err = service.PlanResourceRecordSync(context.Background(), options)
+	require.Nil(t, err)
+	assert.True(t, plan.IsEmpty())
+}
+
+func TestDnsSvcsServerGlobalLoadBalancers(t *testing.T) {
+	service := newDnsSvcs(t)
+	zone := createZone(t, service, "instance-1", "example.com")
+
+	monitor, _, err := service.CreateMonitor(service.NewCreateMonitorOptions("instance-1").SetName("monitor").SetType("HTTPS"))
+	require.Nil(t, err)
+	assert.Equal(t, int64(443), *monitor.Port)
+	assert.Equal(t, "/", *monitor.Path)
+
+	origin := &dnssvcsv1.OriginInput{Name: core.StringPtr("origin-1"), Address: core.StringPtr("10.0.0.1")}
+	_, _, err = service.CreatePool(service.NewCreatePoolOptions("instance-1").SetName("pool").
+		SetOrigins([]dnssvcsv1.OriginInput{*origin}).SetMonitor("missing"))
+	assert.True(t, common.IsNotFound(err))
+	pool, _, err := service.CreatePool(service.NewCreatePoolOptions("instance-1").SetName("pool").
+		SetOrigins([]dnssvcsv1.OriginInput{*origin}).SetMonitor(*monitor.ID))
+	require.Nil(t, err)
+	assert.True(t, *pool.Enabled)
+	assert.True(t, *pool.Origins[0].Enabled)
+
+	loadBalancer, _, err := service.CreateLoadBalancer(service.NewCreateLoadBalancerOptions("instance-1", *zone.ID).
+		SetName("glb").SetFallbackPool(*pool.ID).SetDefaultPools([]string{*pool.ID}))
+	require.Nil(t, err)
+	assert.Equal(t, "glb.example.com", *loadBalancer.Name)
+	assert.Equal(t, int64(60), *loadBalancer.TTL)
+
+	_, err = service.DeleteMonitor(service.NewDeleteMonitorOptions("instance-1", *monitor.ID))
+	assert.True(t, common.AsAPIError(err).HasCode("resource_in_use"))
+	_, err = service.DeletePool(service.NewDeletePoolOptions("instance-1", *pool.ID))
+	assert.True(t, common.IsConflict(err))
+
+	_, err = service.DeleteLoadBalancer(service.NewDeleteLoadBalancerOptions("instance-1", *zone.ID, *loadBalancer.ID))
+	require.Nil(t, err)
+	_, err = service.DeletePool(service.NewDeletePoolOptions("instance-1", *pool.ID))
+	require.Nil(t, err)
+	pools, _, err := service.ListPools(service.NewListPoolsOptions("instance-1"))
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), *pools.TotalCount)
+}
+
+func TestDnsSvcsServerCustomResolvers(t *testing.T) {
+	service := newDnsSvcs(t)
+	location1, _ := service.NewLocationInput("crn:v1:bluemix:public:is:us-south-1:a/account::subnet:subnet-1")
+	location2, _ := service.NewLocationInput("crn:v1:bluemix:public:is:us-south-2:a/account::subnet:subnet-2")
+	resolver, _, err := service.CreateCustomResolver(service.NewCreateCustomResolverOptions("instance-1").
+		SetName("resolver").SetLocations([]dnssvcsv1.LocationInput{*location1, *location2}))
+	require.Nil(t, err)
+	require.Len(t, resolver.Locations, 2)
+	assert.Equal(t, *location1.SubnetCrn, *resolver.Locations[0].SubnetCrn)
+	assert.NotEmpty(t, *resolver.Locations[0].DnsServerIp)
+
+	resolver, _, err = service.UpdateCrLocationsOrder(service.NewUpdateCrLocationsOrderOptions("instance-1", *resolver.ID).
+		SetLocations([]string{*resolver.Locations[1].ID, *resolver.Locations[0].ID}))
+	require.Nil(t, err)
+	assert.Equal(t, *location2.SubnetCrn, *resolver.Locations[0].SubnetCrn)
+
+	rule, _, err := service.CreateForwardingRule(service.NewCreateForwardingRuleOptions("instance-1", *resolver.ID).
+		SetType(dnssvcsv1.CreateForwardingRuleOptions_Type_Zone).SetMatch("example.com").SetForwardTo([]string{"161.26.0.7"}))
+	require.Nil(t, err)
+	rules, _, err := service.ListForwardingRules(service.NewListForwardingRulesOptions("instance-1", *resolver.ID))
+	require.Nil(t, err)
+	require.Len(t, rules.ForwardingRules, 2)
+	assert.Equal(t, dnssvcsv1.ForwardingRule_Type_Default, *rules.ForwardingRules[0].Type)
+	assert.Equal(t, *rule.ID, *rules.ForwardingRules[1].ID)
+	_, err = service.DeleteForwardingRule(service.NewDeleteForwardingRuleOptions("instance-1", *resolver.ID, *rules.ForwardingRules[0].ID))
+	assert.Equal(t, 400, common.AsAPIError(err).StatusCode)
+
+	secondaryZone, _, err := service.CreateSecondaryZone(service.NewCreateSecondaryZoneOptions("instance-1", *resolver.ID).
+		SetZone("example.org").SetTransferFrom([]string{"10.0.0.7"}))
+	require.Nil(t, err)
+	assert.False(t, *secondaryZone.Enabled)
+	secondaryZone, _, err = service.UpdateSecondaryZone(service.NewUpdateSecondaryZoneOptions("instance-1", *resolver.ID, *secondaryZone.ID).
+		SetEnabled(true))
+	require.Nil(t, err)
+	assert.True(t, *secondaryZone.Enabled)
+
+	_, err = service.DeleteCustomResolver(service.NewDeleteCustomResolverOptions("instance-1", *resolver.ID))
+	require.Nil(t, err)
+	_, _, err = service.ListSecondaryZones(service.NewListSecondaryZonesOptions("instance-1", *resolver.ID))
+	assert.True(t, common.IsNotFound(err))
+}
+
+func TestDnsSvcsServerLinkedZones(t *testing.T) {
+	service := newDnsSvcs(t)
+	zone := createZone(t, service, "owner", "example.com")
+
+	linkedZone, _, err := service.CreateLinkedZone(service.NewCreateLinkedZoneOptions("linker").
+		SetOwnerInstanceID("owner").SetOwnerZoneID(*zone.ID))
+	require.Nil(t, err)
+	assert.Equal(t, "example.com", *linkedZone.Name)
+	assert.Equal(t, dnssvcsv1.LinkedDnszone_State_PendingApproval, *linkedZone.State)
+
+	vpc, _ := service.NewPermittedNetworkVpc("crn:v1:bluemix:public:is:us-south:a/account::vpc:vpc-1")
+	networkOptions := service.NewCreateLzPermittedNetworkOptions("linker", *linkedZone.ID).
+		SetType(dnssvcsv1.CreateLzPermittedNetworkOptions_Type_Vpc).SetPermittedNetwork(vpc)
+	_, _, err = service.CreateLzPermittedNetwork(networkOptions)
+	assert.True(t, common.IsConflict(err))
+
+	requests, _, err := service.ListDnszoneAccessRequests(service.NewListDnszoneAccessRequestsOptions("owner", *zone.ID))
+	require.Nil(t, err)
+	require.Len(t, requests.AccessRequests, 1)
+	request := requests.AccessRequests[0]
+	assert.Equal(t, *linkedZone.ID, *request.Requestor.LinkedZoneID)
+	request2, _, err := service.UpdateDnszoneAccessRequest(service.NewUpdateDnszoneAccessRequestOptions("owner", *zone.ID, *request.ID).
+		SetAction(dnssvcsv1.UpdateDnszoneAccessRequestOptions_Action_Approve))
+	require.Nil(t, err)
+	assert.Equal(t, dnssvcsv1.AccessRequest_State_Approved, *request2.State)
+
+	_, _, err = service.CreateLzPermittedNetwork(networkOptions)
+	require.Nil(t, err)
+	linkedZone, _, err = service.GetLinkedZone(service.NewGetLinkedZoneOptions("linker", *linkedZone.ID))
+	require.Nil(t, err)
+	assert.Equal(t, dnssvcsv1.LinkedDnszone_State_Active, *linkedZone.State)
+}
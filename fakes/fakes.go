@@ -0,0 +1,801 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fakes provides in-memory fakes of the services of this SDK, for testing code that uses the
+// service packages without network access. Each fake is an http.Handler that keeps its state in
+// memory; serve it with net/http/httptest and point a service at the URL of the test server:
+//
+//   server := httptest.NewServer(fakes.NewDnsSvcsServer())
+//   defer server.Close()
+//
+//   dnsSvcs, err := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+//     URL:           server.URL,
+//     Authenticator: &core.NoAuthAuthenticator{},
+//   })
+//
+// The fakes implement the resources, validation and error envelopes of the services closely enough
+// for unit tests, but not every rule the services enforce. The fakes of services whose resources move
+// through asynchronous states can take their time from a Clock, to control when the transitions take
+// place.
+package fakes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// object is a resource as it is stored and serialized.
+type object = map[string]interface{}
+
+// apiError is an error response of a fake.
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func newAPIError(status int, code string, format string, a ...interface{}) *apiError {
+	return &apiError{status: status, code: code, message: fmt.Sprintf(format, a...)}
+}
+
+func errBadRequest(format string, a ...interface{}) *apiError {
+	return newAPIError(http.StatusBadRequest, "invalid_request", format, a...)
+}
+
+func errNotFound(format string, a ...interface{}) *apiError {
+	return newAPIError(http.StatusNotFound, "not_found", format, a...)
+}
+
+func errConflict(format string, a ...interface{}) *apiError {
+	return newAPIError(http.StatusConflict, "already_exists", format, a...)
+}
+
+// envelope writes the responses of a fake in the format of its service.
+type envelope interface {
+	// writeResult writes a successful response with the given result, which may be nil.
+	writeResult(w http.ResponseWriter, r *http.Request, status int, result interface{})
+
+	// writeList writes the response to a list request, taking the page to return from the
+	// request's query parameters.
+	writeList(w http.ResponseWriter, r *http.Request, res *resource, items []object)
+
+	// writeError writes an error response.
+	writeError(w http.ResponseWriter, r *http.Request, err *apiError)
+}
+
+// request is a request for a resource, with the path parameters and the parent resource.
+type request struct {
+	*http.Request
+
+	// The path parameters, in order.
+	params []string
+
+	// The path of the collection of the resource.
+	collectionPath string
+
+	// The resource the collection belongs to, or nil for a top-level collection.
+	parent object
+}
+
+// resource describes a collection of resources served by a fake.
+type resource struct {
+	// The path of the collection, with * for each path parameter, e.g. "/instances/*/dnszones".
+	path string
+
+	// The key of the items in the body of list responses.
+	listKey string
+
+	// Whether list responses are paginated.
+	paginated bool
+
+	// The fields (dotted for nested fields) that lists can be filtered by, with a query parameter of
+	// the same name, and ordered by, with the order and direction query parameters. The items must
+	// match all the filters, or any of them if the match query parameter is "any".
+	filters, orders []string
+
+	// The fields (dotted for nested fields) that are required when the resource is created.
+	required []string
+
+	// The fields whose values, together, must be unique in the collection (compared ignoring case).
+	unique []string
+
+	// The fields that are ignored when the resource is updated.
+	immutable []string
+
+	// The methods used to update a resource, if resources can be updated.
+	updateMethods []string
+
+	// Whether resources can't be created or deleted through the API.
+	noCreate, noDelete bool
+
+	// Whether resources can be created (POST), updated (PUT) and deleted (DELETE with the id query
+	// parameter) in bulk, on the collection.
+	bulk bool
+
+	// The status of delete responses, and the result they return, if any.
+	deleteStatus int
+	deleteResult func(item object) interface{}
+
+	// If set, deleting a resource applies these changes to it, e.g. a "deleting" status, and the
+	// resource is only removed after the transition delay of the server.
+	deleting object
+
+	// Hooks that validate and complete a resource being created or updated, or check that a
+	// resource can be deleted; they are called with the lock of the server held.
+	create func(req *request, item object) *apiError
+	update func(req *request, item object, changes object) *apiError
+	delete func(req *request, item object) *apiError
+
+	// Hooks that update other resources once a resource has been created or deleted.
+	created func(req *request, item object)
+	deleted func(req *request, item object)
+
+	// Hook that returns the resource as it is rendered in responses.
+	render func(itemPath string, item object) object
+}
+
+// action is a request handled outside of the resources of a fake.
+type action struct {
+	method  string
+	path    string
+	handler func(req *request) (status int, result interface{}, err *apiError)
+}
+
+// collection is the resources of a collection, in order of creation.
+type collection struct {
+	ids   []string
+	items map[string]object
+}
+
+// server is the in-memory store and HTTP handler shared by the fakes.
+type server struct {
+	mu          sync.Mutex
+	envelope    envelope
+	resources   []*resource
+	actions     []*action
+	collections map[string]*collection
+	now         func() time.Time
+	newID       func() string
+
+	// The fields holding the creation and modification times of the resources.
+	createdField, modifiedField string
+
+	// The delay after which the scheduled transitions of resources take place, and the transitions
+	// that haven't taken place yet.
+	delay       time.Duration
+	transitions []*transition
+}
+
+func newServer(env envelope) *server {
+	return &server{
+		envelope:    env,
+		collections: map[string]*collection{},
+		now:         time.Now,
+		newID: func() string {
+			return uuid.New().String()
+		},
+		createdField:  "created_on",
+		modifiedField: "modified_on",
+	}
+}
+
+// ServeHTTP routes a request to the action or resource whose path it matches. Paths are matched, and
+// resources stored, with their path parameters escaped.
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.applyTransitions()
+
+	segments := splitPath(r.URL.EscapedPath())
+	for _, a := range s.actions {
+		if params, ok := matchPath(splitPath(a.path), segments); ok {
+			if r.Method != a.method {
+				s.envelope.writeError(w, r, errMethodNotAllowed(r))
+				return
+			}
+			status, result, err := a.handler(&request{Request: r, params: params})
+			if err != nil {
+				s.envelope.writeError(w, r, err)
+				return
+			}
+			s.envelope.writeResult(w, r, status, result)
+			return
+		}
+	}
+	for _, res := range s.resources {
+		pattern := splitPath(res.path)
+		if params, ok := matchPath(pattern, segments); ok {
+			s.serveCollection(w, r, res, params)
+			return
+		}
+		if len(segments) == len(pattern)+1 {
+			if params, ok := matchPath(pattern, segments[:len(pattern)]); ok {
+				s.serveItem(w, r, res, params, segments[len(pattern)])
+				return
+			}
+		}
+	}
+	s.envelope.writeError(w, r, errNotFound("the path %s was not found", r.URL.Path))
+}
+
+func (s *server) serveCollection(w http.ResponseWriter, r *http.Request, res *resource, params []string) {
+	req, err := s.newRequest(r, res, params)
+	if err == nil {
+		var status int
+		var result interface{}
+		switch {
+		case r.Method == http.MethodGet:
+			var items []object
+			if items, err = s.query(req, res); err == nil {
+				s.envelope.writeList(w, r, res, items)
+				return
+			}
+		case r.Method == http.MethodPost && !res.noCreate:
+			status, result, err = s.serveCreate(req, res)
+		case r.Method == http.MethodPut && res.bulk:
+			status, result, err = s.serveBulkUpdate(req, res)
+		case r.Method == http.MethodDelete && res.bulk:
+			status, result, err = s.serveBulkDelete(req, res)
+		default:
+			err = errMethodNotAllowed(r)
+		}
+		if err == nil {
+			s.envelope.writeResult(w, r, status, result)
+			return
+		}
+	}
+	s.envelope.writeError(w, r, err)
+}
+
+func (s *server) serveItem(w http.ResponseWriter, r *http.Request, res *resource, params []string, id string) {
+	req, err := s.newRequest(r, res, params)
+	if err == nil {
+		item, ok := s.get(req.collectionPath + "/" + id)
+		switch {
+		case !ok:
+			err = errNotFound("%s %s was not found", resourceName(res), id)
+		case r.Method == http.MethodGet:
+			s.envelope.writeResult(w, r, http.StatusOK, s.rendered(res, req.collectionPath, item))
+			return
+		case containsString(res.updateMethods, r.Method):
+			var changes, updated object
+			if changes, err = decodeBody(r); err == nil {
+				if updated, err = s.prepareUpdate(req, res, item, changes); err == nil {
+					s.commitUpdate(item, updated)
+					s.envelope.writeResult(w, r, http.StatusOK, s.rendered(res, req.collectionPath, item))
+					return
+				}
+			}
+		case r.Method == http.MethodDelete && !res.noDelete:
+			if err = s.checkDelete(req, res, item); err == nil {
+				s.delete(req, res, item)
+				s.envelope.writeResult(w, r, deleteStatus(res), deleteResult(res, item))
+				return
+			}
+		default:
+			err = errMethodNotAllowed(r)
+		}
+	}
+	s.envelope.writeError(w, r, err)
+}
+
+func (s *server) serveCreate(req *request, res *resource) (status int, result interface{}, err *apiError) {
+	if !res.bulk {
+		var item object
+		if item, err = decodeBody(req.Request); err == nil {
+			if err = s.create(req, res, item); err == nil {
+				return http.StatusOK, s.rendered(res, req.collectionPath, item), nil
+			}
+		}
+		return
+	}
+	var items []object
+	if err = decodeJSON(req.Request, &items); err != nil {
+		return
+	}
+	rendered := []object{}
+	for i, item := range items {
+		if err = s.create(req, res, item); err != nil {
+			for _, created := range items[:i] {
+				s.remove(req.collectionPath + "/" + created["id"].(string))
+			}
+			return
+		}
+		rendered = append(rendered, s.rendered(res, req.collectionPath, item))
+	}
+	return http.StatusOK, rendered, nil
+}
+
+func (s *server) serveBulkUpdate(req *request, res *resource) (status int, result interface{}, err *apiError) {
+	var changesList []object
+	if err = decodeJSON(req.Request, &changesList); err != nil {
+		return
+	}
+	var items, updates []object
+	for _, changes := range changesList {
+		id := stringValue(changes, "id")
+		item, ok := s.get(req.collectionPath + "/" + id)
+		if !ok {
+			return 0, nil, errNotFound("%s %s was not found", resourceName(res), id)
+		}
+		var updated object
+		if updated, err = s.prepareUpdate(req, res, item, changes); err != nil {
+			return
+		}
+		items, updates = append(items, item), append(updates, updated)
+	}
+	rendered := []object{}
+	for i, item := range items {
+		s.commitUpdate(item, updates[i])
+		rendered = append(rendered, s.rendered(res, req.collectionPath, item))
+	}
+	return http.StatusOK, rendered, nil
+}
+
+func (s *server) serveBulkDelete(req *request, res *resource) (status int, result interface{}, err *apiError) {
+	var items []object
+	for _, ids := range req.URL.Query()["id"] {
+		for _, id := range strings.Split(ids, ",") {
+			item, ok := s.get(req.collectionPath + "/" + id)
+			if !ok {
+				return 0, nil, errNotFound("%s %s was not found", resourceName(res), id)
+			}
+			if err = s.checkDelete(req, res, item); err != nil {
+				return
+			}
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return 0, nil, errBadRequest("id is required")
+	}
+	results := []interface{}{}
+	for _, item := range items {
+		s.delete(req, res, item)
+		results = append(results, deleteResult(res, item))
+	}
+	return deleteStatus(res), results, nil
+}
+
+// newRequest returns the request for a collection, checking that the resource it belongs to, if any,
+// exists; this is the closest resource whose path is a prefix of the path of the collection.
+func (s *server) newRequest(r *http.Request, res *resource, params []string) (req *request, err *apiError) {
+	segments := splitPath(r.URL.EscapedPath())[:len(splitPath(res.path))]
+	req = &request{Request: r, params: params, collectionPath: "/" + strings.Join(segments, "/")}
+	for n := len(segments) - 1; n > 1; n-- {
+		for _, parentRes := range s.resources {
+			pattern := splitPath(parentRes.path)
+			if n != len(pattern)+1 {
+				continue
+			}
+			if _, ok := matchPath(pattern, segments[:n-1]); ok {
+				parent, ok := s.get("/" + strings.Join(segments[:n], "/"))
+				if !ok {
+					return nil, errNotFound("%s %s was not found", resourceName(parentRes), segments[n-1])
+				}
+				req.parent = parent
+				return
+			}
+		}
+	}
+	return
+}
+
+// query returns the rendered items of a collection that match the filters of a list request, in the
+// requested order.
+func (s *server) query(req *request, res *resource) (items []object, err *apiError) {
+	values := req.URL.Query()
+	matchAny := values.Get("match") == "any"
+	for _, item := range s.list(req.collectionPath) {
+		item = s.rendered(res, req.collectionPath, item)
+		matched, filtered := !matchAny, false
+		for _, field := range res.filters {
+			if value := values.Get(field); value != "" {
+				filtered = true
+				fieldMatched := strings.EqualFold(fmt.Sprint(lookup(item, field)), value)
+				if matchAny {
+					matched = matched || fieldMatched
+				} else {
+					matched = matched && fieldMatched
+				}
+			}
+		}
+		if matched || !filtered {
+			items = append(items, item)
+		}
+	}
+	if order := values.Get("order"); order != "" {
+		if !containsString(res.orders, order) {
+			return nil, errBadRequest("order %q is not one of %s", order, strings.Join(res.orders, ", "))
+		}
+		descending := values.Get("direction") == "desc"
+		sort.SliceStable(items, func(i, j int) bool {
+			c := compareValues(lookup(items[i], order), lookup(items[j], order))
+			if descending {
+				return c > 0
+			}
+			return c < 0
+		})
+	}
+	return
+}
+
+// create validates and completes a resource, and adds it to its collection.
+func (s *server) create(req *request, res *resource, item object) (err *apiError) {
+	if item == nil {
+		return errBadRequest("the request body is not a JSON object")
+	}
+	for _, field := range res.required {
+		if isEmpty(lookup(item, field)) {
+			return errBadRequest("%s is required", field)
+		}
+	}
+	if res.create != nil {
+		if err = res.create(req, item); err != nil {
+			return
+		}
+	}
+	if err = s.checkUnique(req.collectionPath, res, item, ""); err != nil {
+		return
+	}
+	s.insert(req.collectionPath, item)
+	if res.created != nil {
+		res.created(req, item)
+	}
+	return
+}
+
+// prepareUpdate returns a resource with the given changes applied, validated and completed, to be
+// committed with commitUpdate.
+func (s *server) prepareUpdate(req *request, res *resource, item object, changes object) (updated object, err *apiError) {
+	for _, field := range append([]string{"id", s.createdField, s.modifiedField}, res.immutable...) {
+		delete(changes, field)
+	}
+	updated = object{}
+	for key, value := range item {
+		updated[key] = value
+	}
+	for key, value := range changes {
+		if value == nil {
+			delete(updated, key)
+		} else {
+			updated[key] = value
+		}
+	}
+	if res.update != nil {
+		if err = res.update(req, updated, changes); err != nil {
+			return nil, err
+		}
+	}
+	if err = s.checkUnique(req.collectionPath, res, updated, item["id"].(string)); err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (s *server) commitUpdate(item object, updated object) {
+	for key := range item {
+		delete(item, key)
+	}
+	for key, value := range updated {
+		item[key] = value
+	}
+	item[s.modifiedField] = s.timestamp()
+}
+
+func (s *server) checkDelete(req *request, res *resource, item object) *apiError {
+	if res.delete != nil {
+		return res.delete(req, item)
+	}
+	return nil
+}
+
+func (s *server) delete(req *request, res *resource, item object) {
+	itemPath := req.collectionPath + "/" + item["id"].(string)
+	if res.deleting != nil {
+		for key, value := range res.deleting {
+			item[key] = value
+		}
+		item[s.modifiedField] = s.timestamp()
+		s.unschedule(itemPath)
+		s.schedule(itemPath, nil)
+	} else {
+		s.remove(itemPath)
+	}
+	if res.deleted != nil {
+		res.deleted(req, item)
+	}
+}
+
+func (s *server) checkUnique(collectionPath string, res *resource, item object, id string) *apiError {
+	if len(res.unique) == 0 {
+		return nil
+	}
+	for _, other := range s.list(collectionPath) {
+		if other["id"] == id {
+			continue
+		}
+		duplicate := true
+		for _, field := range res.unique {
+			duplicate = duplicate && equalValues(lookup(item, field), lookup(other, field))
+		}
+		if duplicate {
+			return errConflict("%s with the same %s already exists", resourceName(res), strings.Join(res.unique, ", "))
+		}
+	}
+	return nil
+}
+
+func (s *server) rendered(res *resource, collectionPath string, item object) object {
+	if res.render == nil {
+		return item
+	}
+	return res.render(collectionPath+"/"+item["id"].(string), item)
+}
+
+// insert adds an item to a collection, assigning its ID and timestamps.
+func (s *server) insert(collectionPath string, item object) {
+	c := s.collections[collectionPath]
+	if c == nil {
+		c = &collection{items: map[string]object{}}
+		s.collections[collectionPath] = c
+	}
+	id := s.newID()
+	item["id"] = id
+	item[s.createdField] = s.timestamp()
+	item[s.modifiedField] = item[s.createdField]
+	c.ids = append(c.ids, id)
+	c.items[id] = item
+}
+
+// moveBefore moves an item of a collection before another one.
+func (s *server) moveBefore(collectionPath string, id string, beforeID string) {
+	c := s.collections[collectionPath]
+	var ids []string
+	for _, other := range c.ids {
+		if other == beforeID {
+			ids = append(ids, id)
+		}
+		if other != id {
+			ids = append(ids, other)
+		}
+	}
+	c.ids = ids
+}
+
+// get returns the item at a path.
+func (s *server) get(itemPath string) (item object, ok bool) {
+	i := strings.LastIndex(itemPath, "/")
+	if c := s.collections[itemPath[:i]]; c != nil {
+		item, ok = c.items[itemPath[i+1:]]
+	}
+	return
+}
+
+// list returns the items of a collection, in order of creation.
+func (s *server) list(collectionPath string) (items []object) {
+	if c := s.collections[collectionPath]; c != nil {
+		for _, id := range c.ids {
+			items = append(items, c.items[id])
+		}
+	}
+	return
+}
+
+// listAll returns the items of the collections whose path matches a pattern, e.g.
+// "/instances/i-1/dnszones/*/load_balancers".
+func (s *server) listAll(pattern string) (items []object) {
+	for path := range s.collections {
+		if _, ok := matchPath(splitPath(pattern), splitPath(path)); ok {
+			items = append(items, s.list(path)...)
+		}
+	}
+	return
+}
+
+// remove deletes the item at a path, and the collections that belong to it.
+func (s *server) remove(itemPath string) {
+	i := strings.LastIndex(itemPath, "/")
+	if c := s.collections[itemPath[:i]]; c != nil {
+		id := itemPath[i+1:]
+		delete(c.items, id)
+		for j, other := range c.ids {
+			if other == id {
+				c.ids = append(c.ids[:j], c.ids[j+1:]...)
+				break
+			}
+		}
+	}
+	for path := range s.collections {
+		if strings.HasPrefix(path, itemPath+"/") {
+			delete(s.collections, path)
+		}
+	}
+}
+
+func (s *server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
+
+func errInUse(format string, a ...interface{}) *apiError {
+	return newAPIError(http.StatusConflict, "resource_in_use", format, a...)
+}
+
+func errMethodNotAllowed(r *http.Request) *apiError {
+	return newAPIError(http.StatusMethodNotAllowed, "method_not_allowed", "the method %s is not allowed for %s", r.Method, r.URL.Path)
+}
+
+// resourceName returns the name of a resource for error messages, e.g. "dnszone" for "/instances/*/dnszones".
+func resourceName(res *resource) string {
+	segments := splitPath(res.path)
+	return strings.TrimSuffix(segments[len(segments)-1], "s")
+}
+
+func splitPath(path string) (segments []string) {
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return
+}
+
+// matchPath returns the path parameters if the segments match the pattern.
+func matchPath(pattern []string, segments []string) (params []string, ok bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	for i, segment := range pattern {
+		if segment == "*" {
+			params = append(params, segments[i])
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func deleteStatus(res *resource) int {
+	if res.deleteStatus == 0 {
+		return http.StatusNoContent
+	}
+	return res.deleteStatus
+}
+
+func deleteResult(res *resource, item object) interface{} {
+	if res.deleteResult == nil {
+		return nil
+	}
+	return res.deleteResult(item)
+}
+
+// decodeBody returns the JSON object in the body of a request, or an empty object if it is empty.
+func decodeBody(r *http.Request) (body object, err *apiError) {
+	body = object{}
+	return body, decodeJSON(r, &body)
+}
+
+func decodeJSON(r *http.Request, v interface{}) *apiError {
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return errBadRequest("the request body could not be read: %s", err.Error())
+	}
+	if len(buf) == 0 {
+		return nil
+	}
+	if err = json.Unmarshal(buf, v); err != nil {
+		return errBadRequest("the request body is not valid: %s", err.Error())
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// lookup returns the value of a dotted field of an object, or nil.
+func lookup(item object, field string) interface{} {
+	var value interface{} = item
+	for _, key := range strings.Split(field, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+func isEmpty(value interface{}) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case []interface{}:
+		return len(value) == 0
+	}
+	return false
+}
+
+func equalValues(a, b interface{}) bool {
+	if a, ok := a.(string); ok {
+		if b, ok := b.(string); ok {
+			return strings.EqualFold(a, b)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// compareValues compares two JSON values, numerically if both are numbers.
+func compareValues(a, b interface{}) int {
+	if a, ok := toFloat(a); ok {
+		if b, ok := toFloat(b); ok {
+			switch {
+			case a < b:
+				return -1
+			case a > b:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, true
+	case int:
+		return float64(value), true
+	}
+	return 0, false
+}
+
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// stringValue returns a string field of an object, or "".
+func stringValue(item object, field string) string {
+	value, _ := lookup(item, field).(string)
+	return value
+}
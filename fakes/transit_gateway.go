@@ -0,0 +1,497 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakes
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// The default and maximum number of items per page of the paginated lists of the Transit Gateway fake.
+	transitGatewayDefaultLimit = 50
+	transitGatewayMaxLimit     = 50
+
+	// The BGP ASN of the transit gateway side of GRE tunnel connections.
+	transitGatewayLocalBgpAsn = 64490
+)
+
+// TransitGatewayServer is an in-memory fake of the Transit Gateway API, served by transitgatewayapisv1.
+// It covers transit gateways, their connections (vpc, classic, directlink and gre_tunnel), the prefix
+// filters of the connections and route reports.
+//
+// Like the service, the fake creates and deletes resources asynchronously: transit gateways are created
+// "pending" and become "available", connections are created "pending" and become "attached", route
+// reports are created "pending" and become "complete", and deleted transit gateways and connections are
+// "deleting" before they disappear. Each transition takes place once the transition delay has elapsed
+// on the clock of the fake; see SetClock and SetTransitionDelay. Connections to a network of another
+// account (with a network_account_id) are "pending_approval" until they are approved or rejected with
+// CreateTransitGatewayConnectionActions.
+//
+// Requests must have the version query parameter. Lists honor the limit and start query parameters.
+type TransitGatewayServer struct {
+	srv *server
+}
+
+// NewTransitGatewayServer returns a new, empty fake of the Transit Gateway API. Its clock is the system
+// clock, and its transition delay is zero: resources reach their next state by the next request.
+func NewTransitGatewayServer() *TransitGatewayServer {
+	s := &TransitGatewayServer{srv: newServer(startEnvelope{
+		defaultLimit: transitGatewayDefaultLimit,
+		maxLimit:     transitGatewayMaxLimit,
+		moreInfo:     "https://cloud.ibm.com/docs/transit-gateway",
+	})}
+	s.srv.createdField = "created_at"
+	s.srv.modifiedField = "updated_at"
+
+	s.srv.resources = []*resource{
+		{
+			path:          "/transit_gateways",
+			listKey:       "transit_gateways",
+			paginated:     true,
+			required:      []string{"name", "location"},
+			unique:        []string{"name"},
+			immutable:     []string{"crn", "location", "status", "resource_group"},
+			updateMethods: []string{http.MethodPatch},
+			deleting:      object{"status": "deleting"},
+			create:        s.createTransitGateway,
+			created:       s.transitGatewayCreated,
+			delete:        s.deleteTransitGateway,
+		},
+		{
+			path:          "/transit_gateways/*/connections",
+			listKey:       "connections",
+			paginated:     true,
+			required:      []string{"network_type"},
+			unique:        []string{"name"},
+			immutable:     []string{"network_type", "network_id", "network_account_id", "base_connection_id", "status", "request_status", "prefix_filters"},
+			updateMethods: []string{http.MethodPatch},
+			deleting:      object{"status": "deleting"},
+			create:        s.createConnection,
+			created:       s.connectionCreated,
+			update:        s.updateConnection,
+			delete:        s.deleteConnection,
+			render:        s.renderConnection,
+		},
+		{
+			path:          "/transit_gateways/*/connections/*/prefix_filters",
+			listKey:       "prefix_filters",
+			required:      []string{"action", "prefix"},
+			immutable:     []string{"before"},
+			updateMethods: []string{http.MethodPatch},
+			create:        s.createPrefixFilter,
+			created:       s.prefixFilterCreated,
+			update:        s.updatePrefixFilter,
+		},
+		{
+			path:    "/transit_gateways/*/route_reports",
+			listKey: "route_reports",
+			create:  s.createRouteReport,
+			created: s.routeReportCreated,
+		},
+	}
+	s.srv.actions = []*action{
+		{method: http.MethodGet, path: "/connections", handler: s.listConnections},
+		{method: http.MethodPost, path: "/transit_gateways/*/connections/*/actions", handler: s.updateConnectionRequest},
+	}
+	return s
+}
+
+// SetClock makes the fake take the time from a clock, or from the system clock if clock is nil.
+func (s *TransitGatewayServer) SetClock(clock *Clock) {
+	s.srv.setClock(clock)
+}
+
+// SetTransitionDelay sets the time that the transitions of resources started from now on take.
+func (s *TransitGatewayServer) SetTransitionDelay(delay time.Duration) {
+	s.srv.setDelay(delay)
+}
+
+// ServeHTTP serves a request to the Transit Gateway API.
+func (s *TransitGatewayServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !checkVersion(s.srv, w, r) {
+		return
+	}
+	s.srv.ServeHTTP(w, r)
+}
+
+func (s *TransitGatewayServer) createTransitGateway(req *request, item object) *apiError {
+	setDefaults(item, object{"global": false})
+	item["status"] = "pending"
+	item["resource_group"] = resourceGroupReference(item)
+	return nil
+}
+
+func (s *TransitGatewayServer) transitGatewayCreated(req *request, item object) {
+	item["crn"] = "crn:v1:bluemix:public:transit:" + stringValue(item, "location") + ":a/fake-account::gateway:" + item["id"].(string)
+	s.srv.schedule(req.collectionPath+"/"+item["id"].(string), object{"status": "available"})
+}
+
+func (s *TransitGatewayServer) deleteTransitGateway(req *request, item object) *apiError {
+	if connections := s.srv.list(req.collectionPath + "/" + item["id"].(string) + "/connections"); len(connections) > 0 {
+		return errInUse("transit gateway %s has %d connections, which must be deleted first", item["id"], len(connections))
+	}
+	return nil
+}
+
+func (s *TransitGatewayServer) createConnection(req *request, item object) *apiError {
+	networkType := stringValue(item, "network_type")
+	switch networkType {
+	case "vpc", "directlink":
+		if !strings.HasPrefix(stringValue(item, "network_id"), "crn:") {
+			return errBadRequest("network_id must be the CRN of the network of %s connections", networkType)
+		}
+	case "classic":
+	case "gre_tunnel":
+		baseID := stringValue(item, "base_connection_id")
+		base, ok := s.srv.get(req.collectionPath + "/" + baseID)
+		if !ok || base["network_type"] != "classic" {
+			return errBadRequest("base_connection_id %q is not the ID of a classic connection of the transit gateway", baseID)
+		}
+		for _, field := range []string{"local_gateway_ip", "local_tunnel_ip", "remote_gateway_ip", "remote_tunnel_ip"} {
+			if net.ParseIP(stringValue(item, field)) == nil {
+				return errBadRequest("%s is required for gre_tunnel connections, and must be an IP address", field)
+			}
+		}
+		if stringValue(item, "zone.name") == "" {
+			return errBadRequest("zone.name is required for gre_tunnel connections")
+		}
+		// The remote ASN is a string in requests, and a number in responses.
+		if asn, ok := item["remote_bgp_asn"].(string); ok {
+			n, err := strconv.Atoi(asn)
+			if err != nil {
+				return errBadRequest("remote_bgp_asn %q is not a number", asn)
+			}
+			item["remote_bgp_asn"] = n
+		}
+		setDefaults(item, object{"local_bgp_asn": transitGatewayLocalBgpAsn, "mtu": 9000, "remote_bgp_asn": 64999})
+	default:
+		return errBadRequest("network_type %q is not one of vpc, classic, directlink and gre_tunnel", networkType)
+	}
+	for _, other := range s.srv.list(req.collectionPath) {
+		if networkType != "gre_tunnel" && other["network_type"] == networkType && equalValues(other["network_id"], item["network_id"]) {
+			return errConflict("the transit gateway already has a connection to this %s network", networkType)
+		}
+	}
+	setDefaults(item, object{"name": networkType + "-" + uuid.New().String()[:8], "prefix_filters_default": "permit"})
+	if err := checkPrefixFiltersDefault(item); err != nil {
+		return err
+	}
+	for i, filter := range objects(item["prefix_filters"]) {
+		if err := checkPrefixFilter(filter); err != nil {
+			err.message = "prefix_filters[" + strconv.Itoa(i) + "]: " + err.message
+			return err
+		}
+	}
+	item["status"] = "pending"
+	if stringValue(item, "network_account_id") != "" {
+		item["status"] = "pending_approval"
+		item["request_status"] = "pending"
+	}
+	return nil
+}
+
+func (s *TransitGatewayServer) connectionCreated(req *request, item object) {
+	itemPath := req.collectionPath + "/" + item["id"].(string)
+	for _, filter := range objects(item["prefix_filters"]) {
+		s.srv.insert(itemPath+"/prefix_filters", filter)
+	}
+	delete(item, "prefix_filters")
+	if item["status"] == "pending" {
+		s.srv.schedule(itemPath, object{"status": "attached"})
+	}
+}
+
+func (s *TransitGatewayServer) updateConnection(req *request, item object, changes object) *apiError {
+	return checkPrefixFiltersDefault(item)
+}
+
+func (s *TransitGatewayServer) deleteConnection(req *request, item object) *apiError {
+	for _, other := range s.srv.list(req.collectionPath) {
+		if other["base_connection_id"] == item["id"] {
+			return errInUse("connection %s is the base connection of GRE tunnel %s, which must be deleted first", item["id"], other["id"])
+		}
+	}
+	return nil
+}
+
+// renderConnection returns a connection with its prefix filters.
+func (s *TransitGatewayServer) renderConnection(itemPath string, item object) object {
+	rendered := object{}
+	for key, value := range item {
+		rendered[key] = value
+	}
+	if filters := s.srv.list(itemPath + "/prefix_filters"); len(filters) > 0 {
+		rendered["prefix_filters"] = filters
+	}
+	return rendered
+}
+
+// listConnections lists the connections of all the transit gateways.
+func (s *TransitGatewayServer) listConnections(req *request) (int, interface{}, *apiError) {
+	var connections []object
+	for _, gateway := range s.srv.list("/transit_gateways") {
+		gatewayPath := "/transit_gateways/" + gateway["id"].(string)
+		for _, connection := range s.srv.list(gatewayPath + "/connections") {
+			connection = s.renderConnection(gatewayPath+"/connections/"+connection["id"].(string), connection)
+			connection["transit_gateway"] = object{"id": gateway["id"], "crn": gateway["crn"], "name": gateway["name"]}
+			connections = append(connections, connection)
+		}
+	}
+	body, err := startPage(req.Request, "connections", connections, transitGatewayDefaultLimit, transitGatewayMaxLimit, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, body, nil
+}
+
+// updateConnectionRequest approves or rejects a connection to a network of another account.
+func (s *TransitGatewayServer) updateConnectionRequest(req *request) (int, interface{}, *apiError) {
+	itemPath := "/transit_gateways/" + req.params[0] + "/connections/" + req.params[1]
+	connection, ok := s.srv.get(itemPath)
+	if !ok {
+		return 0, nil, errNotFound("connection %s was not found", req.params[1])
+	}
+	body, err := decodeBody(req.Request)
+	if err != nil {
+		return 0, nil, err
+	}
+	if connection["request_status"] != "pending" {
+		return 0, nil, newAPIError(http.StatusConflict, "invalid_state", "connection %s is not pending approval", req.params[1])
+	}
+	switch body["action"] {
+	case "approve":
+		connection["status"] = "pending"
+		connection["request_status"] = "approved"
+		s.srv.schedule(itemPath, object{"status": "attached"})
+	case "reject":
+		connection["status"] = "detached"
+		connection["request_status"] = "rejected"
+	default:
+		return 0, nil, errBadRequest("action %q is not one of approve and reject", body["action"])
+	}
+	connection["updated_at"] = s.srv.timestamp()
+	return http.StatusNoContent, nil, nil
+}
+
+func (s *TransitGatewayServer) createPrefixFilter(req *request, item object) *apiError {
+	if before := stringValue(item, "before"); before != "" {
+		if _, ok := s.srv.get(req.collectionPath + "/" + before); !ok {
+			return errBadRequest("before %q is not the ID of a prefix filter of the connection", before)
+		}
+	}
+	return checkPrefixFilter(item)
+}
+
+func (s *TransitGatewayServer) prefixFilterCreated(req *request, item object) {
+	if before := stringValue(item, "before"); before != "" {
+		s.srv.moveBefore(req.collectionPath, item["id"].(string), before)
+	}
+}
+
+func (s *TransitGatewayServer) updatePrefixFilter(req *request, item object, changes object) *apiError {
+	return checkPrefixFilter(item)
+}
+
+func (s *TransitGatewayServer) createRouteReport(req *request, item object) *apiError {
+	item["status"] = "pending"
+	item["connections"] = []object{}
+	item["overlapping_routes"] = []object{}
+	return nil
+}
+
+// routeReportCreated schedules the completion of a route report, listing the connections of the transit
+// gateway at the time of the request. The fake doesn't know the routes of the connected networks.
+func (s *TransitGatewayServer) routeReportCreated(req *request, item object) {
+	gatewayPath := strings.TrimSuffix(req.collectionPath, "/route_reports")
+	connections := []object{}
+	for _, connection := range s.srv.list(gatewayPath + "/connections") {
+		connections = append(connections, object{
+			"id":     connection["id"],
+			"name":   connection["name"],
+			"type":   connection["network_type"],
+			"routes": []object{},
+		})
+	}
+	s.srv.schedule(req.collectionPath+"/"+item["id"].(string), object{"status": "complete", "connections": connections})
+}
+
+func checkPrefixFiltersDefault(item object) *apiError {
+	switch item["prefix_filters_default"] {
+	case "permit", "deny":
+		return nil
+	}
+	return errBadRequest("prefix_filters_default %q is not one of permit and deny", item["prefix_filters_default"])
+}
+
+// checkPrefixFilter checks the action, prefix and length range of a prefix filter.
+func checkPrefixFilter(filter object) *apiError {
+	switch filter["action"] {
+	case "permit", "deny":
+	default:
+		return errBadRequest("action %q is not one of permit and deny", filter["action"])
+	}
+	_, prefix, err := net.ParseCIDR(stringValue(filter, "prefix"))
+	if err != nil || prefix.IP.To4() == nil {
+		return errBadRequest("prefix %q is not an IPv4 CIDR", filter["prefix"])
+	}
+	length, _ := prefix.Mask.Size()
+	ge, hasGe := toFloat(filter["ge"])
+	le, hasLe := toFloat(filter["le"])
+	if (hasGe && (ge < float64(length) || ge > 32)) || (hasLe && (le < float64(length) || le > 32)) || (hasGe && hasLe && ge > le) {
+		return errBadRequest("the range of prefix lengths [ge, le] must be within [%d, 32]", length)
+	}
+	return nil
+}
+
+// resourceGroupReference returns the reference to the resource group of a resource being created,
+// which is a default resource group if it isn't given.
+func resourceGroupReference(item object) object {
+	id := stringValue(item, "resource_group.id")
+	if id == "" {
+		id = "fake-resource-group"
+	}
+	return object{"id": id, "href": "https://resource-controller.cloud.ibm.com/v2/resource_groups/" + id}
+}
+
+// objects returns the objects of a JSON array.
+func objects(value interface{}) (objs []object) {
+	values, _ := value.([]interface{})
+	for _, value := range values {
+		if obj, ok := value.(map[string]interface{}); ok {
+			objs = append(objs, obj)
+		}
+	}
+	return
+}
+
+// checkVersion writes an error response and returns false if a request doesn't have the version query
+// parameter.
+func checkVersion(srv *server, w http.ResponseWriter, r *http.Request) bool {
+	if r.URL.Query().Get("version") == "" {
+		srv.envelope.writeError(w, r, errBadRequest("the version query parameter is required"))
+		return false
+	}
+	return true
+}
+
+// startEnvelope writes responses in the format of Transit Gateway and Direct Link, whose paginated lists
+// are paged with the limit and start query parameters.
+type startEnvelope struct {
+	defaultLimit, maxLimit int
+
+	// Whether paginated lists have the total number of items.
+	totalCount bool
+
+	// The link to the documentation of the service, in errors.
+	moreInfo string
+}
+
+func (startEnvelope) writeResult(w http.ResponseWriter, r *http.Request, status int, result interface{}) {
+	w.Header().Set("X-Request-Id", requestID(r))
+	writeJSON(w, status, result)
+}
+
+func (env startEnvelope) writeList(w http.ResponseWriter, r *http.Request, res *resource, items []object) {
+	if !res.paginated {
+		if items == nil {
+			items = []object{}
+		}
+		env.writeResult(w, r, http.StatusOK, object{res.listKey: items})
+		return
+	}
+	body, err := startPage(r, res.listKey, items, env.defaultLimit, env.maxLimit, env.totalCount)
+	if err != nil {
+		env.writeError(w, r, err)
+		return
+	}
+	env.writeResult(w, r, http.StatusOK, body)
+}
+
+func (env startEnvelope) writeError(w http.ResponseWriter, r *http.Request, err *apiError) {
+	trace := requestID(r)
+	w.Header().Set("X-Request-Id", trace)
+	writeJSON(w, err.status, object{
+		"errors": []object{{"code": err.code, "message": err.message, "more_info": env.moreInfo}},
+		"trace":  trace,
+	})
+}
+
+// startPage returns the body of the response to a list request paged with the limit and start query
+// parameters. The start token of a page is the ID of its first item.
+func startPage(r *http.Request, listKey string, items []object, defaultLimit int, maxLimit int, totalCount bool) (body object, err *apiError) {
+	query := r.URL.Query()
+	limit := defaultLimit
+	if value := query.Get("limit"); value != "" {
+		var convErr error
+		if limit, convErr = strconv.Atoi(value); convErr != nil || limit < 1 || limit > maxLimit {
+			return nil, errBadRequest("limit %q is not an integer between 1 and %d", value, maxLimit)
+		}
+	}
+	total := len(items)
+	if start := query.Get("start"); start != "" {
+		i := 0
+		for i < len(items) && items[i]["id"] != start {
+			i++
+		}
+		if i == len(items) {
+			return nil, errBadRequest("start %q is not a valid page token", start)
+		}
+		items = items[i:]
+	}
+	href := func(start string) string {
+		u := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path}
+		if r.TLS != nil {
+			u.Scheme = "https"
+		}
+		values := url.Values{"limit": {strconv.Itoa(limit)}}
+		if start != "" {
+			values.Set("start", start)
+		}
+		u.RawQuery = values.Encode()
+		return u.String()
+	}
+
+	body = object{"limit": limit, "first": object{"href": href("")}}
+	if len(items) > limit {
+		start := items[limit]["id"].(string)
+		body["next"] = object{"href": href(start), "start": start}
+		items = items[:limit]
+	}
+	if items == nil {
+		items = []object{}
+	}
+	body[listKey] = items
+	if totalCount {
+		body["total_count"] = total
+	}
+	return body, nil
+}
+
+// requestID returns the X-Request-Id of a request, or a new one.
+func requestID(r *http.Request) string {
+	if id := r.Header.Get("X-Request-Id"); id != "" {
+		return id
+	}
+	return uuid.New().String()
+}
@@ -0,0 +1,203 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastWait polls often, and gives up quickly.
+var fastWait = &common.WaitOptions{InitialInterval: time.Millisecond, Timeout: 100 * time.Millisecond}
+
+// newTransitGateway returns a TransitGatewayApisV1 pointed at a new fake, and the clock of the fake,
+// whose transition delay is a minute.
+func newTransitGateway(t *testing.T) (*transitgatewayapisv1.TransitGatewayApisV1, *Clock) {
+	fake := NewTransitGatewayServer()
+	clock := NewClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	fake.SetClock(clock)
+	fake.SetTransitionDelay(time.Minute)
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	service, err := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Version:       core.StringPtr("2022-01-01"),
+	})
+	require.Nil(t, err)
+	return service, clock
+}
+
+func createTransitGateway(t *testing.T, service *transitgatewayapisv1.TransitGatewayApisV1, name string) *transitgatewayapisv1.TransitGateway {
+	gateway, _, err := service.CreateTransitGateway(service.NewCreateTransitGatewayOptions("us-south", name))
+	require.Nil(t, err)
+	return gateway
+}
+
+func TestTransitGatewayServerGateways(t *testing.T) {
+	service, clock := newTransitGateway(t)
+	ctx := context.Background()
+	gateway := createTransitGateway(t, service, "gateway-1")
+	assert.Equal(t, transitgatewayapisv1.TransitGateway_Status_Pending, *gateway.Status)
+	assert.Equal(t, "crn:v1:bluemix:public:transit:us-south:a/fake-account::gateway:"+*gateway.ID, *gateway.Crn)
+
+	_, err := service.WaitForTransitGatewayAvailable(ctx, *gateway.ID, fastWait)
+	var stateErr *common.ResourceStateError
+	require.True(t, errors.As(err, &stateErr))
+	assert.Equal(t, transitgatewayapisv1.TransitGateway_Status_Pending, stateErr.Status)
+
+	clock.Advance(time.Minute)
+	gateway, err = service.WaitForTransitGatewayAvailable(ctx, *gateway.ID, fastWait)
+	require.Nil(t, err)
+	assert.Equal(t, transitgatewayapisv1.TransitGateway_Status_Available, *gateway.Status)
+
+	_, _, err = service.CreateTransitGateway(service.NewCreateTransitGatewayOptions("us-south", "gateway-1"))
+	assert.True(t, common.IsConflict(err))
+	for i := 2; i <= 5; i++ {
+		createTransitGateway(t, service, fmt.Sprintf("gateway-%d", i))
+	}
+	list, _, err := service.ListTransitGateways(service.NewListTransitGatewaysOptions().SetLimit(2))
+	require.Nil(t, err)
+	assert.Len(t, list.TransitGateways, 2)
+	start, err := list.GetNextStart()
+	require.Nil(t, err)
+	require.NotNil(t, start)
+	pager, err := service.NewTransitGatewaysPager(service.NewListTransitGatewaysOptions().SetLimit(2))
+	require.Nil(t, err)
+	all, err := pager.GetAll()
+	require.Nil(t, err)
+	assert.Len(t, all, 5)
+
+	_, err = service.DeleteTransitGateway(service.NewDeleteTransitGatewayOptions(*gateway.ID))
+	require.Nil(t, err)
+	gateway, _, err = service.GetTransitGateway(service.NewGetTransitGatewayOptions(*gateway.ID))
+	require.Nil(t, err)
+	assert.Equal(t, transitgatewayapisv1.TransitGateway_Status_Deleting, *gateway.Status)
+	clock.Advance(time.Minute)
+	assert.Nil(t, service.WaitForTransitGatewayDeleted(ctx, *gateway.ID, fastWait))
+
+	response, err := http.Get(service.GetServiceURL() + "/transit_gateways")
+	require.Nil(t, err)
+	response.Body.Close()
+	assert.Equal(t, 400, response.StatusCode)
+}
+
+func TestTransitGatewayServerConnections(t *testing.T) {
+	service, clock := newTransitGateway(t)
+	ctx := context.Background()
+	gateway := createTransitGateway(t, service, "gateway-1")
+
+	filter, _ := service.NewTransitGatewayConnectionPrefixFilter("deny", "10.0.0.0/8")
+	vpc, _, err := service.CreateTransitGatewayConnection(service.NewCreateTransitGatewayConnectionOptions(*gateway.ID, "vpc").
+		SetNetworkID("crn:v1:bluemix:public:is:us-south:a/fake-account::vpc:vpc-1").
+		SetPrefixFilters([]transitgatewayapisv1.TransitGatewayConnectionPrefixFilter{*filter}))
+	require.Nil(t, err)
+	assert.Equal(t, transitgatewayapisv1.TransitGatewayConnectionCust_Status_Pending, *vpc.Status)
+	require.Len(t, vpc.PrefixFilters, 1)
+	_, _, err = service.CreateTransitGatewayConnection(service.NewCreateTransitGatewayConnectionOptions(*gateway.ID, "vpc").
+		SetNetworkID("crn:v1:bluemix:public:is:us-south:a/fake-account::vpc:vpc-1"))
+	assert.True(t, common.IsConflict(err))
+
+	classic, _, err := service.CreateTransitGatewayConnection(service.NewCreateTransitGatewayConnectionOptions(*gateway.ID, "classic").
+		SetName("classic"))
+	require.Nil(t, err)
+	gre, _, err := service.CreateTransitGatewayConnection(service.NewCreateTransitGatewayConnectionOptions(*gateway.ID, "gre_tunnel").
+		SetName("gre").SetBaseConnectionID(*classic.ID).SetZone(&transitgatewayapisv1.ZoneIdentityByName{Name: core.StringPtr("us-south-1")}).
+		SetLocalGatewayIp("192.168.100.1").SetLocalTunnelIp("192.168.129.2").
+		SetRemoteGatewayIp("10.242.63.12").SetRemoteTunnelIp("192.168.129.1").SetRemoteBgpAsn("65010"))
+	require.Nil(t, err)
+	assert.Equal(t, int64(65010), *gre.RemoteBgpAsn)
+	assert.Equal(t, int64(64490), *gre.LocalBgpAsn)
+
+	crossAccount, _, err := service.CreateTransitGatewayConnection(service.NewCreateTransitGatewayConnectionOptions(*gateway.ID, "directlink").
+		SetNetworkID("crn:v1:bluemix:public:directlink:dal03:a/other-account::dedicated:gateway-1").SetNetworkAccountID("other-account"))
+	require.Nil(t, err)
+	crossAccount, err = service.WaitForConnectionCreated(ctx, *gateway.ID, *crossAccount.ID, fastWait)
+	require.Nil(t, err)
+	assert.Equal(t, transitgatewayapisv1.TransitGatewayConnectionCust_Status_PendingApproval, *crossAccount.Status)
+
+	clock.Advance(time.Minute)
+	vpc, err = service.WaitForConnectionAttached(ctx, *gateway.ID, *vpc.ID, fastWait)
+	require.Nil(t, err)
+	assert.Equal(t, transitgatewayapisv1.TransitGatewayConnectionCust_Status_Attached, *vpc.Status)
+
+	_, err = service.CreateTransitGatewayConnectionActions(service.NewCreateTransitGatewayConnectionActionsOptions(*gateway.ID, *crossAccount.ID, "approve"))
+	require.Nil(t, err)
+	clock.Advance(time.Minute)
+	crossAccount, err = service.WaitForConnectionAttached(ctx, *gateway.ID, *crossAccount.ID, fastWait)
+	require.Nil(t, err)
+	assert.Equal(t, transitgatewayapisv1.TransitGatewayConnectionCust_RequestStatus_Approved, *crossAccount.RequestStatus)
+
+	connections, _, err := service.ListConnections(service.NewListConnectionsOptions())
+	require.Nil(t, err)
+	require.Len(t, connections.Connections, 4)
+	assert.Equal(t, "gateway-1", *connections.Connections[0].TransitGateway.Name)
+
+	_, err = service.DeleteTransitGatewayConnection(service.NewDeleteTransitGatewayConnectionOptions(*gateway.ID, *classic.ID))
+	assert.True(t, common.IsConflict(err))
+	_, err = service.DeleteTransitGateway(service.NewDeleteTransitGatewayOptions(*gateway.ID))
+	assert.True(t, common.IsConflict(err))
+	_, err = service.DeleteTransitGatewayConnection(service.NewDeleteTransitGatewayConnectionOptions(*gateway.ID, *vpc.ID))
+	require.Nil(t, err)
+	_, err = service.WaitForConnectionAttached(ctx, *gateway.ID, *vpc.ID, fastWait)
+	var stateErr *common.ResourceStateError
+	require.True(t, errors.As(err, &stateErr))
+	assert.Equal(t, transitgatewayapisv1.TransitGatewayConnectionCust_Status_Deleting, stateErr.Status)
+	clock.Advance(time.Minute)
+	assert.Nil(t, service.WaitForConnectionDeleted(ctx, *gateway.ID, *vpc.ID, fastWait))
+}
+
+func TestTransitGatewayServerPrefixFiltersAndRouteReports(t *testing.T) {
+	service, clock := newTransitGateway(t)
+	ctx := context.Background()
+	gateway := createTransitGateway(t, service, "gateway-1")
+	connection, _, err := service.CreateTransitGatewayConnection(service.NewCreateTransitGatewayConnectionOptions(*gateway.ID, "classic"))
+	require.Nil(t, err)
+
+	last, _, err := service.CreateTransitGatewayConnectionPrefixFilter(service.NewCreateTransitGatewayConnectionPrefixFilterOptions(*gateway.ID, *connection.ID, "deny", "10.0.0.0/8"))
+	require.Nil(t, err)
+	first, _, err := service.CreateTransitGatewayConnectionPrefixFilter(service.NewCreateTransitGatewayConnectionPrefixFilterOptions(*gateway.ID, *connection.ID, "permit", "10.1.0.0/16").
+		SetBefore(*last.ID).SetGe(16).SetLe(24))
+	require.Nil(t, err)
+	_, _, err = service.CreateTransitGatewayConnectionPrefixFilter(service.NewCreateTransitGatewayConnectionPrefixFilterOptions(*gateway.ID, *connection.ID, "permit", "10.1.0.0/16").
+		SetLe(8))
+	assert.Equal(t, 400, common.AsAPIError(err).StatusCode)
+	filters, _, err := service.ListTransitGatewayConnectionPrefixFilters(service.NewListTransitGatewayConnectionPrefixFiltersOptions(*gateway.ID, *connection.ID))
+	require.Nil(t, err)
+	require.Len(t, filters.PrefixFilters, 2)
+	assert.Equal(t, *first.ID, *filters.PrefixFilters[0].ID)
+
+	report, _, err := service.CreateTransitGatewayRouteReport(service.NewCreateTransitGatewayRouteReportOptions(*gateway.ID))
+	require.Nil(t, err)
+	assert.Equal(t, transitgatewayapisv1.RouteReport_Status_Pending, *report.Status)
+	clock.Advance(time.Minute)
+	report, err = service.WaitForRouteReportComplete(ctx, *gateway.ID, *report.ID, fastWait)
+	require.Nil(t, err)
+	require.Len(t, report.Connections, 1)
+	assert.Equal(t, *connection.ID, *report.Connections[0].ID)
+}
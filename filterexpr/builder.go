@@ -0,0 +1,212 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filterexpr
+
+import (
+	"net"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+)
+
+// FieldBuilder builds the comparisons of a field:
+//
+//   expression := filterexpr.And(
+//       filterexpr.Field("ip.src").In("1.2.3.0/24"),
+//       filterexpr.Field("http.request.uri.path").Contains("/admin"),
+//   )
+//   text, err := filterexpr.Build(expression)
+//   // ip.src in {1.2.3.0/24} and http.request.uri.path contains "/admin"
+//
+// The values are converted to the type of the field: strings to IP addresses or networks for a field
+// of type ip, and Go integers to values of type int. Values that can't be converted are reported by
+// Validate, and so by Build.
+type FieldBuilder struct {
+	name string
+}
+
+// Field returns a builder of the comparisons of the field with the given name.
+func Field(name string) *FieldBuilder {
+	return &FieldBuilder{name: name}
+}
+
+func (field *FieldBuilder) compare(operator Operator, value Value) *Comparison {
+	return &Comparison{Field: field.name, Operator: operator, Value: value, Offset: -1}
+}
+
+// IsTrue returns the test of a boolean field.
+func (field *FieldBuilder) IsTrue() *Comparison {
+	return &Comparison{Field: field.name, Offset: -1}
+}
+
+// Eq returns the comparison of the field with a value for equality.
+func (field *FieldBuilder) Eq(value interface{}) *Comparison {
+	return field.compare(OperatorEq, field.value(value))
+}
+
+// Ne returns the comparison of the field with a value for inequality.
+func (field *FieldBuilder) Ne(value interface{}) *Comparison {
+	return field.compare(OperatorNe, field.value(value))
+}
+
+// Lt returns the comparison of the field with a value that it must be less than.
+func (field *FieldBuilder) Lt(value interface{}) *Comparison {
+	return field.compare(OperatorLt, field.value(value))
+}
+
+// Le returns the comparison of the field with a value that it must be less than or equal to.
+func (field *FieldBuilder) Le(value interface{}) *Comparison {
+	return field.compare(OperatorLe, field.value(value))
+}
+
+// Gt returns the comparison of the field with a value that it must be greater than.
+func (field *FieldBuilder) Gt(value interface{}) *Comparison {
+	return field.compare(OperatorGt, field.value(value))
+}
+
+// Ge returns the comparison of the field with a value that it must be greater than or equal to.
+func (field *FieldBuilder) Ge(value interface{}) *Comparison {
+	return field.compare(OperatorGe, field.value(value))
+}
+
+// Contains returns the comparison of the field with a string that it must contain.
+func (field *FieldBuilder) Contains(s string) *Comparison {
+	return field.compare(OperatorContains, StringValue(s))
+}
+
+// Matches returns the comparison of the field with a regular expression that it must match.
+func (field *FieldBuilder) Matches(pattern string) *Comparison {
+	return field.compare(OperatorMatches, StringValue(pattern))
+}
+
+// In returns the comparison of the field with a set of values, which may include ranges made by Range.
+func (field *FieldBuilder) In(values ...interface{}) *Comparison {
+	set := SetValue{}
+	for _, value := range values {
+		set = append(set, field.value(value))
+	}
+	return field.compare(OperatorIn, set)
+}
+
+// Range returns an inclusive range of integers or IP addresses, for the In method of a FieldBuilder.
+func Range(from interface{}, to interface{}) *RangeValue {
+	return &RangeValue{From: toValue(from, TypeInvalid), To: toValue(to, TypeInvalid)}
+}
+
+// value converts a value to the type of the field.
+func (field *FieldBuilder) value(value interface{}) Value {
+	fieldType := Fields[field.name]
+	if r, ok := value.(*RangeValue); ok {
+		return &RangeValue{From: convert(r.From, fieldType), To: convert(r.To, fieldType)}
+	}
+	return toValue(value, fieldType)
+}
+
+// convert converts a value of a range to the given type, if it was made from a string.
+func convert(value Value, fieldType Type) Value {
+	if s, ok := value.(StringValue); ok {
+		return toValue(string(s), fieldType)
+	}
+	return value
+}
+
+// toValue converts a Go value to a Value, parsing strings as IP addresses or networks for fields of type ip.
+func toValue(value interface{}, fieldType Type) Value {
+	switch v := value.(type) {
+	case Value:
+		return v
+	case string:
+		if fieldType == TypeIP {
+			if ip, ok := parseIP(v); ok {
+				return ip
+			}
+			return invalidValue{value}
+		}
+		return StringValue(v)
+	case int:
+		return IntValue(v)
+	case int32:
+		return IntValue(v)
+	case int64:
+		return IntValue(v)
+	case uint16:
+		return IntValue(v)
+	case uint32:
+		return IntValue(v)
+	case net.IP:
+		return IPValue{IP: v}
+	case *net.IPNet:
+		return IPValue{IP: v.IP, Network: v}
+	}
+	return invalidValue{value}
+}
+
+// And returns the conjunction of expressions. Operands that are conjunctions are flattened.
+func And(operands ...Expression) Expression {
+	return logical(OperatorAnd, operands)
+}
+
+// Or returns the disjunction of expressions. Operands that are disjunctions are flattened.
+func Or(operands ...Expression) Expression {
+	return logical(OperatorOr, operands)
+}
+
+// Xor returns the exclusive disjunction of expressions.
+func Xor(operands ...Expression) Expression {
+	return logical(OperatorXor, operands)
+}
+
+// Not returns the negation of an expression.
+func Not(operand Expression) Expression {
+	return &Negation{Operand: operand}
+}
+
+func logical(operator LogicalOperator, operands []Expression) Expression {
+	var flattened []Expression
+	for _, operand := range operands {
+		if inner, ok := operand.(*Logical); ok && inner.Operator == operator && operator != OperatorXor {
+			flattened = append(flattened, inner.Operands...)
+		} else if operand != nil {
+			flattened = append(flattened, operand)
+		}
+	}
+	if len(flattened) == 1 {
+		return flattened[0]
+	}
+	return &Logical{Operator: operator, Operands: flattened}
+}
+
+// Build validates an expression and returns it in canonical form.
+func Build(e Expression) (string, error) {
+	if err := Validate(e); err != nil {
+		return "", err
+	}
+	return e.String(), nil
+}
+
+// NewFilterInput returns the input of filtersv1.CreateFilter for an expression, which is validated first.
+func NewFilterInput(e Expression, description string) (*filtersv1.FilterInput, error) {
+	expression, err := Build(e)
+	if err != nil {
+		return nil, err
+	}
+	input := &filtersv1.FilterInput{Expression: core.StringPtr(expression)}
+	if description != "" {
+		input.Description = core.StringPtr(description)
+	}
+	return input, nil
+}
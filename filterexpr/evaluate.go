@@ -0,0 +1,364 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filterexpr
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+)
+
+// Request is a synthetic HTTP request, against which expressions are evaluated.
+type Request struct {
+	// The IP address of the client (ip.src).
+	IP net.IP
+
+	// The host (http.host), path (http.request.uri.path) and query string without the "?"
+	// (http.request.uri.query) of the URI, from which http.request.uri and http.request.full_uri
+	// are derived.
+	Host  string
+	Path  string
+	Query string
+
+	// The method of the request (http.request.method), e.g. "GET".
+	Method string
+
+	// The headers of the request (http.user_agent, http.referer, http.cookie, http.x_forwarded_for).
+	UserAgent     string
+	Referer       string
+	Cookie        string
+	XForwardedFor string
+
+	// The geolocation of the client (ip.geoip.country, ip.geoip.continent, ip.geoip.asnum).
+	Country   string
+	Continent string
+	ASNum     int64
+
+	// The threat score of the client (cf.threat_score), from 0 to 100.
+	ThreatScore int64
+
+	// Whether the request is made over HTTPS (ssl).
+	SSL bool
+
+	// Whether the client is a known good bot (cf.client.bot).
+	ClientBot bool
+
+	// The values of the other fields, by name: strings, integers, booleans or IP addresses. The fields
+	// that have no value are empty, zero or false.
+	Fields map[string]interface{}
+}
+
+// value returns the value of a field of the request: a string, an int64, a net.IP or a bool.
+func (request *Request) value(field string, fieldType Type) (interface{}, error) {
+	if value, ok := request.Fields[field]; ok {
+		switch v := value.(type) {
+		case int:
+			return int64(v), nil
+		case string, int64, bool, net.IP:
+			return v, nil
+		}
+		return nil, fmt.Errorf("invalid value %v of field %s", value, field)
+	}
+	uri := request.Path
+	if request.Query != "" {
+		uri += "?" + request.Query
+	}
+	switch field {
+	case "ip.src":
+		return request.IP, nil
+	case "http.host":
+		return request.Host, nil
+	case "http.request.uri.path":
+		return request.Path, nil
+	case "http.request.uri.query":
+		return request.Query, nil
+	case "http.request.uri":
+		return uri, nil
+	case "http.request.full_uri":
+		scheme := "http"
+		if request.SSL {
+			scheme = "https"
+		}
+		return scheme + "://" + request.Host + uri, nil
+	case "http.request.method":
+		return request.Method, nil
+	case "http.user_agent":
+		return request.UserAgent, nil
+	case "http.referer":
+		return request.Referer, nil
+	case "http.cookie":
+		return request.Cookie, nil
+	case "http.x_forwarded_for":
+		return request.XForwardedFor, nil
+	case "ip.geoip.country":
+		return request.Country, nil
+	case "ip.geoip.continent":
+		return request.Continent, nil
+	case "ip.geoip.asnum":
+		return request.ASNum, nil
+	case "cf.threat_score":
+		return request.ThreatScore, nil
+	case "ssl":
+		return request.SSL, nil
+	case "cf.client.bot":
+		return request.ClientBot, nil
+	}
+	switch fieldType {
+	case TypeString:
+		return "", nil
+	case TypeInt:
+		return int64(0), nil
+	case TypeIP:
+		return net.IP(nil), nil
+	case TypeBool:
+		return false, nil
+	}
+	return nil, fmt.Errorf("unknown field %s", field)
+}
+
+// Evaluate reports whether a request matches an expression, which is validated first.
+func Evaluate(e Expression, request *Request) (bool, error) {
+	if err := Validate(e); err != nil {
+		return false, err
+	}
+	return evaluate(e, request)
+}
+
+func evaluate(e Expression, request *Request) (bool, error) {
+	switch e := e.(type) {
+	case *Comparison:
+		return evaluateComparison(e, request)
+	case *Negation:
+		matched, err := evaluate(e.Operand, request)
+		return !matched, err
+	case *Logical:
+		result := e.Operator == OperatorAnd
+		for _, operand := range e.Operands {
+			matched, err := evaluate(operand, request)
+			if err != nil {
+				return false, err
+			}
+			switch {
+			case e.Operator == OperatorAnd && !matched:
+				return false, nil
+			case e.Operator == OperatorOr && matched:
+				return true, nil
+			case e.Operator == OperatorXor:
+				result = result != matched
+			}
+		}
+		return result, nil
+	}
+	return false, fmt.Errorf("unknown expression %T", e)
+}
+
+// evaluateComparison evaluates a comparison, which has been validated.
+func evaluateComparison(comparison *Comparison, request *Request) (bool, error) {
+	fieldType := Fields[comparison.Field]
+	actual, err := request.value(comparison.Field, fieldType)
+	if err != nil {
+		return false, err
+	}
+	if comparison.Operator == "" {
+		b, ok := actual.(bool)
+		if !ok {
+			return false, fmt.Errorf("field %s has a value of type %T instead of bool", comparison.Field, actual)
+		}
+		return b, nil
+	}
+
+	switch comparison.Operator {
+	case OperatorIn:
+		for _, element := range comparison.Value.(SetValue) {
+			if matchesElement(actual, element) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case OperatorEq:
+		return matchesElement(actual, comparison.Value), nil
+	case OperatorNe:
+		return !matchesElement(actual, comparison.Value), nil
+	case OperatorContains:
+		return strings.Contains(fmt.Sprint(actual), string(comparison.Value.(StringValue))), nil
+	case OperatorMatches:
+		pattern, err := regexp.Compile(string(comparison.Value.(StringValue)))
+		if err != nil {
+			return false, err
+		}
+		return pattern.MatchString(fmt.Sprint(actual)), nil
+	}
+
+	a, ok := actual.(int64)
+	if !ok {
+		return false, fmt.Errorf("field %s has a value of type %T instead of int", comparison.Field, actual)
+	}
+	b := int64(comparison.Value.(IntValue))
+	switch comparison.Operator {
+	case OperatorLt:
+		return a < b, nil
+	case OperatorLe:
+		return a <= b, nil
+	case OperatorGt:
+		return a > b, nil
+	case OperatorGe:
+		return a >= b, nil
+	}
+	return false, fmt.Errorf("unknown operator %s", comparison.Operator)
+}
+
+// matchesElement reports whether the value of a field is equal to a value, within a network, or within a range.
+func matchesElement(actual interface{}, value Value) bool {
+	switch v := value.(type) {
+	case StringValue:
+		s, ok := actual.(string)
+		return ok && s == string(v)
+	case IntValue:
+		i, ok := actual.(int64)
+		return ok && i == int64(v)
+	case IPValue:
+		ip, ok := actual.(net.IP)
+		if !ok || ip == nil {
+			return false
+		}
+		if v.Network != nil {
+			return v.Network.Contains(ip)
+		}
+		return v.IP.Equal(ip)
+	case *RangeValue:
+		switch from := v.From.(type) {
+		case IntValue:
+			i, ok := actual.(int64)
+			return ok && int64(from) <= i && i <= int64(v.To.(IntValue))
+		case IPValue:
+			ip, ok := actual.(net.IP)
+			if !ok || ip == nil || (ip.To4() == nil) != (from.IP.To4() == nil) {
+				return false
+			}
+			return bytes.Compare(from.IP.To16(), ip.To16()) <= 0 && bytes.Compare(ip.To16(), v.To.(IPValue).IP.To16()) <= 0
+		}
+	}
+	return false
+}
+
+// ActionBypass is the action of firewall rules that skip other features for the matching requests,
+// which isn't among the constants of firewallrulesv1.
+const ActionBypass = "bypass"
+
+// actionPrecedence is the order in which the rules are evaluated by action, when they have no priority.
+var actionPrecedence = map[string]int{
+	firewallrulesv1.FirewallRuleObject_Action_Log: 0,
+	ActionBypass: 1,
+	firewallrulesv1.FirewallRuleObject_Action_Allow:       2,
+	firewallrulesv1.FirewallRuleObject_Action_Challenge:   3,
+	firewallrulesv1.FirewallRuleObject_Action_JsChallenge: 3,
+	firewallrulesv1.FirewallRuleObject_Action_Block:       4,
+}
+
+// isTerminating reports whether a matching rule with the action ends the evaluation of the rules.
+func isTerminating(action string) bool {
+	return action != firewallrulesv1.FirewallRuleObject_Action_Log && action != ActionBypass
+}
+
+// RuleResult is the outcome of the evaluation of firewall rules against a request.
+type RuleResult struct {
+	// The first matching rule whose action ends the evaluation, or nil if there is none, in which
+	// case the request goes through.
+	Rule *firewallrulesv1.FirewallRuleObject
+
+	// The action of Rule, or an empty string if Rule is nil.
+	Action string
+
+	// The rules that match the request, in the order in which they were evaluated, including those
+	// whose actions (log and bypass) don't end the evaluation.
+	Matched []*firewallrulesv1.FirewallRuleObject
+}
+
+// EvaluateRules evaluates firewall rules against a request. Paused rules, and rules whose filters are
+// paused, are skipped. The rules with a priority in priorities, keyed by rule ID, are evaluated first,
+// from the lowest priority to the highest. The others are then evaluated by action, in the order log,
+// bypass, allow, challenge and js_challenge, block, and in the order in which they are given for the
+// same action. The evaluation ends at the first matching rule whose action isn't log or bypass.
+func EvaluateRules(rules []firewallrulesv1.FirewallRuleObject, request *Request, priorities map[string]int) (*RuleResult, error) {
+	type candidate struct {
+		rule       *firewallrulesv1.FirewallRuleObject
+		expression Expression
+		index      int
+	}
+	var candidates []candidate
+	for i := range rules {
+		rule := &rules[i]
+		if isTrue(rule.Paused) || rule.Filter == nil || isTrue(rule.Filter.Paused) {
+			continue
+		}
+		if rule.Filter.Expression == nil {
+			return nil, fmt.Errorf("firewall rule %s: missing filter expression", stringValue(rule.ID))
+		}
+		e, err := Check(*rule.Filter.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("firewall rule %s: %s", stringValue(rule.ID), err.Error())
+		}
+		candidates = append(candidates, candidate{rule: rule, expression: e, index: i})
+	}
+
+	priority := func(c candidate) (int, bool) {
+		p, ok := priorities[stringValue(c.rule.ID)]
+		return p, ok
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		pi, iHasPriority := priority(candidates[i])
+		pj, jHasPriority := priority(candidates[j])
+		if iHasPriority || jHasPriority {
+			return iHasPriority && (!jHasPriority || pi < pj)
+		}
+		return actionPrecedence[stringValue(candidates[i].rule.Action)] < actionPrecedence[stringValue(candidates[j].rule.Action)]
+	})
+
+	result := &RuleResult{}
+	for _, c := range candidates {
+		matched, err := evaluate(c.expression, request)
+		if err != nil {
+			return nil, fmt.Errorf("firewall rule %s: %s", stringValue(c.rule.ID), err.Error())
+		}
+		if !matched {
+			continue
+		}
+		result.Matched = append(result.Matched, c.rule)
+		if action := stringValue(c.rule.Action); isTerminating(action) {
+			result.Rule = c.rule
+			result.Action = action
+			break
+		}
+	}
+	return result, nil
+}
+
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
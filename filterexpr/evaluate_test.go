@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filterexpr
+
+import (
+	"net"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	request := &Request{
+		IP:          net.ParseIP("192.0.2.10"),
+		Host:        "www.example.com",
+		Path:        "/admin/login",
+		Query:       "next=%2F",
+		Method:      "POST",
+		UserAgent:   "curl/7.68.0",
+		Country:     "CN",
+		ASNum:       4134,
+		ThreatScore: 25,
+		SSL:         true,
+		Fields:      map[string]interface{}{"cf.bot_management.score": 12},
+	}
+	tests := []struct {
+		expression string
+		matched    bool
+	}{
+		{`ip.src eq 192.0.2.10`, true},
+		{`ip.src in {192.0.2.0/24}`, true},
+		{`ip.src in {198.51.100.0/24 192.0.2.1..192.0.2.9}`, false},
+		{`ip.src in {192.0.2.1..192.0.2.20}`, true},
+		{`http.host eq "www.example.com" and http.request.uri.path contains "/admin"`, true},
+		{`http.host eq "WWW.example.com"`, false},
+		{`http.request.uri eq "/admin/login?next=%2F"`, true},
+		{`http.request.full_uri eq "https://www.example.com/admin/login?next=%2F"`, true},
+		{`http.request.method in {"GET" "HEAD"}`, false},
+		{`http.user_agent matches "^curl/"`, true},
+		{`ip.geoip.country in {"CN" "RU"} and cf.threat_score ge 25`, true},
+		{`cf.threat_score gt 25 or ip.geoip.asnum in {4000..4200}`, true},
+		{`cf.bot_management.score lt 30`, true},
+		{`ssl xor cf.client.bot`, true},
+		{`not ssl`, false},
+		{`http.referer ne ""`, false},
+	}
+	for _, test := range tests {
+		e, err := Parse(test.expression)
+		require.Nil(t, err, test.expression)
+		matched, err := Evaluate(e, request)
+		assert.Nil(t, err, test.expression)
+		assert.Equal(t, test.matched, matched, test.expression)
+	}
+
+	// The expression is validated before it is evaluated.
+	_, err := Evaluate(&Comparison{Field: "cf.threat_score", Operator: OperatorEq, Value: StringValue("high")}, request)
+	assert.NotNil(t, err)
+}
+
+func newFirewallRule(id string, action string, expression string) firewallrulesv1.FirewallRuleObject {
+	return firewallrulesv1.FirewallRuleObject{
+		ID:     core.StringPtr(id),
+		Paused: core.BoolPtr(false),
+		Action: core.StringPtr(action),
+		Filter: &firewallrulesv1.FirewallRuleObjectFilter{
+			ID:         core.StringPtr(id + "-filter"),
+			Paused:     core.BoolPtr(false),
+			Expression: core.StringPtr(expression),
+		},
+	}
+}
+
+func TestEvaluateRules(t *testing.T) {
+	rules := []firewallrulesv1.FirewallRuleObject{
+		newFirewallRule("block-admin", firewallrulesv1.FirewallRuleObject_Action_Block, `http.request.uri.path contains "/admin"`),
+		newFirewallRule("challenge-threats", firewallrulesv1.FirewallRuleObject_Action_Challenge, `cf.threat_score gt 10`),
+		newFirewallRule("allow-office", firewallrulesv1.FirewallRuleObject_Action_Allow, `ip.src in {192.0.2.0/24}`),
+		newFirewallRule("log-all", firewallrulesv1.FirewallRuleObject_Action_Log, `http.host eq "www.example.com"`),
+	}
+	request := &Request{
+		IP:          net.ParseIP("203.0.113.5"),
+		Host:        "www.example.com",
+		Path:        "/admin",
+		ThreatScore: 50,
+	}
+
+	// Without priorities, the rules are evaluated by action: log, allow, challenge, block.
+	result, err := EvaluateRules(rules, request, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "challenge-threats", *result.Rule.ID)
+	assert.Equal(t, firewallrulesv1.FirewallRuleObject_Action_Challenge, result.Action)
+	require.Equal(t, 2, len(result.Matched))
+	assert.Equal(t, "log-all", *result.Matched[0].ID)
+
+	// The rules with a priority are evaluated first.
+	result, err = EvaluateRules(rules, request, map[string]int{"block-admin": 1})
+	require.Nil(t, err)
+	assert.Equal(t, "block-admin", *result.Rule.ID)
+	assert.Equal(t, 1, len(result.Matched))
+
+	// An allowed address is let through before the other rules.
+	request.IP = net.ParseIP("192.0.2.10")
+	result, err = EvaluateRules(rules, request, nil)
+	require.Nil(t, err)
+	assert.Equal(t, firewallrulesv1.FirewallRuleObject_Action_Allow, result.Action)
+
+	// Paused rules and filters are skipped, and no matching rule lets the request through.
+	rules[2].Paused = core.BoolPtr(true)
+	rules[1].Filter.Paused = core.BoolPtr(true)
+	rules[0].Filter.Paused = core.BoolPtr(true)
+	result, err = EvaluateRules(rules, request, nil)
+	require.Nil(t, err)
+	assert.Nil(t, result.Rule)
+	assert.Equal(t, "", result.Action)
+	assert.Equal(t, 1, len(result.Matched))
+
+	// An invalid expression is reported with its rule.
+	rules = append(rules, newFirewallRule("invalid", firewallrulesv1.FirewallRuleObject_Action_Block, `cf.threat_score eq "high"`))
+	_, err = EvaluateRules(rules, request, nil)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "firewall rule invalid")
+}
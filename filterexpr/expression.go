@@ -0,0 +1,291 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package filterexpr parses, validates and builds the expressions of the firewall rules language,
+// which are the Expression of a filtersv1.FilterInput, e.g.:
+//
+//   (ip.src in {1.2.3.0/24} and http.request.uri.path contains "/admin")
+//
+// Parse turns an expression into an Expression tree, Validate checks its fields, operators and
+// values, and the String method of an Expression writes it in canonical form. Expressions can also
+// be built with Field, And, Or, Xor and Not.
+package filterexpr
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Type is the type of a field or a value.
+type Type int
+
+// The types of the fields and values.
+const (
+	TypeInvalid Type = iota
+	TypeString
+	TypeInt
+	TypeIP
+	TypeBool
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeString:
+		return "string"
+	case TypeInt:
+		return "int"
+	case TypeIP:
+		return "ip"
+	case TypeBool:
+		return "bool"
+	}
+	return "invalid"
+}
+
+// Fields are the fields known to Validate, along with their types. Add to it to validate expressions
+// that use other fields.
+var Fields = map[string]Type{
+	"cf.bot_management.score":         TypeInt,
+	"cf.bot_management.verified_bot":  TypeBool,
+	"cf.client.bot":                   TypeBool,
+	"cf.edge.server_ip":               TypeIP,
+	"cf.edge.server_port":             TypeInt,
+	"cf.threat_score":                 TypeInt,
+	"http.cookie":                     TypeString,
+	"http.host":                       TypeString,
+	"http.referer":                    TypeString,
+	"http.request.full_uri":           TypeString,
+	"http.request.method":             TypeString,
+	"http.request.uri":                TypeString,
+	"http.request.uri.path":           TypeString,
+	"http.request.uri.query":          TypeString,
+	"http.request.version":            TypeString,
+	"http.user_agent":                 TypeString,
+	"http.x_forwarded_for":            TypeString,
+	"ip.geoip.asnum":                  TypeInt,
+	"ip.geoip.continent":              TypeString,
+	"ip.geoip.country":                TypeString,
+	"ip.geoip.is_in_european_union":   TypeBool,
+	"ip.geoip.subdivision_1_iso_code": TypeString,
+	"ip.geoip.subdivision_2_iso_code": TypeString,
+	"ip.src":                          TypeIP,
+	"ssl":                             TypeBool,
+}
+
+// Operator is a comparison operator.
+type Operator string
+
+// The comparison operators, by their canonical names. Their symbols (e.g. "==") are accepted by Parse.
+const (
+	OperatorEq       Operator = "eq"
+	OperatorNe       Operator = "ne"
+	OperatorLt       Operator = "lt"
+	OperatorLe       Operator = "le"
+	OperatorGt       Operator = "gt"
+	OperatorGe       Operator = "ge"
+	OperatorContains Operator = "contains"
+	OperatorMatches  Operator = "matches"
+	OperatorIn       Operator = "in"
+)
+
+// LogicalOperator is an operator that combines expressions.
+type LogicalOperator string
+
+// The logical operators, by their canonical names. Their symbols (e.g. "&&") are accepted by Parse.
+const (
+	OperatorAnd LogicalOperator = "and"
+	OperatorXor LogicalOperator = "xor"
+	OperatorOr  LogicalOperator = "or"
+)
+
+// precedence returns the precedence of a logical operator; "not" binds tighter than all of them.
+func (operator LogicalOperator) precedence() int {
+	switch operator {
+	case OperatorAnd:
+		return 3
+	case OperatorXor:
+		return 2
+	}
+	return 1
+}
+
+// Expression is a node of an expression tree: a *Comparison, a *Logical or a *Negation.
+type Expression interface {
+	// String returns the expression in canonical form.
+	String() string
+
+	isExpression()
+}
+
+// Comparison compares a field with a value, or tests a boolean field if Operator is empty.
+type Comparison struct {
+	Field    string
+	Operator Operator
+	Value    Value
+
+	// The offset of the comparison in the parsed expression, or -1 if it wasn't parsed.
+	Offset int
+}
+
+// Logical combines two or more expressions with a logical operator.
+type Logical struct {
+	Operator LogicalOperator
+	Operands []Expression
+}
+
+// Negation negates an expression.
+type Negation struct {
+	Operand Expression
+}
+
+func (*Comparison) isExpression() {}
+func (*Logical) isExpression()    {}
+func (*Negation) isExpression()   {}
+
+func (comparison *Comparison) String() string {
+	if comparison.Operator == "" {
+		return comparison.Field
+	}
+	value := "<nil>"
+	if comparison.Value != nil {
+		value = comparison.Value.String()
+	}
+	return comparison.Field + " " + string(comparison.Operator) + " " + value
+}
+
+func (logical *Logical) String() string {
+	operands := make([]string, len(logical.Operands))
+	for i, operand := range logical.Operands {
+		operands[i] = operand.String()
+		if inner, ok := operand.(*Logical); ok && inner.Operator.precedence() <= logical.Operator.precedence() {
+			operands[i] = "(" + operands[i] + ")"
+		}
+	}
+	return strings.Join(operands, " "+string(logical.Operator)+" ")
+}
+
+func (negation *Negation) String() string {
+	if _, ok := negation.Operand.(*Logical); ok {
+		return "not (" + negation.Operand.String() + ")"
+	}
+	return "not " + negation.Operand.String()
+}
+
+// Value is the value a field is compared with.
+type Value interface {
+	// String returns the value as written in an expression.
+	String() string
+
+	// Type returns the type of the value.
+	Type() Type
+}
+
+// StringValue is a string value.
+type StringValue string
+
+// IntValue is an integer value.
+type IntValue int64
+
+// IPValue is an IP address, or a network in CIDR notation if Network is not nil.
+type IPValue struct {
+	IP      net.IP
+	Network *net.IPNet
+}
+
+// RangeValue is an inclusive range of integers or IP addresses, within a SetValue.
+type RangeValue struct {
+	From Value
+	To   Value
+}
+
+// SetValue is a set of values, for the "in" operator.
+type SetValue []Value
+
+// invalidValue is a value of the builder that can't be converted to the type of its field.
+type invalidValue struct {
+	value interface{}
+}
+
+func (value StringValue) String() string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + replacer.Replace(string(value)) + `"`
+}
+
+func (value IntValue) String() string {
+	return strconv.FormatInt(int64(value), 10)
+}
+
+func (value IPValue) String() string {
+	if value.Network != nil {
+		return value.Network.String()
+	}
+	return value.IP.String()
+}
+
+func (value *RangeValue) String() string {
+	return value.From.String() + ".." + value.To.String()
+}
+
+func (value SetValue) String() string {
+	elements := make([]string, len(value))
+	for i, element := range value {
+		elements[i] = element.String()
+	}
+	return "{" + strings.Join(elements, " ") + "}"
+}
+
+func (value invalidValue) String() string {
+	return fmt.Sprint(value.value)
+}
+
+func (StringValue) Type() Type { return TypeString }
+func (IntValue) Type() Type    { return TypeInt }
+func (IPValue) Type() Type     { return TypeIP }
+
+func (value *RangeValue) Type() Type {
+	if value.From == nil {
+		return TypeInvalid
+	}
+	return value.From.Type()
+}
+
+// Type returns the type of the elements of the set, or TypeInvalid if it is empty.
+func (value SetValue) Type() Type {
+	if len(value) == 0 {
+		return TypeInvalid
+	}
+	return value[0].Type()
+}
+
+func (invalidValue) Type() Type { return TypeInvalid }
+
+// parseIP parses an IP address or a network in CIDR notation.
+func parseIP(text string) (IPValue, bool) {
+	if strings.Contains(text, "/") {
+		_, network, err := net.ParseCIDR(text)
+		if err != nil {
+			return IPValue{}, false
+		}
+		return IPValue{IP: network.IP, Network: network}, true
+	}
+	ip := net.ParseIP(text)
+	if ip == nil {
+		return IPValue{}, false
+	}
+	return IPValue{IP: ip}, true
+}
@@ -0,0 +1,179 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filterexpr
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCanonical(t *testing.T) {
+	tests := []struct {
+		expression string
+		canonical  string
+	}{
+		{`(ip.src in {1.2.3.0/24} and http.request.uri.path contains "/admin")`,
+			`ip.src in {1.2.3.0/24} and http.request.uri.path contains "/admin"`},
+		{`http.host == "example.com" || http.host eq "www.example.com" && ssl`,
+			`http.host eq "example.com" or http.host eq "www.example.com" and ssl`},
+		{`(http.host eq "a" or http.host eq "b") and not ssl`,
+			`(http.host eq "a" or http.host eq "b") and not ssl`},
+		{`!(cf.threat_score > 10 ^^ cf.client.bot)`,
+			`not (cf.threat_score gt 10 xor cf.client.bot)`},
+		{`cf.edge.server_port in {80 443 8000..8080}`,
+			`cf.edge.server_port in {80 443 8000..8080}`},
+		{`ip.src in {2001:db8::/32 10.0.0.1..10.0.0.9}  and  http.user_agent ~ "^curl/\\d+"`,
+			`ip.src in {2001:db8::/32 10.0.0.1..10.0.0.9} and http.user_agent matches "^curl/\\d+"`},
+		{`http.request.uri.query contains "q=\"x\""`,
+			`http.request.uri.query contains "q=\"x\""`},
+		{`((ssl))`, `ssl`},
+		{`ssl and (cf.client.bot and ip.src eq 1.2.3.4)`, `ssl and (cf.client.bot and ip.src eq 1.2.3.4)`},
+	}
+	for _, test := range tests {
+		e, err := Parse(test.expression)
+		require.Nil(t, err, test.expression)
+		assert.Equal(t, test.canonical, e.String(), test.expression)
+		assert.Nil(t, Validate(e), test.expression)
+
+		// The canonical form parses to the same tree.
+		again, err := Parse(e.String())
+		require.Nil(t, err)
+		assert.Equal(t, e.String(), again.String())
+	}
+}
+
+func TestParseTree(t *testing.T) {
+	e, err := Parse(`ip.src eq 1.2.3.4 or not http.host in {"a" "b"} and ssl`)
+	require.Nil(t, err)
+	or, ok := e.(*Logical)
+	require.True(t, ok)
+	assert.Equal(t, OperatorOr, or.Operator)
+	require.Len(t, or.Operands, 2)
+	first := or.Operands[0].(*Comparison)
+	assert.Equal(t, "ip.src", first.Field)
+	assert.Equal(t, OperatorEq, first.Operator)
+	assert.Equal(t, IPValue{IP: net.ParseIP("1.2.3.4")}, first.Value)
+	assert.Equal(t, 0, first.Offset)
+	and := or.Operands[1].(*Logical)
+	assert.Equal(t, OperatorAnd, and.Operator)
+	in := and.Operands[0].(*Negation).Operand.(*Comparison)
+	assert.Equal(t, SetValue{StringValue("a"), StringValue("b")}, in.Value)
+	assert.Equal(t, 25, in.Offset)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		offset     int
+		message    string
+	}{
+		{``, 0, "empty expression"},
+		{`http.host eq "example.com`, 13, "unterminated string"},
+		{`http.host eq "a\n"`, 15, "invalid escape sequence in string"},
+		{`http.host eq example`, 13, `invalid value "example"`},
+		{`(ssl`, 4, `expected ")", found end of expression`},
+		{`ssl and`, 7, "expected a field or a parenthesized expression, found end of expression"},
+		{`ssl ssl`, 4, `expected a logical operator, found "ssl"`},
+		{`ip.src in 1.2.3.4`, 10, `expected "{", found "1.2.3.4"`},
+		{`ip.src in {1.2.3.4`, 18, "expected a value, found end of expression"},
+		{`http.host eq 'a'`, 13, `unexpected character '\''`},
+		{`cf.threat_score in {1..x}`, 20, `invalid range "1..x"`},
+		{`http.host eq {"a"}`, 13, `expected a value, found "{"`},
+	}
+	for _, test := range tests {
+		_, err := Parse(test.expression)
+		syntaxErr, ok := err.(*SyntaxError)
+		require.True(t, ok, test.expression)
+		assert.Equal(t, test.offset, syntaxErr.Offset, test.expression)
+		assert.Equal(t, test.message, syntaxErr.Message, test.expression)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		expression string
+		message    string
+	}{
+		{`http.hots eq "a"`, "unknown field http.hots"},
+		{`http.host`, "field http.host of type string must be compared with a value"},
+		{`ssl eq 1`, "field ssl of type bool can't be compared"},
+		{`ip.src contains "1.2"`, "operator contains doesn't apply to field ip.src of type ip"},
+		{`http.host gt "a"`, "operator gt doesn't apply to field http.host of type string"},
+		{`cf.threat_score eq "10"`, `value "10" of type string doesn't match the type int of the field`},
+		{`ip.src eq 1.2.3.0/24`, "operator eq requires an IP address, use in to match network 1.2.3.0/24"},
+		{`ip.src in {}`, "empty set"},
+		{`ip.src in {1.2.3.4 "a"}`, `value "a" of type string doesn't match the type ip of the field`},
+		{`cf.threat_score in {10..1}`, "range 10..1 must not be reversed"},
+		{`ip.src in {10.0.0.9..10.0.0.1}`, "range 10.0.0.9..10.0.0.1 must not be reversed"},
+		{`ip.src in {10.0.0.0/8..10.0.0.1}`, "range 10.0.0.0/8..10.0.0.1 must have IP addresses as bounds"},
+		{`http.user_agent matches "(curl"`, "invalid regular expression: error parsing regexp: missing closing ): `(curl`"},
+	}
+	for _, test := range tests {
+		_, err := Check(test.expression)
+		errs, ok := err.(ValidationErrors)
+		require.True(t, ok, test.expression)
+		require.Len(t, errs, 1, test.expression)
+		assert.Equal(t, test.message, errs[0].Message, test.expression)
+		assert.Equal(t, 0, errs[0].Offset)
+	}
+
+	e, err := Parse(`ssl and (http.hots eq "a" or not cf.threat_score lt "1")`)
+	require.Nil(t, err)
+	errs := Validate(e).(ValidationErrors)
+	require.Len(t, errs, 2)
+	assert.Equal(t, 9, errs[0].Offset)
+	assert.Equal(t, `http.hots eq "a": unknown field http.hots; `+
+		`cf.threat_score lt "1": value "1" of type string doesn't match the type int of the field`, errs.Error())
+}
+
+func TestBuilder(t *testing.T) {
+	e := And(
+		Field("ip.src").In("1.2.3.0/24", net.ParseIP("10.0.0.1"), Range("10.1.0.1", "10.1.0.9")),
+		Field("http.request.uri.path").Contains("/admin"),
+		And(Not(Field("ssl").IsTrue()), Or(Field("cf.threat_score").Ge(10), Field("cf.edge.server_port").In(80, Range(8000, 8080)))),
+	)
+	text, err := Build(e)
+	require.Nil(t, err)
+	assert.Equal(t, `ip.src in {1.2.3.0/24 10.0.0.1 10.1.0.1..10.1.0.9} and http.request.uri.path contains "/admin" and `+
+		`not ssl and (cf.threat_score ge 10 or cf.edge.server_port in {80 8000..8080})`, text)
+	parsed, err := Check(text)
+	require.Nil(t, err)
+	assert.Equal(t, text, parsed.String())
+
+	text, err = Build(Xor(Field("http.host").Eq("example.com"), Field("http.request.method").Ne("GET")))
+	require.Nil(t, err)
+	assert.Equal(t, `http.host eq "example.com" xor http.request.method ne "GET"`, text)
+
+	_, err = Build(Field("ip.src").Eq("not-an-ip"))
+	require.NotNil(t, err)
+	assert.Equal(t, `ip.src eq not-an-ip: value not-an-ip of type invalid doesn't match the type ip of the field`, err.Error())
+	assert.Equal(t, -1, err.(ValidationErrors)[0].Offset)
+	_, err = Build(Field("cf.threat_score").Gt(1.5))
+	assert.NotNil(t, err)
+}
+
+func TestNewFilterInput(t *testing.T) {
+	input, err := NewFilterInput(Field("http.host").Eq("example.com"), "block example.com")
+	require.Nil(t, err)
+	assert.Equal(t, `http.host eq "example.com"`, *input.Expression)
+	assert.Equal(t, "block example.com", *input.Description)
+	_, err = NewFilterInput(Field("http.hots").Eq("example.com"), "")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,367 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filterexpr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SyntaxError describes a syntax error in an expression.
+type SyntaxError struct {
+	// The offset in the expression of the erroneous token.
+	Offset int
+
+	// A description of the error.
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("filter expression offset %d: %s", e.Offset, e.Message)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenSymbol
+)
+
+// token is a word (a keyword, field name, number or IP address), a quoted string, or a symbol.
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+func (t token) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return "string " + StringValue(t.text).String()
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// symbols are the symbols of the language, longest first.
+var symbols = []string{"==", "!=", "<=", ">=", "&&", "||", "^^", "<", ">", "~", "!", "(", ")", "{", "}"}
+
+// operatorNames maps the names and symbols of the comparison operators to the operators.
+var operatorNames = map[string]Operator{
+	"eq": OperatorEq, "==": OperatorEq,
+	"ne": OperatorNe, "!=": OperatorNe,
+	"lt": OperatorLt, "<": OperatorLt,
+	"le": OperatorLe, "<=": OperatorLe,
+	"gt": OperatorGt, ">": OperatorGt,
+	"ge": OperatorGe, ">=": OperatorGe,
+	"contains": OperatorContains,
+	"matches":  OperatorMatches, "~": OperatorMatches,
+	"in": OperatorIn,
+}
+
+// logicalNames maps the names and symbols of the logical operators to the operators.
+var logicalNames = map[string]LogicalOperator{
+	"and": OperatorAnd, "&&": OperatorAnd,
+	"xor": OperatorXor, "^^": OperatorXor,
+	"or": OperatorOr, "||": OperatorOr,
+}
+
+func isWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.' || c == ':' || c == '/' || c == '-'
+}
+
+// tokenize splits an expression into tokens.
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"':
+			start := i
+			var text strings.Builder
+			for i++; ; i++ {
+				if i >= len(expression) {
+					return nil, &SyntaxError{Offset: start, Message: "unterminated string"}
+				}
+				if expression[i] == '"' {
+					i++
+					break
+				}
+				if expression[i] == '\\' {
+					i++
+					if i >= len(expression) || expression[i] != '"' && expression[i] != '\\' {
+						return nil, &SyntaxError{Offset: i - 1, Message: "invalid escape sequence in string"}
+					}
+				}
+				text.WriteByte(expression[i])
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text.String(), offset: start})
+		case isWordChar(c):
+			start := i
+			for i < len(expression) && isWordChar(expression[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: expression[start:i], offset: start})
+		default:
+			matched := false
+			for _, symbol := range symbols {
+				if strings.HasPrefix(expression[i:], symbol) {
+					tokens = append(tokens, token{kind: tokenSymbol, text: symbol, offset: i})
+					i += len(symbol)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, &SyntaxError{Offset: i, Message: fmt.Sprintf("unexpected character %q", c)}
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, offset: len(expression)}), nil
+}
+
+// Parse parses an expression. Only its syntax is checked; use Validate to check its fields, operators
+// and values, or Check to do both.
+func Parse(expression string) (Expression, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, &SyntaxError{Offset: 0, Message: "empty expression"}
+	}
+	e, err := p.parseLogical(OperatorOr)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.unexpected(t, "a logical operator")
+	}
+	return e, nil
+}
+
+// Check parses and validates an expression.
+func Check(expression string) (Expression, error) {
+	e, err := Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	if err = Validate(e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) unexpected(t token, expected string) error {
+	return &SyntaxError{Offset: t.offset, Message: fmt.Sprintf("expected %s, found %s", expected, t.describe())}
+}
+
+// logicalOperator returns the logical operator of a token, if it is one.
+func logicalOperator(t token) (LogicalOperator, bool) {
+	if t.kind != tokenWord && t.kind != tokenSymbol {
+		return "", false
+	}
+	operator, ok := logicalNames[strings.ToLower(t.text)]
+	return operator, ok
+}
+
+// parseLogical parses a sequence of operands combined with an operator of the given precedence, whose
+// operands combine operators of a higher precedence.
+func (p *parser) parseLogical(operator LogicalOperator) (Expression, error) {
+	parseOperand := p.parseNot
+	switch operator {
+	case OperatorOr:
+		parseOperand = func() (Expression, error) { return p.parseLogical(OperatorXor) }
+	case OperatorXor:
+		parseOperand = func() (Expression, error) { return p.parseLogical(OperatorAnd) }
+	}
+	first, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []Expression{first}
+	for {
+		if found, ok := logicalOperator(p.peek()); !ok || found != operator {
+			break
+		}
+		p.next()
+		operand, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return &Logical{Operator: operator, Operands: operands}, nil
+}
+
+func (p *parser) parseNot() (Expression, error) {
+	t := p.peek()
+	if t.kind == tokenSymbol && t.text == "!" || t.kind == tokenWord && strings.ToLower(t.text) == "not" {
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &Negation{Operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expression, error) {
+	t := p.next()
+	if t.kind == tokenSymbol && t.text == "(" {
+		e, err := p.parseLogical(OperatorOr)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenSymbol || closing.text != ")" {
+			return nil, p.unexpected(closing, `")"`)
+		}
+		return e, nil
+	}
+	if t.kind != tokenWord || !isFieldName(t.text) {
+		return nil, p.unexpected(t, "a field or a parenthesized expression")
+	}
+	comparison := &Comparison{Field: t.text, Offset: t.offset}
+
+	operatorToken := p.peek()
+	if operatorToken.kind != tokenWord && operatorToken.kind != tokenSymbol {
+		return comparison, nil
+	}
+	operator, ok := operatorNames[strings.ToLower(operatorToken.text)]
+	if !ok {
+		// A boolean field, followed by a logical operator or the end of a parenthesized expression.
+		return comparison, nil
+	}
+	p.next()
+	comparison.Operator = operator
+
+	var err error
+	if operator == OperatorIn {
+		comparison.Value, err = p.parseSet()
+	} else {
+		comparison.Value, err = p.parseValue(false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return comparison, nil
+}
+
+// parseSet parses the set of values of the "in" operator.
+func (p *parser) parseSet() (Value, error) {
+	if opening := p.next(); opening.kind != tokenSymbol || opening.text != "{" {
+		return nil, p.unexpected(opening, `"{"`)
+	}
+	set := SetValue{}
+	for {
+		t := p.peek()
+		if t.kind == tokenSymbol && t.text == "}" {
+			p.next()
+			return set, nil
+		}
+		value, err := p.parseValue(true)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, value)
+	}
+}
+
+// parseValue parses a string, an integer or an IP address or network, or a range of integers or IP
+// addresses if ranges are allowed.
+func (p *parser) parseValue(allowRange bool) (Value, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString:
+		return StringValue(t.text), nil
+	case tokenWord:
+		if allowRange && strings.Contains(t.text, "..") {
+			bounds := strings.SplitN(t.text, "..", 2)
+			from, fromOK := parseScalar(bounds[0])
+			to, toOK := parseScalar(bounds[1])
+			if !fromOK || !toOK {
+				return nil, &SyntaxError{Offset: t.offset, Message: fmt.Sprintf("invalid range %q", t.text)}
+			}
+			return &RangeValue{From: from, To: to}, nil
+		}
+		if value, ok := parseScalar(t.text); ok {
+			return value, nil
+		}
+		return nil, &SyntaxError{Offset: t.offset, Message: fmt.Sprintf("invalid value %q", t.text)}
+	}
+	return nil, p.unexpected(t, "a value")
+}
+
+// parseScalar parses an integer, or an IP address or network.
+func parseScalar(text string) (Value, bool) {
+	if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return IntValue(i), true
+	}
+	if ip, ok := parseIP(text); ok {
+		return ip, true
+	}
+	return nil, false
+}
+
+// isFieldName reports whether text is a field name: lowercase words separated by dots.
+func isFieldName(text string) bool {
+	if text == "" || !(text[0] >= 'a' && text[0] <= 'z') {
+		return false
+	}
+	for _, word := range strings.Split(text, ".") {
+		if word == "" {
+			return false
+		}
+		for i := 0; i < len(word); i++ {
+			c := word[i]
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_') {
+				return false
+			}
+		}
+	}
+	_, isOperator := operatorNames[text]
+	_, isLogical := logicalNames[text]
+	return !isOperator && !isLogical && text != "not"
+}
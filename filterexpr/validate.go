@@ -0,0 +1,205 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filterexpr
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ValidationError describes a comparison of an expression that Validate rejects.
+type ValidationError struct {
+	// The comparison, in canonical form.
+	Comparison string
+
+	// The offset of the comparison in the parsed expression, or -1 if it wasn't parsed.
+	Offset int
+
+	// A description of the error.
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Comparison, e.Message)
+}
+
+// ValidationErrors are the errors Validate finds in an expression.
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// operatorTypes are the types of the fields each operator applies to.
+var operatorTypes = map[Operator][]Type{
+	OperatorEq:       {TypeString, TypeInt, TypeIP},
+	OperatorNe:       {TypeString, TypeInt, TypeIP},
+	OperatorLt:       {TypeInt},
+	OperatorLe:       {TypeInt},
+	OperatorGt:       {TypeInt},
+	OperatorGe:       {TypeInt},
+	OperatorContains: {TypeString},
+	OperatorMatches:  {TypeString},
+	OperatorIn:       {TypeString, TypeInt, TypeIP},
+}
+
+// Validate checks that the comparisons of an expression are on known Fields, with operators that
+// apply to the types of the fields, and values of the same types. It returns ValidationErrors, or nil
+// if the expression is valid.
+func Validate(e Expression) error {
+	var errs ValidationErrors
+	walk(e, func(comparison *Comparison) {
+		if message := validateComparison(comparison); message != "" {
+			errs = append(errs, &ValidationError{Comparison: comparison.String(), Offset: comparison.Offset, Message: message})
+		}
+	})
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// walk calls visit for each comparison of an expression, in order.
+func walk(e Expression, visit func(comparison *Comparison)) {
+	switch e := e.(type) {
+	case *Comparison:
+		visit(e)
+	case *Logical:
+		for _, operand := range e.Operands {
+			walk(operand, visit)
+		}
+	case *Negation:
+		walk(e.Operand, visit)
+	}
+}
+
+// validateComparison returns why a comparison is invalid, or an empty string if it is valid.
+func validateComparison(comparison *Comparison) string {
+	fieldType, ok := Fields[comparison.Field]
+	if !ok {
+		return fmt.Sprintf("unknown field %s", comparison.Field)
+	}
+	if comparison.Operator == "" {
+		if fieldType != TypeBool {
+			return fmt.Sprintf("field %s of type %s must be compared with a value", comparison.Field, fieldType)
+		}
+		return ""
+	}
+	if fieldType == TypeBool {
+		return fmt.Sprintf("field %s of type bool can't be compared", comparison.Field)
+	}
+	types, ok := operatorTypes[comparison.Operator]
+	if !ok {
+		return fmt.Sprintf("unknown operator %s", comparison.Operator)
+	}
+	if !containsType(types, fieldType) {
+		return fmt.Sprintf("operator %s doesn't apply to field %s of type %s", comparison.Operator, comparison.Field, fieldType)
+	}
+	if comparison.Value == nil {
+		return "missing value"
+	}
+
+	if comparison.Operator == OperatorIn {
+		set, ok := comparison.Value.(SetValue)
+		if !ok {
+			return "operator in requires a set of values"
+		}
+		if len(set) == 0 {
+			return "empty set"
+		}
+		for _, element := range set {
+			if message := validateValue(element, fieldType, true); message != "" {
+				return message
+			}
+		}
+		return ""
+	}
+	if message := validateValue(comparison.Value, fieldType, false); message != "" {
+		return message
+	}
+	if ip, ok := comparison.Value.(IPValue); ok && ip.Network != nil {
+		return fmt.Sprintf("operator %s requires an IP address, use in to match network %s", comparison.Operator, ip)
+	}
+	if comparison.Operator == OperatorMatches {
+		if _, err := regexp.Compile(string(comparison.Value.(StringValue))); err != nil {
+			return fmt.Sprintf("invalid regular expression: %s", err.Error())
+		}
+	}
+	return ""
+}
+
+// validateValue returns why a value can't be compared with a field of the given type, or an empty
+// string if it can.
+func validateValue(value Value, fieldType Type, inSet bool) string {
+	if r, ok := value.(*RangeValue); ok {
+		if !inSet {
+			return fmt.Sprintf("range %s is only allowed in a set", r)
+		}
+		if fieldType != TypeInt && fieldType != TypeIP {
+			return fmt.Sprintf("range %s doesn't apply to a field of type %s", r, fieldType)
+		}
+		if r.From == nil || r.To == nil || r.From.Type() != fieldType || r.To.Type() != fieldType {
+			return fmt.Sprintf("range %s must have bounds of type %s", r, fieldType)
+		}
+		for _, bound := range []Value{r.From, r.To} {
+			if ip, ok := bound.(IPValue); ok && ip.Network != nil {
+				return fmt.Sprintf("range %s must have IP addresses as bounds", r)
+			}
+		}
+		if !rangeIsOrdered(r) {
+			return fmt.Sprintf("range %s must not be reversed", r)
+		}
+		return ""
+	}
+	if _, ok := value.(SetValue); ok {
+		return fmt.Sprintf("set %s is only allowed with operator in", value)
+	}
+	if value.Type() != fieldType {
+		return fmt.Sprintf("value %s of type %s doesn't match the type %s of the field", value, value.Type(), fieldType)
+	}
+	return ""
+}
+
+// rangeIsOrdered reports whether the bounds of a range, which have the same type, aren't reversed.
+func rangeIsOrdered(r *RangeValue) bool {
+	switch from := r.From.(type) {
+	case IntValue:
+		return from <= r.To.(IntValue)
+	case IPValue:
+		to := r.To.(IPValue)
+		if (from.IP.To4() == nil) != (to.IP.To4() == nil) {
+			return false
+		}
+		return bytes.Compare(from.IP.To16(), to.IP.To16()) <= 0
+	}
+	return false
+}
+
+func containsType(types []Type, t Type) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
@@ -159,6 +159,24 @@ func (filters *FiltersV1) DisableRetries() {
 	filters.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (filters *FiltersV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(filters.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (filters *FiltersV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(filters.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (filters *FiltersV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(filters.Service, middleware...)
+}
+
 // ListAllFilters : List all filters for a zone
 // List all filters for a zone.
 func (filters *FiltersV1) ListAllFilters(listAllFiltersOptions *ListAllFiltersOptions) (result *ListFiltersResp, response *core.DetailedResponse, err error) {
@@ -202,6 +220,13 @@ func (filters *FiltersV1) ListAllFiltersWithContext(ctx context.Context, listAll
 		builder.AddHeader("X-Auth-User-Token", fmt.Sprint(*listAllFiltersOptions.XAuthUserToken))
 	}
 
+	if listAllFiltersOptions.Page != nil {
+		builder.AddQuery("page", fmt.Sprint(*listAllFiltersOptions.Page))
+	}
+	if listAllFiltersOptions.PerPage != nil {
+		builder.AddQuery("per_page", fmt.Sprint(*listAllFiltersOptions.PerPage))
+	}
+
 	request, err := builder.Build()
 	if err != nil {
 		return
@@ -210,6 +235,7 @@ func (filters *FiltersV1) ListAllFiltersWithContext(ctx context.Context, listAll
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -282,6 +308,7 @@ func (filters *FiltersV1) CreateFilterWithContext(ctx context.Context, createFil
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -354,6 +381,7 @@ func (filters *FiltersV1) UpdateFiltersWithContext(ctx context.Context, updateFi
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -420,6 +448,7 @@ func (filters *FiltersV1) DeleteFiltersWithContext(ctx context.Context, deleteFi
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -485,6 +514,7 @@ func (filters *FiltersV1) DeleteFilterWithContext(ctx context.Context, deleteFil
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -550,6 +580,7 @@ func (filters *FiltersV1) GetFilterWithContext(ctx context.Context, getFilterOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -634,6 +665,7 @@ func (filters *FiltersV1) UpdateFilterWithContext(ctx context.Context, updateFil
 	var rawResponse map[string]json.RawMessage
 	response, err = filters.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -923,6 +955,12 @@ type ListAllFiltersOptions struct {
 	// Zone identifier of the zone for which filters are listed.
 	ZoneIdentifier *string `validate:"required,ne="`
 
+	// Page number of paginated results.
+	Page *int64 `json:"page,omitempty"`
+
+	// Maximum number of filters per page.
+	PerPage *int64 `json:"per_page,omitempty"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -954,6 +992,18 @@ func (options *ListAllFiltersOptions) SetZoneIdentifier(zoneIdentifier string) *
 	return options
 }
 
+// SetPage : Allow user to set Page
+func (options *ListAllFiltersOptions) SetPage(page int64) *ListAllFiltersOptions {
+	options.Page = core.Int64Ptr(page)
+	return options
+}
+
+// SetPerPage : Allow user to set PerPage
+func (options *ListAllFiltersOptions) SetPerPage(perPage int64) *ListAllFiltersOptions {
+	options.PerPage = core.Int64Ptr(perPage)
+	return options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *ListAllFiltersOptions) SetHeaders(param map[string]string) *ListAllFiltersOptions {
 	options.Headers = param
@@ -1483,3 +1533,97 @@ func UnmarshalListFiltersResp(m map[string]json.RawMessage, result interface{})
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// FiltersPager can be used to simplify the use of the "ListAllFilters" method.
+type FiltersPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListAllFiltersOptions
+	client   *FiltersV1
+}
+
+// NewFiltersPager returns a new FiltersPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (filters *FiltersV1) NewFiltersPager(options *ListAllFiltersOptions) (pager *FiltersPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListAllFiltersOptions = *options
+	pager = &FiltersPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   filters,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *FiltersPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *FiltersPager) GetNextWithContext(ctx context.Context) (page []FilterObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListAllFiltersWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ListFiltersRespResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *FiltersPager) GetAllWithContext(ctx context.Context) (allItems []FilterObject, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []FilterObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *FiltersPager) GetNext() (page []FilterObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *FiltersPager) GetAll() (allItems []FilterObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -0,0 +1,45 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filtersv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// FiltersV1API is the interface of the operations of FiltersV1, through which they can be replaced
+// in unit tests, for instance with the mocks.FiltersV1 of the mocks package.
+type FiltersV1API interface {
+	ListAllFilters(listAllFiltersOptions *ListAllFiltersOptions) (result *ListFiltersResp, response *core.DetailedResponse, err error)
+	ListAllFiltersWithContext(ctx context.Context, listAllFiltersOptions *ListAllFiltersOptions) (result *ListFiltersResp, response *core.DetailedResponse, err error)
+	CreateFilter(createFilterOptions *CreateFilterOptions) (result *FiltersResp, response *core.DetailedResponse, err error)
+	CreateFilterWithContext(ctx context.Context, createFilterOptions *CreateFilterOptions) (result *FiltersResp, response *core.DetailedResponse, err error)
+	UpdateFilters(updateFiltersOptions *UpdateFiltersOptions) (result *FiltersResp, response *core.DetailedResponse, err error)
+	UpdateFiltersWithContext(ctx context.Context, updateFiltersOptions *UpdateFiltersOptions) (result *FiltersResp, response *core.DetailedResponse, err error)
+	DeleteFilters(deleteFiltersOptions *DeleteFiltersOptions) (result *DeleteFiltersResp, response *core.DetailedResponse, err error)
+	DeleteFiltersWithContext(ctx context.Context, deleteFiltersOptions *DeleteFiltersOptions) (result *DeleteFiltersResp, response *core.DetailedResponse, err error)
+	DeleteFilter(deleteFilterOptions *DeleteFilterOptions) (result *DeleteFilterResp, response *core.DetailedResponse, err error)
+	DeleteFilterWithContext(ctx context.Context, deleteFilterOptions *DeleteFilterOptions) (result *DeleteFilterResp, response *core.DetailedResponse, err error)
+	GetFilter(getFilterOptions *GetFilterOptions) (result *FilterResp, response *core.DetailedResponse, err error)
+	GetFilterWithContext(ctx context.Context, getFilterOptions *GetFilterOptions) (result *FilterResp, response *core.DetailedResponse, err error)
+	UpdateFilter(updateFilterOptions *UpdateFilterOptions) (result *FilterResp, response *core.DetailedResponse, err error)
+	UpdateFilterWithContext(ctx context.Context, updateFilterOptions *UpdateFilterOptions) (result *FilterResp, response *core.DetailedResponse, err error)
+}
+
+// FiltersV1 implements FiltersV1API.
+var _ FiltersV1API = (*FiltersV1)(nil)
@@ -0,0 +1,81 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filtersv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`FiltersV1 pagers`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+	listAllFiltersPath := "/v1/testString/zones/testString/filters"
+
+	Describe(`FiltersPager`, func() {
+		Context(`Using mock server endpoint with two pages of results`, func() {
+			BeforeEach(func() {
+				requestCount = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					requestCount++
+					Expect(req.URL.EscapedPath()).To(Equal(listAllFiltersPath))
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.Header["X-Auth-User-Token"]).ToNot(BeNil())
+					Expect(req.URL.Query()["per_page"]).To(Equal([]string{"1"}))
+					Expect(req.URL.Query()["page"]).To(Equal([]string{fmt.Sprint(requestCount)}))
+
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"success": true, "errors": [], "messages": [], "result": [{"id": "filter-%d", "paused": false, "description": "Login from office", "expression": "ip.src eq 93.184.216.0"}], "result_info": {"page": %d, "per_page": 1, "count": 1, "total_count": 2}}`, requestCount, requestCount)
+				}))
+			})
+			It(`Use FiltersPager.GetAll successfully`, func() {
+				filtersService, serviceErr := filtersv1.NewFiltersV1(&filtersv1.FiltersV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(filtersService).ToNot(BeNil())
+
+				listAllFiltersOptionsModel := filtersService.NewListAllFiltersOptions("testString", "testString", "testString")
+				listAllFiltersOptionsModel.SetPerPage(1)
+
+				pager, err := filtersService.NewFiltersPager(listAllFiltersOptionsModel)
+				Expect(err).To(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+				Expect(*allResults[0].ID).To(Equal("filter-1"))
+				Expect(*allResults[1].ID).To(Equal("filter-2"))
+				Expect(requestCount).To(Equal(2))
+				Expect(pager.HasNext()).To(BeFalse())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+})
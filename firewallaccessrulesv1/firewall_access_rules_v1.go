@@ -172,6 +172,24 @@ func (firewallAccessRules *FirewallAccessRulesV1) DisableRetries() {
 	firewallAccessRules.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (firewallAccessRules *FirewallAccessRulesV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(firewallAccessRules.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (firewallAccessRules *FirewallAccessRulesV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(firewallAccessRules.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (firewallAccessRules *FirewallAccessRulesV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(firewallAccessRules.Service, middleware...)
+}
+
 // ListAllAccountAccessRules : List instance level firewall access rules
 // List all instance level firewall access rules.
 func (firewallAccessRules *FirewallAccessRulesV1) ListAllAccountAccessRules(listAllAccountAccessRulesOptions *ListAllAccountAccessRulesOptions) (result *ListAccountAccessRulesResp, response *core.DetailedResponse, err error) {
@@ -243,6 +261,7 @@ func (firewallAccessRules *FirewallAccessRulesV1) ListAllAccountAccessRulesWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListAccountAccessRulesResp)
@@ -313,6 +332,7 @@ func (firewallAccessRules *FirewallAccessRulesV1) CreateAccountAccessRuleWithCon
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAccountAccessRuleResp)
@@ -372,6 +392,7 @@ func (firewallAccessRules *FirewallAccessRulesV1) DeleteAccountAccessRuleWithCon
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteAccountAccessRuleResp)
@@ -431,6 +452,7 @@ func (firewallAccessRules *FirewallAccessRulesV1) GetAccountAccessRuleWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAccountAccessRuleResp)
@@ -503,6 +525,7 @@ func (firewallAccessRules *FirewallAccessRulesV1) UpdateAccountAccessRuleWithCon
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAccountAccessRuleResp)
@@ -1176,3 +1199,97 @@ func UnmarshalListAccountAccessRulesResp(m map[string]json.RawMessage, result in
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// AccountAccessRulesPager can be used to simplify the use of the "ListAllAccountAccessRules" method.
+type AccountAccessRulesPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListAllAccountAccessRulesOptions
+	client   *FirewallAccessRulesV1
+}
+
+// NewAccountAccessRulesPager returns a new AccountAccessRulesPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (firewallAccessRules *FirewallAccessRulesV1) NewAccountAccessRulesPager(options *ListAllAccountAccessRulesOptions) (pager *AccountAccessRulesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListAllAccountAccessRulesOptions = *options
+	pager = &AccountAccessRulesPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   firewallAccessRules,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *AccountAccessRulesPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *AccountAccessRulesPager) GetNextWithContext(ctx context.Context) (page []AccountAccessRuleObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListAllAccountAccessRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ListAccountAccessRulesRespResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *AccountAccessRulesPager) GetAllWithContext(ctx context.Context) (allItems []AccountAccessRuleObject, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []AccountAccessRuleObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *AccountAccessRulesPager) GetNext() (page []AccountAccessRuleObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *AccountAccessRulesPager) GetAll() (allItems []AccountAccessRuleObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -0,0 +1,41 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallaccessrulesv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// FirewallAccessRulesV1API is the interface of the operations of FirewallAccessRulesV1, through which they can be replaced
+// in unit tests, for instance with the mocks.FirewallAccessRulesV1 of the mocks package.
+type FirewallAccessRulesV1API interface {
+	ListAllAccountAccessRules(listAllAccountAccessRulesOptions *ListAllAccountAccessRulesOptions) (result *ListAccountAccessRulesResp, response *core.DetailedResponse, err error)
+	ListAllAccountAccessRulesWithContext(ctx context.Context, listAllAccountAccessRulesOptions *ListAllAccountAccessRulesOptions) (result *ListAccountAccessRulesResp, response *core.DetailedResponse, err error)
+	CreateAccountAccessRule(createAccountAccessRuleOptions *CreateAccountAccessRuleOptions) (result *AccountAccessRuleResp, response *core.DetailedResponse, err error)
+	CreateAccountAccessRuleWithContext(ctx context.Context, createAccountAccessRuleOptions *CreateAccountAccessRuleOptions) (result *AccountAccessRuleResp, response *core.DetailedResponse, err error)
+	DeleteAccountAccessRule(deleteAccountAccessRuleOptions *DeleteAccountAccessRuleOptions) (result *DeleteAccountAccessRuleResp, response *core.DetailedResponse, err error)
+	DeleteAccountAccessRuleWithContext(ctx context.Context, deleteAccountAccessRuleOptions *DeleteAccountAccessRuleOptions) (result *DeleteAccountAccessRuleResp, response *core.DetailedResponse, err error)
+	GetAccountAccessRule(getAccountAccessRuleOptions *GetAccountAccessRuleOptions) (result *AccountAccessRuleResp, response *core.DetailedResponse, err error)
+	GetAccountAccessRuleWithContext(ctx context.Context, getAccountAccessRuleOptions *GetAccountAccessRuleOptions) (result *AccountAccessRuleResp, response *core.DetailedResponse, err error)
+	UpdateAccountAccessRule(updateAccountAccessRuleOptions *UpdateAccountAccessRuleOptions) (result *AccountAccessRuleResp, response *core.DetailedResponse, err error)
+	UpdateAccountAccessRuleWithContext(ctx context.Context, updateAccountAccessRuleOptions *UpdateAccountAccessRuleOptions) (result *AccountAccessRuleResp, response *core.DetailedResponse, err error)
+}
+
+// FirewallAccessRulesV1 implements FirewallAccessRulesV1API.
+var _ FirewallAccessRulesV1API = (*FirewallAccessRulesV1)(nil)
@@ -0,0 +1,548 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallaccessrulesv1
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// DefaultAccessRuleSyncTag is the tag that marks the notes of the rules managed by PlanAccountAccessRuleSync
+// when no other tag is set.
+const DefaultAccessRuleSyncTag = "managed-by:access-rule-sync"
+
+// The prefix lengths of the ip_range rules. An IPv4 range is limited to /16 and /24, and an IPv6 range
+// to /32, /48 and /64; the other networks are split into ranges of the next longer length, or into
+// ip rules.
+var (
+	AccessRuleIPv4RangePrefixLengths = []int{16, 24}
+	AccessRuleIPv6RangePrefixLengths = []int{32, 48, 64}
+)
+
+// maxAccessRuleSplitBits limits the number of rules into which a network may be split to 2^16.
+const maxAccessRuleSplitBits = 16
+
+var (
+	asnPattern     = regexp.MustCompile(`^(?i:AS)?([0-9]+)$`)
+	countryPattern = regexp.MustCompile(`^[A-Za-z]{2}$`)
+)
+
+// SyncAccountAccessRulesOptions : The PlanAccountAccessRuleSync options.
+type SyncAccountAccessRulesOptions struct {
+	// The desired IP addresses, CIDRs, AS numbers (e.g. "AS13335" or "13335") and country codes
+	// (e.g. "US"). IP addresses and CIDRs are aggregated with AggregateCIDRs.
+	Values []string `json:"values"`
+
+	// The action to apply to a matched request (one of the CreateAccountAccessRuleOptions_Mode_* constants).
+	Mode *string `json:"mode" validate:"required"`
+
+	// The notes of the rules managed by the sync (DefaultAccessRuleSyncTag if nil). Only the rules whose
+	// notes are the tag, or start with the tag followed by a space, are updated or deleted.
+	Tag *string `json:"tag,omitempty"`
+
+	// The maximum number of rules that applying the plan may delete (common.DefaultSyncMaxDeletions if nil).
+	MaxDeletions *int64 `json:"max_deletions,omitempty"`
+
+	// The policy with which the changes throttled by the service are retried (the default policy if nil).
+	RetryPolicy *common.RetryPolicy `json:"-"`
+}
+
+// NewSyncAccountAccessRulesOptions : Instantiate SyncAccountAccessRulesOptions
+func (*FirewallAccessRulesV1) NewSyncAccountAccessRulesOptions(values []string, mode string) *SyncAccountAccessRulesOptions {
+	return &SyncAccountAccessRulesOptions{
+		Values: values,
+		Mode:   core.StringPtr(mode),
+	}
+}
+
+// SetTag : Allow user to set Tag
+func (options *SyncAccountAccessRulesOptions) SetTag(tag string) *SyncAccountAccessRulesOptions {
+	options.Tag = core.StringPtr(tag)
+	return options
+}
+
+// SetMaxDeletions : Allow user to set MaxDeletions
+func (options *SyncAccountAccessRulesOptions) SetMaxDeletions(maxDeletions int64) *SyncAccountAccessRulesOptions {
+	options.MaxDeletions = core.Int64Ptr(maxDeletions)
+	return options
+}
+
+// SetRetryPolicy : Allow user to set RetryPolicy
+func (options *SyncAccountAccessRulesOptions) SetRetryPolicy(retryPolicy *common.RetryPolicy) *SyncAccountAccessRulesOptions {
+	options.RetryPolicy = retryPolicy
+	return options
+}
+
+// AccountAccessRuleChange : A change of an AccountAccessRuleSyncPlan.
+type AccountAccessRuleChange struct {
+	// The action of the change (one of the common.SyncAction* constants).
+	Action string
+
+	// The configuration of the rule.
+	Target string
+	Value  string
+
+	// The desired mode, for a create or an update.
+	Mode string
+
+	// The rule of the account, for an update or a delete.
+	Current *AccountAccessRuleObject
+
+	// The rule returned by the service once a create or an update is applied.
+	Result *AccountAccessRuleObject
+
+	// Whether the change was applied, and the error if it failed.
+	Applied bool
+	Err     error
+}
+
+// String returns the change as a line of a diff: the rule to create prefixed with "+", the rule to
+// delete prefixed with "-", or the rule to update prefixed with "~" followed by the change of mode.
+func (change *AccountAccessRuleChange) String() string {
+	switch change.Action {
+	case common.SyncActionCreate:
+		return fmt.Sprintf("+ %s %s %s", change.Target, change.Value, change.Mode)
+	case common.SyncActionUpdate:
+		return fmt.Sprintf("~ %s %s %s -> %s", change.Target, change.Value, core.StringNilMapper(change.Current.Mode), change.Mode)
+	default:
+		return fmt.Sprintf("- %s %s %s", change.Target, change.Value, core.StringNilMapper(change.Current.Mode))
+	}
+}
+
+// AccountAccessRuleSyncPlan : The changes that bring the access rules of an account to their desired state.
+type AccountAccessRuleSyncPlan struct {
+	// The tag of the managed rules.
+	Tag string
+
+	// The changes: the creates and updates in the order of the desired rules, then the deletes.
+	Changes []*AccountAccessRuleChange
+
+	// The number of desired rules that are already present.
+	Unchanged int
+
+	// The rules that are not managed by the sync, and are left alone although their configuration is
+	// one of the desired ones.
+	Unmanaged []AccountAccessRuleObject
+
+	// The maximum number of rules that applying the plan may delete.
+	MaxDeletions int64
+
+	// The policy with which the changes throttled by the service are retried.
+	RetryPolicy *common.RetryPolicy
+}
+
+// IsEmpty returns true if the access rules are already in their desired state.
+func (plan *AccountAccessRuleSyncPlan) IsEmpty() bool {
+	return len(plan.Changes) == 0
+}
+
+// Count returns the number of changes with the given action.
+func (plan *AccountAccessRuleSyncPlan) Count(action string) (count int) {
+	for _, change := range plan.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return
+}
+
+// String returns the plan as a human-readable diff: a summary line followed by one line per change.
+func (plan *AccountAccessRuleSyncPlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Access rules %s: %d to create, %d to update, %d to delete, %d unchanged, %d unmanaged\n",
+		plan.Tag, plan.Count(common.SyncActionCreate), plan.Count(common.SyncActionUpdate),
+		plan.Count(common.SyncActionDelete), plan.Unchanged, len(plan.Unmanaged))
+	for _, change := range plan.Changes {
+		b.WriteString(change.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// PlanAccountAccessRuleSync computes the changes that bring the rules of the account managed by the
+// sync, the ones tagged through their notes, to the desired values. IP addresses and CIDRs are first
+// collapsed into the fewest CIDRs, which are then split into the ranges that access rules support.
+// A desired rule that is present is left alone, or updated if its mode differs; a desired rule whose
+// configuration is taken by a rule that is not managed is left to that rule; the other desired rules
+// are created, and the remaining managed rules are deleted. The plan can be printed as a diff, and
+// applied with ApplyAccountAccessRuleSyncPlan.
+func (firewallAccessRules *FirewallAccessRulesV1) PlanAccountAccessRuleSync(ctx context.Context, syncAccountAccessRulesOptions *SyncAccountAccessRulesOptions) (plan *AccountAccessRuleSyncPlan, err error) {
+	err = core.ValidateNotNil(syncAccountAccessRulesOptions, "syncAccountAccessRulesOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(syncAccountAccessRulesOptions, "syncAccountAccessRulesOptions")
+	if err != nil {
+		return
+	}
+	desired, err := AccessRuleConfigurations(syncAccountAccessRulesOptions.Values)
+	if err != nil {
+		return
+	}
+
+	pager, err := firewallAccessRules.NewAccountAccessRulesPager(firewallAccessRules.NewListAllAccountAccessRulesOptions())
+	if err != nil {
+		return
+	}
+	rules, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+
+	plan = &AccountAccessRuleSyncPlan{
+		Tag:          DefaultAccessRuleSyncTag,
+		MaxDeletions: common.DefaultSyncMaxDeletions,
+		RetryPolicy:  syncAccountAccessRulesOptions.RetryPolicy,
+	}
+	if syncAccountAccessRulesOptions.Tag != nil {
+		plan.Tag = *syncAccountAccessRulesOptions.Tag
+	}
+	if syncAccountAccessRulesOptions.MaxDeletions != nil {
+		plan.MaxDeletions = *syncAccountAccessRulesOptions.MaxDeletions
+	}
+	mode := *syncAccountAccessRulesOptions.Mode
+
+	rulesByKey := map[string]*AccountAccessRuleObject{}
+	for i := range rules {
+		if rules[i].Configuration != nil {
+			rulesByKey[accessRuleKey(core.StringNilMapper(rules[i].Configuration.Target), core.StringNilMapper(rules[i].Configuration.Value))] = &rules[i]
+		}
+	}
+	desiredKeys := map[string]bool{}
+	for _, configuration := range desired {
+		target, value := *configuration.Target, *configuration.Value
+		key := accessRuleKey(target, value)
+		desiredKeys[key] = true
+		current := rulesByKey[key]
+		switch {
+		case current == nil:
+			plan.Changes = append(plan.Changes, &AccountAccessRuleChange{Action: common.SyncActionCreate, Target: target, Value: value, Mode: mode})
+		case !plan.manages(current):
+			plan.Unmanaged = append(plan.Unmanaged, *current)
+		case core.StringNilMapper(current.Mode) != mode:
+			plan.Changes = append(plan.Changes, &AccountAccessRuleChange{Action: common.SyncActionUpdate, Target: target, Value: value, Mode: mode, Current: current})
+		default:
+			plan.Unchanged++
+		}
+	}
+
+	var deletes []*AccountAccessRuleChange
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Configuration == nil || !plan.manages(rule) {
+			continue
+		}
+		target, value := core.StringNilMapper(rule.Configuration.Target), core.StringNilMapper(rule.Configuration.Value)
+		if !desiredKeys[accessRuleKey(target, value)] {
+			deletes = append(deletes, &AccountAccessRuleChange{Action: common.SyncActionDelete, Target: target, Value: value, Current: rule})
+		}
+	}
+	sort.SliceStable(deletes, func(i, j int) bool {
+		if deletes[i].Target != deletes[j].Target {
+			return deletes[i].Target < deletes[j].Target
+		}
+		return deletes[i].Value < deletes[j].Value
+	})
+	plan.Changes = append(plan.Changes, deletes...)
+	return
+}
+
+// ApplyAccountAccessRuleSyncPlan applies the changes of a plan computed by PlanAccountAccessRuleSync,
+// with at most concurrency changes in progress at the same time (common.DefaultSyncConcurrency if less
+// than one). Nothing is applied if the plan deletes more rules than its MaxDeletions, in which case the
+// error wraps common.ErrSyncMaxDeletions. Creates and updates are applied first, then deletes, so that
+// the addresses that stay denied remain covered while ranges are merged; if a change fails, the deletes
+// are not attempted. The changes throttled by the service are paced by a common.SyncThrottle with the
+// RetryPolicy of the plan. The outcome of each change is recorded in the plan, and a *common.SyncError
+// is returned if any change failed.
+func (firewallAccessRules *FirewallAccessRulesV1) ApplyAccountAccessRuleSyncPlan(ctx context.Context, plan *AccountAccessRuleSyncPlan, concurrency int) error {
+	err := core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		return err
+	}
+	if deletions := plan.Count(common.SyncActionDelete); int64(deletions) > plan.MaxDeletions {
+		return fmt.Errorf("%w: %d rules would be deleted, the maximum is %d", common.ErrSyncMaxDeletions, deletions, plan.MaxDeletions)
+	}
+
+	throttle := &common.SyncThrottle{Policy: plan.RetryPolicy}
+	syncErr := &common.SyncError{}
+	for _, deletes := range []bool{false, true} {
+		var changes []*AccountAccessRuleChange
+		for _, change := range plan.Changes {
+			if (change.Action == common.SyncActionDelete) == deletes && !change.Applied {
+				changes = append(changes, change)
+			}
+		}
+		if len(syncErr.Errors) > 0 {
+			syncErr.Skipped += len(changes)
+			continue
+		}
+		errs := common.RunConcurrently(ctx, concurrency, len(changes), func(ctx context.Context, index int) error {
+			return throttle.Do(ctx, func(ctx context.Context) error {
+				return firewallAccessRules.applyAccountAccessRuleChange(ctx, plan, changes[index])
+			})
+		})
+		for i, changeErr := range errs {
+			if changeErr != nil {
+				changes[i].Err = changeErr
+				syncErr.Errors = append(syncErr.Errors, fmt.Errorf("%s: %w", changes[i], changeErr))
+			} else {
+				changes[i].Applied = true
+				syncErr.Applied++
+			}
+		}
+	}
+	if len(syncErr.Errors) > 0 {
+		return syncErr
+	}
+	return nil
+}
+
+// applyAccountAccessRuleChange applies one change of a plan.
+func (firewallAccessRules *FirewallAccessRulesV1) applyAccountAccessRuleChange(ctx context.Context, plan *AccountAccessRuleSyncPlan, change *AccountAccessRuleChange) (err error) {
+	var result *AccountAccessRuleResp
+	switch change.Action {
+	case common.SyncActionCreate:
+		configuration := &AccountAccessRuleInputConfiguration{
+			Target: core.StringPtr(change.Target),
+			Value:  core.StringPtr(change.Value),
+		}
+		options := firewallAccessRules.NewCreateAccountAccessRuleOptions()
+		options.SetMode(change.Mode).SetNotes(plan.Tag).SetConfiguration(configuration)
+		result, _, err = firewallAccessRules.CreateAccountAccessRuleWithContext(ctx, options)
+	case common.SyncActionUpdate:
+		options := firewallAccessRules.NewUpdateAccountAccessRuleOptions(core.StringNilMapper(change.Current.ID))
+		options.SetMode(change.Mode)
+		result, _, err = firewallAccessRules.UpdateAccountAccessRuleWithContext(ctx, options)
+	case common.SyncActionDelete:
+		_, _, err = firewallAccessRules.DeleteAccountAccessRuleWithContext(ctx, firewallAccessRules.NewDeleteAccountAccessRuleOptions(core.StringNilMapper(change.Current.ID)))
+		if common.IsNotFound(err) {
+			err = nil
+		}
+	default:
+		err = fmt.Errorf("unknown sync action %q", change.Action)
+	}
+	if err == nil && result != nil && result.Result != nil {
+		change.Result = result.Result
+	}
+	return
+}
+
+// manages returns true if the rule is tagged as managed by the sync.
+func (plan *AccountAccessRuleSyncPlan) manages(rule *AccountAccessRuleObject) bool {
+	notes := core.StringNilMapper(rule.Notes)
+	return notes == plan.Tag || strings.HasPrefix(notes, plan.Tag+" ")
+}
+
+// accessRuleKey returns the key that identifies the configuration of a rule, with the IP address or
+// network in its canonical form.
+func accessRuleKey(target string, value string) string {
+	switch target {
+	case AccountAccessRuleInputConfiguration_Target_Ip:
+		if ip := net.ParseIP(value); ip != nil {
+			value = ip.String()
+		}
+	case AccountAccessRuleInputConfiguration_Target_IpRange:
+		if _, network, err := net.ParseCIDR(value); err == nil {
+			value = network.String()
+		}
+	}
+	return target + ":" + strings.ToUpper(value)
+}
+
+// AccessRuleConfigurations returns the configurations of the access rules for a list of IP addresses,
+// CIDRs, AS numbers and country codes. IP addresses and CIDRs are aggregated with AggregateCIDRs, then
+// split into ip rules and ip_range rules of the lengths of AccessRuleIPv4RangePrefixLengths and
+// AccessRuleIPv6RangePrefixLengths. The networks come first, followed by the AS numbers and the
+// country codes in the order of values; duplicates are removed.
+func AccessRuleConfigurations(values []string) (configurations []*AccountAccessRuleInputConfiguration, err error) {
+	var cidrs []string
+	var others []*AccountAccessRuleInputConfiguration
+	seen := map[string]bool{}
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		var target string
+		switch {
+		case asnPattern.MatchString(value):
+			target, value = AccountAccessRuleInputConfiguration_Target_Asn, "AS"+asnPattern.FindStringSubmatch(value)[1]
+		case countryPattern.MatchString(value):
+			target, value = AccountAccessRuleInputConfiguration_Target_Country, strings.ToUpper(value)
+		default:
+			cidrs = append(cidrs, value)
+			continue
+		}
+		if !seen[target+":"+value] {
+			seen[target+":"+value] = true
+			others = append(others, &AccountAccessRuleInputConfiguration{Target: core.StringPtr(target), Value: core.StringPtr(value)})
+		}
+	}
+
+	networks, err := AggregateCIDRs(cidrs)
+	if err != nil {
+		return
+	}
+	for _, network := range networks {
+		var split []*AccountAccessRuleInputConfiguration
+		split, err = splitAccessRuleNetwork(network)
+		if err != nil {
+			return nil, err
+		}
+		configurations = append(configurations, split...)
+	}
+	return append(configurations, others...), nil
+}
+
+// splitAccessRuleNetwork returns the configurations of the rules that cover a network: the network
+// itself if its length is allowed, else the ranges of the next longer allowed length, or its addresses.
+func splitAccessRuleNetwork(network *net.IPNet) ([]*AccountAccessRuleInputConfiguration, error) {
+	ones, bits := network.Mask.Size()
+	lengths := AccessRuleIPv4RangePrefixLengths
+	if bits == 8*net.IPv6len {
+		lengths = AccessRuleIPv6RangePrefixLengths
+	}
+	length := bits
+	for _, allowed := range lengths {
+		if allowed >= ones && allowed < length {
+			length = allowed
+		}
+	}
+	if length-ones > maxAccessRuleSplitBits {
+		return nil, fmt.Errorf("network %s would be split into more than %d access rules", network, 1<<maxAccessRuleSplitBits)
+	}
+
+	target := AccountAccessRuleInputConfiguration_Target_IpRange
+	if length == bits {
+		target = AccountAccessRuleInputConfiguration_Target_Ip
+	}
+	first := new(big.Int).SetBytes(network.IP)
+	step := new(big.Int).Lsh(big.NewInt(1), uint(bits-length))
+	count := 1 << uint(length-ones)
+	configurations := make([]*AccountAccessRuleInputConfiguration, 0, count)
+	for i := 0; i < count; i++ {
+		value := intToIP(first, len(network.IP)).String()
+		if target == AccountAccessRuleInputConfiguration_Target_IpRange {
+			value = fmt.Sprintf("%s/%d", value, length)
+		}
+		configurations = append(configurations, &AccountAccessRuleInputConfiguration{Target: core.StringPtr(target), Value: core.StringPtr(value)})
+		first.Add(first, step)
+	}
+	return configurations, nil
+}
+
+// AggregateCIDRs parses IP addresses and CIDRs, and returns the fewest CIDRs that cover the same
+// addresses: overlapping and adjacent networks are merged, e.g. 192.0.2.0/25 and 192.0.2.128/25
+// into 192.0.2.0/24. The IPv4 networks come first, then the IPv6 ones, each in ascending order.
+// IPv4-mapped IPv6 addresses are treated as IPv4 addresses.
+func AggregateCIDRs(values []string) ([]*net.IPNet, error) {
+	intervals := map[int][][2]*big.Int{}
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		var network *net.IPNet
+		if strings.Contains(value, "/") {
+			ip, parsed, err := net.ParseCIDR(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR %q", value)
+			}
+			ones, bits := parsed.Mask.Size()
+			if ip4 := ip.To4(); ip4 != nil && bits == 8*net.IPv6len {
+				// An IPv4-mapped CIDR such as ::ffff:192.0.2.0/120.
+				if ones < 96 {
+					return nil, fmt.Errorf("invalid CIDR %q", value)
+				}
+				parsed = &net.IPNet{IP: ip4.Mask(net.CIDRMask(ones-96, 32)), Mask: net.CIDRMask(ones-96, 32)}
+			}
+			network = parsed
+		} else {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", value)
+			}
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			network = &net.IPNet{IP: ip, Mask: net.CIDRMask(8*len(ip), 8*len(ip))}
+		}
+		ones, bits := network.Mask.Size()
+		first := new(big.Int).SetBytes(network.IP)
+		last := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+		last.Add(last, first).Sub(last, big.NewInt(1))
+		intervals[len(network.IP)] = append(intervals[len(network.IP)], [2]*big.Int{first, last})
+	}
+
+	var networks []*net.IPNet
+	for _, size := range []int{net.IPv4len, net.IPv6len} {
+		family := intervals[size]
+		sort.Slice(family, func(i, j int) bool {
+			return family[i][0].Cmp(family[j][0]) < 0
+		})
+		var merged [][2]*big.Int
+		for _, interval := range family {
+			if n := len(merged); n > 0 {
+				next := new(big.Int).Add(merged[n-1][1], big.NewInt(1))
+				if interval[0].Cmp(next) <= 0 {
+					if interval[1].Cmp(merged[n-1][1]) > 0 {
+						merged[n-1][1] = interval[1]
+					}
+					continue
+				}
+			}
+			merged = append(merged, interval)
+		}
+		for _, interval := range merged {
+			networks = append(networks, rangeToCIDRs(interval[0], interval[1], size)...)
+		}
+	}
+	return networks, nil
+}
+
+// rangeToCIDRs returns the fewest CIDRs that cover the addresses from first to last, of size bytes.
+func rangeToCIDRs(first *big.Int, last *big.Int, size int) (networks []*net.IPNet) {
+	bits := 8 * size
+	first = new(big.Int).Set(first)
+	end := new(big.Int).Add(last, big.NewInt(1))
+	for first.Cmp(end) < 0 {
+		// The largest block that starts at first and ends before end.
+		hostBits := bits
+		if first.Sign() != 0 && int(first.TrailingZeroBits()) < hostBits {
+			hostBits = int(first.TrailingZeroBits())
+		}
+		blockSize := new(big.Int).Lsh(big.NewInt(1), uint(hostBits))
+		for new(big.Int).Add(first, blockSize).Cmp(end) > 0 {
+			hostBits--
+			blockSize.Rsh(blockSize, 1)
+		}
+		networks = append(networks, &net.IPNet{IP: intToIP(first, size), Mask: net.CIDRMask(bits-hostBits, bits)})
+		first.Add(first, blockSize)
+	}
+	return
+}
+
+// intToIP returns the IP address of size bytes whose value is n.
+func intToIP(n *big.Int, size int) net.IP {
+	b := n.Bytes()
+	ip := make(net.IP, size)
+	copy(ip[size-len(b):], b)
+	return ip
+}
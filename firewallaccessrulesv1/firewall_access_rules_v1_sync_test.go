@@ -0,0 +1,222 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallaccessrulesv1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/fakes"
+	"github.com/IBM/networking-go-sdk/firewallaccessrulesv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`FirewallAccessRulesV1 account access rule sync`, func() {
+	const crn = "crn:v1:bluemix:public:internet-svcs:global:a/fake-account:instance-1::"
+	var testServer *httptest.Server
+	var firewallAccessRulesService *firewallaccessrulesv1.FirewallAccessRulesV1
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(fakes.NewCisServer())
+		var err error
+		firewallAccessRulesService, err = firewallaccessrulesv1.NewFirewallAccessRulesV1(&firewallaccessrulesv1.FirewallAccessRulesV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Crn:           core.StringPtr(crn),
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	createRule := func(target string, value string, mode string, notes string) {
+		configuration, _ := firewallAccessRulesService.NewAccountAccessRuleInputConfiguration(target, value)
+		_, _, err := firewallAccessRulesService.CreateAccountAccessRule(firewallAccessRulesService.NewCreateAccountAccessRuleOptions().
+			SetMode(mode).SetNotes(notes).SetConfiguration(configuration))
+		Expect(err).To(BeNil())
+	}
+	listRules := func() map[string]string {
+		list, _, err := firewallAccessRulesService.ListAllAccountAccessRules(firewallAccessRulesService.NewListAllAccountAccessRulesOptions())
+		Expect(err).To(BeNil())
+		rules := map[string]string{}
+		for _, rule := range list.Result {
+			rules[*rule.Configuration.Value] = *rule.Mode + " " + *rule.Notes
+		}
+		return rules
+	}
+	values := func(configurations []*firewallaccessrulesv1.AccountAccessRuleInputConfiguration) (result []string) {
+		for _, configuration := range configurations {
+			result = append(result, *configuration.Target+" "+*configuration.Value)
+		}
+		return
+	}
+
+	Describe(`AggregateCIDRs(values []string)`, func() {
+		It(`Merges overlapping and adjacent networks into the fewest CIDRs`, func() {
+			networks, err := firewallaccessrulesv1.AggregateCIDRs([]string{
+				"192.0.2.128/25", "2001:db8::1", "192.0.2.0/25", "192.0.2.7", "10.0.0.0/31", "10.0.0.2",
+				"::ffff:10.0.0.3", "2001:db8::/127", "10.0.1.0/24",
+			})
+			Expect(err).To(BeNil())
+			var cidrs []string
+			for _, network := range networks {
+				cidrs = append(cidrs, network.String())
+			}
+			Expect(cidrs).To(Equal([]string{"10.0.0.0/30", "10.0.1.0/24", "192.0.2.0/24", "2001:db8::/127"}))
+		})
+		It(`Splits a range that is not aligned`, func() {
+			networks, err := firewallaccessrulesv1.AggregateCIDRs([]string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"})
+			Expect(err).To(BeNil())
+			Expect(networks).To(HaveLen(3))
+			Expect(networks[0].String()).To(Equal("10.0.0.1/32"))
+			Expect(networks[1].String()).To(Equal("10.0.0.2/31"))
+			Expect(networks[2].String()).To(Equal("10.0.0.4/32"))
+		})
+		It(`Rejects invalid values`, func() {
+			_, err := firewallaccessrulesv1.AggregateCIDRs([]string{"10.0.0.256"})
+			Expect(err).To(MatchError(`invalid IP address "10.0.0.256"`))
+			_, err = firewallaccessrulesv1.AggregateCIDRs([]string{"10.0.0.0/33"})
+			Expect(err).To(MatchError(`invalid CIDR "10.0.0.0/33"`))
+		})
+	})
+
+	Describe(`AccessRuleConfigurations(values []string)`, func() {
+		It(`Splits networks into the ranges supported by access rules`, func() {
+			configurations, err := firewallaccessrulesv1.AccessRuleConfigurations([]string{
+				"198.51.100.0/23", "203.0.113.0/30", "10.0.0.0/16", "as13335", "13335", "us", "2001:db8::/47",
+			})
+			Expect(err).To(BeNil())
+			Expect(values(configurations)).To(Equal([]string{
+				"ip_range 10.0.0.0/16", "ip_range 198.51.100.0/24", "ip_range 198.51.101.0/24",
+				"ip 203.0.113.0", "ip 203.0.113.1", "ip 203.0.113.2", "ip 203.0.113.3",
+				"ip_range 2001:db8::/48", "ip_range 2001:db8:1::/48", "asn AS13335", "country US",
+			}))
+		})
+		It(`Rejects a network that would need too many rules`, func() {
+			_, err := firewallaccessrulesv1.AccessRuleConfigurations([]string{"2001:db8::/80"})
+			Expect(err).To(MatchError("network 2001:db8::/80 would be split into more than 65536 access rules"))
+		})
+	})
+
+	Describe(`PlanAccountAccessRuleSync(syncAccountAccessRulesOptions *SyncAccountAccessRulesOptions)`, func() {
+		It(`Plans the changes of the managed rules as a diff`, func() {
+			createRule("ip", "192.0.2.1", "block", firewallaccessrulesv1.DefaultAccessRuleSyncTag)
+			createRule("ip_range", "198.51.100.0/24", "challenge", firewallaccessrulesv1.DefaultAccessRuleSyncTag+" imported")
+			createRule("ip", "203.0.113.9", "block", firewallaccessrulesv1.DefaultAccessRuleSyncTag)
+			createRule("ip", "203.0.113.10", "whitelist", "office")
+			createRule("ip", "203.0.113.11", "block", "other")
+
+			plan, err := firewallAccessRulesService.PlanAccountAccessRuleSync(context.Background(),
+				firewallAccessRulesService.NewSyncAccountAccessRulesOptions(
+					[]string{"198.51.100.0/25", "198.51.100.128/25", "192.0.2.1", "203.0.113.10", "AS64500"}, "block"))
+			Expect(err).To(BeNil())
+			Expect(plan.Unchanged).To(Equal(1))
+			Expect(plan.Unmanaged).To(HaveLen(1))
+			Expect(plan.String()).To(Equal("Access rules " + firewallaccessrulesv1.DefaultAccessRuleSyncTag +
+				": 1 to create, 1 to update, 1 to delete, 1 unchanged, 1 unmanaged\n" +
+				"~ ip_range 198.51.100.0/24 challenge -> block\n" +
+				"+ asn AS64500 block\n" +
+				"- ip 203.0.113.9 block\n"))
+		})
+		It(`Requires a mode`, func() {
+			_, err := firewallAccessRulesService.PlanAccountAccessRuleSync(context.Background(),
+				&firewallaccessrulesv1.SyncAccountAccessRulesOptions{Values: []string{"192.0.2.1"}})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`ApplyAccountAccessRuleSyncPlan(plan *AccountAccessRuleSyncPlan, concurrency int)`, func() {
+		It(`Applies the plan, tagging the created rules`, func() {
+			createRule("ip", "192.0.2.1", "block", "deny-list")
+			createRule("ip", "192.0.2.2", "block", "deny-list")
+			createRule("ip", "203.0.113.10", "whitelist", "office")
+
+			options := firewallAccessRulesService.NewSyncAccountAccessRulesOptions(
+				[]string{"192.0.2.0/24", "198.51.100.7"}, "block").SetTag("deny-list")
+			plan, err := firewallAccessRulesService.PlanAccountAccessRuleSync(context.Background(), options)
+			Expect(err).To(BeNil())
+			Expect(firewallAccessRulesService.ApplyAccountAccessRuleSyncPlan(context.Background(), plan, 2)).To(BeNil())
+			for _, change := range plan.Changes {
+				Expect(change.Applied).To(BeTrue())
+			}
+			Expect(listRules()).To(Equal(map[string]string{
+				"192.0.2.0/24": "block deny-list",
+				"198.51.100.7": "block deny-list",
+				"203.0.113.10": "whitelist office",
+			}))
+
+			plan, err = firewallAccessRulesService.PlanAccountAccessRuleSync(context.Background(), options)
+			Expect(err).To(BeNil())
+			Expect(plan.IsEmpty()).To(BeTrue())
+		})
+		It(`Pauses and retries the changes throttled by the service`, func() {
+			var throttled int32
+			firewallAccessRulesService.EnableMiddleware(common.StubMiddleware(func(req *http.Request) (*http.Response, error) {
+				if req.Method != http.MethodPost || atomic.AddInt32(&throttled, 1) > 2 {
+					return nil, nil
+				}
+				resp := common.NewResponse(req, http.StatusTooManyRequests,
+					`{"success": false, "errors": [{"code": 971, "message": "Please wait and consider throttling your request speed"}], "messages": [], "result": null}`)
+				resp.Header.Set("Retry-After", "0")
+				return resp, nil
+			}))
+
+			options := firewallAccessRulesService.NewSyncAccountAccessRulesOptions(
+				[]string{"192.0.2.1", "192.0.2.3", "192.0.2.5"}, "challenge").
+				SetRetryPolicy(&common.RetryPolicy{MinRetryInterval: time.Millisecond, MaxRetryInterval: time.Millisecond})
+			plan, err := firewallAccessRulesService.PlanAccountAccessRuleSync(context.Background(), options)
+			Expect(err).To(BeNil())
+			Expect(firewallAccessRulesService.ApplyAccountAccessRuleSyncPlan(context.Background(), plan, 3)).To(BeNil())
+			Expect(throttled).To(Equal(int32(5)))
+			Expect(listRules()).To(HaveLen(3))
+		})
+		It(`Skips the deletes after a failure`, func() {
+			createRule("ip", "192.0.2.1", "block", firewallaccessrulesv1.DefaultAccessRuleSyncTag)
+			_, err := firewallAccessRulesService.PlanAccountAccessRuleSync(context.Background(),
+				firewallAccessRulesService.NewSyncAccountAccessRulesOptions([]string{"XX-1"}, "block"))
+			Expect(err).To(MatchError(`invalid IP address "XX-1"`))
+
+			plan, err := firewallAccessRulesService.PlanAccountAccessRuleSync(context.Background(),
+				firewallAccessRulesService.NewSyncAccountAccessRulesOptions([]string{"198.51.100.7"}, "deny"))
+			Expect(err).To(BeNil())
+			err = firewallAccessRulesService.ApplyAccountAccessRuleSyncPlan(context.Background(), plan, 0)
+			var syncErr *common.SyncError
+			Expect(errors.As(err, &syncErr)).To(BeTrue())
+			Expect(syncErr.Errors).To(HaveLen(1))
+			Expect(syncErr.Skipped).To(Equal(1))
+			Expect(listRules()).To(HaveKey("192.0.2.1"))
+		})
+		It(`Refuses a plan that deletes too many rules`, func() {
+			createRule("ip", "192.0.2.1", "block", firewallaccessrulesv1.DefaultAccessRuleSyncTag)
+			createRule("ip", "192.0.2.2", "block", firewallaccessrulesv1.DefaultAccessRuleSyncTag)
+			plan, err := firewallAccessRulesService.PlanAccountAccessRuleSync(context.Background(),
+				firewallAccessRulesService.NewSyncAccountAccessRulesOptions(nil, "block").SetMaxDeletions(1))
+			Expect(err).To(BeNil())
+			err = firewallAccessRulesService.ApplyAccountAccessRuleSyncPlan(context.Background(), plan, 0)
+			Expect(errors.Is(err, common.ErrSyncMaxDeletions)).To(BeTrue())
+			Expect(listRules()).To(HaveLen(2))
+		})
+	})
+})
@@ -178,6 +178,24 @@ func (firewallApi *FirewallApiV1) DisableRetries() {
 	firewallApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (firewallApi *FirewallApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(firewallApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (firewallApi *FirewallApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(firewallApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (firewallApi *FirewallApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(firewallApi.Service, middleware...)
+}
+
 // GetSecurityLevelSetting : Get security level setting
 // For a given zone identifier, get security level setting.
 func (firewallApi *FirewallApiV1) GetSecurityLevelSetting(getSecurityLevelSettingOptions *GetSecurityLevelSettingOptions) (result *SecurityLevelSettingResp, response *core.DetailedResponse, err error) {
@@ -222,6 +240,7 @@ func (firewallApi *FirewallApiV1) GetSecurityLevelSettingWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSecurityLevelSettingResp)
@@ -287,6 +306,7 @@ func (firewallApi *FirewallApiV1) SetSecurityLevelSettingWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSecurityLevelSettingResp)
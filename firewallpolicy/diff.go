@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// FieldDiff is a field of a resource whose value in the zone differs from the policy.
+type FieldDiff struct {
+	// The name of the field, e.g. "mode" or "urls".
+	Field string
+
+	// The value of the field in the policy, and in the zone, as decoded from JSON.
+	Desired interface{}
+	Actual  interface{}
+}
+
+// String returns the field with its value in the zone and in the policy, e.g. `mode: "block" -> "challenge"`.
+func (diff FieldDiff) String() string {
+	return fmt.Sprintf("%s: %s -> %s", diff.Field, jsonString(diff.Actual), jsonString(diff.Desired))
+}
+
+// normalize returns a value as decoded from its JSON encoding, so that the values of the policy and those
+// of the zone, of different types, can be compared.
+func normalize(value interface{}) interface{} {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(b, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
+
+// diffFields returns the fields of desired whose values don't match those of actual, sorted by name.
+func diffFields(desired map[string]interface{}, actual map[string]interface{}) (diffs []FieldDiff) {
+	for field, value := range desired {
+		if !matches(value, actual[field]) {
+			diffs = append(diffs, FieldDiff{Field: field, Desired: value, Actual: actual[field]})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Field < diffs[j].Field
+	})
+	return
+}
+
+// matches reports whether an actual value matches a desired one, both normalized. The fields of objects
+// that the desired value doesn't set are ignored, since the services fill them with defaults, and the
+// elements of arrays are compared regardless of their order.
+func matches(desired interface{}, actual interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range d {
+			if !matches(value, a[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, _ := actual.([]interface{})
+		if len(a) != len(d) {
+			return false
+		}
+		used := make([]bool, len(a))
+	elements:
+		for _, value := range d {
+			for i := range a {
+				if !used[i] && matches(value, a[i]) {
+					used[i] = true
+					continue elements
+				}
+			}
+			return false
+		}
+		return true
+	}
+	return reflect.DeepEqual(desired, actual)
+}
+
+func jsonString(value interface{}) string {
+	if value == nil {
+		return "none"
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
@@ -0,0 +1,312 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallpolicy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/fakes"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/mocks"
+	"github.com/IBM/networking-go-sdk/networking"
+	"github.com/IBM/networking-go-sdk/useragentblockingrulesv1"
+	"github.com/IBM/networking-go-sdk/zonefirewallaccessrulesv1"
+	"github.com/IBM/networking-go-sdk/zonelockdownv1"
+	"github.com/IBM/networking-go-sdk/zoneratelimitsv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCrn = "crn:v1:bluemix:public:internet-svcs:global:a/fake-account:instance-1::"
+
+// newTestReconciler returns a reconciler of a zone created in a new fake of CIS.
+func newTestReconciler(t *testing.T) *Reconciler {
+	server := httptest.NewServer(fakes.NewCisServer())
+	t.Cleanup(server.Close)
+	client := networking.NewClient(&networking.Config{
+		Authenticator: &core.NoAuthAuthenticator{},
+		Crn:           testCrn,
+		Endpoints:     map[string]string{networking.CisEndpoint: server.URL},
+	})
+	zones, err := client.ZonesV1()
+	require.Nil(t, err)
+	zone, _, err := zones.CreateZone(zones.NewCreateZoneOptions().SetName("example.com"))
+	require.Nil(t, err)
+	reconciler, err := NewReconciler(client.WithZone(*zone.Result.ID), "token")
+	require.Nil(t, err)
+	return reconciler
+}
+
+func newTestPolicy() *Policy {
+	return &Policy{
+		AccessRules: []AccessRule{
+			{Target: "ip", Value: "198.51.100.7", Mode: "block", Notes: "scanner"},
+			{Target: "country", Value: "XX", Mode: "challenge"},
+		},
+		UserAgentRules: []UserAgentRule{
+			{UserAgent: "BadBot/1.0", Mode: "block"},
+		},
+		Lockdowns: []Lockdown{
+			{Description: "internal", URLs: []string{"example.com/internal/*"}, IPs: []string{"192.0.2.0/24", "203.0.113.9"}},
+			{Description: "admin", URLs: []string{"example.com/admin/*"}, IPs: []string{"192.0.2.1"}, Priority: core.Int64Ptr(1)},
+		},
+		RateLimits: []RateLimit{
+			{
+				Description: "login",
+				Threshold:   10,
+				Period:      60,
+				Match: &zoneratelimitsv1.RatelimitInputMatch{
+					Request: &zoneratelimitsv1.RatelimitInputMatchRequest{URL: core.StringPtr("example.com/login")},
+				},
+				Action: &zoneratelimitsv1.RatelimitInputAction{Mode: core.StringPtr("ban"), Timeout: core.Int64Ptr(600)},
+			},
+		},
+		FirewallRules: []FirewallRule{
+			{Description: "block admin", Expression: `(http.request.uri.path contains "/admin")`, Action: "block"},
+			{Description: "log bots", Expression: `cf.client.bot`, Action: "log", Paused: true},
+		},
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	assert.Nil(t, newTestPolicy().Validate())
+
+	policy := newTestPolicy()
+	policy.AccessRules = append(policy.AccessRules, AccessRule{Target: "ip", Value: "198.51.100.7", Mode: "challenge"})
+	policy.Lockdowns[0].IPs = []string{"192.0.2.0/33"}
+	policy.FirewallRules[0].Expression = `http.host eq`
+	err := policy.Validate()
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), `access_rule "ip:198.51.100.7" is declared more than once`)
+	assert.Contains(t, err.Error(), `lockdown "internal": "192.0.2.0/33" is not an IP address or a CIDR`)
+	assert.Contains(t, err.Error(), `firewall_rule "block admin": filter expression`)
+}
+
+func TestReconcilerApply(t *testing.T) {
+	ctx := context.Background()
+	reconciler := newTestReconciler(t)
+	policy := newTestPolicy()
+
+	plan, err := reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	assert.Equal(t, 8, plan.Count(common.SyncActionCreate))
+	lines := strings.Split(plan.String(), "\n")
+	assert.Equal(t, "Firewall policy: 8 to create, 0 to update, 0 to delete, 0 unchanged", lines[0])
+	// The lockdowns are created by priority.
+	assert.Equal(t, `+ lockdown "admin"`, lines[4])
+	require.Nil(t, reconciler.Apply(ctx, plan))
+
+	plan, err = reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	assert.True(t, plan.IsEmpty(), plan.String())
+	assert.Equal(t, 8, plan.Unchanged)
+
+	// A kind missing from the policy is left alone, and an empty list deletes every resource of the kind.
+	policy = &Policy{AccessRules: []AccessRule{}}
+	plan, err = reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	assert.Equal(t, "Firewall policy: 0 to create, 0 to update, 2 to delete, 0 unchanged\n"+
+		"- access_rule \"country:XX\"\n- access_rule \"ip:198.51.100.7\"\n", plan.String())
+
+	// A plan that deletes too many resources is refused.
+	reconciler.MaxDeletions = core.Int64Ptr(1)
+	plan, err = reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	assert.True(t, errors.Is(reconciler.Apply(ctx, plan), common.ErrSyncMaxDeletions))
+}
+
+func TestReconcilerDrift(t *testing.T) {
+	ctx := context.Background()
+	reconciler := newTestReconciler(t)
+	policy := newTestPolicy()
+	plan, err := reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	require.Nil(t, reconciler.Apply(ctx, plan))
+
+	// Changes made outside of the reconciler, as in the console.
+	lockdownService := reconciler.Lockdowns.(*zonelockdownv1.ZoneLockdownV1)
+	accessRuleService := reconciler.AccessRules.(*zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1)
+	userAgentRuleService := reconciler.UserAgentRules.(*useragentblockingrulesv1.UserAgentBlockingRulesV1)
+	firewallRuleService := reconciler.FirewallRules.(*firewallrulesv1.FirewallRulesV1)
+	lockdowns, _, err := lockdownService.ListAllZoneLockownRules(lockdownService.NewListAllZoneLockownRulesOptions())
+	require.Nil(t, err)
+	// The lockdowns were created by priority.
+	internal := lockdowns.Result[1]
+	require.Equal(t, "internal", *internal.Description)
+	_, _, err = lockdownService.UpdateLockdownRule(lockdownService.NewUpdateLockdownRuleOptions(*internal.ID).
+		SetDescription("internal").
+		SetUrls(internal.Urls).
+		SetConfigurations(lockdownConfigurations(policy.Lockdowns[0])).
+		SetPaused(true).
+		SetPriority(5))
+	require.Nil(t, err)
+	_, _, err = accessRuleService.CreateZoneAccessRule(accessRuleService.NewCreateZoneAccessRuleOptions().
+		SetMode("whitelist").
+		SetConfiguration(&zonefirewallaccessrulesv1.ZoneAccessRuleInputConfiguration{Target: core.StringPtr("ip"), Value: core.StringPtr("192.0.2.50")}))
+	require.Nil(t, err)
+	rules, _, err := userAgentRuleService.ListAllZoneUserAgentRules(userAgentRuleService.NewListAllZoneUserAgentRulesOptions())
+	require.Nil(t, err)
+	_, _, err = userAgentRuleService.DeleteZoneUserAgentRule(userAgentRuleService.NewDeleteZoneUserAgentRuleOptions(*rules.Result[0].ID))
+	require.Nil(t, err)
+	firewallRules, _, err := firewallRuleService.ListAllFirewallRules(
+		firewallRuleService.NewListAllFirewallRulesOptions("token", reconciler.Crn, reconciler.ZoneID))
+	require.Nil(t, err)
+	_, _, err = firewallRuleService.UpdateFirewallRuleWithFilter(reconciler.Filters,
+		firewallRuleService.NewUpdateFirewallRuleWithFilterOptions("token", reconciler.Crn, reconciler.ZoneID, *firewallRules.Result[0].ID).
+			SetExpression(`http.request.uri.path contains "/administrator"`))
+	require.Nil(t, err)
+
+	drifts, err := reconciler.DetectDrift(ctx, policy)
+	require.Nil(t, err)
+	var report []string
+	for _, drift := range drifts {
+		drift.ModifiedOn = ""
+		report = append(report, drift.String())
+	}
+	assert.Equal(t, []string{
+		`access_rule "ip:192.0.2.50" added`,
+		`user_agent_rule "BadBot/1.0" removed`,
+		`lockdown "internal" modified: paused: true -> false`,
+		`firewall_rule "block admin" modified: expression: "http.request.uri.path contains \"/administrator\"" -> "http.request.uri.path contains \"/admin\""`,
+	}, report)
+	assert.NotEmpty(t, drifts[0].ModifiedOn)
+
+	// Applying the policy again undoes the changes, and preserves the priority of the lockdown.
+	plan, err = reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	require.Nil(t, reconciler.Apply(ctx, plan))
+	lockdown, _, err := lockdownService.GetLockdown(lockdownService.NewGetLockdownOptions(*internal.ID))
+	require.Nil(t, err)
+	assert.False(t, *lockdown.Result.Paused)
+	assert.Equal(t, int64(5), *lockdown.Result.Priority)
+	drifts, err = reconciler.DetectDrift(ctx, policy)
+	require.Nil(t, err)
+	assert.Empty(t, drifts)
+}
+
+func TestReconcilerCreatePausedFirewallRule(t *testing.T) {
+	ctx := context.Background()
+	reconciler := newTestReconciler(t)
+	failPause := true
+	firewallRuleService := reconciler.FirewallRules.(*firewallrulesv1.FirewallRulesV1)
+	firewallRuleService.EnableMiddleware(common.StubMiddleware(func(req *http.Request) (*http.Response, error) {
+		if failPause && req.Method == http.MethodPut && strings.Contains(req.URL.Path, "/firewall/rules/") {
+			return common.NewResponse(req, 500, `{"success": false, "errors": [{"code": 10000, "message": "Internal error"}], "messages": [], "result": null}`), nil
+		}
+		return nil, nil
+	}))
+	policy := &Policy{FirewallRules: []FirewallRule{
+		{Description: "block admin", Expression: `(http.request.uri.path contains "/admin")`, Action: "block", Paused: true},
+	}}
+	listRules := func() []firewallrulesv1.FirewallRuleObject {
+		list, _, err := firewallRuleService.ListAllFirewallRules(firewallRuleService.NewListAllFirewallRulesOptions(
+			reconciler.XAuthUserToken, reconciler.Crn, reconciler.ZoneID))
+		require.Nil(t, err)
+		require.Len(t, list.Result, 1)
+		return list.Result
+	}
+
+	// The rule could not be paused, but its filter is, so that the rule never takes effect.
+	plan, err := reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	assert.NotNil(t, reconciler.Apply(ctx, plan))
+	rule := listRules()[0]
+	assert.False(t, *rule.Paused)
+	assert.True(t, *rule.Filter.Paused)
+
+	// Applying the policy again pauses the rule, then resumes its filter.
+	failPause = false
+	plan, err = reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	assert.Equal(t, 1, plan.Count(common.SyncActionUpdate))
+	require.Nil(t, reconciler.Apply(ctx, plan))
+	rule = listRules()[0]
+	assert.True(t, *rule.Paused)
+	assert.False(t, *rule.Filter.Paused)
+	plan, err = reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	assert.True(t, plan.IsEmpty(), plan.String())
+}
+
+func TestReconcilerWithMocks(t *testing.T) {
+	ctx := context.Background()
+	lockdowns := &mocks.ZoneLockdownV1{}
+	lockdowns.ListAllZoneLockownRulesFunc = func(options *zonelockdownv1.ListAllZoneLockownRulesOptions) (*zonelockdownv1.ListLockdownResp, *core.DetailedResponse, error) {
+		return &zonelockdownv1.ListLockdownResp{
+			Result: []zonelockdownv1.LockdownObject{
+				{ID: core.StringPtr("l-1"), Description: core.StringPtr("old"), Urls: []string{"example.com/old/*"}, Paused: core.BoolPtr(false)},
+			},
+			ResultInfo: &zonelockdownv1.ListLockdownRespResultInfo{
+				Page: core.Int64Ptr(1), PerPage: core.Int64Ptr(20), Count: core.Int64Ptr(1), TotalCount: core.Int64Ptr(1),
+			},
+		}, &core.DetailedResponse{StatusCode: 200}, nil
+	}
+	lockdowns.CreateZoneLockdownRuleFunc = func(options *zonelockdownv1.CreateZoneLockdownRuleOptions) (*zonelockdownv1.LockdownResp, *core.DetailedResponse, error) {
+		return &zonelockdownv1.LockdownResp{}, &core.DetailedResponse{StatusCode: 200}, nil
+	}
+	lockdowns.DeleteZoneLockdownRuleFunc = func(options *zonelockdownv1.DeleteZoneLockdownRuleOptions) (*zonelockdownv1.DeleteLockdownResp, *core.DetailedResponse, error) {
+		return &zonelockdownv1.DeleteLockdownResp{}, &core.DetailedResponse{StatusCode: 200}, nil
+	}
+	reconciler := &Reconciler{Lockdowns: lockdowns}
+	policy := &Policy{Lockdowns: []Lockdown{
+		{Description: "admin", URLs: []string{"example.com/admin/*"}, IPs: []string{"192.0.2.1"}},
+	}}
+
+	plan, err := reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	assert.Equal(t, 1, plan.Count(common.SyncActionCreate))
+	assert.Equal(t, 1, plan.Count(common.SyncActionDelete))
+	require.Nil(t, reconciler.Apply(ctx, plan))
+	assert.Equal(t, "admin", *lockdowns.Calls("CreateZoneLockdownRuleWithContext")[0].Args[1].(*zonelockdownv1.CreateZoneLockdownRuleOptions).Description)
+	assert.Equal(t, "l-1", *lockdowns.Calls("DeleteZoneLockdownRuleWithContext")[0].Args[1].(*zonelockdownv1.DeleteZoneLockdownRuleOptions).LockdownRuleIdentifier)
+}
+
+func TestReconcilerApplyErrors(t *testing.T) {
+	ctx := context.Background()
+	reconciler := newTestReconciler(t)
+	policy := &Policy{
+		AccessRules: []AccessRule{{Target: "ip", Value: "198.51.100.7", Mode: "block"}},
+		Lockdowns:   []Lockdown{{Description: "internal", URLs: []string{"example.com/internal/*"}, IPs: []string{"192.0.2.1"}}},
+	}
+	plan, err := reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	require.Nil(t, reconciler.Apply(ctx, plan))
+
+	// A failed create leaves the deletes out, so that the zone isn't left less protected.
+	policy.AccessRules = []AccessRule{{Target: "ip", Value: "198.51.100.8", Mode: "deny"}}
+	plan, err = reconciler.Plan(ctx, policy)
+	require.Nil(t, err)
+	err = reconciler.Apply(ctx, plan)
+	var syncErr *common.SyncError
+	require.True(t, errors.As(err, &syncErr))
+	assert.Equal(t, 1, len(syncErr.Errors))
+	assert.Equal(t, 1, syncErr.Skipped)
+	assert.Equal(t, 400, common.AsAPIError(plan.Changes[0].Err).StatusCode)
+	assert.False(t, plan.Changes[1].Applied)
+
+	// A kind without a client can't be managed.
+	reconciler.RateLimits = nil
+	_, err = reconciler.Plan(ctx, &Policy{RateLimits: []RateLimit{}})
+	assert.NotNil(t, err)
+}
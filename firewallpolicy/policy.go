@@ -0,0 +1,255 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package firewallpolicy reconciles the firewall posture of a CIS zone with a declarative policy: the
+// firewall rules, lockdowns, rate limits, IP access rules and user agent blocking rules that the zone
+// should have. A Reconciler computes the plan that brings the zone to the policy, which can be reviewed
+// as a diff, applied, or read as the drift of the zone from the policy.
+//
+// The policy is a JSON document:
+//
+//   {
+//     "firewall_rules": [
+//       {"description": "block admin", "expression": "http.request.uri.path contains \"/admin\"", "action": "block"}
+//     ],
+//     "lockdowns": [
+//       {"description": "office only", "urls": ["example.com/internal/*"], "ips": ["192.0.2.0/24"], "priority": 1}
+//     ],
+//     "access_rules": [
+//       {"target": "country", "value": "XX", "mode": "challenge"}
+//     ]
+//   }
+//
+// The resources of each kind are identified by a key: the description of firewall rules, lockdowns and
+// rate limits, the target and value of access rules, and the user agent of user agent rules. A kind whose
+// list is missing from the policy is left alone; an empty list deletes all the resources of the kind.
+package firewallpolicy
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/filterexpr"
+	"github.com/IBM/networking-go-sdk/zoneratelimitsv1"
+)
+
+// The kinds of resources of a policy.
+const (
+	KindFirewallRule  = "firewall_rule"
+	KindLockdown      = "lockdown"
+	KindRateLimit     = "rate_limit"
+	KindAccessRule    = "access_rule"
+	KindUserAgentRule = "user_agent_rule"
+)
+
+// Policy is the desired firewall posture of a zone.
+type Policy struct {
+	// The IP access rules of the zone; the rules inherited from the instance are left alone.
+	AccessRules []AccessRule `json:"access_rules,omitempty"`
+
+	// The user agent blocking rules of the zone.
+	UserAgentRules []UserAgentRule `json:"user_agent_rules,omitempty"`
+
+	// The lockdowns of the zone.
+	Lockdowns []Lockdown `json:"lockdowns,omitempty"`
+
+	// The rate limits of the zone.
+	RateLimits []RateLimit `json:"rate_limits,omitempty"`
+
+	// The firewall rules of the zone, each with its own filter.
+	FirewallRules []FirewallRule `json:"firewall_rules,omitempty"`
+}
+
+// AccessRule is an IP access rule, identified by its target and value.
+type AccessRule struct {
+	// The request property to target: "ip", "ip_range", "asn" or "country".
+	Target string `json:"target"`
+
+	// The value of the property, e.g. an IP address, a CIDR, an AS number or a country code.
+	Value string `json:"value"`
+
+	// The action to apply: "block", "challenge", "js_challenge" or "whitelist".
+	Mode string `json:"mode"`
+
+	// A note about the rule.
+	Notes string `json:"notes,omitempty"`
+}
+
+// UserAgentRule is a user agent blocking rule, identified by its user agent.
+type UserAgentRule struct {
+	// The exact user agent to match.
+	UserAgent string `json:"user_agent"`
+
+	// The action to apply: "block", "challenge" or "js_challenge".
+	Mode string `json:"mode"`
+
+	// A description of the rule.
+	Description string `json:"description,omitempty"`
+
+	// Whether the rule is disabled.
+	Paused bool `json:"paused,omitempty"`
+}
+
+// Lockdown is a lockdown rule, identified by its description.
+type Lockdown struct {
+	// The description of the lockdown.
+	Description string `json:"description"`
+
+	// The URL patterns that only the IP addresses may access.
+	URLs []string `json:"urls"`
+
+	// The IP addresses and CIDRs that may access the URLs.
+	IPs []string `json:"ips"`
+
+	// The priority of the lockdown. If not set, the priority of an existing lockdown is preserved.
+	Priority *int64 `json:"priority,omitempty"`
+
+	// Whether the lockdown is paused.
+	Paused bool `json:"paused,omitempty"`
+}
+
+// RateLimit is a rate limit, identified by its description.
+type RateLimit struct {
+	// The description of the rate limit.
+	Description string `json:"description"`
+
+	// The number of requests per period above which the action is performed.
+	Threshold int64 `json:"threshold"`
+
+	// The period in seconds over which the requests are counted.
+	Period int64 `json:"period"`
+
+	// The traffic that is counted.
+	Match *zoneratelimitsv1.RatelimitInputMatch `json:"match"`
+
+	// The action performed above the threshold.
+	Action *zoneratelimitsv1.RatelimitInputAction `json:"action"`
+
+	// The criteria under which the rate limit is bypassed. If not set, those of an existing rate limit are
+	// preserved.
+	Bypass []zoneratelimitsv1.RatelimitInputBypassItem `json:"bypass,omitempty"`
+
+	// Whether the rate limit is based on NAT. If not set, that of an existing rate limit is preserved.
+	Correlate *zoneratelimitsv1.RatelimitInputCorrelate `json:"correlate,omitempty"`
+
+	// Whether the rate limit is disabled.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// FirewallRule is a firewall rule along with its filter, identified by its description.
+type FirewallRule struct {
+	// The description of the firewall rule.
+	Description string `json:"description"`
+
+	// The expression of the filter, which is compared with the one of the zone in its canonical form.
+	Expression string `json:"expression"`
+
+	// The action of the rule: "allow", "block", "challenge", "js_challenge" or "log".
+	Action string `json:"action"`
+
+	// Whether the rule is paused.
+	Paused bool `json:"paused,omitempty"`
+}
+
+// Validate checks that the resources of the policy are complete, and that their keys are unique.
+func (policy *Policy) Validate() error {
+	var errs []string
+	keys := map[string]bool{}
+	check := func(kind string, key string, problems ...string) {
+		for _, problem := range problems {
+			if problem != "" {
+				errs = append(errs, fmt.Sprintf("%s %q: %s", kind, key, problem))
+			}
+		}
+		if keys[kind+" "+key] {
+			errs = append(errs, fmt.Sprintf("%s %q is declared more than once", kind, key))
+		}
+		keys[kind+" "+key] = true
+	}
+
+	for _, rule := range policy.AccessRules {
+		check(KindAccessRule, accessRuleKey(rule.Target, rule.Value), required("target", rule.Target),
+			required("value", rule.Value), required("mode", rule.Mode))
+	}
+	for _, rule := range policy.UserAgentRules {
+		check(KindUserAgentRule, rule.UserAgent, required("user_agent", rule.UserAgent), required("mode", rule.Mode))
+	}
+	for _, lockdown := range policy.Lockdowns {
+		problems := []string{required("description", lockdown.Description)}
+		if len(lockdown.URLs) == 0 {
+			problems = append(problems, "urls is required")
+		}
+		if len(lockdown.IPs) == 0 {
+			problems = append(problems, "ips is required")
+		}
+		for _, ip := range lockdown.IPs {
+			if lockdownTarget(ip) == "" {
+				problems = append(problems, fmt.Sprintf("%q is not an IP address or a CIDR", ip))
+			}
+		}
+		check(KindLockdown, lockdown.Description, problems...)
+	}
+	for _, rateLimit := range policy.RateLimits {
+		problems := []string{required("description", rateLimit.Description)}
+		if rateLimit.Threshold <= 0 || rateLimit.Period <= 0 {
+			problems = append(problems, "threshold and period must be positive")
+		}
+		if rateLimit.Match == nil {
+			problems = append(problems, "match is required")
+		}
+		if rateLimit.Action == nil || rateLimit.Action.Mode == nil {
+			problems = append(problems, "action.mode is required")
+		}
+		check(KindRateLimit, rateLimit.Description, problems...)
+	}
+	for _, rule := range policy.FirewallRules {
+		problems := []string{required("description", rule.Description), required("action", rule.Action)}
+		if _, err := filterexpr.Check(rule.Expression); err != nil {
+			problems = append(problems, err.Error())
+		}
+		check(KindFirewallRule, rule.Description, problems...)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid policy: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func required(field string, value string) string {
+	if value == "" {
+		return field + " is required"
+	}
+	return ""
+}
+
+// accessRuleKey returns the key of an access rule.
+func accessRuleKey(target string, value string) string {
+	return target + ":" + value
+}
+
+// lockdownTarget returns the target of the configuration of a lockdown for an IP address ("ip") or a
+// CIDR ("ip_range"), or an empty string if it is neither.
+func lockdownTarget(ip string) string {
+	if net.ParseIP(ip) != nil {
+		return "ip"
+	}
+	if _, _, err := net.ParseCIDR(ip); err == nil {
+		return "ip_range"
+	}
+	return ""
+}
@@ -0,0 +1,420 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallpolicy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/networking"
+	"github.com/IBM/networking-go-sdk/useragentblockingrulesv1"
+	"github.com/IBM/networking-go-sdk/zonefirewallaccessrulesv1"
+	"github.com/IBM/networking-go-sdk/zonelockdownv1"
+	"github.com/IBM/networking-go-sdk/zoneratelimitsv1"
+)
+
+// The types of drift of a zone from its policy.
+const (
+	// A resource of the policy was changed in the zone.
+	DriftModified = "modified"
+
+	// A resource that isn't in the policy was added to the zone.
+	DriftAdded = "added"
+
+	// A resource of the policy was removed from the zone.
+	DriftRemoved = "removed"
+)
+
+// Reconciler brings the firewall posture of a zone to a policy. The clients of the zone-scoped services
+// must be scoped to the zone; those of the kinds of resources that policies don't manage may be nil.
+type Reconciler struct {
+	AccessRules    zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1API
+	UserAgentRules useragentblockingrulesv1.UserAgentBlockingRulesV1API
+	Lockdowns      zonelockdownv1.ZoneLockdownV1API
+	RateLimits     zoneratelimitsv1.ZoneRateLimitsV1API
+	FirewallRules  firewallrulesv1.FirewallRulesV1API
+	Filters        filtersv1.FiltersV1API
+
+	// The IAM token, instance CRN and zone of the firewall rules and filters, which take them with every
+	// request.
+	XAuthUserToken string
+	Crn            string
+	ZoneID         string
+
+	// The maximum number of resources that applying a plan may delete (common.DefaultSyncMaxDeletions if nil).
+	MaxDeletions *int64
+}
+
+// NewReconciler returns a Reconciler of the zone of a networking client, with its service clients. The
+// token is sent with the requests of the firewall rules and filters.
+func NewReconciler(client *networking.Client, xAuthUserToken string) (reconciler *Reconciler, err error) {
+	config := client.Config()
+	reconciler = &Reconciler{
+		XAuthUserToken: xAuthUserToken,
+		Crn:            config.Crn,
+		ZoneID:         config.ZoneID,
+	}
+	if reconciler.AccessRules, err = client.ZoneFirewallAccessRulesV1(); err != nil {
+		return nil, err
+	}
+	if reconciler.UserAgentRules, err = client.UserAgentBlockingRulesV1(); err != nil {
+		return nil, err
+	}
+	if reconciler.Lockdowns, err = client.ZoneLockdownV1(); err != nil {
+		return nil, err
+	}
+	if reconciler.RateLimits, err = client.ZoneRateLimitsV1(); err != nil {
+		return nil, err
+	}
+	if reconciler.FirewallRules, err = client.FirewallRulesV1(); err != nil {
+		return nil, err
+	}
+	if reconciler.Filters, err = client.FiltersV1(); err != nil {
+		return nil, err
+	}
+	return reconciler, nil
+}
+
+// kinds returns the kinds of resources, in the order in which their changes are applied.
+func (reconciler *Reconciler) kinds() []resourceKind {
+	return []resourceKind{
+		accessRuleKind{reconciler.AccessRules},
+		userAgentRuleKind{reconciler.UserAgentRules},
+		lockdownKind{reconciler.Lockdowns},
+		rateLimitKind{reconciler.RateLimits},
+		firewallRuleKind{reconciler},
+	}
+}
+
+// hasClient reports whether the reconciler has the clients of a kind of resources.
+func (reconciler *Reconciler) hasClient(kind string) bool {
+	switch kind {
+	case KindAccessRule:
+		return reconciler.AccessRules != nil
+	case KindUserAgentRule:
+		return reconciler.UserAgentRules != nil
+	case KindLockdown:
+		return reconciler.Lockdowns != nil
+	case KindRateLimit:
+		return reconciler.RateLimits != nil
+	case KindFirewallRule:
+		return reconciler.FirewallRules != nil && reconciler.Filters != nil
+	}
+	return false
+}
+
+// Change is a change of a Plan.
+type Change struct {
+	// The kind of the resource (one of the Kind* constants), and its key.
+	Kind string
+	Key  string
+
+	// The action of the change (one of the common.SyncAction* constants).
+	Action string
+
+	// The fields that an update changes.
+	Diffs []FieldDiff
+
+	// The resource of the policy, for a create or an update: an AccessRule, UserAgentRule, Lockdown,
+	// RateLimit or FirewallRule.
+	Desired interface{}
+
+	// The resource of the zone, for an update or a delete: a *zonefirewallaccessrulesv1.ZoneAccessRuleObject,
+	// *useragentblockingrulesv1.UseragentRuleObject, *zonelockdownv1.LockdownObject,
+	// *zoneratelimitsv1.RatelimitObject or *firewallrulesv1.FirewallRuleObject.
+	Current interface{}
+
+	// Whether the change was applied, and the error if it failed.
+	Applied bool
+	Err     error
+
+	kind    resourceKind
+	desired *resource
+	current *resource
+}
+
+// String returns the change as a line of a diff: the resource to create prefixed with "+", the resource
+// to delete prefixed with "-", or the resource to update prefixed with "~" followed by the changed fields.
+func (change *Change) String() string {
+	switch change.Action {
+	case common.SyncActionCreate:
+		return fmt.Sprintf("+ %s %q", change.Kind, change.Key)
+	case common.SyncActionUpdate:
+		diffs := make([]string, len(change.Diffs))
+		for i, diff := range change.Diffs {
+			diffs[i] = diff.String()
+		}
+		return fmt.Sprintf("~ %s %q (%s)", change.Kind, change.Key, strings.Join(diffs, ", "))
+	default:
+		return fmt.Sprintf("- %s %q", change.Kind, change.Key)
+	}
+}
+
+// Plan is the set of changes that bring the firewall posture of a zone to a policy.
+type Plan struct {
+	// The changes, by kind in the order in which they are applied; the creates and updates of lockdowns are
+	// sorted by priority.
+	Changes []*Change
+
+	// The number of resources of the policy that are already present.
+	Unchanged int
+
+	// The maximum number of resources that applying the plan may delete.
+	MaxDeletions int64
+}
+
+// IsEmpty returns true if the zone is already in the state of the policy.
+func (plan *Plan) IsEmpty() bool {
+	return len(plan.Changes) == 0
+}
+
+// Count returns the number of changes with the given action.
+func (plan *Plan) Count(action string) (count int) {
+	for _, change := range plan.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return
+}
+
+// String returns the plan as a human-readable diff: a summary line followed by one line per change.
+func (plan *Plan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Firewall policy: %d to create, %d to update, %d to delete, %d unchanged\n",
+		plan.Count(common.SyncActionCreate), plan.Count(common.SyncActionUpdate),
+		plan.Count(common.SyncActionDelete), plan.Unchanged)
+	for _, change := range plan.Changes {
+		b.WriteString(change.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Plan computes the changes that bring the zone to a policy. A resource of the policy whose key matches a
+// resource of the zone is left alone, or updated if the fields managed by the policy differ; the others
+// are created. The resources of the zone that the policy doesn't have are deleted, for the kinds that the
+// policy manages. The plan can be printed as a diff, and applied with Apply.
+func (reconciler *Reconciler) Plan(ctx context.Context, policy *Policy) (plan *Plan, err error) {
+	err = core.ValidateNotNil(policy, "policy cannot be nil")
+	if err != nil {
+		return
+	}
+	if err = policy.Validate(); err != nil {
+		return
+	}
+
+	plan = &Plan{MaxDeletions: common.DefaultSyncMaxDeletions}
+	if reconciler.MaxDeletions != nil {
+		plan.MaxDeletions = *reconciler.MaxDeletions
+	}
+	for _, kind := range reconciler.kinds() {
+		desired, managed := kind.desired(policy)
+		if !managed {
+			continue
+		}
+		if !reconciler.hasClient(kind.name()) {
+			return nil, fmt.Errorf("the policy has %s resources, but the reconciler has no client for them", kind.name())
+		}
+		current, err := kind.list(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing the %s resources: %w", kind.name(), err)
+		}
+		plan.Unchanged += plan.addChanges(kind, desired, current)
+	}
+	return
+}
+
+// addChanges adds the changes of a kind of resources to the plan, and returns the number of resources
+// that are unchanged.
+func (plan *Plan) addChanges(kind resourceKind, desired []*resource, current []*resource) (unchanged int) {
+	byKey := map[string][]*resource{}
+	for _, r := range current {
+		byKey[r.key] = append(byKey[r.key], r)
+	}
+
+	var changes []*Change
+	for _, d := range desired {
+		change := &Change{Kind: kind.name(), Key: d.key, Desired: d.object, kind: kind, desired: d}
+		if matching := byKey[d.key]; len(matching) > 0 {
+			c := matching[0]
+			byKey[d.key] = matching[1:]
+			change.Diffs = diffFields(d.fields, c.fields)
+			if len(change.Diffs) == 0 {
+				unchanged++
+				continue
+			}
+			change.Action = common.SyncActionUpdate
+			change.Current, change.current = c.object, c
+		} else {
+			change.Action = common.SyncActionCreate
+		}
+		changes = append(changes, change)
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		pi, pj := changes[i].desired.priority, changes[j].desired.priority
+		return pi != nil && (pj == nil || *pi < *pj)
+	})
+
+	var deletes []*Change
+	for _, r := range current {
+		if remaining := byKey[r.key]; len(remaining) > 0 && remaining[0] == r {
+			byKey[r.key] = remaining[1:]
+			deletes = append(deletes, &Change{
+				Kind: kind.name(), Key: r.key, Action: common.SyncActionDelete,
+				Current: r.object, kind: kind, current: r,
+			})
+		}
+	}
+	sort.SliceStable(deletes, func(i, j int) bool {
+		return deletes[i].Key < deletes[j].Key
+	})
+	plan.Changes = append(plan.Changes, append(changes, deletes...)...)
+	return
+}
+
+// Apply applies the changes of a plan that are not applied yet, one at a time and in order. The creates
+// and updates are applied first, so that the zone is never less protected than before nor than the
+// policy; the deletes are only applied if all of them succeed. A plan is refused if it deletes more
+// resources than its MaxDeletions, with an error that wraps common.ErrSyncMaxDeletions. If some changes
+// fail, the error is a *common.SyncError, and the error of each change is set in its Err field.
+func (reconciler *Reconciler) Apply(ctx context.Context, plan *Plan) error {
+	err := core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		return err
+	}
+	if deletions := plan.Count(common.SyncActionDelete); int64(deletions) > plan.MaxDeletions {
+		return fmt.Errorf("%w: %d resources would be deleted, the maximum is %d", common.ErrSyncMaxDeletions, deletions, plan.MaxDeletions)
+	}
+
+	syncErr := &common.SyncError{}
+	for _, phase := range [][]string{{common.SyncActionCreate, common.SyncActionUpdate}, {common.SyncActionDelete}} {
+		var changes []*Change
+		for _, change := range plan.Changes {
+			if !change.Applied && core.SliceContains(phase, change.Action) {
+				changes = append(changes, change)
+			}
+		}
+		if len(syncErr.Errors) > 0 {
+			syncErr.Skipped += len(changes)
+			continue
+		}
+		for _, change := range changes {
+			if change.Err = ctx.Err(); change.Err == nil {
+				change.Err = applyChange(ctx, change)
+			}
+			if change.Err != nil {
+				syncErr.Errors = append(syncErr.Errors, fmt.Errorf("%s: %w", change, change.Err))
+			} else {
+				change.Applied = true
+				syncErr.Applied++
+			}
+		}
+	}
+	if len(syncErr.Errors) > 0 {
+		return syncErr
+	}
+	return nil
+}
+
+// applyChange applies one change of a plan.
+func applyChange(ctx context.Context, change *Change) (err error) {
+	switch change.Action {
+	case common.SyncActionCreate:
+		err = change.kind.create(ctx, change.desired)
+	case common.SyncActionUpdate:
+		err = change.kind.update(ctx, change.desired, change.current)
+	case common.SyncActionDelete:
+		err = change.kind.delete(ctx, change.current)
+		if common.IsNotFound(err) {
+			err = nil
+		}
+	default:
+		err = fmt.Errorf("unknown sync action %q", change.Action)
+	}
+	return
+}
+
+// Drift is a difference between a zone and its policy, such as a change made in the console.
+type Drift struct {
+	// The kind of the resource (one of the Kind* constants), its key, and its ID in the zone.
+	Kind string
+	Key  string
+	ID   string
+
+	// The type of the drift (one of the Drift* constants).
+	Type string
+
+	// The fields of a modified resource whose values differ from the policy.
+	Diffs []FieldDiff
+
+	// When the resource was last modified, for the kinds whose resources have a modification time.
+	ModifiedOn string
+}
+
+// String returns the drift as a line of a report, e.g. `lockdown "office" modified: paused: false -> true`.
+func (drift Drift) String() string {
+	s := fmt.Sprintf("%s %q %s", drift.Kind, drift.Key, drift.Type)
+	if drift.ModifiedOn != "" {
+		s += " on " + drift.ModifiedOn
+	}
+	if len(drift.Diffs) > 0 {
+		diffs := make([]string, len(drift.Diffs))
+		for i, diff := range drift.Diffs {
+			diffs[i] = diff.String()
+		}
+		s += ": " + strings.Join(diffs, ", ")
+	}
+	return s
+}
+
+// Drift returns the drift of the zone from the policy of the plan: the resources to update were
+// modified, those to delete were added, and those to create were removed.
+func (plan *Plan) Drift() (drifts []Drift) {
+	for _, change := range plan.Changes {
+		drift := Drift{Kind: change.Kind, Key: change.Key, Diffs: change.Diffs}
+		switch change.Action {
+		case common.SyncActionCreate:
+			drift.Type = DriftRemoved
+		case common.SyncActionUpdate:
+			drift.Type = DriftModified
+		default:
+			drift.Type = DriftAdded
+		}
+		if change.current != nil {
+			drift.ID = change.current.id
+			drift.ModifiedOn = change.current.modifiedOn
+		}
+		drifts = append(drifts, drift)
+	}
+	return
+}
+
+// DetectDrift returns the drift of the zone from a policy that was applied to it, without changing it.
+func (reconciler *Reconciler) DetectDrift(ctx context.Context, policy *Policy) ([]Drift, error) {
+	plan, err := reconciler.Plan(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	return plan.Drift(), nil
+}
@@ -0,0 +1,622 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/filterexpr"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/useragentblockingrulesv1"
+	"github.com/IBM/networking-go-sdk/zonefirewallaccessrulesv1"
+	"github.com/IBM/networking-go-sdk/zonelockdownv1"
+	"github.com/IBM/networking-go-sdk/zoneratelimitsv1"
+)
+
+// resource is a resource of the policy or of the zone, along with the normalized values of the fields that
+// the policy manages.
+type resource struct {
+	key        string
+	id         string
+	fields     map[string]interface{}
+	priority   *int64
+	modifiedOn string
+
+	// The item of the policy (e.g. a Lockdown), or the object of the zone (e.g. a *zonelockdownv1.LockdownObject).
+	object interface{}
+}
+
+// resourceKind lists, creates, updates and deletes the resources of a kind.
+type resourceKind interface {
+	name() string
+
+	// desired returns the resources of the policy, and whether the policy manages the kind.
+	desired(policy *Policy) (resources []*resource, managed bool)
+
+	list(ctx context.Context) ([]*resource, error)
+	create(ctx context.Context, desired *resource) error
+	update(ctx context.Context, desired *resource, current *resource) error
+	delete(ctx context.Context, current *resource) error
+}
+
+func newResource(key string, id string, object interface{}, fields map[string]interface{}) *resource {
+	return &resource{key: key, id: id, object: object, fields: normalize(fields).(map[string]interface{})}
+}
+
+// convert converts a model of a service to another one with the same JSON encoding, e.g. from the type
+// returned for a resource to the type with which it is updated.
+func convert(from interface{}, to interface{}) error {
+	b, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, to)
+}
+
+// resultInfo is the "result_info" of a page of a list operation.
+type resultInfo struct {
+	Page       *int64 `json:"page"`
+	PerPage    *int64 `json:"per_page"`
+	Count      *int64 `json:"count"`
+	TotalCount *int64 `json:"total_count"`
+}
+
+// listPages lists the resources of a kind page by page. listPage requests a page, and returns its resources,
+// its number of items and its "result_info".
+func listPages(ctx context.Context, listPage func(ctx context.Context, page *int64) ([]*resource, int, interface{}, error)) (resources []*resource, err error) {
+	pager, err := common.NewResultInfoPager(nil)
+	if err != nil {
+		return
+	}
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var page []*resource
+		var itemCount int
+		var info interface{}
+		page, itemCount, info, err = listPage(ctx, pager.Page())
+		if err != nil {
+			return
+		}
+		var pageInfo resultInfo
+		if err = convert(info, &pageInfo); err != nil {
+			return
+		}
+		if pager.Update(pageInfo.Page, pageInfo.PerPage, pageInfo.Count, pageInfo.TotalCount, itemCount) {
+			resources = append(resources, page...)
+		}
+	}
+	return
+}
+
+// accessRuleKind is the kind of the IP access rules of the zone.
+type accessRuleKind struct {
+	service zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1API
+}
+
+func (accessRuleKind) name() string {
+	return KindAccessRule
+}
+
+func (accessRuleKind) desired(policy *Policy) (resources []*resource, managed bool) {
+	for _, rule := range policy.AccessRules {
+		resources = append(resources, newResource(accessRuleKey(rule.Target, rule.Value), "", rule, map[string]interface{}{
+			"mode":  rule.Mode,
+			"notes": rule.Notes,
+		}))
+	}
+	return resources, policy.AccessRules != nil
+}
+
+func (kind accessRuleKind) list(ctx context.Context) ([]*resource, error) {
+	return listPages(ctx, kind.listPage)
+}
+
+func (kind accessRuleKind) listPage(ctx context.Context, page *int64) (resources []*resource, itemCount int, info interface{}, err error) {
+	result, _, err := kind.service.ListAllZoneAccessRulesWithContext(ctx, &zonefirewallaccessrulesv1.ListAllZoneAccessRulesOptions{Page: page})
+	if err != nil || result == nil {
+		return
+	}
+	for i := range result.Result {
+		rule := &result.Result[i]
+		if rule.Scope != nil && core.StringNilMapper(rule.Scope.Type) == zonefirewallaccessrulesv1.ZoneAccessRuleObjectScope_Type_Account {
+			continue
+		}
+		var target, value string
+		if rule.Configuration != nil {
+			target, value = core.StringNilMapper(rule.Configuration.Target), core.StringNilMapper(rule.Configuration.Value)
+		}
+		r := newResource(accessRuleKey(target, value), core.StringNilMapper(rule.ID), rule, map[string]interface{}{
+			"mode":  rule.Mode,
+			"notes": rule.Notes,
+		})
+		r.modifiedOn = core.StringNilMapper(rule.ModifiedOn)
+		resources = append(resources, r)
+	}
+	return resources, len(result.Result), result.ResultInfo, nil
+}
+
+func (kind accessRuleKind) create(ctx context.Context, desired *resource) error {
+	rule := desired.object.(AccessRule)
+	options := (&zonefirewallaccessrulesv1.CreateZoneAccessRuleOptions{}).
+		SetMode(rule.Mode).
+		SetConfiguration(&zonefirewallaccessrulesv1.ZoneAccessRuleInputConfiguration{
+			Target: core.StringPtr(rule.Target),
+			Value:  core.StringPtr(rule.Value),
+		})
+	if rule.Notes != "" {
+		options.SetNotes(rule.Notes)
+	}
+	_, _, err := kind.service.CreateZoneAccessRuleWithContext(ctx, options)
+	return err
+}
+
+func (kind accessRuleKind) update(ctx context.Context, desired *resource, current *resource) error {
+	rule := desired.object.(AccessRule)
+	_, _, err := kind.service.UpdateZoneAccessRuleWithContext(ctx, (&zonefirewallaccessrulesv1.UpdateZoneAccessRuleOptions{AccessruleIdentifier: core.StringPtr(current.id)}).
+		SetMode(rule.Mode).
+		SetNotes(rule.Notes))
+	return err
+}
+
+func (kind accessRuleKind) delete(ctx context.Context, current *resource) error {
+	_, _, err := kind.service.DeleteZoneAccessRuleWithContext(ctx, &zonefirewallaccessrulesv1.DeleteZoneAccessRuleOptions{
+		AccessruleIdentifier: core.StringPtr(current.id),
+	})
+	return err
+}
+
+// userAgentRuleKind is the kind of the user agent blocking rules of the zone.
+type userAgentRuleKind struct {
+	service useragentblockingrulesv1.UserAgentBlockingRulesV1API
+}
+
+func (userAgentRuleKind) name() string {
+	return KindUserAgentRule
+}
+
+func (userAgentRuleKind) desired(policy *Policy) (resources []*resource, managed bool) {
+	for _, rule := range policy.UserAgentRules {
+		resources = append(resources, newResource(rule.UserAgent, "", rule, map[string]interface{}{
+			"mode":        rule.Mode,
+			"description": rule.Description,
+			"paused":      rule.Paused,
+		}))
+	}
+	return resources, policy.UserAgentRules != nil
+}
+
+func (kind userAgentRuleKind) list(ctx context.Context) ([]*resource, error) {
+	return listPages(ctx, kind.listPage)
+}
+
+func (kind userAgentRuleKind) listPage(ctx context.Context, page *int64) (resources []*resource, itemCount int, info interface{}, err error) {
+	result, _, err := kind.service.ListAllZoneUserAgentRulesWithContext(ctx, &useragentblockingrulesv1.ListAllZoneUserAgentRulesOptions{Page: page})
+	if err != nil || result == nil {
+		return
+	}
+	for i := range result.Result {
+		rule := &result.Result[i]
+		var userAgent string
+		if rule.Configuration != nil {
+			userAgent = core.StringNilMapper(rule.Configuration.Value)
+		}
+		resources = append(resources, newResource(userAgent, core.StringNilMapper(rule.ID), rule, map[string]interface{}{
+			"mode":        rule.Mode,
+			"description": rule.Description,
+			"paused":      rule.Paused,
+		}))
+	}
+	return resources, len(result.Result), result.ResultInfo, nil
+}
+
+func (kind userAgentRuleKind) configuration(rule UserAgentRule) *useragentblockingrulesv1.UseragentRuleInputConfiguration {
+	return &useragentblockingrulesv1.UseragentRuleInputConfiguration{
+		Target: core.StringPtr(useragentblockingrulesv1.UseragentRuleInputConfiguration_Target_Ua),
+		Value:  core.StringPtr(rule.UserAgent),
+	}
+}
+
+func (kind userAgentRuleKind) create(ctx context.Context, desired *resource) error {
+	rule := desired.object.(UserAgentRule)
+	_, _, err := kind.service.CreateZoneUserAgentRuleWithContext(ctx, (&useragentblockingrulesv1.CreateZoneUserAgentRuleOptions{}).
+		SetMode(rule.Mode).
+		SetDescription(rule.Description).
+		SetPaused(rule.Paused).
+		SetConfiguration(kind.configuration(rule)))
+	return err
+}
+
+func (kind userAgentRuleKind) update(ctx context.Context, desired *resource, current *resource) error {
+	rule := desired.object.(UserAgentRule)
+	_, _, err := kind.service.UpdateUserAgentRuleWithContext(ctx, (&useragentblockingrulesv1.UpdateUserAgentRuleOptions{UseragentRuleIdentifier: core.StringPtr(current.id)}).
+		SetMode(rule.Mode).
+		SetDescription(rule.Description).
+		SetPaused(rule.Paused).
+		SetConfiguration(kind.configuration(rule)))
+	return err
+}
+
+func (kind userAgentRuleKind) delete(ctx context.Context, current *resource) error {
+	_, _, err := kind.service.DeleteZoneUserAgentRuleWithContext(ctx, &useragentblockingrulesv1.DeleteZoneUserAgentRuleOptions{
+		UseragentRuleIdentifier: core.StringPtr(current.id),
+	})
+	return err
+}
+
+// lockdownKind is the kind of the lockdowns of the zone.
+type lockdownKind struct {
+	service zonelockdownv1.ZoneLockdownV1API
+}
+
+func (lockdownKind) name() string {
+	return KindLockdown
+}
+
+func (lockdownKind) desired(policy *Policy) (resources []*resource, managed bool) {
+	for _, lockdown := range policy.Lockdowns {
+		fields := map[string]interface{}{
+			"urls":           lockdown.URLs,
+			"configurations": lockdownConfigurations(lockdown),
+			"paused":         lockdown.Paused,
+		}
+		if lockdown.Priority != nil {
+			fields["priority"] = *lockdown.Priority
+		}
+		r := newResource(lockdown.Description, "", lockdown, fields)
+		r.priority = lockdown.Priority
+		resources = append(resources, r)
+	}
+	return resources, policy.Lockdowns != nil
+}
+
+func lockdownConfigurations(lockdown Lockdown) (configurations []zonelockdownv1.LockdownInputConfigurationsItem) {
+	for _, ip := range lockdown.IPs {
+		configurations = append(configurations, zonelockdownv1.LockdownInputConfigurationsItem{
+			Target: core.StringPtr(lockdownTarget(ip)),
+			Value:  core.StringPtr(ip),
+		})
+	}
+	return
+}
+
+func (kind lockdownKind) list(ctx context.Context) ([]*resource, error) {
+	return listPages(ctx, kind.listPage)
+}
+
+func (kind lockdownKind) listPage(ctx context.Context, page *int64) (resources []*resource, itemCount int, info interface{}, err error) {
+	result, _, err := kind.service.ListAllZoneLockownRulesWithContext(ctx, &zonelockdownv1.ListAllZoneLockownRulesOptions{Page: page})
+	if err != nil || result == nil {
+		return
+	}
+	for i := range result.Result {
+		lockdown := &result.Result[i]
+		r := newResource(core.StringNilMapper(lockdown.Description), core.StringNilMapper(lockdown.ID), lockdown, map[string]interface{}{
+			"urls":           lockdown.Urls,
+			"configurations": lockdown.Configurations,
+			"paused":         lockdown.Paused,
+			"priority":       lockdown.Priority,
+		})
+		r.priority = lockdown.Priority
+		resources = append(resources, r)
+	}
+	return resources, len(result.Result), result.ResultInfo, nil
+}
+
+func (kind lockdownKind) create(ctx context.Context, desired *resource) error {
+	lockdown := desired.object.(Lockdown)
+	options := (&zonelockdownv1.CreateZoneLockdownRuleOptions{}).
+		SetDescription(lockdown.Description).
+		SetUrls(lockdown.URLs).
+		SetConfigurations(lockdownConfigurations(lockdown)).
+		SetPaused(lockdown.Paused)
+	if lockdown.Priority != nil {
+		options.SetPriority(*lockdown.Priority)
+	}
+	_, _, err := kind.service.CreateZoneLockdownRuleWithContext(ctx, options)
+	return err
+}
+
+// update replaces a lockdown, with its current priority unless the policy sets one.
+func (kind lockdownKind) update(ctx context.Context, desired *resource, current *resource) error {
+	lockdown := desired.object.(Lockdown)
+	options := (&zonelockdownv1.UpdateLockdownRuleOptions{LockdownRuleIdentifier: core.StringPtr(current.id)}).
+		SetDescription(lockdown.Description).
+		SetUrls(lockdown.URLs).
+		SetConfigurations(lockdownConfigurations(lockdown)).
+		SetPaused(lockdown.Paused)
+	if lockdown.Priority != nil {
+		options.SetPriority(*lockdown.Priority)
+	} else if current.priority != nil {
+		options.SetPriority(*current.priority)
+	}
+	_, _, err := kind.service.UpdateLockdownRuleWithContext(ctx, options)
+	return err
+}
+
+func (kind lockdownKind) delete(ctx context.Context, current *resource) error {
+	_, _, err := kind.service.DeleteZoneLockdownRuleWithContext(ctx, &zonelockdownv1.DeleteZoneLockdownRuleOptions{
+		LockdownRuleIdentifier: core.StringPtr(current.id),
+	})
+	return err
+}
+
+// rateLimitKind is the kind of the rate limits of the zone.
+type rateLimitKind struct {
+	service zoneratelimitsv1.ZoneRateLimitsV1API
+}
+
+func (rateLimitKind) name() string {
+	return KindRateLimit
+}
+
+func (rateLimitKind) desired(policy *Policy) (resources []*resource, managed bool) {
+	for _, rateLimit := range policy.RateLimits {
+		fields := map[string]interface{}{
+			"threshold": rateLimit.Threshold,
+			"period":    rateLimit.Period,
+			"match":     rateLimit.Match,
+			"action":    rateLimit.Action,
+			"disabled":  rateLimit.Disabled,
+		}
+		if rateLimit.Bypass != nil {
+			fields["bypass"] = rateLimit.Bypass
+		}
+		if rateLimit.Correlate != nil {
+			fields["correlate"] = rateLimit.Correlate
+		}
+		resources = append(resources, newResource(rateLimit.Description, "", rateLimit, fields))
+	}
+	return resources, policy.RateLimits != nil
+}
+
+func (kind rateLimitKind) list(ctx context.Context) ([]*resource, error) {
+	return listPages(ctx, kind.listPage)
+}
+
+func (kind rateLimitKind) listPage(ctx context.Context, page *int64) (resources []*resource, itemCount int, info interface{}, err error) {
+	result, _, err := kind.service.ListAllZoneRateLimitsWithContext(ctx, &zoneratelimitsv1.ListAllZoneRateLimitsOptions{Page: page})
+	if err != nil || result == nil {
+		return
+	}
+	for i := range result.Result {
+		rateLimit := &result.Result[i]
+		resources = append(resources, newResource(core.StringNilMapper(rateLimit.Description), core.StringNilMapper(rateLimit.ID), rateLimit, map[string]interface{}{
+			"threshold": rateLimit.Threshold,
+			"period":    rateLimit.Period,
+			"match":     rateLimit.Match,
+			"action":    rateLimit.Action,
+			"disabled":  rateLimit.Disabled,
+			"bypass":    rateLimit.Bypass,
+			"correlate": rateLimit.Correlate,
+		}))
+	}
+	return resources, len(result.Result), result.ResultInfo, nil
+}
+
+func (kind rateLimitKind) create(ctx context.Context, desired *resource) error {
+	rateLimit := desired.object.(RateLimit)
+	_, _, err := kind.service.CreateZoneRateLimitsWithContext(ctx, &zoneratelimitsv1.CreateZoneRateLimitsOptions{
+		Description: core.StringPtr(rateLimit.Description),
+		Threshold:   core.Int64Ptr(rateLimit.Threshold),
+		Period:      core.Int64Ptr(rateLimit.Period),
+		Match:       rateLimit.Match,
+		Action:      rateLimit.Action,
+		Bypass:      rateLimit.Bypass,
+		Correlate:   rateLimit.Correlate,
+		Disabled:    core.BoolPtr(rateLimit.Disabled),
+	})
+	return err
+}
+
+// update replaces a rate limit, with its current bypass and correlate unless the policy sets them.
+func (kind rateLimitKind) update(ctx context.Context, desired *resource, current *resource) error {
+	rateLimit := desired.object.(RateLimit)
+	options := &zoneratelimitsv1.UpdateRateLimitOptions{
+		RateLimitIdentifier: core.StringPtr(current.id),
+		Description:         core.StringPtr(rateLimit.Description),
+		Threshold:           core.Int64Ptr(rateLimit.Threshold),
+		Period:              core.Int64Ptr(rateLimit.Period),
+		Match:               rateLimit.Match,
+		Action:              rateLimit.Action,
+		Bypass:              rateLimit.Bypass,
+		Correlate:           rateLimit.Correlate,
+		Disabled:            core.BoolPtr(rateLimit.Disabled),
+	}
+	currentRateLimit := current.object.(*zoneratelimitsv1.RatelimitObject)
+	if options.Bypass == nil && currentRateLimit.Bypass != nil {
+		if err := convert(currentRateLimit.Bypass, &options.Bypass); err != nil {
+			return err
+		}
+	}
+	if options.Correlate == nil && currentRateLimit.Correlate != nil {
+		if err := convert(currentRateLimit.Correlate, &options.Correlate); err != nil {
+			return err
+		}
+	}
+	_, _, err := kind.service.UpdateRateLimitWithContext(ctx, options)
+	return err
+}
+
+func (kind rateLimitKind) delete(ctx context.Context, current *resource) error {
+	_, _, err := kind.service.DeleteZoneRateLimitWithContext(ctx, &zoneratelimitsv1.DeleteZoneRateLimitOptions{
+		RateLimitIdentifier: core.StringPtr(current.id),
+	})
+	return err
+}
+
+// firewallRuleKind is the kind of the firewall rules of the zone, which are created, updated and deleted
+// along with their filters.
+type firewallRuleKind struct {
+	reconciler *Reconciler
+}
+
+func (firewallRuleKind) name() string {
+	return KindFirewallRule
+}
+
+// canonicalExpression returns an expression in its canonical form, or as is if it can't be parsed.
+func canonicalExpression(expression string) string {
+	if e, err := filterexpr.Parse(expression); err == nil {
+		return e.String()
+	}
+	return expression
+}
+
+func (firewallRuleKind) desired(policy *Policy) (resources []*resource, managed bool) {
+	for _, rule := range policy.FirewallRules {
+		resources = append(resources, newResource(rule.Description, "", rule, map[string]interface{}{
+			"expression":    canonicalExpression(rule.Expression),
+			"action":        rule.Action,
+			"paused":        rule.Paused,
+			"filter_paused": false,
+		}))
+	}
+	return resources, policy.FirewallRules != nil
+}
+
+func (kind firewallRuleKind) list(ctx context.Context) ([]*resource, error) {
+	return listPages(ctx, kind.listPage)
+}
+
+func (kind firewallRuleKind) listPage(ctx context.Context, page *int64) (resources []*resource, itemCount int, info interface{}, err error) {
+	r := kind.reconciler
+	result, _, err := r.FirewallRules.ListAllFirewallRulesWithContext(ctx, &firewallrulesv1.ListAllFirewallRulesOptions{
+		XAuthUserToken: core.StringPtr(r.XAuthUserToken),
+		Crn:            core.StringPtr(r.Crn),
+		ZoneIdentifier: core.StringPtr(r.ZoneID),
+		Page:           page,
+	})
+	if err != nil || result == nil {
+		return
+	}
+	for i := range result.Result {
+		rule := &result.Result[i]
+		var expression string
+		var filterPaused bool
+		if rule.Filter != nil {
+			expression = canonicalExpression(core.StringNilMapper(rule.Filter.Expression))
+			filterPaused = rule.Filter.Paused != nil && *rule.Filter.Paused
+		}
+		resource := newResource(core.StringNilMapper(rule.Description), core.StringNilMapper(rule.ID), rule, map[string]interface{}{
+			"expression":    expression,
+			"action":        rule.Action,
+			"paused":        rule.Paused,
+			"filter_paused": filterPaused,
+		})
+		resource.modifiedOn = core.StringNilMapper(rule.ModifiedOn)
+		resources = append(resources, resource)
+	}
+	return resources, len(result.Result), result.ResultInfo, nil
+}
+
+// updateOptions returns the options of the update of a firewall rule along with its filter.
+func (kind firewallRuleKind) updateOptions(id string) *firewallrulesv1.UpdateFirewallRuleWithFilterOptions {
+	r := kind.reconciler
+	return &firewallrulesv1.UpdateFirewallRuleWithFilterOptions{
+		XAuthUserToken:         core.StringPtr(r.XAuthUserToken),
+		Crn:                    core.StringPtr(r.Crn),
+		ZoneIdentifier:         core.StringPtr(r.ZoneID),
+		FirewallRuleIdentifier: core.StringPtr(id),
+	}
+}
+
+// create creates a firewall rule with its filter. Since rules are created active, the filter of a rule
+// that must be paused is created paused, so that the rule never takes effect: the rule is then paused,
+// and its filter resumed.
+func (kind firewallRuleKind) create(ctx context.Context, desired *resource) error {
+	r := kind.reconciler
+	rule := desired.object.(FirewallRule)
+	result, _, err := r.FirewallRules.CreateFirewallRulesWithFiltersWithContext(ctx, r.Filters, &firewallrulesv1.CreateFirewallRulesWithFiltersOptions{
+		XAuthUserToken: core.StringPtr(r.XAuthUserToken),
+		Crn:            core.StringPtr(r.Crn),
+		ZoneIdentifier: core.StringPtr(r.ZoneID),
+		FirewallRules: []firewallrulesv1.FirewallRuleWithFilterInput{{
+			Expression:        core.StringPtr(rule.Expression),
+			Action:            core.StringPtr(rule.Action),
+			Description:       core.StringPtr(rule.Description),
+			FilterDescription: core.StringPtr(rule.Description),
+			FilterPaused:      core.BoolPtr(rule.Paused),
+		}},
+	})
+	if err != nil || !rule.Paused {
+		return err
+	}
+	if len(result.Result) != 1 {
+		return fmt.Errorf("%d firewall rules were created instead of 1", len(result.Result))
+	}
+	created := result.Result[0]
+	if created.Filter == nil || created.Filter.ID == nil {
+		return fmt.Errorf("firewall rule %s has no filter", core.StringNilMapper(created.ID))
+	}
+	_, _, err = r.FirewallRules.UpdateFirewallRuleWithFilterWithContext(ctx, r.Filters, kind.updateOptions(*created.ID).SetPaused(true))
+	if err != nil {
+		return err
+	}
+	return kind.resumeFilter(ctx, *created.Filter.ID, rule.Expression, rule.Description)
+}
+
+func (kind firewallRuleKind) update(ctx context.Context, desired *resource, current *resource) error {
+	r := kind.reconciler
+	rule := desired.object.(FirewallRule)
+	options := kind.updateOptions(current.id).
+		SetAction(rule.Action).
+		SetPaused(rule.Paused)
+	if current.fields["expression"] != desired.fields["expression"] {
+		options.SetExpression(rule.Expression)
+	}
+	_, _, err := r.FirewallRules.UpdateFirewallRuleWithFilterWithContext(ctx, r.Filters, options)
+	if err != nil || current.fields["filter_paused"] == desired.fields["filter_paused"] {
+		return err
+	}
+	// The filter was left paused by a create that failed midway.
+	filter := current.object.(*firewallrulesv1.FirewallRuleObject).Filter
+	return kind.resumeFilter(ctx, *filter.ID, rule.Expression, core.StringNilMapper(filter.Description))
+}
+
+// resumeFilter resumes the paused filter of a firewall rule.
+func (kind firewallRuleKind) resumeFilter(ctx context.Context, filterID string, expression string, description string) error {
+	r := kind.reconciler
+	_, _, err := r.Filters.UpdateFilterWithContext(ctx, &filtersv1.UpdateFilterOptions{
+		XAuthUserToken:   core.StringPtr(r.XAuthUserToken),
+		Crn:              core.StringPtr(r.Crn),
+		ZoneIdentifier:   core.StringPtr(r.ZoneID),
+		FilterIdentifier: core.StringPtr(filterID),
+		ID:               core.StringPtr(filterID),
+		Expression:       core.StringPtr(expression),
+		Description:      core.StringPtr(description),
+		Paused:           core.BoolPtr(false),
+	})
+	return err
+}
+
+func (kind firewallRuleKind) delete(ctx context.Context, current *resource) error {
+	r := kind.reconciler
+	_, _, err := r.FirewallRules.DeleteFirewallRuleWithFilterWithContext(ctx, r.Filters, &firewallrulesv1.DeleteFirewallRuleWithFilterOptions{
+		XAuthUserToken:         core.StringPtr(r.XAuthUserToken),
+		Crn:                    core.StringPtr(r.Crn),
+		ZoneIdentifier:         core.StringPtr(r.ZoneID),
+		FirewallRuleIdentifier: core.StringPtr(current.id),
+	})
+	return err
+}
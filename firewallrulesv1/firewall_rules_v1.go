@@ -159,6 +159,24 @@ func (firewallRules *FirewallRulesV1) DisableRetries() {
 	firewallRules.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (firewallRules *FirewallRulesV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(firewallRules.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (firewallRules *FirewallRulesV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(firewallRules.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (firewallRules *FirewallRulesV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(firewallRules.Service, middleware...)
+}
+
 // ListAllFirewallRules : List all firewall rules for a zone
 // List all firewall rules for a zone.
 func (firewallRules *FirewallRulesV1) ListAllFirewallRules(listAllFirewallRulesOptions *ListAllFirewallRulesOptions) (result *ListFirewallRulesResp, response *core.DetailedResponse, err error) {
@@ -202,6 +220,13 @@ func (firewallRules *FirewallRulesV1) ListAllFirewallRulesWithContext(ctx contex
 		builder.AddHeader("X-Auth-User-Token", fmt.Sprint(*listAllFirewallRulesOptions.XAuthUserToken))
 	}
 
+	if listAllFirewallRulesOptions.Page != nil {
+		builder.AddQuery("page", fmt.Sprint(*listAllFirewallRulesOptions.Page))
+	}
+	if listAllFirewallRulesOptions.PerPage != nil {
+		builder.AddQuery("per_page", fmt.Sprint(*listAllFirewallRulesOptions.PerPage))
+	}
+
 	request, err := builder.Build()
 	if err != nil {
 		return
@@ -210,6 +235,7 @@ func (firewallRules *FirewallRulesV1) ListAllFirewallRulesWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -282,6 +308,7 @@ func (firewallRules *FirewallRulesV1) CreateFirewallRulesWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -354,6 +381,7 @@ func (firewallRules *FirewallRulesV1) UpdateFirewllRulesWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -420,6 +448,7 @@ func (firewallRules *FirewallRulesV1) DeleteFirewallRulesWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -485,6 +514,7 @@ func (firewallRules *FirewallRulesV1) DeleteFirewallRuleWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -550,6 +580,7 @@ func (firewallRules *FirewallRulesV1) GetFirewallRuleWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -634,6 +665,7 @@ func (firewallRules *FirewallRulesV1) UpdateFirewallRuleWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = firewallRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1104,6 +1136,12 @@ type ListAllFirewallRulesOptions struct {
 	// Zone identifier of the zone for which firewall rules are listed.
 	ZoneIdentifier *string `validate:"required,ne="`
 
+	// Page number of paginated results.
+	Page *int64 `json:"page,omitempty"`
+
+	// Maximum number of firewall rules per page.
+	PerPage *int64 `json:"per_page,omitempty"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -1135,6 +1173,18 @@ func (options *ListAllFirewallRulesOptions) SetZoneIdentifier(zoneIdentifier str
 	return options
 }
 
+// SetPage : Allow user to set Page
+func (options *ListAllFirewallRulesOptions) SetPage(page int64) *ListAllFirewallRulesOptions {
+	options.Page = core.Int64Ptr(page)
+	return options
+}
+
+// SetPerPage : Allow user to set PerPage
+func (options *ListAllFirewallRulesOptions) SetPerPage(perPage int64) *ListAllFirewallRulesOptions {
+	options.PerPage = core.Int64Ptr(perPage)
+	return options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *ListAllFirewallRulesOptions) SetHeaders(param map[string]string) *ListAllFirewallRulesOptions {
 	options.Headers = param
@@ -1654,3 +1704,97 @@ func UnmarshalListFirewallRulesResp(m map[string]json.RawMessage, result interfa
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// FirewallRulesPager can be used to simplify the use of the "ListAllFirewallRules" method.
+type FirewallRulesPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListAllFirewallRulesOptions
+	client   *FirewallRulesV1
+}
+
+// NewFirewallRulesPager returns a new FirewallRulesPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (firewallRules *FirewallRulesV1) NewFirewallRulesPager(options *ListAllFirewallRulesOptions) (pager *FirewallRulesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListAllFirewallRulesOptions = *options
+	pager = &FirewallRulesPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   firewallRules,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *FirewallRulesPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *FirewallRulesPager) GetNextWithContext(ctx context.Context) (page []FirewallRuleObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListAllFirewallRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ListFirewallRulesRespResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *FirewallRulesPager) GetAllWithContext(ctx context.Context) (allItems []FirewallRuleObject, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []FirewallRuleObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *FirewallRulesPager) GetNext() (page []FirewallRuleObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *FirewallRulesPager) GetAll() (allItems []FirewallRuleObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -0,0 +1,480 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallrulesv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+)
+
+// FilterRollbackError is returned when an operation on firewall rules and their filters failed, and the
+// changes made to the filters could not be undone.
+type FilterRollbackError struct {
+	// The error of the operation.
+	Err error
+
+	// The error of the rollback.
+	RollbackErr error
+
+	// The filters that are left created or changed.
+	FilterIDs []string
+}
+
+func (e *FilterRollbackError) Error() string {
+	return fmt.Sprintf("%s; filters %s could not be rolled back: %s", e.Err.Error(), strings.Join(e.FilterIDs, ", "), e.RollbackErr.Error())
+}
+
+// Unwrap returns the error of the operation, so that errors.Is and errors.As can inspect it.
+func (e *FilterRollbackError) Unwrap() error {
+	return e.Err
+}
+
+// FirewallRuleWithFilterInput : A firewall rule to create along with its filter.
+type FirewallRuleWithFilterInput struct {
+	// The expression of the filter.
+	Expression *string `validate:"required"`
+
+	// To briefly describe the filter.
+	FilterDescription *string
+
+	// Indicates if the filter is paused.
+	FilterPaused *bool
+
+	// The firewall action to perform, "log" action is only available for enterprise plan instances.
+	Action *string `validate:"required"`
+
+	// To briefly describe the firewall rule.
+	Description *string
+}
+
+// NewFirewallRuleWithFilterInput : Instantiate FirewallRuleWithFilterInput
+func (*FirewallRulesV1) NewFirewallRuleWithFilterInput(expression string, action string) (model *FirewallRuleWithFilterInput, err error) {
+	model = &FirewallRuleWithFilterInput{
+		Expression: core.StringPtr(expression),
+		Action:     core.StringPtr(action),
+	}
+	err = core.ValidateStruct(model, "required parameters")
+	return
+}
+
+// CreateFirewallRulesWithFiltersOptions : The CreateFirewallRulesWithFilters options.
+type CreateFirewallRulesWithFiltersOptions struct {
+	// IBM Cloud user IAM token.
+	XAuthUserToken *string `validate:"required"`
+
+	// Full url-encoded cloud resource name (CRN) of resource instance.
+	Crn *string `validate:"required,ne="`
+
+	// Zone identifier of the zone for which firewall rules are created.
+	ZoneIdentifier *string `validate:"required,ne="`
+
+	// The firewall rules to create, along with their filters.
+	FirewallRules []FirewallRuleWithFilterInput `validate:"required,dive"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewCreateFirewallRulesWithFiltersOptions : Instantiate CreateFirewallRulesWithFiltersOptions
+func (*FirewallRulesV1) NewCreateFirewallRulesWithFiltersOptions(xAuthUserToken string, crn string, zoneIdentifier string, firewallRules []FirewallRuleWithFilterInput) *CreateFirewallRulesWithFiltersOptions {
+	return &CreateFirewallRulesWithFiltersOptions{
+		XAuthUserToken: core.StringPtr(xAuthUserToken),
+		Crn:            core.StringPtr(crn),
+		ZoneIdentifier: core.StringPtr(zoneIdentifier),
+		FirewallRules:  firewallRules,
+	}
+}
+
+// SetFirewallRules : Allow user to set FirewallRules
+func (options *CreateFirewallRulesWithFiltersOptions) SetFirewallRules(firewallRules []FirewallRuleWithFilterInput) *CreateFirewallRulesWithFiltersOptions {
+	options.FirewallRules = firewallRules
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *CreateFirewallRulesWithFiltersOptions) SetHeaders(param map[string]string) *CreateFirewallRulesWithFiltersOptions {
+	options.Headers = param
+	return options
+}
+
+// CreateFirewallRulesWithFilters : Create firewall rules along with their filters
+// Create the filters of the firewall rules, then the firewall rules. If the firewall rules can't be created,
+// the filters are deleted, so that none is left behind; if they can't be deleted either, the error is a
+// *FilterRollbackError.
+func (firewallRules *FirewallRulesV1) CreateFirewallRulesWithFilters(filters filtersv1.FiltersV1API, createFirewallRulesWithFiltersOptions *CreateFirewallRulesWithFiltersOptions) (result *FirewallRulesResp, response *core.DetailedResponse, err error) {
+	return firewallRules.CreateFirewallRulesWithFiltersWithContext(context.Background(), filters, createFirewallRulesWithFiltersOptions)
+}
+
+// CreateFirewallRulesWithFiltersWithContext is an alternate form of the CreateFirewallRulesWithFilters method which supports a Context parameter
+func (firewallRules *FirewallRulesV1) CreateFirewallRulesWithFiltersWithContext(ctx context.Context, filters filtersv1.FiltersV1API, createFirewallRulesWithFiltersOptions *CreateFirewallRulesWithFiltersOptions) (result *FirewallRulesResp, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createFirewallRulesWithFiltersOptions, "createFirewallRulesWithFiltersOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createFirewallRulesWithFiltersOptions, "createFirewallRulesWithFiltersOptions")
+	if err != nil {
+		return
+	}
+	options := createFirewallRulesWithFiltersOptions
+
+	filterInputs := make([]filtersv1.FilterInput, len(options.FirewallRules))
+	for i, rule := range options.FirewallRules {
+		filterInputs[i] = filtersv1.FilterInput{
+			Expression:  rule.Expression,
+			Description: rule.FilterDescription,
+			Paused:      rule.FilterPaused,
+		}
+	}
+	createdFilters, response, err := filters.CreateFilterWithContext(ctx, &filtersv1.CreateFilterOptions{
+		XAuthUserToken: options.XAuthUserToken,
+		Crn:            options.Crn,
+		ZoneIdentifier: options.ZoneIdentifier,
+		FilterInput:    filterInputs,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+
+	var filterIDs []string
+	for _, filter := range createdFilters.Result {
+		filterIDs = append(filterIDs, *filter.ID)
+	}
+	if len(filterIDs) != len(options.FirewallRules) {
+		err = fmt.Errorf("%d filters were created for %d firewall rules", len(filterIDs), len(options.FirewallRules))
+	} else {
+		ruleInputs := make([]FirewallRuleInputWithFilterID, len(options.FirewallRules))
+		for i, rule := range options.FirewallRules {
+			ruleInputs[i] = FirewallRuleInputWithFilterID{
+				Filter:      &FirewallRuleInputWithFilterIdFilter{ID: core.StringPtr(filterIDs[i])},
+				Action:      rule.Action,
+				Description: rule.Description,
+			}
+		}
+		result, response, err = firewallRules.CreateFirewallRulesWithContext(ctx, &CreateFirewallRulesOptions{
+			XAuthUserToken:                options.XAuthUserToken,
+			Crn:                           options.Crn,
+			ZoneIdentifier:                options.ZoneIdentifier,
+			FirewallRuleInputWithFilterID: ruleInputs,
+			Headers:                       options.Headers,
+		})
+	}
+	if err != nil && len(filterIDs) > 0 {
+		// The rollback isn't bound to ctx, which may be the reason why the firewall rules failed.
+		_, _, rollbackErr := filters.DeleteFiltersWithContext(context.Background(), &filtersv1.DeleteFiltersOptions{
+			XAuthUserToken: options.XAuthUserToken,
+			Crn:            options.Crn,
+			ZoneIdentifier: options.ZoneIdentifier,
+			ID:             core.StringPtr(strings.Join(filterIDs, ",")),
+			Headers:        options.Headers,
+		})
+		if rollbackErr != nil {
+			err = &FilterRollbackError{Err: err, RollbackErr: rollbackErr, FilterIDs: filterIDs}
+		}
+	}
+	return
+}
+
+// UpdateFirewallRuleWithFilterOptions : The UpdateFirewallRuleWithFilter options.
+type UpdateFirewallRuleWithFilterOptions struct {
+	// IBM Cloud user IAM token.
+	XAuthUserToken *string `validate:"required"`
+
+	// Full crn of the service instance.
+	Crn *string `validate:"required,ne="`
+
+	// Zone identifier (zone id).
+	ZoneIdentifier *string `validate:"required,ne="`
+
+	// Identifier of firewall rule.
+	FirewallRuleIdentifier *string `validate:"required,ne="`
+
+	// The firewall action to perform; unchanged if not set.
+	Action *string
+
+	// Indicates if the firewall rule is paused; unchanged if not set.
+	Paused *bool
+
+	// To briefly describe the firewall rule; unchanged if not set.
+	Description *string
+
+	// The expression of the filter of the firewall rule; unchanged if not set.
+	Expression *string
+
+	// To briefly describe the filter of the firewall rule; unchanged if not set.
+	FilterDescription *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewUpdateFirewallRuleWithFilterOptions : Instantiate UpdateFirewallRuleWithFilterOptions
+func (*FirewallRulesV1) NewUpdateFirewallRuleWithFilterOptions(xAuthUserToken string, crn string, zoneIdentifier string, firewallRuleIdentifier string) *UpdateFirewallRuleWithFilterOptions {
+	return &UpdateFirewallRuleWithFilterOptions{
+		XAuthUserToken:         core.StringPtr(xAuthUserToken),
+		Crn:                    core.StringPtr(crn),
+		ZoneIdentifier:         core.StringPtr(zoneIdentifier),
+		FirewallRuleIdentifier: core.StringPtr(firewallRuleIdentifier),
+	}
+}
+
+// SetAction : Allow user to set Action
+func (options *UpdateFirewallRuleWithFilterOptions) SetAction(action string) *UpdateFirewallRuleWithFilterOptions {
+	options.Action = core.StringPtr(action)
+	return options
+}
+
+// SetPaused : Allow user to set Paused
+func (options *UpdateFirewallRuleWithFilterOptions) SetPaused(paused bool) *UpdateFirewallRuleWithFilterOptions {
+	options.Paused = core.BoolPtr(paused)
+	return options
+}
+
+// SetDescription : Allow user to set Description
+func (options *UpdateFirewallRuleWithFilterOptions) SetDescription(description string) *UpdateFirewallRuleWithFilterOptions {
+	options.Description = core.StringPtr(description)
+	return options
+}
+
+// SetExpression : Allow user to set Expression
+func (options *UpdateFirewallRuleWithFilterOptions) SetExpression(expression string) *UpdateFirewallRuleWithFilterOptions {
+	options.Expression = core.StringPtr(expression)
+	return options
+}
+
+// SetFilterDescription : Allow user to set FilterDescription
+func (options *UpdateFirewallRuleWithFilterOptions) SetFilterDescription(filterDescription string) *UpdateFirewallRuleWithFilterOptions {
+	options.FilterDescription = core.StringPtr(filterDescription)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpdateFirewallRuleWithFilterOptions) SetHeaders(param map[string]string) *UpdateFirewallRuleWithFilterOptions {
+	options.Headers = param
+	return options
+}
+
+// UpdateFirewallRuleWithFilter : Update a firewall rule along with its filter
+// Update the filter of a firewall rule, if its expression or description change, then the firewall rule. If
+// the firewall rule can't be updated, the filter is restored; if it can't be restored either, the error is
+// a *FilterRollbackError.
+func (firewallRules *FirewallRulesV1) UpdateFirewallRuleWithFilter(filters filtersv1.FiltersV1API, updateFirewallRuleWithFilterOptions *UpdateFirewallRuleWithFilterOptions) (result *FirewallRuleResp, response *core.DetailedResponse, err error) {
+	return firewallRules.UpdateFirewallRuleWithFilterWithContext(context.Background(), filters, updateFirewallRuleWithFilterOptions)
+}
+
+// UpdateFirewallRuleWithFilterWithContext is an alternate form of the UpdateFirewallRuleWithFilter method which supports a Context parameter
+func (firewallRules *FirewallRulesV1) UpdateFirewallRuleWithFilterWithContext(ctx context.Context, filters filtersv1.FiltersV1API, updateFirewallRuleWithFilterOptions *UpdateFirewallRuleWithFilterOptions) (result *FirewallRuleResp, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateFirewallRuleWithFilterOptions, "updateFirewallRuleWithFilterOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(updateFirewallRuleWithFilterOptions, "updateFirewallRuleWithFilterOptions")
+	if err != nil {
+		return
+	}
+	options := updateFirewallRuleWithFilterOptions
+
+	current, response, err := firewallRules.GetFirewallRuleWithContext(ctx, &GetFirewallRuleOptions{
+		XAuthUserToken:         options.XAuthUserToken,
+		Crn:                    options.Crn,
+		ZoneIdentifier:         options.ZoneIdentifier,
+		FirewallRuleIdentifier: options.FirewallRuleIdentifier,
+		Headers:                options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	rule := current.Result
+	if rule.Filter == nil || rule.Filter.ID == nil {
+		err = fmt.Errorf("firewall rule %s has no filter", *options.FirewallRuleIdentifier)
+		return
+	}
+	filter := rule.Filter
+
+	updateFilterOptions := func(expression *string, description *string) *filtersv1.UpdateFilterOptions {
+		return &filtersv1.UpdateFilterOptions{
+			XAuthUserToken:   options.XAuthUserToken,
+			Crn:              options.Crn,
+			ZoneIdentifier:   options.ZoneIdentifier,
+			FilterIdentifier: filter.ID,
+			ID:               filter.ID,
+			Expression:       expression,
+			Description:      description,
+			Paused:           filter.Paused,
+			Headers:          options.Headers,
+		}
+	}
+	filterChanged := (options.Expression != nil && !equalStrings(options.Expression, filter.Expression)) ||
+		(options.FilterDescription != nil && !equalStrings(options.FilterDescription, filter.Description))
+	if filterChanged {
+		_, response, err = filters.UpdateFilterWithContext(ctx, updateFilterOptions(
+			firstString(options.Expression, filter.Expression), firstString(options.FilterDescription, filter.Description)))
+		if err != nil {
+			return
+		}
+	}
+
+	result, response, err = firewallRules.UpdateFirewallRuleWithContext(ctx, &UpdateFirewallRuleOptions{
+		XAuthUserToken:         options.XAuthUserToken,
+		Crn:                    options.Crn,
+		ZoneIdentifier:         options.ZoneIdentifier,
+		FirewallRuleIdentifier: options.FirewallRuleIdentifier,
+		Action:                 firstString(options.Action, rule.Action),
+		Paused:                 firstBool(options.Paused, rule.Paused),
+		Description:            firstString(options.Description, rule.Description),
+		Filter:                 &FirewallRuleUpdateInputFilter{ID: filter.ID},
+		Headers:                options.Headers,
+	})
+	if err != nil && filterChanged {
+		// The rollback isn't bound to ctx, which may be the reason why the firewall rule failed.
+		_, _, rollbackErr := filters.UpdateFilterWithContext(context.Background(), updateFilterOptions(filter.Expression, filter.Description))
+		if rollbackErr != nil {
+			err = &FilterRollbackError{Err: err, RollbackErr: rollbackErr, FilterIDs: []string{*filter.ID}}
+		}
+	}
+	return
+}
+
+// DeleteFirewallRuleWithFilterOptions : The DeleteFirewallRuleWithFilter options.
+type DeleteFirewallRuleWithFilterOptions struct {
+	// IBM Cloud user IAM token.
+	XAuthUserToken *string `validate:"required"`
+
+	// Full crn of the service instance.
+	Crn *string `validate:"required,ne="`
+
+	// Zone identifier (zone id).
+	ZoneIdentifier *string `validate:"required,ne="`
+
+	// Identifier of the firewall rule.
+	FirewallRuleIdentifier *string `validate:"required,ne="`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewDeleteFirewallRuleWithFilterOptions : Instantiate DeleteFirewallRuleWithFilterOptions
+func (*FirewallRulesV1) NewDeleteFirewallRuleWithFilterOptions(xAuthUserToken string, crn string, zoneIdentifier string, firewallRuleIdentifier string) *DeleteFirewallRuleWithFilterOptions {
+	return &DeleteFirewallRuleWithFilterOptions{
+		XAuthUserToken:         core.StringPtr(xAuthUserToken),
+		Crn:                    core.StringPtr(crn),
+		ZoneIdentifier:         core.StringPtr(zoneIdentifier),
+		FirewallRuleIdentifier: core.StringPtr(firewallRuleIdentifier),
+	}
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DeleteFirewallRuleWithFilterOptions) SetHeaders(param map[string]string) *DeleteFirewallRuleWithFilterOptions {
+	options.Headers = param
+	return options
+}
+
+// DeleteFirewallRuleWithFilter : Delete a firewall rule along with its filter
+// Delete a firewall rule, then its filter unless another firewall rule of the zone uses it. If the filter
+// can't be deleted, the firewall rule stays deleted: the result and response are those of the firewall
+// rule, and the error that of the filter.
+func (firewallRules *FirewallRulesV1) DeleteFirewallRuleWithFilter(filters filtersv1.FiltersV1API, deleteFirewallRuleWithFilterOptions *DeleteFirewallRuleWithFilterOptions) (result *DeleteFirewallRuleResp, response *core.DetailedResponse, err error) {
+	return firewallRules.DeleteFirewallRuleWithFilterWithContext(context.Background(), filters, deleteFirewallRuleWithFilterOptions)
+}
+
+// DeleteFirewallRuleWithFilterWithContext is an alternate form of the DeleteFirewallRuleWithFilter method which supports a Context parameter
+func (firewallRules *FirewallRulesV1) DeleteFirewallRuleWithFilterWithContext(ctx context.Context, filters filtersv1.FiltersV1API, deleteFirewallRuleWithFilterOptions *DeleteFirewallRuleWithFilterOptions) (result *DeleteFirewallRuleResp, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(deleteFirewallRuleWithFilterOptions, "deleteFirewallRuleWithFilterOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(deleteFirewallRuleWithFilterOptions, "deleteFirewallRuleWithFilterOptions")
+	if err != nil {
+		return
+	}
+	options := deleteFirewallRuleWithFilterOptions
+
+	current, response, err := firewallRules.GetFirewallRuleWithContext(ctx, &GetFirewallRuleOptions{
+		XAuthUserToken:         options.XAuthUserToken,
+		Crn:                    options.Crn,
+		ZoneIdentifier:         options.ZoneIdentifier,
+		FirewallRuleIdentifier: options.FirewallRuleIdentifier,
+		Headers:                options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	result, response, err = firewallRules.DeleteFirewallRuleWithContext(ctx, &DeleteFirewallRuleOptions{
+		XAuthUserToken:         options.XAuthUserToken,
+		Crn:                    options.Crn,
+		ZoneIdentifier:         options.ZoneIdentifier,
+		FirewallRuleIdentifier: options.FirewallRuleIdentifier,
+		Headers:                options.Headers,
+	})
+	if err != nil || current.Result.Filter == nil || current.Result.Filter.ID == nil {
+		return
+	}
+	filterID := *current.Result.Filter.ID
+
+	pager, err := firewallRules.NewFirewallRulesPager(&ListAllFirewallRulesOptions{
+		XAuthUserToken: options.XAuthUserToken,
+		Crn:            options.Crn,
+		ZoneIdentifier: options.ZoneIdentifier,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	rules, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = fmt.Errorf("firewall rule %s was deleted, but not its filter %s: %w", *options.FirewallRuleIdentifier, filterID, err)
+		return
+	}
+	for _, rule := range rules {
+		if rule.Filter != nil && rule.Filter.ID != nil && *rule.Filter.ID == filterID {
+			return
+		}
+	}
+	_, _, err = filters.DeleteFilterWithContext(ctx, &filtersv1.DeleteFilterOptions{
+		XAuthUserToken:   options.XAuthUserToken,
+		Crn:              options.Crn,
+		ZoneIdentifier:   options.ZoneIdentifier,
+		FilterIdentifier: core.StringPtr(filterID),
+		Headers:          options.Headers,
+	})
+	if err != nil {
+		err = fmt.Errorf("firewall rule %s was deleted, but not its filter %s: %w", *options.FirewallRuleIdentifier, filterID, err)
+	}
+	return
+}
+
+func equalStrings(a *string, b *string) bool {
+	return a != nil && b != nil && *a == *b
+}
+
+func firstString(s *string, fallback *string) *string {
+	if s != nil {
+		return s
+	}
+	return fallback
+}
+
+func firstBool(b *bool, fallback *bool) *bool {
+	if b != nil {
+		return b
+	}
+	return fallback
+}
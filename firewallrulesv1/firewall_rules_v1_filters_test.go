@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallrulesv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/fakes"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/mocks"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`FirewallRulesV1 rules with filters`, func() {
+	const crn = "crn:v1:bluemix:public:internet-svcs:global:a/fake-account:instance-1::"
+	var testServer *httptest.Server
+	var firewallRulesService *firewallrulesv1.FirewallRulesV1
+	var filtersService *filtersv1.FiltersV1
+	var zoneID string
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(fakes.NewCisServer())
+		zonesService, err := zonesv1.NewZonesV1(&zonesv1.ZonesV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Crn:           core.StringPtr(crn),
+		})
+		Expect(err).To(BeNil())
+		zone, _, err := zonesService.CreateZone(zonesService.NewCreateZoneOptions().SetName("example.com"))
+		Expect(err).To(BeNil())
+		zoneID = *zone.Result.ID
+
+		firewallRulesService, err = firewallrulesv1.NewFirewallRulesV1(&firewallrulesv1.FirewallRulesV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		filtersService, err = filtersv1.NewFiltersV1(&filtersv1.FiltersV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	listFilters := func() []filtersv1.FilterObject {
+		list, _, err := filtersService.ListAllFilters(filtersService.NewListAllFiltersOptions("token", crn, zoneID))
+		Expect(err).To(BeNil())
+		return list.Result
+	}
+	createRules := func(actions ...string) []firewallrulesv1.FirewallRuleObject {
+		var inputs []firewallrulesv1.FirewallRuleWithFilterInput
+		for i, action := range actions {
+			input, err := firewallRulesService.NewFirewallRuleWithFilterInput(
+				[]string{`ip.src eq 192.0.2.1`, `http.host eq "example.com"`}[i], action)
+			Expect(err).To(BeNil())
+			inputs = append(inputs, *input)
+		}
+		result, _, err := firewallRulesService.CreateFirewallRulesWithFilters(filtersService,
+			firewallRulesService.NewCreateFirewallRulesWithFiltersOptions("token", crn, zoneID, inputs))
+		Expect(err).To(BeNil())
+		return result.Result
+	}
+
+	Describe(`CreateFirewallRulesWithFilters(filters filtersv1.FiltersV1API, createFirewallRulesWithFiltersOptions *CreateFirewallRulesWithFiltersOptions)`, func() {
+		It(`Creates the firewall rules with their filters`, func() {
+			rules := createRules("block", "challenge")
+			Expect(len(rules)).To(Equal(2))
+			Expect(*rules[0].Filter.Expression).To(Equal(`ip.src eq 192.0.2.1`))
+			Expect(*rules[1].Action).To(Equal("challenge"))
+			Expect(len(listFilters())).To(Equal(2))
+		})
+		It(`Deletes the filters when the firewall rules can't be created`, func() {
+			_, response, err := firewallRulesService.CreateFirewallRulesWithFilters(filtersService,
+				firewallRulesService.NewCreateFirewallRulesWithFiltersOptions("token", crn, zoneID, []firewallrulesv1.FirewallRuleWithFilterInput{
+					{Expression: core.StringPtr(`ssl`), Action: core.StringPtr("block")},
+					{Expression: core.StringPtr(`not ssl`), Action: core.StringPtr("bypass")},
+				}))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(400))
+			Expect(listFilters()).To(BeEmpty())
+		})
+		It(`Reports the filters that can't be deleted`, func() {
+			failingFilters := &mocks.FiltersV1{
+				CreateFilterWithContextFunc: filtersService.CreateFilterWithContext,
+				DeleteFiltersWithContextFunc: func(ctx context.Context, options *filtersv1.DeleteFiltersOptions) (*filtersv1.DeleteFiltersResp, *core.DetailedResponse, error) {
+					return nil, nil, errors.New("connection reset")
+				},
+			}
+			_, _, err := firewallRulesService.CreateFirewallRulesWithFilters(failingFilters,
+				firewallRulesService.NewCreateFirewallRulesWithFiltersOptions("token", crn, zoneID, []firewallrulesv1.FirewallRuleWithFilterInput{
+					{Expression: core.StringPtr(`ssl`), Action: core.StringPtr("bypass")},
+				}))
+			var rollbackErr *firewallrulesv1.FilterRollbackError
+			Expect(errors.As(err, &rollbackErr)).To(BeTrue())
+			Expect(rollbackErr.FilterIDs).To(Equal([]string{*listFilters()[0].ID}))
+			Expect(common.AsAPIError(err).StatusCode).To(Equal(400))
+		})
+		It(`Rejects a firewall rule without an expression`, func() {
+			_, _, err := firewallRulesService.CreateFirewallRulesWithFilters(filtersService,
+				firewallRulesService.NewCreateFirewallRulesWithFiltersOptions("token", crn, zoneID, []firewallrulesv1.FirewallRuleWithFilterInput{
+					{Action: core.StringPtr("block")},
+				}))
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`UpdateFirewallRuleWithFilter(filters filtersv1.FiltersV1API, updateFirewallRuleWithFilterOptions *UpdateFirewallRuleWithFilterOptions)`, func() {
+		It(`Updates the firewall rule and its expression`, func() {
+			rule := createRules("block")[0]
+			result, _, err := firewallRulesService.UpdateFirewallRuleWithFilter(filtersService,
+				firewallRulesService.NewUpdateFirewallRuleWithFilterOptions("token", crn, zoneID, *rule.ID).
+					SetAction("js_challenge").
+					SetExpression(`ip.src in {192.0.2.0/24}`))
+			Expect(err).To(BeNil())
+			Expect(*result.Result.Action).To(Equal("js_challenge"))
+			Expect(*result.Result.Filter.ID).To(Equal(*rule.Filter.ID))
+			Expect(*result.Result.Filter.Expression).To(Equal(`ip.src in {192.0.2.0/24}`))
+		})
+		It(`Restores the filter when the firewall rule can't be updated`, func() {
+			rule := createRules("block")[0]
+			_, _, err := firewallRulesService.UpdateFirewallRuleWithFilter(filtersService,
+				firewallRulesService.NewUpdateFirewallRuleWithFilterOptions("token", crn, zoneID, *rule.ID).
+					SetAction("bypass").
+					SetExpression(`ip.src in {192.0.2.0/24}`))
+			Expect(err).ToNot(BeNil())
+			filters := listFilters()
+			Expect(len(filters)).To(Equal(1))
+			Expect(*filters[0].Expression).To(Equal(`ip.src eq 192.0.2.1`))
+		})
+	})
+
+	Describe(`DeleteFirewallRuleWithFilter(filters filtersv1.FiltersV1API, deleteFirewallRuleWithFilterOptions *DeleteFirewallRuleWithFilterOptions)`, func() {
+		It(`Deletes the firewall rule and its filter`, func() {
+			rules := createRules("block", "allow")
+			_, _, err := firewallRulesService.DeleteFirewallRuleWithFilter(filtersService,
+				firewallRulesService.NewDeleteFirewallRuleWithFilterOptions("token", crn, zoneID, *rules[0].ID))
+			Expect(err).To(BeNil())
+			filters := listFilters()
+			Expect(len(filters)).To(Equal(1))
+			Expect(*filters[0].ID).To(Equal(*rules[1].Filter.ID))
+		})
+		It(`Keeps the filter when another firewall rule uses it`, func() {
+			rule := createRules("block")[0]
+			rulesPath := "/v1/" + url.PathEscape(crn) + "/zones/" + zoneID + "/firewall/rules"
+			firewallRulesService.EnableMiddleware(common.StubMiddleware(func(req *http.Request) (*http.Response, error) {
+				if req.Method != http.MethodGet || req.URL.EscapedPath() != rulesPath {
+					return nil, nil
+				}
+				return common.NewResponse(req, 200, fmt.Sprintf(`{"success": true, "errors": [], "messages": [], "result": [
+					{"id": "other-rule", "paused": false, "description": "", "action": "log", "filter": {"id": "%s"}}],
+					"result_info": {"page": 1, "per_page": 20, "count": 1, "total_count": 1}}`, *rule.Filter.ID)), nil
+			}))
+			_, _, err := firewallRulesService.DeleteFirewallRuleWithFilter(filtersService,
+				firewallRulesService.NewDeleteFirewallRuleWithFilterOptions("token", crn, zoneID, *rule.ID))
+			Expect(err).To(BeNil())
+			Expect(len(listFilters())).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,52 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firewallrulesv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+)
+
+// FirewallRulesV1API is the interface of the operations of FirewallRulesV1, through which they can be replaced
+// in unit tests, for instance with the mocks.FirewallRulesV1 of the mocks package.
+type FirewallRulesV1API interface {
+	ListAllFirewallRules(listAllFirewallRulesOptions *ListAllFirewallRulesOptions) (result *ListFirewallRulesResp, response *core.DetailedResponse, err error)
+	ListAllFirewallRulesWithContext(ctx context.Context, listAllFirewallRulesOptions *ListAllFirewallRulesOptions) (result *ListFirewallRulesResp, response *core.DetailedResponse, err error)
+	CreateFirewallRules(createFirewallRulesOptions *CreateFirewallRulesOptions) (result *FirewallRulesResp, response *core.DetailedResponse, err error)
+	CreateFirewallRulesWithContext(ctx context.Context, createFirewallRulesOptions *CreateFirewallRulesOptions) (result *FirewallRulesResp, response *core.DetailedResponse, err error)
+	UpdateFirewllRules(updateFirewllRulesOptions *UpdateFirewllRulesOptions) (result *FirewallRulesResp, response *core.DetailedResponse, err error)
+	UpdateFirewllRulesWithContext(ctx context.Context, updateFirewllRulesOptions *UpdateFirewllRulesOptions) (result *FirewallRulesResp, response *core.DetailedResponse, err error)
+	DeleteFirewallRules(deleteFirewallRulesOptions *DeleteFirewallRulesOptions) (result *DeleteFirewallRulesResp, response *core.DetailedResponse, err error)
+	DeleteFirewallRulesWithContext(ctx context.Context, deleteFirewallRulesOptions *DeleteFirewallRulesOptions) (result *DeleteFirewallRulesResp, response *core.DetailedResponse, err error)
+	DeleteFirewallRule(deleteFirewallRuleOptions *DeleteFirewallRuleOptions) (result *DeleteFirewallRuleResp, response *core.DetailedResponse, err error)
+	DeleteFirewallRuleWithContext(ctx context.Context, deleteFirewallRuleOptions *DeleteFirewallRuleOptions) (result *DeleteFirewallRuleResp, response *core.DetailedResponse, err error)
+	GetFirewallRule(getFirewallRuleOptions *GetFirewallRuleOptions) (result *FirewallRuleResp, response *core.DetailedResponse, err error)
+	GetFirewallRuleWithContext(ctx context.Context, getFirewallRuleOptions *GetFirewallRuleOptions) (result *FirewallRuleResp, response *core.DetailedResponse, err error)
+	UpdateFirewallRule(updateFirewallRuleOptions *UpdateFirewallRuleOptions) (result *FirewallRuleResp, response *core.DetailedResponse, err error)
+	UpdateFirewallRuleWithContext(ctx context.Context, updateFirewallRuleOptions *UpdateFirewallRuleOptions) (result *FirewallRuleResp, response *core.DetailedResponse, err error)
+	CreateFirewallRulesWithFilters(filters filtersv1.FiltersV1API, createFirewallRulesWithFiltersOptions *CreateFirewallRulesWithFiltersOptions) (result *FirewallRulesResp, response *core.DetailedResponse, err error)
+	CreateFirewallRulesWithFiltersWithContext(ctx context.Context, filters filtersv1.FiltersV1API, createFirewallRulesWithFiltersOptions *CreateFirewallRulesWithFiltersOptions) (result *FirewallRulesResp, response *core.DetailedResponse, err error)
+	UpdateFirewallRuleWithFilter(filters filtersv1.FiltersV1API, updateFirewallRuleWithFilterOptions *UpdateFirewallRuleWithFilterOptions) (result *FirewallRuleResp, response *core.DetailedResponse, err error)
+	UpdateFirewallRuleWithFilterWithContext(ctx context.Context, filters filtersv1.FiltersV1API, updateFirewallRuleWithFilterOptions *UpdateFirewallRuleWithFilterOptions) (result *FirewallRuleResp, response *core.DetailedResponse, err error)
+	DeleteFirewallRuleWithFilter(filters filtersv1.FiltersV1API, deleteFirewallRuleWithFilterOptions *DeleteFirewallRuleWithFilterOptions) (result *DeleteFirewallRuleResp, response *core.DetailedResponse, err error)
+	DeleteFirewallRuleWithFilterWithContext(ctx context.Context, filters filtersv1.FiltersV1API, deleteFirewallRuleWithFilterOptions *DeleteFirewallRuleWithFilterOptions) (result *DeleteFirewallRuleResp, response *core.DetailedResponse, err error)
+}
+
+// FirewallRulesV1 implements FirewallRulesV1API.
+var _ FirewallRulesV1API = (*FirewallRulesV1)(nil)
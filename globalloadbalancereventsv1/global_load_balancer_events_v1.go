@@ -172,6 +172,24 @@ func (globalLoadBalancerEvents *GlobalLoadBalancerEventsV1) DisableRetries() {
 	globalLoadBalancerEvents.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (globalLoadBalancerEvents *GlobalLoadBalancerEventsV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(globalLoadBalancerEvents.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (globalLoadBalancerEvents *GlobalLoadBalancerEventsV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(globalLoadBalancerEvents.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (globalLoadBalancerEvents *GlobalLoadBalancerEventsV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(globalLoadBalancerEvents.Service, middleware...)
+}
+
 // GetLoadBalancerEvents : List all load balancer events
 // Get load balancer events for all origins.
 func (globalLoadBalancerEvents *GlobalLoadBalancerEventsV1) GetLoadBalancerEvents(getLoadBalancerEventsOptions *GetLoadBalancerEventsOptions) (result *ListEventsResp, response *core.DetailedResponse, err error) {
@@ -215,6 +233,7 @@ func (globalLoadBalancerEvents *GlobalLoadBalancerEventsV1) GetLoadBalancerEvent
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerEvents.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListEventsResp)
@@ -0,0 +1,33 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalloadbalancereventsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GlobalLoadBalancerEventsV1API is the interface of the operations of GlobalLoadBalancerEventsV1, through which they can be replaced
+// in unit tests, for instance with the mocks.GlobalLoadBalancerEventsV1 of the mocks package.
+type GlobalLoadBalancerEventsV1API interface {
+	GetLoadBalancerEvents(getLoadBalancerEventsOptions *GetLoadBalancerEventsOptions) (result *ListEventsResp, response *core.DetailedResponse, err error)
+	GetLoadBalancerEventsWithContext(ctx context.Context, getLoadBalancerEventsOptions *GetLoadBalancerEventsOptions) (result *ListEventsResp, response *core.DetailedResponse, err error)
+}
+
+// GlobalLoadBalancerEventsV1 implements GlobalLoadBalancerEventsV1API.
+var _ GlobalLoadBalancerEventsV1API = (*GlobalLoadBalancerEventsV1)(nil)
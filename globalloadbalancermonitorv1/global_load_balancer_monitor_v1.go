@@ -171,6 +171,24 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) DisableRetries() {
 	globalLoadBalancerMonitor.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(globalLoadBalancerMonitor.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(globalLoadBalancerMonitor.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(globalLoadBalancerMonitor.Service, middleware...)
+}
+
 // ListAllLoadBalancerMonitors : List all load balancer monitors
 // List configured load balancer monitors for a user.
 func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) ListAllLoadBalancerMonitors(listAllLoadBalancerMonitorsOptions *ListAllLoadBalancerMonitorsOptions) (result *ListMonitorResp, response *core.DetailedResponse, err error) {
@@ -214,6 +232,7 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) ListAllLoadBalance
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerMonitor.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListMonitorResp)
@@ -314,6 +333,7 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) CreateLoadBalancer
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerMonitor.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitorResp)
@@ -419,6 +439,7 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) EditLoadBalancerMo
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerMonitor.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitorResp)
@@ -478,6 +499,7 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) DeleteLoadBalancer
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerMonitor.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteMonitorResp)
@@ -537,6 +559,7 @@ func (globalLoadBalancerMonitor *GlobalLoadBalancerMonitorV1) GetLoadBalancerMon
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerMonitor.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitorResp)
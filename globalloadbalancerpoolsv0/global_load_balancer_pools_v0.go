@@ -171,6 +171,24 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) DisableRetries() {
 	globalLoadBalancerPools.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(globalLoadBalancerPools.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(globalLoadBalancerPools.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(globalLoadBalancerPools.Service, middleware...)
+}
+
 // ListAllLoadBalancerPools : List all pools
 // List all configured load balancer pools.
 func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) ListAllLoadBalancerPools(listAllLoadBalancerPoolsOptions *ListAllLoadBalancerPoolsOptions) (result *ListLoadBalancerPoolsResp, response *core.DetailedResponse, err error) {
@@ -206,6 +224,13 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) ListAllLoadBalancerPoo
 	}
 	builder.AddHeader("Accept", "application/json")
 
+	if listAllLoadBalancerPoolsOptions.Page != nil {
+		builder.AddQuery("page", fmt.Sprint(*listAllLoadBalancerPoolsOptions.Page))
+	}
+	if listAllLoadBalancerPoolsOptions.PerPage != nil {
+		builder.AddQuery("per_page", fmt.Sprint(*listAllLoadBalancerPoolsOptions.PerPage))
+	}
+
 	request, err := builder.Build()
 	if err != nil {
 		return
@@ -214,6 +239,7 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) ListAllLoadBalancerPoo
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerPools.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListLoadBalancerPoolsResp)
@@ -299,6 +325,7 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) CreateLoadBalancerPool
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerPools.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancerPoolResp)
@@ -358,6 +385,7 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) GetLoadBalancerPoolWit
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerPools.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancerPoolResp)
@@ -417,6 +445,7 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) DeleteLoadBalancerPool
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerPools.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteLoadBalancerPoolResp)
@@ -507,6 +536,7 @@ func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) EditLoadBalancerPoolWi
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancerPools.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancerPoolResp)
@@ -782,6 +812,11 @@ func (options *GetLoadBalancerPoolOptions) SetHeaders(param map[string]string) *
 
 // ListAllLoadBalancerPoolsOptions : The ListAllLoadBalancerPools options.
 type ListAllLoadBalancerPoolsOptions struct {
+	// Page number of paginated results.
+	Page *int64 `json:"page,omitempty"`
+
+	// Maximum number of load balancer pools per page.
+	PerPage *int64 `json:"per_page,omitempty"`
 
 	// Allows users to set headers on API requests
 	Headers map[string]string
@@ -792,6 +827,18 @@ func (*GlobalLoadBalancerPoolsV0) NewListAllLoadBalancerPoolsOptions() *ListAllL
 	return &ListAllLoadBalancerPoolsOptions{}
 }
 
+// SetPage : Allow user to set Page
+func (options *ListAllLoadBalancerPoolsOptions) SetPage(page int64) *ListAllLoadBalancerPoolsOptions {
+	options.Page = core.Int64Ptr(page)
+	return options
+}
+
+// SetPerPage : Allow user to set PerPage
+func (options *ListAllLoadBalancerPoolsOptions) SetPerPage(perPage int64) *ListAllLoadBalancerPoolsOptions {
+	options.PerPage = core.Int64Ptr(perPage)
+	return options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *ListAllLoadBalancerPoolsOptions) SetHeaders(param map[string]string) *ListAllLoadBalancerPoolsOptions {
 	options.Headers = param
@@ -1154,3 +1201,97 @@ func UnmarshalResultInfo(m map[string]json.RawMessage, result interface{}) (err
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// LoadBalancerPoolsPager can be used to simplify the use of the "ListAllLoadBalancerPools" method.
+type LoadBalancerPoolsPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListAllLoadBalancerPoolsOptions
+	client   *GlobalLoadBalancerPoolsV0
+}
+
+// NewLoadBalancerPoolsPager returns a new LoadBalancerPoolsPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (globalLoadBalancerPools *GlobalLoadBalancerPoolsV0) NewLoadBalancerPoolsPager(options *ListAllLoadBalancerPoolsOptions) (pager *LoadBalancerPoolsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListAllLoadBalancerPoolsOptions = *options
+	pager = &LoadBalancerPoolsPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   globalLoadBalancerPools,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *LoadBalancerPoolsPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *LoadBalancerPoolsPager) GetNextWithContext(ctx context.Context) (page []LoadBalancerPoolPack, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListAllLoadBalancerPoolsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *LoadBalancerPoolsPager) GetAllWithContext(ctx context.Context) (allItems []LoadBalancerPoolPack, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []LoadBalancerPoolPack
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *LoadBalancerPoolsPager) GetNext() (page []LoadBalancerPoolPack, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *LoadBalancerPoolsPager) GetAll() (allItems []LoadBalancerPoolPack, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	common "github.com/IBM/networking-go-sdk/common"
@@ -111,6 +112,35 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) SetServiceURL(url string) erro
 	return globalLoadBalancers.Service.SetServiceURL(url)
 }
 
+// EnableRetries enables automatic retries for requests invoked for this service instance.
+// If either parameter is specified as 0, then a default value is used instead.
+func (globalLoadBalancers *GlobalLoadBalancersV1) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
+	globalLoadBalancers.Service.EnableRetries(maxRetries, maxRetryInterval)
+}
+
+// DisableRetries disables automatic retries for requests invoked for this service instance.
+func (globalLoadBalancers *GlobalLoadBalancersV1) DisableRetries() {
+	globalLoadBalancers.Service.DisableRetries()
+}
+
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (globalLoadBalancers *GlobalLoadBalancersV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(globalLoadBalancers.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (globalLoadBalancers *GlobalLoadBalancersV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(globalLoadBalancers.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (globalLoadBalancers *GlobalLoadBalancersV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(globalLoadBalancers.Service, middleware...)
+}
+
 // ListLoadBalancers : List load balancers
 // List the Global Load Balancers for a given DNS zone.
 func (globalLoadBalancers *GlobalLoadBalancersV1) ListLoadBalancers(listLoadBalancersOptions *ListLoadBalancersOptions) (result *ListLoadBalancers, response *core.DetailedResponse, err error) {
@@ -153,6 +183,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) ListLoadBalancers(listLoadBala
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListLoadBalancers)
@@ -234,6 +265,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) CreateLoadBalancer(createLoadB
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancer)
@@ -284,6 +316,9 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) DeleteLoadBalancer(deleteLoadB
 	}
 
 	response, err = globalLoadBalancers.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -330,6 +365,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) GetLoadBalancer(getLoadBalance
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancer)
@@ -411,6 +447,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) UpdateLoadBalancer(updateLoadB
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancer)
@@ -464,6 +501,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) ListPools(listPoolsOptions *Li
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListPools)
@@ -551,6 +589,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) CreatePool(createPoolOptions *
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPool)
@@ -601,6 +640,9 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) DeletePool(deletePoolOptions *
 	}
 
 	response, err = globalLoadBalancers.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -647,6 +689,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) GetPool(getPoolOptions *GetPoo
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPool)
@@ -734,6 +777,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) UpdatePool(updatePoolOptions *
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPool)
@@ -787,6 +831,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) ListMonitors(listMonitorsOptio
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListMonitors)
@@ -886,6 +931,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) CreateMonitor(createMonitorOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitor)
@@ -936,6 +982,9 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) DeleteMonitor(deleteMonitorOpt
 	}
 
 	response, err = globalLoadBalancers.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -982,6 +1031,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) GetMonitor(getMonitorOptions *
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitor)
@@ -1081,6 +1131,7 @@ func (globalLoadBalancers *GlobalLoadBalancersV1) UpdateMonitor(updateMonitorOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancers.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalMonitor)
@@ -178,6 +178,24 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) DisableRetries() {
 	globalLoadBalancer.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (globalLoadBalancer *GlobalLoadBalancerV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(globalLoadBalancer.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (globalLoadBalancer *GlobalLoadBalancerV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(globalLoadBalancer.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (globalLoadBalancer *GlobalLoadBalancerV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(globalLoadBalancer.Service, middleware...)
+}
+
 // ListAllLoadBalancers : List all load balancers
 // List configured load balancers.
 func (globalLoadBalancer *GlobalLoadBalancerV1) ListAllLoadBalancers(listAllLoadBalancersOptions *ListAllLoadBalancersOptions) (result *ListLoadBalancersResp, response *core.DetailedResponse, err error) {
@@ -222,6 +240,7 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) ListAllLoadBalancersWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancer.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListLoadBalancersResp)
@@ -317,6 +336,7 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) CreateLoadBalancerWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancer.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancersResp)
@@ -417,6 +437,7 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) EditLoadBalancerWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancer.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancersResp)
@@ -477,6 +498,7 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) DeleteLoadBalancerWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancer.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteLoadBalancersResp)
@@ -537,6 +559,7 @@ func (globalLoadBalancer *GlobalLoadBalancerV1) GetLoadBalancerSettingsWithConte
 	var rawResponse map[string]json.RawMessage
 	response, err = globalLoadBalancer.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLoadBalancersResp)
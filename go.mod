@@ -6,6 +6,7 @@ require (
 	github.com/IBM/go-sdk-core/v5 v5.8.0
 	github.com/go-openapi/strfmt v0.20.2
 	github.com/google/uuid v1.1.1
+	github.com/hashicorp/go-retryablehttp v0.7.0
 	github.com/joho/godotenv v1.3.0
 	github.com/onsi/ginkgo v1.14.2
 	github.com/onsi/gomega v1.10.5
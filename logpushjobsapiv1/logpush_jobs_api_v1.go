@@ -183,6 +183,24 @@ func (logpushJobsApi *LogpushJobsApiV1) DisableRetries() {
 	logpushJobsApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (logpushJobsApi *LogpushJobsApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(logpushJobsApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (logpushJobsApi *LogpushJobsApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(logpushJobsApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (logpushJobsApi *LogpushJobsApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(logpushJobsApi.Service, middleware...)
+}
+
 // GetLogpushJobs : List logpush jobs
 // List configured logpush jobs for your domain.
 func (logpushJobsApi *LogpushJobsApiV1) GetLogpushJobs(getLogpushJobsOptions *GetLogpushJobsOptions) (result *ListLogpushJobsResp, response *core.DetailedResponse, err error) {
@@ -227,6 +245,7 @@ func (logpushJobsApi *LogpushJobsApiV1) GetLogpushJobsWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -312,6 +331,7 @@ func (logpushJobsApi *LogpushJobsApiV1) CreateLogpushJobWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -374,6 +394,7 @@ func (logpushJobsApi *LogpushJobsApiV1) GetLogpushJobWithContext(ctx context.Con
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -458,6 +479,7 @@ func (logpushJobsApi *LogpushJobsApiV1) UpdateLogpushJobWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -520,6 +542,7 @@ func (logpushJobsApi *LogpushJobsApiV1) DeleteLogpushJobWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -578,6 +601,7 @@ func (logpushJobsApi *LogpushJobsApiV1) ListFieldsForDatasetWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -636,6 +660,7 @@ func (logpushJobsApi *LogpushJobsApiV1) ListLogpushJobsForDatasetWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -703,6 +728,7 @@ func (logpushJobsApi *LogpushJobsApiV1) GetLogpushOwnershipWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -773,6 +799,7 @@ func (logpushJobsApi *LogpushJobsApiV1) ValidateLogpushOwnershipChallengeWithCon
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -830,6 +857,7 @@ func (logpushJobsApi *LogpushJobsApiV1) GetLogpushJobsV2WithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -895,6 +923,7 @@ func (logpushJobsApi *LogpushJobsApiV1) CreateLogpushJobV2WithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -957,6 +986,7 @@ func (logpushJobsApi *LogpushJobsApiV1) GetLogpushJobV2WithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1027,6 +1057,7 @@ func (logpushJobsApi *LogpushJobsApiV1) UpdateLogpushJobV2WithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1089,6 +1120,7 @@ func (logpushJobsApi *LogpushJobsApiV1) DeleteLogpushJobV2WithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1156,6 +1188,7 @@ func (logpushJobsApi *LogpushJobsApiV1) GetLogpushOwnershipV2WithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1226,6 +1259,7 @@ func (logpushJobsApi *LogpushJobsApiV1) ValidateLogpushOwnershipChallengeV2WithC
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1284,6 +1318,7 @@ func (logpushJobsApi *LogpushJobsApiV1) ListFieldsForDatasetV2WithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1342,6 +1377,7 @@ func (logpushJobsApi *LogpushJobsApiV1) ListLogpushJobsForDatasetV2WithContext(c
 	var rawResponse map[string]json.RawMessage
 	response, err = logpushJobsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,67 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logpushjobsapiv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// LogpushJobsApiV1API is the interface of the operations of LogpushJobsApiV1, through which they can be replaced
+// in unit tests, for instance with the mocks.LogpushJobsApiV1 of the mocks package.
+type LogpushJobsApiV1API interface {
+	GetLogpushJobs(getLogpushJobsOptions *GetLogpushJobsOptions) (result *ListLogpushJobsResp, response *core.DetailedResponse, err error)
+	GetLogpushJobsWithContext(ctx context.Context, getLogpushJobsOptions *GetLogpushJobsOptions) (result *ListLogpushJobsResp, response *core.DetailedResponse, err error)
+	CreateLogpushJob(createLogpushJobOptions *CreateLogpushJobOptions) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	CreateLogpushJobWithContext(ctx context.Context, createLogpushJobOptions *CreateLogpushJobOptions) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	GetLogpushJob(getLogpushJobOptions *GetLogpushJobOptions) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	GetLogpushJobWithContext(ctx context.Context, getLogpushJobOptions *GetLogpushJobOptions) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	UpdateLogpushJob(updateLogpushJobOptions *UpdateLogpushJobOptions) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	UpdateLogpushJobWithContext(ctx context.Context, updateLogpushJobOptions *UpdateLogpushJobOptions) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	DeleteLogpushJob(deleteLogpushJobOptions *DeleteLogpushJobOptions) (result *DeleteLogpushJobResp, response *core.DetailedResponse, err error)
+	DeleteLogpushJobWithContext(ctx context.Context, deleteLogpushJobOptions *DeleteLogpushJobOptions) (result *DeleteLogpushJobResp, response *core.DetailedResponse, err error)
+	ListFieldsForDataset(listFieldsForDatasetOptions *ListFieldsForDatasetOptions) (result *ListFieldsResp, response *core.DetailedResponse, err error)
+	ListFieldsForDatasetWithContext(ctx context.Context, listFieldsForDatasetOptions *ListFieldsForDatasetOptions) (result *ListFieldsResp, response *core.DetailedResponse, err error)
+	ListLogpushJobsForDataset(listLogpushJobsForDatasetOptions *ListLogpushJobsForDatasetOptions) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	ListLogpushJobsForDatasetWithContext(ctx context.Context, listLogpushJobsForDatasetOptions *ListLogpushJobsForDatasetOptions) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	GetLogpushOwnership(getLogpushOwnershipOptions *GetLogpushOwnershipOptions) (result *OwnershipChallengeResp, response *core.DetailedResponse, err error)
+	GetLogpushOwnershipWithContext(ctx context.Context, getLogpushOwnershipOptions *GetLogpushOwnershipOptions) (result *OwnershipChallengeResp, response *core.DetailedResponse, err error)
+	ValidateLogpushOwnershipChallenge(validateLogpushOwnershipChallengeOptions *ValidateLogpushOwnershipChallengeOptions) (result *OwnershipChallengeValidateResult, response *core.DetailedResponse, err error)
+	ValidateLogpushOwnershipChallengeWithContext(ctx context.Context, validateLogpushOwnershipChallengeOptions *ValidateLogpushOwnershipChallengeOptions) (result *OwnershipChallengeValidateResult, response *core.DetailedResponse, err error)
+	GetLogpushJobsV2(getLogpushJobsV2Options *GetLogpushJobsV2Options) (result *ListLogpushJobsResp, response *core.DetailedResponse, err error)
+	GetLogpushJobsV2WithContext(ctx context.Context, getLogpushJobsV2Options *GetLogpushJobsV2Options) (result *ListLogpushJobsResp, response *core.DetailedResponse, err error)
+	CreateLogpushJobV2(createLogpushJobV2Options *CreateLogpushJobV2Options) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	CreateLogpushJobV2WithContext(ctx context.Context, createLogpushJobV2Options *CreateLogpushJobV2Options) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	GetLogpushJobV2(getLogpushJobV2Options *GetLogpushJobV2Options) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	GetLogpushJobV2WithContext(ctx context.Context, getLogpushJobV2Options *GetLogpushJobV2Options) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	UpdateLogpushJobV2(updateLogpushJobV2Options *UpdateLogpushJobV2Options) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	UpdateLogpushJobV2WithContext(ctx context.Context, updateLogpushJobV2Options *UpdateLogpushJobV2Options) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	DeleteLogpushJobV2(deleteLogpushJobV2Options *DeleteLogpushJobV2Options) (result *DeleteLogpushJobResp, response *core.DetailedResponse, err error)
+	DeleteLogpushJobV2WithContext(ctx context.Context, deleteLogpushJobV2Options *DeleteLogpushJobV2Options) (result *DeleteLogpushJobResp, response *core.DetailedResponse, err error)
+	GetLogpushOwnershipV2(getLogpushOwnershipV2Options *GetLogpushOwnershipV2Options) (result *OwnershipChallengeResp, response *core.DetailedResponse, err error)
+	GetLogpushOwnershipV2WithContext(ctx context.Context, getLogpushOwnershipV2Options *GetLogpushOwnershipV2Options) (result *OwnershipChallengeResp, response *core.DetailedResponse, err error)
+	ValidateLogpushOwnershipChallengeV2(validateLogpushOwnershipChallengeV2Options *ValidateLogpushOwnershipChallengeV2Options) (result *OwnershipChallengeValidateResult, response *core.DetailedResponse, err error)
+	ValidateLogpushOwnershipChallengeV2WithContext(ctx context.Context, validateLogpushOwnershipChallengeV2Options *ValidateLogpushOwnershipChallengeV2Options) (result *OwnershipChallengeValidateResult, response *core.DetailedResponse, err error)
+	ListFieldsForDatasetV2(listFieldsForDatasetV2Options *ListFieldsForDatasetV2Options) (result *ListFieldsResp, response *core.DetailedResponse, err error)
+	ListFieldsForDatasetV2WithContext(ctx context.Context, listFieldsForDatasetV2Options *ListFieldsForDatasetV2Options) (result *ListFieldsResp, response *core.DetailedResponse, err error)
+	ListLogpushJobsForDatasetV2(listLogpushJobsForDatasetV2Options *ListLogpushJobsForDatasetV2Options) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+	ListLogpushJobsForDatasetV2WithContext(ctx context.Context, listLogpushJobsForDatasetV2Options *ListLogpushJobsForDatasetV2Options) (result *LogpushJobsResp, response *core.DetailedResponse, err error)
+}
+
+// LogpushJobsApiV1 implements LogpushJobsApiV1API.
+var _ LogpushJobsApiV1API = (*LogpushJobsApiV1)(nil)
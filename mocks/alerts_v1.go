@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/alertsv1"
+)
+
+// AlertsV1 is a mock of alertsv1.AlertsV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type AlertsV1 struct {
+	Mock
+
+	GetAlertPoliciesFunc             func(*alertsv1.GetAlertPoliciesOptions) (*alertsv1.ListAlertPoliciesResp, *core.DetailedResponse, error)
+	GetAlertPoliciesWithContextFunc  func(context.Context, *alertsv1.GetAlertPoliciesOptions) (*alertsv1.ListAlertPoliciesResp, *core.DetailedResponse, error)
+	CreateAlertPolicyFunc            func(*alertsv1.CreateAlertPolicyOptions) (*alertsv1.AlertSuccessResp, *core.DetailedResponse, error)
+	CreateAlertPolicyWithContextFunc func(context.Context, *alertsv1.CreateAlertPolicyOptions) (*alertsv1.AlertSuccessResp, *core.DetailedResponse, error)
+	GetAlertPolicyFunc               func(*alertsv1.GetAlertPolicyOptions) (*alertsv1.GetAlertPolicyResp, *core.DetailedResponse, error)
+	GetAlertPolicyWithContextFunc    func(context.Context, *alertsv1.GetAlertPolicyOptions) (*alertsv1.GetAlertPolicyResp, *core.DetailedResponse, error)
+	UpdateAlertPolicyFunc            func(*alertsv1.UpdateAlertPolicyOptions) (*alertsv1.AlertSuccessResp, *core.DetailedResponse, error)
+	UpdateAlertPolicyWithContextFunc func(context.Context, *alertsv1.UpdateAlertPolicyOptions) (*alertsv1.AlertSuccessResp, *core.DetailedResponse, error)
+	DeleteAlertPolicyFunc            func(*alertsv1.DeleteAlertPolicyOptions) (*alertsv1.AlertSuccessResp, *core.DetailedResponse, error)
+	DeleteAlertPolicyWithContextFunc func(context.Context, *alertsv1.DeleteAlertPolicyOptions) (*alertsv1.AlertSuccessResp, *core.DetailedResponse, error)
+}
+
+// AlertsV1 implements alertsv1.AlertsV1API.
+var _ alertsv1.AlertsV1API = (*AlertsV1)(nil)
+
+// GetAlertPolicies invokes the programmed GetAlertPoliciesFunc.
+func (mock *AlertsV1) GetAlertPolicies(getAlertPoliciesOptions *alertsv1.GetAlertPoliciesOptions) (result *alertsv1.ListAlertPoliciesResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAlertPolicies", getAlertPoliciesOptions)
+	if mock.GetAlertPoliciesFunc != nil {
+		return mock.GetAlertPoliciesFunc(getAlertPoliciesOptions)
+	}
+	if mock.GetAlertPoliciesWithContextFunc != nil {
+		return mock.GetAlertPoliciesWithContextFunc(context.Background(), getAlertPoliciesOptions)
+	}
+	err = notProgrammed("GetAlertPolicies")
+	return
+}
+
+// GetAlertPoliciesWithContext invokes the programmed GetAlertPoliciesWithContextFunc.
+func (mock *AlertsV1) GetAlertPoliciesWithContext(ctx context.Context, getAlertPoliciesOptions *alertsv1.GetAlertPoliciesOptions) (result *alertsv1.ListAlertPoliciesResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAlertPoliciesWithContext", ctx, getAlertPoliciesOptions)
+	if mock.GetAlertPoliciesWithContextFunc != nil {
+		return mock.GetAlertPoliciesWithContextFunc(ctx, getAlertPoliciesOptions)
+	}
+	if mock.GetAlertPoliciesFunc != nil {
+		return mock.GetAlertPoliciesFunc(getAlertPoliciesOptions)
+	}
+	err = notProgrammed("GetAlertPoliciesWithContext")
+	return
+}
+
+// CreateAlertPolicy invokes the programmed CreateAlertPolicyFunc.
+func (mock *AlertsV1) CreateAlertPolicy(createAlertPolicyOptions *alertsv1.CreateAlertPolicyOptions) (result *alertsv1.AlertSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAlertPolicy", createAlertPolicyOptions)
+	if mock.CreateAlertPolicyFunc != nil {
+		return mock.CreateAlertPolicyFunc(createAlertPolicyOptions)
+	}
+	if mock.CreateAlertPolicyWithContextFunc != nil {
+		return mock.CreateAlertPolicyWithContextFunc(context.Background(), createAlertPolicyOptions)
+	}
+	err = notProgrammed("CreateAlertPolicy")
+	return
+}
+
+// CreateAlertPolicyWithContext invokes the programmed CreateAlertPolicyWithContextFunc.
+func (mock *AlertsV1) CreateAlertPolicyWithContext(ctx context.Context, createAlertPolicyOptions *alertsv1.CreateAlertPolicyOptions) (result *alertsv1.AlertSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAlertPolicyWithContext", ctx, createAlertPolicyOptions)
+	if mock.CreateAlertPolicyWithContextFunc != nil {
+		return mock.CreateAlertPolicyWithContextFunc(ctx, createAlertPolicyOptions)
+	}
+	if mock.CreateAlertPolicyFunc != nil {
+		return mock.CreateAlertPolicyFunc(createAlertPolicyOptions)
+	}
+	err = notProgrammed("CreateAlertPolicyWithContext")
+	return
+}
+
+// GetAlertPolicy invokes the programmed GetAlertPolicyFunc.
+func (mock *AlertsV1) GetAlertPolicy(getAlertPolicyOptions *alertsv1.GetAlertPolicyOptions) (result *alertsv1.GetAlertPolicyResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAlertPolicy", getAlertPolicyOptions)
+	if mock.GetAlertPolicyFunc != nil {
+		return mock.GetAlertPolicyFunc(getAlertPolicyOptions)
+	}
+	if mock.GetAlertPolicyWithContextFunc != nil {
+		return mock.GetAlertPolicyWithContextFunc(context.Background(), getAlertPolicyOptions)
+	}
+	err = notProgrammed("GetAlertPolicy")
+	return
+}
+
+// GetAlertPolicyWithContext invokes the programmed GetAlertPolicyWithContextFunc.
+func (mock *AlertsV1) GetAlertPolicyWithContext(ctx context.Context, getAlertPolicyOptions *alertsv1.GetAlertPolicyOptions) (result *alertsv1.GetAlertPolicyResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAlertPolicyWithContext", ctx, getAlertPolicyOptions)
+	if mock.GetAlertPolicyWithContextFunc != nil {
+		return mock.GetAlertPolicyWithContextFunc(ctx, getAlertPolicyOptions)
+	}
+	if mock.GetAlertPolicyFunc != nil {
+		return mock.GetAlertPolicyFunc(getAlertPolicyOptions)
+	}
+	err = notProgrammed("GetAlertPolicyWithContext")
+	return
+}
+
+// UpdateAlertPolicy invokes the programmed UpdateAlertPolicyFunc.
+func (mock *AlertsV1) UpdateAlertPolicy(updateAlertPolicyOptions *alertsv1.UpdateAlertPolicyOptions) (result *alertsv1.AlertSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAlertPolicy", updateAlertPolicyOptions)
+	if mock.UpdateAlertPolicyFunc != nil {
+		return mock.UpdateAlertPolicyFunc(updateAlertPolicyOptions)
+	}
+	if mock.UpdateAlertPolicyWithContextFunc != nil {
+		return mock.UpdateAlertPolicyWithContextFunc(context.Background(), updateAlertPolicyOptions)
+	}
+	err = notProgrammed("UpdateAlertPolicy")
+	return
+}
+
+// UpdateAlertPolicyWithContext invokes the programmed UpdateAlertPolicyWithContextFunc.
+func (mock *AlertsV1) UpdateAlertPolicyWithContext(ctx context.Context, updateAlertPolicyOptions *alertsv1.UpdateAlertPolicyOptions) (result *alertsv1.AlertSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAlertPolicyWithContext", ctx, updateAlertPolicyOptions)
+	if mock.UpdateAlertPolicyWithContextFunc != nil {
+		return mock.UpdateAlertPolicyWithContextFunc(ctx, updateAlertPolicyOptions)
+	}
+	if mock.UpdateAlertPolicyFunc != nil {
+		return mock.UpdateAlertPolicyFunc(updateAlertPolicyOptions)
+	}
+	err = notProgrammed("UpdateAlertPolicyWithContext")
+	return
+}
+
+// DeleteAlertPolicy invokes the programmed DeleteAlertPolicyFunc.
+func (mock *AlertsV1) DeleteAlertPolicy(deleteAlertPolicyOptions *alertsv1.DeleteAlertPolicyOptions) (result *alertsv1.AlertSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteAlertPolicy", deleteAlertPolicyOptions)
+	if mock.DeleteAlertPolicyFunc != nil {
+		return mock.DeleteAlertPolicyFunc(deleteAlertPolicyOptions)
+	}
+	if mock.DeleteAlertPolicyWithContextFunc != nil {
+		return mock.DeleteAlertPolicyWithContextFunc(context.Background(), deleteAlertPolicyOptions)
+	}
+	err = notProgrammed("DeleteAlertPolicy")
+	return
+}
+
+// DeleteAlertPolicyWithContext invokes the programmed DeleteAlertPolicyWithContextFunc.
+func (mock *AlertsV1) DeleteAlertPolicyWithContext(ctx context.Context, deleteAlertPolicyOptions *alertsv1.DeleteAlertPolicyOptions) (result *alertsv1.AlertSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteAlertPolicyWithContext", ctx, deleteAlertPolicyOptions)
+	if mock.DeleteAlertPolicyWithContextFunc != nil {
+		return mock.DeleteAlertPolicyWithContextFunc(ctx, deleteAlertPolicyOptions)
+	}
+	if mock.DeleteAlertPolicyFunc != nil {
+		return mock.DeleteAlertPolicyFunc(deleteAlertPolicyOptions)
+	}
+	err = notProgrammed("DeleteAlertPolicyWithContext")
+	return
+}
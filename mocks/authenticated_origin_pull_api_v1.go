@@ -0,0 +1,342 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/authenticatedoriginpullapiv1"
+)
+
+// AuthenticatedOriginPullApiV1 is a mock of authenticatedoriginpullapiv1.AuthenticatedOriginPullApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type AuthenticatedOriginPullApiV1 struct {
+	Mock
+
+	GetZoneOriginPullSettingsFunc                      func(*authenticatedoriginpullapiv1.GetZoneOriginPullSettingsOptions) (*authenticatedoriginpullapiv1.GetZoneOriginPullSettingsResp, *core.DetailedResponse, error)
+	GetZoneOriginPullSettingsWithContextFunc           func(context.Context, *authenticatedoriginpullapiv1.GetZoneOriginPullSettingsOptions) (*authenticatedoriginpullapiv1.GetZoneOriginPullSettingsResp, *core.DetailedResponse, error)
+	SetZoneOriginPullSettingsFunc                      func(*authenticatedoriginpullapiv1.SetZoneOriginPullSettingsOptions) (*authenticatedoriginpullapiv1.GetZoneOriginPullSettingsResp, *core.DetailedResponse, error)
+	SetZoneOriginPullSettingsWithContextFunc           func(context.Context, *authenticatedoriginpullapiv1.SetZoneOriginPullSettingsOptions) (*authenticatedoriginpullapiv1.GetZoneOriginPullSettingsResp, *core.DetailedResponse, error)
+	ListZoneOriginPullCertificatesFunc                 func(*authenticatedoriginpullapiv1.ListZoneOriginPullCertificatesOptions) (*authenticatedoriginpullapiv1.ListZoneOriginPullCertificatesResp, *core.DetailedResponse, error)
+	ListZoneOriginPullCertificatesWithContextFunc      func(context.Context, *authenticatedoriginpullapiv1.ListZoneOriginPullCertificatesOptions) (*authenticatedoriginpullapiv1.ListZoneOriginPullCertificatesResp, *core.DetailedResponse, error)
+	UploadZoneOriginPullCertificateFunc                func(*authenticatedoriginpullapiv1.UploadZoneOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, *core.DetailedResponse, error)
+	UploadZoneOriginPullCertificateWithContextFunc     func(context.Context, *authenticatedoriginpullapiv1.UploadZoneOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, *core.DetailedResponse, error)
+	GetZoneOriginPullCertificateFunc                   func(*authenticatedoriginpullapiv1.GetZoneOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, *core.DetailedResponse, error)
+	GetZoneOriginPullCertificateWithContextFunc        func(context.Context, *authenticatedoriginpullapiv1.GetZoneOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, *core.DetailedResponse, error)
+	DeleteZoneOriginPullCertificateFunc                func(*authenticatedoriginpullapiv1.DeleteZoneOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, *core.DetailedResponse, error)
+	DeleteZoneOriginPullCertificateWithContextFunc     func(context.Context, *authenticatedoriginpullapiv1.DeleteZoneOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, *core.DetailedResponse, error)
+	SetHostnameOriginPullSettingsFunc                  func(*authenticatedoriginpullapiv1.SetHostnameOriginPullSettingsOptions) (*authenticatedoriginpullapiv1.ListHostnameOriginPullSettingsResp, *core.DetailedResponse, error)
+	SetHostnameOriginPullSettingsWithContextFunc       func(context.Context, *authenticatedoriginpullapiv1.SetHostnameOriginPullSettingsOptions) (*authenticatedoriginpullapiv1.ListHostnameOriginPullSettingsResp, *core.DetailedResponse, error)
+	GetHostnameOriginPullSettingsFunc                  func(*authenticatedoriginpullapiv1.GetHostnameOriginPullSettingsOptions) (*authenticatedoriginpullapiv1.GetHostnameOriginPullSettingsResp, *core.DetailedResponse, error)
+	GetHostnameOriginPullSettingsWithContextFunc       func(context.Context, *authenticatedoriginpullapiv1.GetHostnameOriginPullSettingsOptions) (*authenticatedoriginpullapiv1.GetHostnameOriginPullSettingsResp, *core.DetailedResponse, error)
+	UploadHostnameOriginPullCertificateFunc            func(*authenticatedoriginpullapiv1.UploadHostnameOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, *core.DetailedResponse, error)
+	UploadHostnameOriginPullCertificateWithContextFunc func(context.Context, *authenticatedoriginpullapiv1.UploadHostnameOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, *core.DetailedResponse, error)
+	GetHostnameOriginPullCertificateFunc               func(*authenticatedoriginpullapiv1.GetHostnameOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, *core.DetailedResponse, error)
+	GetHostnameOriginPullCertificateWithContextFunc    func(context.Context, *authenticatedoriginpullapiv1.GetHostnameOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, *core.DetailedResponse, error)
+	DeleteHostnameOriginPullCertificateFunc            func(*authenticatedoriginpullapiv1.DeleteHostnameOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, *core.DetailedResponse, error)
+	DeleteHostnameOriginPullCertificateWithContextFunc func(context.Context, *authenticatedoriginpullapiv1.DeleteHostnameOriginPullCertificateOptions) (*authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, *core.DetailedResponse, error)
+}
+
+// AuthenticatedOriginPullApiV1 implements authenticatedoriginpullapiv1.AuthenticatedOriginPullApiV1API.
+var _ authenticatedoriginpullapiv1.AuthenticatedOriginPullApiV1API = (*AuthenticatedOriginPullApiV1)(nil)
+
+// GetZoneOriginPullSettings invokes the programmed GetZoneOriginPullSettingsFunc.
+func (mock *AuthenticatedOriginPullApiV1) GetZoneOriginPullSettings(getZoneOriginPullSettingsOptions *authenticatedoriginpullapiv1.GetZoneOriginPullSettingsOptions) (result *authenticatedoriginpullapiv1.GetZoneOriginPullSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneOriginPullSettings", getZoneOriginPullSettingsOptions)
+	if mock.GetZoneOriginPullSettingsFunc != nil {
+		return mock.GetZoneOriginPullSettingsFunc(getZoneOriginPullSettingsOptions)
+	}
+	if mock.GetZoneOriginPullSettingsWithContextFunc != nil {
+		return mock.GetZoneOriginPullSettingsWithContextFunc(context.Background(), getZoneOriginPullSettingsOptions)
+	}
+	err = notProgrammed("GetZoneOriginPullSettings")
+	return
+}
+
+// GetZoneOriginPullSettingsWithContext invokes the programmed GetZoneOriginPullSettingsWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) GetZoneOriginPullSettingsWithContext(ctx context.Context, getZoneOriginPullSettingsOptions *authenticatedoriginpullapiv1.GetZoneOriginPullSettingsOptions) (result *authenticatedoriginpullapiv1.GetZoneOriginPullSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneOriginPullSettingsWithContext", ctx, getZoneOriginPullSettingsOptions)
+	if mock.GetZoneOriginPullSettingsWithContextFunc != nil {
+		return mock.GetZoneOriginPullSettingsWithContextFunc(ctx, getZoneOriginPullSettingsOptions)
+	}
+	if mock.GetZoneOriginPullSettingsFunc != nil {
+		return mock.GetZoneOriginPullSettingsFunc(getZoneOriginPullSettingsOptions)
+	}
+	err = notProgrammed("GetZoneOriginPullSettingsWithContext")
+	return
+}
+
+// SetZoneOriginPullSettings invokes the programmed SetZoneOriginPullSettingsFunc.
+func (mock *AuthenticatedOriginPullApiV1) SetZoneOriginPullSettings(setZoneOriginPullSettingsOptions *authenticatedoriginpullapiv1.SetZoneOriginPullSettingsOptions) (result *authenticatedoriginpullapiv1.GetZoneOriginPullSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("SetZoneOriginPullSettings", setZoneOriginPullSettingsOptions)
+	if mock.SetZoneOriginPullSettingsFunc != nil {
+		return mock.SetZoneOriginPullSettingsFunc(setZoneOriginPullSettingsOptions)
+	}
+	if mock.SetZoneOriginPullSettingsWithContextFunc != nil {
+		return mock.SetZoneOriginPullSettingsWithContextFunc(context.Background(), setZoneOriginPullSettingsOptions)
+	}
+	err = notProgrammed("SetZoneOriginPullSettings")
+	return
+}
+
+// SetZoneOriginPullSettingsWithContext invokes the programmed SetZoneOriginPullSettingsWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) SetZoneOriginPullSettingsWithContext(ctx context.Context, setZoneOriginPullSettingsOptions *authenticatedoriginpullapiv1.SetZoneOriginPullSettingsOptions) (result *authenticatedoriginpullapiv1.GetZoneOriginPullSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("SetZoneOriginPullSettingsWithContext", ctx, setZoneOriginPullSettingsOptions)
+	if mock.SetZoneOriginPullSettingsWithContextFunc != nil {
+		return mock.SetZoneOriginPullSettingsWithContextFunc(ctx, setZoneOriginPullSettingsOptions)
+	}
+	if mock.SetZoneOriginPullSettingsFunc != nil {
+		return mock.SetZoneOriginPullSettingsFunc(setZoneOriginPullSettingsOptions)
+	}
+	err = notProgrammed("SetZoneOriginPullSettingsWithContext")
+	return
+}
+
+// ListZoneOriginPullCertificates invokes the programmed ListZoneOriginPullCertificatesFunc.
+func (mock *AuthenticatedOriginPullApiV1) ListZoneOriginPullCertificates(listZoneOriginPullCertificatesOptions *authenticatedoriginpullapiv1.ListZoneOriginPullCertificatesOptions) (result *authenticatedoriginpullapiv1.ListZoneOriginPullCertificatesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListZoneOriginPullCertificates", listZoneOriginPullCertificatesOptions)
+	if mock.ListZoneOriginPullCertificatesFunc != nil {
+		return mock.ListZoneOriginPullCertificatesFunc(listZoneOriginPullCertificatesOptions)
+	}
+	if mock.ListZoneOriginPullCertificatesWithContextFunc != nil {
+		return mock.ListZoneOriginPullCertificatesWithContextFunc(context.Background(), listZoneOriginPullCertificatesOptions)
+	}
+	err = notProgrammed("ListZoneOriginPullCertificates")
+	return
+}
+
+// ListZoneOriginPullCertificatesWithContext invokes the programmed ListZoneOriginPullCertificatesWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) ListZoneOriginPullCertificatesWithContext(ctx context.Context, listZoneOriginPullCertificatesOptions *authenticatedoriginpullapiv1.ListZoneOriginPullCertificatesOptions) (result *authenticatedoriginpullapiv1.ListZoneOriginPullCertificatesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListZoneOriginPullCertificatesWithContext", ctx, listZoneOriginPullCertificatesOptions)
+	if mock.ListZoneOriginPullCertificatesWithContextFunc != nil {
+		return mock.ListZoneOriginPullCertificatesWithContextFunc(ctx, listZoneOriginPullCertificatesOptions)
+	}
+	if mock.ListZoneOriginPullCertificatesFunc != nil {
+		return mock.ListZoneOriginPullCertificatesFunc(listZoneOriginPullCertificatesOptions)
+	}
+	err = notProgrammed("ListZoneOriginPullCertificatesWithContext")
+	return
+}
+
+// UploadZoneOriginPullCertificate invokes the programmed UploadZoneOriginPullCertificateFunc.
+func (mock *AuthenticatedOriginPullApiV1) UploadZoneOriginPullCertificate(uploadZoneOriginPullCertificateOptions *authenticatedoriginpullapiv1.UploadZoneOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("UploadZoneOriginPullCertificate", uploadZoneOriginPullCertificateOptions)
+	if mock.UploadZoneOriginPullCertificateFunc != nil {
+		return mock.UploadZoneOriginPullCertificateFunc(uploadZoneOriginPullCertificateOptions)
+	}
+	if mock.UploadZoneOriginPullCertificateWithContextFunc != nil {
+		return mock.UploadZoneOriginPullCertificateWithContextFunc(context.Background(), uploadZoneOriginPullCertificateOptions)
+	}
+	err = notProgrammed("UploadZoneOriginPullCertificate")
+	return
+}
+
+// UploadZoneOriginPullCertificateWithContext invokes the programmed UploadZoneOriginPullCertificateWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) UploadZoneOriginPullCertificateWithContext(ctx context.Context, uploadZoneOriginPullCertificateOptions *authenticatedoriginpullapiv1.UploadZoneOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("UploadZoneOriginPullCertificateWithContext", ctx, uploadZoneOriginPullCertificateOptions)
+	if mock.UploadZoneOriginPullCertificateWithContextFunc != nil {
+		return mock.UploadZoneOriginPullCertificateWithContextFunc(ctx, uploadZoneOriginPullCertificateOptions)
+	}
+	if mock.UploadZoneOriginPullCertificateFunc != nil {
+		return mock.UploadZoneOriginPullCertificateFunc(uploadZoneOriginPullCertificateOptions)
+	}
+	err = notProgrammed("UploadZoneOriginPullCertificateWithContext")
+	return
+}
+
+// GetZoneOriginPullCertificate invokes the programmed GetZoneOriginPullCertificateFunc.
+func (mock *AuthenticatedOriginPullApiV1) GetZoneOriginPullCertificate(getZoneOriginPullCertificateOptions *authenticatedoriginpullapiv1.GetZoneOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneOriginPullCertificate", getZoneOriginPullCertificateOptions)
+	if mock.GetZoneOriginPullCertificateFunc != nil {
+		return mock.GetZoneOriginPullCertificateFunc(getZoneOriginPullCertificateOptions)
+	}
+	if mock.GetZoneOriginPullCertificateWithContextFunc != nil {
+		return mock.GetZoneOriginPullCertificateWithContextFunc(context.Background(), getZoneOriginPullCertificateOptions)
+	}
+	err = notProgrammed("GetZoneOriginPullCertificate")
+	return
+}
+
+// GetZoneOriginPullCertificateWithContext invokes the programmed GetZoneOriginPullCertificateWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) GetZoneOriginPullCertificateWithContext(ctx context.Context, getZoneOriginPullCertificateOptions *authenticatedoriginpullapiv1.GetZoneOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneOriginPullCertificateWithContext", ctx, getZoneOriginPullCertificateOptions)
+	if mock.GetZoneOriginPullCertificateWithContextFunc != nil {
+		return mock.GetZoneOriginPullCertificateWithContextFunc(ctx, getZoneOriginPullCertificateOptions)
+	}
+	if mock.GetZoneOriginPullCertificateFunc != nil {
+		return mock.GetZoneOriginPullCertificateFunc(getZoneOriginPullCertificateOptions)
+	}
+	err = notProgrammed("GetZoneOriginPullCertificateWithContext")
+	return
+}
+
+// DeleteZoneOriginPullCertificate invokes the programmed DeleteZoneOriginPullCertificateFunc.
+func (mock *AuthenticatedOriginPullApiV1) DeleteZoneOriginPullCertificate(deleteZoneOriginPullCertificateOptions *authenticatedoriginpullapiv1.DeleteZoneOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneOriginPullCertificate", deleteZoneOriginPullCertificateOptions)
+	if mock.DeleteZoneOriginPullCertificateFunc != nil {
+		return mock.DeleteZoneOriginPullCertificateFunc(deleteZoneOriginPullCertificateOptions)
+	}
+	if mock.DeleteZoneOriginPullCertificateWithContextFunc != nil {
+		return mock.DeleteZoneOriginPullCertificateWithContextFunc(context.Background(), deleteZoneOriginPullCertificateOptions)
+	}
+	err = notProgrammed("DeleteZoneOriginPullCertificate")
+	return
+}
+
+// DeleteZoneOriginPullCertificateWithContext invokes the programmed DeleteZoneOriginPullCertificateWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) DeleteZoneOriginPullCertificateWithContext(ctx context.Context, deleteZoneOriginPullCertificateOptions *authenticatedoriginpullapiv1.DeleteZoneOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.ZoneOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneOriginPullCertificateWithContext", ctx, deleteZoneOriginPullCertificateOptions)
+	if mock.DeleteZoneOriginPullCertificateWithContextFunc != nil {
+		return mock.DeleteZoneOriginPullCertificateWithContextFunc(ctx, deleteZoneOriginPullCertificateOptions)
+	}
+	if mock.DeleteZoneOriginPullCertificateFunc != nil {
+		return mock.DeleteZoneOriginPullCertificateFunc(deleteZoneOriginPullCertificateOptions)
+	}
+	err = notProgrammed("DeleteZoneOriginPullCertificateWithContext")
+	return
+}
+
+// SetHostnameOriginPullSettings invokes the programmed SetHostnameOriginPullSettingsFunc.
+func (mock *AuthenticatedOriginPullApiV1) SetHostnameOriginPullSettings(setHostnameOriginPullSettingsOptions *authenticatedoriginpullapiv1.SetHostnameOriginPullSettingsOptions) (result *authenticatedoriginpullapiv1.ListHostnameOriginPullSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("SetHostnameOriginPullSettings", setHostnameOriginPullSettingsOptions)
+	if mock.SetHostnameOriginPullSettingsFunc != nil {
+		return mock.SetHostnameOriginPullSettingsFunc(setHostnameOriginPullSettingsOptions)
+	}
+	if mock.SetHostnameOriginPullSettingsWithContextFunc != nil {
+		return mock.SetHostnameOriginPullSettingsWithContextFunc(context.Background(), setHostnameOriginPullSettingsOptions)
+	}
+	err = notProgrammed("SetHostnameOriginPullSettings")
+	return
+}
+
+// SetHostnameOriginPullSettingsWithContext invokes the programmed SetHostnameOriginPullSettingsWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) SetHostnameOriginPullSettingsWithContext(ctx context.Context, setHostnameOriginPullSettingsOptions *authenticatedoriginpullapiv1.SetHostnameOriginPullSettingsOptions) (result *authenticatedoriginpullapiv1.ListHostnameOriginPullSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("SetHostnameOriginPullSettingsWithContext", ctx, setHostnameOriginPullSettingsOptions)
+	if mock.SetHostnameOriginPullSettingsWithContextFunc != nil {
+		return mock.SetHostnameOriginPullSettingsWithContextFunc(ctx, setHostnameOriginPullSettingsOptions)
+	}
+	if mock.SetHostnameOriginPullSettingsFunc != nil {
+		return mock.SetHostnameOriginPullSettingsFunc(setHostnameOriginPullSettingsOptions)
+	}
+	err = notProgrammed("SetHostnameOriginPullSettingsWithContext")
+	return
+}
+
+// GetHostnameOriginPullSettings invokes the programmed GetHostnameOriginPullSettingsFunc.
+func (mock *AuthenticatedOriginPullApiV1) GetHostnameOriginPullSettings(getHostnameOriginPullSettingsOptions *authenticatedoriginpullapiv1.GetHostnameOriginPullSettingsOptions) (result *authenticatedoriginpullapiv1.GetHostnameOriginPullSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetHostnameOriginPullSettings", getHostnameOriginPullSettingsOptions)
+	if mock.GetHostnameOriginPullSettingsFunc != nil {
+		return mock.GetHostnameOriginPullSettingsFunc(getHostnameOriginPullSettingsOptions)
+	}
+	if mock.GetHostnameOriginPullSettingsWithContextFunc != nil {
+		return mock.GetHostnameOriginPullSettingsWithContextFunc(context.Background(), getHostnameOriginPullSettingsOptions)
+	}
+	err = notProgrammed("GetHostnameOriginPullSettings")
+	return
+}
+
+// GetHostnameOriginPullSettingsWithContext invokes the programmed GetHostnameOriginPullSettingsWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) GetHostnameOriginPullSettingsWithContext(ctx context.Context, getHostnameOriginPullSettingsOptions *authenticatedoriginpullapiv1.GetHostnameOriginPullSettingsOptions) (result *authenticatedoriginpullapiv1.GetHostnameOriginPullSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetHostnameOriginPullSettingsWithContext", ctx, getHostnameOriginPullSettingsOptions)
+	if mock.GetHostnameOriginPullSettingsWithContextFunc != nil {
+		return mock.GetHostnameOriginPullSettingsWithContextFunc(ctx, getHostnameOriginPullSettingsOptions)
+	}
+	if mock.GetHostnameOriginPullSettingsFunc != nil {
+		return mock.GetHostnameOriginPullSettingsFunc(getHostnameOriginPullSettingsOptions)
+	}
+	err = notProgrammed("GetHostnameOriginPullSettingsWithContext")
+	return
+}
+
+// UploadHostnameOriginPullCertificate invokes the programmed UploadHostnameOriginPullCertificateFunc.
+func (mock *AuthenticatedOriginPullApiV1) UploadHostnameOriginPullCertificate(uploadHostnameOriginPullCertificateOptions *authenticatedoriginpullapiv1.UploadHostnameOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("UploadHostnameOriginPullCertificate", uploadHostnameOriginPullCertificateOptions)
+	if mock.UploadHostnameOriginPullCertificateFunc != nil {
+		return mock.UploadHostnameOriginPullCertificateFunc(uploadHostnameOriginPullCertificateOptions)
+	}
+	if mock.UploadHostnameOriginPullCertificateWithContextFunc != nil {
+		return mock.UploadHostnameOriginPullCertificateWithContextFunc(context.Background(), uploadHostnameOriginPullCertificateOptions)
+	}
+	err = notProgrammed("UploadHostnameOriginPullCertificate")
+	return
+}
+
+// UploadHostnameOriginPullCertificateWithContext invokes the programmed UploadHostnameOriginPullCertificateWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) UploadHostnameOriginPullCertificateWithContext(ctx context.Context, uploadHostnameOriginPullCertificateOptions *authenticatedoriginpullapiv1.UploadHostnameOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("UploadHostnameOriginPullCertificateWithContext", ctx, uploadHostnameOriginPullCertificateOptions)
+	if mock.UploadHostnameOriginPullCertificateWithContextFunc != nil {
+		return mock.UploadHostnameOriginPullCertificateWithContextFunc(ctx, uploadHostnameOriginPullCertificateOptions)
+	}
+	if mock.UploadHostnameOriginPullCertificateFunc != nil {
+		return mock.UploadHostnameOriginPullCertificateFunc(uploadHostnameOriginPullCertificateOptions)
+	}
+	err = notProgrammed("UploadHostnameOriginPullCertificateWithContext")
+	return
+}
+
+// GetHostnameOriginPullCertificate invokes the programmed GetHostnameOriginPullCertificateFunc.
+func (mock *AuthenticatedOriginPullApiV1) GetHostnameOriginPullCertificate(getHostnameOriginPullCertificateOptions *authenticatedoriginpullapiv1.GetHostnameOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("GetHostnameOriginPullCertificate", getHostnameOriginPullCertificateOptions)
+	if mock.GetHostnameOriginPullCertificateFunc != nil {
+		return mock.GetHostnameOriginPullCertificateFunc(getHostnameOriginPullCertificateOptions)
+	}
+	if mock.GetHostnameOriginPullCertificateWithContextFunc != nil {
+		return mock.GetHostnameOriginPullCertificateWithContextFunc(context.Background(), getHostnameOriginPullCertificateOptions)
+	}
+	err = notProgrammed("GetHostnameOriginPullCertificate")
+	return
+}
+
+// GetHostnameOriginPullCertificateWithContext invokes the programmed GetHostnameOriginPullCertificateWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) GetHostnameOriginPullCertificateWithContext(ctx context.Context, getHostnameOriginPullCertificateOptions *authenticatedoriginpullapiv1.GetHostnameOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("GetHostnameOriginPullCertificateWithContext", ctx, getHostnameOriginPullCertificateOptions)
+	if mock.GetHostnameOriginPullCertificateWithContextFunc != nil {
+		return mock.GetHostnameOriginPullCertificateWithContextFunc(ctx, getHostnameOriginPullCertificateOptions)
+	}
+	if mock.GetHostnameOriginPullCertificateFunc != nil {
+		return mock.GetHostnameOriginPullCertificateFunc(getHostnameOriginPullCertificateOptions)
+	}
+	err = notProgrammed("GetHostnameOriginPullCertificateWithContext")
+	return
+}
+
+// DeleteHostnameOriginPullCertificate invokes the programmed DeleteHostnameOriginPullCertificateFunc.
+func (mock *AuthenticatedOriginPullApiV1) DeleteHostnameOriginPullCertificate(deleteHostnameOriginPullCertificateOptions *authenticatedoriginpullapiv1.DeleteHostnameOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteHostnameOriginPullCertificate", deleteHostnameOriginPullCertificateOptions)
+	if mock.DeleteHostnameOriginPullCertificateFunc != nil {
+		return mock.DeleteHostnameOriginPullCertificateFunc(deleteHostnameOriginPullCertificateOptions)
+	}
+	if mock.DeleteHostnameOriginPullCertificateWithContextFunc != nil {
+		return mock.DeleteHostnameOriginPullCertificateWithContextFunc(context.Background(), deleteHostnameOriginPullCertificateOptions)
+	}
+	err = notProgrammed("DeleteHostnameOriginPullCertificate")
+	return
+}
+
+// DeleteHostnameOriginPullCertificateWithContext invokes the programmed DeleteHostnameOriginPullCertificateWithContextFunc.
+func (mock *AuthenticatedOriginPullApiV1) DeleteHostnameOriginPullCertificateWithContext(ctx context.Context, deleteHostnameOriginPullCertificateOptions *authenticatedoriginpullapiv1.DeleteHostnameOriginPullCertificateOptions) (result *authenticatedoriginpullapiv1.HostnameOriginPullCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteHostnameOriginPullCertificateWithContext", ctx, deleteHostnameOriginPullCertificateOptions)
+	if mock.DeleteHostnameOriginPullCertificateWithContextFunc != nil {
+		return mock.DeleteHostnameOriginPullCertificateWithContextFunc(ctx, deleteHostnameOriginPullCertificateOptions)
+	}
+	if mock.DeleteHostnameOriginPullCertificateFunc != nil {
+		return mock.DeleteHostnameOriginPullCertificateFunc(deleteHostnameOriginPullCertificateOptions)
+	}
+	err = notProgrammed("DeleteHostnameOriginPullCertificateWithContext")
+	return
+}
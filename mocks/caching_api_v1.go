@@ -0,0 +1,426 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/cachingapiv1"
+)
+
+// CachingApiV1 is a mock of cachingapiv1.CachingApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type CachingApiV1 struct {
+	Mock
+
+	PurgeAllFunc                           func(*cachingapiv1.PurgeAllOptions) (*cachingapiv1.PurgeAllResponse, *core.DetailedResponse, error)
+	PurgeAllWithContextFunc                func(context.Context, *cachingapiv1.PurgeAllOptions) (*cachingapiv1.PurgeAllResponse, *core.DetailedResponse, error)
+	PurgeByUrlsFunc                        func(*cachingapiv1.PurgeByUrlsOptions) (*cachingapiv1.PurgeAllResponse, *core.DetailedResponse, error)
+	PurgeByUrlsWithContextFunc             func(context.Context, *cachingapiv1.PurgeByUrlsOptions) (*cachingapiv1.PurgeAllResponse, *core.DetailedResponse, error)
+	PurgeByCacheTagsFunc                   func(*cachingapiv1.PurgeByCacheTagsOptions) (*cachingapiv1.PurgeAllResponse, *core.DetailedResponse, error)
+	PurgeByCacheTagsWithContextFunc        func(context.Context, *cachingapiv1.PurgeByCacheTagsOptions) (*cachingapiv1.PurgeAllResponse, *core.DetailedResponse, error)
+	PurgeByHostsFunc                       func(*cachingapiv1.PurgeByHostsOptions) (*cachingapiv1.PurgeAllResponse, *core.DetailedResponse, error)
+	PurgeByHostsWithContextFunc            func(context.Context, *cachingapiv1.PurgeByHostsOptions) (*cachingapiv1.PurgeAllResponse, *core.DetailedResponse, error)
+	GetBrowserCacheTTLFunc                 func(*cachingapiv1.GetBrowserCacheTtlOptions) (*cachingapiv1.BrowserTTLResponse, *core.DetailedResponse, error)
+	GetBrowserCacheTTLWithContextFunc      func(context.Context, *cachingapiv1.GetBrowserCacheTtlOptions) (*cachingapiv1.BrowserTTLResponse, *core.DetailedResponse, error)
+	UpdateBrowserCacheTTLFunc              func(*cachingapiv1.UpdateBrowserCacheTtlOptions) (*cachingapiv1.BrowserTTLResponse, *core.DetailedResponse, error)
+	UpdateBrowserCacheTTLWithContextFunc   func(context.Context, *cachingapiv1.UpdateBrowserCacheTtlOptions) (*cachingapiv1.BrowserTTLResponse, *core.DetailedResponse, error)
+	GetServeStaleContentFunc               func(*cachingapiv1.GetServeStaleContentOptions) (*cachingapiv1.ServeStaleContentResponse, *core.DetailedResponse, error)
+	GetServeStaleContentWithContextFunc    func(context.Context, *cachingapiv1.GetServeStaleContentOptions) (*cachingapiv1.ServeStaleContentResponse, *core.DetailedResponse, error)
+	UpdateServeStaleContentFunc            func(*cachingapiv1.UpdateServeStaleContentOptions) (*cachingapiv1.ServeStaleContentResponse, *core.DetailedResponse, error)
+	UpdateServeStaleContentWithContextFunc func(context.Context, *cachingapiv1.UpdateServeStaleContentOptions) (*cachingapiv1.ServeStaleContentResponse, *core.DetailedResponse, error)
+	GetDevelopmentModeFunc                 func(*cachingapiv1.GetDevelopmentModeOptions) (*cachingapiv1.DeveopmentModeResponse, *core.DetailedResponse, error)
+	GetDevelopmentModeWithContextFunc      func(context.Context, *cachingapiv1.GetDevelopmentModeOptions) (*cachingapiv1.DeveopmentModeResponse, *core.DetailedResponse, error)
+	UpdateDevelopmentModeFunc              func(*cachingapiv1.UpdateDevelopmentModeOptions) (*cachingapiv1.DeveopmentModeResponse, *core.DetailedResponse, error)
+	UpdateDevelopmentModeWithContextFunc   func(context.Context, *cachingapiv1.UpdateDevelopmentModeOptions) (*cachingapiv1.DeveopmentModeResponse, *core.DetailedResponse, error)
+	GetQueryStringSortFunc                 func(*cachingapiv1.GetQueryStringSortOptions) (*cachingapiv1.EnableQueryStringSortResponse, *core.DetailedResponse, error)
+	GetQueryStringSortWithContextFunc      func(context.Context, *cachingapiv1.GetQueryStringSortOptions) (*cachingapiv1.EnableQueryStringSortResponse, *core.DetailedResponse, error)
+	UpdateQueryStringSortFunc              func(*cachingapiv1.UpdateQueryStringSortOptions) (*cachingapiv1.EnableQueryStringSortResponse, *core.DetailedResponse, error)
+	UpdateQueryStringSortWithContextFunc   func(context.Context, *cachingapiv1.UpdateQueryStringSortOptions) (*cachingapiv1.EnableQueryStringSortResponse, *core.DetailedResponse, error)
+	GetCacheLevelFunc                      func(*cachingapiv1.GetCacheLevelOptions) (*cachingapiv1.CacheLevelResponse, *core.DetailedResponse, error)
+	GetCacheLevelWithContextFunc           func(context.Context, *cachingapiv1.GetCacheLevelOptions) (*cachingapiv1.CacheLevelResponse, *core.DetailedResponse, error)
+	UpdateCacheLevelFunc                   func(*cachingapiv1.UpdateCacheLevelOptions) (*cachingapiv1.CacheLevelResponse, *core.DetailedResponse, error)
+	UpdateCacheLevelWithContextFunc        func(context.Context, *cachingapiv1.UpdateCacheLevelOptions) (*cachingapiv1.CacheLevelResponse, *core.DetailedResponse, error)
+}
+
+// CachingApiV1 implements cachingapiv1.CachingApiV1API.
+var _ cachingapiv1.CachingApiV1API = (*CachingApiV1)(nil)
+
+// PurgeAll invokes the programmed PurgeAllFunc.
+func (mock *CachingApiV1) PurgeAll(purgeAllOptions *cachingapiv1.PurgeAllOptions) (result *cachingapiv1.PurgeAllResponse, response *core.DetailedResponse, err error) {
+	mock.called("PurgeAll", purgeAllOptions)
+	if mock.PurgeAllFunc != nil {
+		return mock.PurgeAllFunc(purgeAllOptions)
+	}
+	if mock.PurgeAllWithContextFunc != nil {
+		return mock.PurgeAllWithContextFunc(context.Background(), purgeAllOptions)
+	}
+	err = notProgrammed("PurgeAll")
+	return
+}
+
+// PurgeAllWithContext invokes the programmed PurgeAllWithContextFunc.
+func (mock *CachingApiV1) PurgeAllWithContext(ctx context.Context, purgeAllOptions *cachingapiv1.PurgeAllOptions) (result *cachingapiv1.PurgeAllResponse, response *core.DetailedResponse, err error) {
+	mock.called("PurgeAllWithContext", ctx, purgeAllOptions)
+	if mock.PurgeAllWithContextFunc != nil {
+		return mock.PurgeAllWithContextFunc(ctx, purgeAllOptions)
+	}
+	if mock.PurgeAllFunc != nil {
+		return mock.PurgeAllFunc(purgeAllOptions)
+	}
+	err = notProgrammed("PurgeAllWithContext")
+	return
+}
+
+// PurgeByUrls invokes the programmed PurgeByUrlsFunc.
+func (mock *CachingApiV1) PurgeByUrls(purgeByUrlsOptions *cachingapiv1.PurgeByUrlsOptions) (result *cachingapiv1.PurgeAllResponse, response *core.DetailedResponse, err error) {
+	mock.called("PurgeByUrls", purgeByUrlsOptions)
+	if mock.PurgeByUrlsFunc != nil {
+		return mock.PurgeByUrlsFunc(purgeByUrlsOptions)
+	}
+	if mock.PurgeByUrlsWithContextFunc != nil {
+		return mock.PurgeByUrlsWithContextFunc(context.Background(), purgeByUrlsOptions)
+	}
+	err = notProgrammed("PurgeByUrls")
+	return
+}
+
+// PurgeByUrlsWithContext invokes the programmed PurgeByUrlsWithContextFunc.
+func (mock *CachingApiV1) PurgeByUrlsWithContext(ctx context.Context, purgeByUrlsOptions *cachingapiv1.PurgeByUrlsOptions) (result *cachingapiv1.PurgeAllResponse, response *core.DetailedResponse, err error) {
+	mock.called("PurgeByUrlsWithContext", ctx, purgeByUrlsOptions)
+	if mock.PurgeByUrlsWithContextFunc != nil {
+		return mock.PurgeByUrlsWithContextFunc(ctx, purgeByUrlsOptions)
+	}
+	if mock.PurgeByUrlsFunc != nil {
+		return mock.PurgeByUrlsFunc(purgeByUrlsOptions)
+	}
+	err = notProgrammed("PurgeByUrlsWithContext")
+	return
+}
+
+// PurgeByCacheTags invokes the programmed PurgeByCacheTagsFunc.
+func (mock *CachingApiV1) PurgeByCacheTags(purgeByCacheTagsOptions *cachingapiv1.PurgeByCacheTagsOptions) (result *cachingapiv1.PurgeAllResponse, response *core.DetailedResponse, err error) {
+	mock.called("PurgeByCacheTags", purgeByCacheTagsOptions)
+	if mock.PurgeByCacheTagsFunc != nil {
+		return mock.PurgeByCacheTagsFunc(purgeByCacheTagsOptions)
+	}
+	if mock.PurgeByCacheTagsWithContextFunc != nil {
+		return mock.PurgeByCacheTagsWithContextFunc(context.Background(), purgeByCacheTagsOptions)
+	}
+	err = notProgrammed("PurgeByCacheTags")
+	return
+}
+
+// PurgeByCacheTagsWithContext invokes the programmed PurgeByCacheTagsWithContextFunc.
+func (mock *CachingApiV1) PurgeByCacheTagsWithContext(ctx context.Context, purgeByCacheTagsOptions *cachingapiv1.PurgeByCacheTagsOptions) (result *cachingapiv1.PurgeAllResponse, response *core.DetailedResponse, err error) {
+	mock.called("PurgeByCacheTagsWithContext", ctx, purgeByCacheTagsOptions)
+	if mock.PurgeByCacheTagsWithContextFunc != nil {
+		return mock.PurgeByCacheTagsWithContextFunc(ctx, purgeByCacheTagsOptions)
+	}
+	if mock.PurgeByCacheTagsFunc != nil {
+		return mock.PurgeByCacheTagsFunc(purgeByCacheTagsOptions)
+	}
+	err = notProgrammed("PurgeByCacheTagsWithContext")
+	return
+}
+
+// PurgeByHosts invokes the programmed PurgeByHostsFunc.
+func (mock *CachingApiV1) PurgeByHosts(purgeByHostsOptions *cachingapiv1.PurgeByHostsOptions) (result *cachingapiv1.PurgeAllResponse, response *core.DetailedResponse, err error) {
+	mock.called("PurgeByHosts", purgeByHostsOptions)
+	if mock.PurgeByHostsFunc != nil {
+		return mock.PurgeByHostsFunc(purgeByHostsOptions)
+	}
+	if mock.PurgeByHostsWithContextFunc != nil {
+		return mock.PurgeByHostsWithContextFunc(context.Background(), purgeByHostsOptions)
+	}
+	err = notProgrammed("PurgeByHosts")
+	return
+}
+
+// PurgeByHostsWithContext invokes the programmed PurgeByHostsWithContextFunc.
+func (mock *CachingApiV1) PurgeByHostsWithContext(ctx context.Context, purgeByHostsOptions *cachingapiv1.PurgeByHostsOptions) (result *cachingapiv1.PurgeAllResponse, response *core.DetailedResponse, err error) {
+	mock.called("PurgeByHostsWithContext", ctx, purgeByHostsOptions)
+	if mock.PurgeByHostsWithContextFunc != nil {
+		return mock.PurgeByHostsWithContextFunc(ctx, purgeByHostsOptions)
+	}
+	if mock.PurgeByHostsFunc != nil {
+		return mock.PurgeByHostsFunc(purgeByHostsOptions)
+	}
+	err = notProgrammed("PurgeByHostsWithContext")
+	return
+}
+
+// GetBrowserCacheTTL invokes the programmed GetBrowserCacheTTLFunc.
+func (mock *CachingApiV1) GetBrowserCacheTTL(getBrowserCacheTtlOptions *cachingapiv1.GetBrowserCacheTtlOptions) (result *cachingapiv1.BrowserTTLResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetBrowserCacheTTL", getBrowserCacheTtlOptions)
+	if mock.GetBrowserCacheTTLFunc != nil {
+		return mock.GetBrowserCacheTTLFunc(getBrowserCacheTtlOptions)
+	}
+	if mock.GetBrowserCacheTTLWithContextFunc != nil {
+		return mock.GetBrowserCacheTTLWithContextFunc(context.Background(), getBrowserCacheTtlOptions)
+	}
+	err = notProgrammed("GetBrowserCacheTTL")
+	return
+}
+
+// GetBrowserCacheTTLWithContext invokes the programmed GetBrowserCacheTTLWithContextFunc.
+func (mock *CachingApiV1) GetBrowserCacheTTLWithContext(ctx context.Context, getBrowserCacheTtlOptions *cachingapiv1.GetBrowserCacheTtlOptions) (result *cachingapiv1.BrowserTTLResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetBrowserCacheTTLWithContext", ctx, getBrowserCacheTtlOptions)
+	if mock.GetBrowserCacheTTLWithContextFunc != nil {
+		return mock.GetBrowserCacheTTLWithContextFunc(ctx, getBrowserCacheTtlOptions)
+	}
+	if mock.GetBrowserCacheTTLFunc != nil {
+		return mock.GetBrowserCacheTTLFunc(getBrowserCacheTtlOptions)
+	}
+	err = notProgrammed("GetBrowserCacheTTLWithContext")
+	return
+}
+
+// UpdateBrowserCacheTTL invokes the programmed UpdateBrowserCacheTTLFunc.
+func (mock *CachingApiV1) UpdateBrowserCacheTTL(updateBrowserCacheTtlOptions *cachingapiv1.UpdateBrowserCacheTtlOptions) (result *cachingapiv1.BrowserTTLResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateBrowserCacheTTL", updateBrowserCacheTtlOptions)
+	if mock.UpdateBrowserCacheTTLFunc != nil {
+		return mock.UpdateBrowserCacheTTLFunc(updateBrowserCacheTtlOptions)
+	}
+	if mock.UpdateBrowserCacheTTLWithContextFunc != nil {
+		return mock.UpdateBrowserCacheTTLWithContextFunc(context.Background(), updateBrowserCacheTtlOptions)
+	}
+	err = notProgrammed("UpdateBrowserCacheTTL")
+	return
+}
+
+// UpdateBrowserCacheTTLWithContext invokes the programmed UpdateBrowserCacheTTLWithContextFunc.
+func (mock *CachingApiV1) UpdateBrowserCacheTTLWithContext(ctx context.Context, updateBrowserCacheTtlOptions *cachingapiv1.UpdateBrowserCacheTtlOptions) (result *cachingapiv1.BrowserTTLResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateBrowserCacheTTLWithContext", ctx, updateBrowserCacheTtlOptions)
+	if mock.UpdateBrowserCacheTTLWithContextFunc != nil {
+		return mock.UpdateBrowserCacheTTLWithContextFunc(ctx, updateBrowserCacheTtlOptions)
+	}
+	if mock.UpdateBrowserCacheTTLFunc != nil {
+		return mock.UpdateBrowserCacheTTLFunc(updateBrowserCacheTtlOptions)
+	}
+	err = notProgrammed("UpdateBrowserCacheTTLWithContext")
+	return
+}
+
+// GetServeStaleContent invokes the programmed GetServeStaleContentFunc.
+func (mock *CachingApiV1) GetServeStaleContent(getServeStaleContentOptions *cachingapiv1.GetServeStaleContentOptions) (result *cachingapiv1.ServeStaleContentResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetServeStaleContent", getServeStaleContentOptions)
+	if mock.GetServeStaleContentFunc != nil {
+		return mock.GetServeStaleContentFunc(getServeStaleContentOptions)
+	}
+	if mock.GetServeStaleContentWithContextFunc != nil {
+		return mock.GetServeStaleContentWithContextFunc(context.Background(), getServeStaleContentOptions)
+	}
+	err = notProgrammed("GetServeStaleContent")
+	return
+}
+
+// GetServeStaleContentWithContext invokes the programmed GetServeStaleContentWithContextFunc.
+func (mock *CachingApiV1) GetServeStaleContentWithContext(ctx context.Context, getServeStaleContentOptions *cachingapiv1.GetServeStaleContentOptions) (result *cachingapiv1.ServeStaleContentResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetServeStaleContentWithContext", ctx, getServeStaleContentOptions)
+	if mock.GetServeStaleContentWithContextFunc != nil {
+		return mock.GetServeStaleContentWithContextFunc(ctx, getServeStaleContentOptions)
+	}
+	if mock.GetServeStaleContentFunc != nil {
+		return mock.GetServeStaleContentFunc(getServeStaleContentOptions)
+	}
+	err = notProgrammed("GetServeStaleContentWithContext")
+	return
+}
+
+// UpdateServeStaleContent invokes the programmed UpdateServeStaleContentFunc.
+func (mock *CachingApiV1) UpdateServeStaleContent(updateServeStaleContentOptions *cachingapiv1.UpdateServeStaleContentOptions) (result *cachingapiv1.ServeStaleContentResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateServeStaleContent", updateServeStaleContentOptions)
+	if mock.UpdateServeStaleContentFunc != nil {
+		return mock.UpdateServeStaleContentFunc(updateServeStaleContentOptions)
+	}
+	if mock.UpdateServeStaleContentWithContextFunc != nil {
+		return mock.UpdateServeStaleContentWithContextFunc(context.Background(), updateServeStaleContentOptions)
+	}
+	err = notProgrammed("UpdateServeStaleContent")
+	return
+}
+
+// UpdateServeStaleContentWithContext invokes the programmed UpdateServeStaleContentWithContextFunc.
+func (mock *CachingApiV1) UpdateServeStaleContentWithContext(ctx context.Context, updateServeStaleContentOptions *cachingapiv1.UpdateServeStaleContentOptions) (result *cachingapiv1.ServeStaleContentResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateServeStaleContentWithContext", ctx, updateServeStaleContentOptions)
+	if mock.UpdateServeStaleContentWithContextFunc != nil {
+		return mock.UpdateServeStaleContentWithContextFunc(ctx, updateServeStaleContentOptions)
+	}
+	if mock.UpdateServeStaleContentFunc != nil {
+		return mock.UpdateServeStaleContentFunc(updateServeStaleContentOptions)
+	}
+	err = notProgrammed("UpdateServeStaleContentWithContext")
+	return
+}
+
+// GetDevelopmentMode invokes the programmed GetDevelopmentModeFunc.
+func (mock *CachingApiV1) GetDevelopmentMode(getDevelopmentModeOptions *cachingapiv1.GetDevelopmentModeOptions) (result *cachingapiv1.DeveopmentModeResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetDevelopmentMode", getDevelopmentModeOptions)
+	if mock.GetDevelopmentModeFunc != nil {
+		return mock.GetDevelopmentModeFunc(getDevelopmentModeOptions)
+	}
+	if mock.GetDevelopmentModeWithContextFunc != nil {
+		return mock.GetDevelopmentModeWithContextFunc(context.Background(), getDevelopmentModeOptions)
+	}
+	err = notProgrammed("GetDevelopmentMode")
+	return
+}
+
+// GetDevelopmentModeWithContext invokes the programmed GetDevelopmentModeWithContextFunc.
+func (mock *CachingApiV1) GetDevelopmentModeWithContext(ctx context.Context, getDevelopmentModeOptions *cachingapiv1.GetDevelopmentModeOptions) (result *cachingapiv1.DeveopmentModeResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetDevelopmentModeWithContext", ctx, getDevelopmentModeOptions)
+	if mock.GetDevelopmentModeWithContextFunc != nil {
+		return mock.GetDevelopmentModeWithContextFunc(ctx, getDevelopmentModeOptions)
+	}
+	if mock.GetDevelopmentModeFunc != nil {
+		return mock.GetDevelopmentModeFunc(getDevelopmentModeOptions)
+	}
+	err = notProgrammed("GetDevelopmentModeWithContext")
+	return
+}
+
+// UpdateDevelopmentMode invokes the programmed UpdateDevelopmentModeFunc.
+func (mock *CachingApiV1) UpdateDevelopmentMode(updateDevelopmentModeOptions *cachingapiv1.UpdateDevelopmentModeOptions) (result *cachingapiv1.DeveopmentModeResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateDevelopmentMode", updateDevelopmentModeOptions)
+	if mock.UpdateDevelopmentModeFunc != nil {
+		return mock.UpdateDevelopmentModeFunc(updateDevelopmentModeOptions)
+	}
+	if mock.UpdateDevelopmentModeWithContextFunc != nil {
+		return mock.UpdateDevelopmentModeWithContextFunc(context.Background(), updateDevelopmentModeOptions)
+	}
+	err = notProgrammed("UpdateDevelopmentMode")
+	return
+}
+
+// UpdateDevelopmentModeWithContext invokes the programmed UpdateDevelopmentModeWithContextFunc.
+func (mock *CachingApiV1) UpdateDevelopmentModeWithContext(ctx context.Context, updateDevelopmentModeOptions *cachingapiv1.UpdateDevelopmentModeOptions) (result *cachingapiv1.DeveopmentModeResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateDevelopmentModeWithContext", ctx, updateDevelopmentModeOptions)
+	if mock.UpdateDevelopmentModeWithContextFunc != nil {
+		return mock.UpdateDevelopmentModeWithContextFunc(ctx, updateDevelopmentModeOptions)
+	}
+	if mock.UpdateDevelopmentModeFunc != nil {
+		return mock.UpdateDevelopmentModeFunc(updateDevelopmentModeOptions)
+	}
+	err = notProgrammed("UpdateDevelopmentModeWithContext")
+	return
+}
+
+// GetQueryStringSort invokes the programmed GetQueryStringSortFunc.
+func (mock *CachingApiV1) GetQueryStringSort(getQueryStringSortOptions *cachingapiv1.GetQueryStringSortOptions) (result *cachingapiv1.EnableQueryStringSortResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetQueryStringSort", getQueryStringSortOptions)
+	if mock.GetQueryStringSortFunc != nil {
+		return mock.GetQueryStringSortFunc(getQueryStringSortOptions)
+	}
+	if mock.GetQueryStringSortWithContextFunc != nil {
+		return mock.GetQueryStringSortWithContextFunc(context.Background(), getQueryStringSortOptions)
+	}
+	err = notProgrammed("GetQueryStringSort")
+	return
+}
+
+// GetQueryStringSortWithContext invokes the programmed GetQueryStringSortWithContextFunc.
+func (mock *CachingApiV1) GetQueryStringSortWithContext(ctx context.Context, getQueryStringSortOptions *cachingapiv1.GetQueryStringSortOptions) (result *cachingapiv1.EnableQueryStringSortResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetQueryStringSortWithContext", ctx, getQueryStringSortOptions)
+	if mock.GetQueryStringSortWithContextFunc != nil {
+		return mock.GetQueryStringSortWithContextFunc(ctx, getQueryStringSortOptions)
+	}
+	if mock.GetQueryStringSortFunc != nil {
+		return mock.GetQueryStringSortFunc(getQueryStringSortOptions)
+	}
+	err = notProgrammed("GetQueryStringSortWithContext")
+	return
+}
+
+// UpdateQueryStringSort invokes the programmed UpdateQueryStringSortFunc.
+func (mock *CachingApiV1) UpdateQueryStringSort(updateQueryStringSortOptions *cachingapiv1.UpdateQueryStringSortOptions) (result *cachingapiv1.EnableQueryStringSortResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateQueryStringSort", updateQueryStringSortOptions)
+	if mock.UpdateQueryStringSortFunc != nil {
+		return mock.UpdateQueryStringSortFunc(updateQueryStringSortOptions)
+	}
+	if mock.UpdateQueryStringSortWithContextFunc != nil {
+		return mock.UpdateQueryStringSortWithContextFunc(context.Background(), updateQueryStringSortOptions)
+	}
+	err = notProgrammed("UpdateQueryStringSort")
+	return
+}
+
+// UpdateQueryStringSortWithContext invokes the programmed UpdateQueryStringSortWithContextFunc.
+func (mock *CachingApiV1) UpdateQueryStringSortWithContext(ctx context.Context, updateQueryStringSortOptions *cachingapiv1.UpdateQueryStringSortOptions) (result *cachingapiv1.EnableQueryStringSortResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateQueryStringSortWithContext", ctx, updateQueryStringSortOptions)
+	if mock.UpdateQueryStringSortWithContextFunc != nil {
+		return mock.UpdateQueryStringSortWithContextFunc(ctx, updateQueryStringSortOptions)
+	}
+	if mock.UpdateQueryStringSortFunc != nil {
+		return mock.UpdateQueryStringSortFunc(updateQueryStringSortOptions)
+	}
+	err = notProgrammed("UpdateQueryStringSortWithContext")
+	return
+}
+
+// GetCacheLevel invokes the programmed GetCacheLevelFunc.
+func (mock *CachingApiV1) GetCacheLevel(getCacheLevelOptions *cachingapiv1.GetCacheLevelOptions) (result *cachingapiv1.CacheLevelResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetCacheLevel", getCacheLevelOptions)
+	if mock.GetCacheLevelFunc != nil {
+		return mock.GetCacheLevelFunc(getCacheLevelOptions)
+	}
+	if mock.GetCacheLevelWithContextFunc != nil {
+		return mock.GetCacheLevelWithContextFunc(context.Background(), getCacheLevelOptions)
+	}
+	err = notProgrammed("GetCacheLevel")
+	return
+}
+
+// GetCacheLevelWithContext invokes the programmed GetCacheLevelWithContextFunc.
+func (mock *CachingApiV1) GetCacheLevelWithContext(ctx context.Context, getCacheLevelOptions *cachingapiv1.GetCacheLevelOptions) (result *cachingapiv1.CacheLevelResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetCacheLevelWithContext", ctx, getCacheLevelOptions)
+	if mock.GetCacheLevelWithContextFunc != nil {
+		return mock.GetCacheLevelWithContextFunc(ctx, getCacheLevelOptions)
+	}
+	if mock.GetCacheLevelFunc != nil {
+		return mock.GetCacheLevelFunc(getCacheLevelOptions)
+	}
+	err = notProgrammed("GetCacheLevelWithContext")
+	return
+}
+
+// UpdateCacheLevel invokes the programmed UpdateCacheLevelFunc.
+func (mock *CachingApiV1) UpdateCacheLevel(updateCacheLevelOptions *cachingapiv1.UpdateCacheLevelOptions) (result *cachingapiv1.CacheLevelResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCacheLevel", updateCacheLevelOptions)
+	if mock.UpdateCacheLevelFunc != nil {
+		return mock.UpdateCacheLevelFunc(updateCacheLevelOptions)
+	}
+	if mock.UpdateCacheLevelWithContextFunc != nil {
+		return mock.UpdateCacheLevelWithContextFunc(context.Background(), updateCacheLevelOptions)
+	}
+	err = notProgrammed("UpdateCacheLevel")
+	return
+}
+
+// UpdateCacheLevelWithContext invokes the programmed UpdateCacheLevelWithContextFunc.
+func (mock *CachingApiV1) UpdateCacheLevelWithContext(ctx context.Context, updateCacheLevelOptions *cachingapiv1.UpdateCacheLevelOptions) (result *cachingapiv1.CacheLevelResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCacheLevelWithContext", ctx, updateCacheLevelOptions)
+	if mock.UpdateCacheLevelWithContextFunc != nil {
+		return mock.UpdateCacheLevelWithContextFunc(ctx, updateCacheLevelOptions)
+	}
+	if mock.UpdateCacheLevelFunc != nil {
+		return mock.UpdateCacheLevelFunc(updateCacheLevelOptions)
+	}
+	err = notProgrammed("UpdateCacheLevelWithContext")
+	return
+}
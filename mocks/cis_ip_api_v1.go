@@ -0,0 +1,62 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/cisipapiv1"
+)
+
+// CisIpApiV1 is a mock of cisipapiv1.CisIpApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type CisIpApiV1 struct {
+	Mock
+
+	ListIpsFunc            func(*cisipapiv1.ListIpsOptions) (*cisipapiv1.IpResponse, *core.DetailedResponse, error)
+	ListIpsWithContextFunc func(context.Context, *cisipapiv1.ListIpsOptions) (*cisipapiv1.IpResponse, *core.DetailedResponse, error)
+}
+
+// CisIpApiV1 implements cisipapiv1.CisIpApiV1API.
+var _ cisipapiv1.CisIpApiV1API = (*CisIpApiV1)(nil)
+
+// ListIps invokes the programmed ListIpsFunc.
+func (mock *CisIpApiV1) ListIps(listIpsOptions *cisipapiv1.ListIpsOptions) (result *cisipapiv1.IpResponse, response *core.DetailedResponse, err error) {
+	mock.called("ListIps", listIpsOptions)
+	if mock.ListIpsFunc != nil {
+		return mock.ListIpsFunc(listIpsOptions)
+	}
+	if mock.ListIpsWithContextFunc != nil {
+		return mock.ListIpsWithContextFunc(context.Background(), listIpsOptions)
+	}
+	err = notProgrammed("ListIps")
+	return
+}
+
+// ListIpsWithContext invokes the programmed ListIpsWithContextFunc.
+func (mock *CisIpApiV1) ListIpsWithContext(ctx context.Context, listIpsOptions *cisipapiv1.ListIpsOptions) (result *cisipapiv1.IpResponse, response *core.DetailedResponse, err error) {
+	mock.called("ListIpsWithContext", ctx, listIpsOptions)
+	if mock.ListIpsWithContextFunc != nil {
+		return mock.ListIpsWithContextFunc(ctx, listIpsOptions)
+	}
+	if mock.ListIpsFunc != nil {
+		return mock.ListIpsFunc(listIpsOptions)
+	}
+	err = notProgrammed("ListIpsWithContext")
+	return
+}
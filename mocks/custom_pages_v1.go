@@ -0,0 +1,202 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/custompagesv1"
+)
+
+// CustomPagesV1 is a mock of custompagesv1.CustomPagesV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type CustomPagesV1 struct {
+	Mock
+
+	ListInstanceCustomPagesFunc             func(*custompagesv1.ListInstanceCustomPagesOptions) (*custompagesv1.ListCustomPagesResp, *core.DetailedResponse, error)
+	ListInstanceCustomPagesWithContextFunc  func(context.Context, *custompagesv1.ListInstanceCustomPagesOptions) (*custompagesv1.ListCustomPagesResp, *core.DetailedResponse, error)
+	GetInstanceCustomPageFunc               func(*custompagesv1.GetInstanceCustomPageOptions) (*custompagesv1.CustomPageSpecificResp, *core.DetailedResponse, error)
+	GetInstanceCustomPageWithContextFunc    func(context.Context, *custompagesv1.GetInstanceCustomPageOptions) (*custompagesv1.CustomPageSpecificResp, *core.DetailedResponse, error)
+	UpdateInstanceCustomPageFunc            func(*custompagesv1.UpdateInstanceCustomPageOptions) (*custompagesv1.CustomPageSpecificResp, *core.DetailedResponse, error)
+	UpdateInstanceCustomPageWithContextFunc func(context.Context, *custompagesv1.UpdateInstanceCustomPageOptions) (*custompagesv1.CustomPageSpecificResp, *core.DetailedResponse, error)
+	ListZoneCustomPagesFunc                 func(*custompagesv1.ListZoneCustomPagesOptions) (*custompagesv1.ListCustomPagesResp, *core.DetailedResponse, error)
+	ListZoneCustomPagesWithContextFunc      func(context.Context, *custompagesv1.ListZoneCustomPagesOptions) (*custompagesv1.ListCustomPagesResp, *core.DetailedResponse, error)
+	GetZoneCustomPageFunc                   func(*custompagesv1.GetZoneCustomPageOptions) (*custompagesv1.CustomPageSpecificResp, *core.DetailedResponse, error)
+	GetZoneCustomPageWithContextFunc        func(context.Context, *custompagesv1.GetZoneCustomPageOptions) (*custompagesv1.CustomPageSpecificResp, *core.DetailedResponse, error)
+	UpdateZoneCustomPageFunc                func(*custompagesv1.UpdateZoneCustomPageOptions) (*custompagesv1.CustomPageSpecificResp, *core.DetailedResponse, error)
+	UpdateZoneCustomPageWithContextFunc     func(context.Context, *custompagesv1.UpdateZoneCustomPageOptions) (*custompagesv1.CustomPageSpecificResp, *core.DetailedResponse, error)
+}
+
+// CustomPagesV1 implements custompagesv1.CustomPagesV1API.
+var _ custompagesv1.CustomPagesV1API = (*CustomPagesV1)(nil)
+
+// ListInstanceCustomPages invokes the programmed ListInstanceCustomPagesFunc.
+func (mock *CustomPagesV1) ListInstanceCustomPages(listInstanceCustomPagesOptions *custompagesv1.ListInstanceCustomPagesOptions) (result *custompagesv1.ListCustomPagesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListInstanceCustomPages", listInstanceCustomPagesOptions)
+	if mock.ListInstanceCustomPagesFunc != nil {
+		return mock.ListInstanceCustomPagesFunc(listInstanceCustomPagesOptions)
+	}
+	if mock.ListInstanceCustomPagesWithContextFunc != nil {
+		return mock.ListInstanceCustomPagesWithContextFunc(context.Background(), listInstanceCustomPagesOptions)
+	}
+	err = notProgrammed("ListInstanceCustomPages")
+	return
+}
+
+// ListInstanceCustomPagesWithContext invokes the programmed ListInstanceCustomPagesWithContextFunc.
+func (mock *CustomPagesV1) ListInstanceCustomPagesWithContext(ctx context.Context, listInstanceCustomPagesOptions *custompagesv1.ListInstanceCustomPagesOptions) (result *custompagesv1.ListCustomPagesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListInstanceCustomPagesWithContext", ctx, listInstanceCustomPagesOptions)
+	if mock.ListInstanceCustomPagesWithContextFunc != nil {
+		return mock.ListInstanceCustomPagesWithContextFunc(ctx, listInstanceCustomPagesOptions)
+	}
+	if mock.ListInstanceCustomPagesFunc != nil {
+		return mock.ListInstanceCustomPagesFunc(listInstanceCustomPagesOptions)
+	}
+	err = notProgrammed("ListInstanceCustomPagesWithContext")
+	return
+}
+
+// GetInstanceCustomPage invokes the programmed GetInstanceCustomPageFunc.
+func (mock *CustomPagesV1) GetInstanceCustomPage(getInstanceCustomPageOptions *custompagesv1.GetInstanceCustomPageOptions) (result *custompagesv1.CustomPageSpecificResp, response *core.DetailedResponse, err error) {
+	mock.called("GetInstanceCustomPage", getInstanceCustomPageOptions)
+	if mock.GetInstanceCustomPageFunc != nil {
+		return mock.GetInstanceCustomPageFunc(getInstanceCustomPageOptions)
+	}
+	if mock.GetInstanceCustomPageWithContextFunc != nil {
+		return mock.GetInstanceCustomPageWithContextFunc(context.Background(), getInstanceCustomPageOptions)
+	}
+	err = notProgrammed("GetInstanceCustomPage")
+	return
+}
+
+// GetInstanceCustomPageWithContext invokes the programmed GetInstanceCustomPageWithContextFunc.
+func (mock *CustomPagesV1) GetInstanceCustomPageWithContext(ctx context.Context, getInstanceCustomPageOptions *custompagesv1.GetInstanceCustomPageOptions) (result *custompagesv1.CustomPageSpecificResp, response *core.DetailedResponse, err error) {
+	mock.called("GetInstanceCustomPageWithContext", ctx, getInstanceCustomPageOptions)
+	if mock.GetInstanceCustomPageWithContextFunc != nil {
+		return mock.GetInstanceCustomPageWithContextFunc(ctx, getInstanceCustomPageOptions)
+	}
+	if mock.GetInstanceCustomPageFunc != nil {
+		return mock.GetInstanceCustomPageFunc(getInstanceCustomPageOptions)
+	}
+	err = notProgrammed("GetInstanceCustomPageWithContext")
+	return
+}
+
+// UpdateInstanceCustomPage invokes the programmed UpdateInstanceCustomPageFunc.
+func (mock *CustomPagesV1) UpdateInstanceCustomPage(updateInstanceCustomPageOptions *custompagesv1.UpdateInstanceCustomPageOptions) (result *custompagesv1.CustomPageSpecificResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateInstanceCustomPage", updateInstanceCustomPageOptions)
+	if mock.UpdateInstanceCustomPageFunc != nil {
+		return mock.UpdateInstanceCustomPageFunc(updateInstanceCustomPageOptions)
+	}
+	if mock.UpdateInstanceCustomPageWithContextFunc != nil {
+		return mock.UpdateInstanceCustomPageWithContextFunc(context.Background(), updateInstanceCustomPageOptions)
+	}
+	err = notProgrammed("UpdateInstanceCustomPage")
+	return
+}
+
+// UpdateInstanceCustomPageWithContext invokes the programmed UpdateInstanceCustomPageWithContextFunc.
+func (mock *CustomPagesV1) UpdateInstanceCustomPageWithContext(ctx context.Context, updateInstanceCustomPageOptions *custompagesv1.UpdateInstanceCustomPageOptions) (result *custompagesv1.CustomPageSpecificResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateInstanceCustomPageWithContext", ctx, updateInstanceCustomPageOptions)
+	if mock.UpdateInstanceCustomPageWithContextFunc != nil {
+		return mock.UpdateInstanceCustomPageWithContextFunc(ctx, updateInstanceCustomPageOptions)
+	}
+	if mock.UpdateInstanceCustomPageFunc != nil {
+		return mock.UpdateInstanceCustomPageFunc(updateInstanceCustomPageOptions)
+	}
+	err = notProgrammed("UpdateInstanceCustomPageWithContext")
+	return
+}
+
+// ListZoneCustomPages invokes the programmed ListZoneCustomPagesFunc.
+func (mock *CustomPagesV1) ListZoneCustomPages(listZoneCustomPagesOptions *custompagesv1.ListZoneCustomPagesOptions) (result *custompagesv1.ListCustomPagesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListZoneCustomPages", listZoneCustomPagesOptions)
+	if mock.ListZoneCustomPagesFunc != nil {
+		return mock.ListZoneCustomPagesFunc(listZoneCustomPagesOptions)
+	}
+	if mock.ListZoneCustomPagesWithContextFunc != nil {
+		return mock.ListZoneCustomPagesWithContextFunc(context.Background(), listZoneCustomPagesOptions)
+	}
+	err = notProgrammed("ListZoneCustomPages")
+	return
+}
+
+// ListZoneCustomPagesWithContext invokes the programmed ListZoneCustomPagesWithContextFunc.
+func (mock *CustomPagesV1) ListZoneCustomPagesWithContext(ctx context.Context, listZoneCustomPagesOptions *custompagesv1.ListZoneCustomPagesOptions) (result *custompagesv1.ListCustomPagesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListZoneCustomPagesWithContext", ctx, listZoneCustomPagesOptions)
+	if mock.ListZoneCustomPagesWithContextFunc != nil {
+		return mock.ListZoneCustomPagesWithContextFunc(ctx, listZoneCustomPagesOptions)
+	}
+	if mock.ListZoneCustomPagesFunc != nil {
+		return mock.ListZoneCustomPagesFunc(listZoneCustomPagesOptions)
+	}
+	err = notProgrammed("ListZoneCustomPagesWithContext")
+	return
+}
+
+// GetZoneCustomPage invokes the programmed GetZoneCustomPageFunc.
+func (mock *CustomPagesV1) GetZoneCustomPage(getZoneCustomPageOptions *custompagesv1.GetZoneCustomPageOptions) (result *custompagesv1.CustomPageSpecificResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneCustomPage", getZoneCustomPageOptions)
+	if mock.GetZoneCustomPageFunc != nil {
+		return mock.GetZoneCustomPageFunc(getZoneCustomPageOptions)
+	}
+	if mock.GetZoneCustomPageWithContextFunc != nil {
+		return mock.GetZoneCustomPageWithContextFunc(context.Background(), getZoneCustomPageOptions)
+	}
+	err = notProgrammed("GetZoneCustomPage")
+	return
+}
+
+// GetZoneCustomPageWithContext invokes the programmed GetZoneCustomPageWithContextFunc.
+func (mock *CustomPagesV1) GetZoneCustomPageWithContext(ctx context.Context, getZoneCustomPageOptions *custompagesv1.GetZoneCustomPageOptions) (result *custompagesv1.CustomPageSpecificResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneCustomPageWithContext", ctx, getZoneCustomPageOptions)
+	if mock.GetZoneCustomPageWithContextFunc != nil {
+		return mock.GetZoneCustomPageWithContextFunc(ctx, getZoneCustomPageOptions)
+	}
+	if mock.GetZoneCustomPageFunc != nil {
+		return mock.GetZoneCustomPageFunc(getZoneCustomPageOptions)
+	}
+	err = notProgrammed("GetZoneCustomPageWithContext")
+	return
+}
+
+// UpdateZoneCustomPage invokes the programmed UpdateZoneCustomPageFunc.
+func (mock *CustomPagesV1) UpdateZoneCustomPage(updateZoneCustomPageOptions *custompagesv1.UpdateZoneCustomPageOptions) (result *custompagesv1.CustomPageSpecificResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateZoneCustomPage", updateZoneCustomPageOptions)
+	if mock.UpdateZoneCustomPageFunc != nil {
+		return mock.UpdateZoneCustomPageFunc(updateZoneCustomPageOptions)
+	}
+	if mock.UpdateZoneCustomPageWithContextFunc != nil {
+		return mock.UpdateZoneCustomPageWithContextFunc(context.Background(), updateZoneCustomPageOptions)
+	}
+	err = notProgrammed("UpdateZoneCustomPage")
+	return
+}
+
+// UpdateZoneCustomPageWithContext invokes the programmed UpdateZoneCustomPageWithContextFunc.
+func (mock *CustomPagesV1) UpdateZoneCustomPageWithContext(ctx context.Context, updateZoneCustomPageOptions *custompagesv1.UpdateZoneCustomPageOptions) (result *custompagesv1.CustomPageSpecificResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateZoneCustomPageWithContext", ctx, updateZoneCustomPageOptions)
+	if mock.UpdateZoneCustomPageWithContextFunc != nil {
+		return mock.UpdateZoneCustomPageWithContextFunc(ctx, updateZoneCustomPageOptions)
+	}
+	if mock.UpdateZoneCustomPageFunc != nil {
+		return mock.UpdateZoneCustomPageFunc(updateZoneCustomPageOptions)
+	}
+	err = notProgrammed("UpdateZoneCustomPageWithContext")
+	return
+}
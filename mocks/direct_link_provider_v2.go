@@ -0,0 +1,230 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/directlinkproviderv2"
+)
+
+// DirectLinkProviderV2 is a mock of directlinkproviderv2.DirectLinkProviderV2API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type DirectLinkProviderV2 struct {
+	Mock
+
+	ListProviderGatewaysFunc             func(*directlinkproviderv2.ListProviderGatewaysOptions) (*directlinkproviderv2.ProviderGatewayCollection, *core.DetailedResponse, error)
+	ListProviderGatewaysWithContextFunc  func(context.Context, *directlinkproviderv2.ListProviderGatewaysOptions) (*directlinkproviderv2.ProviderGatewayCollection, *core.DetailedResponse, error)
+	CreateProviderGatewayFunc            func(*directlinkproviderv2.CreateProviderGatewayOptions) (*directlinkproviderv2.ProviderGateway, *core.DetailedResponse, error)
+	CreateProviderGatewayWithContextFunc func(context.Context, *directlinkproviderv2.CreateProviderGatewayOptions) (*directlinkproviderv2.ProviderGateway, *core.DetailedResponse, error)
+	DeleteProviderGatewayFunc            func(*directlinkproviderv2.DeleteProviderGatewayOptions) (*directlinkproviderv2.ProviderGateway, *core.DetailedResponse, error)
+	DeleteProviderGatewayWithContextFunc func(context.Context, *directlinkproviderv2.DeleteProviderGatewayOptions) (*directlinkproviderv2.ProviderGateway, *core.DetailedResponse, error)
+	GetProviderGatewayFunc               func(*directlinkproviderv2.GetProviderGatewayOptions) (*directlinkproviderv2.ProviderGateway, *core.DetailedResponse, error)
+	GetProviderGatewayWithContextFunc    func(context.Context, *directlinkproviderv2.GetProviderGatewayOptions) (*directlinkproviderv2.ProviderGateway, *core.DetailedResponse, error)
+	UpdateProviderGatewayFunc            func(*directlinkproviderv2.UpdateProviderGatewayOptions) (*directlinkproviderv2.ProviderGateway, *core.DetailedResponse, error)
+	UpdateProviderGatewayWithContextFunc func(context.Context, *directlinkproviderv2.UpdateProviderGatewayOptions) (*directlinkproviderv2.ProviderGateway, *core.DetailedResponse, error)
+	ListProviderPortsFunc                func(*directlinkproviderv2.ListProviderPortsOptions) (*directlinkproviderv2.ProviderPortCollection, *core.DetailedResponse, error)
+	ListProviderPortsWithContextFunc     func(context.Context, *directlinkproviderv2.ListProviderPortsOptions) (*directlinkproviderv2.ProviderPortCollection, *core.DetailedResponse, error)
+	GetProviderPortFunc                  func(*directlinkproviderv2.GetProviderPortOptions) (*directlinkproviderv2.ProviderPort, *core.DetailedResponse, error)
+	GetProviderPortWithContextFunc       func(context.Context, *directlinkproviderv2.GetProviderPortOptions) (*directlinkproviderv2.ProviderPort, *core.DetailedResponse, error)
+}
+
+// DirectLinkProviderV2 implements directlinkproviderv2.DirectLinkProviderV2API.
+var _ directlinkproviderv2.DirectLinkProviderV2API = (*DirectLinkProviderV2)(nil)
+
+// ListProviderGateways invokes the programmed ListProviderGatewaysFunc.
+func (mock *DirectLinkProviderV2) ListProviderGateways(listProviderGatewaysOptions *directlinkproviderv2.ListProviderGatewaysOptions) (result *directlinkproviderv2.ProviderGatewayCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListProviderGateways", listProviderGatewaysOptions)
+	if mock.ListProviderGatewaysFunc != nil {
+		return mock.ListProviderGatewaysFunc(listProviderGatewaysOptions)
+	}
+	if mock.ListProviderGatewaysWithContextFunc != nil {
+		return mock.ListProviderGatewaysWithContextFunc(context.Background(), listProviderGatewaysOptions)
+	}
+	err = notProgrammed("ListProviderGateways")
+	return
+}
+
+// ListProviderGatewaysWithContext invokes the programmed ListProviderGatewaysWithContextFunc.
+func (mock *DirectLinkProviderV2) ListProviderGatewaysWithContext(ctx context.Context, listProviderGatewaysOptions *directlinkproviderv2.ListProviderGatewaysOptions) (result *directlinkproviderv2.ProviderGatewayCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListProviderGatewaysWithContext", ctx, listProviderGatewaysOptions)
+	if mock.ListProviderGatewaysWithContextFunc != nil {
+		return mock.ListProviderGatewaysWithContextFunc(ctx, listProviderGatewaysOptions)
+	}
+	if mock.ListProviderGatewaysFunc != nil {
+		return mock.ListProviderGatewaysFunc(listProviderGatewaysOptions)
+	}
+	err = notProgrammed("ListProviderGatewaysWithContext")
+	return
+}
+
+// CreateProviderGateway invokes the programmed CreateProviderGatewayFunc.
+func (mock *DirectLinkProviderV2) CreateProviderGateway(createProviderGatewayOptions *directlinkproviderv2.CreateProviderGatewayOptions) (result *directlinkproviderv2.ProviderGateway, response *core.DetailedResponse, err error) {
+	mock.called("CreateProviderGateway", createProviderGatewayOptions)
+	if mock.CreateProviderGatewayFunc != nil {
+		return mock.CreateProviderGatewayFunc(createProviderGatewayOptions)
+	}
+	if mock.CreateProviderGatewayWithContextFunc != nil {
+		return mock.CreateProviderGatewayWithContextFunc(context.Background(), createProviderGatewayOptions)
+	}
+	err = notProgrammed("CreateProviderGateway")
+	return
+}
+
+// CreateProviderGatewayWithContext invokes the programmed CreateProviderGatewayWithContextFunc.
+func (mock *DirectLinkProviderV2) CreateProviderGatewayWithContext(ctx context.Context, createProviderGatewayOptions *directlinkproviderv2.CreateProviderGatewayOptions) (result *directlinkproviderv2.ProviderGateway, response *core.DetailedResponse, err error) {
+	mock.called("CreateProviderGatewayWithContext", ctx, createProviderGatewayOptions)
+	if mock.CreateProviderGatewayWithContextFunc != nil {
+		return mock.CreateProviderGatewayWithContextFunc(ctx, createProviderGatewayOptions)
+	}
+	if mock.CreateProviderGatewayFunc != nil {
+		return mock.CreateProviderGatewayFunc(createProviderGatewayOptions)
+	}
+	err = notProgrammed("CreateProviderGatewayWithContext")
+	return
+}
+
+// DeleteProviderGateway invokes the programmed DeleteProviderGatewayFunc.
+func (mock *DirectLinkProviderV2) DeleteProviderGateway(deleteProviderGatewayOptions *directlinkproviderv2.DeleteProviderGatewayOptions) (result *directlinkproviderv2.ProviderGateway, response *core.DetailedResponse, err error) {
+	mock.called("DeleteProviderGateway", deleteProviderGatewayOptions)
+	if mock.DeleteProviderGatewayFunc != nil {
+		return mock.DeleteProviderGatewayFunc(deleteProviderGatewayOptions)
+	}
+	if mock.DeleteProviderGatewayWithContextFunc != nil {
+		return mock.DeleteProviderGatewayWithContextFunc(context.Background(), deleteProviderGatewayOptions)
+	}
+	err = notProgrammed("DeleteProviderGateway")
+	return
+}
+
+// DeleteProviderGatewayWithContext invokes the programmed DeleteProviderGatewayWithContextFunc.
+func (mock *DirectLinkProviderV2) DeleteProviderGatewayWithContext(ctx context.Context, deleteProviderGatewayOptions *directlinkproviderv2.DeleteProviderGatewayOptions) (result *directlinkproviderv2.ProviderGateway, response *core.DetailedResponse, err error) {
+	mock.called("DeleteProviderGatewayWithContext", ctx, deleteProviderGatewayOptions)
+	if mock.DeleteProviderGatewayWithContextFunc != nil {
+		return mock.DeleteProviderGatewayWithContextFunc(ctx, deleteProviderGatewayOptions)
+	}
+	if mock.DeleteProviderGatewayFunc != nil {
+		return mock.DeleteProviderGatewayFunc(deleteProviderGatewayOptions)
+	}
+	err = notProgrammed("DeleteProviderGatewayWithContext")
+	return
+}
+
+// GetProviderGateway invokes the programmed GetProviderGatewayFunc.
+func (mock *DirectLinkProviderV2) GetProviderGateway(getProviderGatewayOptions *directlinkproviderv2.GetProviderGatewayOptions) (result *directlinkproviderv2.ProviderGateway, response *core.DetailedResponse, err error) {
+	mock.called("GetProviderGateway", getProviderGatewayOptions)
+	if mock.GetProviderGatewayFunc != nil {
+		return mock.GetProviderGatewayFunc(getProviderGatewayOptions)
+	}
+	if mock.GetProviderGatewayWithContextFunc != nil {
+		return mock.GetProviderGatewayWithContextFunc(context.Background(), getProviderGatewayOptions)
+	}
+	err = notProgrammed("GetProviderGateway")
+	return
+}
+
+// GetProviderGatewayWithContext invokes the programmed GetProviderGatewayWithContextFunc.
+func (mock *DirectLinkProviderV2) GetProviderGatewayWithContext(ctx context.Context, getProviderGatewayOptions *directlinkproviderv2.GetProviderGatewayOptions) (result *directlinkproviderv2.ProviderGateway, response *core.DetailedResponse, err error) {
+	mock.called("GetProviderGatewayWithContext", ctx, getProviderGatewayOptions)
+	if mock.GetProviderGatewayWithContextFunc != nil {
+		return mock.GetProviderGatewayWithContextFunc(ctx, getProviderGatewayOptions)
+	}
+	if mock.GetProviderGatewayFunc != nil {
+		return mock.GetProviderGatewayFunc(getProviderGatewayOptions)
+	}
+	err = notProgrammed("GetProviderGatewayWithContext")
+	return
+}
+
+// UpdateProviderGateway invokes the programmed UpdateProviderGatewayFunc.
+func (mock *DirectLinkProviderV2) UpdateProviderGateway(updateProviderGatewayOptions *directlinkproviderv2.UpdateProviderGatewayOptions) (result *directlinkproviderv2.ProviderGateway, response *core.DetailedResponse, err error) {
+	mock.called("UpdateProviderGateway", updateProviderGatewayOptions)
+	if mock.UpdateProviderGatewayFunc != nil {
+		return mock.UpdateProviderGatewayFunc(updateProviderGatewayOptions)
+	}
+	if mock.UpdateProviderGatewayWithContextFunc != nil {
+		return mock.UpdateProviderGatewayWithContextFunc(context.Background(), updateProviderGatewayOptions)
+	}
+	err = notProgrammed("UpdateProviderGateway")
+	return
+}
+
+// UpdateProviderGatewayWithContext invokes the programmed UpdateProviderGatewayWithContextFunc.
+func (mock *DirectLinkProviderV2) UpdateProviderGatewayWithContext(ctx context.Context, updateProviderGatewayOptions *directlinkproviderv2.UpdateProviderGatewayOptions) (result *directlinkproviderv2.ProviderGateway, response *core.DetailedResponse, err error) {
+	mock.called("UpdateProviderGatewayWithContext", ctx, updateProviderGatewayOptions)
+	if mock.UpdateProviderGatewayWithContextFunc != nil {
+		return mock.UpdateProviderGatewayWithContextFunc(ctx, updateProviderGatewayOptions)
+	}
+	if mock.UpdateProviderGatewayFunc != nil {
+		return mock.UpdateProviderGatewayFunc(updateProviderGatewayOptions)
+	}
+	err = notProgrammed("UpdateProviderGatewayWithContext")
+	return
+}
+
+// ListProviderPorts invokes the programmed ListProviderPortsFunc.
+func (mock *DirectLinkProviderV2) ListProviderPorts(listProviderPortsOptions *directlinkproviderv2.ListProviderPortsOptions) (result *directlinkproviderv2.ProviderPortCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListProviderPorts", listProviderPortsOptions)
+	if mock.ListProviderPortsFunc != nil {
+		return mock.ListProviderPortsFunc(listProviderPortsOptions)
+	}
+	if mock.ListProviderPortsWithContextFunc != nil {
+		return mock.ListProviderPortsWithContextFunc(context.Background(), listProviderPortsOptions)
+	}
+	err = notProgrammed("ListProviderPorts")
+	return
+}
+
+// ListProviderPortsWithContext invokes the programmed ListProviderPortsWithContextFunc.
+func (mock *DirectLinkProviderV2) ListProviderPortsWithContext(ctx context.Context, listProviderPortsOptions *directlinkproviderv2.ListProviderPortsOptions) (result *directlinkproviderv2.ProviderPortCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListProviderPortsWithContext", ctx, listProviderPortsOptions)
+	if mock.ListProviderPortsWithContextFunc != nil {
+		return mock.ListProviderPortsWithContextFunc(ctx, listProviderPortsOptions)
+	}
+	if mock.ListProviderPortsFunc != nil {
+		return mock.ListProviderPortsFunc(listProviderPortsOptions)
+	}
+	err = notProgrammed("ListProviderPortsWithContext")
+	return
+}
+
+// GetProviderPort invokes the programmed GetProviderPortFunc.
+func (mock *DirectLinkProviderV2) GetProviderPort(getProviderPortOptions *directlinkproviderv2.GetProviderPortOptions) (result *directlinkproviderv2.ProviderPort, response *core.DetailedResponse, err error) {
+	mock.called("GetProviderPort", getProviderPortOptions)
+	if mock.GetProviderPortFunc != nil {
+		return mock.GetProviderPortFunc(getProviderPortOptions)
+	}
+	if mock.GetProviderPortWithContextFunc != nil {
+		return mock.GetProviderPortWithContextFunc(context.Background(), getProviderPortOptions)
+	}
+	err = notProgrammed("GetProviderPort")
+	return
+}
+
+// GetProviderPortWithContext invokes the programmed GetProviderPortWithContextFunc.
+func (mock *DirectLinkProviderV2) GetProviderPortWithContext(ctx context.Context, getProviderPortOptions *directlinkproviderv2.GetProviderPortOptions) (result *directlinkproviderv2.ProviderPort, response *core.DetailedResponse, err error) {
+	mock.called("GetProviderPortWithContext", ctx, getProviderPortOptions)
+	if mock.GetProviderPortWithContextFunc != nil {
+		return mock.GetProviderPortWithContextFunc(ctx, getProviderPortOptions)
+	}
+	if mock.GetProviderPortFunc != nil {
+		return mock.GetProviderPortFunc(getProviderPortOptions)
+	}
+	err = notProgrammed("GetProviderPortWithContext")
+	return
+}
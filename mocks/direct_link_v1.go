@@ -0,0 +1,623 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+)
+
+// DirectLinkV1 is a mock of directlinkv1.DirectLinkV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type DirectLinkV1 struct {
+	Mock
+
+	ListGatewaysFunc                                           func(*directlinkv1.ListGatewaysOptions) (*directlinkv1.GatewayCollection, *core.DetailedResponse, error)
+	ListGatewaysWithContextFunc                                func(context.Context, *directlinkv1.ListGatewaysOptions) (*directlinkv1.GatewayCollection, *core.DetailedResponse, error)
+	CreateGatewayFunc                                          func(*directlinkv1.CreateGatewayOptions) (*directlinkv1.Gateway, *core.DetailedResponse, error)
+	CreateGatewayWithContextFunc                               func(context.Context, *directlinkv1.CreateGatewayOptions) (*directlinkv1.Gateway, *core.DetailedResponse, error)
+	DeleteGatewayFunc                                          func(*directlinkv1.DeleteGatewayOptions) (*core.DetailedResponse, error)
+	DeleteGatewayWithContextFunc                               func(context.Context, *directlinkv1.DeleteGatewayOptions) (*core.DetailedResponse, error)
+	GetGatewayFunc                                             func(*directlinkv1.GetGatewayOptions) (*directlinkv1.Gateway, *core.DetailedResponse, error)
+	GetGatewayWithContextFunc                                  func(context.Context, *directlinkv1.GetGatewayOptions) (*directlinkv1.Gateway, *core.DetailedResponse, error)
+	UpdateGatewayFunc                                          func(*directlinkv1.UpdateGatewayOptions) (*directlinkv1.Gateway, *core.DetailedResponse, error)
+	UpdateGatewayWithContextFunc                               func(context.Context, *directlinkv1.UpdateGatewayOptions) (*directlinkv1.Gateway, *core.DetailedResponse, error)
+	CreateGatewayActionFunc                                    func(*directlinkv1.CreateGatewayActionOptions) (*directlinkv1.Gateway, *core.DetailedResponse, error)
+	CreateGatewayActionWithContextFunc                         func(context.Context, *directlinkv1.CreateGatewayActionOptions) (*directlinkv1.Gateway, *core.DetailedResponse, error)
+	ListGatewayCompletionNoticeFunc                            func(*directlinkv1.ListGatewayCompletionNoticeOptions) (io.ReadCloser, *core.DetailedResponse, error)
+	ListGatewayCompletionNoticeWithContextFunc                 func(context.Context, *directlinkv1.ListGatewayCompletionNoticeOptions) (io.ReadCloser, *core.DetailedResponse, error)
+	CreateGatewayCompletionNoticeFunc                          func(*directlinkv1.CreateGatewayCompletionNoticeOptions) (*core.DetailedResponse, error)
+	CreateGatewayCompletionNoticeWithContextFunc               func(context.Context, *directlinkv1.CreateGatewayCompletionNoticeOptions) (*core.DetailedResponse, error)
+	ListGatewayLetterOfAuthorizationFunc                       func(*directlinkv1.ListGatewayLetterOfAuthorizationOptions) (io.ReadCloser, *core.DetailedResponse, error)
+	ListGatewayLetterOfAuthorizationWithContextFunc            func(context.Context, *directlinkv1.ListGatewayLetterOfAuthorizationOptions) (io.ReadCloser, *core.DetailedResponse, error)
+	GetGatewayStatisticsFunc                                   func(*directlinkv1.GetGatewayStatisticsOptions) (*directlinkv1.GatewayStatisticCollection, *core.DetailedResponse, error)
+	GetGatewayStatisticsWithContextFunc                        func(context.Context, *directlinkv1.GetGatewayStatisticsOptions) (*directlinkv1.GatewayStatisticCollection, *core.DetailedResponse, error)
+	GetGatewayStatusFunc                                       func(*directlinkv1.GetGatewayStatusOptions) (*directlinkv1.GatewayStatusCollection, *core.DetailedResponse, error)
+	GetGatewayStatusWithContextFunc                            func(context.Context, *directlinkv1.GetGatewayStatusOptions) (*directlinkv1.GatewayStatusCollection, *core.DetailedResponse, error)
+	ListOfferingTypeLocationsFunc                              func(*directlinkv1.ListOfferingTypeLocationsOptions) (*directlinkv1.LocationCollection, *core.DetailedResponse, error)
+	ListOfferingTypeLocationsWithContextFunc                   func(context.Context, *directlinkv1.ListOfferingTypeLocationsOptions) (*directlinkv1.LocationCollection, *core.DetailedResponse, error)
+	ListOfferingTypeLocationCrossConnectRoutersFunc            func(*directlinkv1.ListOfferingTypeLocationCrossConnectRoutersOptions) (*directlinkv1.LocationCrossConnectRouterCollection, *core.DetailedResponse, error)
+	ListOfferingTypeLocationCrossConnectRoutersWithContextFunc func(context.Context, *directlinkv1.ListOfferingTypeLocationCrossConnectRoutersOptions) (*directlinkv1.LocationCrossConnectRouterCollection, *core.DetailedResponse, error)
+	ListOfferingTypeSpeedsFunc                                 func(*directlinkv1.ListOfferingTypeSpeedsOptions) (*directlinkv1.OfferingSpeedCollection, *core.DetailedResponse, error)
+	ListOfferingTypeSpeedsWithContextFunc                      func(context.Context, *directlinkv1.ListOfferingTypeSpeedsOptions) (*directlinkv1.OfferingSpeedCollection, *core.DetailedResponse, error)
+	ListPortsFunc                                              func(*directlinkv1.ListPortsOptions) (*directlinkv1.PortCollection, *core.DetailedResponse, error)
+	ListPortsWithContextFunc                                   func(context.Context, *directlinkv1.ListPortsOptions) (*directlinkv1.PortCollection, *core.DetailedResponse, error)
+	GetPortFunc                                                func(*directlinkv1.GetPortOptions) (*directlinkv1.Port, *core.DetailedResponse, error)
+	GetPortWithContextFunc                                     func(context.Context, *directlinkv1.GetPortOptions) (*directlinkv1.Port, *core.DetailedResponse, error)
+	ListGatewayVirtualConnectionsFunc                          func(*directlinkv1.ListGatewayVirtualConnectionsOptions) (*directlinkv1.GatewayVirtualConnectionCollection, *core.DetailedResponse, error)
+	ListGatewayVirtualConnectionsWithContextFunc               func(context.Context, *directlinkv1.ListGatewayVirtualConnectionsOptions) (*directlinkv1.GatewayVirtualConnectionCollection, *core.DetailedResponse, error)
+	CreateGatewayVirtualConnectionFunc                         func(*directlinkv1.CreateGatewayVirtualConnectionOptions) (*directlinkv1.GatewayVirtualConnection, *core.DetailedResponse, error)
+	CreateGatewayVirtualConnectionWithContextFunc              func(context.Context, *directlinkv1.CreateGatewayVirtualConnectionOptions) (*directlinkv1.GatewayVirtualConnection, *core.DetailedResponse, error)
+	DeleteGatewayVirtualConnectionFunc                         func(*directlinkv1.DeleteGatewayVirtualConnectionOptions) (*core.DetailedResponse, error)
+	DeleteGatewayVirtualConnectionWithContextFunc              func(context.Context, *directlinkv1.DeleteGatewayVirtualConnectionOptions) (*core.DetailedResponse, error)
+	GetGatewayVirtualConnectionFunc                            func(*directlinkv1.GetGatewayVirtualConnectionOptions) (*directlinkv1.GatewayVirtualConnection, *core.DetailedResponse, error)
+	GetGatewayVirtualConnectionWithContextFunc                 func(context.Context, *directlinkv1.GetGatewayVirtualConnectionOptions) (*directlinkv1.GatewayVirtualConnection, *core.DetailedResponse, error)
+	UpdateGatewayVirtualConnectionFunc                         func(*directlinkv1.UpdateGatewayVirtualConnectionOptions) (*directlinkv1.GatewayVirtualConnection, *core.DetailedResponse, error)
+	UpdateGatewayVirtualConnectionWithContextFunc              func(context.Context, *directlinkv1.UpdateGatewayVirtualConnectionOptions) (*directlinkv1.GatewayVirtualConnection, *core.DetailedResponse, error)
+}
+
+// DirectLinkV1 implements directlinkv1.DirectLinkV1API.
+var _ directlinkv1.DirectLinkV1API = (*DirectLinkV1)(nil)
+
+// ListGateways invokes the programmed ListGatewaysFunc.
+func (mock *DirectLinkV1) ListGateways(listGatewaysOptions *directlinkv1.ListGatewaysOptions) (result *directlinkv1.GatewayCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListGateways", listGatewaysOptions)
+	if mock.ListGatewaysFunc != nil {
+		return mock.ListGatewaysFunc(listGatewaysOptions)
+	}
+	if mock.ListGatewaysWithContextFunc != nil {
+		return mock.ListGatewaysWithContextFunc(context.Background(), listGatewaysOptions)
+	}
+	err = notProgrammed("ListGateways")
+	return
+}
+
+// ListGatewaysWithContext invokes the programmed ListGatewaysWithContextFunc.
+func (mock *DirectLinkV1) ListGatewaysWithContext(ctx context.Context, listGatewaysOptions *directlinkv1.ListGatewaysOptions) (result *directlinkv1.GatewayCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListGatewaysWithContext", ctx, listGatewaysOptions)
+	if mock.ListGatewaysWithContextFunc != nil {
+		return mock.ListGatewaysWithContextFunc(ctx, listGatewaysOptions)
+	}
+	if mock.ListGatewaysFunc != nil {
+		return mock.ListGatewaysFunc(listGatewaysOptions)
+	}
+	err = notProgrammed("ListGatewaysWithContext")
+	return
+}
+
+// CreateGateway invokes the programmed CreateGatewayFunc.
+func (mock *DirectLinkV1) CreateGateway(createGatewayOptions *directlinkv1.CreateGatewayOptions) (result *directlinkv1.Gateway, response *core.DetailedResponse, err error) {
+	mock.called("CreateGateway", createGatewayOptions)
+	if mock.CreateGatewayFunc != nil {
+		return mock.CreateGatewayFunc(createGatewayOptions)
+	}
+	if mock.CreateGatewayWithContextFunc != nil {
+		return mock.CreateGatewayWithContextFunc(context.Background(), createGatewayOptions)
+	}
+	err = notProgrammed("CreateGateway")
+	return
+}
+
+// CreateGatewayWithContext invokes the programmed CreateGatewayWithContextFunc.
+func (mock *DirectLinkV1) CreateGatewayWithContext(ctx context.Context, createGatewayOptions *directlinkv1.CreateGatewayOptions) (result *directlinkv1.Gateway, response *core.DetailedResponse, err error) {
+	mock.called("CreateGatewayWithContext", ctx, createGatewayOptions)
+	if mock.CreateGatewayWithContextFunc != nil {
+		return mock.CreateGatewayWithContextFunc(ctx, createGatewayOptions)
+	}
+	if mock.CreateGatewayFunc != nil {
+		return mock.CreateGatewayFunc(createGatewayOptions)
+	}
+	err = notProgrammed("CreateGatewayWithContext")
+	return
+}
+
+// DeleteGateway invokes the programmed DeleteGatewayFunc.
+func (mock *DirectLinkV1) DeleteGateway(deleteGatewayOptions *directlinkv1.DeleteGatewayOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteGateway", deleteGatewayOptions)
+	if mock.DeleteGatewayFunc != nil {
+		return mock.DeleteGatewayFunc(deleteGatewayOptions)
+	}
+	if mock.DeleteGatewayWithContextFunc != nil {
+		return mock.DeleteGatewayWithContextFunc(context.Background(), deleteGatewayOptions)
+	}
+	err = notProgrammed("DeleteGateway")
+	return
+}
+
+// DeleteGatewayWithContext invokes the programmed DeleteGatewayWithContextFunc.
+func (mock *DirectLinkV1) DeleteGatewayWithContext(ctx context.Context, deleteGatewayOptions *directlinkv1.DeleteGatewayOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteGatewayWithContext", ctx, deleteGatewayOptions)
+	if mock.DeleteGatewayWithContextFunc != nil {
+		return mock.DeleteGatewayWithContextFunc(ctx, deleteGatewayOptions)
+	}
+	if mock.DeleteGatewayFunc != nil {
+		return mock.DeleteGatewayFunc(deleteGatewayOptions)
+	}
+	err = notProgrammed("DeleteGatewayWithContext")
+	return
+}
+
+// GetGateway invokes the programmed GetGatewayFunc.
+func (mock *DirectLinkV1) GetGateway(getGatewayOptions *directlinkv1.GetGatewayOptions) (result *directlinkv1.Gateway, response *core.DetailedResponse, err error) {
+	mock.called("GetGateway", getGatewayOptions)
+	if mock.GetGatewayFunc != nil {
+		return mock.GetGatewayFunc(getGatewayOptions)
+	}
+	if mock.GetGatewayWithContextFunc != nil {
+		return mock.GetGatewayWithContextFunc(context.Background(), getGatewayOptions)
+	}
+	err = notProgrammed("GetGateway")
+	return
+}
+
+// GetGatewayWithContext invokes the programmed GetGatewayWithContextFunc.
+func (mock *DirectLinkV1) GetGatewayWithContext(ctx context.Context, getGatewayOptions *directlinkv1.GetGatewayOptions) (result *directlinkv1.Gateway, response *core.DetailedResponse, err error) {
+	mock.called("GetGatewayWithContext", ctx, getGatewayOptions)
+	if mock.GetGatewayWithContextFunc != nil {
+		return mock.GetGatewayWithContextFunc(ctx, getGatewayOptions)
+	}
+	if mock.GetGatewayFunc != nil {
+		return mock.GetGatewayFunc(getGatewayOptions)
+	}
+	err = notProgrammed("GetGatewayWithContext")
+	return
+}
+
+// UpdateGateway invokes the programmed UpdateGatewayFunc.
+func (mock *DirectLinkV1) UpdateGateway(updateGatewayOptions *directlinkv1.UpdateGatewayOptions) (result *directlinkv1.Gateway, response *core.DetailedResponse, err error) {
+	mock.called("UpdateGateway", updateGatewayOptions)
+	if mock.UpdateGatewayFunc != nil {
+		return mock.UpdateGatewayFunc(updateGatewayOptions)
+	}
+	if mock.UpdateGatewayWithContextFunc != nil {
+		return mock.UpdateGatewayWithContextFunc(context.Background(), updateGatewayOptions)
+	}
+	err = notProgrammed("UpdateGateway")
+	return
+}
+
+// UpdateGatewayWithContext invokes the programmed UpdateGatewayWithContextFunc.
+func (mock *DirectLinkV1) UpdateGatewayWithContext(ctx context.Context, updateGatewayOptions *directlinkv1.UpdateGatewayOptions) (result *directlinkv1.Gateway, response *core.DetailedResponse, err error) {
+	mock.called("UpdateGatewayWithContext", ctx, updateGatewayOptions)
+	if mock.UpdateGatewayWithContextFunc != nil {
+		return mock.UpdateGatewayWithContextFunc(ctx, updateGatewayOptions)
+	}
+	if mock.UpdateGatewayFunc != nil {
+		return mock.UpdateGatewayFunc(updateGatewayOptions)
+	}
+	err = notProgrammed("UpdateGatewayWithContext")
+	return
+}
+
+// CreateGatewayAction invokes the programmed CreateGatewayActionFunc.
+func (mock *DirectLinkV1) CreateGatewayAction(createGatewayActionOptions *directlinkv1.CreateGatewayActionOptions) (result *directlinkv1.Gateway, response *core.DetailedResponse, err error) {
+	mock.called("CreateGatewayAction", createGatewayActionOptions)
+	if mock.CreateGatewayActionFunc != nil {
+		return mock.CreateGatewayActionFunc(createGatewayActionOptions)
+	}
+	if mock.CreateGatewayActionWithContextFunc != nil {
+		return mock.CreateGatewayActionWithContextFunc(context.Background(), createGatewayActionOptions)
+	}
+	err = notProgrammed("CreateGatewayAction")
+	return
+}
+
+// CreateGatewayActionWithContext invokes the programmed CreateGatewayActionWithContextFunc.
+func (mock *DirectLinkV1) CreateGatewayActionWithContext(ctx context.Context, createGatewayActionOptions *directlinkv1.CreateGatewayActionOptions) (result *directlinkv1.Gateway, response *core.DetailedResponse, err error) {
+	mock.called("CreateGatewayActionWithContext", ctx, createGatewayActionOptions)
+	if mock.CreateGatewayActionWithContextFunc != nil {
+		return mock.CreateGatewayActionWithContextFunc(ctx, createGatewayActionOptions)
+	}
+	if mock.CreateGatewayActionFunc != nil {
+		return mock.CreateGatewayActionFunc(createGatewayActionOptions)
+	}
+	err = notProgrammed("CreateGatewayActionWithContext")
+	return
+}
+
+// ListGatewayCompletionNotice invokes the programmed ListGatewayCompletionNoticeFunc.
+func (mock *DirectLinkV1) ListGatewayCompletionNotice(listGatewayCompletionNoticeOptions *directlinkv1.ListGatewayCompletionNoticeOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.called("ListGatewayCompletionNotice", listGatewayCompletionNoticeOptions)
+	if mock.ListGatewayCompletionNoticeFunc != nil {
+		return mock.ListGatewayCompletionNoticeFunc(listGatewayCompletionNoticeOptions)
+	}
+	if mock.ListGatewayCompletionNoticeWithContextFunc != nil {
+		return mock.ListGatewayCompletionNoticeWithContextFunc(context.Background(), listGatewayCompletionNoticeOptions)
+	}
+	err = notProgrammed("ListGatewayCompletionNotice")
+	return
+}
+
+// ListGatewayCompletionNoticeWithContext invokes the programmed ListGatewayCompletionNoticeWithContextFunc.
+func (mock *DirectLinkV1) ListGatewayCompletionNoticeWithContext(ctx context.Context, listGatewayCompletionNoticeOptions *directlinkv1.ListGatewayCompletionNoticeOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.called("ListGatewayCompletionNoticeWithContext", ctx, listGatewayCompletionNoticeOptions)
+	if mock.ListGatewayCompletionNoticeWithContextFunc != nil {
+		return mock.ListGatewayCompletionNoticeWithContextFunc(ctx, listGatewayCompletionNoticeOptions)
+	}
+	if mock.ListGatewayCompletionNoticeFunc != nil {
+		return mock.ListGatewayCompletionNoticeFunc(listGatewayCompletionNoticeOptions)
+	}
+	err = notProgrammed("ListGatewayCompletionNoticeWithContext")
+	return
+}
+
+// CreateGatewayCompletionNotice invokes the programmed CreateGatewayCompletionNoticeFunc.
+func (mock *DirectLinkV1) CreateGatewayCompletionNotice(createGatewayCompletionNoticeOptions *directlinkv1.CreateGatewayCompletionNoticeOptions) (response *core.DetailedResponse, err error) {
+	mock.called("CreateGatewayCompletionNotice", createGatewayCompletionNoticeOptions)
+	if mock.CreateGatewayCompletionNoticeFunc != nil {
+		return mock.CreateGatewayCompletionNoticeFunc(createGatewayCompletionNoticeOptions)
+	}
+	if mock.CreateGatewayCompletionNoticeWithContextFunc != nil {
+		return mock.CreateGatewayCompletionNoticeWithContextFunc(context.Background(), createGatewayCompletionNoticeOptions)
+	}
+	err = notProgrammed("CreateGatewayCompletionNotice")
+	return
+}
+
+// CreateGatewayCompletionNoticeWithContext invokes the programmed CreateGatewayCompletionNoticeWithContextFunc.
+func (mock *DirectLinkV1) CreateGatewayCompletionNoticeWithContext(ctx context.Context, createGatewayCompletionNoticeOptions *directlinkv1.CreateGatewayCompletionNoticeOptions) (response *core.DetailedResponse, err error) {
+	mock.called("CreateGatewayCompletionNoticeWithContext", ctx, createGatewayCompletionNoticeOptions)
+	if mock.CreateGatewayCompletionNoticeWithContextFunc != nil {
+		return mock.CreateGatewayCompletionNoticeWithContextFunc(ctx, createGatewayCompletionNoticeOptions)
+	}
+	if mock.CreateGatewayCompletionNoticeFunc != nil {
+		return mock.CreateGatewayCompletionNoticeFunc(createGatewayCompletionNoticeOptions)
+	}
+	err = notProgrammed("CreateGatewayCompletionNoticeWithContext")
+	return
+}
+
+// ListGatewayLetterOfAuthorization invokes the programmed ListGatewayLetterOfAuthorizationFunc.
+func (mock *DirectLinkV1) ListGatewayLetterOfAuthorization(listGatewayLetterOfAuthorizationOptions *directlinkv1.ListGatewayLetterOfAuthorizationOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.called("ListGatewayLetterOfAuthorization", listGatewayLetterOfAuthorizationOptions)
+	if mock.ListGatewayLetterOfAuthorizationFunc != nil {
+		return mock.ListGatewayLetterOfAuthorizationFunc(listGatewayLetterOfAuthorizationOptions)
+	}
+	if mock.ListGatewayLetterOfAuthorizationWithContextFunc != nil {
+		return mock.ListGatewayLetterOfAuthorizationWithContextFunc(context.Background(), listGatewayLetterOfAuthorizationOptions)
+	}
+	err = notProgrammed("ListGatewayLetterOfAuthorization")
+	return
+}
+
+// ListGatewayLetterOfAuthorizationWithContext invokes the programmed ListGatewayLetterOfAuthorizationWithContextFunc.
+func (mock *DirectLinkV1) ListGatewayLetterOfAuthorizationWithContext(ctx context.Context, listGatewayLetterOfAuthorizationOptions *directlinkv1.ListGatewayLetterOfAuthorizationOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.called("ListGatewayLetterOfAuthorizationWithContext", ctx, listGatewayLetterOfAuthorizationOptions)
+	if mock.ListGatewayLetterOfAuthorizationWithContextFunc != nil {
+		return mock.ListGatewayLetterOfAuthorizationWithContextFunc(ctx, listGatewayLetterOfAuthorizationOptions)
+	}
+	if mock.ListGatewayLetterOfAuthorizationFunc != nil {
+		return mock.ListGatewayLetterOfAuthorizationFunc(listGatewayLetterOfAuthorizationOptions)
+	}
+	err = notProgrammed("ListGatewayLetterOfAuthorizationWithContext")
+	return
+}
+
+// GetGatewayStatistics invokes the programmed GetGatewayStatisticsFunc.
+func (mock *DirectLinkV1) GetGatewayStatistics(getGatewayStatisticsOptions *directlinkv1.GetGatewayStatisticsOptions) (result *directlinkv1.GatewayStatisticCollection, response *core.DetailedResponse, err error) {
+	mock.called("GetGatewayStatistics", getGatewayStatisticsOptions)
+	if mock.GetGatewayStatisticsFunc != nil {
+		return mock.GetGatewayStatisticsFunc(getGatewayStatisticsOptions)
+	}
+	if mock.GetGatewayStatisticsWithContextFunc != nil {
+		return mock.GetGatewayStatisticsWithContextFunc(context.Background(), getGatewayStatisticsOptions)
+	}
+	err = notProgrammed("GetGatewayStatistics")
+	return
+}
+
+// GetGatewayStatisticsWithContext invokes the programmed GetGatewayStatisticsWithContextFunc.
+func (mock *DirectLinkV1) GetGatewayStatisticsWithContext(ctx context.Context, getGatewayStatisticsOptions *directlinkv1.GetGatewayStatisticsOptions) (result *directlinkv1.GatewayStatisticCollection, response *core.DetailedResponse, err error) {
+	mock.called("GetGatewayStatisticsWithContext", ctx, getGatewayStatisticsOptions)
+	if mock.GetGatewayStatisticsWithContextFunc != nil {
+		return mock.GetGatewayStatisticsWithContextFunc(ctx, getGatewayStatisticsOptions)
+	}
+	if mock.GetGatewayStatisticsFunc != nil {
+		return mock.GetGatewayStatisticsFunc(getGatewayStatisticsOptions)
+	}
+	err = notProgrammed("GetGatewayStatisticsWithContext")
+	return
+}
+
+// GetGatewayStatus invokes the programmed GetGatewayStatusFunc.
+func (mock *DirectLinkV1) GetGatewayStatus(getGatewayStatusOptions *directlinkv1.GetGatewayStatusOptions) (result *directlinkv1.GatewayStatusCollection, response *core.DetailedResponse, err error) {
+	mock.called("GetGatewayStatus", getGatewayStatusOptions)
+	if mock.GetGatewayStatusFunc != nil {
+		return mock.GetGatewayStatusFunc(getGatewayStatusOptions)
+	}
+	if mock.GetGatewayStatusWithContextFunc != nil {
+		return mock.GetGatewayStatusWithContextFunc(context.Background(), getGatewayStatusOptions)
+	}
+	err = notProgrammed("GetGatewayStatus")
+	return
+}
+
+// GetGatewayStatusWithContext invokes the programmed GetGatewayStatusWithContextFunc.
+func (mock *DirectLinkV1) GetGatewayStatusWithContext(ctx context.Context, getGatewayStatusOptions *directlinkv1.GetGatewayStatusOptions) (result *directlinkv1.GatewayStatusCollection, response *core.DetailedResponse, err error) {
+	mock.called("GetGatewayStatusWithContext", ctx, getGatewayStatusOptions)
+	if mock.GetGatewayStatusWithContextFunc != nil {
+		return mock.GetGatewayStatusWithContextFunc(ctx, getGatewayStatusOptions)
+	}
+	if mock.GetGatewayStatusFunc != nil {
+		return mock.GetGatewayStatusFunc(getGatewayStatusOptions)
+	}
+	err = notProgrammed("GetGatewayStatusWithContext")
+	return
+}
+
+// ListOfferingTypeLocations invokes the programmed ListOfferingTypeLocationsFunc.
+func (mock *DirectLinkV1) ListOfferingTypeLocations(listOfferingTypeLocationsOptions *directlinkv1.ListOfferingTypeLocationsOptions) (result *directlinkv1.LocationCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListOfferingTypeLocations", listOfferingTypeLocationsOptions)
+	if mock.ListOfferingTypeLocationsFunc != nil {
+		return mock.ListOfferingTypeLocationsFunc(listOfferingTypeLocationsOptions)
+	}
+	if mock.ListOfferingTypeLocationsWithContextFunc != nil {
+		return mock.ListOfferingTypeLocationsWithContextFunc(context.Background(), listOfferingTypeLocationsOptions)
+	}
+	err = notProgrammed("ListOfferingTypeLocations")
+	return
+}
+
+// ListOfferingTypeLocationsWithContext invokes the programmed ListOfferingTypeLocationsWithContextFunc.
+func (mock *DirectLinkV1) ListOfferingTypeLocationsWithContext(ctx context.Context, listOfferingTypeLocationsOptions *directlinkv1.ListOfferingTypeLocationsOptions) (result *directlinkv1.LocationCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListOfferingTypeLocationsWithContext", ctx, listOfferingTypeLocationsOptions)
+	if mock.ListOfferingTypeLocationsWithContextFunc != nil {
+		return mock.ListOfferingTypeLocationsWithContextFunc(ctx, listOfferingTypeLocationsOptions)
+	}
+	if mock.ListOfferingTypeLocationsFunc != nil {
+		return mock.ListOfferingTypeLocationsFunc(listOfferingTypeLocationsOptions)
+	}
+	err = notProgrammed("ListOfferingTypeLocationsWithContext")
+	return
+}
+
+// ListOfferingTypeLocationCrossConnectRouters invokes the programmed ListOfferingTypeLocationCrossConnectRoutersFunc.
+func (mock *DirectLinkV1) ListOfferingTypeLocationCrossConnectRouters(listOfferingTypeLocationCrossConnectRoutersOptions *directlinkv1.ListOfferingTypeLocationCrossConnectRoutersOptions) (result *directlinkv1.LocationCrossConnectRouterCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListOfferingTypeLocationCrossConnectRouters", listOfferingTypeLocationCrossConnectRoutersOptions)
+	if mock.ListOfferingTypeLocationCrossConnectRoutersFunc != nil {
+		return mock.ListOfferingTypeLocationCrossConnectRoutersFunc(listOfferingTypeLocationCrossConnectRoutersOptions)
+	}
+	if mock.ListOfferingTypeLocationCrossConnectRoutersWithContextFunc != nil {
+		return mock.ListOfferingTypeLocationCrossConnectRoutersWithContextFunc(context.Background(), listOfferingTypeLocationCrossConnectRoutersOptions)
+	}
+	err = notProgrammed("ListOfferingTypeLocationCrossConnectRouters")
+	return
+}
+
+// ListOfferingTypeLocationCrossConnectRoutersWithContext invokes the programmed ListOfferingTypeLocationCrossConnectRoutersWithContextFunc.
+func (mock *DirectLinkV1) ListOfferingTypeLocationCrossConnectRoutersWithContext(ctx context.Context, listOfferingTypeLocationCrossConnectRoutersOptions *directlinkv1.ListOfferingTypeLocationCrossConnectRoutersOptions) (result *directlinkv1.LocationCrossConnectRouterCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListOfferingTypeLocationCrossConnectRoutersWithContext", ctx, listOfferingTypeLocationCrossConnectRoutersOptions)
+	if mock.ListOfferingTypeLocationCrossConnectRoutersWithContextFunc != nil {
+		return mock.ListOfferingTypeLocationCrossConnectRoutersWithContextFunc(ctx, listOfferingTypeLocationCrossConnectRoutersOptions)
+	}
+	if mock.ListOfferingTypeLocationCrossConnectRoutersFunc != nil {
+		return mock.ListOfferingTypeLocationCrossConnectRoutersFunc(listOfferingTypeLocationCrossConnectRoutersOptions)
+	}
+	err = notProgrammed("ListOfferingTypeLocationCrossConnectRoutersWithContext")
+	return
+}
+
+// ListOfferingTypeSpeeds invokes the programmed ListOfferingTypeSpeedsFunc.
+func (mock *DirectLinkV1) ListOfferingTypeSpeeds(listOfferingTypeSpeedsOptions *directlinkv1.ListOfferingTypeSpeedsOptions) (result *directlinkv1.OfferingSpeedCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListOfferingTypeSpeeds", listOfferingTypeSpeedsOptions)
+	if mock.ListOfferingTypeSpeedsFunc != nil {
+		return mock.ListOfferingTypeSpeedsFunc(listOfferingTypeSpeedsOptions)
+	}
+	if mock.ListOfferingTypeSpeedsWithContextFunc != nil {
+		return mock.ListOfferingTypeSpeedsWithContextFunc(context.Background(), listOfferingTypeSpeedsOptions)
+	}
+	err = notProgrammed("ListOfferingTypeSpeeds")
+	return
+}
+
+// ListOfferingTypeSpeedsWithContext invokes the programmed ListOfferingTypeSpeedsWithContextFunc.
+func (mock *DirectLinkV1) ListOfferingTypeSpeedsWithContext(ctx context.Context, listOfferingTypeSpeedsOptions *directlinkv1.ListOfferingTypeSpeedsOptions) (result *directlinkv1.OfferingSpeedCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListOfferingTypeSpeedsWithContext", ctx, listOfferingTypeSpeedsOptions)
+	if mock.ListOfferingTypeSpeedsWithContextFunc != nil {
+		return mock.ListOfferingTypeSpeedsWithContextFunc(ctx, listOfferingTypeSpeedsOptions)
+	}
+	if mock.ListOfferingTypeSpeedsFunc != nil {
+		return mock.ListOfferingTypeSpeedsFunc(listOfferingTypeSpeedsOptions)
+	}
+	err = notProgrammed("ListOfferingTypeSpeedsWithContext")
+	return
+}
+
+// ListPorts invokes the programmed ListPortsFunc.
+func (mock *DirectLinkV1) ListPorts(listPortsOptions *directlinkv1.ListPortsOptions) (result *directlinkv1.PortCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListPorts", listPortsOptions)
+	if mock.ListPortsFunc != nil {
+		return mock.ListPortsFunc(listPortsOptions)
+	}
+	if mock.ListPortsWithContextFunc != nil {
+		return mock.ListPortsWithContextFunc(context.Background(), listPortsOptions)
+	}
+	err = notProgrammed("ListPorts")
+	return
+}
+
+// ListPortsWithContext invokes the programmed ListPortsWithContextFunc.
+func (mock *DirectLinkV1) ListPortsWithContext(ctx context.Context, listPortsOptions *directlinkv1.ListPortsOptions) (result *directlinkv1.PortCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListPortsWithContext", ctx, listPortsOptions)
+	if mock.ListPortsWithContextFunc != nil {
+		return mock.ListPortsWithContextFunc(ctx, listPortsOptions)
+	}
+	if mock.ListPortsFunc != nil {
+		return mock.ListPortsFunc(listPortsOptions)
+	}
+	err = notProgrammed("ListPortsWithContext")
+	return
+}
+
+// GetPort invokes the programmed GetPortFunc.
+func (mock *DirectLinkV1) GetPort(getPortOptions *directlinkv1.GetPortOptions) (result *directlinkv1.Port, response *core.DetailedResponse, err error) {
+	mock.called("GetPort", getPortOptions)
+	if mock.GetPortFunc != nil {
+		return mock.GetPortFunc(getPortOptions)
+	}
+	if mock.GetPortWithContextFunc != nil {
+		return mock.GetPortWithContextFunc(context.Background(), getPortOptions)
+	}
+	err = notProgrammed("GetPort")
+	return
+}
+
+// GetPortWithContext invokes the programmed GetPortWithContextFunc.
+func (mock *DirectLinkV1) GetPortWithContext(ctx context.Context, getPortOptions *directlinkv1.GetPortOptions) (result *directlinkv1.Port, response *core.DetailedResponse, err error) {
+	mock.called("GetPortWithContext", ctx, getPortOptions)
+	if mock.GetPortWithContextFunc != nil {
+		return mock.GetPortWithContextFunc(ctx, getPortOptions)
+	}
+	if mock.GetPortFunc != nil {
+		return mock.GetPortFunc(getPortOptions)
+	}
+	err = notProgrammed("GetPortWithContext")
+	return
+}
+
+// ListGatewayVirtualConnections invokes the programmed ListGatewayVirtualConnectionsFunc.
+func (mock *DirectLinkV1) ListGatewayVirtualConnections(listGatewayVirtualConnectionsOptions *directlinkv1.ListGatewayVirtualConnectionsOptions) (result *directlinkv1.GatewayVirtualConnectionCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListGatewayVirtualConnections", listGatewayVirtualConnectionsOptions)
+	if mock.ListGatewayVirtualConnectionsFunc != nil {
+		return mock.ListGatewayVirtualConnectionsFunc(listGatewayVirtualConnectionsOptions)
+	}
+	if mock.ListGatewayVirtualConnectionsWithContextFunc != nil {
+		return mock.ListGatewayVirtualConnectionsWithContextFunc(context.Background(), listGatewayVirtualConnectionsOptions)
+	}
+	err = notProgrammed("ListGatewayVirtualConnections")
+	return
+}
+
+// ListGatewayVirtualConnectionsWithContext invokes the programmed ListGatewayVirtualConnectionsWithContextFunc.
+func (mock *DirectLinkV1) ListGatewayVirtualConnectionsWithContext(ctx context.Context, listGatewayVirtualConnectionsOptions *directlinkv1.ListGatewayVirtualConnectionsOptions) (result *directlinkv1.GatewayVirtualConnectionCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListGatewayVirtualConnectionsWithContext", ctx, listGatewayVirtualConnectionsOptions)
+	if mock.ListGatewayVirtualConnectionsWithContextFunc != nil {
+		return mock.ListGatewayVirtualConnectionsWithContextFunc(ctx, listGatewayVirtualConnectionsOptions)
+	}
+	if mock.ListGatewayVirtualConnectionsFunc != nil {
+		return mock.ListGatewayVirtualConnectionsFunc(listGatewayVirtualConnectionsOptions)
+	}
+	err = notProgrammed("ListGatewayVirtualConnectionsWithContext")
+	return
+}
+
+// CreateGatewayVirtualConnection invokes the programmed CreateGatewayVirtualConnectionFunc.
+func (mock *DirectLinkV1) CreateGatewayVirtualConnection(createGatewayVirtualConnectionOptions *directlinkv1.CreateGatewayVirtualConnectionOptions) (result *directlinkv1.GatewayVirtualConnection, response *core.DetailedResponse, err error) {
+	mock.called("CreateGatewayVirtualConnection", createGatewayVirtualConnectionOptions)
+	if mock.CreateGatewayVirtualConnectionFunc != nil {
+		return mock.CreateGatewayVirtualConnectionFunc(createGatewayVirtualConnectionOptions)
+	}
+	if mock.CreateGatewayVirtualConnectionWithContextFunc != nil {
+		return mock.CreateGatewayVirtualConnectionWithContextFunc(context.Background(), createGatewayVirtualConnectionOptions)
+	}
+	err = notProgrammed("CreateGatewayVirtualConnection")
+	return
+}
+
+// CreateGatewayVirtualConnectionWithContext invokes the programmed CreateGatewayVirtualConnectionWithContextFunc.
+func (mock *DirectLinkV1) CreateGatewayVirtualConnectionWithContext(ctx context.Context, createGatewayVirtualConnectionOptions *directlinkv1.CreateGatewayVirtualConnectionOptions) (result *directlinkv1.GatewayVirtualConnection, response *core.DetailedResponse, err error) {
+	mock.called("CreateGatewayVirtualConnectionWithContext", ctx, createGatewayVirtualConnectionOptions)
+	if mock.CreateGatewayVirtualConnectionWithContextFunc != nil {
+		return mock.CreateGatewayVirtualConnectionWithContextFunc(ctx, createGatewayVirtualConnectionOptions)
+	}
+	if mock.CreateGatewayVirtualConnectionFunc != nil {
+		return mock.CreateGatewayVirtualConnectionFunc(createGatewayVirtualConnectionOptions)
+	}
+	err = notProgrammed("CreateGatewayVirtualConnectionWithContext")
+	return
+}
+
+// DeleteGatewayVirtualConnection invokes the programmed DeleteGatewayVirtualConnectionFunc.
+func (mock *DirectLinkV1) DeleteGatewayVirtualConnection(deleteGatewayVirtualConnectionOptions *directlinkv1.DeleteGatewayVirtualConnectionOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteGatewayVirtualConnection", deleteGatewayVirtualConnectionOptions)
+	if mock.DeleteGatewayVirtualConnectionFunc != nil {
+		return mock.DeleteGatewayVirtualConnectionFunc(deleteGatewayVirtualConnectionOptions)
+	}
+	if mock.DeleteGatewayVirtualConnectionWithContextFunc != nil {
+		return mock.DeleteGatewayVirtualConnectionWithContextFunc(context.Background(), deleteGatewayVirtualConnectionOptions)
+	}
+	err = notProgrammed("DeleteGatewayVirtualConnection")
+	return
+}
+
+// DeleteGatewayVirtualConnectionWithContext invokes the programmed DeleteGatewayVirtualConnectionWithContextFunc.
+func (mock *DirectLinkV1) DeleteGatewayVirtualConnectionWithContext(ctx context.Context, deleteGatewayVirtualConnectionOptions *directlinkv1.DeleteGatewayVirtualConnectionOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteGatewayVirtualConnectionWithContext", ctx, deleteGatewayVirtualConnectionOptions)
+	if mock.DeleteGatewayVirtualConnectionWithContextFunc != nil {
+		return mock.DeleteGatewayVirtualConnectionWithContextFunc(ctx, deleteGatewayVirtualConnectionOptions)
+	}
+	if mock.DeleteGatewayVirtualConnectionFunc != nil {
+		return mock.DeleteGatewayVirtualConnectionFunc(deleteGatewayVirtualConnectionOptions)
+	}
+	err = notProgrammed("DeleteGatewayVirtualConnectionWithContext")
+	return
+}
+
+// GetGatewayVirtualConnection invokes the programmed GetGatewayVirtualConnectionFunc.
+func (mock *DirectLinkV1) GetGatewayVirtualConnection(getGatewayVirtualConnectionOptions *directlinkv1.GetGatewayVirtualConnectionOptions) (result *directlinkv1.GatewayVirtualConnection, response *core.DetailedResponse, err error) {
+	mock.called("GetGatewayVirtualConnection", getGatewayVirtualConnectionOptions)
+	if mock.GetGatewayVirtualConnectionFunc != nil {
+		return mock.GetGatewayVirtualConnectionFunc(getGatewayVirtualConnectionOptions)
+	}
+	if mock.GetGatewayVirtualConnectionWithContextFunc != nil {
+		return mock.GetGatewayVirtualConnectionWithContextFunc(context.Background(), getGatewayVirtualConnectionOptions)
+	}
+	err = notProgrammed("GetGatewayVirtualConnection")
+	return
+}
+
+// GetGatewayVirtualConnectionWithContext invokes the programmed GetGatewayVirtualConnectionWithContextFunc.
+func (mock *DirectLinkV1) GetGatewayVirtualConnectionWithContext(ctx context.Context, getGatewayVirtualConnectionOptions *directlinkv1.GetGatewayVirtualConnectionOptions) (result *directlinkv1.GatewayVirtualConnection, response *core.DetailedResponse, err error) {
+	mock.called("GetGatewayVirtualConnectionWithContext", ctx, getGatewayVirtualConnectionOptions)
+	if mock.GetGatewayVirtualConnectionWithContextFunc != nil {
+		return mock.GetGatewayVirtualConnectionWithContextFunc(ctx, getGatewayVirtualConnectionOptions)
+	}
+	if mock.GetGatewayVirtualConnectionFunc != nil {
+		return mock.GetGatewayVirtualConnectionFunc(getGatewayVirtualConnectionOptions)
+	}
+	err = notProgrammed("GetGatewayVirtualConnectionWithContext")
+	return
+}
+
+// UpdateGatewayVirtualConnection invokes the programmed UpdateGatewayVirtualConnectionFunc.
+func (mock *DirectLinkV1) UpdateGatewayVirtualConnection(updateGatewayVirtualConnectionOptions *directlinkv1.UpdateGatewayVirtualConnectionOptions) (result *directlinkv1.GatewayVirtualConnection, response *core.DetailedResponse, err error) {
+	mock.called("UpdateGatewayVirtualConnection", updateGatewayVirtualConnectionOptions)
+	if mock.UpdateGatewayVirtualConnectionFunc != nil {
+		return mock.UpdateGatewayVirtualConnectionFunc(updateGatewayVirtualConnectionOptions)
+	}
+	if mock.UpdateGatewayVirtualConnectionWithContextFunc != nil {
+		return mock.UpdateGatewayVirtualConnectionWithContextFunc(context.Background(), updateGatewayVirtualConnectionOptions)
+	}
+	err = notProgrammed("UpdateGatewayVirtualConnection")
+	return
+}
+
+// UpdateGatewayVirtualConnectionWithContext invokes the programmed UpdateGatewayVirtualConnectionWithContextFunc.
+func (mock *DirectLinkV1) UpdateGatewayVirtualConnectionWithContext(ctx context.Context, updateGatewayVirtualConnectionOptions *directlinkv1.UpdateGatewayVirtualConnectionOptions) (result *directlinkv1.GatewayVirtualConnection, response *core.DetailedResponse, err error) {
+	mock.called("UpdateGatewayVirtualConnectionWithContext", ctx, updateGatewayVirtualConnectionOptions)
+	if mock.UpdateGatewayVirtualConnectionWithContextFunc != nil {
+		return mock.UpdateGatewayVirtualConnectionWithContextFunc(ctx, updateGatewayVirtualConnectionOptions)
+	}
+	if mock.UpdateGatewayVirtualConnectionFunc != nil {
+		return mock.UpdateGatewayVirtualConnectionFunc(updateGatewayVirtualConnectionOptions)
+	}
+	err = notProgrammed("UpdateGatewayVirtualConnectionWithContext")
+	return
+}
@@ -0,0 +1,91 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordbulkv1"
+)
+
+// DnsRecordBulkV1 is a mock of dnsrecordbulkv1.DnsRecordBulkV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type DnsRecordBulkV1 struct {
+	Mock
+
+	GetDnsRecordsBulkFunc             func(*dnsrecordbulkv1.GetDnsRecordsBulkOptions) (io.ReadCloser, *core.DetailedResponse, error)
+	GetDnsRecordsBulkWithContextFunc  func(context.Context, *dnsrecordbulkv1.GetDnsRecordsBulkOptions) (io.ReadCloser, *core.DetailedResponse, error)
+	PostDnsRecordsBulkFunc            func(*dnsrecordbulkv1.PostDnsRecordsBulkOptions) (*dnsrecordbulkv1.DnsRecordsObject, *core.DetailedResponse, error)
+	PostDnsRecordsBulkWithContextFunc func(context.Context, *dnsrecordbulkv1.PostDnsRecordsBulkOptions) (*dnsrecordbulkv1.DnsRecordsObject, *core.DetailedResponse, error)
+}
+
+// DnsRecordBulkV1 implements dnsrecordbulkv1.DnsRecordBulkV1API.
+var _ dnsrecordbulkv1.DnsRecordBulkV1API = (*DnsRecordBulkV1)(nil)
+
+// GetDnsRecordsBulk invokes the programmed GetDnsRecordsBulkFunc.
+func (mock *DnsRecordBulkV1) GetDnsRecordsBulk(getDnsRecordsBulkOptions *dnsrecordbulkv1.GetDnsRecordsBulkOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.called("GetDnsRecordsBulk", getDnsRecordsBulkOptions)
+	if mock.GetDnsRecordsBulkFunc != nil {
+		return mock.GetDnsRecordsBulkFunc(getDnsRecordsBulkOptions)
+	}
+	if mock.GetDnsRecordsBulkWithContextFunc != nil {
+		return mock.GetDnsRecordsBulkWithContextFunc(context.Background(), getDnsRecordsBulkOptions)
+	}
+	err = notProgrammed("GetDnsRecordsBulk")
+	return
+}
+
+// GetDnsRecordsBulkWithContext invokes the programmed GetDnsRecordsBulkWithContextFunc.
+func (mock *DnsRecordBulkV1) GetDnsRecordsBulkWithContext(ctx context.Context, getDnsRecordsBulkOptions *dnsrecordbulkv1.GetDnsRecordsBulkOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.called("GetDnsRecordsBulkWithContext", ctx, getDnsRecordsBulkOptions)
+	if mock.GetDnsRecordsBulkWithContextFunc != nil {
+		return mock.GetDnsRecordsBulkWithContextFunc(ctx, getDnsRecordsBulkOptions)
+	}
+	if mock.GetDnsRecordsBulkFunc != nil {
+		return mock.GetDnsRecordsBulkFunc(getDnsRecordsBulkOptions)
+	}
+	err = notProgrammed("GetDnsRecordsBulkWithContext")
+	return
+}
+
+// PostDnsRecordsBulk invokes the programmed PostDnsRecordsBulkFunc.
+func (mock *DnsRecordBulkV1) PostDnsRecordsBulk(postDnsRecordsBulkOptions *dnsrecordbulkv1.PostDnsRecordsBulkOptions) (result *dnsrecordbulkv1.DnsRecordsObject, response *core.DetailedResponse, err error) {
+	mock.called("PostDnsRecordsBulk", postDnsRecordsBulkOptions)
+	if mock.PostDnsRecordsBulkFunc != nil {
+		return mock.PostDnsRecordsBulkFunc(postDnsRecordsBulkOptions)
+	}
+	if mock.PostDnsRecordsBulkWithContextFunc != nil {
+		return mock.PostDnsRecordsBulkWithContextFunc(context.Background(), postDnsRecordsBulkOptions)
+	}
+	err = notProgrammed("PostDnsRecordsBulk")
+	return
+}
+
+// PostDnsRecordsBulkWithContext invokes the programmed PostDnsRecordsBulkWithContextFunc.
+func (mock *DnsRecordBulkV1) PostDnsRecordsBulkWithContext(ctx context.Context, postDnsRecordsBulkOptions *dnsrecordbulkv1.PostDnsRecordsBulkOptions) (result *dnsrecordbulkv1.DnsRecordsObject, response *core.DetailedResponse, err error) {
+	mock.called("PostDnsRecordsBulkWithContext", ctx, postDnsRecordsBulkOptions)
+	if mock.PostDnsRecordsBulkWithContextFunc != nil {
+		return mock.PostDnsRecordsBulkWithContextFunc(ctx, postDnsRecordsBulkOptions)
+	}
+	if mock.PostDnsRecordsBulkFunc != nil {
+		return mock.PostDnsRecordsBulkFunc(postDnsRecordsBulkOptions)
+	}
+	err = notProgrammed("PostDnsRecordsBulkWithContext")
+	return
+}
@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+)
+
+// DnsRecordsV1 is a mock of dnsrecordsv1.DnsRecordsV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type DnsRecordsV1 struct {
+	Mock
+
+	ListAllDnsRecordsFunc            func(*dnsrecordsv1.ListAllDnsRecordsOptions) (*dnsrecordsv1.ListDnsrecordsResp, *core.DetailedResponse, error)
+	ListAllDnsRecordsWithContextFunc func(context.Context, *dnsrecordsv1.ListAllDnsRecordsOptions) (*dnsrecordsv1.ListDnsrecordsResp, *core.DetailedResponse, error)
+	CreateDnsRecordFunc              func(*dnsrecordsv1.CreateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error)
+	CreateDnsRecordWithContextFunc   func(context.Context, *dnsrecordsv1.CreateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error)
+	CreateDnsRecordWithRetryFunc     func(context.Context, *dnsrecordsv1.CreateDnsRecordOptions, *common.RetryPolicy) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error)
+	DeleteDnsRecordFunc              func(*dnsrecordsv1.DeleteDnsRecordOptions) (*dnsrecordsv1.DeleteDnsrecordResp, *core.DetailedResponse, error)
+	DeleteDnsRecordWithContextFunc   func(context.Context, *dnsrecordsv1.DeleteDnsRecordOptions) (*dnsrecordsv1.DeleteDnsrecordResp, *core.DetailedResponse, error)
+	GetDnsRecordFunc                 func(*dnsrecordsv1.GetDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error)
+	GetDnsRecordWithContextFunc      func(context.Context, *dnsrecordsv1.GetDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error)
+	UpdateDnsRecordFunc              func(*dnsrecordsv1.UpdateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error)
+	UpdateDnsRecordWithContextFunc   func(context.Context, *dnsrecordsv1.UpdateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error)
+}
+
+// DnsRecordsV1 implements dnsrecordsv1.DnsRecordsV1API.
+var _ dnsrecordsv1.DnsRecordsV1API = (*DnsRecordsV1)(nil)
+
+// ListAllDnsRecords invokes the programmed ListAllDnsRecordsFunc.
+func (mock *DnsRecordsV1) ListAllDnsRecords(listAllDnsRecordsOptions *dnsrecordsv1.ListAllDnsRecordsOptions) (result *dnsrecordsv1.ListDnsrecordsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllDnsRecords", listAllDnsRecordsOptions)
+	if mock.ListAllDnsRecordsFunc != nil {
+		return mock.ListAllDnsRecordsFunc(listAllDnsRecordsOptions)
+	}
+	if mock.ListAllDnsRecordsWithContextFunc != nil {
+		return mock.ListAllDnsRecordsWithContextFunc(context.Background(), listAllDnsRecordsOptions)
+	}
+	err = notProgrammed("ListAllDnsRecords")
+	return
+}
+
+// ListAllDnsRecordsWithContext invokes the programmed ListAllDnsRecordsWithContextFunc.
+func (mock *DnsRecordsV1) ListAllDnsRecordsWithContext(ctx context.Context, listAllDnsRecordsOptions *dnsrecordsv1.ListAllDnsRecordsOptions) (result *dnsrecordsv1.ListDnsrecordsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllDnsRecordsWithContext", ctx, listAllDnsRecordsOptions)
+	if mock.ListAllDnsRecordsWithContextFunc != nil {
+		return mock.ListAllDnsRecordsWithContextFunc(ctx, listAllDnsRecordsOptions)
+	}
+	if mock.ListAllDnsRecordsFunc != nil {
+		return mock.ListAllDnsRecordsFunc(listAllDnsRecordsOptions)
+	}
+	err = notProgrammed("ListAllDnsRecordsWithContext")
+	return
+}
+
+// CreateDnsRecord invokes the programmed CreateDnsRecordFunc.
+func (mock *DnsRecordsV1) CreateDnsRecord(createDnsRecordOptions *dnsrecordsv1.CreateDnsRecordOptions) (result *dnsrecordsv1.DnsrecordResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateDnsRecord", createDnsRecordOptions)
+	if mock.CreateDnsRecordFunc != nil {
+		return mock.CreateDnsRecordFunc(createDnsRecordOptions)
+	}
+	if mock.CreateDnsRecordWithContextFunc != nil {
+		return mock.CreateDnsRecordWithContextFunc(context.Background(), createDnsRecordOptions)
+	}
+	err = notProgrammed("CreateDnsRecord")
+	return
+}
+
+// CreateDnsRecordWithContext invokes the programmed CreateDnsRecordWithContextFunc.
+func (mock *DnsRecordsV1) CreateDnsRecordWithContext(ctx context.Context, createDnsRecordOptions *dnsrecordsv1.CreateDnsRecordOptions) (result *dnsrecordsv1.DnsrecordResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateDnsRecordWithContext", ctx, createDnsRecordOptions)
+	if mock.CreateDnsRecordWithContextFunc != nil {
+		return mock.CreateDnsRecordWithContextFunc(ctx, createDnsRecordOptions)
+	}
+	if mock.CreateDnsRecordFunc != nil {
+		return mock.CreateDnsRecordFunc(createDnsRecordOptions)
+	}
+	err = notProgrammed("CreateDnsRecordWithContext")
+	return
+}
+
+// CreateDnsRecordWithRetry invokes the programmed CreateDnsRecordWithRetryFunc.
+func (mock *DnsRecordsV1) CreateDnsRecordWithRetry(ctx context.Context, createDnsRecordOptions *dnsrecordsv1.CreateDnsRecordOptions, policy *common.RetryPolicy) (result *dnsrecordsv1.DnsrecordResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateDnsRecordWithRetry", ctx, createDnsRecordOptions, policy)
+	if mock.CreateDnsRecordWithRetryFunc != nil {
+		return mock.CreateDnsRecordWithRetryFunc(ctx, createDnsRecordOptions, policy)
+	}
+	err = notProgrammed("CreateDnsRecordWithRetry")
+	return
+}
+
+// DeleteDnsRecord invokes the programmed DeleteDnsRecordFunc.
+func (mock *DnsRecordsV1) DeleteDnsRecord(deleteDnsRecordOptions *dnsrecordsv1.DeleteDnsRecordOptions) (result *dnsrecordsv1.DeleteDnsrecordResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteDnsRecord", deleteDnsRecordOptions)
+	if mock.DeleteDnsRecordFunc != nil {
+		return mock.DeleteDnsRecordFunc(deleteDnsRecordOptions)
+	}
+	if mock.DeleteDnsRecordWithContextFunc != nil {
+		return mock.DeleteDnsRecordWithContextFunc(context.Background(), deleteDnsRecordOptions)
+	}
+	err = notProgrammed("DeleteDnsRecord")
+	return
+}
+
+// DeleteDnsRecordWithContext invokes the programmed DeleteDnsRecordWithContextFunc.
+func (mock *DnsRecordsV1) DeleteDnsRecordWithContext(ctx context.Context, deleteDnsRecordOptions *dnsrecordsv1.DeleteDnsRecordOptions) (result *dnsrecordsv1.DeleteDnsrecordResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteDnsRecordWithContext", ctx, deleteDnsRecordOptions)
+	if mock.DeleteDnsRecordWithContextFunc != nil {
+		return mock.DeleteDnsRecordWithContextFunc(ctx, deleteDnsRecordOptions)
+	}
+	if mock.DeleteDnsRecordFunc != nil {
+		return mock.DeleteDnsRecordFunc(deleteDnsRecordOptions)
+	}
+	err = notProgrammed("DeleteDnsRecordWithContext")
+	return
+}
+
+// GetDnsRecord invokes the programmed GetDnsRecordFunc.
+func (mock *DnsRecordsV1) GetDnsRecord(getDnsRecordOptions *dnsrecordsv1.GetDnsRecordOptions) (result *dnsrecordsv1.DnsrecordResp, response *core.DetailedResponse, err error) {
+	mock.called("GetDnsRecord", getDnsRecordOptions)
+	if mock.GetDnsRecordFunc != nil {
+		return mock.GetDnsRecordFunc(getDnsRecordOptions)
+	}
+	if mock.GetDnsRecordWithContextFunc != nil {
+		return mock.GetDnsRecordWithContextFunc(context.Background(), getDnsRecordOptions)
+	}
+	err = notProgrammed("GetDnsRecord")
+	return
+}
+
+// GetDnsRecordWithContext invokes the programmed GetDnsRecordWithContextFunc.
+func (mock *DnsRecordsV1) GetDnsRecordWithContext(ctx context.Context, getDnsRecordOptions *dnsrecordsv1.GetDnsRecordOptions) (result *dnsrecordsv1.DnsrecordResp, response *core.DetailedResponse, err error) {
+	mock.called("GetDnsRecordWithContext", ctx, getDnsRecordOptions)
+	if mock.GetDnsRecordWithContextFunc != nil {
+		return mock.GetDnsRecordWithContextFunc(ctx, getDnsRecordOptions)
+	}
+	if mock.GetDnsRecordFunc != nil {
+		return mock.GetDnsRecordFunc(getDnsRecordOptions)
+	}
+	err = notProgrammed("GetDnsRecordWithContext")
+	return
+}
+
+// UpdateDnsRecord invokes the programmed UpdateDnsRecordFunc.
+func (mock *DnsRecordsV1) UpdateDnsRecord(updateDnsRecordOptions *dnsrecordsv1.UpdateDnsRecordOptions) (result *dnsrecordsv1.DnsrecordResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateDnsRecord", updateDnsRecordOptions)
+	if mock.UpdateDnsRecordFunc != nil {
+		return mock.UpdateDnsRecordFunc(updateDnsRecordOptions)
+	}
+	if mock.UpdateDnsRecordWithContextFunc != nil {
+		return mock.UpdateDnsRecordWithContextFunc(context.Background(), updateDnsRecordOptions)
+	}
+	err = notProgrammed("UpdateDnsRecord")
+	return
+}
+
+// UpdateDnsRecordWithContext invokes the programmed UpdateDnsRecordWithContextFunc.
+func (mock *DnsRecordsV1) UpdateDnsRecordWithContext(ctx context.Context, updateDnsRecordOptions *dnsrecordsv1.UpdateDnsRecordOptions) (result *dnsrecordsv1.DnsrecordResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateDnsRecordWithContext", ctx, updateDnsRecordOptions)
+	if mock.UpdateDnsRecordWithContextFunc != nil {
+		return mock.UpdateDnsRecordWithContextFunc(ctx, updateDnsRecordOptions)
+	}
+	if mock.UpdateDnsRecordFunc != nil {
+		return mock.UpdateDnsRecordFunc(updateDnsRecordOptions)
+	}
+	err = notProgrammed("UpdateDnsRecordWithContext")
+	return
+}
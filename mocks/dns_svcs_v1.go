@@ -0,0 +1,1783 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+)
+
+// DnsSvcsV1 is a mock of dnssvcsv1.DnsSvcsV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type DnsSvcsV1 struct {
+	Mock
+
+	ListDnszonesFunc                            func(*dnssvcsv1.ListDnszonesOptions) (*dnssvcsv1.ListDnszones, *core.DetailedResponse, error)
+	ListDnszonesWithContextFunc                 func(context.Context, *dnssvcsv1.ListDnszonesOptions) (*dnssvcsv1.ListDnszones, *core.DetailedResponse, error)
+	CreateDnszoneFunc                           func(*dnssvcsv1.CreateDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error)
+	CreateDnszoneWithContextFunc                func(context.Context, *dnssvcsv1.CreateDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error)
+	DeleteDnszoneFunc                           func(*dnssvcsv1.DeleteDnszoneOptions) (*core.DetailedResponse, error)
+	DeleteDnszoneWithContextFunc                func(context.Context, *dnssvcsv1.DeleteDnszoneOptions) (*core.DetailedResponse, error)
+	GetDnszoneFunc                              func(*dnssvcsv1.GetDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error)
+	GetDnszoneWithContextFunc                   func(context.Context, *dnssvcsv1.GetDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error)
+	UpdateDnszoneFunc                           func(*dnssvcsv1.UpdateDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error)
+	UpdateDnszoneWithContextFunc                func(context.Context, *dnssvcsv1.UpdateDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error)
+	ListResourceRecordsFunc                     func(*dnssvcsv1.ListResourceRecordsOptions) (*dnssvcsv1.ListResourceRecords, *core.DetailedResponse, error)
+	ListResourceRecordsWithContextFunc          func(context.Context, *dnssvcsv1.ListResourceRecordsOptions) (*dnssvcsv1.ListResourceRecords, *core.DetailedResponse, error)
+	CreateResourceRecordFunc                    func(*dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	CreateResourceRecordWithContextFunc         func(context.Context, *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	CreateResourceRecordWithRetryFunc           func(context.Context, *dnssvcsv1.CreateResourceRecordOptions, *common.RetryPolicy) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	DeleteResourceRecordFunc                    func(*dnssvcsv1.DeleteResourceRecordOptions) (*core.DetailedResponse, error)
+	DeleteResourceRecordWithContextFunc         func(context.Context, *dnssvcsv1.DeleteResourceRecordOptions) (*core.DetailedResponse, error)
+	GetResourceRecordFunc                       func(*dnssvcsv1.GetResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	GetResourceRecordWithContextFunc            func(context.Context, *dnssvcsv1.GetResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	UpdateResourceRecordFunc                    func(*dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	UpdateResourceRecordWithContextFunc         func(context.Context, *dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	ExportResourceRecordsFunc                   func(*dnssvcsv1.ExportResourceRecordsOptions) (io.ReadCloser, *core.DetailedResponse, error)
+	ExportResourceRecordsWithContextFunc        func(context.Context, *dnssvcsv1.ExportResourceRecordsOptions) (io.ReadCloser, *core.DetailedResponse, error)
+	ImportResourceRecordsFunc                   func(*dnssvcsv1.ImportResourceRecordsOptions) (*dnssvcsv1.ImportResourceRecordsResp, *core.DetailedResponse, error)
+	ImportResourceRecordsWithContextFunc        func(context.Context, *dnssvcsv1.ImportResourceRecordsOptions) (*dnssvcsv1.ImportResourceRecordsResp, *core.DetailedResponse, error)
+	ListPermittedNetworksFunc                   func(*dnssvcsv1.ListPermittedNetworksOptions) (*dnssvcsv1.ListPermittedNetworks, *core.DetailedResponse, error)
+	ListPermittedNetworksWithContextFunc        func(context.Context, *dnssvcsv1.ListPermittedNetworksOptions) (*dnssvcsv1.ListPermittedNetworks, *core.DetailedResponse, error)
+	CreatePermittedNetworkFunc                  func(*dnssvcsv1.CreatePermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	CreatePermittedNetworkWithContextFunc       func(context.Context, *dnssvcsv1.CreatePermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	DeletePermittedNetworkFunc                  func(*dnssvcsv1.DeletePermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	DeletePermittedNetworkWithContextFunc       func(context.Context, *dnssvcsv1.DeletePermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	GetPermittedNetworkFunc                     func(*dnssvcsv1.GetPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	GetPermittedNetworkWithContextFunc          func(context.Context, *dnssvcsv1.GetPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	ListLoadBalancersFunc                       func(*dnssvcsv1.ListLoadBalancersOptions) (*dnssvcsv1.ListLoadBalancers, *core.DetailedResponse, error)
+	ListLoadBalancersWithContextFunc            func(context.Context, *dnssvcsv1.ListLoadBalancersOptions) (*dnssvcsv1.ListLoadBalancers, *core.DetailedResponse, error)
+	CreateLoadBalancerFunc                      func(*dnssvcsv1.CreateLoadBalancerOptions) (*dnssvcsv1.LoadBalancer, *core.DetailedResponse, error)
+	CreateLoadBalancerWithContextFunc           func(context.Context, *dnssvcsv1.CreateLoadBalancerOptions) (*dnssvcsv1.LoadBalancer, *core.DetailedResponse, error)
+	DeleteLoadBalancerFunc                      func(*dnssvcsv1.DeleteLoadBalancerOptions) (*core.DetailedResponse, error)
+	DeleteLoadBalancerWithContextFunc           func(context.Context, *dnssvcsv1.DeleteLoadBalancerOptions) (*core.DetailedResponse, error)
+	GetLoadBalancerFunc                         func(*dnssvcsv1.GetLoadBalancerOptions) (*dnssvcsv1.LoadBalancer, *core.DetailedResponse, error)
+	GetLoadBalancerWithContextFunc              func(context.Context, *dnssvcsv1.GetLoadBalancerOptions) (*dnssvcsv1.LoadBalancer, *core.DetailedResponse, error)
+	UpdateLoadBalancerFunc                      func(*dnssvcsv1.UpdateLoadBalancerOptions) (*dnssvcsv1.LoadBalancer, *core.DetailedResponse, error)
+	UpdateLoadBalancerWithContextFunc           func(context.Context, *dnssvcsv1.UpdateLoadBalancerOptions) (*dnssvcsv1.LoadBalancer, *core.DetailedResponse, error)
+	ListPoolsFunc                               func(*dnssvcsv1.ListPoolsOptions) (*dnssvcsv1.ListPools, *core.DetailedResponse, error)
+	ListPoolsWithContextFunc                    func(context.Context, *dnssvcsv1.ListPoolsOptions) (*dnssvcsv1.ListPools, *core.DetailedResponse, error)
+	CreatePoolFunc                              func(*dnssvcsv1.CreatePoolOptions) (*dnssvcsv1.Pool, *core.DetailedResponse, error)
+	CreatePoolWithContextFunc                   func(context.Context, *dnssvcsv1.CreatePoolOptions) (*dnssvcsv1.Pool, *core.DetailedResponse, error)
+	DeletePoolFunc                              func(*dnssvcsv1.DeletePoolOptions) (*core.DetailedResponse, error)
+	DeletePoolWithContextFunc                   func(context.Context, *dnssvcsv1.DeletePoolOptions) (*core.DetailedResponse, error)
+	GetPoolFunc                                 func(*dnssvcsv1.GetPoolOptions) (*dnssvcsv1.Pool, *core.DetailedResponse, error)
+	GetPoolWithContextFunc                      func(context.Context, *dnssvcsv1.GetPoolOptions) (*dnssvcsv1.Pool, *core.DetailedResponse, error)
+	UpdatePoolFunc                              func(*dnssvcsv1.UpdatePoolOptions) (*dnssvcsv1.Pool, *core.DetailedResponse, error)
+	UpdatePoolWithContextFunc                   func(context.Context, *dnssvcsv1.UpdatePoolOptions) (*dnssvcsv1.Pool, *core.DetailedResponse, error)
+	ListMonitorsFunc                            func(*dnssvcsv1.ListMonitorsOptions) (*dnssvcsv1.ListMonitors, *core.DetailedResponse, error)
+	ListMonitorsWithContextFunc                 func(context.Context, *dnssvcsv1.ListMonitorsOptions) (*dnssvcsv1.ListMonitors, *core.DetailedResponse, error)
+	CreateMonitorFunc                           func(*dnssvcsv1.CreateMonitorOptions) (*dnssvcsv1.Monitor, *core.DetailedResponse, error)
+	CreateMonitorWithContextFunc                func(context.Context, *dnssvcsv1.CreateMonitorOptions) (*dnssvcsv1.Monitor, *core.DetailedResponse, error)
+	DeleteMonitorFunc                           func(*dnssvcsv1.DeleteMonitorOptions) (*core.DetailedResponse, error)
+	DeleteMonitorWithContextFunc                func(context.Context, *dnssvcsv1.DeleteMonitorOptions) (*core.DetailedResponse, error)
+	GetMonitorFunc                              func(*dnssvcsv1.GetMonitorOptions) (*dnssvcsv1.Monitor, *core.DetailedResponse, error)
+	GetMonitorWithContextFunc                   func(context.Context, *dnssvcsv1.GetMonitorOptions) (*dnssvcsv1.Monitor, *core.DetailedResponse, error)
+	UpdateMonitorFunc                           func(*dnssvcsv1.UpdateMonitorOptions) (*dnssvcsv1.Monitor, *core.DetailedResponse, error)
+	UpdateMonitorWithContextFunc                func(context.Context, *dnssvcsv1.UpdateMonitorOptions) (*dnssvcsv1.Monitor, *core.DetailedResponse, error)
+	ListCustomResolversFunc                     func(*dnssvcsv1.ListCustomResolversOptions) (*dnssvcsv1.CustomResolverList, *core.DetailedResponse, error)
+	ListCustomResolversWithContextFunc          func(context.Context, *dnssvcsv1.ListCustomResolversOptions) (*dnssvcsv1.CustomResolverList, *core.DetailedResponse, error)
+	CreateCustomResolverFunc                    func(*dnssvcsv1.CreateCustomResolverOptions) (*dnssvcsv1.CustomResolver, *core.DetailedResponse, error)
+	CreateCustomResolverWithContextFunc         func(context.Context, *dnssvcsv1.CreateCustomResolverOptions) (*dnssvcsv1.CustomResolver, *core.DetailedResponse, error)
+	DeleteCustomResolverFunc                    func(*dnssvcsv1.DeleteCustomResolverOptions) (*core.DetailedResponse, error)
+	DeleteCustomResolverWithContextFunc         func(context.Context, *dnssvcsv1.DeleteCustomResolverOptions) (*core.DetailedResponse, error)
+	GetCustomResolverFunc                       func(*dnssvcsv1.GetCustomResolverOptions) (*dnssvcsv1.CustomResolver, *core.DetailedResponse, error)
+	GetCustomResolverWithContextFunc            func(context.Context, *dnssvcsv1.GetCustomResolverOptions) (*dnssvcsv1.CustomResolver, *core.DetailedResponse, error)
+	UpdateCustomResolverFunc                    func(*dnssvcsv1.UpdateCustomResolverOptions) (*dnssvcsv1.CustomResolver, *core.DetailedResponse, error)
+	UpdateCustomResolverWithContextFunc         func(context.Context, *dnssvcsv1.UpdateCustomResolverOptions) (*dnssvcsv1.CustomResolver, *core.DetailedResponse, error)
+	UpdateCrLocationsOrderFunc                  func(*dnssvcsv1.UpdateCrLocationsOrderOptions) (*dnssvcsv1.CustomResolver, *core.DetailedResponse, error)
+	UpdateCrLocationsOrderWithContextFunc       func(context.Context, *dnssvcsv1.UpdateCrLocationsOrderOptions) (*dnssvcsv1.CustomResolver, *core.DetailedResponse, error)
+	AddCustomResolverLocationFunc               func(*dnssvcsv1.AddCustomResolverLocationOptions) (*dnssvcsv1.Location, *core.DetailedResponse, error)
+	AddCustomResolverLocationWithContextFunc    func(context.Context, *dnssvcsv1.AddCustomResolverLocationOptions) (*dnssvcsv1.Location, *core.DetailedResponse, error)
+	UpdateCustomResolverLocationFunc            func(*dnssvcsv1.UpdateCustomResolverLocationOptions) (*dnssvcsv1.Location, *core.DetailedResponse, error)
+	UpdateCustomResolverLocationWithContextFunc func(context.Context, *dnssvcsv1.UpdateCustomResolverLocationOptions) (*dnssvcsv1.Location, *core.DetailedResponse, error)
+	DeleteCustomResolverLocationFunc            func(*dnssvcsv1.DeleteCustomResolverLocationOptions) (*core.DetailedResponse, error)
+	DeleteCustomResolverLocationWithContextFunc func(context.Context, *dnssvcsv1.DeleteCustomResolverLocationOptions) (*core.DetailedResponse, error)
+	ListForwardingRulesFunc                     func(*dnssvcsv1.ListForwardingRulesOptions) (*dnssvcsv1.ForwardingRuleList, *core.DetailedResponse, error)
+	ListForwardingRulesWithContextFunc          func(context.Context, *dnssvcsv1.ListForwardingRulesOptions) (*dnssvcsv1.ForwardingRuleList, *core.DetailedResponse, error)
+	CreateForwardingRuleFunc                    func(*dnssvcsv1.CreateForwardingRuleOptions) (*dnssvcsv1.ForwardingRule, *core.DetailedResponse, error)
+	CreateForwardingRuleWithContextFunc         func(context.Context, *dnssvcsv1.CreateForwardingRuleOptions) (*dnssvcsv1.ForwardingRule, *core.DetailedResponse, error)
+	DeleteForwardingRuleFunc                    func(*dnssvcsv1.DeleteForwardingRuleOptions) (*core.DetailedResponse, error)
+	DeleteForwardingRuleWithContextFunc         func(context.Context, *dnssvcsv1.DeleteForwardingRuleOptions) (*core.DetailedResponse, error)
+	GetForwardingRuleFunc                       func(*dnssvcsv1.GetForwardingRuleOptions) (*dnssvcsv1.ForwardingRule, *core.DetailedResponse, error)
+	GetForwardingRuleWithContextFunc            func(context.Context, *dnssvcsv1.GetForwardingRuleOptions) (*dnssvcsv1.ForwardingRule, *core.DetailedResponse, error)
+	UpdateForwardingRuleFunc                    func(*dnssvcsv1.UpdateForwardingRuleOptions) (*dnssvcsv1.ForwardingRule, *core.DetailedResponse, error)
+	UpdateForwardingRuleWithContextFunc         func(context.Context, *dnssvcsv1.UpdateForwardingRuleOptions) (*dnssvcsv1.ForwardingRule, *core.DetailedResponse, error)
+	CreateSecondaryZoneFunc                     func(*dnssvcsv1.CreateSecondaryZoneOptions) (*dnssvcsv1.SecondaryZone, *core.DetailedResponse, error)
+	CreateSecondaryZoneWithContextFunc          func(context.Context, *dnssvcsv1.CreateSecondaryZoneOptions) (*dnssvcsv1.SecondaryZone, *core.DetailedResponse, error)
+	ListSecondaryZonesFunc                      func(*dnssvcsv1.ListSecondaryZonesOptions) (*dnssvcsv1.SecondaryZoneList, *core.DetailedResponse, error)
+	ListSecondaryZonesWithContextFunc           func(context.Context, *dnssvcsv1.ListSecondaryZonesOptions) (*dnssvcsv1.SecondaryZoneList, *core.DetailedResponse, error)
+	GetSecondaryZoneFunc                        func(*dnssvcsv1.GetSecondaryZoneOptions) (*dnssvcsv1.SecondaryZone, *core.DetailedResponse, error)
+	GetSecondaryZoneWithContextFunc             func(context.Context, *dnssvcsv1.GetSecondaryZoneOptions) (*dnssvcsv1.SecondaryZone, *core.DetailedResponse, error)
+	UpdateSecondaryZoneFunc                     func(*dnssvcsv1.UpdateSecondaryZoneOptions) (*dnssvcsv1.SecondaryZone, *core.DetailedResponse, error)
+	UpdateSecondaryZoneWithContextFunc          func(context.Context, *dnssvcsv1.UpdateSecondaryZoneOptions) (*dnssvcsv1.SecondaryZone, *core.DetailedResponse, error)
+	DeleteSecondaryZoneFunc                     func(*dnssvcsv1.DeleteSecondaryZoneOptions) (*core.DetailedResponse, error)
+	DeleteSecondaryZoneWithContextFunc          func(context.Context, *dnssvcsv1.DeleteSecondaryZoneOptions) (*core.DetailedResponse, error)
+	ListLinkedZonesFunc                         func(*dnssvcsv1.ListLinkedZonesOptions) (*dnssvcsv1.LinkedDnszonesList, *core.DetailedResponse, error)
+	ListLinkedZonesWithContextFunc              func(context.Context, *dnssvcsv1.ListLinkedZonesOptions) (*dnssvcsv1.LinkedDnszonesList, *core.DetailedResponse, error)
+	CreateLinkedZoneFunc                        func(*dnssvcsv1.CreateLinkedZoneOptions) (*dnssvcsv1.LinkedDnszone, *core.DetailedResponse, error)
+	CreateLinkedZoneWithContextFunc             func(context.Context, *dnssvcsv1.CreateLinkedZoneOptions) (*dnssvcsv1.LinkedDnszone, *core.DetailedResponse, error)
+	GetLinkedZoneFunc                           func(*dnssvcsv1.GetLinkedZoneOptions) (*dnssvcsv1.LinkedDnszone, *core.DetailedResponse, error)
+	GetLinkedZoneWithContextFunc                func(context.Context, *dnssvcsv1.GetLinkedZoneOptions) (*dnssvcsv1.LinkedDnszone, *core.DetailedResponse, error)
+	UpdateLinkedZoneFunc                        func(*dnssvcsv1.UpdateLinkedZoneOptions) (*dnssvcsv1.LinkedDnszone, *core.DetailedResponse, error)
+	UpdateLinkedZoneWithContextFunc             func(context.Context, *dnssvcsv1.UpdateLinkedZoneOptions) (*dnssvcsv1.LinkedDnszone, *core.DetailedResponse, error)
+	DeleteLinkedZoneFunc                        func(*dnssvcsv1.DeleteLinkedZoneOptions) (*core.DetailedResponse, error)
+	DeleteLinkedZoneWithContextFunc             func(context.Context, *dnssvcsv1.DeleteLinkedZoneOptions) (*core.DetailedResponse, error)
+	ListDnszoneAccessRequestsFunc               func(*dnssvcsv1.ListDnszoneAccessRequestsOptions) (*dnssvcsv1.AccessRequestsList, *core.DetailedResponse, error)
+	ListDnszoneAccessRequestsWithContextFunc    func(context.Context, *dnssvcsv1.ListDnszoneAccessRequestsOptions) (*dnssvcsv1.AccessRequestsList, *core.DetailedResponse, error)
+	GetDnszoneAccessRequestFunc                 func(*dnssvcsv1.GetDnszoneAccessRequestOptions) (*dnssvcsv1.AccessRequest, *core.DetailedResponse, error)
+	GetDnszoneAccessRequestWithContextFunc      func(context.Context, *dnssvcsv1.GetDnszoneAccessRequestOptions) (*dnssvcsv1.AccessRequest, *core.DetailedResponse, error)
+	UpdateDnszoneAccessRequestFunc              func(*dnssvcsv1.UpdateDnszoneAccessRequestOptions) (*dnssvcsv1.AccessRequest, *core.DetailedResponse, error)
+	UpdateDnszoneAccessRequestWithContextFunc   func(context.Context, *dnssvcsv1.UpdateDnszoneAccessRequestOptions) (*dnssvcsv1.AccessRequest, *core.DetailedResponse, error)
+	ListLinkedPermittedNetworksFunc             func(*dnssvcsv1.ListLinkedPermittedNetworksOptions) (*dnssvcsv1.ListPermittedNetworks, *core.DetailedResponse, error)
+	ListLinkedPermittedNetworksWithContextFunc  func(context.Context, *dnssvcsv1.ListLinkedPermittedNetworksOptions) (*dnssvcsv1.ListPermittedNetworks, *core.DetailedResponse, error)
+	CreateLzPermittedNetworkFunc                func(*dnssvcsv1.CreateLzPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	CreateLzPermittedNetworkWithContextFunc     func(context.Context, *dnssvcsv1.CreateLzPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	DeleteLzPermittedNetworkFunc                func(*dnssvcsv1.DeleteLzPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	DeleteLzPermittedNetworkWithContextFunc     func(context.Context, *dnssvcsv1.DeleteLzPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	GetLinkedPermittedNetworkFunc               func(*dnssvcsv1.GetLinkedPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	GetLinkedPermittedNetworkWithContextFunc    func(context.Context, *dnssvcsv1.GetLinkedPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+}
+
+// DnsSvcsV1 implements dnssvcsv1.DnsSvcsV1API.
+var _ dnssvcsv1.DnsSvcsV1API = (*DnsSvcsV1)(nil)
+
+// ListDnszones invokes the programmed ListDnszonesFunc.
+func (mock *DnsSvcsV1) ListDnszones(listDnszonesOptions *dnssvcsv1.ListDnszonesOptions) (result *dnssvcsv1.ListDnszones, response *core.DetailedResponse, err error) {
+	mock.called("ListDnszones", listDnszonesOptions)
+	if mock.ListDnszonesFunc != nil {
+		return mock.ListDnszonesFunc(listDnszonesOptions)
+	}
+	if mock.ListDnszonesWithContextFunc != nil {
+		return mock.ListDnszonesWithContextFunc(context.Background(), listDnszonesOptions)
+	}
+	err = notProgrammed("ListDnszones")
+	return
+}
+
+// ListDnszonesWithContext invokes the programmed ListDnszonesWithContextFunc.
+func (mock *DnsSvcsV1) ListDnszonesWithContext(ctx context.Context, listDnszonesOptions *dnssvcsv1.ListDnszonesOptions) (result *dnssvcsv1.ListDnszones, response *core.DetailedResponse, err error) {
+	mock.called("ListDnszonesWithContext", ctx, listDnszonesOptions)
+	if mock.ListDnszonesWithContextFunc != nil {
+		return mock.ListDnszonesWithContextFunc(ctx, listDnszonesOptions)
+	}
+	if mock.ListDnszonesFunc != nil {
+		return mock.ListDnszonesFunc(listDnszonesOptions)
+	}
+	err = notProgrammed("ListDnszonesWithContext")
+	return
+}
+
+// CreateDnszone invokes the programmed CreateDnszoneFunc.
+func (mock *DnsSvcsV1) CreateDnszone(createDnszoneOptions *dnssvcsv1.CreateDnszoneOptions) (result *dnssvcsv1.Dnszone, response *core.DetailedResponse, err error) {
+	mock.called("CreateDnszone", createDnszoneOptions)
+	if mock.CreateDnszoneFunc != nil {
+		return mock.CreateDnszoneFunc(createDnszoneOptions)
+	}
+	if mock.CreateDnszoneWithContextFunc != nil {
+		return mock.CreateDnszoneWithContextFunc(context.Background(), createDnszoneOptions)
+	}
+	err = notProgrammed("CreateDnszone")
+	return
+}
+
+// CreateDnszoneWithContext invokes the programmed CreateDnszoneWithContextFunc.
+func (mock *DnsSvcsV1) CreateDnszoneWithContext(ctx context.Context, createDnszoneOptions *dnssvcsv1.CreateDnszoneOptions) (result *dnssvcsv1.Dnszone, response *core.DetailedResponse, err error) {
+	mock.called("CreateDnszoneWithContext", ctx, createDnszoneOptions)
+	if mock.CreateDnszoneWithContextFunc != nil {
+		return mock.CreateDnszoneWithContextFunc(ctx, createDnszoneOptions)
+	}
+	if mock.CreateDnszoneFunc != nil {
+		return mock.CreateDnszoneFunc(createDnszoneOptions)
+	}
+	err = notProgrammed("CreateDnszoneWithContext")
+	return
+}
+
+// DeleteDnszone invokes the programmed DeleteDnszoneFunc.
+func (mock *DnsSvcsV1) DeleteDnszone(deleteDnszoneOptions *dnssvcsv1.DeleteDnszoneOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteDnszone", deleteDnszoneOptions)
+	if mock.DeleteDnszoneFunc != nil {
+		return mock.DeleteDnszoneFunc(deleteDnszoneOptions)
+	}
+	if mock.DeleteDnszoneWithContextFunc != nil {
+		return mock.DeleteDnszoneWithContextFunc(context.Background(), deleteDnszoneOptions)
+	}
+	err = notProgrammed("DeleteDnszone")
+	return
+}
+
+// DeleteDnszoneWithContext invokes the programmed DeleteDnszoneWithContextFunc.
+func (mock *DnsSvcsV1) DeleteDnszoneWithContext(ctx context.Context, deleteDnszoneOptions *dnssvcsv1.DeleteDnszoneOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteDnszoneWithContext", ctx, deleteDnszoneOptions)
+	if mock.DeleteDnszoneWithContextFunc != nil {
+		return mock.DeleteDnszoneWithContextFunc(ctx, deleteDnszoneOptions)
+	}
+	if mock.DeleteDnszoneFunc != nil {
+		return mock.DeleteDnszoneFunc(deleteDnszoneOptions)
+	}
+	err = notProgrammed("DeleteDnszoneWithContext")
+	return
+}
+
+// GetDnszone invokes the programmed GetDnszoneFunc.
+func (mock *DnsSvcsV1) GetDnszone(getDnszoneOptions *dnssvcsv1.GetDnszoneOptions) (result *dnssvcsv1.Dnszone, response *core.DetailedResponse, err error) {
+	mock.called("GetDnszone", getDnszoneOptions)
+	if mock.GetDnszoneFunc != nil {
+		return mock.GetDnszoneFunc(getDnszoneOptions)
+	}
+	if mock.GetDnszoneWithContextFunc != nil {
+		return mock.GetDnszoneWithContextFunc(context.Background(), getDnszoneOptions)
+	}
+	err = notProgrammed("GetDnszone")
+	return
+}
+
+// GetDnszoneWithContext invokes the programmed GetDnszoneWithContextFunc.
+func (mock *DnsSvcsV1) GetDnszoneWithContext(ctx context.Context, getDnszoneOptions *dnssvcsv1.GetDnszoneOptions) (result *dnssvcsv1.Dnszone, response *core.DetailedResponse, err error) {
+	mock.called("GetDnszoneWithContext", ctx, getDnszoneOptions)
+	if mock.GetDnszoneWithContextFunc != nil {
+		return mock.GetDnszoneWithContextFunc(ctx, getDnszoneOptions)
+	}
+	if mock.GetDnszoneFunc != nil {
+		return mock.GetDnszoneFunc(getDnszoneOptions)
+	}
+	err = notProgrammed("GetDnszoneWithContext")
+	return
+}
+
+// UpdateDnszone invokes the programmed UpdateDnszoneFunc.
+func (mock *DnsSvcsV1) UpdateDnszone(updateDnszoneOptions *dnssvcsv1.UpdateDnszoneOptions) (result *dnssvcsv1.Dnszone, response *core.DetailedResponse, err error) {
+	mock.called("UpdateDnszone", updateDnszoneOptions)
+	if mock.UpdateDnszoneFunc != nil {
+		return mock.UpdateDnszoneFunc(updateDnszoneOptions)
+	}
+	if mock.UpdateDnszoneWithContextFunc != nil {
+		return mock.UpdateDnszoneWithContextFunc(context.Background(), updateDnszoneOptions)
+	}
+	err = notProgrammed("UpdateDnszone")
+	return
+}
+
+// UpdateDnszoneWithContext invokes the programmed UpdateDnszoneWithContextFunc.
+func (mock *DnsSvcsV1) UpdateDnszoneWithContext(ctx context.Context, updateDnszoneOptions *dnssvcsv1.UpdateDnszoneOptions) (result *dnssvcsv1.Dnszone, response *core.DetailedResponse, err error) {
+	mock.called("UpdateDnszoneWithContext", ctx, updateDnszoneOptions)
+	if mock.UpdateDnszoneWithContextFunc != nil {
+		return mock.UpdateDnszoneWithContextFunc(ctx, updateDnszoneOptions)
+	}
+	if mock.UpdateDnszoneFunc != nil {
+		return mock.UpdateDnszoneFunc(updateDnszoneOptions)
+	}
+	err = notProgrammed("UpdateDnszoneWithContext")
+	return
+}
+
+// ListResourceRecords invokes the programmed ListResourceRecordsFunc.
+func (mock *DnsSvcsV1) ListResourceRecords(listResourceRecordsOptions *dnssvcsv1.ListResourceRecordsOptions) (result *dnssvcsv1.ListResourceRecords, response *core.DetailedResponse, err error) {
+	mock.called("ListResourceRecords", listResourceRecordsOptions)
+	if mock.ListResourceRecordsFunc != nil {
+		return mock.ListResourceRecordsFunc(listResourceRecordsOptions)
+	}
+	if mock.ListResourceRecordsWithContextFunc != nil {
+		return mock.ListResourceRecordsWithContextFunc(context.Background(), listResourceRecordsOptions)
+	}
+	err = notProgrammed("ListResourceRecords")
+	return
+}
+
+// ListResourceRecordsWithContext invokes the programmed ListResourceRecordsWithContextFunc.
+func (mock *DnsSvcsV1) ListResourceRecordsWithContext(ctx context.Context, listResourceRecordsOptions *dnssvcsv1.ListResourceRecordsOptions) (result *dnssvcsv1.ListResourceRecords, response *core.DetailedResponse, err error) {
+	mock.called("ListResourceRecordsWithContext", ctx, listResourceRecordsOptions)
+	if mock.ListResourceRecordsWithContextFunc != nil {
+		return mock.ListResourceRecordsWithContextFunc(ctx, listResourceRecordsOptions)
+	}
+	if mock.ListResourceRecordsFunc != nil {
+		return mock.ListResourceRecordsFunc(listResourceRecordsOptions)
+	}
+	err = notProgrammed("ListResourceRecordsWithContext")
+	return
+}
+
+// CreateResourceRecord invokes the programmed CreateResourceRecordFunc.
+func (mock *DnsSvcsV1) CreateResourceRecord(createResourceRecordOptions *dnssvcsv1.CreateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	mock.called("CreateResourceRecord", createResourceRecordOptions)
+	if mock.CreateResourceRecordFunc != nil {
+		return mock.CreateResourceRecordFunc(createResourceRecordOptions)
+	}
+	if mock.CreateResourceRecordWithContextFunc != nil {
+		return mock.CreateResourceRecordWithContextFunc(context.Background(), createResourceRecordOptions)
+	}
+	err = notProgrammed("CreateResourceRecord")
+	return
+}
+
+// CreateResourceRecordWithContext invokes the programmed CreateResourceRecordWithContextFunc.
+func (mock *DnsSvcsV1) CreateResourceRecordWithContext(ctx context.Context, createResourceRecordOptions *dnssvcsv1.CreateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	mock.called("CreateResourceRecordWithContext", ctx, createResourceRecordOptions)
+	if mock.CreateResourceRecordWithContextFunc != nil {
+		return mock.CreateResourceRecordWithContextFunc(ctx, createResourceRecordOptions)
+	}
+	if mock.CreateResourceRecordFunc != nil {
+		return mock.CreateResourceRecordFunc(createResourceRecordOptions)
+	}
+	err = notProgrammed("CreateResourceRecordWithContext")
+	return
+}
+
+// CreateResourceRecordWithRetry invokes the programmed CreateResourceRecordWithRetryFunc.
+func (mock *DnsSvcsV1) CreateResourceRecordWithRetry(ctx context.Context, createResourceRecordOptions *dnssvcsv1.CreateResourceRecordOptions, policy *common.RetryPolicy) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	mock.called("CreateResourceRecordWithRetry", ctx, createResourceRecordOptions, policy)
+	if mock.CreateResourceRecordWithRetryFunc != nil {
+		return mock.CreateResourceRecordWithRetryFunc(ctx, createResourceRecordOptions, policy)
+	}
+	err = notProgrammed("CreateResourceRecordWithRetry")
+	return
+}
+
+// DeleteResourceRecord invokes the programmed DeleteResourceRecordFunc.
+func (mock *DnsSvcsV1) DeleteResourceRecord(deleteResourceRecordOptions *dnssvcsv1.DeleteResourceRecordOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteResourceRecord", deleteResourceRecordOptions)
+	if mock.DeleteResourceRecordFunc != nil {
+		return mock.DeleteResourceRecordFunc(deleteResourceRecordOptions)
+	}
+	if mock.DeleteResourceRecordWithContextFunc != nil {
+		return mock.DeleteResourceRecordWithContextFunc(context.Background(), deleteResourceRecordOptions)
+	}
+	err = notProgrammed("DeleteResourceRecord")
+	return
+}
+
+// DeleteResourceRecordWithContext invokes the programmed DeleteResourceRecordWithContextFunc.
+func (mock *DnsSvcsV1) DeleteResourceRecordWithContext(ctx context.Context, deleteResourceRecordOptions *dnssvcsv1.DeleteResourceRecordOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteResourceRecordWithContext", ctx, deleteResourceRecordOptions)
+	if mock.DeleteResourceRecordWithContextFunc != nil {
+		return mock.DeleteResourceRecordWithContextFunc(ctx, deleteResourceRecordOptions)
+	}
+	if mock.DeleteResourceRecordFunc != nil {
+		return mock.DeleteResourceRecordFunc(deleteResourceRecordOptions)
+	}
+	err = notProgrammed("DeleteResourceRecordWithContext")
+	return
+}
+
+// GetResourceRecord invokes the programmed GetResourceRecordFunc.
+func (mock *DnsSvcsV1) GetResourceRecord(getResourceRecordOptions *dnssvcsv1.GetResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	mock.called("GetResourceRecord", getResourceRecordOptions)
+	if mock.GetResourceRecordFunc != nil {
+		return mock.GetResourceRecordFunc(getResourceRecordOptions)
+	}
+	if mock.GetResourceRecordWithContextFunc != nil {
+		return mock.GetResourceRecordWithContextFunc(context.Background(), getResourceRecordOptions)
+	}
+	err = notProgrammed("GetResourceRecord")
+	return
+}
+
+// GetResourceRecordWithContext invokes the programmed GetResourceRecordWithContextFunc.
+func (mock *DnsSvcsV1) GetResourceRecordWithContext(ctx context.Context, getResourceRecordOptions *dnssvcsv1.GetResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	mock.called("GetResourceRecordWithContext", ctx, getResourceRecordOptions)
+	if mock.GetResourceRecordWithContextFunc != nil {
+		return mock.GetResourceRecordWithContextFunc(ctx, getResourceRecordOptions)
+	}
+	if mock.GetResourceRecordFunc != nil {
+		return mock.GetResourceRecordFunc(getResourceRecordOptions)
+	}
+	err = notProgrammed("GetResourceRecordWithContext")
+	return
+}
+
+// UpdateResourceRecord invokes the programmed UpdateResourceRecordFunc.
+func (mock *DnsSvcsV1) UpdateResourceRecord(updateResourceRecordOptions *dnssvcsv1.UpdateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	mock.called("UpdateResourceRecord", updateResourceRecordOptions)
+	if mock.UpdateResourceRecordFunc != nil {
+		return mock.UpdateResourceRecordFunc(updateResourceRecordOptions)
+	}
+	if mock.UpdateResourceRecordWithContextFunc != nil {
+		return mock.UpdateResourceRecordWithContextFunc(context.Background(), updateResourceRecordOptions)
+	}
+	err = notProgrammed("UpdateResourceRecord")
+	return
+}
+
+// UpdateResourceRecordWithContext invokes the programmed UpdateResourceRecordWithContextFunc.
+func (mock *DnsSvcsV1) UpdateResourceRecordWithContext(ctx context.Context, updateResourceRecordOptions *dnssvcsv1.UpdateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	mock.called("UpdateResourceRecordWithContext", ctx, updateResourceRecordOptions)
+	if mock.UpdateResourceRecordWithContextFunc != nil {
+		return mock.UpdateResourceRecordWithContextFunc(ctx, updateResourceRecordOptions)
+	}
+	if mock.UpdateResourceRecordFunc != nil {
+		return mock.UpdateResourceRecordFunc(updateResourceRecordOptions)
+	}
+	err = notProgrammed("UpdateResourceRecordWithContext")
+	return
+}
+
+// ExportResourceRecords invokes the programmed ExportResourceRecordsFunc.
+func (mock *DnsSvcsV1) ExportResourceRecords(exportResourceRecordsOptions *dnssvcsv1.ExportResourceRecordsOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.called("ExportResourceRecords", exportResourceRecordsOptions)
+	if mock.ExportResourceRecordsFunc != nil {
+		return mock.ExportResourceRecordsFunc(exportResourceRecordsOptions)
+	}
+	if mock.ExportResourceRecordsWithContextFunc != nil {
+		return mock.ExportResourceRecordsWithContextFunc(context.Background(), exportResourceRecordsOptions)
+	}
+	err = notProgrammed("ExportResourceRecords")
+	return
+}
+
+// ExportResourceRecordsWithContext invokes the programmed ExportResourceRecordsWithContextFunc.
+func (mock *DnsSvcsV1) ExportResourceRecordsWithContext(ctx context.Context, exportResourceRecordsOptions *dnssvcsv1.ExportResourceRecordsOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.called("ExportResourceRecordsWithContext", ctx, exportResourceRecordsOptions)
+	if mock.ExportResourceRecordsWithContextFunc != nil {
+		return mock.ExportResourceRecordsWithContextFunc(ctx, exportResourceRecordsOptions)
+	}
+	if mock.ExportResourceRecordsFunc != nil {
+		return mock.ExportResourceRecordsFunc(exportResourceRecordsOptions)
+	}
+	err = notProgrammed("ExportResourceRecordsWithContext")
+	return
+}
+
+// ImportResourceRecords invokes the programmed ImportResourceRecordsFunc.
+func (mock *DnsSvcsV1) ImportResourceRecords(importResourceRecordsOptions *dnssvcsv1.ImportResourceRecordsOptions) (result *dnssvcsv1.ImportResourceRecordsResp, response *core.DetailedResponse, err error) {
+	mock.called("ImportResourceRecords", importResourceRecordsOptions)
+	if mock.ImportResourceRecordsFunc != nil {
+		return mock.ImportResourceRecordsFunc(importResourceRecordsOptions)
+	}
+	if mock.ImportResourceRecordsWithContextFunc != nil {
+		return mock.ImportResourceRecordsWithContextFunc(context.Background(), importResourceRecordsOptions)
+	}
+	err = notProgrammed("ImportResourceRecords")
+	return
+}
+
+// ImportResourceRecordsWithContext invokes the programmed ImportResourceRecordsWithContextFunc.
+func (mock *DnsSvcsV1) ImportResourceRecordsWithContext(ctx context.Context, importResourceRecordsOptions *dnssvcsv1.ImportResourceRecordsOptions) (result *dnssvcsv1.ImportResourceRecordsResp, response *core.DetailedResponse, err error) {
+	mock.called("ImportResourceRecordsWithContext", ctx, importResourceRecordsOptions)
+	if mock.ImportResourceRecordsWithContextFunc != nil {
+		return mock.ImportResourceRecordsWithContextFunc(ctx, importResourceRecordsOptions)
+	}
+	if mock.ImportResourceRecordsFunc != nil {
+		return mock.ImportResourceRecordsFunc(importResourceRecordsOptions)
+	}
+	err = notProgrammed("ImportResourceRecordsWithContext")
+	return
+}
+
+// ListPermittedNetworks invokes the programmed ListPermittedNetworksFunc.
+func (mock *DnsSvcsV1) ListPermittedNetworks(listPermittedNetworksOptions *dnssvcsv1.ListPermittedNetworksOptions) (result *dnssvcsv1.ListPermittedNetworks, response *core.DetailedResponse, err error) {
+	mock.called("ListPermittedNetworks", listPermittedNetworksOptions)
+	if mock.ListPermittedNetworksFunc != nil {
+		return mock.ListPermittedNetworksFunc(listPermittedNetworksOptions)
+	}
+	if mock.ListPermittedNetworksWithContextFunc != nil {
+		return mock.ListPermittedNetworksWithContextFunc(context.Background(), listPermittedNetworksOptions)
+	}
+	err = notProgrammed("ListPermittedNetworks")
+	return
+}
+
+// ListPermittedNetworksWithContext invokes the programmed ListPermittedNetworksWithContextFunc.
+func (mock *DnsSvcsV1) ListPermittedNetworksWithContext(ctx context.Context, listPermittedNetworksOptions *dnssvcsv1.ListPermittedNetworksOptions) (result *dnssvcsv1.ListPermittedNetworks, response *core.DetailedResponse, err error) {
+	mock.called("ListPermittedNetworksWithContext", ctx, listPermittedNetworksOptions)
+	if mock.ListPermittedNetworksWithContextFunc != nil {
+		return mock.ListPermittedNetworksWithContextFunc(ctx, listPermittedNetworksOptions)
+	}
+	if mock.ListPermittedNetworksFunc != nil {
+		return mock.ListPermittedNetworksFunc(listPermittedNetworksOptions)
+	}
+	err = notProgrammed("ListPermittedNetworksWithContext")
+	return
+}
+
+// CreatePermittedNetwork invokes the programmed CreatePermittedNetworkFunc.
+func (mock *DnsSvcsV1) CreatePermittedNetwork(createPermittedNetworkOptions *dnssvcsv1.CreatePermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("CreatePermittedNetwork", createPermittedNetworkOptions)
+	if mock.CreatePermittedNetworkFunc != nil {
+		return mock.CreatePermittedNetworkFunc(createPermittedNetworkOptions)
+	}
+	if mock.CreatePermittedNetworkWithContextFunc != nil {
+		return mock.CreatePermittedNetworkWithContextFunc(context.Background(), createPermittedNetworkOptions)
+	}
+	err = notProgrammed("CreatePermittedNetwork")
+	return
+}
+
+// CreatePermittedNetworkWithContext invokes the programmed CreatePermittedNetworkWithContextFunc.
+func (mock *DnsSvcsV1) CreatePermittedNetworkWithContext(ctx context.Context, createPermittedNetworkOptions *dnssvcsv1.CreatePermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("CreatePermittedNetworkWithContext", ctx, createPermittedNetworkOptions)
+	if mock.CreatePermittedNetworkWithContextFunc != nil {
+		return mock.CreatePermittedNetworkWithContextFunc(ctx, createPermittedNetworkOptions)
+	}
+	if mock.CreatePermittedNetworkFunc != nil {
+		return mock.CreatePermittedNetworkFunc(createPermittedNetworkOptions)
+	}
+	err = notProgrammed("CreatePermittedNetworkWithContext")
+	return
+}
+
+// DeletePermittedNetwork invokes the programmed DeletePermittedNetworkFunc.
+func (mock *DnsSvcsV1) DeletePermittedNetwork(deletePermittedNetworkOptions *dnssvcsv1.DeletePermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("DeletePermittedNetwork", deletePermittedNetworkOptions)
+	if mock.DeletePermittedNetworkFunc != nil {
+		return mock.DeletePermittedNetworkFunc(deletePermittedNetworkOptions)
+	}
+	if mock.DeletePermittedNetworkWithContextFunc != nil {
+		return mock.DeletePermittedNetworkWithContextFunc(context.Background(), deletePermittedNetworkOptions)
+	}
+	err = notProgrammed("DeletePermittedNetwork")
+	return
+}
+
+// DeletePermittedNetworkWithContext invokes the programmed DeletePermittedNetworkWithContextFunc.
+func (mock *DnsSvcsV1) DeletePermittedNetworkWithContext(ctx context.Context, deletePermittedNetworkOptions *dnssvcsv1.DeletePermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("DeletePermittedNetworkWithContext", ctx, deletePermittedNetworkOptions)
+	if mock.DeletePermittedNetworkWithContextFunc != nil {
+		return mock.DeletePermittedNetworkWithContextFunc(ctx, deletePermittedNetworkOptions)
+	}
+	if mock.DeletePermittedNetworkFunc != nil {
+		return mock.DeletePermittedNetworkFunc(deletePermittedNetworkOptions)
+	}
+	err = notProgrammed("DeletePermittedNetworkWithContext")
+	return
+}
+
+// GetPermittedNetwork invokes the programmed GetPermittedNetworkFunc.
+func (mock *DnsSvcsV1) GetPermittedNetwork(getPermittedNetworkOptions *dnssvcsv1.GetPermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("GetPermittedNetwork", getPermittedNetworkOptions)
+	if mock.GetPermittedNetworkFunc != nil {
+		return mock.GetPermittedNetworkFunc(getPermittedNetworkOptions)
+	}
+	if mock.GetPermittedNetworkWithContextFunc != nil {
+		return mock.GetPermittedNetworkWithContextFunc(context.Background(), getPermittedNetworkOptions)
+	}
+	err = notProgrammed("GetPermittedNetwork")
+	return
+}
+
+// GetPermittedNetworkWithContext invokes the programmed GetPermittedNetworkWithContextFunc.
+func (mock *DnsSvcsV1) GetPermittedNetworkWithContext(ctx context.Context, getPermittedNetworkOptions *dnssvcsv1.GetPermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("GetPermittedNetworkWithContext", ctx, getPermittedNetworkOptions)
+	if mock.GetPermittedNetworkWithContextFunc != nil {
+		return mock.GetPermittedNetworkWithContextFunc(ctx, getPermittedNetworkOptions)
+	}
+	if mock.GetPermittedNetworkFunc != nil {
+		return mock.GetPermittedNetworkFunc(getPermittedNetworkOptions)
+	}
+	err = notProgrammed("GetPermittedNetworkWithContext")
+	return
+}
+
+// ListLoadBalancers invokes the programmed ListLoadBalancersFunc.
+func (mock *DnsSvcsV1) ListLoadBalancers(listLoadBalancersOptions *dnssvcsv1.ListLoadBalancersOptions) (result *dnssvcsv1.ListLoadBalancers, response *core.DetailedResponse, err error) {
+	mock.called("ListLoadBalancers", listLoadBalancersOptions)
+	if mock.ListLoadBalancersFunc != nil {
+		return mock.ListLoadBalancersFunc(listLoadBalancersOptions)
+	}
+	if mock.ListLoadBalancersWithContextFunc != nil {
+		return mock.ListLoadBalancersWithContextFunc(context.Background(), listLoadBalancersOptions)
+	}
+	err = notProgrammed("ListLoadBalancers")
+	return
+}
+
+// ListLoadBalancersWithContext invokes the programmed ListLoadBalancersWithContextFunc.
+func (mock *DnsSvcsV1) ListLoadBalancersWithContext(ctx context.Context, listLoadBalancersOptions *dnssvcsv1.ListLoadBalancersOptions) (result *dnssvcsv1.ListLoadBalancers, response *core.DetailedResponse, err error) {
+	mock.called("ListLoadBalancersWithContext", ctx, listLoadBalancersOptions)
+	if mock.ListLoadBalancersWithContextFunc != nil {
+		return mock.ListLoadBalancersWithContextFunc(ctx, listLoadBalancersOptions)
+	}
+	if mock.ListLoadBalancersFunc != nil {
+		return mock.ListLoadBalancersFunc(listLoadBalancersOptions)
+	}
+	err = notProgrammed("ListLoadBalancersWithContext")
+	return
+}
+
+// CreateLoadBalancer invokes the programmed CreateLoadBalancerFunc.
+func (mock *DnsSvcsV1) CreateLoadBalancer(createLoadBalancerOptions *dnssvcsv1.CreateLoadBalancerOptions) (result *dnssvcsv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	mock.called("CreateLoadBalancer", createLoadBalancerOptions)
+	if mock.CreateLoadBalancerFunc != nil {
+		return mock.CreateLoadBalancerFunc(createLoadBalancerOptions)
+	}
+	if mock.CreateLoadBalancerWithContextFunc != nil {
+		return mock.CreateLoadBalancerWithContextFunc(context.Background(), createLoadBalancerOptions)
+	}
+	err = notProgrammed("CreateLoadBalancer")
+	return
+}
+
+// CreateLoadBalancerWithContext invokes the programmed CreateLoadBalancerWithContextFunc.
+func (mock *DnsSvcsV1) CreateLoadBalancerWithContext(ctx context.Context, createLoadBalancerOptions *dnssvcsv1.CreateLoadBalancerOptions) (result *dnssvcsv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	mock.called("CreateLoadBalancerWithContext", ctx, createLoadBalancerOptions)
+	if mock.CreateLoadBalancerWithContextFunc != nil {
+		return mock.CreateLoadBalancerWithContextFunc(ctx, createLoadBalancerOptions)
+	}
+	if mock.CreateLoadBalancerFunc != nil {
+		return mock.CreateLoadBalancerFunc(createLoadBalancerOptions)
+	}
+	err = notProgrammed("CreateLoadBalancerWithContext")
+	return
+}
+
+// DeleteLoadBalancer invokes the programmed DeleteLoadBalancerFunc.
+func (mock *DnsSvcsV1) DeleteLoadBalancer(deleteLoadBalancerOptions *dnssvcsv1.DeleteLoadBalancerOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteLoadBalancer", deleteLoadBalancerOptions)
+	if mock.DeleteLoadBalancerFunc != nil {
+		return mock.DeleteLoadBalancerFunc(deleteLoadBalancerOptions)
+	}
+	if mock.DeleteLoadBalancerWithContextFunc != nil {
+		return mock.DeleteLoadBalancerWithContextFunc(context.Background(), deleteLoadBalancerOptions)
+	}
+	err = notProgrammed("DeleteLoadBalancer")
+	return
+}
+
+// DeleteLoadBalancerWithContext invokes the programmed DeleteLoadBalancerWithContextFunc.
+func (mock *DnsSvcsV1) DeleteLoadBalancerWithContext(ctx context.Context, deleteLoadBalancerOptions *dnssvcsv1.DeleteLoadBalancerOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteLoadBalancerWithContext", ctx, deleteLoadBalancerOptions)
+	if mock.DeleteLoadBalancerWithContextFunc != nil {
+		return mock.DeleteLoadBalancerWithContextFunc(ctx, deleteLoadBalancerOptions)
+	}
+	if mock.DeleteLoadBalancerFunc != nil {
+		return mock.DeleteLoadBalancerFunc(deleteLoadBalancerOptions)
+	}
+	err = notProgrammed("DeleteLoadBalancerWithContext")
+	return
+}
+
+// GetLoadBalancer invokes the programmed GetLoadBalancerFunc.
+func (mock *DnsSvcsV1) GetLoadBalancer(getLoadBalancerOptions *dnssvcsv1.GetLoadBalancerOptions) (result *dnssvcsv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancer", getLoadBalancerOptions)
+	if mock.GetLoadBalancerFunc != nil {
+		return mock.GetLoadBalancerFunc(getLoadBalancerOptions)
+	}
+	if mock.GetLoadBalancerWithContextFunc != nil {
+		return mock.GetLoadBalancerWithContextFunc(context.Background(), getLoadBalancerOptions)
+	}
+	err = notProgrammed("GetLoadBalancer")
+	return
+}
+
+// GetLoadBalancerWithContext invokes the programmed GetLoadBalancerWithContextFunc.
+func (mock *DnsSvcsV1) GetLoadBalancerWithContext(ctx context.Context, getLoadBalancerOptions *dnssvcsv1.GetLoadBalancerOptions) (result *dnssvcsv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancerWithContext", ctx, getLoadBalancerOptions)
+	if mock.GetLoadBalancerWithContextFunc != nil {
+		return mock.GetLoadBalancerWithContextFunc(ctx, getLoadBalancerOptions)
+	}
+	if mock.GetLoadBalancerFunc != nil {
+		return mock.GetLoadBalancerFunc(getLoadBalancerOptions)
+	}
+	err = notProgrammed("GetLoadBalancerWithContext")
+	return
+}
+
+// UpdateLoadBalancer invokes the programmed UpdateLoadBalancerFunc.
+func (mock *DnsSvcsV1) UpdateLoadBalancer(updateLoadBalancerOptions *dnssvcsv1.UpdateLoadBalancerOptions) (result *dnssvcsv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLoadBalancer", updateLoadBalancerOptions)
+	if mock.UpdateLoadBalancerFunc != nil {
+		return mock.UpdateLoadBalancerFunc(updateLoadBalancerOptions)
+	}
+	if mock.UpdateLoadBalancerWithContextFunc != nil {
+		return mock.UpdateLoadBalancerWithContextFunc(context.Background(), updateLoadBalancerOptions)
+	}
+	err = notProgrammed("UpdateLoadBalancer")
+	return
+}
+
+// UpdateLoadBalancerWithContext invokes the programmed UpdateLoadBalancerWithContextFunc.
+func (mock *DnsSvcsV1) UpdateLoadBalancerWithContext(ctx context.Context, updateLoadBalancerOptions *dnssvcsv1.UpdateLoadBalancerOptions) (result *dnssvcsv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLoadBalancerWithContext", ctx, updateLoadBalancerOptions)
+	if mock.UpdateLoadBalancerWithContextFunc != nil {
+		return mock.UpdateLoadBalancerWithContextFunc(ctx, updateLoadBalancerOptions)
+	}
+	if mock.UpdateLoadBalancerFunc != nil {
+		return mock.UpdateLoadBalancerFunc(updateLoadBalancerOptions)
+	}
+	err = notProgrammed("UpdateLoadBalancerWithContext")
+	return
+}
+
+// ListPools invokes the programmed ListPoolsFunc.
+func (mock *DnsSvcsV1) ListPools(listPoolsOptions *dnssvcsv1.ListPoolsOptions) (result *dnssvcsv1.ListPools, response *core.DetailedResponse, err error) {
+	mock.called("ListPools", listPoolsOptions)
+	if mock.ListPoolsFunc != nil {
+		return mock.ListPoolsFunc(listPoolsOptions)
+	}
+	if mock.ListPoolsWithContextFunc != nil {
+		return mock.ListPoolsWithContextFunc(context.Background(), listPoolsOptions)
+	}
+	err = notProgrammed("ListPools")
+	return
+}
+
+// ListPoolsWithContext invokes the programmed ListPoolsWithContextFunc.
+func (mock *DnsSvcsV1) ListPoolsWithContext(ctx context.Context, listPoolsOptions *dnssvcsv1.ListPoolsOptions) (result *dnssvcsv1.ListPools, response *core.DetailedResponse, err error) {
+	mock.called("ListPoolsWithContext", ctx, listPoolsOptions)
+	if mock.ListPoolsWithContextFunc != nil {
+		return mock.ListPoolsWithContextFunc(ctx, listPoolsOptions)
+	}
+	if mock.ListPoolsFunc != nil {
+		return mock.ListPoolsFunc(listPoolsOptions)
+	}
+	err = notProgrammed("ListPoolsWithContext")
+	return
+}
+
+// CreatePool invokes the programmed CreatePoolFunc.
+func (mock *DnsSvcsV1) CreatePool(createPoolOptions *dnssvcsv1.CreatePoolOptions) (result *dnssvcsv1.Pool, response *core.DetailedResponse, err error) {
+	mock.called("CreatePool", createPoolOptions)
+	if mock.CreatePoolFunc != nil {
+		return mock.CreatePoolFunc(createPoolOptions)
+	}
+	if mock.CreatePoolWithContextFunc != nil {
+		return mock.CreatePoolWithContextFunc(context.Background(), createPoolOptions)
+	}
+	err = notProgrammed("CreatePool")
+	return
+}
+
+// CreatePoolWithContext invokes the programmed CreatePoolWithContextFunc.
+func (mock *DnsSvcsV1) CreatePoolWithContext(ctx context.Context, createPoolOptions *dnssvcsv1.CreatePoolOptions) (result *dnssvcsv1.Pool, response *core.DetailedResponse, err error) {
+	mock.called("CreatePoolWithContext", ctx, createPoolOptions)
+	if mock.CreatePoolWithContextFunc != nil {
+		return mock.CreatePoolWithContextFunc(ctx, createPoolOptions)
+	}
+	if mock.CreatePoolFunc != nil {
+		return mock.CreatePoolFunc(createPoolOptions)
+	}
+	err = notProgrammed("CreatePoolWithContext")
+	return
+}
+
+// DeletePool invokes the programmed DeletePoolFunc.
+func (mock *DnsSvcsV1) DeletePool(deletePoolOptions *dnssvcsv1.DeletePoolOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeletePool", deletePoolOptions)
+	if mock.DeletePoolFunc != nil {
+		return mock.DeletePoolFunc(deletePoolOptions)
+	}
+	if mock.DeletePoolWithContextFunc != nil {
+		return mock.DeletePoolWithContextFunc(context.Background(), deletePoolOptions)
+	}
+	err = notProgrammed("DeletePool")
+	return
+}
+
+// DeletePoolWithContext invokes the programmed DeletePoolWithContextFunc.
+func (mock *DnsSvcsV1) DeletePoolWithContext(ctx context.Context, deletePoolOptions *dnssvcsv1.DeletePoolOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeletePoolWithContext", ctx, deletePoolOptions)
+	if mock.DeletePoolWithContextFunc != nil {
+		return mock.DeletePoolWithContextFunc(ctx, deletePoolOptions)
+	}
+	if mock.DeletePoolFunc != nil {
+		return mock.DeletePoolFunc(deletePoolOptions)
+	}
+	err = notProgrammed("DeletePoolWithContext")
+	return
+}
+
+// GetPool invokes the programmed GetPoolFunc.
+func (mock *DnsSvcsV1) GetPool(getPoolOptions *dnssvcsv1.GetPoolOptions) (result *dnssvcsv1.Pool, response *core.DetailedResponse, err error) {
+	mock.called("GetPool", getPoolOptions)
+	if mock.GetPoolFunc != nil {
+		return mock.GetPoolFunc(getPoolOptions)
+	}
+	if mock.GetPoolWithContextFunc != nil {
+		return mock.GetPoolWithContextFunc(context.Background(), getPoolOptions)
+	}
+	err = notProgrammed("GetPool")
+	return
+}
+
+// GetPoolWithContext invokes the programmed GetPoolWithContextFunc.
+func (mock *DnsSvcsV1) GetPoolWithContext(ctx context.Context, getPoolOptions *dnssvcsv1.GetPoolOptions) (result *dnssvcsv1.Pool, response *core.DetailedResponse, err error) {
+	mock.called("GetPoolWithContext", ctx, getPoolOptions)
+	if mock.GetPoolWithContextFunc != nil {
+		return mock.GetPoolWithContextFunc(ctx, getPoolOptions)
+	}
+	if mock.GetPoolFunc != nil {
+		return mock.GetPoolFunc(getPoolOptions)
+	}
+	err = notProgrammed("GetPoolWithContext")
+	return
+}
+
+// UpdatePool invokes the programmed UpdatePoolFunc.
+func (mock *DnsSvcsV1) UpdatePool(updatePoolOptions *dnssvcsv1.UpdatePoolOptions) (result *dnssvcsv1.Pool, response *core.DetailedResponse, err error) {
+	mock.called("UpdatePool", updatePoolOptions)
+	if mock.UpdatePoolFunc != nil {
+		return mock.UpdatePoolFunc(updatePoolOptions)
+	}
+	if mock.UpdatePoolWithContextFunc != nil {
+		return mock.UpdatePoolWithContextFunc(context.Background(), updatePoolOptions)
+	}
+	err = notProgrammed("UpdatePool")
+	return
+}
+
+// UpdatePoolWithContext invokes the programmed UpdatePoolWithContextFunc.
+func (mock *DnsSvcsV1) UpdatePoolWithContext(ctx context.Context, updatePoolOptions *dnssvcsv1.UpdatePoolOptions) (result *dnssvcsv1.Pool, response *core.DetailedResponse, err error) {
+	mock.called("UpdatePoolWithContext", ctx, updatePoolOptions)
+	if mock.UpdatePoolWithContextFunc != nil {
+		return mock.UpdatePoolWithContextFunc(ctx, updatePoolOptions)
+	}
+	if mock.UpdatePoolFunc != nil {
+		return mock.UpdatePoolFunc(updatePoolOptions)
+	}
+	err = notProgrammed("UpdatePoolWithContext")
+	return
+}
+
+// ListMonitors invokes the programmed ListMonitorsFunc.
+func (mock *DnsSvcsV1) ListMonitors(listMonitorsOptions *dnssvcsv1.ListMonitorsOptions) (result *dnssvcsv1.ListMonitors, response *core.DetailedResponse, err error) {
+	mock.called("ListMonitors", listMonitorsOptions)
+	if mock.ListMonitorsFunc != nil {
+		return mock.ListMonitorsFunc(listMonitorsOptions)
+	}
+	if mock.ListMonitorsWithContextFunc != nil {
+		return mock.ListMonitorsWithContextFunc(context.Background(), listMonitorsOptions)
+	}
+	err = notProgrammed("ListMonitors")
+	return
+}
+
+// ListMonitorsWithContext invokes the programmed ListMonitorsWithContextFunc.
+func (mock *DnsSvcsV1) ListMonitorsWithContext(ctx context.Context, listMonitorsOptions *dnssvcsv1.ListMonitorsOptions) (result *dnssvcsv1.ListMonitors, response *core.DetailedResponse, err error) {
+	mock.called("ListMonitorsWithContext", ctx, listMonitorsOptions)
+	if mock.ListMonitorsWithContextFunc != nil {
+		return mock.ListMonitorsWithContextFunc(ctx, listMonitorsOptions)
+	}
+	if mock.ListMonitorsFunc != nil {
+		return mock.ListMonitorsFunc(listMonitorsOptions)
+	}
+	err = notProgrammed("ListMonitorsWithContext")
+	return
+}
+
+// CreateMonitor invokes the programmed CreateMonitorFunc.
+func (mock *DnsSvcsV1) CreateMonitor(createMonitorOptions *dnssvcsv1.CreateMonitorOptions) (result *dnssvcsv1.Monitor, response *core.DetailedResponse, err error) {
+	mock.called("CreateMonitor", createMonitorOptions)
+	if mock.CreateMonitorFunc != nil {
+		return mock.CreateMonitorFunc(createMonitorOptions)
+	}
+	if mock.CreateMonitorWithContextFunc != nil {
+		return mock.CreateMonitorWithContextFunc(context.Background(), createMonitorOptions)
+	}
+	err = notProgrammed("CreateMonitor")
+	return
+}
+
+// CreateMonitorWithContext invokes the programmed CreateMonitorWithContextFunc.
+func (mock *DnsSvcsV1) CreateMonitorWithContext(ctx context.Context, createMonitorOptions *dnssvcsv1.CreateMonitorOptions) (result *dnssvcsv1.Monitor, response *core.DetailedResponse, err error) {
+	mock.called("CreateMonitorWithContext", ctx, createMonitorOptions)
+	if mock.CreateMonitorWithContextFunc != nil {
+		return mock.CreateMonitorWithContextFunc(ctx, createMonitorOptions)
+	}
+	if mock.CreateMonitorFunc != nil {
+		return mock.CreateMonitorFunc(createMonitorOptions)
+	}
+	err = notProgrammed("CreateMonitorWithContext")
+	return
+}
+
+// DeleteMonitor invokes the programmed DeleteMonitorFunc.
+func (mock *DnsSvcsV1) DeleteMonitor(deleteMonitorOptions *dnssvcsv1.DeleteMonitorOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteMonitor", deleteMonitorOptions)
+	if mock.DeleteMonitorFunc != nil {
+		return mock.DeleteMonitorFunc(deleteMonitorOptions)
+	}
+	if mock.DeleteMonitorWithContextFunc != nil {
+		return mock.DeleteMonitorWithContextFunc(context.Background(), deleteMonitorOptions)
+	}
+	err = notProgrammed("DeleteMonitor")
+	return
+}
+
+// DeleteMonitorWithContext invokes the programmed DeleteMonitorWithContextFunc.
+func (mock *DnsSvcsV1) DeleteMonitorWithContext(ctx context.Context, deleteMonitorOptions *dnssvcsv1.DeleteMonitorOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteMonitorWithContext", ctx, deleteMonitorOptions)
+	if mock.DeleteMonitorWithContextFunc != nil {
+		return mock.DeleteMonitorWithContextFunc(ctx, deleteMonitorOptions)
+	}
+	if mock.DeleteMonitorFunc != nil {
+		return mock.DeleteMonitorFunc(deleteMonitorOptions)
+	}
+	err = notProgrammed("DeleteMonitorWithContext")
+	return
+}
+
+// GetMonitor invokes the programmed GetMonitorFunc.
+func (mock *DnsSvcsV1) GetMonitor(getMonitorOptions *dnssvcsv1.GetMonitorOptions) (result *dnssvcsv1.Monitor, response *core.DetailedResponse, err error) {
+	mock.called("GetMonitor", getMonitorOptions)
+	if mock.GetMonitorFunc != nil {
+		return mock.GetMonitorFunc(getMonitorOptions)
+	}
+	if mock.GetMonitorWithContextFunc != nil {
+		return mock.GetMonitorWithContextFunc(context.Background(), getMonitorOptions)
+	}
+	err = notProgrammed("GetMonitor")
+	return
+}
+
+// GetMonitorWithContext invokes the programmed GetMonitorWithContextFunc.
+func (mock *DnsSvcsV1) GetMonitorWithContext(ctx context.Context, getMonitorOptions *dnssvcsv1.GetMonitorOptions) (result *dnssvcsv1.Monitor, response *core.DetailedResponse, err error) {
+	mock.called("GetMonitorWithContext", ctx, getMonitorOptions)
+	if mock.GetMonitorWithContextFunc != nil {
+		return mock.GetMonitorWithContextFunc(ctx, getMonitorOptions)
+	}
+	if mock.GetMonitorFunc != nil {
+		return mock.GetMonitorFunc(getMonitorOptions)
+	}
+	err = notProgrammed("GetMonitorWithContext")
+	return
+}
+
+// UpdateMonitor invokes the programmed UpdateMonitorFunc.
+func (mock *DnsSvcsV1) UpdateMonitor(updateMonitorOptions *dnssvcsv1.UpdateMonitorOptions) (result *dnssvcsv1.Monitor, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMonitor", updateMonitorOptions)
+	if mock.UpdateMonitorFunc != nil {
+		return mock.UpdateMonitorFunc(updateMonitorOptions)
+	}
+	if mock.UpdateMonitorWithContextFunc != nil {
+		return mock.UpdateMonitorWithContextFunc(context.Background(), updateMonitorOptions)
+	}
+	err = notProgrammed("UpdateMonitor")
+	return
+}
+
+// UpdateMonitorWithContext invokes the programmed UpdateMonitorWithContextFunc.
+func (mock *DnsSvcsV1) UpdateMonitorWithContext(ctx context.Context, updateMonitorOptions *dnssvcsv1.UpdateMonitorOptions) (result *dnssvcsv1.Monitor, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMonitorWithContext", ctx, updateMonitorOptions)
+	if mock.UpdateMonitorWithContextFunc != nil {
+		return mock.UpdateMonitorWithContextFunc(ctx, updateMonitorOptions)
+	}
+	if mock.UpdateMonitorFunc != nil {
+		return mock.UpdateMonitorFunc(updateMonitorOptions)
+	}
+	err = notProgrammed("UpdateMonitorWithContext")
+	return
+}
+
+// ListCustomResolvers invokes the programmed ListCustomResolversFunc.
+func (mock *DnsSvcsV1) ListCustomResolvers(listCustomResolversOptions *dnssvcsv1.ListCustomResolversOptions) (result *dnssvcsv1.CustomResolverList, response *core.DetailedResponse, err error) {
+	mock.called("ListCustomResolvers", listCustomResolversOptions)
+	if mock.ListCustomResolversFunc != nil {
+		return mock.ListCustomResolversFunc(listCustomResolversOptions)
+	}
+	if mock.ListCustomResolversWithContextFunc != nil {
+		return mock.ListCustomResolversWithContextFunc(context.Background(), listCustomResolversOptions)
+	}
+	err = notProgrammed("ListCustomResolvers")
+	return
+}
+
+// ListCustomResolversWithContext invokes the programmed ListCustomResolversWithContextFunc.
+func (mock *DnsSvcsV1) ListCustomResolversWithContext(ctx context.Context, listCustomResolversOptions *dnssvcsv1.ListCustomResolversOptions) (result *dnssvcsv1.CustomResolverList, response *core.DetailedResponse, err error) {
+	mock.called("ListCustomResolversWithContext", ctx, listCustomResolversOptions)
+	if mock.ListCustomResolversWithContextFunc != nil {
+		return mock.ListCustomResolversWithContextFunc(ctx, listCustomResolversOptions)
+	}
+	if mock.ListCustomResolversFunc != nil {
+		return mock.ListCustomResolversFunc(listCustomResolversOptions)
+	}
+	err = notProgrammed("ListCustomResolversWithContext")
+	return
+}
+
+// CreateCustomResolver invokes the programmed CreateCustomResolverFunc.
+func (mock *DnsSvcsV1) CreateCustomResolver(createCustomResolverOptions *dnssvcsv1.CreateCustomResolverOptions) (result *dnssvcsv1.CustomResolver, response *core.DetailedResponse, err error) {
+	mock.called("CreateCustomResolver", createCustomResolverOptions)
+	if mock.CreateCustomResolverFunc != nil {
+		return mock.CreateCustomResolverFunc(createCustomResolverOptions)
+	}
+	if mock.CreateCustomResolverWithContextFunc != nil {
+		return mock.CreateCustomResolverWithContextFunc(context.Background(), createCustomResolverOptions)
+	}
+	err = notProgrammed("CreateCustomResolver")
+	return
+}
+
+// CreateCustomResolverWithContext invokes the programmed CreateCustomResolverWithContextFunc.
+func (mock *DnsSvcsV1) CreateCustomResolverWithContext(ctx context.Context, createCustomResolverOptions *dnssvcsv1.CreateCustomResolverOptions) (result *dnssvcsv1.CustomResolver, response *core.DetailedResponse, err error) {
+	mock.called("CreateCustomResolverWithContext", ctx, createCustomResolverOptions)
+	if mock.CreateCustomResolverWithContextFunc != nil {
+		return mock.CreateCustomResolverWithContextFunc(ctx, createCustomResolverOptions)
+	}
+	if mock.CreateCustomResolverFunc != nil {
+		return mock.CreateCustomResolverFunc(createCustomResolverOptions)
+	}
+	err = notProgrammed("CreateCustomResolverWithContext")
+	return
+}
+
+// DeleteCustomResolver invokes the programmed DeleteCustomResolverFunc.
+func (mock *DnsSvcsV1) DeleteCustomResolver(deleteCustomResolverOptions *dnssvcsv1.DeleteCustomResolverOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteCustomResolver", deleteCustomResolverOptions)
+	if mock.DeleteCustomResolverFunc != nil {
+		return mock.DeleteCustomResolverFunc(deleteCustomResolverOptions)
+	}
+	if mock.DeleteCustomResolverWithContextFunc != nil {
+		return mock.DeleteCustomResolverWithContextFunc(context.Background(), deleteCustomResolverOptions)
+	}
+	err = notProgrammed("DeleteCustomResolver")
+	return
+}
+
+// DeleteCustomResolverWithContext invokes the programmed DeleteCustomResolverWithContextFunc.
+func (mock *DnsSvcsV1) DeleteCustomResolverWithContext(ctx context.Context, deleteCustomResolverOptions *dnssvcsv1.DeleteCustomResolverOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteCustomResolverWithContext", ctx, deleteCustomResolverOptions)
+	if mock.DeleteCustomResolverWithContextFunc != nil {
+		return mock.DeleteCustomResolverWithContextFunc(ctx, deleteCustomResolverOptions)
+	}
+	if mock.DeleteCustomResolverFunc != nil {
+		return mock.DeleteCustomResolverFunc(deleteCustomResolverOptions)
+	}
+	err = notProgrammed("DeleteCustomResolverWithContext")
+	return
+}
+
+// GetCustomResolver invokes the programmed GetCustomResolverFunc.
+func (mock *DnsSvcsV1) GetCustomResolver(getCustomResolverOptions *dnssvcsv1.GetCustomResolverOptions) (result *dnssvcsv1.CustomResolver, response *core.DetailedResponse, err error) {
+	mock.called("GetCustomResolver", getCustomResolverOptions)
+	if mock.GetCustomResolverFunc != nil {
+		return mock.GetCustomResolverFunc(getCustomResolverOptions)
+	}
+	if mock.GetCustomResolverWithContextFunc != nil {
+		return mock.GetCustomResolverWithContextFunc(context.Background(), getCustomResolverOptions)
+	}
+	err = notProgrammed("GetCustomResolver")
+	return
+}
+
+// GetCustomResolverWithContext invokes the programmed GetCustomResolverWithContextFunc.
+func (mock *DnsSvcsV1) GetCustomResolverWithContext(ctx context.Context, getCustomResolverOptions *dnssvcsv1.GetCustomResolverOptions) (result *dnssvcsv1.CustomResolver, response *core.DetailedResponse, err error) {
+	mock.called("GetCustomResolverWithContext", ctx, getCustomResolverOptions)
+	if mock.GetCustomResolverWithContextFunc != nil {
+		return mock.GetCustomResolverWithContextFunc(ctx, getCustomResolverOptions)
+	}
+	if mock.GetCustomResolverFunc != nil {
+		return mock.GetCustomResolverFunc(getCustomResolverOptions)
+	}
+	err = notProgrammed("GetCustomResolverWithContext")
+	return
+}
+
+// UpdateCustomResolver invokes the programmed UpdateCustomResolverFunc.
+func (mock *DnsSvcsV1) UpdateCustomResolver(updateCustomResolverOptions *dnssvcsv1.UpdateCustomResolverOptions) (result *dnssvcsv1.CustomResolver, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCustomResolver", updateCustomResolverOptions)
+	if mock.UpdateCustomResolverFunc != nil {
+		return mock.UpdateCustomResolverFunc(updateCustomResolverOptions)
+	}
+	if mock.UpdateCustomResolverWithContextFunc != nil {
+		return mock.UpdateCustomResolverWithContextFunc(context.Background(), updateCustomResolverOptions)
+	}
+	err = notProgrammed("UpdateCustomResolver")
+	return
+}
+
+// UpdateCustomResolverWithContext invokes the programmed UpdateCustomResolverWithContextFunc.
+func (mock *DnsSvcsV1) UpdateCustomResolverWithContext(ctx context.Context, updateCustomResolverOptions *dnssvcsv1.UpdateCustomResolverOptions) (result *dnssvcsv1.CustomResolver, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCustomResolverWithContext", ctx, updateCustomResolverOptions)
+	if mock.UpdateCustomResolverWithContextFunc != nil {
+		return mock.UpdateCustomResolverWithContextFunc(ctx, updateCustomResolverOptions)
+	}
+	if mock.UpdateCustomResolverFunc != nil {
+		return mock.UpdateCustomResolverFunc(updateCustomResolverOptions)
+	}
+	err = notProgrammed("UpdateCustomResolverWithContext")
+	return
+}
+
+// UpdateCrLocationsOrder invokes the programmed UpdateCrLocationsOrderFunc.
+func (mock *DnsSvcsV1) UpdateCrLocationsOrder(updateCrLocationsOrderOptions *dnssvcsv1.UpdateCrLocationsOrderOptions) (result *dnssvcsv1.CustomResolver, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCrLocationsOrder", updateCrLocationsOrderOptions)
+	if mock.UpdateCrLocationsOrderFunc != nil {
+		return mock.UpdateCrLocationsOrderFunc(updateCrLocationsOrderOptions)
+	}
+	if mock.UpdateCrLocationsOrderWithContextFunc != nil {
+		return mock.UpdateCrLocationsOrderWithContextFunc(context.Background(), updateCrLocationsOrderOptions)
+	}
+	err = notProgrammed("UpdateCrLocationsOrder")
+	return
+}
+
+// UpdateCrLocationsOrderWithContext invokes the programmed UpdateCrLocationsOrderWithContextFunc.
+func (mock *DnsSvcsV1) UpdateCrLocationsOrderWithContext(ctx context.Context, updateCrLocationsOrderOptions *dnssvcsv1.UpdateCrLocationsOrderOptions) (result *dnssvcsv1.CustomResolver, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCrLocationsOrderWithContext", ctx, updateCrLocationsOrderOptions)
+	if mock.UpdateCrLocationsOrderWithContextFunc != nil {
+		return mock.UpdateCrLocationsOrderWithContextFunc(ctx, updateCrLocationsOrderOptions)
+	}
+	if mock.UpdateCrLocationsOrderFunc != nil {
+		return mock.UpdateCrLocationsOrderFunc(updateCrLocationsOrderOptions)
+	}
+	err = notProgrammed("UpdateCrLocationsOrderWithContext")
+	return
+}
+
+// AddCustomResolverLocation invokes the programmed AddCustomResolverLocationFunc.
+func (mock *DnsSvcsV1) AddCustomResolverLocation(addCustomResolverLocationOptions *dnssvcsv1.AddCustomResolverLocationOptions) (result *dnssvcsv1.Location, response *core.DetailedResponse, err error) {
+	mock.called("AddCustomResolverLocation", addCustomResolverLocationOptions)
+	if mock.AddCustomResolverLocationFunc != nil {
+		return mock.AddCustomResolverLocationFunc(addCustomResolverLocationOptions)
+	}
+	if mock.AddCustomResolverLocationWithContextFunc != nil {
+		return mock.AddCustomResolverLocationWithContextFunc(context.Background(), addCustomResolverLocationOptions)
+	}
+	err = notProgrammed("AddCustomResolverLocation")
+	return
+}
+
+// AddCustomResolverLocationWithContext invokes the programmed AddCustomResolverLocationWithContextFunc.
+func (mock *DnsSvcsV1) AddCustomResolverLocationWithContext(ctx context.Context, addCustomResolverLocationOptions *dnssvcsv1.AddCustomResolverLocationOptions) (result *dnssvcsv1.Location, response *core.DetailedResponse, err error) {
+	mock.called("AddCustomResolverLocationWithContext", ctx, addCustomResolverLocationOptions)
+	if mock.AddCustomResolverLocationWithContextFunc != nil {
+		return mock.AddCustomResolverLocationWithContextFunc(ctx, addCustomResolverLocationOptions)
+	}
+	if mock.AddCustomResolverLocationFunc != nil {
+		return mock.AddCustomResolverLocationFunc(addCustomResolverLocationOptions)
+	}
+	err = notProgrammed("AddCustomResolverLocationWithContext")
+	return
+}
+
+// UpdateCustomResolverLocation invokes the programmed UpdateCustomResolverLocationFunc.
+func (mock *DnsSvcsV1) UpdateCustomResolverLocation(updateCustomResolverLocationOptions *dnssvcsv1.UpdateCustomResolverLocationOptions) (result *dnssvcsv1.Location, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCustomResolverLocation", updateCustomResolverLocationOptions)
+	if mock.UpdateCustomResolverLocationFunc != nil {
+		return mock.UpdateCustomResolverLocationFunc(updateCustomResolverLocationOptions)
+	}
+	if mock.UpdateCustomResolverLocationWithContextFunc != nil {
+		return mock.UpdateCustomResolverLocationWithContextFunc(context.Background(), updateCustomResolverLocationOptions)
+	}
+	err = notProgrammed("UpdateCustomResolverLocation")
+	return
+}
+
+// UpdateCustomResolverLocationWithContext invokes the programmed UpdateCustomResolverLocationWithContextFunc.
+func (mock *DnsSvcsV1) UpdateCustomResolverLocationWithContext(ctx context.Context, updateCustomResolverLocationOptions *dnssvcsv1.UpdateCustomResolverLocationOptions) (result *dnssvcsv1.Location, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCustomResolverLocationWithContext", ctx, updateCustomResolverLocationOptions)
+	if mock.UpdateCustomResolverLocationWithContextFunc != nil {
+		return mock.UpdateCustomResolverLocationWithContextFunc(ctx, updateCustomResolverLocationOptions)
+	}
+	if mock.UpdateCustomResolverLocationFunc != nil {
+		return mock.UpdateCustomResolverLocationFunc(updateCustomResolverLocationOptions)
+	}
+	err = notProgrammed("UpdateCustomResolverLocationWithContext")
+	return
+}
+
+// DeleteCustomResolverLocation invokes the programmed DeleteCustomResolverLocationFunc.
+func (mock *DnsSvcsV1) DeleteCustomResolverLocation(deleteCustomResolverLocationOptions *dnssvcsv1.DeleteCustomResolverLocationOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteCustomResolverLocation", deleteCustomResolverLocationOptions)
+	if mock.DeleteCustomResolverLocationFunc != nil {
+		return mock.DeleteCustomResolverLocationFunc(deleteCustomResolverLocationOptions)
+	}
+	if mock.DeleteCustomResolverLocationWithContextFunc != nil {
+		return mock.DeleteCustomResolverLocationWithContextFunc(context.Background(), deleteCustomResolverLocationOptions)
+	}
+	err = notProgrammed("DeleteCustomResolverLocation")
+	return
+}
+
+// DeleteCustomResolverLocationWithContext invokes the programmed DeleteCustomResolverLocationWithContextFunc.
+func (mock *DnsSvcsV1) DeleteCustomResolverLocationWithContext(ctx context.Context, deleteCustomResolverLocationOptions *dnssvcsv1.DeleteCustomResolverLocationOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteCustomResolverLocationWithContext", ctx, deleteCustomResolverLocationOptions)
+	if mock.DeleteCustomResolverLocationWithContextFunc != nil {
+		return mock.DeleteCustomResolverLocationWithContextFunc(ctx, deleteCustomResolverLocationOptions)
+	}
+	if mock.DeleteCustomResolverLocationFunc != nil {
+		return mock.DeleteCustomResolverLocationFunc(deleteCustomResolverLocationOptions)
+	}
+	err = notProgrammed("DeleteCustomResolverLocationWithContext")
+	return
+}
+
+// ListForwardingRules invokes the programmed ListForwardingRulesFunc.
+func (mock *DnsSvcsV1) ListForwardingRules(listForwardingRulesOptions *dnssvcsv1.ListForwardingRulesOptions) (result *dnssvcsv1.ForwardingRuleList, response *core.DetailedResponse, err error) {
+	mock.called("ListForwardingRules", listForwardingRulesOptions)
+	if mock.ListForwardingRulesFunc != nil {
+		return mock.ListForwardingRulesFunc(listForwardingRulesOptions)
+	}
+	if mock.ListForwardingRulesWithContextFunc != nil {
+		return mock.ListForwardingRulesWithContextFunc(context.Background(), listForwardingRulesOptions)
+	}
+	err = notProgrammed("ListForwardingRules")
+	return
+}
+
+// ListForwardingRulesWithContext invokes the programmed ListForwardingRulesWithContextFunc.
+func (mock *DnsSvcsV1) ListForwardingRulesWithContext(ctx context.Context, listForwardingRulesOptions *dnssvcsv1.ListForwardingRulesOptions) (result *dnssvcsv1.ForwardingRuleList, response *core.DetailedResponse, err error) {
+	mock.called("ListForwardingRulesWithContext", ctx, listForwardingRulesOptions)
+	if mock.ListForwardingRulesWithContextFunc != nil {
+		return mock.ListForwardingRulesWithContextFunc(ctx, listForwardingRulesOptions)
+	}
+	if mock.ListForwardingRulesFunc != nil {
+		return mock.ListForwardingRulesFunc(listForwardingRulesOptions)
+	}
+	err = notProgrammed("ListForwardingRulesWithContext")
+	return
+}
+
+// CreateForwardingRule invokes the programmed CreateForwardingRuleFunc.
+func (mock *DnsSvcsV1) CreateForwardingRule(createForwardingRuleOptions *dnssvcsv1.CreateForwardingRuleOptions) (result *dnssvcsv1.ForwardingRule, response *core.DetailedResponse, err error) {
+	mock.called("CreateForwardingRule", createForwardingRuleOptions)
+	if mock.CreateForwardingRuleFunc != nil {
+		return mock.CreateForwardingRuleFunc(createForwardingRuleOptions)
+	}
+	if mock.CreateForwardingRuleWithContextFunc != nil {
+		return mock.CreateForwardingRuleWithContextFunc(context.Background(), createForwardingRuleOptions)
+	}
+	err = notProgrammed("CreateForwardingRule")
+	return
+}
+
+// CreateForwardingRuleWithContext invokes the programmed CreateForwardingRuleWithContextFunc.
+func (mock *DnsSvcsV1) CreateForwardingRuleWithContext(ctx context.Context, createForwardingRuleOptions *dnssvcsv1.CreateForwardingRuleOptions) (result *dnssvcsv1.ForwardingRule, response *core.DetailedResponse, err error) {
+	mock.called("CreateForwardingRuleWithContext", ctx, createForwardingRuleOptions)
+	if mock.CreateForwardingRuleWithContextFunc != nil {
+		return mock.CreateForwardingRuleWithContextFunc(ctx, createForwardingRuleOptions)
+	}
+	if mock.CreateForwardingRuleFunc != nil {
+		return mock.CreateForwardingRuleFunc(createForwardingRuleOptions)
+	}
+	err = notProgrammed("CreateForwardingRuleWithContext")
+	return
+}
+
+// DeleteForwardingRule invokes the programmed DeleteForwardingRuleFunc.
+func (mock *DnsSvcsV1) DeleteForwardingRule(deleteForwardingRuleOptions *dnssvcsv1.DeleteForwardingRuleOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteForwardingRule", deleteForwardingRuleOptions)
+	if mock.DeleteForwardingRuleFunc != nil {
+		return mock.DeleteForwardingRuleFunc(deleteForwardingRuleOptions)
+	}
+	if mock.DeleteForwardingRuleWithContextFunc != nil {
+		return mock.DeleteForwardingRuleWithContextFunc(context.Background(), deleteForwardingRuleOptions)
+	}
+	err = notProgrammed("DeleteForwardingRule")
+	return
+}
+
+// DeleteForwardingRuleWithContext invokes the programmed DeleteForwardingRuleWithContextFunc.
+func (mock *DnsSvcsV1) DeleteForwardingRuleWithContext(ctx context.Context, deleteForwardingRuleOptions *dnssvcsv1.DeleteForwardingRuleOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteForwardingRuleWithContext", ctx, deleteForwardingRuleOptions)
+	if mock.DeleteForwardingRuleWithContextFunc != nil {
+		return mock.DeleteForwardingRuleWithContextFunc(ctx, deleteForwardingRuleOptions)
+	}
+	if mock.DeleteForwardingRuleFunc != nil {
+		return mock.DeleteForwardingRuleFunc(deleteForwardingRuleOptions)
+	}
+	err = notProgrammed("DeleteForwardingRuleWithContext")
+	return
+}
+
+// GetForwardingRule invokes the programmed GetForwardingRuleFunc.
+func (mock *DnsSvcsV1) GetForwardingRule(getForwardingRuleOptions *dnssvcsv1.GetForwardingRuleOptions) (result *dnssvcsv1.ForwardingRule, response *core.DetailedResponse, err error) {
+	mock.called("GetForwardingRule", getForwardingRuleOptions)
+	if mock.GetForwardingRuleFunc != nil {
+		return mock.GetForwardingRuleFunc(getForwardingRuleOptions)
+	}
+	if mock.GetForwardingRuleWithContextFunc != nil {
+		return mock.GetForwardingRuleWithContextFunc(context.Background(), getForwardingRuleOptions)
+	}
+	err = notProgrammed("GetForwardingRule")
+	return
+}
+
+// GetForwardingRuleWithContext invokes the programmed GetForwardingRuleWithContextFunc.
+func (mock *DnsSvcsV1) GetForwardingRuleWithContext(ctx context.Context, getForwardingRuleOptions *dnssvcsv1.GetForwardingRuleOptions) (result *dnssvcsv1.ForwardingRule, response *core.DetailedResponse, err error) {
+	mock.called("GetForwardingRuleWithContext", ctx, getForwardingRuleOptions)
+	if mock.GetForwardingRuleWithContextFunc != nil {
+		return mock.GetForwardingRuleWithContextFunc(ctx, getForwardingRuleOptions)
+	}
+	if mock.GetForwardingRuleFunc != nil {
+		return mock.GetForwardingRuleFunc(getForwardingRuleOptions)
+	}
+	err = notProgrammed("GetForwardingRuleWithContext")
+	return
+}
+
+// UpdateForwardingRule invokes the programmed UpdateForwardingRuleFunc.
+func (mock *DnsSvcsV1) UpdateForwardingRule(updateForwardingRuleOptions *dnssvcsv1.UpdateForwardingRuleOptions) (result *dnssvcsv1.ForwardingRule, response *core.DetailedResponse, err error) {
+	mock.called("UpdateForwardingRule", updateForwardingRuleOptions)
+	if mock.UpdateForwardingRuleFunc != nil {
+		return mock.UpdateForwardingRuleFunc(updateForwardingRuleOptions)
+	}
+	if mock.UpdateForwardingRuleWithContextFunc != nil {
+		return mock.UpdateForwardingRuleWithContextFunc(context.Background(), updateForwardingRuleOptions)
+	}
+	err = notProgrammed("UpdateForwardingRule")
+	return
+}
+
+// UpdateForwardingRuleWithContext invokes the programmed UpdateForwardingRuleWithContextFunc.
+func (mock *DnsSvcsV1) UpdateForwardingRuleWithContext(ctx context.Context, updateForwardingRuleOptions *dnssvcsv1.UpdateForwardingRuleOptions) (result *dnssvcsv1.ForwardingRule, response *core.DetailedResponse, err error) {
+	mock.called("UpdateForwardingRuleWithContext", ctx, updateForwardingRuleOptions)
+	if mock.UpdateForwardingRuleWithContextFunc != nil {
+		return mock.UpdateForwardingRuleWithContextFunc(ctx, updateForwardingRuleOptions)
+	}
+	if mock.UpdateForwardingRuleFunc != nil {
+		return mock.UpdateForwardingRuleFunc(updateForwardingRuleOptions)
+	}
+	err = notProgrammed("UpdateForwardingRuleWithContext")
+	return
+}
+
+// CreateSecondaryZone invokes the programmed CreateSecondaryZoneFunc.
+func (mock *DnsSvcsV1) CreateSecondaryZone(createSecondaryZoneOptions *dnssvcsv1.CreateSecondaryZoneOptions) (result *dnssvcsv1.SecondaryZone, response *core.DetailedResponse, err error) {
+	mock.called("CreateSecondaryZone", createSecondaryZoneOptions)
+	if mock.CreateSecondaryZoneFunc != nil {
+		return mock.CreateSecondaryZoneFunc(createSecondaryZoneOptions)
+	}
+	if mock.CreateSecondaryZoneWithContextFunc != nil {
+		return mock.CreateSecondaryZoneWithContextFunc(context.Background(), createSecondaryZoneOptions)
+	}
+	err = notProgrammed("CreateSecondaryZone")
+	return
+}
+
+// CreateSecondaryZoneWithContext invokes the programmed CreateSecondaryZoneWithContextFunc.
+func (mock *DnsSvcsV1) CreateSecondaryZoneWithContext(ctx context.Context, createSecondaryZoneOptions *dnssvcsv1.CreateSecondaryZoneOptions) (result *dnssvcsv1.SecondaryZone, response *core.DetailedResponse, err error) {
+	mock.called("CreateSecondaryZoneWithContext", ctx, createSecondaryZoneOptions)
+	if mock.CreateSecondaryZoneWithContextFunc != nil {
+		return mock.CreateSecondaryZoneWithContextFunc(ctx, createSecondaryZoneOptions)
+	}
+	if mock.CreateSecondaryZoneFunc != nil {
+		return mock.CreateSecondaryZoneFunc(createSecondaryZoneOptions)
+	}
+	err = notProgrammed("CreateSecondaryZoneWithContext")
+	return
+}
+
+// ListSecondaryZones invokes the programmed ListSecondaryZonesFunc.
+func (mock *DnsSvcsV1) ListSecondaryZones(listSecondaryZonesOptions *dnssvcsv1.ListSecondaryZonesOptions) (result *dnssvcsv1.SecondaryZoneList, response *core.DetailedResponse, err error) {
+	mock.called("ListSecondaryZones", listSecondaryZonesOptions)
+	if mock.ListSecondaryZonesFunc != nil {
+		return mock.ListSecondaryZonesFunc(listSecondaryZonesOptions)
+	}
+	if mock.ListSecondaryZonesWithContextFunc != nil {
+		return mock.ListSecondaryZonesWithContextFunc(context.Background(), listSecondaryZonesOptions)
+	}
+	err = notProgrammed("ListSecondaryZones")
+	return
+}
+
+// ListSecondaryZonesWithContext invokes the programmed ListSecondaryZonesWithContextFunc.
+func (mock *DnsSvcsV1) ListSecondaryZonesWithContext(ctx context.Context, listSecondaryZonesOptions *dnssvcsv1.ListSecondaryZonesOptions) (result *dnssvcsv1.SecondaryZoneList, response *core.DetailedResponse, err error) {
+	mock.called("ListSecondaryZonesWithContext", ctx, listSecondaryZonesOptions)
+	if mock.ListSecondaryZonesWithContextFunc != nil {
+		return mock.ListSecondaryZonesWithContextFunc(ctx, listSecondaryZonesOptions)
+	}
+	if mock.ListSecondaryZonesFunc != nil {
+		return mock.ListSecondaryZonesFunc(listSecondaryZonesOptions)
+	}
+	err = notProgrammed("ListSecondaryZonesWithContext")
+	return
+}
+
+// GetSecondaryZone invokes the programmed GetSecondaryZoneFunc.
+func (mock *DnsSvcsV1) GetSecondaryZone(getSecondaryZoneOptions *dnssvcsv1.GetSecondaryZoneOptions) (result *dnssvcsv1.SecondaryZone, response *core.DetailedResponse, err error) {
+	mock.called("GetSecondaryZone", getSecondaryZoneOptions)
+	if mock.GetSecondaryZoneFunc != nil {
+		return mock.GetSecondaryZoneFunc(getSecondaryZoneOptions)
+	}
+	if mock.GetSecondaryZoneWithContextFunc != nil {
+		return mock.GetSecondaryZoneWithContextFunc(context.Background(), getSecondaryZoneOptions)
+	}
+	err = notProgrammed("GetSecondaryZone")
+	return
+}
+
+// GetSecondaryZoneWithContext invokes the programmed GetSecondaryZoneWithContextFunc.
+func (mock *DnsSvcsV1) GetSecondaryZoneWithContext(ctx context.Context, getSecondaryZoneOptions *dnssvcsv1.GetSecondaryZoneOptions) (result *dnssvcsv1.SecondaryZone, response *core.DetailedResponse, err error) {
+	mock.called("GetSecondaryZoneWithContext", ctx, getSecondaryZoneOptions)
+	if mock.GetSecondaryZoneWithContextFunc != nil {
+		return mock.GetSecondaryZoneWithContextFunc(ctx, getSecondaryZoneOptions)
+	}
+	if mock.GetSecondaryZoneFunc != nil {
+		return mock.GetSecondaryZoneFunc(getSecondaryZoneOptions)
+	}
+	err = notProgrammed("GetSecondaryZoneWithContext")
+	return
+}
+
+// UpdateSecondaryZone invokes the programmed UpdateSecondaryZoneFunc.
+func (mock *DnsSvcsV1) UpdateSecondaryZone(updateSecondaryZoneOptions *dnssvcsv1.UpdateSecondaryZoneOptions) (result *dnssvcsv1.SecondaryZone, response *core.DetailedResponse, err error) {
+	mock.called("UpdateSecondaryZone", updateSecondaryZoneOptions)
+	if mock.UpdateSecondaryZoneFunc != nil {
+		return mock.UpdateSecondaryZoneFunc(updateSecondaryZoneOptions)
+	}
+	if mock.UpdateSecondaryZoneWithContextFunc != nil {
+		return mock.UpdateSecondaryZoneWithContextFunc(context.Background(), updateSecondaryZoneOptions)
+	}
+	err = notProgrammed("UpdateSecondaryZone")
+	return
+}
+
+// UpdateSecondaryZoneWithContext invokes the programmed UpdateSecondaryZoneWithContextFunc.
+func (mock *DnsSvcsV1) UpdateSecondaryZoneWithContext(ctx context.Context, updateSecondaryZoneOptions *dnssvcsv1.UpdateSecondaryZoneOptions) (result *dnssvcsv1.SecondaryZone, response *core.DetailedResponse, err error) {
+	mock.called("UpdateSecondaryZoneWithContext", ctx, updateSecondaryZoneOptions)
+	if mock.UpdateSecondaryZoneWithContextFunc != nil {
+		return mock.UpdateSecondaryZoneWithContextFunc(ctx, updateSecondaryZoneOptions)
+	}
+	if mock.UpdateSecondaryZoneFunc != nil {
+		return mock.UpdateSecondaryZoneFunc(updateSecondaryZoneOptions)
+	}
+	err = notProgrammed("UpdateSecondaryZoneWithContext")
+	return
+}
+
+// DeleteSecondaryZone invokes the programmed DeleteSecondaryZoneFunc.
+func (mock *DnsSvcsV1) DeleteSecondaryZone(deleteSecondaryZoneOptions *dnssvcsv1.DeleteSecondaryZoneOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteSecondaryZone", deleteSecondaryZoneOptions)
+	if mock.DeleteSecondaryZoneFunc != nil {
+		return mock.DeleteSecondaryZoneFunc(deleteSecondaryZoneOptions)
+	}
+	if mock.DeleteSecondaryZoneWithContextFunc != nil {
+		return mock.DeleteSecondaryZoneWithContextFunc(context.Background(), deleteSecondaryZoneOptions)
+	}
+	err = notProgrammed("DeleteSecondaryZone")
+	return
+}
+
+// DeleteSecondaryZoneWithContext invokes the programmed DeleteSecondaryZoneWithContextFunc.
+func (mock *DnsSvcsV1) DeleteSecondaryZoneWithContext(ctx context.Context, deleteSecondaryZoneOptions *dnssvcsv1.DeleteSecondaryZoneOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteSecondaryZoneWithContext", ctx, deleteSecondaryZoneOptions)
+	if mock.DeleteSecondaryZoneWithContextFunc != nil {
+		return mock.DeleteSecondaryZoneWithContextFunc(ctx, deleteSecondaryZoneOptions)
+	}
+	if mock.DeleteSecondaryZoneFunc != nil {
+		return mock.DeleteSecondaryZoneFunc(deleteSecondaryZoneOptions)
+	}
+	err = notProgrammed("DeleteSecondaryZoneWithContext")
+	return
+}
+
+// ListLinkedZones invokes the programmed ListLinkedZonesFunc.
+func (mock *DnsSvcsV1) ListLinkedZones(listLinkedZonesOptions *dnssvcsv1.ListLinkedZonesOptions) (result *dnssvcsv1.LinkedDnszonesList, response *core.DetailedResponse, err error) {
+	mock.called("ListLinkedZones", listLinkedZonesOptions)
+	if mock.ListLinkedZonesFunc != nil {
+		return mock.ListLinkedZonesFunc(listLinkedZonesOptions)
+	}
+	if mock.ListLinkedZonesWithContextFunc != nil {
+		return mock.ListLinkedZonesWithContextFunc(context.Background(), listLinkedZonesOptions)
+	}
+	err = notProgrammed("ListLinkedZones")
+	return
+}
+
+// ListLinkedZonesWithContext invokes the programmed ListLinkedZonesWithContextFunc.
+func (mock *DnsSvcsV1) ListLinkedZonesWithContext(ctx context.Context, listLinkedZonesOptions *dnssvcsv1.ListLinkedZonesOptions) (result *dnssvcsv1.LinkedDnszonesList, response *core.DetailedResponse, err error) {
+	mock.called("ListLinkedZonesWithContext", ctx, listLinkedZonesOptions)
+	if mock.ListLinkedZonesWithContextFunc != nil {
+		return mock.ListLinkedZonesWithContextFunc(ctx, listLinkedZonesOptions)
+	}
+	if mock.ListLinkedZonesFunc != nil {
+		return mock.ListLinkedZonesFunc(listLinkedZonesOptions)
+	}
+	err = notProgrammed("ListLinkedZonesWithContext")
+	return
+}
+
+// CreateLinkedZone invokes the programmed CreateLinkedZoneFunc.
+func (mock *DnsSvcsV1) CreateLinkedZone(createLinkedZoneOptions *dnssvcsv1.CreateLinkedZoneOptions) (result *dnssvcsv1.LinkedDnszone, response *core.DetailedResponse, err error) {
+	mock.called("CreateLinkedZone", createLinkedZoneOptions)
+	if mock.CreateLinkedZoneFunc != nil {
+		return mock.CreateLinkedZoneFunc(createLinkedZoneOptions)
+	}
+	if mock.CreateLinkedZoneWithContextFunc != nil {
+		return mock.CreateLinkedZoneWithContextFunc(context.Background(), createLinkedZoneOptions)
+	}
+	err = notProgrammed("CreateLinkedZone")
+	return
+}
+
+// CreateLinkedZoneWithContext invokes the programmed CreateLinkedZoneWithContextFunc.
+func (mock *DnsSvcsV1) CreateLinkedZoneWithContext(ctx context.Context, createLinkedZoneOptions *dnssvcsv1.CreateLinkedZoneOptions) (result *dnssvcsv1.LinkedDnszone, response *core.DetailedResponse, err error) {
+	mock.called("CreateLinkedZoneWithContext", ctx, createLinkedZoneOptions)
+	if mock.CreateLinkedZoneWithContextFunc != nil {
+		return mock.CreateLinkedZoneWithContextFunc(ctx, createLinkedZoneOptions)
+	}
+	if mock.CreateLinkedZoneFunc != nil {
+		return mock.CreateLinkedZoneFunc(createLinkedZoneOptions)
+	}
+	err = notProgrammed("CreateLinkedZoneWithContext")
+	return
+}
+
+// GetLinkedZone invokes the programmed GetLinkedZoneFunc.
+func (mock *DnsSvcsV1) GetLinkedZone(getLinkedZoneOptions *dnssvcsv1.GetLinkedZoneOptions) (result *dnssvcsv1.LinkedDnszone, response *core.DetailedResponse, err error) {
+	mock.called("GetLinkedZone", getLinkedZoneOptions)
+	if mock.GetLinkedZoneFunc != nil {
+		return mock.GetLinkedZoneFunc(getLinkedZoneOptions)
+	}
+	if mock.GetLinkedZoneWithContextFunc != nil {
+		return mock.GetLinkedZoneWithContextFunc(context.Background(), getLinkedZoneOptions)
+	}
+	err = notProgrammed("GetLinkedZone")
+	return
+}
+
+// GetLinkedZoneWithContext invokes the programmed GetLinkedZoneWithContextFunc.
+func (mock *DnsSvcsV1) GetLinkedZoneWithContext(ctx context.Context, getLinkedZoneOptions *dnssvcsv1.GetLinkedZoneOptions) (result *dnssvcsv1.LinkedDnszone, response *core.DetailedResponse, err error) {
+	mock.called("GetLinkedZoneWithContext", ctx, getLinkedZoneOptions)
+	if mock.GetLinkedZoneWithContextFunc != nil {
+		return mock.GetLinkedZoneWithContextFunc(ctx, getLinkedZoneOptions)
+	}
+	if mock.GetLinkedZoneFunc != nil {
+		return mock.GetLinkedZoneFunc(getLinkedZoneOptions)
+	}
+	err = notProgrammed("GetLinkedZoneWithContext")
+	return
+}
+
+// UpdateLinkedZone invokes the programmed UpdateLinkedZoneFunc.
+func (mock *DnsSvcsV1) UpdateLinkedZone(updateLinkedZoneOptions *dnssvcsv1.UpdateLinkedZoneOptions) (result *dnssvcsv1.LinkedDnszone, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLinkedZone", updateLinkedZoneOptions)
+	if mock.UpdateLinkedZoneFunc != nil {
+		return mock.UpdateLinkedZoneFunc(updateLinkedZoneOptions)
+	}
+	if mock.UpdateLinkedZoneWithContextFunc != nil {
+		return mock.UpdateLinkedZoneWithContextFunc(context.Background(), updateLinkedZoneOptions)
+	}
+	err = notProgrammed("UpdateLinkedZone")
+	return
+}
+
+// UpdateLinkedZoneWithContext invokes the programmed UpdateLinkedZoneWithContextFunc.
+func (mock *DnsSvcsV1) UpdateLinkedZoneWithContext(ctx context.Context, updateLinkedZoneOptions *dnssvcsv1.UpdateLinkedZoneOptions) (result *dnssvcsv1.LinkedDnszone, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLinkedZoneWithContext", ctx, updateLinkedZoneOptions)
+	if mock.UpdateLinkedZoneWithContextFunc != nil {
+		return mock.UpdateLinkedZoneWithContextFunc(ctx, updateLinkedZoneOptions)
+	}
+	if mock.UpdateLinkedZoneFunc != nil {
+		return mock.UpdateLinkedZoneFunc(updateLinkedZoneOptions)
+	}
+	err = notProgrammed("UpdateLinkedZoneWithContext")
+	return
+}
+
+// DeleteLinkedZone invokes the programmed DeleteLinkedZoneFunc.
+func (mock *DnsSvcsV1) DeleteLinkedZone(deleteLinkedZoneOptions *dnssvcsv1.DeleteLinkedZoneOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteLinkedZone", deleteLinkedZoneOptions)
+	if mock.DeleteLinkedZoneFunc != nil {
+		return mock.DeleteLinkedZoneFunc(deleteLinkedZoneOptions)
+	}
+	if mock.DeleteLinkedZoneWithContextFunc != nil {
+		return mock.DeleteLinkedZoneWithContextFunc(context.Background(), deleteLinkedZoneOptions)
+	}
+	err = notProgrammed("DeleteLinkedZone")
+	return
+}
+
+// DeleteLinkedZoneWithContext invokes the programmed DeleteLinkedZoneWithContextFunc.
+func (mock *DnsSvcsV1) DeleteLinkedZoneWithContext(ctx context.Context, deleteLinkedZoneOptions *dnssvcsv1.DeleteLinkedZoneOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteLinkedZoneWithContext", ctx, deleteLinkedZoneOptions)
+	if mock.DeleteLinkedZoneWithContextFunc != nil {
+		return mock.DeleteLinkedZoneWithContextFunc(ctx, deleteLinkedZoneOptions)
+	}
+	if mock.DeleteLinkedZoneFunc != nil {
+		return mock.DeleteLinkedZoneFunc(deleteLinkedZoneOptions)
+	}
+	err = notProgrammed("DeleteLinkedZoneWithContext")
+	return
+}
+
+// ListDnszoneAccessRequests invokes the programmed ListDnszoneAccessRequestsFunc.
+func (mock *DnsSvcsV1) ListDnszoneAccessRequests(listDnszoneAccessRequestsOptions *dnssvcsv1.ListDnszoneAccessRequestsOptions) (result *dnssvcsv1.AccessRequestsList, response *core.DetailedResponse, err error) {
+	mock.called("ListDnszoneAccessRequests", listDnszoneAccessRequestsOptions)
+	if mock.ListDnszoneAccessRequestsFunc != nil {
+		return mock.ListDnszoneAccessRequestsFunc(listDnszoneAccessRequestsOptions)
+	}
+	if mock.ListDnszoneAccessRequestsWithContextFunc != nil {
+		return mock.ListDnszoneAccessRequestsWithContextFunc(context.Background(), listDnszoneAccessRequestsOptions)
+	}
+	err = notProgrammed("ListDnszoneAccessRequests")
+	return
+}
+
+// ListDnszoneAccessRequestsWithContext invokes the programmed ListDnszoneAccessRequestsWithContextFunc.
+func (mock *DnsSvcsV1) ListDnszoneAccessRequestsWithContext(ctx context.Context, listDnszoneAccessRequestsOptions *dnssvcsv1.ListDnszoneAccessRequestsOptions) (result *dnssvcsv1.AccessRequestsList, response *core.DetailedResponse, err error) {
+	mock.called("ListDnszoneAccessRequestsWithContext", ctx, listDnszoneAccessRequestsOptions)
+	if mock.ListDnszoneAccessRequestsWithContextFunc != nil {
+		return mock.ListDnszoneAccessRequestsWithContextFunc(ctx, listDnszoneAccessRequestsOptions)
+	}
+	if mock.ListDnszoneAccessRequestsFunc != nil {
+		return mock.ListDnszoneAccessRequestsFunc(listDnszoneAccessRequestsOptions)
+	}
+	err = notProgrammed("ListDnszoneAccessRequestsWithContext")
+	return
+}
+
+// GetDnszoneAccessRequest invokes the programmed GetDnszoneAccessRequestFunc.
+func (mock *DnsSvcsV1) GetDnszoneAccessRequest(getDnszoneAccessRequestOptions *dnssvcsv1.GetDnszoneAccessRequestOptions) (result *dnssvcsv1.AccessRequest, response *core.DetailedResponse, err error) {
+	mock.called("GetDnszoneAccessRequest", getDnszoneAccessRequestOptions)
+	if mock.GetDnszoneAccessRequestFunc != nil {
+		return mock.GetDnszoneAccessRequestFunc(getDnszoneAccessRequestOptions)
+	}
+	if mock.GetDnszoneAccessRequestWithContextFunc != nil {
+		return mock.GetDnszoneAccessRequestWithContextFunc(context.Background(), getDnszoneAccessRequestOptions)
+	}
+	err = notProgrammed("GetDnszoneAccessRequest")
+	return
+}
+
+// GetDnszoneAccessRequestWithContext invokes the programmed GetDnszoneAccessRequestWithContextFunc.
+func (mock *DnsSvcsV1) GetDnszoneAccessRequestWithContext(ctx context.Context, getDnszoneAccessRequestOptions *dnssvcsv1.GetDnszoneAccessRequestOptions) (result *dnssvcsv1.AccessRequest, response *core.DetailedResponse, err error) {
+	mock.called("GetDnszoneAccessRequestWithContext", ctx, getDnszoneAccessRequestOptions)
+	if mock.GetDnszoneAccessRequestWithContextFunc != nil {
+		return mock.GetDnszoneAccessRequestWithContextFunc(ctx, getDnszoneAccessRequestOptions)
+	}
+	if mock.GetDnszoneAccessRequestFunc != nil {
+		return mock.GetDnszoneAccessRequestFunc(getDnszoneAccessRequestOptions)
+	}
+	err = notProgrammed("GetDnszoneAccessRequestWithContext")
+	return
+}
+
+// UpdateDnszoneAccessRequest invokes the programmed UpdateDnszoneAccessRequestFunc.
+func (mock *DnsSvcsV1) UpdateDnszoneAccessRequest(updateDnszoneAccessRequestOptions *dnssvcsv1.UpdateDnszoneAccessRequestOptions) (result *dnssvcsv1.AccessRequest, response *core.DetailedResponse, err error) {
+	mock.called("UpdateDnszoneAccessRequest", updateDnszoneAccessRequestOptions)
+	if mock.UpdateDnszoneAccessRequestFunc != nil {
+		return mock.UpdateDnszoneAccessRequestFunc(updateDnszoneAccessRequestOptions)
+	}
+	if mock.UpdateDnszoneAccessRequestWithContextFunc != nil {
+		return mock.UpdateDnszoneAccessRequestWithContextFunc(context.Background(), updateDnszoneAccessRequestOptions)
+	}
+	err = notProgrammed("UpdateDnszoneAccessRequest")
+	return
+}
+
+// UpdateDnszoneAccessRequestWithContext invokes the programmed UpdateDnszoneAccessRequestWithContextFunc.
+func (mock *DnsSvcsV1) UpdateDnszoneAccessRequestWithContext(ctx context.Context, updateDnszoneAccessRequestOptions *dnssvcsv1.UpdateDnszoneAccessRequestOptions) (result *dnssvcsv1.AccessRequest, response *core.DetailedResponse, err error) {
+	mock.called("UpdateDnszoneAccessRequestWithContext", ctx, updateDnszoneAccessRequestOptions)
+	if mock.UpdateDnszoneAccessRequestWithContextFunc != nil {
+		return mock.UpdateDnszoneAccessRequestWithContextFunc(ctx, updateDnszoneAccessRequestOptions)
+	}
+	if mock.UpdateDnszoneAccessRequestFunc != nil {
+		return mock.UpdateDnszoneAccessRequestFunc(updateDnszoneAccessRequestOptions)
+	}
+	err = notProgrammed("UpdateDnszoneAccessRequestWithContext")
+	return
+}
+
+// ListLinkedPermittedNetworks invokes the programmed ListLinkedPermittedNetworksFunc.
+func (mock *DnsSvcsV1) ListLinkedPermittedNetworks(listLinkedPermittedNetworksOptions *dnssvcsv1.ListLinkedPermittedNetworksOptions) (result *dnssvcsv1.ListPermittedNetworks, response *core.DetailedResponse, err error) {
+	mock.called("ListLinkedPermittedNetworks", listLinkedPermittedNetworksOptions)
+	if mock.ListLinkedPermittedNetworksFunc != nil {
+		return mock.ListLinkedPermittedNetworksFunc(listLinkedPermittedNetworksOptions)
+	}
+	if mock.ListLinkedPermittedNetworksWithContextFunc != nil {
+		return mock.ListLinkedPermittedNetworksWithContextFunc(context.Background(), listLinkedPermittedNetworksOptions)
+	}
+	err = notProgrammed("ListLinkedPermittedNetworks")
+	return
+}
+
+// ListLinkedPermittedNetworksWithContext invokes the programmed ListLinkedPermittedNetworksWithContextFunc.
+func (mock *DnsSvcsV1) ListLinkedPermittedNetworksWithContext(ctx context.Context, listLinkedPermittedNetworksOptions *dnssvcsv1.ListLinkedPermittedNetworksOptions) (result *dnssvcsv1.ListPermittedNetworks, response *core.DetailedResponse, err error) {
+	mock.called("ListLinkedPermittedNetworksWithContext", ctx, listLinkedPermittedNetworksOptions)
+	if mock.ListLinkedPermittedNetworksWithContextFunc != nil {
+		return mock.ListLinkedPermittedNetworksWithContextFunc(ctx, listLinkedPermittedNetworksOptions)
+	}
+	if mock.ListLinkedPermittedNetworksFunc != nil {
+		return mock.ListLinkedPermittedNetworksFunc(listLinkedPermittedNetworksOptions)
+	}
+	err = notProgrammed("ListLinkedPermittedNetworksWithContext")
+	return
+}
+
+// CreateLzPermittedNetwork invokes the programmed CreateLzPermittedNetworkFunc.
+func (mock *DnsSvcsV1) CreateLzPermittedNetwork(createLzPermittedNetworkOptions *dnssvcsv1.CreateLzPermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("CreateLzPermittedNetwork", createLzPermittedNetworkOptions)
+	if mock.CreateLzPermittedNetworkFunc != nil {
+		return mock.CreateLzPermittedNetworkFunc(createLzPermittedNetworkOptions)
+	}
+	if mock.CreateLzPermittedNetworkWithContextFunc != nil {
+		return mock.CreateLzPermittedNetworkWithContextFunc(context.Background(), createLzPermittedNetworkOptions)
+	}
+	err = notProgrammed("CreateLzPermittedNetwork")
+	return
+}
+
+// CreateLzPermittedNetworkWithContext invokes the programmed CreateLzPermittedNetworkWithContextFunc.
+func (mock *DnsSvcsV1) CreateLzPermittedNetworkWithContext(ctx context.Context, createLzPermittedNetworkOptions *dnssvcsv1.CreateLzPermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("CreateLzPermittedNetworkWithContext", ctx, createLzPermittedNetworkOptions)
+	if mock.CreateLzPermittedNetworkWithContextFunc != nil {
+		return mock.CreateLzPermittedNetworkWithContextFunc(ctx, createLzPermittedNetworkOptions)
+	}
+	if mock.CreateLzPermittedNetworkFunc != nil {
+		return mock.CreateLzPermittedNetworkFunc(createLzPermittedNetworkOptions)
+	}
+	err = notProgrammed("CreateLzPermittedNetworkWithContext")
+	return
+}
+
+// DeleteLzPermittedNetwork invokes the programmed DeleteLzPermittedNetworkFunc.
+func (mock *DnsSvcsV1) DeleteLzPermittedNetwork(deleteLzPermittedNetworkOptions *dnssvcsv1.DeleteLzPermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLzPermittedNetwork", deleteLzPermittedNetworkOptions)
+	if mock.DeleteLzPermittedNetworkFunc != nil {
+		return mock.DeleteLzPermittedNetworkFunc(deleteLzPermittedNetworkOptions)
+	}
+	if mock.DeleteLzPermittedNetworkWithContextFunc != nil {
+		return mock.DeleteLzPermittedNetworkWithContextFunc(context.Background(), deleteLzPermittedNetworkOptions)
+	}
+	err = notProgrammed("DeleteLzPermittedNetwork")
+	return
+}
+
+// DeleteLzPermittedNetworkWithContext invokes the programmed DeleteLzPermittedNetworkWithContextFunc.
+func (mock *DnsSvcsV1) DeleteLzPermittedNetworkWithContext(ctx context.Context, deleteLzPermittedNetworkOptions *dnssvcsv1.DeleteLzPermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLzPermittedNetworkWithContext", ctx, deleteLzPermittedNetworkOptions)
+	if mock.DeleteLzPermittedNetworkWithContextFunc != nil {
+		return mock.DeleteLzPermittedNetworkWithContextFunc(ctx, deleteLzPermittedNetworkOptions)
+	}
+	if mock.DeleteLzPermittedNetworkFunc != nil {
+		return mock.DeleteLzPermittedNetworkFunc(deleteLzPermittedNetworkOptions)
+	}
+	err = notProgrammed("DeleteLzPermittedNetworkWithContext")
+	return
+}
+
+// GetLinkedPermittedNetwork invokes the programmed GetLinkedPermittedNetworkFunc.
+func (mock *DnsSvcsV1) GetLinkedPermittedNetwork(getLinkedPermittedNetworkOptions *dnssvcsv1.GetLinkedPermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("GetLinkedPermittedNetwork", getLinkedPermittedNetworkOptions)
+	if mock.GetLinkedPermittedNetworkFunc != nil {
+		return mock.GetLinkedPermittedNetworkFunc(getLinkedPermittedNetworkOptions)
+	}
+	if mock.GetLinkedPermittedNetworkWithContextFunc != nil {
+		return mock.GetLinkedPermittedNetworkWithContextFunc(context.Background(), getLinkedPermittedNetworkOptions)
+	}
+	err = notProgrammed("GetLinkedPermittedNetwork")
+	return
+}
+
+// GetLinkedPermittedNetworkWithContext invokes the programmed GetLinkedPermittedNetworkWithContextFunc.
+func (mock *DnsSvcsV1) GetLinkedPermittedNetworkWithContext(ctx context.Context, getLinkedPermittedNetworkOptions *dnssvcsv1.GetLinkedPermittedNetworkOptions) (result *dnssvcsv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("GetLinkedPermittedNetworkWithContext", ctx, getLinkedPermittedNetworkOptions)
+	if mock.GetLinkedPermittedNetworkWithContextFunc != nil {
+		return mock.GetLinkedPermittedNetworkWithContextFunc(ctx, getLinkedPermittedNetworkOptions)
+	}
+	if mock.GetLinkedPermittedNetworkFunc != nil {
+		return mock.GetLinkedPermittedNetworkFunc(getLinkedPermittedNetworkOptions)
+	}
+	err = notProgrammed("GetLinkedPermittedNetworkWithContext")
+	return
+}
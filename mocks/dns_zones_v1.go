@@ -0,0 +1,87 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnszonesv1"
+)
+
+// DnsZonesV1 is a mock of dnszonesv1.DnsZonesV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type DnsZonesV1 struct {
+	Mock
+
+	ListDnszonesFunc  func(*dnszonesv1.ListDnszonesOptions) (*dnszonesv1.ListDnszones, *core.DetailedResponse, error)
+	CreateDnszoneFunc func(*dnszonesv1.CreateDnszoneOptions) (*dnszonesv1.Dnszone, *core.DetailedResponse, error)
+	DeleteDnszoneFunc func(*dnszonesv1.DeleteDnszoneOptions) (*core.DetailedResponse, error)
+	GetDnszoneFunc    func(*dnszonesv1.GetDnszoneOptions) (*dnszonesv1.Dnszone, *core.DetailedResponse, error)
+	UpdateDnszoneFunc func(*dnszonesv1.UpdateDnszoneOptions) (*dnszonesv1.Dnszone, *core.DetailedResponse, error)
+}
+
+// DnsZonesV1 implements dnszonesv1.DnsZonesV1API.
+var _ dnszonesv1.DnsZonesV1API = (*DnsZonesV1)(nil)
+
+// ListDnszones invokes the programmed ListDnszonesFunc.
+func (mock *DnsZonesV1) ListDnszones(listDnszonesOptions *dnszonesv1.ListDnszonesOptions) (result *dnszonesv1.ListDnszones, response *core.DetailedResponse, err error) {
+	mock.called("ListDnszones", listDnszonesOptions)
+	if mock.ListDnszonesFunc != nil {
+		return mock.ListDnszonesFunc(listDnszonesOptions)
+	}
+	err = notProgrammed("ListDnszones")
+	return
+}
+
+// CreateDnszone invokes the programmed CreateDnszoneFunc.
+func (mock *DnsZonesV1) CreateDnszone(createDnszoneOptions *dnszonesv1.CreateDnszoneOptions) (result *dnszonesv1.Dnszone, response *core.DetailedResponse, err error) {
+	mock.called("CreateDnszone", createDnszoneOptions)
+	if mock.CreateDnszoneFunc != nil {
+		return mock.CreateDnszoneFunc(createDnszoneOptions)
+	}
+	err = notProgrammed("CreateDnszone")
+	return
+}
+
+// DeleteDnszone invokes the programmed DeleteDnszoneFunc.
+func (mock *DnsZonesV1) DeleteDnszone(deleteDnszoneOptions *dnszonesv1.DeleteDnszoneOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteDnszone", deleteDnszoneOptions)
+	if mock.DeleteDnszoneFunc != nil {
+		return mock.DeleteDnszoneFunc(deleteDnszoneOptions)
+	}
+	err = notProgrammed("DeleteDnszone")
+	return
+}
+
+// GetDnszone invokes the programmed GetDnszoneFunc.
+func (mock *DnsZonesV1) GetDnszone(getDnszoneOptions *dnszonesv1.GetDnszoneOptions) (result *dnszonesv1.Dnszone, response *core.DetailedResponse, err error) {
+	mock.called("GetDnszone", getDnszoneOptions)
+	if mock.GetDnszoneFunc != nil {
+		return mock.GetDnszoneFunc(getDnszoneOptions)
+	}
+	err = notProgrammed("GetDnszone")
+	return
+}
+
+// UpdateDnszone invokes the programmed UpdateDnszoneFunc.
+func (mock *DnsZonesV1) UpdateDnszone(updateDnszoneOptions *dnszonesv1.UpdateDnszoneOptions) (result *dnszonesv1.Dnszone, response *core.DetailedResponse, err error) {
+	mock.called("UpdateDnszone", updateDnszoneOptions)
+	if mock.UpdateDnszoneFunc != nil {
+		return mock.UpdateDnszoneFunc(updateDnszoneOptions)
+	}
+	err = notProgrammed("UpdateDnszone")
+	return
+}
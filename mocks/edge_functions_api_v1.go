@@ -0,0 +1,287 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/edgefunctionsapiv1"
+)
+
+// EdgeFunctionsApiV1 is a mock of edgefunctionsapiv1.EdgeFunctionsApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type EdgeFunctionsApiV1 struct {
+	Mock
+
+	ListEdgeFunctionsActionsFunc              func(*edgefunctionsapiv1.ListEdgeFunctionsActionsOptions) (*edgefunctionsapiv1.ListEdgeFunctionsActionsResp, *core.DetailedResponse, error)
+	ListEdgeFunctionsActionsWithContextFunc   func(context.Context, *edgefunctionsapiv1.ListEdgeFunctionsActionsOptions) (*edgefunctionsapiv1.ListEdgeFunctionsActionsResp, *core.DetailedResponse, error)
+	UpdateEdgeFunctionsActionFunc             func(*edgefunctionsapiv1.UpdateEdgeFunctionsActionOptions) (*edgefunctionsapiv1.GetEdgeFunctionsActionResp, *core.DetailedResponse, error)
+	UpdateEdgeFunctionsActionWithContextFunc  func(context.Context, *edgefunctionsapiv1.UpdateEdgeFunctionsActionOptions) (*edgefunctionsapiv1.GetEdgeFunctionsActionResp, *core.DetailedResponse, error)
+	GetEdgeFunctionsActionFunc                func(*edgefunctionsapiv1.GetEdgeFunctionsActionOptions) (io.ReadCloser, *core.DetailedResponse, error)
+	GetEdgeFunctionsActionWithContextFunc     func(context.Context, *edgefunctionsapiv1.GetEdgeFunctionsActionOptions) (io.ReadCloser, *core.DetailedResponse, error)
+	DeleteEdgeFunctionsActionFunc             func(*edgefunctionsapiv1.DeleteEdgeFunctionsActionOptions) (*edgefunctionsapiv1.DeleteEdgeFunctionsActionResp, *core.DetailedResponse, error)
+	DeleteEdgeFunctionsActionWithContextFunc  func(context.Context, *edgefunctionsapiv1.DeleteEdgeFunctionsActionOptions) (*edgefunctionsapiv1.DeleteEdgeFunctionsActionResp, *core.DetailedResponse, error)
+	CreateEdgeFunctionsTriggerFunc            func(*edgefunctionsapiv1.CreateEdgeFunctionsTriggerOptions) (*edgefunctionsapiv1.CreateEdgeFunctionsTriggerResp, *core.DetailedResponse, error)
+	CreateEdgeFunctionsTriggerWithContextFunc func(context.Context, *edgefunctionsapiv1.CreateEdgeFunctionsTriggerOptions) (*edgefunctionsapiv1.CreateEdgeFunctionsTriggerResp, *core.DetailedResponse, error)
+	ListEdgeFunctionsTriggersFunc             func(*edgefunctionsapiv1.ListEdgeFunctionsTriggersOptions) (*edgefunctionsapiv1.ListEdgeFunctionsTriggersResp, *core.DetailedResponse, error)
+	ListEdgeFunctionsTriggersWithContextFunc  func(context.Context, *edgefunctionsapiv1.ListEdgeFunctionsTriggersOptions) (*edgefunctionsapiv1.ListEdgeFunctionsTriggersResp, *core.DetailedResponse, error)
+	GetEdgeFunctionsTriggerFunc               func(*edgefunctionsapiv1.GetEdgeFunctionsTriggerOptions) (*edgefunctionsapiv1.GetEdgeFunctionsTriggerResp, *core.DetailedResponse, error)
+	GetEdgeFunctionsTriggerWithContextFunc    func(context.Context, *edgefunctionsapiv1.GetEdgeFunctionsTriggerOptions) (*edgefunctionsapiv1.GetEdgeFunctionsTriggerResp, *core.DetailedResponse, error)
+	UpdateEdgeFunctionsTriggerFunc            func(*edgefunctionsapiv1.UpdateEdgeFunctionsTriggerOptions) (*edgefunctionsapiv1.GetEdgeFunctionsTriggerResp, *core.DetailedResponse, error)
+	UpdateEdgeFunctionsTriggerWithContextFunc func(context.Context, *edgefunctionsapiv1.UpdateEdgeFunctionsTriggerOptions) (*edgefunctionsapiv1.GetEdgeFunctionsTriggerResp, *core.DetailedResponse, error)
+	DeleteEdgeFunctionsTriggerFunc            func(*edgefunctionsapiv1.DeleteEdgeFunctionsTriggerOptions) (*edgefunctionsapiv1.CreateEdgeFunctionsTriggerResp, *core.DetailedResponse, error)
+	DeleteEdgeFunctionsTriggerWithContextFunc func(context.Context, *edgefunctionsapiv1.DeleteEdgeFunctionsTriggerOptions) (*edgefunctionsapiv1.CreateEdgeFunctionsTriggerResp, *core.DetailedResponse, error)
+}
+
+// EdgeFunctionsApiV1 implements edgefunctionsapiv1.EdgeFunctionsApiV1API.
+var _ edgefunctionsapiv1.EdgeFunctionsApiV1API = (*EdgeFunctionsApiV1)(nil)
+
+// ListEdgeFunctionsActions invokes the programmed ListEdgeFunctionsActionsFunc.
+func (mock *EdgeFunctionsApiV1) ListEdgeFunctionsActions(listEdgeFunctionsActionsOptions *edgefunctionsapiv1.ListEdgeFunctionsActionsOptions) (result *edgefunctionsapiv1.ListEdgeFunctionsActionsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListEdgeFunctionsActions", listEdgeFunctionsActionsOptions)
+	if mock.ListEdgeFunctionsActionsFunc != nil {
+		return mock.ListEdgeFunctionsActionsFunc(listEdgeFunctionsActionsOptions)
+	}
+	if mock.ListEdgeFunctionsActionsWithContextFunc != nil {
+		return mock.ListEdgeFunctionsActionsWithContextFunc(context.Background(), listEdgeFunctionsActionsOptions)
+	}
+	err = notProgrammed("ListEdgeFunctionsActions")
+	return
+}
+
+// ListEdgeFunctionsActionsWithContext invokes the programmed ListEdgeFunctionsActionsWithContextFunc.
+func (mock *EdgeFunctionsApiV1) ListEdgeFunctionsActionsWithContext(ctx context.Context, listEdgeFunctionsActionsOptions *edgefunctionsapiv1.ListEdgeFunctionsActionsOptions) (result *edgefunctionsapiv1.ListEdgeFunctionsActionsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListEdgeFunctionsActionsWithContext", ctx, listEdgeFunctionsActionsOptions)
+	if mock.ListEdgeFunctionsActionsWithContextFunc != nil {
+		return mock.ListEdgeFunctionsActionsWithContextFunc(ctx, listEdgeFunctionsActionsOptions)
+	}
+	if mock.ListEdgeFunctionsActionsFunc != nil {
+		return mock.ListEdgeFunctionsActionsFunc(listEdgeFunctionsActionsOptions)
+	}
+	err = notProgrammed("ListEdgeFunctionsActionsWithContext")
+	return
+}
+
+// UpdateEdgeFunctionsAction invokes the programmed UpdateEdgeFunctionsActionFunc.
+func (mock *EdgeFunctionsApiV1) UpdateEdgeFunctionsAction(updateEdgeFunctionsActionOptions *edgefunctionsapiv1.UpdateEdgeFunctionsActionOptions) (result *edgefunctionsapiv1.GetEdgeFunctionsActionResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateEdgeFunctionsAction", updateEdgeFunctionsActionOptions)
+	if mock.UpdateEdgeFunctionsActionFunc != nil {
+		return mock.UpdateEdgeFunctionsActionFunc(updateEdgeFunctionsActionOptions)
+	}
+	if mock.UpdateEdgeFunctionsActionWithContextFunc != nil {
+		return mock.UpdateEdgeFunctionsActionWithContextFunc(context.Background(), updateEdgeFunctionsActionOptions)
+	}
+	err = notProgrammed("UpdateEdgeFunctionsAction")
+	return
+}
+
+// UpdateEdgeFunctionsActionWithContext invokes the programmed UpdateEdgeFunctionsActionWithContextFunc.
+func (mock *EdgeFunctionsApiV1) UpdateEdgeFunctionsActionWithContext(ctx context.Context, updateEdgeFunctionsActionOptions *edgefunctionsapiv1.UpdateEdgeFunctionsActionOptions) (result *edgefunctionsapiv1.GetEdgeFunctionsActionResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateEdgeFunctionsActionWithContext", ctx, updateEdgeFunctionsActionOptions)
+	if mock.UpdateEdgeFunctionsActionWithContextFunc != nil {
+		return mock.UpdateEdgeFunctionsActionWithContextFunc(ctx, updateEdgeFunctionsActionOptions)
+	}
+	if mock.UpdateEdgeFunctionsActionFunc != nil {
+		return mock.UpdateEdgeFunctionsActionFunc(updateEdgeFunctionsActionOptions)
+	}
+	err = notProgrammed("UpdateEdgeFunctionsActionWithContext")
+	return
+}
+
+// GetEdgeFunctionsAction invokes the programmed GetEdgeFunctionsActionFunc.
+func (mock *EdgeFunctionsApiV1) GetEdgeFunctionsAction(getEdgeFunctionsActionOptions *edgefunctionsapiv1.GetEdgeFunctionsActionOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.called("GetEdgeFunctionsAction", getEdgeFunctionsActionOptions)
+	if mock.GetEdgeFunctionsActionFunc != nil {
+		return mock.GetEdgeFunctionsActionFunc(getEdgeFunctionsActionOptions)
+	}
+	if mock.GetEdgeFunctionsActionWithContextFunc != nil {
+		return mock.GetEdgeFunctionsActionWithContextFunc(context.Background(), getEdgeFunctionsActionOptions)
+	}
+	err = notProgrammed("GetEdgeFunctionsAction")
+	return
+}
+
+// GetEdgeFunctionsActionWithContext invokes the programmed GetEdgeFunctionsActionWithContextFunc.
+func (mock *EdgeFunctionsApiV1) GetEdgeFunctionsActionWithContext(ctx context.Context, getEdgeFunctionsActionOptions *edgefunctionsapiv1.GetEdgeFunctionsActionOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.called("GetEdgeFunctionsActionWithContext", ctx, getEdgeFunctionsActionOptions)
+	if mock.GetEdgeFunctionsActionWithContextFunc != nil {
+		return mock.GetEdgeFunctionsActionWithContextFunc(ctx, getEdgeFunctionsActionOptions)
+	}
+	if mock.GetEdgeFunctionsActionFunc != nil {
+		return mock.GetEdgeFunctionsActionFunc(getEdgeFunctionsActionOptions)
+	}
+	err = notProgrammed("GetEdgeFunctionsActionWithContext")
+	return
+}
+
+// DeleteEdgeFunctionsAction invokes the programmed DeleteEdgeFunctionsActionFunc.
+func (mock *EdgeFunctionsApiV1) DeleteEdgeFunctionsAction(deleteEdgeFunctionsActionOptions *edgefunctionsapiv1.DeleteEdgeFunctionsActionOptions) (result *edgefunctionsapiv1.DeleteEdgeFunctionsActionResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteEdgeFunctionsAction", deleteEdgeFunctionsActionOptions)
+	if mock.DeleteEdgeFunctionsActionFunc != nil {
+		return mock.DeleteEdgeFunctionsActionFunc(deleteEdgeFunctionsActionOptions)
+	}
+	if mock.DeleteEdgeFunctionsActionWithContextFunc != nil {
+		return mock.DeleteEdgeFunctionsActionWithContextFunc(context.Background(), deleteEdgeFunctionsActionOptions)
+	}
+	err = notProgrammed("DeleteEdgeFunctionsAction")
+	return
+}
+
+// DeleteEdgeFunctionsActionWithContext invokes the programmed DeleteEdgeFunctionsActionWithContextFunc.
+func (mock *EdgeFunctionsApiV1) DeleteEdgeFunctionsActionWithContext(ctx context.Context, deleteEdgeFunctionsActionOptions *edgefunctionsapiv1.DeleteEdgeFunctionsActionOptions) (result *edgefunctionsapiv1.DeleteEdgeFunctionsActionResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteEdgeFunctionsActionWithContext", ctx, deleteEdgeFunctionsActionOptions)
+	if mock.DeleteEdgeFunctionsActionWithContextFunc != nil {
+		return mock.DeleteEdgeFunctionsActionWithContextFunc(ctx, deleteEdgeFunctionsActionOptions)
+	}
+	if mock.DeleteEdgeFunctionsActionFunc != nil {
+		return mock.DeleteEdgeFunctionsActionFunc(deleteEdgeFunctionsActionOptions)
+	}
+	err = notProgrammed("DeleteEdgeFunctionsActionWithContext")
+	return
+}
+
+// CreateEdgeFunctionsTrigger invokes the programmed CreateEdgeFunctionsTriggerFunc.
+func (mock *EdgeFunctionsApiV1) CreateEdgeFunctionsTrigger(createEdgeFunctionsTriggerOptions *edgefunctionsapiv1.CreateEdgeFunctionsTriggerOptions) (result *edgefunctionsapiv1.CreateEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateEdgeFunctionsTrigger", createEdgeFunctionsTriggerOptions)
+	if mock.CreateEdgeFunctionsTriggerFunc != nil {
+		return mock.CreateEdgeFunctionsTriggerFunc(createEdgeFunctionsTriggerOptions)
+	}
+	if mock.CreateEdgeFunctionsTriggerWithContextFunc != nil {
+		return mock.CreateEdgeFunctionsTriggerWithContextFunc(context.Background(), createEdgeFunctionsTriggerOptions)
+	}
+	err = notProgrammed("CreateEdgeFunctionsTrigger")
+	return
+}
+
+// CreateEdgeFunctionsTriggerWithContext invokes the programmed CreateEdgeFunctionsTriggerWithContextFunc.
+func (mock *EdgeFunctionsApiV1) CreateEdgeFunctionsTriggerWithContext(ctx context.Context, createEdgeFunctionsTriggerOptions *edgefunctionsapiv1.CreateEdgeFunctionsTriggerOptions) (result *edgefunctionsapiv1.CreateEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateEdgeFunctionsTriggerWithContext", ctx, createEdgeFunctionsTriggerOptions)
+	if mock.CreateEdgeFunctionsTriggerWithContextFunc != nil {
+		return mock.CreateEdgeFunctionsTriggerWithContextFunc(ctx, createEdgeFunctionsTriggerOptions)
+	}
+	if mock.CreateEdgeFunctionsTriggerFunc != nil {
+		return mock.CreateEdgeFunctionsTriggerFunc(createEdgeFunctionsTriggerOptions)
+	}
+	err = notProgrammed("CreateEdgeFunctionsTriggerWithContext")
+	return
+}
+
+// ListEdgeFunctionsTriggers invokes the programmed ListEdgeFunctionsTriggersFunc.
+func (mock *EdgeFunctionsApiV1) ListEdgeFunctionsTriggers(listEdgeFunctionsTriggersOptions *edgefunctionsapiv1.ListEdgeFunctionsTriggersOptions) (result *edgefunctionsapiv1.ListEdgeFunctionsTriggersResp, response *core.DetailedResponse, err error) {
+	mock.called("ListEdgeFunctionsTriggers", listEdgeFunctionsTriggersOptions)
+	if mock.ListEdgeFunctionsTriggersFunc != nil {
+		return mock.ListEdgeFunctionsTriggersFunc(listEdgeFunctionsTriggersOptions)
+	}
+	if mock.ListEdgeFunctionsTriggersWithContextFunc != nil {
+		return mock.ListEdgeFunctionsTriggersWithContextFunc(context.Background(), listEdgeFunctionsTriggersOptions)
+	}
+	err = notProgrammed("ListEdgeFunctionsTriggers")
+	return
+}
+
+// ListEdgeFunctionsTriggersWithContext invokes the programmed ListEdgeFunctionsTriggersWithContextFunc.
+func (mock *EdgeFunctionsApiV1) ListEdgeFunctionsTriggersWithContext(ctx context.Context, listEdgeFunctionsTriggersOptions *edgefunctionsapiv1.ListEdgeFunctionsTriggersOptions) (result *edgefunctionsapiv1.ListEdgeFunctionsTriggersResp, response *core.DetailedResponse, err error) {
+	mock.called("ListEdgeFunctionsTriggersWithContext", ctx, listEdgeFunctionsTriggersOptions)
+	if mock.ListEdgeFunctionsTriggersWithContextFunc != nil {
+		return mock.ListEdgeFunctionsTriggersWithContextFunc(ctx, listEdgeFunctionsTriggersOptions)
+	}
+	if mock.ListEdgeFunctionsTriggersFunc != nil {
+		return mock.ListEdgeFunctionsTriggersFunc(listEdgeFunctionsTriggersOptions)
+	}
+	err = notProgrammed("ListEdgeFunctionsTriggersWithContext")
+	return
+}
+
+// GetEdgeFunctionsTrigger invokes the programmed GetEdgeFunctionsTriggerFunc.
+func (mock *EdgeFunctionsApiV1) GetEdgeFunctionsTrigger(getEdgeFunctionsTriggerOptions *edgefunctionsapiv1.GetEdgeFunctionsTriggerOptions) (result *edgefunctionsapiv1.GetEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error) {
+	mock.called("GetEdgeFunctionsTrigger", getEdgeFunctionsTriggerOptions)
+	if mock.GetEdgeFunctionsTriggerFunc != nil {
+		return mock.GetEdgeFunctionsTriggerFunc(getEdgeFunctionsTriggerOptions)
+	}
+	if mock.GetEdgeFunctionsTriggerWithContextFunc != nil {
+		return mock.GetEdgeFunctionsTriggerWithContextFunc(context.Background(), getEdgeFunctionsTriggerOptions)
+	}
+	err = notProgrammed("GetEdgeFunctionsTrigger")
+	return
+}
+
+// GetEdgeFunctionsTriggerWithContext invokes the programmed GetEdgeFunctionsTriggerWithContextFunc.
+func (mock *EdgeFunctionsApiV1) GetEdgeFunctionsTriggerWithContext(ctx context.Context, getEdgeFunctionsTriggerOptions *edgefunctionsapiv1.GetEdgeFunctionsTriggerOptions) (result *edgefunctionsapiv1.GetEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error) {
+	mock.called("GetEdgeFunctionsTriggerWithContext", ctx, getEdgeFunctionsTriggerOptions)
+	if mock.GetEdgeFunctionsTriggerWithContextFunc != nil {
+		return mock.GetEdgeFunctionsTriggerWithContextFunc(ctx, getEdgeFunctionsTriggerOptions)
+	}
+	if mock.GetEdgeFunctionsTriggerFunc != nil {
+		return mock.GetEdgeFunctionsTriggerFunc(getEdgeFunctionsTriggerOptions)
+	}
+	err = notProgrammed("GetEdgeFunctionsTriggerWithContext")
+	return
+}
+
+// UpdateEdgeFunctionsTrigger invokes the programmed UpdateEdgeFunctionsTriggerFunc.
+func (mock *EdgeFunctionsApiV1) UpdateEdgeFunctionsTrigger(updateEdgeFunctionsTriggerOptions *edgefunctionsapiv1.UpdateEdgeFunctionsTriggerOptions) (result *edgefunctionsapiv1.GetEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateEdgeFunctionsTrigger", updateEdgeFunctionsTriggerOptions)
+	if mock.UpdateEdgeFunctionsTriggerFunc != nil {
+		return mock.UpdateEdgeFunctionsTriggerFunc(updateEdgeFunctionsTriggerOptions)
+	}
+	if mock.UpdateEdgeFunctionsTriggerWithContextFunc != nil {
+		return mock.UpdateEdgeFunctionsTriggerWithContextFunc(context.Background(), updateEdgeFunctionsTriggerOptions)
+	}
+	err = notProgrammed("UpdateEdgeFunctionsTrigger")
+	return
+}
+
+// UpdateEdgeFunctionsTriggerWithContext invokes the programmed UpdateEdgeFunctionsTriggerWithContextFunc.
+func (mock *EdgeFunctionsApiV1) UpdateEdgeFunctionsTriggerWithContext(ctx context.Context, updateEdgeFunctionsTriggerOptions *edgefunctionsapiv1.UpdateEdgeFunctionsTriggerOptions) (result *edgefunctionsapiv1.GetEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateEdgeFunctionsTriggerWithContext", ctx, updateEdgeFunctionsTriggerOptions)
+	if mock.UpdateEdgeFunctionsTriggerWithContextFunc != nil {
+		return mock.UpdateEdgeFunctionsTriggerWithContextFunc(ctx, updateEdgeFunctionsTriggerOptions)
+	}
+	if mock.UpdateEdgeFunctionsTriggerFunc != nil {
+		return mock.UpdateEdgeFunctionsTriggerFunc(updateEdgeFunctionsTriggerOptions)
+	}
+	err = notProgrammed("UpdateEdgeFunctionsTriggerWithContext")
+	return
+}
+
+// DeleteEdgeFunctionsTrigger invokes the programmed DeleteEdgeFunctionsTriggerFunc.
+func (mock *EdgeFunctionsApiV1) DeleteEdgeFunctionsTrigger(deleteEdgeFunctionsTriggerOptions *edgefunctionsapiv1.DeleteEdgeFunctionsTriggerOptions) (result *edgefunctionsapiv1.CreateEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteEdgeFunctionsTrigger", deleteEdgeFunctionsTriggerOptions)
+	if mock.DeleteEdgeFunctionsTriggerFunc != nil {
+		return mock.DeleteEdgeFunctionsTriggerFunc(deleteEdgeFunctionsTriggerOptions)
+	}
+	if mock.DeleteEdgeFunctionsTriggerWithContextFunc != nil {
+		return mock.DeleteEdgeFunctionsTriggerWithContextFunc(context.Background(), deleteEdgeFunctionsTriggerOptions)
+	}
+	err = notProgrammed("DeleteEdgeFunctionsTrigger")
+	return
+}
+
+// DeleteEdgeFunctionsTriggerWithContext invokes the programmed DeleteEdgeFunctionsTriggerWithContextFunc.
+func (mock *EdgeFunctionsApiV1) DeleteEdgeFunctionsTriggerWithContext(ctx context.Context, deleteEdgeFunctionsTriggerOptions *edgefunctionsapiv1.DeleteEdgeFunctionsTriggerOptions) (result *edgefunctionsapiv1.CreateEdgeFunctionsTriggerResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteEdgeFunctionsTriggerWithContext", ctx, deleteEdgeFunctionsTriggerOptions)
+	if mock.DeleteEdgeFunctionsTriggerWithContextFunc != nil {
+		return mock.DeleteEdgeFunctionsTriggerWithContextFunc(ctx, deleteEdgeFunctionsTriggerOptions)
+	}
+	if mock.DeleteEdgeFunctionsTriggerFunc != nil {
+		return mock.DeleteEdgeFunctionsTriggerFunc(deleteEdgeFunctionsTriggerOptions)
+	}
+	err = notProgrammed("DeleteEdgeFunctionsTriggerWithContext")
+	return
+}
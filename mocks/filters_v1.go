@@ -0,0 +1,230 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+)
+
+// FiltersV1 is a mock of filtersv1.FiltersV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type FiltersV1 struct {
+	Mock
+
+	ListAllFiltersFunc            func(*filtersv1.ListAllFiltersOptions) (*filtersv1.ListFiltersResp, *core.DetailedResponse, error)
+	ListAllFiltersWithContextFunc func(context.Context, *filtersv1.ListAllFiltersOptions) (*filtersv1.ListFiltersResp, *core.DetailedResponse, error)
+	CreateFilterFunc              func(*filtersv1.CreateFilterOptions) (*filtersv1.FiltersResp, *core.DetailedResponse, error)
+	CreateFilterWithContextFunc   func(context.Context, *filtersv1.CreateFilterOptions) (*filtersv1.FiltersResp, *core.DetailedResponse, error)
+	UpdateFiltersFunc             func(*filtersv1.UpdateFiltersOptions) (*filtersv1.FiltersResp, *core.DetailedResponse, error)
+	UpdateFiltersWithContextFunc  func(context.Context, *filtersv1.UpdateFiltersOptions) (*filtersv1.FiltersResp, *core.DetailedResponse, error)
+	DeleteFiltersFunc             func(*filtersv1.DeleteFiltersOptions) (*filtersv1.DeleteFiltersResp, *core.DetailedResponse, error)
+	DeleteFiltersWithContextFunc  func(context.Context, *filtersv1.DeleteFiltersOptions) (*filtersv1.DeleteFiltersResp, *core.DetailedResponse, error)
+	DeleteFilterFunc              func(*filtersv1.DeleteFilterOptions) (*filtersv1.DeleteFilterResp, *core.DetailedResponse, error)
+	DeleteFilterWithContextFunc   func(context.Context, *filtersv1.DeleteFilterOptions) (*filtersv1.DeleteFilterResp, *core.DetailedResponse, error)
+	GetFilterFunc                 func(*filtersv1.GetFilterOptions) (*filtersv1.FilterResp, *core.DetailedResponse, error)
+	GetFilterWithContextFunc      func(context.Context, *filtersv1.GetFilterOptions) (*filtersv1.FilterResp, *core.DetailedResponse, error)
+	UpdateFilterFunc              func(*filtersv1.UpdateFilterOptions) (*filtersv1.FilterResp, *core.DetailedResponse, error)
+	UpdateFilterWithContextFunc   func(context.Context, *filtersv1.UpdateFilterOptions) (*filtersv1.FilterResp, *core.DetailedResponse, error)
+}
+
+// FiltersV1 implements filtersv1.FiltersV1API.
+var _ filtersv1.FiltersV1API = (*FiltersV1)(nil)
+
+// ListAllFilters invokes the programmed ListAllFiltersFunc.
+func (mock *FiltersV1) ListAllFilters(listAllFiltersOptions *filtersv1.ListAllFiltersOptions) (result *filtersv1.ListFiltersResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllFilters", listAllFiltersOptions)
+	if mock.ListAllFiltersFunc != nil {
+		return mock.ListAllFiltersFunc(listAllFiltersOptions)
+	}
+	if mock.ListAllFiltersWithContextFunc != nil {
+		return mock.ListAllFiltersWithContextFunc(context.Background(), listAllFiltersOptions)
+	}
+	err = notProgrammed("ListAllFilters")
+	return
+}
+
+// ListAllFiltersWithContext invokes the programmed ListAllFiltersWithContextFunc.
+func (mock *FiltersV1) ListAllFiltersWithContext(ctx context.Context, listAllFiltersOptions *filtersv1.ListAllFiltersOptions) (result *filtersv1.ListFiltersResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllFiltersWithContext", ctx, listAllFiltersOptions)
+	if mock.ListAllFiltersWithContextFunc != nil {
+		return mock.ListAllFiltersWithContextFunc(ctx, listAllFiltersOptions)
+	}
+	if mock.ListAllFiltersFunc != nil {
+		return mock.ListAllFiltersFunc(listAllFiltersOptions)
+	}
+	err = notProgrammed("ListAllFiltersWithContext")
+	return
+}
+
+// CreateFilter invokes the programmed CreateFilterFunc.
+func (mock *FiltersV1) CreateFilter(createFilterOptions *filtersv1.CreateFilterOptions) (result *filtersv1.FiltersResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateFilter", createFilterOptions)
+	if mock.CreateFilterFunc != nil {
+		return mock.CreateFilterFunc(createFilterOptions)
+	}
+	if mock.CreateFilterWithContextFunc != nil {
+		return mock.CreateFilterWithContextFunc(context.Background(), createFilterOptions)
+	}
+	err = notProgrammed("CreateFilter")
+	return
+}
+
+// CreateFilterWithContext invokes the programmed CreateFilterWithContextFunc.
+func (mock *FiltersV1) CreateFilterWithContext(ctx context.Context, createFilterOptions *filtersv1.CreateFilterOptions) (result *filtersv1.FiltersResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateFilterWithContext", ctx, createFilterOptions)
+	if mock.CreateFilterWithContextFunc != nil {
+		return mock.CreateFilterWithContextFunc(ctx, createFilterOptions)
+	}
+	if mock.CreateFilterFunc != nil {
+		return mock.CreateFilterFunc(createFilterOptions)
+	}
+	err = notProgrammed("CreateFilterWithContext")
+	return
+}
+
+// UpdateFilters invokes the programmed UpdateFiltersFunc.
+func (mock *FiltersV1) UpdateFilters(updateFiltersOptions *filtersv1.UpdateFiltersOptions) (result *filtersv1.FiltersResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateFilters", updateFiltersOptions)
+	if mock.UpdateFiltersFunc != nil {
+		return mock.UpdateFiltersFunc(updateFiltersOptions)
+	}
+	if mock.UpdateFiltersWithContextFunc != nil {
+		return mock.UpdateFiltersWithContextFunc(context.Background(), updateFiltersOptions)
+	}
+	err = notProgrammed("UpdateFilters")
+	return
+}
+
+// UpdateFiltersWithContext invokes the programmed UpdateFiltersWithContextFunc.
+func (mock *FiltersV1) UpdateFiltersWithContext(ctx context.Context, updateFiltersOptions *filtersv1.UpdateFiltersOptions) (result *filtersv1.FiltersResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateFiltersWithContext", ctx, updateFiltersOptions)
+	if mock.UpdateFiltersWithContextFunc != nil {
+		return mock.UpdateFiltersWithContextFunc(ctx, updateFiltersOptions)
+	}
+	if mock.UpdateFiltersFunc != nil {
+		return mock.UpdateFiltersFunc(updateFiltersOptions)
+	}
+	err = notProgrammed("UpdateFiltersWithContext")
+	return
+}
+
+// DeleteFilters invokes the programmed DeleteFiltersFunc.
+func (mock *FiltersV1) DeleteFilters(deleteFiltersOptions *filtersv1.DeleteFiltersOptions) (result *filtersv1.DeleteFiltersResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteFilters", deleteFiltersOptions)
+	if mock.DeleteFiltersFunc != nil {
+		return mock.DeleteFiltersFunc(deleteFiltersOptions)
+	}
+	if mock.DeleteFiltersWithContextFunc != nil {
+		return mock.DeleteFiltersWithContextFunc(context.Background(), deleteFiltersOptions)
+	}
+	err = notProgrammed("DeleteFilters")
+	return
+}
+
+// DeleteFiltersWithContext invokes the programmed DeleteFiltersWithContextFunc.
+func (mock *FiltersV1) DeleteFiltersWithContext(ctx context.Context, deleteFiltersOptions *filtersv1.DeleteFiltersOptions) (result *filtersv1.DeleteFiltersResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteFiltersWithContext", ctx, deleteFiltersOptions)
+	if mock.DeleteFiltersWithContextFunc != nil {
+		return mock.DeleteFiltersWithContextFunc(ctx, deleteFiltersOptions)
+	}
+	if mock.DeleteFiltersFunc != nil {
+		return mock.DeleteFiltersFunc(deleteFiltersOptions)
+	}
+	err = notProgrammed("DeleteFiltersWithContext")
+	return
+}
+
+// DeleteFilter invokes the programmed DeleteFilterFunc.
+func (mock *FiltersV1) DeleteFilter(deleteFilterOptions *filtersv1.DeleteFilterOptions) (result *filtersv1.DeleteFilterResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteFilter", deleteFilterOptions)
+	if mock.DeleteFilterFunc != nil {
+		return mock.DeleteFilterFunc(deleteFilterOptions)
+	}
+	if mock.DeleteFilterWithContextFunc != nil {
+		return mock.DeleteFilterWithContextFunc(context.Background(), deleteFilterOptions)
+	}
+	err = notProgrammed("DeleteFilter")
+	return
+}
+
+// DeleteFilterWithContext invokes the programmed DeleteFilterWithContextFunc.
+func (mock *FiltersV1) DeleteFilterWithContext(ctx context.Context, deleteFilterOptions *filtersv1.DeleteFilterOptions) (result *filtersv1.DeleteFilterResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteFilterWithContext", ctx, deleteFilterOptions)
+	if mock.DeleteFilterWithContextFunc != nil {
+		return mock.DeleteFilterWithContextFunc(ctx, deleteFilterOptions)
+	}
+	if mock.DeleteFilterFunc != nil {
+		return mock.DeleteFilterFunc(deleteFilterOptions)
+	}
+	err = notProgrammed("DeleteFilterWithContext")
+	return
+}
+
+// GetFilter invokes the programmed GetFilterFunc.
+func (mock *FiltersV1) GetFilter(getFilterOptions *filtersv1.GetFilterOptions) (result *filtersv1.FilterResp, response *core.DetailedResponse, err error) {
+	mock.called("GetFilter", getFilterOptions)
+	if mock.GetFilterFunc != nil {
+		return mock.GetFilterFunc(getFilterOptions)
+	}
+	if mock.GetFilterWithContextFunc != nil {
+		return mock.GetFilterWithContextFunc(context.Background(), getFilterOptions)
+	}
+	err = notProgrammed("GetFilter")
+	return
+}
+
+// GetFilterWithContext invokes the programmed GetFilterWithContextFunc.
+func (mock *FiltersV1) GetFilterWithContext(ctx context.Context, getFilterOptions *filtersv1.GetFilterOptions) (result *filtersv1.FilterResp, response *core.DetailedResponse, err error) {
+	mock.called("GetFilterWithContext", ctx, getFilterOptions)
+	if mock.GetFilterWithContextFunc != nil {
+		return mock.GetFilterWithContextFunc(ctx, getFilterOptions)
+	}
+	if mock.GetFilterFunc != nil {
+		return mock.GetFilterFunc(getFilterOptions)
+	}
+	err = notProgrammed("GetFilterWithContext")
+	return
+}
+
+// UpdateFilter invokes the programmed UpdateFilterFunc.
+func (mock *FiltersV1) UpdateFilter(updateFilterOptions *filtersv1.UpdateFilterOptions) (result *filtersv1.FilterResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateFilter", updateFilterOptions)
+	if mock.UpdateFilterFunc != nil {
+		return mock.UpdateFilterFunc(updateFilterOptions)
+	}
+	if mock.UpdateFilterWithContextFunc != nil {
+		return mock.UpdateFilterWithContextFunc(context.Background(), updateFilterOptions)
+	}
+	err = notProgrammed("UpdateFilter")
+	return
+}
+
+// UpdateFilterWithContext invokes the programmed UpdateFilterWithContextFunc.
+func (mock *FiltersV1) UpdateFilterWithContext(ctx context.Context, updateFilterOptions *filtersv1.UpdateFilterOptions) (result *filtersv1.FilterResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateFilterWithContext", ctx, updateFilterOptions)
+	if mock.UpdateFilterWithContextFunc != nil {
+		return mock.UpdateFilterWithContextFunc(ctx, updateFilterOptions)
+	}
+	if mock.UpdateFilterFunc != nil {
+		return mock.UpdateFilterFunc(updateFilterOptions)
+	}
+	err = notProgrammed("UpdateFilterWithContext")
+	return
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/firewallaccessrulesv1"
+)
+
+// FirewallAccessRulesV1 is a mock of firewallaccessrulesv1.FirewallAccessRulesV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type FirewallAccessRulesV1 struct {
+	Mock
+
+	ListAllAccountAccessRulesFunc            func(*firewallaccessrulesv1.ListAllAccountAccessRulesOptions) (*firewallaccessrulesv1.ListAccountAccessRulesResp, *core.DetailedResponse, error)
+	ListAllAccountAccessRulesWithContextFunc func(context.Context, *firewallaccessrulesv1.ListAllAccountAccessRulesOptions) (*firewallaccessrulesv1.ListAccountAccessRulesResp, *core.DetailedResponse, error)
+	CreateAccountAccessRuleFunc              func(*firewallaccessrulesv1.CreateAccountAccessRuleOptions) (*firewallaccessrulesv1.AccountAccessRuleResp, *core.DetailedResponse, error)
+	CreateAccountAccessRuleWithContextFunc   func(context.Context, *firewallaccessrulesv1.CreateAccountAccessRuleOptions) (*firewallaccessrulesv1.AccountAccessRuleResp, *core.DetailedResponse, error)
+	DeleteAccountAccessRuleFunc              func(*firewallaccessrulesv1.DeleteAccountAccessRuleOptions) (*firewallaccessrulesv1.DeleteAccountAccessRuleResp, *core.DetailedResponse, error)
+	DeleteAccountAccessRuleWithContextFunc   func(context.Context, *firewallaccessrulesv1.DeleteAccountAccessRuleOptions) (*firewallaccessrulesv1.DeleteAccountAccessRuleResp, *core.DetailedResponse, error)
+	GetAccountAccessRuleFunc                 func(*firewallaccessrulesv1.GetAccountAccessRuleOptions) (*firewallaccessrulesv1.AccountAccessRuleResp, *core.DetailedResponse, error)
+	GetAccountAccessRuleWithContextFunc      func(context.Context, *firewallaccessrulesv1.GetAccountAccessRuleOptions) (*firewallaccessrulesv1.AccountAccessRuleResp, *core.DetailedResponse, error)
+	UpdateAccountAccessRuleFunc              func(*firewallaccessrulesv1.UpdateAccountAccessRuleOptions) (*firewallaccessrulesv1.AccountAccessRuleResp, *core.DetailedResponse, error)
+	UpdateAccountAccessRuleWithContextFunc   func(context.Context, *firewallaccessrulesv1.UpdateAccountAccessRuleOptions) (*firewallaccessrulesv1.AccountAccessRuleResp, *core.DetailedResponse, error)
+}
+
+// FirewallAccessRulesV1 implements firewallaccessrulesv1.FirewallAccessRulesV1API.
+var _ firewallaccessrulesv1.FirewallAccessRulesV1API = (*FirewallAccessRulesV1)(nil)
+
+// ListAllAccountAccessRules invokes the programmed ListAllAccountAccessRulesFunc.
+func (mock *FirewallAccessRulesV1) ListAllAccountAccessRules(listAllAccountAccessRulesOptions *firewallaccessrulesv1.ListAllAccountAccessRulesOptions) (result *firewallaccessrulesv1.ListAccountAccessRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllAccountAccessRules", listAllAccountAccessRulesOptions)
+	if mock.ListAllAccountAccessRulesFunc != nil {
+		return mock.ListAllAccountAccessRulesFunc(listAllAccountAccessRulesOptions)
+	}
+	if mock.ListAllAccountAccessRulesWithContextFunc != nil {
+		return mock.ListAllAccountAccessRulesWithContextFunc(context.Background(), listAllAccountAccessRulesOptions)
+	}
+	err = notProgrammed("ListAllAccountAccessRules")
+	return
+}
+
+// ListAllAccountAccessRulesWithContext invokes the programmed ListAllAccountAccessRulesWithContextFunc.
+func (mock *FirewallAccessRulesV1) ListAllAccountAccessRulesWithContext(ctx context.Context, listAllAccountAccessRulesOptions *firewallaccessrulesv1.ListAllAccountAccessRulesOptions) (result *firewallaccessrulesv1.ListAccountAccessRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllAccountAccessRulesWithContext", ctx, listAllAccountAccessRulesOptions)
+	if mock.ListAllAccountAccessRulesWithContextFunc != nil {
+		return mock.ListAllAccountAccessRulesWithContextFunc(ctx, listAllAccountAccessRulesOptions)
+	}
+	if mock.ListAllAccountAccessRulesFunc != nil {
+		return mock.ListAllAccountAccessRulesFunc(listAllAccountAccessRulesOptions)
+	}
+	err = notProgrammed("ListAllAccountAccessRulesWithContext")
+	return
+}
+
+// CreateAccountAccessRule invokes the programmed CreateAccountAccessRuleFunc.
+func (mock *FirewallAccessRulesV1) CreateAccountAccessRule(createAccountAccessRuleOptions *firewallaccessrulesv1.CreateAccountAccessRuleOptions) (result *firewallaccessrulesv1.AccountAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAccountAccessRule", createAccountAccessRuleOptions)
+	if mock.CreateAccountAccessRuleFunc != nil {
+		return mock.CreateAccountAccessRuleFunc(createAccountAccessRuleOptions)
+	}
+	if mock.CreateAccountAccessRuleWithContextFunc != nil {
+		return mock.CreateAccountAccessRuleWithContextFunc(context.Background(), createAccountAccessRuleOptions)
+	}
+	err = notProgrammed("CreateAccountAccessRule")
+	return
+}
+
+// CreateAccountAccessRuleWithContext invokes the programmed CreateAccountAccessRuleWithContextFunc.
+func (mock *FirewallAccessRulesV1) CreateAccountAccessRuleWithContext(ctx context.Context, createAccountAccessRuleOptions *firewallaccessrulesv1.CreateAccountAccessRuleOptions) (result *firewallaccessrulesv1.AccountAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAccountAccessRuleWithContext", ctx, createAccountAccessRuleOptions)
+	if mock.CreateAccountAccessRuleWithContextFunc != nil {
+		return mock.CreateAccountAccessRuleWithContextFunc(ctx, createAccountAccessRuleOptions)
+	}
+	if mock.CreateAccountAccessRuleFunc != nil {
+		return mock.CreateAccountAccessRuleFunc(createAccountAccessRuleOptions)
+	}
+	err = notProgrammed("CreateAccountAccessRuleWithContext")
+	return
+}
+
+// DeleteAccountAccessRule invokes the programmed DeleteAccountAccessRuleFunc.
+func (mock *FirewallAccessRulesV1) DeleteAccountAccessRule(deleteAccountAccessRuleOptions *firewallaccessrulesv1.DeleteAccountAccessRuleOptions) (result *firewallaccessrulesv1.DeleteAccountAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteAccountAccessRule", deleteAccountAccessRuleOptions)
+	if mock.DeleteAccountAccessRuleFunc != nil {
+		return mock.DeleteAccountAccessRuleFunc(deleteAccountAccessRuleOptions)
+	}
+	if mock.DeleteAccountAccessRuleWithContextFunc != nil {
+		return mock.DeleteAccountAccessRuleWithContextFunc(context.Background(), deleteAccountAccessRuleOptions)
+	}
+	err = notProgrammed("DeleteAccountAccessRule")
+	return
+}
+
+// DeleteAccountAccessRuleWithContext invokes the programmed DeleteAccountAccessRuleWithContextFunc.
+func (mock *FirewallAccessRulesV1) DeleteAccountAccessRuleWithContext(ctx context.Context, deleteAccountAccessRuleOptions *firewallaccessrulesv1.DeleteAccountAccessRuleOptions) (result *firewallaccessrulesv1.DeleteAccountAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteAccountAccessRuleWithContext", ctx, deleteAccountAccessRuleOptions)
+	if mock.DeleteAccountAccessRuleWithContextFunc != nil {
+		return mock.DeleteAccountAccessRuleWithContextFunc(ctx, deleteAccountAccessRuleOptions)
+	}
+	if mock.DeleteAccountAccessRuleFunc != nil {
+		return mock.DeleteAccountAccessRuleFunc(deleteAccountAccessRuleOptions)
+	}
+	err = notProgrammed("DeleteAccountAccessRuleWithContext")
+	return
+}
+
+// GetAccountAccessRule invokes the programmed GetAccountAccessRuleFunc.
+func (mock *FirewallAccessRulesV1) GetAccountAccessRule(getAccountAccessRuleOptions *firewallaccessrulesv1.GetAccountAccessRuleOptions) (result *firewallaccessrulesv1.AccountAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAccountAccessRule", getAccountAccessRuleOptions)
+	if mock.GetAccountAccessRuleFunc != nil {
+		return mock.GetAccountAccessRuleFunc(getAccountAccessRuleOptions)
+	}
+	if mock.GetAccountAccessRuleWithContextFunc != nil {
+		return mock.GetAccountAccessRuleWithContextFunc(context.Background(), getAccountAccessRuleOptions)
+	}
+	err = notProgrammed("GetAccountAccessRule")
+	return
+}
+
+// GetAccountAccessRuleWithContext invokes the programmed GetAccountAccessRuleWithContextFunc.
+func (mock *FirewallAccessRulesV1) GetAccountAccessRuleWithContext(ctx context.Context, getAccountAccessRuleOptions *firewallaccessrulesv1.GetAccountAccessRuleOptions) (result *firewallaccessrulesv1.AccountAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAccountAccessRuleWithContext", ctx, getAccountAccessRuleOptions)
+	if mock.GetAccountAccessRuleWithContextFunc != nil {
+		return mock.GetAccountAccessRuleWithContextFunc(ctx, getAccountAccessRuleOptions)
+	}
+	if mock.GetAccountAccessRuleFunc != nil {
+		return mock.GetAccountAccessRuleFunc(getAccountAccessRuleOptions)
+	}
+	err = notProgrammed("GetAccountAccessRuleWithContext")
+	return
+}
+
+// UpdateAccountAccessRule invokes the programmed UpdateAccountAccessRuleFunc.
+func (mock *FirewallAccessRulesV1) UpdateAccountAccessRule(updateAccountAccessRuleOptions *firewallaccessrulesv1.UpdateAccountAccessRuleOptions) (result *firewallaccessrulesv1.AccountAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAccountAccessRule", updateAccountAccessRuleOptions)
+	if mock.UpdateAccountAccessRuleFunc != nil {
+		return mock.UpdateAccountAccessRuleFunc(updateAccountAccessRuleOptions)
+	}
+	if mock.UpdateAccountAccessRuleWithContextFunc != nil {
+		return mock.UpdateAccountAccessRuleWithContextFunc(context.Background(), updateAccountAccessRuleOptions)
+	}
+	err = notProgrammed("UpdateAccountAccessRule")
+	return
+}
+
+// UpdateAccountAccessRuleWithContext invokes the programmed UpdateAccountAccessRuleWithContextFunc.
+func (mock *FirewallAccessRulesV1) UpdateAccountAccessRuleWithContext(ctx context.Context, updateAccountAccessRuleOptions *firewallaccessrulesv1.UpdateAccountAccessRuleOptions) (result *firewallaccessrulesv1.AccountAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAccountAccessRuleWithContext", ctx, updateAccountAccessRuleOptions)
+	if mock.UpdateAccountAccessRuleWithContextFunc != nil {
+		return mock.UpdateAccountAccessRuleWithContextFunc(ctx, updateAccountAccessRuleOptions)
+	}
+	if mock.UpdateAccountAccessRuleFunc != nil {
+		return mock.UpdateAccountAccessRuleFunc(updateAccountAccessRuleOptions)
+	}
+	err = notProgrammed("UpdateAccountAccessRuleWithContext")
+	return
+}
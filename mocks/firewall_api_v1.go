@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/firewallapiv1"
+)
+
+// FirewallApiV1 is a mock of firewallapiv1.FirewallApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type FirewallApiV1 struct {
+	Mock
+
+	GetSecurityLevelSettingFunc            func(*firewallapiv1.GetSecurityLevelSettingOptions) (*firewallapiv1.SecurityLevelSettingResp, *core.DetailedResponse, error)
+	GetSecurityLevelSettingWithContextFunc func(context.Context, *firewallapiv1.GetSecurityLevelSettingOptions) (*firewallapiv1.SecurityLevelSettingResp, *core.DetailedResponse, error)
+	SetSecurityLevelSettingFunc            func(*firewallapiv1.SetSecurityLevelSettingOptions) (*firewallapiv1.SecurityLevelSettingResp, *core.DetailedResponse, error)
+	SetSecurityLevelSettingWithContextFunc func(context.Context, *firewallapiv1.SetSecurityLevelSettingOptions) (*firewallapiv1.SecurityLevelSettingResp, *core.DetailedResponse, error)
+}
+
+// FirewallApiV1 implements firewallapiv1.FirewallApiV1API.
+var _ firewallapiv1.FirewallApiV1API = (*FirewallApiV1)(nil)
+
+// GetSecurityLevelSetting invokes the programmed GetSecurityLevelSettingFunc.
+func (mock *FirewallApiV1) GetSecurityLevelSetting(getSecurityLevelSettingOptions *firewallapiv1.GetSecurityLevelSettingOptions) (result *firewallapiv1.SecurityLevelSettingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetSecurityLevelSetting", getSecurityLevelSettingOptions)
+	if mock.GetSecurityLevelSettingFunc != nil {
+		return mock.GetSecurityLevelSettingFunc(getSecurityLevelSettingOptions)
+	}
+	if mock.GetSecurityLevelSettingWithContextFunc != nil {
+		return mock.GetSecurityLevelSettingWithContextFunc(context.Background(), getSecurityLevelSettingOptions)
+	}
+	err = notProgrammed("GetSecurityLevelSetting")
+	return
+}
+
+// GetSecurityLevelSettingWithContext invokes the programmed GetSecurityLevelSettingWithContextFunc.
+func (mock *FirewallApiV1) GetSecurityLevelSettingWithContext(ctx context.Context, getSecurityLevelSettingOptions *firewallapiv1.GetSecurityLevelSettingOptions) (result *firewallapiv1.SecurityLevelSettingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetSecurityLevelSettingWithContext", ctx, getSecurityLevelSettingOptions)
+	if mock.GetSecurityLevelSettingWithContextFunc != nil {
+		return mock.GetSecurityLevelSettingWithContextFunc(ctx, getSecurityLevelSettingOptions)
+	}
+	if mock.GetSecurityLevelSettingFunc != nil {
+		return mock.GetSecurityLevelSettingFunc(getSecurityLevelSettingOptions)
+	}
+	err = notProgrammed("GetSecurityLevelSettingWithContext")
+	return
+}
+
+// SetSecurityLevelSetting invokes the programmed SetSecurityLevelSettingFunc.
+func (mock *FirewallApiV1) SetSecurityLevelSetting(setSecurityLevelSettingOptions *firewallapiv1.SetSecurityLevelSettingOptions) (result *firewallapiv1.SecurityLevelSettingResp, response *core.DetailedResponse, err error) {
+	mock.called("SetSecurityLevelSetting", setSecurityLevelSettingOptions)
+	if mock.SetSecurityLevelSettingFunc != nil {
+		return mock.SetSecurityLevelSettingFunc(setSecurityLevelSettingOptions)
+	}
+	if mock.SetSecurityLevelSettingWithContextFunc != nil {
+		return mock.SetSecurityLevelSettingWithContextFunc(context.Background(), setSecurityLevelSettingOptions)
+	}
+	err = notProgrammed("SetSecurityLevelSetting")
+	return
+}
+
+// SetSecurityLevelSettingWithContext invokes the programmed SetSecurityLevelSettingWithContextFunc.
+func (mock *FirewallApiV1) SetSecurityLevelSettingWithContext(ctx context.Context, setSecurityLevelSettingOptions *firewallapiv1.SetSecurityLevelSettingOptions) (result *firewallapiv1.SecurityLevelSettingResp, response *core.DetailedResponse, err error) {
+	mock.called("SetSecurityLevelSettingWithContext", ctx, setSecurityLevelSettingOptions)
+	if mock.SetSecurityLevelSettingWithContextFunc != nil {
+		return mock.SetSecurityLevelSettingWithContextFunc(ctx, setSecurityLevelSettingOptions)
+	}
+	if mock.SetSecurityLevelSettingFunc != nil {
+		return mock.SetSecurityLevelSettingFunc(setSecurityLevelSettingOptions)
+	}
+	err = notProgrammed("SetSecurityLevelSettingWithContext")
+	return
+}
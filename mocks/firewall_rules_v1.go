@@ -0,0 +1,315 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+)
+
+// FirewallRulesV1 is a mock of firewallrulesv1.FirewallRulesV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type FirewallRulesV1 struct {
+	Mock
+
+	ListAllFirewallRulesFunc                      func(*firewallrulesv1.ListAllFirewallRulesOptions) (*firewallrulesv1.ListFirewallRulesResp, *core.DetailedResponse, error)
+	ListAllFirewallRulesWithContextFunc           func(context.Context, *firewallrulesv1.ListAllFirewallRulesOptions) (*firewallrulesv1.ListFirewallRulesResp, *core.DetailedResponse, error)
+	CreateFirewallRulesFunc                       func(*firewallrulesv1.CreateFirewallRulesOptions) (*firewallrulesv1.FirewallRulesResp, *core.DetailedResponse, error)
+	CreateFirewallRulesWithContextFunc            func(context.Context, *firewallrulesv1.CreateFirewallRulesOptions) (*firewallrulesv1.FirewallRulesResp, *core.DetailedResponse, error)
+	UpdateFirewllRulesFunc                        func(*firewallrulesv1.UpdateFirewllRulesOptions) (*firewallrulesv1.FirewallRulesResp, *core.DetailedResponse, error)
+	UpdateFirewllRulesWithContextFunc             func(context.Context, *firewallrulesv1.UpdateFirewllRulesOptions) (*firewallrulesv1.FirewallRulesResp, *core.DetailedResponse, error)
+	DeleteFirewallRulesFunc                       func(*firewallrulesv1.DeleteFirewallRulesOptions) (*firewallrulesv1.DeleteFirewallRulesResp, *core.DetailedResponse, error)
+	DeleteFirewallRulesWithContextFunc            func(context.Context, *firewallrulesv1.DeleteFirewallRulesOptions) (*firewallrulesv1.DeleteFirewallRulesResp, *core.DetailedResponse, error)
+	DeleteFirewallRuleFunc                        func(*firewallrulesv1.DeleteFirewallRuleOptions) (*firewallrulesv1.DeleteFirewallRuleResp, *core.DetailedResponse, error)
+	DeleteFirewallRuleWithContextFunc             func(context.Context, *firewallrulesv1.DeleteFirewallRuleOptions) (*firewallrulesv1.DeleteFirewallRuleResp, *core.DetailedResponse, error)
+	GetFirewallRuleFunc                           func(*firewallrulesv1.GetFirewallRuleOptions) (*firewallrulesv1.FirewallRuleResp, *core.DetailedResponse, error)
+	GetFirewallRuleWithContextFunc                func(context.Context, *firewallrulesv1.GetFirewallRuleOptions) (*firewallrulesv1.FirewallRuleResp, *core.DetailedResponse, error)
+	UpdateFirewallRuleFunc                        func(*firewallrulesv1.UpdateFirewallRuleOptions) (*firewallrulesv1.FirewallRuleResp, *core.DetailedResponse, error)
+	UpdateFirewallRuleWithContextFunc             func(context.Context, *firewallrulesv1.UpdateFirewallRuleOptions) (*firewallrulesv1.FirewallRuleResp, *core.DetailedResponse, error)
+	CreateFirewallRulesWithFiltersFunc            func(filtersv1.FiltersV1API, *firewallrulesv1.CreateFirewallRulesWithFiltersOptions) (*firewallrulesv1.FirewallRulesResp, *core.DetailedResponse, error)
+	CreateFirewallRulesWithFiltersWithContextFunc func(context.Context, filtersv1.FiltersV1API, *firewallrulesv1.CreateFirewallRulesWithFiltersOptions) (*firewallrulesv1.FirewallRulesResp, *core.DetailedResponse, error)
+	UpdateFirewallRuleWithFilterFunc              func(filtersv1.FiltersV1API, *firewallrulesv1.UpdateFirewallRuleWithFilterOptions) (*firewallrulesv1.FirewallRuleResp, *core.DetailedResponse, error)
+	UpdateFirewallRuleWithFilterWithContextFunc   func(context.Context, filtersv1.FiltersV1API, *firewallrulesv1.UpdateFirewallRuleWithFilterOptions) (*firewallrulesv1.FirewallRuleResp, *core.DetailedResponse, error)
+	DeleteFirewallRuleWithFilterFunc              func(filtersv1.FiltersV1API, *firewallrulesv1.DeleteFirewallRuleWithFilterOptions) (*firewallrulesv1.DeleteFirewallRuleResp, *core.DetailedResponse, error)
+	DeleteFirewallRuleWithFilterWithContextFunc   func(context.Context, filtersv1.FiltersV1API, *firewallrulesv1.DeleteFirewallRuleWithFilterOptions) (*firewallrulesv1.DeleteFirewallRuleResp, *core.DetailedResponse, error)
+}
+
+// FirewallRulesV1 implements firewallrulesv1.FirewallRulesV1API.
+var _ firewallrulesv1.FirewallRulesV1API = (*FirewallRulesV1)(nil)
+
+// ListAllFirewallRules invokes the programmed ListAllFirewallRulesFunc.
+func (mock *FirewallRulesV1) ListAllFirewallRules(listAllFirewallRulesOptions *firewallrulesv1.ListAllFirewallRulesOptions) (result *firewallrulesv1.ListFirewallRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllFirewallRules", listAllFirewallRulesOptions)
+	if mock.ListAllFirewallRulesFunc != nil {
+		return mock.ListAllFirewallRulesFunc(listAllFirewallRulesOptions)
+	}
+	if mock.ListAllFirewallRulesWithContextFunc != nil {
+		return mock.ListAllFirewallRulesWithContextFunc(context.Background(), listAllFirewallRulesOptions)
+	}
+	err = notProgrammed("ListAllFirewallRules")
+	return
+}
+
+// ListAllFirewallRulesWithContext invokes the programmed ListAllFirewallRulesWithContextFunc.
+func (mock *FirewallRulesV1) ListAllFirewallRulesWithContext(ctx context.Context, listAllFirewallRulesOptions *firewallrulesv1.ListAllFirewallRulesOptions) (result *firewallrulesv1.ListFirewallRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllFirewallRulesWithContext", ctx, listAllFirewallRulesOptions)
+	if mock.ListAllFirewallRulesWithContextFunc != nil {
+		return mock.ListAllFirewallRulesWithContextFunc(ctx, listAllFirewallRulesOptions)
+	}
+	if mock.ListAllFirewallRulesFunc != nil {
+		return mock.ListAllFirewallRulesFunc(listAllFirewallRulesOptions)
+	}
+	err = notProgrammed("ListAllFirewallRulesWithContext")
+	return
+}
+
+// CreateFirewallRules invokes the programmed CreateFirewallRulesFunc.
+func (mock *FirewallRulesV1) CreateFirewallRules(createFirewallRulesOptions *firewallrulesv1.CreateFirewallRulesOptions) (result *firewallrulesv1.FirewallRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateFirewallRules", createFirewallRulesOptions)
+	if mock.CreateFirewallRulesFunc != nil {
+		return mock.CreateFirewallRulesFunc(createFirewallRulesOptions)
+	}
+	if mock.CreateFirewallRulesWithContextFunc != nil {
+		return mock.CreateFirewallRulesWithContextFunc(context.Background(), createFirewallRulesOptions)
+	}
+	err = notProgrammed("CreateFirewallRules")
+	return
+}
+
+// CreateFirewallRulesWithContext invokes the programmed CreateFirewallRulesWithContextFunc.
+func (mock *FirewallRulesV1) CreateFirewallRulesWithContext(ctx context.Context, createFirewallRulesOptions *firewallrulesv1.CreateFirewallRulesOptions) (result *firewallrulesv1.FirewallRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateFirewallRulesWithContext", ctx, createFirewallRulesOptions)
+	if mock.CreateFirewallRulesWithContextFunc != nil {
+		return mock.CreateFirewallRulesWithContextFunc(ctx, createFirewallRulesOptions)
+	}
+	if mock.CreateFirewallRulesFunc != nil {
+		return mock.CreateFirewallRulesFunc(createFirewallRulesOptions)
+	}
+	err = notProgrammed("CreateFirewallRulesWithContext")
+	return
+}
+
+// UpdateFirewllRules invokes the programmed UpdateFirewllRulesFunc.
+func (mock *FirewallRulesV1) UpdateFirewllRules(updateFirewllRulesOptions *firewallrulesv1.UpdateFirewllRulesOptions) (result *firewallrulesv1.FirewallRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateFirewllRules", updateFirewllRulesOptions)
+	if mock.UpdateFirewllRulesFunc != nil {
+		return mock.UpdateFirewllRulesFunc(updateFirewllRulesOptions)
+	}
+	if mock.UpdateFirewllRulesWithContextFunc != nil {
+		return mock.UpdateFirewllRulesWithContextFunc(context.Background(), updateFirewllRulesOptions)
+	}
+	err = notProgrammed("UpdateFirewllRules")
+	return
+}
+
+// UpdateFirewllRulesWithContext invokes the programmed UpdateFirewllRulesWithContextFunc.
+func (mock *FirewallRulesV1) UpdateFirewllRulesWithContext(ctx context.Context, updateFirewllRulesOptions *firewallrulesv1.UpdateFirewllRulesOptions) (result *firewallrulesv1.FirewallRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateFirewllRulesWithContext", ctx, updateFirewllRulesOptions)
+	if mock.UpdateFirewllRulesWithContextFunc != nil {
+		return mock.UpdateFirewllRulesWithContextFunc(ctx, updateFirewllRulesOptions)
+	}
+	if mock.UpdateFirewllRulesFunc != nil {
+		return mock.UpdateFirewllRulesFunc(updateFirewllRulesOptions)
+	}
+	err = notProgrammed("UpdateFirewllRulesWithContext")
+	return
+}
+
+// DeleteFirewallRules invokes the programmed DeleteFirewallRulesFunc.
+func (mock *FirewallRulesV1) DeleteFirewallRules(deleteFirewallRulesOptions *firewallrulesv1.DeleteFirewallRulesOptions) (result *firewallrulesv1.DeleteFirewallRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteFirewallRules", deleteFirewallRulesOptions)
+	if mock.DeleteFirewallRulesFunc != nil {
+		return mock.DeleteFirewallRulesFunc(deleteFirewallRulesOptions)
+	}
+	if mock.DeleteFirewallRulesWithContextFunc != nil {
+		return mock.DeleteFirewallRulesWithContextFunc(context.Background(), deleteFirewallRulesOptions)
+	}
+	err = notProgrammed("DeleteFirewallRules")
+	return
+}
+
+// DeleteFirewallRulesWithContext invokes the programmed DeleteFirewallRulesWithContextFunc.
+func (mock *FirewallRulesV1) DeleteFirewallRulesWithContext(ctx context.Context, deleteFirewallRulesOptions *firewallrulesv1.DeleteFirewallRulesOptions) (result *firewallrulesv1.DeleteFirewallRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteFirewallRulesWithContext", ctx, deleteFirewallRulesOptions)
+	if mock.DeleteFirewallRulesWithContextFunc != nil {
+		return mock.DeleteFirewallRulesWithContextFunc(ctx, deleteFirewallRulesOptions)
+	}
+	if mock.DeleteFirewallRulesFunc != nil {
+		return mock.DeleteFirewallRulesFunc(deleteFirewallRulesOptions)
+	}
+	err = notProgrammed("DeleteFirewallRulesWithContext")
+	return
+}
+
+// DeleteFirewallRule invokes the programmed DeleteFirewallRuleFunc.
+func (mock *FirewallRulesV1) DeleteFirewallRule(deleteFirewallRuleOptions *firewallrulesv1.DeleteFirewallRuleOptions) (result *firewallrulesv1.DeleteFirewallRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteFirewallRule", deleteFirewallRuleOptions)
+	if mock.DeleteFirewallRuleFunc != nil {
+		return mock.DeleteFirewallRuleFunc(deleteFirewallRuleOptions)
+	}
+	if mock.DeleteFirewallRuleWithContextFunc != nil {
+		return mock.DeleteFirewallRuleWithContextFunc(context.Background(), deleteFirewallRuleOptions)
+	}
+	err = notProgrammed("DeleteFirewallRule")
+	return
+}
+
+// DeleteFirewallRuleWithContext invokes the programmed DeleteFirewallRuleWithContextFunc.
+func (mock *FirewallRulesV1) DeleteFirewallRuleWithContext(ctx context.Context, deleteFirewallRuleOptions *firewallrulesv1.DeleteFirewallRuleOptions) (result *firewallrulesv1.DeleteFirewallRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteFirewallRuleWithContext", ctx, deleteFirewallRuleOptions)
+	if mock.DeleteFirewallRuleWithContextFunc != nil {
+		return mock.DeleteFirewallRuleWithContextFunc(ctx, deleteFirewallRuleOptions)
+	}
+	if mock.DeleteFirewallRuleFunc != nil {
+		return mock.DeleteFirewallRuleFunc(deleteFirewallRuleOptions)
+	}
+	err = notProgrammed("DeleteFirewallRuleWithContext")
+	return
+}
+
+// GetFirewallRule invokes the programmed GetFirewallRuleFunc.
+func (mock *FirewallRulesV1) GetFirewallRule(getFirewallRuleOptions *firewallrulesv1.GetFirewallRuleOptions) (result *firewallrulesv1.FirewallRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("GetFirewallRule", getFirewallRuleOptions)
+	if mock.GetFirewallRuleFunc != nil {
+		return mock.GetFirewallRuleFunc(getFirewallRuleOptions)
+	}
+	if mock.GetFirewallRuleWithContextFunc != nil {
+		return mock.GetFirewallRuleWithContextFunc(context.Background(), getFirewallRuleOptions)
+	}
+	err = notProgrammed("GetFirewallRule")
+	return
+}
+
+// GetFirewallRuleWithContext invokes the programmed GetFirewallRuleWithContextFunc.
+func (mock *FirewallRulesV1) GetFirewallRuleWithContext(ctx context.Context, getFirewallRuleOptions *firewallrulesv1.GetFirewallRuleOptions) (result *firewallrulesv1.FirewallRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("GetFirewallRuleWithContext", ctx, getFirewallRuleOptions)
+	if mock.GetFirewallRuleWithContextFunc != nil {
+		return mock.GetFirewallRuleWithContextFunc(ctx, getFirewallRuleOptions)
+	}
+	if mock.GetFirewallRuleFunc != nil {
+		return mock.GetFirewallRuleFunc(getFirewallRuleOptions)
+	}
+	err = notProgrammed("GetFirewallRuleWithContext")
+	return
+}
+
+// UpdateFirewallRule invokes the programmed UpdateFirewallRuleFunc.
+func (mock *FirewallRulesV1) UpdateFirewallRule(updateFirewallRuleOptions *firewallrulesv1.UpdateFirewallRuleOptions) (result *firewallrulesv1.FirewallRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateFirewallRule", updateFirewallRuleOptions)
+	if mock.UpdateFirewallRuleFunc != nil {
+		return mock.UpdateFirewallRuleFunc(updateFirewallRuleOptions)
+	}
+	if mock.UpdateFirewallRuleWithContextFunc != nil {
+		return mock.UpdateFirewallRuleWithContextFunc(context.Background(), updateFirewallRuleOptions)
+	}
+	err = notProgrammed("UpdateFirewallRule")
+	return
+}
+
+// UpdateFirewallRuleWithContext invokes the programmed UpdateFirewallRuleWithContextFunc.
+func (mock *FirewallRulesV1) UpdateFirewallRuleWithContext(ctx context.Context, updateFirewallRuleOptions *firewallrulesv1.UpdateFirewallRuleOptions) (result *firewallrulesv1.FirewallRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateFirewallRuleWithContext", ctx, updateFirewallRuleOptions)
+	if mock.UpdateFirewallRuleWithContextFunc != nil {
+		return mock.UpdateFirewallRuleWithContextFunc(ctx, updateFirewallRuleOptions)
+	}
+	if mock.UpdateFirewallRuleFunc != nil {
+		return mock.UpdateFirewallRuleFunc(updateFirewallRuleOptions)
+	}
+	err = notProgrammed("UpdateFirewallRuleWithContext")
+	return
+}
+
+// CreateFirewallRulesWithFilters invokes the programmed CreateFirewallRulesWithFiltersFunc.
+func (mock *FirewallRulesV1) CreateFirewallRulesWithFilters(filters filtersv1.FiltersV1API, createFirewallRulesWithFiltersOptions *firewallrulesv1.CreateFirewallRulesWithFiltersOptions) (result *firewallrulesv1.FirewallRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateFirewallRulesWithFilters", filters, createFirewallRulesWithFiltersOptions)
+	if mock.CreateFirewallRulesWithFiltersFunc != nil {
+		return mock.CreateFirewallRulesWithFiltersFunc(filters, createFirewallRulesWithFiltersOptions)
+	}
+	if mock.CreateFirewallRulesWithFiltersWithContextFunc != nil {
+		return mock.CreateFirewallRulesWithFiltersWithContextFunc(context.Background(), filters, createFirewallRulesWithFiltersOptions)
+	}
+	err = notProgrammed("CreateFirewallRulesWithFilters")
+	return
+}
+
+// CreateFirewallRulesWithFiltersWithContext invokes the programmed CreateFirewallRulesWithFiltersWithContextFunc.
+func (mock *FirewallRulesV1) CreateFirewallRulesWithFiltersWithContext(ctx context.Context, filters filtersv1.FiltersV1API, createFirewallRulesWithFiltersOptions *firewallrulesv1.CreateFirewallRulesWithFiltersOptions) (result *firewallrulesv1.FirewallRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateFirewallRulesWithFiltersWithContext", ctx, filters, createFirewallRulesWithFiltersOptions)
+	if mock.CreateFirewallRulesWithFiltersWithContextFunc != nil {
+		return mock.CreateFirewallRulesWithFiltersWithContextFunc(ctx, filters, createFirewallRulesWithFiltersOptions)
+	}
+	if mock.CreateFirewallRulesWithFiltersFunc != nil {
+		return mock.CreateFirewallRulesWithFiltersFunc(filters, createFirewallRulesWithFiltersOptions)
+	}
+	err = notProgrammed("CreateFirewallRulesWithFiltersWithContext")
+	return
+}
+
+// UpdateFirewallRuleWithFilter invokes the programmed UpdateFirewallRuleWithFilterFunc.
+func (mock *FirewallRulesV1) UpdateFirewallRuleWithFilter(filters filtersv1.FiltersV1API, updateFirewallRuleWithFilterOptions *firewallrulesv1.UpdateFirewallRuleWithFilterOptions) (result *firewallrulesv1.FirewallRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateFirewallRuleWithFilter", filters, updateFirewallRuleWithFilterOptions)
+	if mock.UpdateFirewallRuleWithFilterFunc != nil {
+		return mock.UpdateFirewallRuleWithFilterFunc(filters, updateFirewallRuleWithFilterOptions)
+	}
+	if mock.UpdateFirewallRuleWithFilterWithContextFunc != nil {
+		return mock.UpdateFirewallRuleWithFilterWithContextFunc(context.Background(), filters, updateFirewallRuleWithFilterOptions)
+	}
+	err = notProgrammed("UpdateFirewallRuleWithFilter")
+	return
+}
+
+// UpdateFirewallRuleWithFilterWithContext invokes the programmed UpdateFirewallRuleWithFilterWithContextFunc.
+func (mock *FirewallRulesV1) UpdateFirewallRuleWithFilterWithContext(ctx context.Context, filters filtersv1.FiltersV1API, updateFirewallRuleWithFilterOptions *firewallrulesv1.UpdateFirewallRuleWithFilterOptions) (result *firewallrulesv1.FirewallRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateFirewallRuleWithFilterWithContext", ctx, filters, updateFirewallRuleWithFilterOptions)
+	if mock.UpdateFirewallRuleWithFilterWithContextFunc != nil {
+		return mock.UpdateFirewallRuleWithFilterWithContextFunc(ctx, filters, updateFirewallRuleWithFilterOptions)
+	}
+	if mock.UpdateFirewallRuleWithFilterFunc != nil {
+		return mock.UpdateFirewallRuleWithFilterFunc(filters, updateFirewallRuleWithFilterOptions)
+	}
+	err = notProgrammed("UpdateFirewallRuleWithFilterWithContext")
+	return
+}
+
+// DeleteFirewallRuleWithFilter invokes the programmed DeleteFirewallRuleWithFilterFunc.
+func (mock *FirewallRulesV1) DeleteFirewallRuleWithFilter(filters filtersv1.FiltersV1API, deleteFirewallRuleWithFilterOptions *firewallrulesv1.DeleteFirewallRuleWithFilterOptions) (result *firewallrulesv1.DeleteFirewallRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteFirewallRuleWithFilter", filters, deleteFirewallRuleWithFilterOptions)
+	if mock.DeleteFirewallRuleWithFilterFunc != nil {
+		return mock.DeleteFirewallRuleWithFilterFunc(filters, deleteFirewallRuleWithFilterOptions)
+	}
+	if mock.DeleteFirewallRuleWithFilterWithContextFunc != nil {
+		return mock.DeleteFirewallRuleWithFilterWithContextFunc(context.Background(), filters, deleteFirewallRuleWithFilterOptions)
+	}
+	err = notProgrammed("DeleteFirewallRuleWithFilter")
+	return
+}
+
+// DeleteFirewallRuleWithFilterWithContext invokes the programmed DeleteFirewallRuleWithFilterWithContextFunc.
+func (mock *FirewallRulesV1) DeleteFirewallRuleWithFilterWithContext(ctx context.Context, filters filtersv1.FiltersV1API, deleteFirewallRuleWithFilterOptions *firewallrulesv1.DeleteFirewallRuleWithFilterOptions) (result *firewallrulesv1.DeleteFirewallRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteFirewallRuleWithFilterWithContext", ctx, filters, deleteFirewallRuleWithFilterOptions)
+	if mock.DeleteFirewallRuleWithFilterWithContextFunc != nil {
+		return mock.DeleteFirewallRuleWithFilterWithContextFunc(ctx, filters, deleteFirewallRuleWithFilterOptions)
+	}
+	if mock.DeleteFirewallRuleWithFilterFunc != nil {
+		return mock.DeleteFirewallRuleWithFilterFunc(filters, deleteFirewallRuleWithFilterOptions)
+	}
+	err = notProgrammed("DeleteFirewallRuleWithFilterWithContext")
+	return
+}
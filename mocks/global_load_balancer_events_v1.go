@@ -0,0 +1,62 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/globalloadbalancereventsv1"
+)
+
+// GlobalLoadBalancerEventsV1 is a mock of globalloadbalancereventsv1.GlobalLoadBalancerEventsV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type GlobalLoadBalancerEventsV1 struct {
+	Mock
+
+	GetLoadBalancerEventsFunc            func(*globalloadbalancereventsv1.GetLoadBalancerEventsOptions) (*globalloadbalancereventsv1.ListEventsResp, *core.DetailedResponse, error)
+	GetLoadBalancerEventsWithContextFunc func(context.Context, *globalloadbalancereventsv1.GetLoadBalancerEventsOptions) (*globalloadbalancereventsv1.ListEventsResp, *core.DetailedResponse, error)
+}
+
+// GlobalLoadBalancerEventsV1 implements globalloadbalancereventsv1.GlobalLoadBalancerEventsV1API.
+var _ globalloadbalancereventsv1.GlobalLoadBalancerEventsV1API = (*GlobalLoadBalancerEventsV1)(nil)
+
+// GetLoadBalancerEvents invokes the programmed GetLoadBalancerEventsFunc.
+func (mock *GlobalLoadBalancerEventsV1) GetLoadBalancerEvents(getLoadBalancerEventsOptions *globalloadbalancereventsv1.GetLoadBalancerEventsOptions) (result *globalloadbalancereventsv1.ListEventsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancerEvents", getLoadBalancerEventsOptions)
+	if mock.GetLoadBalancerEventsFunc != nil {
+		return mock.GetLoadBalancerEventsFunc(getLoadBalancerEventsOptions)
+	}
+	if mock.GetLoadBalancerEventsWithContextFunc != nil {
+		return mock.GetLoadBalancerEventsWithContextFunc(context.Background(), getLoadBalancerEventsOptions)
+	}
+	err = notProgrammed("GetLoadBalancerEvents")
+	return
+}
+
+// GetLoadBalancerEventsWithContext invokes the programmed GetLoadBalancerEventsWithContextFunc.
+func (mock *GlobalLoadBalancerEventsV1) GetLoadBalancerEventsWithContext(ctx context.Context, getLoadBalancerEventsOptions *globalloadbalancereventsv1.GetLoadBalancerEventsOptions) (result *globalloadbalancereventsv1.ListEventsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancerEventsWithContext", ctx, getLoadBalancerEventsOptions)
+	if mock.GetLoadBalancerEventsWithContextFunc != nil {
+		return mock.GetLoadBalancerEventsWithContextFunc(ctx, getLoadBalancerEventsOptions)
+	}
+	if mock.GetLoadBalancerEventsFunc != nil {
+		return mock.GetLoadBalancerEventsFunc(getLoadBalancerEventsOptions)
+	}
+	err = notProgrammed("GetLoadBalancerEventsWithContext")
+	return
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/globalloadbalancermonitorv1"
+)
+
+// GlobalLoadBalancerMonitorV1 is a mock of globalloadbalancermonitorv1.GlobalLoadBalancerMonitorV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type GlobalLoadBalancerMonitorV1 struct {
+	Mock
+
+	ListAllLoadBalancerMonitorsFunc            func(*globalloadbalancermonitorv1.ListAllLoadBalancerMonitorsOptions) (*globalloadbalancermonitorv1.ListMonitorResp, *core.DetailedResponse, error)
+	ListAllLoadBalancerMonitorsWithContextFunc func(context.Context, *globalloadbalancermonitorv1.ListAllLoadBalancerMonitorsOptions) (*globalloadbalancermonitorv1.ListMonitorResp, *core.DetailedResponse, error)
+	CreateLoadBalancerMonitorFunc              func(*globalloadbalancermonitorv1.CreateLoadBalancerMonitorOptions) (*globalloadbalancermonitorv1.MonitorResp, *core.DetailedResponse, error)
+	CreateLoadBalancerMonitorWithContextFunc   func(context.Context, *globalloadbalancermonitorv1.CreateLoadBalancerMonitorOptions) (*globalloadbalancermonitorv1.MonitorResp, *core.DetailedResponse, error)
+	EditLoadBalancerMonitorFunc                func(*globalloadbalancermonitorv1.EditLoadBalancerMonitorOptions) (*globalloadbalancermonitorv1.MonitorResp, *core.DetailedResponse, error)
+	EditLoadBalancerMonitorWithContextFunc     func(context.Context, *globalloadbalancermonitorv1.EditLoadBalancerMonitorOptions) (*globalloadbalancermonitorv1.MonitorResp, *core.DetailedResponse, error)
+	DeleteLoadBalancerMonitorFunc              func(*globalloadbalancermonitorv1.DeleteLoadBalancerMonitorOptions) (*globalloadbalancermonitorv1.DeleteMonitorResp, *core.DetailedResponse, error)
+	DeleteLoadBalancerMonitorWithContextFunc   func(context.Context, *globalloadbalancermonitorv1.DeleteLoadBalancerMonitorOptions) (*globalloadbalancermonitorv1.DeleteMonitorResp, *core.DetailedResponse, error)
+	GetLoadBalancerMonitorFunc                 func(*globalloadbalancermonitorv1.GetLoadBalancerMonitorOptions) (*globalloadbalancermonitorv1.MonitorResp, *core.DetailedResponse, error)
+	GetLoadBalancerMonitorWithContextFunc      func(context.Context, *globalloadbalancermonitorv1.GetLoadBalancerMonitorOptions) (*globalloadbalancermonitorv1.MonitorResp, *core.DetailedResponse, error)
+}
+
+// GlobalLoadBalancerMonitorV1 implements globalloadbalancermonitorv1.GlobalLoadBalancerMonitorV1API.
+var _ globalloadbalancermonitorv1.GlobalLoadBalancerMonitorV1API = (*GlobalLoadBalancerMonitorV1)(nil)
+
+// ListAllLoadBalancerMonitors invokes the programmed ListAllLoadBalancerMonitorsFunc.
+func (mock *GlobalLoadBalancerMonitorV1) ListAllLoadBalancerMonitors(listAllLoadBalancerMonitorsOptions *globalloadbalancermonitorv1.ListAllLoadBalancerMonitorsOptions) (result *globalloadbalancermonitorv1.ListMonitorResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllLoadBalancerMonitors", listAllLoadBalancerMonitorsOptions)
+	if mock.ListAllLoadBalancerMonitorsFunc != nil {
+		return mock.ListAllLoadBalancerMonitorsFunc(listAllLoadBalancerMonitorsOptions)
+	}
+	if mock.ListAllLoadBalancerMonitorsWithContextFunc != nil {
+		return mock.ListAllLoadBalancerMonitorsWithContextFunc(context.Background(), listAllLoadBalancerMonitorsOptions)
+	}
+	err = notProgrammed("ListAllLoadBalancerMonitors")
+	return
+}
+
+// ListAllLoadBalancerMonitorsWithContext invokes the programmed ListAllLoadBalancerMonitorsWithContextFunc.
+func (mock *GlobalLoadBalancerMonitorV1) ListAllLoadBalancerMonitorsWithContext(ctx context.Context, listAllLoadBalancerMonitorsOptions *globalloadbalancermonitorv1.ListAllLoadBalancerMonitorsOptions) (result *globalloadbalancermonitorv1.ListMonitorResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllLoadBalancerMonitorsWithContext", ctx, listAllLoadBalancerMonitorsOptions)
+	if mock.ListAllLoadBalancerMonitorsWithContextFunc != nil {
+		return mock.ListAllLoadBalancerMonitorsWithContextFunc(ctx, listAllLoadBalancerMonitorsOptions)
+	}
+	if mock.ListAllLoadBalancerMonitorsFunc != nil {
+		return mock.ListAllLoadBalancerMonitorsFunc(listAllLoadBalancerMonitorsOptions)
+	}
+	err = notProgrammed("ListAllLoadBalancerMonitorsWithContext")
+	return
+}
+
+// CreateLoadBalancerMonitor invokes the programmed CreateLoadBalancerMonitorFunc.
+func (mock *GlobalLoadBalancerMonitorV1) CreateLoadBalancerMonitor(createLoadBalancerMonitorOptions *globalloadbalancermonitorv1.CreateLoadBalancerMonitorOptions) (result *globalloadbalancermonitorv1.MonitorResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateLoadBalancerMonitor", createLoadBalancerMonitorOptions)
+	if mock.CreateLoadBalancerMonitorFunc != nil {
+		return mock.CreateLoadBalancerMonitorFunc(createLoadBalancerMonitorOptions)
+	}
+	if mock.CreateLoadBalancerMonitorWithContextFunc != nil {
+		return mock.CreateLoadBalancerMonitorWithContextFunc(context.Background(), createLoadBalancerMonitorOptions)
+	}
+	err = notProgrammed("CreateLoadBalancerMonitor")
+	return
+}
+
+// CreateLoadBalancerMonitorWithContext invokes the programmed CreateLoadBalancerMonitorWithContextFunc.
+func (mock *GlobalLoadBalancerMonitorV1) CreateLoadBalancerMonitorWithContext(ctx context.Context, createLoadBalancerMonitorOptions *globalloadbalancermonitorv1.CreateLoadBalancerMonitorOptions) (result *globalloadbalancermonitorv1.MonitorResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateLoadBalancerMonitorWithContext", ctx, createLoadBalancerMonitorOptions)
+	if mock.CreateLoadBalancerMonitorWithContextFunc != nil {
+		return mock.CreateLoadBalancerMonitorWithContextFunc(ctx, createLoadBalancerMonitorOptions)
+	}
+	if mock.CreateLoadBalancerMonitorFunc != nil {
+		return mock.CreateLoadBalancerMonitorFunc(createLoadBalancerMonitorOptions)
+	}
+	err = notProgrammed("CreateLoadBalancerMonitorWithContext")
+	return
+}
+
+// EditLoadBalancerMonitor invokes the programmed EditLoadBalancerMonitorFunc.
+func (mock *GlobalLoadBalancerMonitorV1) EditLoadBalancerMonitor(editLoadBalancerMonitorOptions *globalloadbalancermonitorv1.EditLoadBalancerMonitorOptions) (result *globalloadbalancermonitorv1.MonitorResp, response *core.DetailedResponse, err error) {
+	mock.called("EditLoadBalancerMonitor", editLoadBalancerMonitorOptions)
+	if mock.EditLoadBalancerMonitorFunc != nil {
+		return mock.EditLoadBalancerMonitorFunc(editLoadBalancerMonitorOptions)
+	}
+	if mock.EditLoadBalancerMonitorWithContextFunc != nil {
+		return mock.EditLoadBalancerMonitorWithContextFunc(context.Background(), editLoadBalancerMonitorOptions)
+	}
+	err = notProgrammed("EditLoadBalancerMonitor")
+	return
+}
+
+// EditLoadBalancerMonitorWithContext invokes the programmed EditLoadBalancerMonitorWithContextFunc.
+func (mock *GlobalLoadBalancerMonitorV1) EditLoadBalancerMonitorWithContext(ctx context.Context, editLoadBalancerMonitorOptions *globalloadbalancermonitorv1.EditLoadBalancerMonitorOptions) (result *globalloadbalancermonitorv1.MonitorResp, response *core.DetailedResponse, err error) {
+	mock.called("EditLoadBalancerMonitorWithContext", ctx, editLoadBalancerMonitorOptions)
+	if mock.EditLoadBalancerMonitorWithContextFunc != nil {
+		return mock.EditLoadBalancerMonitorWithContextFunc(ctx, editLoadBalancerMonitorOptions)
+	}
+	if mock.EditLoadBalancerMonitorFunc != nil {
+		return mock.EditLoadBalancerMonitorFunc(editLoadBalancerMonitorOptions)
+	}
+	err = notProgrammed("EditLoadBalancerMonitorWithContext")
+	return
+}
+
+// DeleteLoadBalancerMonitor invokes the programmed DeleteLoadBalancerMonitorFunc.
+func (mock *GlobalLoadBalancerMonitorV1) DeleteLoadBalancerMonitor(deleteLoadBalancerMonitorOptions *globalloadbalancermonitorv1.DeleteLoadBalancerMonitorOptions) (result *globalloadbalancermonitorv1.DeleteMonitorResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLoadBalancerMonitor", deleteLoadBalancerMonitorOptions)
+	if mock.DeleteLoadBalancerMonitorFunc != nil {
+		return mock.DeleteLoadBalancerMonitorFunc(deleteLoadBalancerMonitorOptions)
+	}
+	if mock.DeleteLoadBalancerMonitorWithContextFunc != nil {
+		return mock.DeleteLoadBalancerMonitorWithContextFunc(context.Background(), deleteLoadBalancerMonitorOptions)
+	}
+	err = notProgrammed("DeleteLoadBalancerMonitor")
+	return
+}
+
+// DeleteLoadBalancerMonitorWithContext invokes the programmed DeleteLoadBalancerMonitorWithContextFunc.
+func (mock *GlobalLoadBalancerMonitorV1) DeleteLoadBalancerMonitorWithContext(ctx context.Context, deleteLoadBalancerMonitorOptions *globalloadbalancermonitorv1.DeleteLoadBalancerMonitorOptions) (result *globalloadbalancermonitorv1.DeleteMonitorResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLoadBalancerMonitorWithContext", ctx, deleteLoadBalancerMonitorOptions)
+	if mock.DeleteLoadBalancerMonitorWithContextFunc != nil {
+		return mock.DeleteLoadBalancerMonitorWithContextFunc(ctx, deleteLoadBalancerMonitorOptions)
+	}
+	if mock.DeleteLoadBalancerMonitorFunc != nil {
+		return mock.DeleteLoadBalancerMonitorFunc(deleteLoadBalancerMonitorOptions)
+	}
+	err = notProgrammed("DeleteLoadBalancerMonitorWithContext")
+	return
+}
+
+// GetLoadBalancerMonitor invokes the programmed GetLoadBalancerMonitorFunc.
+func (mock *GlobalLoadBalancerMonitorV1) GetLoadBalancerMonitor(getLoadBalancerMonitorOptions *globalloadbalancermonitorv1.GetLoadBalancerMonitorOptions) (result *globalloadbalancermonitorv1.MonitorResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancerMonitor", getLoadBalancerMonitorOptions)
+	if mock.GetLoadBalancerMonitorFunc != nil {
+		return mock.GetLoadBalancerMonitorFunc(getLoadBalancerMonitorOptions)
+	}
+	if mock.GetLoadBalancerMonitorWithContextFunc != nil {
+		return mock.GetLoadBalancerMonitorWithContextFunc(context.Background(), getLoadBalancerMonitorOptions)
+	}
+	err = notProgrammed("GetLoadBalancerMonitor")
+	return
+}
+
+// GetLoadBalancerMonitorWithContext invokes the programmed GetLoadBalancerMonitorWithContextFunc.
+func (mock *GlobalLoadBalancerMonitorV1) GetLoadBalancerMonitorWithContext(ctx context.Context, getLoadBalancerMonitorOptions *globalloadbalancermonitorv1.GetLoadBalancerMonitorOptions) (result *globalloadbalancermonitorv1.MonitorResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancerMonitorWithContext", ctx, getLoadBalancerMonitorOptions)
+	if mock.GetLoadBalancerMonitorWithContextFunc != nil {
+		return mock.GetLoadBalancerMonitorWithContextFunc(ctx, getLoadBalancerMonitorOptions)
+	}
+	if mock.GetLoadBalancerMonitorFunc != nil {
+		return mock.GetLoadBalancerMonitorFunc(getLoadBalancerMonitorOptions)
+	}
+	err = notProgrammed("GetLoadBalancerMonitorWithContext")
+	return
+}
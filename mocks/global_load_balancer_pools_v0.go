@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/globalloadbalancerpoolsv0"
+)
+
+// GlobalLoadBalancerPoolsV0 is a mock of globalloadbalancerpoolsv0.GlobalLoadBalancerPoolsV0API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type GlobalLoadBalancerPoolsV0 struct {
+	Mock
+
+	ListAllLoadBalancerPoolsFunc            func(*globalloadbalancerpoolsv0.ListAllLoadBalancerPoolsOptions) (*globalloadbalancerpoolsv0.ListLoadBalancerPoolsResp, *core.DetailedResponse, error)
+	ListAllLoadBalancerPoolsWithContextFunc func(context.Context, *globalloadbalancerpoolsv0.ListAllLoadBalancerPoolsOptions) (*globalloadbalancerpoolsv0.ListLoadBalancerPoolsResp, *core.DetailedResponse, error)
+	CreateLoadBalancerPoolFunc              func(*globalloadbalancerpoolsv0.CreateLoadBalancerPoolOptions) (*globalloadbalancerpoolsv0.LoadBalancerPoolResp, *core.DetailedResponse, error)
+	CreateLoadBalancerPoolWithContextFunc   func(context.Context, *globalloadbalancerpoolsv0.CreateLoadBalancerPoolOptions) (*globalloadbalancerpoolsv0.LoadBalancerPoolResp, *core.DetailedResponse, error)
+	GetLoadBalancerPoolFunc                 func(*globalloadbalancerpoolsv0.GetLoadBalancerPoolOptions) (*globalloadbalancerpoolsv0.LoadBalancerPoolResp, *core.DetailedResponse, error)
+	GetLoadBalancerPoolWithContextFunc      func(context.Context, *globalloadbalancerpoolsv0.GetLoadBalancerPoolOptions) (*globalloadbalancerpoolsv0.LoadBalancerPoolResp, *core.DetailedResponse, error)
+	DeleteLoadBalancerPoolFunc              func(*globalloadbalancerpoolsv0.DeleteLoadBalancerPoolOptions) (*globalloadbalancerpoolsv0.DeleteLoadBalancerPoolResp, *core.DetailedResponse, error)
+	DeleteLoadBalancerPoolWithContextFunc   func(context.Context, *globalloadbalancerpoolsv0.DeleteLoadBalancerPoolOptions) (*globalloadbalancerpoolsv0.DeleteLoadBalancerPoolResp, *core.DetailedResponse, error)
+	EditLoadBalancerPoolFunc                func(*globalloadbalancerpoolsv0.EditLoadBalancerPoolOptions) (*globalloadbalancerpoolsv0.LoadBalancerPoolResp, *core.DetailedResponse, error)
+	EditLoadBalancerPoolWithContextFunc     func(context.Context, *globalloadbalancerpoolsv0.EditLoadBalancerPoolOptions) (*globalloadbalancerpoolsv0.LoadBalancerPoolResp, *core.DetailedResponse, error)
+}
+
+// GlobalLoadBalancerPoolsV0 implements globalloadbalancerpoolsv0.GlobalLoadBalancerPoolsV0API.
+var _ globalloadbalancerpoolsv0.GlobalLoadBalancerPoolsV0API = (*GlobalLoadBalancerPoolsV0)(nil)
+
+// ListAllLoadBalancerPools invokes the programmed ListAllLoadBalancerPoolsFunc.
+func (mock *GlobalLoadBalancerPoolsV0) ListAllLoadBalancerPools(listAllLoadBalancerPoolsOptions *globalloadbalancerpoolsv0.ListAllLoadBalancerPoolsOptions) (result *globalloadbalancerpoolsv0.ListLoadBalancerPoolsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllLoadBalancerPools", listAllLoadBalancerPoolsOptions)
+	if mock.ListAllLoadBalancerPoolsFunc != nil {
+		return mock.ListAllLoadBalancerPoolsFunc(listAllLoadBalancerPoolsOptions)
+	}
+	if mock.ListAllLoadBalancerPoolsWithContextFunc != nil {
+		return mock.ListAllLoadBalancerPoolsWithContextFunc(context.Background(), listAllLoadBalancerPoolsOptions)
+	}
+	err = notProgrammed("ListAllLoadBalancerPools")
+	return
+}
+
+// ListAllLoadBalancerPoolsWithContext invokes the programmed ListAllLoadBalancerPoolsWithContextFunc.
+func (mock *GlobalLoadBalancerPoolsV0) ListAllLoadBalancerPoolsWithContext(ctx context.Context, listAllLoadBalancerPoolsOptions *globalloadbalancerpoolsv0.ListAllLoadBalancerPoolsOptions) (result *globalloadbalancerpoolsv0.ListLoadBalancerPoolsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllLoadBalancerPoolsWithContext", ctx, listAllLoadBalancerPoolsOptions)
+	if mock.ListAllLoadBalancerPoolsWithContextFunc != nil {
+		return mock.ListAllLoadBalancerPoolsWithContextFunc(ctx, listAllLoadBalancerPoolsOptions)
+	}
+	if mock.ListAllLoadBalancerPoolsFunc != nil {
+		return mock.ListAllLoadBalancerPoolsFunc(listAllLoadBalancerPoolsOptions)
+	}
+	err = notProgrammed("ListAllLoadBalancerPoolsWithContext")
+	return
+}
+
+// CreateLoadBalancerPool invokes the programmed CreateLoadBalancerPoolFunc.
+func (mock *GlobalLoadBalancerPoolsV0) CreateLoadBalancerPool(createLoadBalancerPoolOptions *globalloadbalancerpoolsv0.CreateLoadBalancerPoolOptions) (result *globalloadbalancerpoolsv0.LoadBalancerPoolResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateLoadBalancerPool", createLoadBalancerPoolOptions)
+	if mock.CreateLoadBalancerPoolFunc != nil {
+		return mock.CreateLoadBalancerPoolFunc(createLoadBalancerPoolOptions)
+	}
+	if mock.CreateLoadBalancerPoolWithContextFunc != nil {
+		return mock.CreateLoadBalancerPoolWithContextFunc(context.Background(), createLoadBalancerPoolOptions)
+	}
+	err = notProgrammed("CreateLoadBalancerPool")
+	return
+}
+
+// CreateLoadBalancerPoolWithContext invokes the programmed CreateLoadBalancerPoolWithContextFunc.
+func (mock *GlobalLoadBalancerPoolsV0) CreateLoadBalancerPoolWithContext(ctx context.Context, createLoadBalancerPoolOptions *globalloadbalancerpoolsv0.CreateLoadBalancerPoolOptions) (result *globalloadbalancerpoolsv0.LoadBalancerPoolResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateLoadBalancerPoolWithContext", ctx, createLoadBalancerPoolOptions)
+	if mock.CreateLoadBalancerPoolWithContextFunc != nil {
+		return mock.CreateLoadBalancerPoolWithContextFunc(ctx, createLoadBalancerPoolOptions)
+	}
+	if mock.CreateLoadBalancerPoolFunc != nil {
+		return mock.CreateLoadBalancerPoolFunc(createLoadBalancerPoolOptions)
+	}
+	err = notProgrammed("CreateLoadBalancerPoolWithContext")
+	return
+}
+
+// GetLoadBalancerPool invokes the programmed GetLoadBalancerPoolFunc.
+func (mock *GlobalLoadBalancerPoolsV0) GetLoadBalancerPool(getLoadBalancerPoolOptions *globalloadbalancerpoolsv0.GetLoadBalancerPoolOptions) (result *globalloadbalancerpoolsv0.LoadBalancerPoolResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancerPool", getLoadBalancerPoolOptions)
+	if mock.GetLoadBalancerPoolFunc != nil {
+		return mock.GetLoadBalancerPoolFunc(getLoadBalancerPoolOptions)
+	}
+	if mock.GetLoadBalancerPoolWithContextFunc != nil {
+		return mock.GetLoadBalancerPoolWithContextFunc(context.Background(), getLoadBalancerPoolOptions)
+	}
+	err = notProgrammed("GetLoadBalancerPool")
+	return
+}
+
+// GetLoadBalancerPoolWithContext invokes the programmed GetLoadBalancerPoolWithContextFunc.
+func (mock *GlobalLoadBalancerPoolsV0) GetLoadBalancerPoolWithContext(ctx context.Context, getLoadBalancerPoolOptions *globalloadbalancerpoolsv0.GetLoadBalancerPoolOptions) (result *globalloadbalancerpoolsv0.LoadBalancerPoolResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancerPoolWithContext", ctx, getLoadBalancerPoolOptions)
+	if mock.GetLoadBalancerPoolWithContextFunc != nil {
+		return mock.GetLoadBalancerPoolWithContextFunc(ctx, getLoadBalancerPoolOptions)
+	}
+	if mock.GetLoadBalancerPoolFunc != nil {
+		return mock.GetLoadBalancerPoolFunc(getLoadBalancerPoolOptions)
+	}
+	err = notProgrammed("GetLoadBalancerPoolWithContext")
+	return
+}
+
+// DeleteLoadBalancerPool invokes the programmed DeleteLoadBalancerPoolFunc.
+func (mock *GlobalLoadBalancerPoolsV0) DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions *globalloadbalancerpoolsv0.DeleteLoadBalancerPoolOptions) (result *globalloadbalancerpoolsv0.DeleteLoadBalancerPoolResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLoadBalancerPool", deleteLoadBalancerPoolOptions)
+	if mock.DeleteLoadBalancerPoolFunc != nil {
+		return mock.DeleteLoadBalancerPoolFunc(deleteLoadBalancerPoolOptions)
+	}
+	if mock.DeleteLoadBalancerPoolWithContextFunc != nil {
+		return mock.DeleteLoadBalancerPoolWithContextFunc(context.Background(), deleteLoadBalancerPoolOptions)
+	}
+	err = notProgrammed("DeleteLoadBalancerPool")
+	return
+}
+
+// DeleteLoadBalancerPoolWithContext invokes the programmed DeleteLoadBalancerPoolWithContextFunc.
+func (mock *GlobalLoadBalancerPoolsV0) DeleteLoadBalancerPoolWithContext(ctx context.Context, deleteLoadBalancerPoolOptions *globalloadbalancerpoolsv0.DeleteLoadBalancerPoolOptions) (result *globalloadbalancerpoolsv0.DeleteLoadBalancerPoolResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLoadBalancerPoolWithContext", ctx, deleteLoadBalancerPoolOptions)
+	if mock.DeleteLoadBalancerPoolWithContextFunc != nil {
+		return mock.DeleteLoadBalancerPoolWithContextFunc(ctx, deleteLoadBalancerPoolOptions)
+	}
+	if mock.DeleteLoadBalancerPoolFunc != nil {
+		return mock.DeleteLoadBalancerPoolFunc(deleteLoadBalancerPoolOptions)
+	}
+	err = notProgrammed("DeleteLoadBalancerPoolWithContext")
+	return
+}
+
+// EditLoadBalancerPool invokes the programmed EditLoadBalancerPoolFunc.
+func (mock *GlobalLoadBalancerPoolsV0) EditLoadBalancerPool(editLoadBalancerPoolOptions *globalloadbalancerpoolsv0.EditLoadBalancerPoolOptions) (result *globalloadbalancerpoolsv0.LoadBalancerPoolResp, response *core.DetailedResponse, err error) {
+	mock.called("EditLoadBalancerPool", editLoadBalancerPoolOptions)
+	if mock.EditLoadBalancerPoolFunc != nil {
+		return mock.EditLoadBalancerPoolFunc(editLoadBalancerPoolOptions)
+	}
+	if mock.EditLoadBalancerPoolWithContextFunc != nil {
+		return mock.EditLoadBalancerPoolWithContextFunc(context.Background(), editLoadBalancerPoolOptions)
+	}
+	err = notProgrammed("EditLoadBalancerPool")
+	return
+}
+
+// EditLoadBalancerPoolWithContext invokes the programmed EditLoadBalancerPoolWithContextFunc.
+func (mock *GlobalLoadBalancerPoolsV0) EditLoadBalancerPoolWithContext(ctx context.Context, editLoadBalancerPoolOptions *globalloadbalancerpoolsv0.EditLoadBalancerPoolOptions) (result *globalloadbalancerpoolsv0.LoadBalancerPoolResp, response *core.DetailedResponse, err error) {
+	mock.called("EditLoadBalancerPoolWithContext", ctx, editLoadBalancerPoolOptions)
+	if mock.EditLoadBalancerPoolWithContextFunc != nil {
+		return mock.EditLoadBalancerPoolWithContextFunc(ctx, editLoadBalancerPoolOptions)
+	}
+	if mock.EditLoadBalancerPoolFunc != nil {
+		return mock.EditLoadBalancerPoolFunc(editLoadBalancerPoolOptions)
+	}
+	err = notProgrammed("EditLoadBalancerPoolWithContext")
+	return
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/globalloadbalancerv1"
+)
+
+// GlobalLoadBalancerV1 is a mock of globalloadbalancerv1.GlobalLoadBalancerV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type GlobalLoadBalancerV1 struct {
+	Mock
+
+	ListAllLoadBalancersFunc               func(*globalloadbalancerv1.ListAllLoadBalancersOptions) (*globalloadbalancerv1.ListLoadBalancersResp, *core.DetailedResponse, error)
+	ListAllLoadBalancersWithContextFunc    func(context.Context, *globalloadbalancerv1.ListAllLoadBalancersOptions) (*globalloadbalancerv1.ListLoadBalancersResp, *core.DetailedResponse, error)
+	CreateLoadBalancerFunc                 func(*globalloadbalancerv1.CreateLoadBalancerOptions) (*globalloadbalancerv1.LoadBalancersResp, *core.DetailedResponse, error)
+	CreateLoadBalancerWithContextFunc      func(context.Context, *globalloadbalancerv1.CreateLoadBalancerOptions) (*globalloadbalancerv1.LoadBalancersResp, *core.DetailedResponse, error)
+	EditLoadBalancerFunc                   func(*globalloadbalancerv1.EditLoadBalancerOptions) (*globalloadbalancerv1.LoadBalancersResp, *core.DetailedResponse, error)
+	EditLoadBalancerWithContextFunc        func(context.Context, *globalloadbalancerv1.EditLoadBalancerOptions) (*globalloadbalancerv1.LoadBalancersResp, *core.DetailedResponse, error)
+	DeleteLoadBalancerFunc                 func(*globalloadbalancerv1.DeleteLoadBalancerOptions) (*globalloadbalancerv1.DeleteLoadBalancersResp, *core.DetailedResponse, error)
+	DeleteLoadBalancerWithContextFunc      func(context.Context, *globalloadbalancerv1.DeleteLoadBalancerOptions) (*globalloadbalancerv1.DeleteLoadBalancersResp, *core.DetailedResponse, error)
+	GetLoadBalancerSettingsFunc            func(*globalloadbalancerv1.GetLoadBalancerSettingsOptions) (*globalloadbalancerv1.LoadBalancersResp, *core.DetailedResponse, error)
+	GetLoadBalancerSettingsWithContextFunc func(context.Context, *globalloadbalancerv1.GetLoadBalancerSettingsOptions) (*globalloadbalancerv1.LoadBalancersResp, *core.DetailedResponse, error)
+}
+
+// GlobalLoadBalancerV1 implements globalloadbalancerv1.GlobalLoadBalancerV1API.
+var _ globalloadbalancerv1.GlobalLoadBalancerV1API = (*GlobalLoadBalancerV1)(nil)
+
+// ListAllLoadBalancers invokes the programmed ListAllLoadBalancersFunc.
+func (mock *GlobalLoadBalancerV1) ListAllLoadBalancers(listAllLoadBalancersOptions *globalloadbalancerv1.ListAllLoadBalancersOptions) (result *globalloadbalancerv1.ListLoadBalancersResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllLoadBalancers", listAllLoadBalancersOptions)
+	if mock.ListAllLoadBalancersFunc != nil {
+		return mock.ListAllLoadBalancersFunc(listAllLoadBalancersOptions)
+	}
+	if mock.ListAllLoadBalancersWithContextFunc != nil {
+		return mock.ListAllLoadBalancersWithContextFunc(context.Background(), listAllLoadBalancersOptions)
+	}
+	err = notProgrammed("ListAllLoadBalancers")
+	return
+}
+
+// ListAllLoadBalancersWithContext invokes the programmed ListAllLoadBalancersWithContextFunc.
+func (mock *GlobalLoadBalancerV1) ListAllLoadBalancersWithContext(ctx context.Context, listAllLoadBalancersOptions *globalloadbalancerv1.ListAllLoadBalancersOptions) (result *globalloadbalancerv1.ListLoadBalancersResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllLoadBalancersWithContext", ctx, listAllLoadBalancersOptions)
+	if mock.ListAllLoadBalancersWithContextFunc != nil {
+		return mock.ListAllLoadBalancersWithContextFunc(ctx, listAllLoadBalancersOptions)
+	}
+	if mock.ListAllLoadBalancersFunc != nil {
+		return mock.ListAllLoadBalancersFunc(listAllLoadBalancersOptions)
+	}
+	err = notProgrammed("ListAllLoadBalancersWithContext")
+	return
+}
+
+// CreateLoadBalancer invokes the programmed CreateLoadBalancerFunc.
+func (mock *GlobalLoadBalancerV1) CreateLoadBalancer(createLoadBalancerOptions *globalloadbalancerv1.CreateLoadBalancerOptions) (result *globalloadbalancerv1.LoadBalancersResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateLoadBalancer", createLoadBalancerOptions)
+	if mock.CreateLoadBalancerFunc != nil {
+		return mock.CreateLoadBalancerFunc(createLoadBalancerOptions)
+	}
+	if mock.CreateLoadBalancerWithContextFunc != nil {
+		return mock.CreateLoadBalancerWithContextFunc(context.Background(), createLoadBalancerOptions)
+	}
+	err = notProgrammed("CreateLoadBalancer")
+	return
+}
+
+// CreateLoadBalancerWithContext invokes the programmed CreateLoadBalancerWithContextFunc.
+func (mock *GlobalLoadBalancerV1) CreateLoadBalancerWithContext(ctx context.Context, createLoadBalancerOptions *globalloadbalancerv1.CreateLoadBalancerOptions) (result *globalloadbalancerv1.LoadBalancersResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateLoadBalancerWithContext", ctx, createLoadBalancerOptions)
+	if mock.CreateLoadBalancerWithContextFunc != nil {
+		return mock.CreateLoadBalancerWithContextFunc(ctx, createLoadBalancerOptions)
+	}
+	if mock.CreateLoadBalancerFunc != nil {
+		return mock.CreateLoadBalancerFunc(createLoadBalancerOptions)
+	}
+	err = notProgrammed("CreateLoadBalancerWithContext")
+	return
+}
+
+// EditLoadBalancer invokes the programmed EditLoadBalancerFunc.
+func (mock *GlobalLoadBalancerV1) EditLoadBalancer(editLoadBalancerOptions *globalloadbalancerv1.EditLoadBalancerOptions) (result *globalloadbalancerv1.LoadBalancersResp, response *core.DetailedResponse, err error) {
+	mock.called("EditLoadBalancer", editLoadBalancerOptions)
+	if mock.EditLoadBalancerFunc != nil {
+		return mock.EditLoadBalancerFunc(editLoadBalancerOptions)
+	}
+	if mock.EditLoadBalancerWithContextFunc != nil {
+		return mock.EditLoadBalancerWithContextFunc(context.Background(), editLoadBalancerOptions)
+	}
+	err = notProgrammed("EditLoadBalancer")
+	return
+}
+
+// EditLoadBalancerWithContext invokes the programmed EditLoadBalancerWithContextFunc.
+func (mock *GlobalLoadBalancerV1) EditLoadBalancerWithContext(ctx context.Context, editLoadBalancerOptions *globalloadbalancerv1.EditLoadBalancerOptions) (result *globalloadbalancerv1.LoadBalancersResp, response *core.DetailedResponse, err error) {
+	mock.called("EditLoadBalancerWithContext", ctx, editLoadBalancerOptions)
+	if mock.EditLoadBalancerWithContextFunc != nil {
+		return mock.EditLoadBalancerWithContextFunc(ctx, editLoadBalancerOptions)
+	}
+	if mock.EditLoadBalancerFunc != nil {
+		return mock.EditLoadBalancerFunc(editLoadBalancerOptions)
+	}
+	err = notProgrammed("EditLoadBalancerWithContext")
+	return
+}
+
+// DeleteLoadBalancer invokes the programmed DeleteLoadBalancerFunc.
+func (mock *GlobalLoadBalancerV1) DeleteLoadBalancer(deleteLoadBalancerOptions *globalloadbalancerv1.DeleteLoadBalancerOptions) (result *globalloadbalancerv1.DeleteLoadBalancersResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLoadBalancer", deleteLoadBalancerOptions)
+	if mock.DeleteLoadBalancerFunc != nil {
+		return mock.DeleteLoadBalancerFunc(deleteLoadBalancerOptions)
+	}
+	if mock.DeleteLoadBalancerWithContextFunc != nil {
+		return mock.DeleteLoadBalancerWithContextFunc(context.Background(), deleteLoadBalancerOptions)
+	}
+	err = notProgrammed("DeleteLoadBalancer")
+	return
+}
+
+// DeleteLoadBalancerWithContext invokes the programmed DeleteLoadBalancerWithContextFunc.
+func (mock *GlobalLoadBalancerV1) DeleteLoadBalancerWithContext(ctx context.Context, deleteLoadBalancerOptions *globalloadbalancerv1.DeleteLoadBalancerOptions) (result *globalloadbalancerv1.DeleteLoadBalancersResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLoadBalancerWithContext", ctx, deleteLoadBalancerOptions)
+	if mock.DeleteLoadBalancerWithContextFunc != nil {
+		return mock.DeleteLoadBalancerWithContextFunc(ctx, deleteLoadBalancerOptions)
+	}
+	if mock.DeleteLoadBalancerFunc != nil {
+		return mock.DeleteLoadBalancerFunc(deleteLoadBalancerOptions)
+	}
+	err = notProgrammed("DeleteLoadBalancerWithContext")
+	return
+}
+
+// GetLoadBalancerSettings invokes the programmed GetLoadBalancerSettingsFunc.
+func (mock *GlobalLoadBalancerV1) GetLoadBalancerSettings(getLoadBalancerSettingsOptions *globalloadbalancerv1.GetLoadBalancerSettingsOptions) (result *globalloadbalancerv1.LoadBalancersResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancerSettings", getLoadBalancerSettingsOptions)
+	if mock.GetLoadBalancerSettingsFunc != nil {
+		return mock.GetLoadBalancerSettingsFunc(getLoadBalancerSettingsOptions)
+	}
+	if mock.GetLoadBalancerSettingsWithContextFunc != nil {
+		return mock.GetLoadBalancerSettingsWithContextFunc(context.Background(), getLoadBalancerSettingsOptions)
+	}
+	err = notProgrammed("GetLoadBalancerSettings")
+	return
+}
+
+// GetLoadBalancerSettingsWithContext invokes the programmed GetLoadBalancerSettingsWithContextFunc.
+func (mock *GlobalLoadBalancerV1) GetLoadBalancerSettingsWithContext(ctx context.Context, getLoadBalancerSettingsOptions *globalloadbalancerv1.GetLoadBalancerSettingsOptions) (result *globalloadbalancerv1.LoadBalancersResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancerSettingsWithContext", ctx, getLoadBalancerSettingsOptions)
+	if mock.GetLoadBalancerSettingsWithContextFunc != nil {
+		return mock.GetLoadBalancerSettingsWithContextFunc(ctx, getLoadBalancerSettingsOptions)
+	}
+	if mock.GetLoadBalancerSettingsFunc != nil {
+		return mock.GetLoadBalancerSettingsFunc(getLoadBalancerSettingsOptions)
+	}
+	err = notProgrammed("GetLoadBalancerSettingsWithContext")
+	return
+}
@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/globalloadbalancersv1"
+)
+
+// GlobalLoadBalancersV1 is a mock of globalloadbalancersv1.GlobalLoadBalancersV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type GlobalLoadBalancersV1 struct {
+	Mock
+
+	ListLoadBalancersFunc  func(*globalloadbalancersv1.ListLoadBalancersOptions) (*globalloadbalancersv1.ListLoadBalancers, *core.DetailedResponse, error)
+	CreateLoadBalancerFunc func(*globalloadbalancersv1.CreateLoadBalancerOptions) (*globalloadbalancersv1.LoadBalancer, *core.DetailedResponse, error)
+	DeleteLoadBalancerFunc func(*globalloadbalancersv1.DeleteLoadBalancerOptions) (*core.DetailedResponse, error)
+	GetLoadBalancerFunc    func(*globalloadbalancersv1.GetLoadBalancerOptions) (*globalloadbalancersv1.LoadBalancer, *core.DetailedResponse, error)
+	UpdateLoadBalancerFunc func(*globalloadbalancersv1.UpdateLoadBalancerOptions) (*globalloadbalancersv1.LoadBalancer, *core.DetailedResponse, error)
+	ListPoolsFunc          func(*globalloadbalancersv1.ListPoolsOptions) (*globalloadbalancersv1.ListPools, *core.DetailedResponse, error)
+	CreatePoolFunc         func(*globalloadbalancersv1.CreatePoolOptions) (*globalloadbalancersv1.Pool, *core.DetailedResponse, error)
+	DeletePoolFunc         func(*globalloadbalancersv1.DeletePoolOptions) (*core.DetailedResponse, error)
+	GetPoolFunc            func(*globalloadbalancersv1.GetPoolOptions) (*globalloadbalancersv1.Pool, *core.DetailedResponse, error)
+	UpdatePoolFunc         func(*globalloadbalancersv1.UpdatePoolOptions) (*globalloadbalancersv1.Pool, *core.DetailedResponse, error)
+	ListMonitorsFunc       func(*globalloadbalancersv1.ListMonitorsOptions) (*globalloadbalancersv1.ListMonitors, *core.DetailedResponse, error)
+	CreateMonitorFunc      func(*globalloadbalancersv1.CreateMonitorOptions) (*globalloadbalancersv1.Monitor, *core.DetailedResponse, error)
+	DeleteMonitorFunc      func(*globalloadbalancersv1.DeleteMonitorOptions) (*core.DetailedResponse, error)
+	GetMonitorFunc         func(*globalloadbalancersv1.GetMonitorOptions) (*globalloadbalancersv1.Monitor, *core.DetailedResponse, error)
+	UpdateMonitorFunc      func(*globalloadbalancersv1.UpdateMonitorOptions) (*globalloadbalancersv1.Monitor, *core.DetailedResponse, error)
+}
+
+// GlobalLoadBalancersV1 implements globalloadbalancersv1.GlobalLoadBalancersV1API.
+var _ globalloadbalancersv1.GlobalLoadBalancersV1API = (*GlobalLoadBalancersV1)(nil)
+
+// ListLoadBalancers invokes the programmed ListLoadBalancersFunc.
+func (mock *GlobalLoadBalancersV1) ListLoadBalancers(listLoadBalancersOptions *globalloadbalancersv1.ListLoadBalancersOptions) (result *globalloadbalancersv1.ListLoadBalancers, response *core.DetailedResponse, err error) {
+	mock.called("ListLoadBalancers", listLoadBalancersOptions)
+	if mock.ListLoadBalancersFunc != nil {
+		return mock.ListLoadBalancersFunc(listLoadBalancersOptions)
+	}
+	err = notProgrammed("ListLoadBalancers")
+	return
+}
+
+// CreateLoadBalancer invokes the programmed CreateLoadBalancerFunc.
+func (mock *GlobalLoadBalancersV1) CreateLoadBalancer(createLoadBalancerOptions *globalloadbalancersv1.CreateLoadBalancerOptions) (result *globalloadbalancersv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	mock.called("CreateLoadBalancer", createLoadBalancerOptions)
+	if mock.CreateLoadBalancerFunc != nil {
+		return mock.CreateLoadBalancerFunc(createLoadBalancerOptions)
+	}
+	err = notProgrammed("CreateLoadBalancer")
+	return
+}
+
+// DeleteLoadBalancer invokes the programmed DeleteLoadBalancerFunc.
+func (mock *GlobalLoadBalancersV1) DeleteLoadBalancer(deleteLoadBalancerOptions *globalloadbalancersv1.DeleteLoadBalancerOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteLoadBalancer", deleteLoadBalancerOptions)
+	if mock.DeleteLoadBalancerFunc != nil {
+		return mock.DeleteLoadBalancerFunc(deleteLoadBalancerOptions)
+	}
+	err = notProgrammed("DeleteLoadBalancer")
+	return
+}
+
+// GetLoadBalancer invokes the programmed GetLoadBalancerFunc.
+func (mock *GlobalLoadBalancersV1) GetLoadBalancer(getLoadBalancerOptions *globalloadbalancersv1.GetLoadBalancerOptions) (result *globalloadbalancersv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	mock.called("GetLoadBalancer", getLoadBalancerOptions)
+	if mock.GetLoadBalancerFunc != nil {
+		return mock.GetLoadBalancerFunc(getLoadBalancerOptions)
+	}
+	err = notProgrammed("GetLoadBalancer")
+	return
+}
+
+// UpdateLoadBalancer invokes the programmed UpdateLoadBalancerFunc.
+func (mock *GlobalLoadBalancersV1) UpdateLoadBalancer(updateLoadBalancerOptions *globalloadbalancersv1.UpdateLoadBalancerOptions) (result *globalloadbalancersv1.LoadBalancer, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLoadBalancer", updateLoadBalancerOptions)
+	if mock.UpdateLoadBalancerFunc != nil {
+		return mock.UpdateLoadBalancerFunc(updateLoadBalancerOptions)
+	}
+	err = notProgrammed("UpdateLoadBalancer")
+	return
+}
+
+// ListPools invokes the programmed ListPoolsFunc.
+func (mock *GlobalLoadBalancersV1) ListPools(listPoolsOptions *globalloadbalancersv1.ListPoolsOptions) (result *globalloadbalancersv1.ListPools, response *core.DetailedResponse, err error) {
+	mock.called("ListPools", listPoolsOptions)
+	if mock.ListPoolsFunc != nil {
+		return mock.ListPoolsFunc(listPoolsOptions)
+	}
+	err = notProgrammed("ListPools")
+	return
+}
+
+// CreatePool invokes the programmed CreatePoolFunc.
+func (mock *GlobalLoadBalancersV1) CreatePool(createPoolOptions *globalloadbalancersv1.CreatePoolOptions) (result *globalloadbalancersv1.Pool, response *core.DetailedResponse, err error) {
+	mock.called("CreatePool", createPoolOptions)
+	if mock.CreatePoolFunc != nil {
+		return mock.CreatePoolFunc(createPoolOptions)
+	}
+	err = notProgrammed("CreatePool")
+	return
+}
+
+// DeletePool invokes the programmed DeletePoolFunc.
+func (mock *GlobalLoadBalancersV1) DeletePool(deletePoolOptions *globalloadbalancersv1.DeletePoolOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeletePool", deletePoolOptions)
+	if mock.DeletePoolFunc != nil {
+		return mock.DeletePoolFunc(deletePoolOptions)
+	}
+	err = notProgrammed("DeletePool")
+	return
+}
+
+// GetPool invokes the programmed GetPoolFunc.
+func (mock *GlobalLoadBalancersV1) GetPool(getPoolOptions *globalloadbalancersv1.GetPoolOptions) (result *globalloadbalancersv1.Pool, response *core.DetailedResponse, err error) {
+	mock.called("GetPool", getPoolOptions)
+	if mock.GetPoolFunc != nil {
+		return mock.GetPoolFunc(getPoolOptions)
+	}
+	err = notProgrammed("GetPool")
+	return
+}
+
+// UpdatePool invokes the programmed UpdatePoolFunc.
+func (mock *GlobalLoadBalancersV1) UpdatePool(updatePoolOptions *globalloadbalancersv1.UpdatePoolOptions) (result *globalloadbalancersv1.Pool, response *core.DetailedResponse, err error) {
+	mock.called("UpdatePool", updatePoolOptions)
+	if mock.UpdatePoolFunc != nil {
+		return mock.UpdatePoolFunc(updatePoolOptions)
+	}
+	err = notProgrammed("UpdatePool")
+	return
+}
+
+// ListMonitors invokes the programmed ListMonitorsFunc.
+func (mock *GlobalLoadBalancersV1) ListMonitors(listMonitorsOptions *globalloadbalancersv1.ListMonitorsOptions) (result *globalloadbalancersv1.ListMonitors, response *core.DetailedResponse, err error) {
+	mock.called("ListMonitors", listMonitorsOptions)
+	if mock.ListMonitorsFunc != nil {
+		return mock.ListMonitorsFunc(listMonitorsOptions)
+	}
+	err = notProgrammed("ListMonitors")
+	return
+}
+
+// CreateMonitor invokes the programmed CreateMonitorFunc.
+func (mock *GlobalLoadBalancersV1) CreateMonitor(createMonitorOptions *globalloadbalancersv1.CreateMonitorOptions) (result *globalloadbalancersv1.Monitor, response *core.DetailedResponse, err error) {
+	mock.called("CreateMonitor", createMonitorOptions)
+	if mock.CreateMonitorFunc != nil {
+		return mock.CreateMonitorFunc(createMonitorOptions)
+	}
+	err = notProgrammed("CreateMonitor")
+	return
+}
+
+// DeleteMonitor invokes the programmed DeleteMonitorFunc.
+func (mock *GlobalLoadBalancersV1) DeleteMonitor(deleteMonitorOptions *globalloadbalancersv1.DeleteMonitorOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteMonitor", deleteMonitorOptions)
+	if mock.DeleteMonitorFunc != nil {
+		return mock.DeleteMonitorFunc(deleteMonitorOptions)
+	}
+	err = notProgrammed("DeleteMonitor")
+	return
+}
+
+// GetMonitor invokes the programmed GetMonitorFunc.
+func (mock *GlobalLoadBalancersV1) GetMonitor(getMonitorOptions *globalloadbalancersv1.GetMonitorOptions) (result *globalloadbalancersv1.Monitor, response *core.DetailedResponse, err error) {
+	mock.called("GetMonitor", getMonitorOptions)
+	if mock.GetMonitorFunc != nil {
+		return mock.GetMonitorFunc(getMonitorOptions)
+	}
+	err = notProgrammed("GetMonitor")
+	return
+}
+
+// UpdateMonitor invokes the programmed UpdateMonitorFunc.
+func (mock *GlobalLoadBalancersV1) UpdateMonitor(updateMonitorOptions *globalloadbalancersv1.UpdateMonitorOptions) (result *globalloadbalancersv1.Monitor, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMonitor", updateMonitorOptions)
+	if mock.UpdateMonitorFunc != nil {
+		return mock.UpdateMonitorFunc(updateMonitorOptions)
+	}
+	err = notProgrammed("UpdateMonitor")
+	return
+}
@@ -0,0 +1,538 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/logpushjobsapiv1"
+)
+
+// LogpushJobsApiV1 is a mock of logpushjobsapiv1.LogpushJobsApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type LogpushJobsApiV1 struct {
+	Mock
+
+	GetLogpushJobsFunc                                 func(*logpushjobsapiv1.GetLogpushJobsOptions) (*logpushjobsapiv1.ListLogpushJobsResp, *core.DetailedResponse, error)
+	GetLogpushJobsWithContextFunc                      func(context.Context, *logpushjobsapiv1.GetLogpushJobsOptions) (*logpushjobsapiv1.ListLogpushJobsResp, *core.DetailedResponse, error)
+	CreateLogpushJobFunc                               func(*logpushjobsapiv1.CreateLogpushJobOptions) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	CreateLogpushJobWithContextFunc                    func(context.Context, *logpushjobsapiv1.CreateLogpushJobOptions) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	GetLogpushJobFunc                                  func(*logpushjobsapiv1.GetLogpushJobOptions) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	GetLogpushJobWithContextFunc                       func(context.Context, *logpushjobsapiv1.GetLogpushJobOptions) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	UpdateLogpushJobFunc                               func(*logpushjobsapiv1.UpdateLogpushJobOptions) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	UpdateLogpushJobWithContextFunc                    func(context.Context, *logpushjobsapiv1.UpdateLogpushJobOptions) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	DeleteLogpushJobFunc                               func(*logpushjobsapiv1.DeleteLogpushJobOptions) (*logpushjobsapiv1.DeleteLogpushJobResp, *core.DetailedResponse, error)
+	DeleteLogpushJobWithContextFunc                    func(context.Context, *logpushjobsapiv1.DeleteLogpushJobOptions) (*logpushjobsapiv1.DeleteLogpushJobResp, *core.DetailedResponse, error)
+	ListFieldsForDatasetFunc                           func(*logpushjobsapiv1.ListFieldsForDatasetOptions) (*logpushjobsapiv1.ListFieldsResp, *core.DetailedResponse, error)
+	ListFieldsForDatasetWithContextFunc                func(context.Context, *logpushjobsapiv1.ListFieldsForDatasetOptions) (*logpushjobsapiv1.ListFieldsResp, *core.DetailedResponse, error)
+	ListLogpushJobsForDatasetFunc                      func(*logpushjobsapiv1.ListLogpushJobsForDatasetOptions) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	ListLogpushJobsForDatasetWithContextFunc           func(context.Context, *logpushjobsapiv1.ListLogpushJobsForDatasetOptions) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	GetLogpushOwnershipFunc                            func(*logpushjobsapiv1.GetLogpushOwnershipOptions) (*logpushjobsapiv1.OwnershipChallengeResp, *core.DetailedResponse, error)
+	GetLogpushOwnershipWithContextFunc                 func(context.Context, *logpushjobsapiv1.GetLogpushOwnershipOptions) (*logpushjobsapiv1.OwnershipChallengeResp, *core.DetailedResponse, error)
+	ValidateLogpushOwnershipChallengeFunc              func(*logpushjobsapiv1.ValidateLogpushOwnershipChallengeOptions) (*logpushjobsapiv1.OwnershipChallengeValidateResult, *core.DetailedResponse, error)
+	ValidateLogpushOwnershipChallengeWithContextFunc   func(context.Context, *logpushjobsapiv1.ValidateLogpushOwnershipChallengeOptions) (*logpushjobsapiv1.OwnershipChallengeValidateResult, *core.DetailedResponse, error)
+	GetLogpushJobsV2Func                               func(*logpushjobsapiv1.GetLogpushJobsV2Options) (*logpushjobsapiv1.ListLogpushJobsResp, *core.DetailedResponse, error)
+	GetLogpushJobsV2WithContextFunc                    func(context.Context, *logpushjobsapiv1.GetLogpushJobsV2Options) (*logpushjobsapiv1.ListLogpushJobsResp, *core.DetailedResponse, error)
+	CreateLogpushJobV2Func                             func(*logpushjobsapiv1.CreateLogpushJobV2Options) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	CreateLogpushJobV2WithContextFunc                  func(context.Context, *logpushjobsapiv1.CreateLogpushJobV2Options) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	GetLogpushJobV2Func                                func(*logpushjobsapiv1.GetLogpushJobV2Options) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	GetLogpushJobV2WithContextFunc                     func(context.Context, *logpushjobsapiv1.GetLogpushJobV2Options) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	UpdateLogpushJobV2Func                             func(*logpushjobsapiv1.UpdateLogpushJobV2Options) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	UpdateLogpushJobV2WithContextFunc                  func(context.Context, *logpushjobsapiv1.UpdateLogpushJobV2Options) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	DeleteLogpushJobV2Func                             func(*logpushjobsapiv1.DeleteLogpushJobV2Options) (*logpushjobsapiv1.DeleteLogpushJobResp, *core.DetailedResponse, error)
+	DeleteLogpushJobV2WithContextFunc                  func(context.Context, *logpushjobsapiv1.DeleteLogpushJobV2Options) (*logpushjobsapiv1.DeleteLogpushJobResp, *core.DetailedResponse, error)
+	GetLogpushOwnershipV2Func                          func(*logpushjobsapiv1.GetLogpushOwnershipV2Options) (*logpushjobsapiv1.OwnershipChallengeResp, *core.DetailedResponse, error)
+	GetLogpushOwnershipV2WithContextFunc               func(context.Context, *logpushjobsapiv1.GetLogpushOwnershipV2Options) (*logpushjobsapiv1.OwnershipChallengeResp, *core.DetailedResponse, error)
+	ValidateLogpushOwnershipChallengeV2Func            func(*logpushjobsapiv1.ValidateLogpushOwnershipChallengeV2Options) (*logpushjobsapiv1.OwnershipChallengeValidateResult, *core.DetailedResponse, error)
+	ValidateLogpushOwnershipChallengeV2WithContextFunc func(context.Context, *logpushjobsapiv1.ValidateLogpushOwnershipChallengeV2Options) (*logpushjobsapiv1.OwnershipChallengeValidateResult, *core.DetailedResponse, error)
+	ListFieldsForDatasetV2Func                         func(*logpushjobsapiv1.ListFieldsForDatasetV2Options) (*logpushjobsapiv1.ListFieldsResp, *core.DetailedResponse, error)
+	ListFieldsForDatasetV2WithContextFunc              func(context.Context, *logpushjobsapiv1.ListFieldsForDatasetV2Options) (*logpushjobsapiv1.ListFieldsResp, *core.DetailedResponse, error)
+	ListLogpushJobsForDatasetV2Func                    func(*logpushjobsapiv1.ListLogpushJobsForDatasetV2Options) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+	ListLogpushJobsForDatasetV2WithContextFunc         func(context.Context, *logpushjobsapiv1.ListLogpushJobsForDatasetV2Options) (*logpushjobsapiv1.LogpushJobsResp, *core.DetailedResponse, error)
+}
+
+// LogpushJobsApiV1 implements logpushjobsapiv1.LogpushJobsApiV1API.
+var _ logpushjobsapiv1.LogpushJobsApiV1API = (*LogpushJobsApiV1)(nil)
+
+// GetLogpushJobs invokes the programmed GetLogpushJobsFunc.
+func (mock *LogpushJobsApiV1) GetLogpushJobs(getLogpushJobsOptions *logpushjobsapiv1.GetLogpushJobsOptions) (result *logpushjobsapiv1.ListLogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushJobs", getLogpushJobsOptions)
+	if mock.GetLogpushJobsFunc != nil {
+		return mock.GetLogpushJobsFunc(getLogpushJobsOptions)
+	}
+	if mock.GetLogpushJobsWithContextFunc != nil {
+		return mock.GetLogpushJobsWithContextFunc(context.Background(), getLogpushJobsOptions)
+	}
+	err = notProgrammed("GetLogpushJobs")
+	return
+}
+
+// GetLogpushJobsWithContext invokes the programmed GetLogpushJobsWithContextFunc.
+func (mock *LogpushJobsApiV1) GetLogpushJobsWithContext(ctx context.Context, getLogpushJobsOptions *logpushjobsapiv1.GetLogpushJobsOptions) (result *logpushjobsapiv1.ListLogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushJobsWithContext", ctx, getLogpushJobsOptions)
+	if mock.GetLogpushJobsWithContextFunc != nil {
+		return mock.GetLogpushJobsWithContextFunc(ctx, getLogpushJobsOptions)
+	}
+	if mock.GetLogpushJobsFunc != nil {
+		return mock.GetLogpushJobsFunc(getLogpushJobsOptions)
+	}
+	err = notProgrammed("GetLogpushJobsWithContext")
+	return
+}
+
+// CreateLogpushJob invokes the programmed CreateLogpushJobFunc.
+func (mock *LogpushJobsApiV1) CreateLogpushJob(createLogpushJobOptions *logpushjobsapiv1.CreateLogpushJobOptions) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateLogpushJob", createLogpushJobOptions)
+	if mock.CreateLogpushJobFunc != nil {
+		return mock.CreateLogpushJobFunc(createLogpushJobOptions)
+	}
+	if mock.CreateLogpushJobWithContextFunc != nil {
+		return mock.CreateLogpushJobWithContextFunc(context.Background(), createLogpushJobOptions)
+	}
+	err = notProgrammed("CreateLogpushJob")
+	return
+}
+
+// CreateLogpushJobWithContext invokes the programmed CreateLogpushJobWithContextFunc.
+func (mock *LogpushJobsApiV1) CreateLogpushJobWithContext(ctx context.Context, createLogpushJobOptions *logpushjobsapiv1.CreateLogpushJobOptions) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateLogpushJobWithContext", ctx, createLogpushJobOptions)
+	if mock.CreateLogpushJobWithContextFunc != nil {
+		return mock.CreateLogpushJobWithContextFunc(ctx, createLogpushJobOptions)
+	}
+	if mock.CreateLogpushJobFunc != nil {
+		return mock.CreateLogpushJobFunc(createLogpushJobOptions)
+	}
+	err = notProgrammed("CreateLogpushJobWithContext")
+	return
+}
+
+// GetLogpushJob invokes the programmed GetLogpushJobFunc.
+func (mock *LogpushJobsApiV1) GetLogpushJob(getLogpushJobOptions *logpushjobsapiv1.GetLogpushJobOptions) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushJob", getLogpushJobOptions)
+	if mock.GetLogpushJobFunc != nil {
+		return mock.GetLogpushJobFunc(getLogpushJobOptions)
+	}
+	if mock.GetLogpushJobWithContextFunc != nil {
+		return mock.GetLogpushJobWithContextFunc(context.Background(), getLogpushJobOptions)
+	}
+	err = notProgrammed("GetLogpushJob")
+	return
+}
+
+// GetLogpushJobWithContext invokes the programmed GetLogpushJobWithContextFunc.
+func (mock *LogpushJobsApiV1) GetLogpushJobWithContext(ctx context.Context, getLogpushJobOptions *logpushjobsapiv1.GetLogpushJobOptions) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushJobWithContext", ctx, getLogpushJobOptions)
+	if mock.GetLogpushJobWithContextFunc != nil {
+		return mock.GetLogpushJobWithContextFunc(ctx, getLogpushJobOptions)
+	}
+	if mock.GetLogpushJobFunc != nil {
+		return mock.GetLogpushJobFunc(getLogpushJobOptions)
+	}
+	err = notProgrammed("GetLogpushJobWithContext")
+	return
+}
+
+// UpdateLogpushJob invokes the programmed UpdateLogpushJobFunc.
+func (mock *LogpushJobsApiV1) UpdateLogpushJob(updateLogpushJobOptions *logpushjobsapiv1.UpdateLogpushJobOptions) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLogpushJob", updateLogpushJobOptions)
+	if mock.UpdateLogpushJobFunc != nil {
+		return mock.UpdateLogpushJobFunc(updateLogpushJobOptions)
+	}
+	if mock.UpdateLogpushJobWithContextFunc != nil {
+		return mock.UpdateLogpushJobWithContextFunc(context.Background(), updateLogpushJobOptions)
+	}
+	err = notProgrammed("UpdateLogpushJob")
+	return
+}
+
+// UpdateLogpushJobWithContext invokes the programmed UpdateLogpushJobWithContextFunc.
+func (mock *LogpushJobsApiV1) UpdateLogpushJobWithContext(ctx context.Context, updateLogpushJobOptions *logpushjobsapiv1.UpdateLogpushJobOptions) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLogpushJobWithContext", ctx, updateLogpushJobOptions)
+	if mock.UpdateLogpushJobWithContextFunc != nil {
+		return mock.UpdateLogpushJobWithContextFunc(ctx, updateLogpushJobOptions)
+	}
+	if mock.UpdateLogpushJobFunc != nil {
+		return mock.UpdateLogpushJobFunc(updateLogpushJobOptions)
+	}
+	err = notProgrammed("UpdateLogpushJobWithContext")
+	return
+}
+
+// DeleteLogpushJob invokes the programmed DeleteLogpushJobFunc.
+func (mock *LogpushJobsApiV1) DeleteLogpushJob(deleteLogpushJobOptions *logpushjobsapiv1.DeleteLogpushJobOptions) (result *logpushjobsapiv1.DeleteLogpushJobResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLogpushJob", deleteLogpushJobOptions)
+	if mock.DeleteLogpushJobFunc != nil {
+		return mock.DeleteLogpushJobFunc(deleteLogpushJobOptions)
+	}
+	if mock.DeleteLogpushJobWithContextFunc != nil {
+		return mock.DeleteLogpushJobWithContextFunc(context.Background(), deleteLogpushJobOptions)
+	}
+	err = notProgrammed("DeleteLogpushJob")
+	return
+}
+
+// DeleteLogpushJobWithContext invokes the programmed DeleteLogpushJobWithContextFunc.
+func (mock *LogpushJobsApiV1) DeleteLogpushJobWithContext(ctx context.Context, deleteLogpushJobOptions *logpushjobsapiv1.DeleteLogpushJobOptions) (result *logpushjobsapiv1.DeleteLogpushJobResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLogpushJobWithContext", ctx, deleteLogpushJobOptions)
+	if mock.DeleteLogpushJobWithContextFunc != nil {
+		return mock.DeleteLogpushJobWithContextFunc(ctx, deleteLogpushJobOptions)
+	}
+	if mock.DeleteLogpushJobFunc != nil {
+		return mock.DeleteLogpushJobFunc(deleteLogpushJobOptions)
+	}
+	err = notProgrammed("DeleteLogpushJobWithContext")
+	return
+}
+
+// ListFieldsForDataset invokes the programmed ListFieldsForDatasetFunc.
+func (mock *LogpushJobsApiV1) ListFieldsForDataset(listFieldsForDatasetOptions *logpushjobsapiv1.ListFieldsForDatasetOptions) (result *logpushjobsapiv1.ListFieldsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListFieldsForDataset", listFieldsForDatasetOptions)
+	if mock.ListFieldsForDatasetFunc != nil {
+		return mock.ListFieldsForDatasetFunc(listFieldsForDatasetOptions)
+	}
+	if mock.ListFieldsForDatasetWithContextFunc != nil {
+		return mock.ListFieldsForDatasetWithContextFunc(context.Background(), listFieldsForDatasetOptions)
+	}
+	err = notProgrammed("ListFieldsForDataset")
+	return
+}
+
+// ListFieldsForDatasetWithContext invokes the programmed ListFieldsForDatasetWithContextFunc.
+func (mock *LogpushJobsApiV1) ListFieldsForDatasetWithContext(ctx context.Context, listFieldsForDatasetOptions *logpushjobsapiv1.ListFieldsForDatasetOptions) (result *logpushjobsapiv1.ListFieldsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListFieldsForDatasetWithContext", ctx, listFieldsForDatasetOptions)
+	if mock.ListFieldsForDatasetWithContextFunc != nil {
+		return mock.ListFieldsForDatasetWithContextFunc(ctx, listFieldsForDatasetOptions)
+	}
+	if mock.ListFieldsForDatasetFunc != nil {
+		return mock.ListFieldsForDatasetFunc(listFieldsForDatasetOptions)
+	}
+	err = notProgrammed("ListFieldsForDatasetWithContext")
+	return
+}
+
+// ListLogpushJobsForDataset invokes the programmed ListLogpushJobsForDatasetFunc.
+func (mock *LogpushJobsApiV1) ListLogpushJobsForDataset(listLogpushJobsForDatasetOptions *logpushjobsapiv1.ListLogpushJobsForDatasetOptions) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListLogpushJobsForDataset", listLogpushJobsForDatasetOptions)
+	if mock.ListLogpushJobsForDatasetFunc != nil {
+		return mock.ListLogpushJobsForDatasetFunc(listLogpushJobsForDatasetOptions)
+	}
+	if mock.ListLogpushJobsForDatasetWithContextFunc != nil {
+		return mock.ListLogpushJobsForDatasetWithContextFunc(context.Background(), listLogpushJobsForDatasetOptions)
+	}
+	err = notProgrammed("ListLogpushJobsForDataset")
+	return
+}
+
+// ListLogpushJobsForDatasetWithContext invokes the programmed ListLogpushJobsForDatasetWithContextFunc.
+func (mock *LogpushJobsApiV1) ListLogpushJobsForDatasetWithContext(ctx context.Context, listLogpushJobsForDatasetOptions *logpushjobsapiv1.ListLogpushJobsForDatasetOptions) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListLogpushJobsForDatasetWithContext", ctx, listLogpushJobsForDatasetOptions)
+	if mock.ListLogpushJobsForDatasetWithContextFunc != nil {
+		return mock.ListLogpushJobsForDatasetWithContextFunc(ctx, listLogpushJobsForDatasetOptions)
+	}
+	if mock.ListLogpushJobsForDatasetFunc != nil {
+		return mock.ListLogpushJobsForDatasetFunc(listLogpushJobsForDatasetOptions)
+	}
+	err = notProgrammed("ListLogpushJobsForDatasetWithContext")
+	return
+}
+
+// GetLogpushOwnership invokes the programmed GetLogpushOwnershipFunc.
+func (mock *LogpushJobsApiV1) GetLogpushOwnership(getLogpushOwnershipOptions *logpushjobsapiv1.GetLogpushOwnershipOptions) (result *logpushjobsapiv1.OwnershipChallengeResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushOwnership", getLogpushOwnershipOptions)
+	if mock.GetLogpushOwnershipFunc != nil {
+		return mock.GetLogpushOwnershipFunc(getLogpushOwnershipOptions)
+	}
+	if mock.GetLogpushOwnershipWithContextFunc != nil {
+		return mock.GetLogpushOwnershipWithContextFunc(context.Background(), getLogpushOwnershipOptions)
+	}
+	err = notProgrammed("GetLogpushOwnership")
+	return
+}
+
+// GetLogpushOwnershipWithContext invokes the programmed GetLogpushOwnershipWithContextFunc.
+func (mock *LogpushJobsApiV1) GetLogpushOwnershipWithContext(ctx context.Context, getLogpushOwnershipOptions *logpushjobsapiv1.GetLogpushOwnershipOptions) (result *logpushjobsapiv1.OwnershipChallengeResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushOwnershipWithContext", ctx, getLogpushOwnershipOptions)
+	if mock.GetLogpushOwnershipWithContextFunc != nil {
+		return mock.GetLogpushOwnershipWithContextFunc(ctx, getLogpushOwnershipOptions)
+	}
+	if mock.GetLogpushOwnershipFunc != nil {
+		return mock.GetLogpushOwnershipFunc(getLogpushOwnershipOptions)
+	}
+	err = notProgrammed("GetLogpushOwnershipWithContext")
+	return
+}
+
+// ValidateLogpushOwnershipChallenge invokes the programmed ValidateLogpushOwnershipChallengeFunc.
+func (mock *LogpushJobsApiV1) ValidateLogpushOwnershipChallenge(validateLogpushOwnershipChallengeOptions *logpushjobsapiv1.ValidateLogpushOwnershipChallengeOptions) (result *logpushjobsapiv1.OwnershipChallengeValidateResult, response *core.DetailedResponse, err error) {
+	mock.called("ValidateLogpushOwnershipChallenge", validateLogpushOwnershipChallengeOptions)
+	if mock.ValidateLogpushOwnershipChallengeFunc != nil {
+		return mock.ValidateLogpushOwnershipChallengeFunc(validateLogpushOwnershipChallengeOptions)
+	}
+	if mock.ValidateLogpushOwnershipChallengeWithContextFunc != nil {
+		return mock.ValidateLogpushOwnershipChallengeWithContextFunc(context.Background(), validateLogpushOwnershipChallengeOptions)
+	}
+	err = notProgrammed("ValidateLogpushOwnershipChallenge")
+	return
+}
+
+// ValidateLogpushOwnershipChallengeWithContext invokes the programmed ValidateLogpushOwnershipChallengeWithContextFunc.
+func (mock *LogpushJobsApiV1) ValidateLogpushOwnershipChallengeWithContext(ctx context.Context, validateLogpushOwnershipChallengeOptions *logpushjobsapiv1.ValidateLogpushOwnershipChallengeOptions) (result *logpushjobsapiv1.OwnershipChallengeValidateResult, response *core.DetailedResponse, err error) {
+	mock.called("ValidateLogpushOwnershipChallengeWithContext", ctx, validateLogpushOwnershipChallengeOptions)
+	if mock.ValidateLogpushOwnershipChallengeWithContextFunc != nil {
+		return mock.ValidateLogpushOwnershipChallengeWithContextFunc(ctx, validateLogpushOwnershipChallengeOptions)
+	}
+	if mock.ValidateLogpushOwnershipChallengeFunc != nil {
+		return mock.ValidateLogpushOwnershipChallengeFunc(validateLogpushOwnershipChallengeOptions)
+	}
+	err = notProgrammed("ValidateLogpushOwnershipChallengeWithContext")
+	return
+}
+
+// GetLogpushJobsV2 invokes the programmed GetLogpushJobsV2Func.
+func (mock *LogpushJobsApiV1) GetLogpushJobsV2(getLogpushJobsV2Options *logpushjobsapiv1.GetLogpushJobsV2Options) (result *logpushjobsapiv1.ListLogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushJobsV2", getLogpushJobsV2Options)
+	if mock.GetLogpushJobsV2Func != nil {
+		return mock.GetLogpushJobsV2Func(getLogpushJobsV2Options)
+	}
+	if mock.GetLogpushJobsV2WithContextFunc != nil {
+		return mock.GetLogpushJobsV2WithContextFunc(context.Background(), getLogpushJobsV2Options)
+	}
+	err = notProgrammed("GetLogpushJobsV2")
+	return
+}
+
+// GetLogpushJobsV2WithContext invokes the programmed GetLogpushJobsV2WithContextFunc.
+func (mock *LogpushJobsApiV1) GetLogpushJobsV2WithContext(ctx context.Context, getLogpushJobsV2Options *logpushjobsapiv1.GetLogpushJobsV2Options) (result *logpushjobsapiv1.ListLogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushJobsV2WithContext", ctx, getLogpushJobsV2Options)
+	if mock.GetLogpushJobsV2WithContextFunc != nil {
+		return mock.GetLogpushJobsV2WithContextFunc(ctx, getLogpushJobsV2Options)
+	}
+	if mock.GetLogpushJobsV2Func != nil {
+		return mock.GetLogpushJobsV2Func(getLogpushJobsV2Options)
+	}
+	err = notProgrammed("GetLogpushJobsV2WithContext")
+	return
+}
+
+// CreateLogpushJobV2 invokes the programmed CreateLogpushJobV2Func.
+func (mock *LogpushJobsApiV1) CreateLogpushJobV2(createLogpushJobV2Options *logpushjobsapiv1.CreateLogpushJobV2Options) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateLogpushJobV2", createLogpushJobV2Options)
+	if mock.CreateLogpushJobV2Func != nil {
+		return mock.CreateLogpushJobV2Func(createLogpushJobV2Options)
+	}
+	if mock.CreateLogpushJobV2WithContextFunc != nil {
+		return mock.CreateLogpushJobV2WithContextFunc(context.Background(), createLogpushJobV2Options)
+	}
+	err = notProgrammed("CreateLogpushJobV2")
+	return
+}
+
+// CreateLogpushJobV2WithContext invokes the programmed CreateLogpushJobV2WithContextFunc.
+func (mock *LogpushJobsApiV1) CreateLogpushJobV2WithContext(ctx context.Context, createLogpushJobV2Options *logpushjobsapiv1.CreateLogpushJobV2Options) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateLogpushJobV2WithContext", ctx, createLogpushJobV2Options)
+	if mock.CreateLogpushJobV2WithContextFunc != nil {
+		return mock.CreateLogpushJobV2WithContextFunc(ctx, createLogpushJobV2Options)
+	}
+	if mock.CreateLogpushJobV2Func != nil {
+		return mock.CreateLogpushJobV2Func(createLogpushJobV2Options)
+	}
+	err = notProgrammed("CreateLogpushJobV2WithContext")
+	return
+}
+
+// GetLogpushJobV2 invokes the programmed GetLogpushJobV2Func.
+func (mock *LogpushJobsApiV1) GetLogpushJobV2(getLogpushJobV2Options *logpushjobsapiv1.GetLogpushJobV2Options) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushJobV2", getLogpushJobV2Options)
+	if mock.GetLogpushJobV2Func != nil {
+		return mock.GetLogpushJobV2Func(getLogpushJobV2Options)
+	}
+	if mock.GetLogpushJobV2WithContextFunc != nil {
+		return mock.GetLogpushJobV2WithContextFunc(context.Background(), getLogpushJobV2Options)
+	}
+	err = notProgrammed("GetLogpushJobV2")
+	return
+}
+
+// GetLogpushJobV2WithContext invokes the programmed GetLogpushJobV2WithContextFunc.
+func (mock *LogpushJobsApiV1) GetLogpushJobV2WithContext(ctx context.Context, getLogpushJobV2Options *logpushjobsapiv1.GetLogpushJobV2Options) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushJobV2WithContext", ctx, getLogpushJobV2Options)
+	if mock.GetLogpushJobV2WithContextFunc != nil {
+		return mock.GetLogpushJobV2WithContextFunc(ctx, getLogpushJobV2Options)
+	}
+	if mock.GetLogpushJobV2Func != nil {
+		return mock.GetLogpushJobV2Func(getLogpushJobV2Options)
+	}
+	err = notProgrammed("GetLogpushJobV2WithContext")
+	return
+}
+
+// UpdateLogpushJobV2 invokes the programmed UpdateLogpushJobV2Func.
+func (mock *LogpushJobsApiV1) UpdateLogpushJobV2(updateLogpushJobV2Options *logpushjobsapiv1.UpdateLogpushJobV2Options) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLogpushJobV2", updateLogpushJobV2Options)
+	if mock.UpdateLogpushJobV2Func != nil {
+		return mock.UpdateLogpushJobV2Func(updateLogpushJobV2Options)
+	}
+	if mock.UpdateLogpushJobV2WithContextFunc != nil {
+		return mock.UpdateLogpushJobV2WithContextFunc(context.Background(), updateLogpushJobV2Options)
+	}
+	err = notProgrammed("UpdateLogpushJobV2")
+	return
+}
+
+// UpdateLogpushJobV2WithContext invokes the programmed UpdateLogpushJobV2WithContextFunc.
+func (mock *LogpushJobsApiV1) UpdateLogpushJobV2WithContext(ctx context.Context, updateLogpushJobV2Options *logpushjobsapiv1.UpdateLogpushJobV2Options) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLogpushJobV2WithContext", ctx, updateLogpushJobV2Options)
+	if mock.UpdateLogpushJobV2WithContextFunc != nil {
+		return mock.UpdateLogpushJobV2WithContextFunc(ctx, updateLogpushJobV2Options)
+	}
+	if mock.UpdateLogpushJobV2Func != nil {
+		return mock.UpdateLogpushJobV2Func(updateLogpushJobV2Options)
+	}
+	err = notProgrammed("UpdateLogpushJobV2WithContext")
+	return
+}
+
+// DeleteLogpushJobV2 invokes the programmed DeleteLogpushJobV2Func.
+func (mock *LogpushJobsApiV1) DeleteLogpushJobV2(deleteLogpushJobV2Options *logpushjobsapiv1.DeleteLogpushJobV2Options) (result *logpushjobsapiv1.DeleteLogpushJobResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLogpushJobV2", deleteLogpushJobV2Options)
+	if mock.DeleteLogpushJobV2Func != nil {
+		return mock.DeleteLogpushJobV2Func(deleteLogpushJobV2Options)
+	}
+	if mock.DeleteLogpushJobV2WithContextFunc != nil {
+		return mock.DeleteLogpushJobV2WithContextFunc(context.Background(), deleteLogpushJobV2Options)
+	}
+	err = notProgrammed("DeleteLogpushJobV2")
+	return
+}
+
+// DeleteLogpushJobV2WithContext invokes the programmed DeleteLogpushJobV2WithContextFunc.
+func (mock *LogpushJobsApiV1) DeleteLogpushJobV2WithContext(ctx context.Context, deleteLogpushJobV2Options *logpushjobsapiv1.DeleteLogpushJobV2Options) (result *logpushjobsapiv1.DeleteLogpushJobResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteLogpushJobV2WithContext", ctx, deleteLogpushJobV2Options)
+	if mock.DeleteLogpushJobV2WithContextFunc != nil {
+		return mock.DeleteLogpushJobV2WithContextFunc(ctx, deleteLogpushJobV2Options)
+	}
+	if mock.DeleteLogpushJobV2Func != nil {
+		return mock.DeleteLogpushJobV2Func(deleteLogpushJobV2Options)
+	}
+	err = notProgrammed("DeleteLogpushJobV2WithContext")
+	return
+}
+
+// GetLogpushOwnershipV2 invokes the programmed GetLogpushOwnershipV2Func.
+func (mock *LogpushJobsApiV1) GetLogpushOwnershipV2(getLogpushOwnershipV2Options *logpushjobsapiv1.GetLogpushOwnershipV2Options) (result *logpushjobsapiv1.OwnershipChallengeResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushOwnershipV2", getLogpushOwnershipV2Options)
+	if mock.GetLogpushOwnershipV2Func != nil {
+		return mock.GetLogpushOwnershipV2Func(getLogpushOwnershipV2Options)
+	}
+	if mock.GetLogpushOwnershipV2WithContextFunc != nil {
+		return mock.GetLogpushOwnershipV2WithContextFunc(context.Background(), getLogpushOwnershipV2Options)
+	}
+	err = notProgrammed("GetLogpushOwnershipV2")
+	return
+}
+
+// GetLogpushOwnershipV2WithContext invokes the programmed GetLogpushOwnershipV2WithContextFunc.
+func (mock *LogpushJobsApiV1) GetLogpushOwnershipV2WithContext(ctx context.Context, getLogpushOwnershipV2Options *logpushjobsapiv1.GetLogpushOwnershipV2Options) (result *logpushjobsapiv1.OwnershipChallengeResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLogpushOwnershipV2WithContext", ctx, getLogpushOwnershipV2Options)
+	if mock.GetLogpushOwnershipV2WithContextFunc != nil {
+		return mock.GetLogpushOwnershipV2WithContextFunc(ctx, getLogpushOwnershipV2Options)
+	}
+	if mock.GetLogpushOwnershipV2Func != nil {
+		return mock.GetLogpushOwnershipV2Func(getLogpushOwnershipV2Options)
+	}
+	err = notProgrammed("GetLogpushOwnershipV2WithContext")
+	return
+}
+
+// ValidateLogpushOwnershipChallengeV2 invokes the programmed ValidateLogpushOwnershipChallengeV2Func.
+func (mock *LogpushJobsApiV1) ValidateLogpushOwnershipChallengeV2(validateLogpushOwnershipChallengeV2Options *logpushjobsapiv1.ValidateLogpushOwnershipChallengeV2Options) (result *logpushjobsapiv1.OwnershipChallengeValidateResult, response *core.DetailedResponse, err error) {
+	mock.called("ValidateLogpushOwnershipChallengeV2", validateLogpushOwnershipChallengeV2Options)
+	if mock.ValidateLogpushOwnershipChallengeV2Func != nil {
+		return mock.ValidateLogpushOwnershipChallengeV2Func(validateLogpushOwnershipChallengeV2Options)
+	}
+	if mock.ValidateLogpushOwnershipChallengeV2WithContextFunc != nil {
+		return mock.ValidateLogpushOwnershipChallengeV2WithContextFunc(context.Background(), validateLogpushOwnershipChallengeV2Options)
+	}
+	err = notProgrammed("ValidateLogpushOwnershipChallengeV2")
+	return
+}
+
+// ValidateLogpushOwnershipChallengeV2WithContext invokes the programmed ValidateLogpushOwnershipChallengeV2WithContextFunc.
+func (mock *LogpushJobsApiV1) ValidateLogpushOwnershipChallengeV2WithContext(ctx context.Context, validateLogpushOwnershipChallengeV2Options *logpushjobsapiv1.ValidateLogpushOwnershipChallengeV2Options) (result *logpushjobsapiv1.OwnershipChallengeValidateResult, response *core.DetailedResponse, err error) {
+	mock.called("ValidateLogpushOwnershipChallengeV2WithContext", ctx, validateLogpushOwnershipChallengeV2Options)
+	if mock.ValidateLogpushOwnershipChallengeV2WithContextFunc != nil {
+		return mock.ValidateLogpushOwnershipChallengeV2WithContextFunc(ctx, validateLogpushOwnershipChallengeV2Options)
+	}
+	if mock.ValidateLogpushOwnershipChallengeV2Func != nil {
+		return mock.ValidateLogpushOwnershipChallengeV2Func(validateLogpushOwnershipChallengeV2Options)
+	}
+	err = notProgrammed("ValidateLogpushOwnershipChallengeV2WithContext")
+	return
+}
+
+// ListFieldsForDatasetV2 invokes the programmed ListFieldsForDatasetV2Func.
+func (mock *LogpushJobsApiV1) ListFieldsForDatasetV2(listFieldsForDatasetV2Options *logpushjobsapiv1.ListFieldsForDatasetV2Options) (result *logpushjobsapiv1.ListFieldsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListFieldsForDatasetV2", listFieldsForDatasetV2Options)
+	if mock.ListFieldsForDatasetV2Func != nil {
+		return mock.ListFieldsForDatasetV2Func(listFieldsForDatasetV2Options)
+	}
+	if mock.ListFieldsForDatasetV2WithContextFunc != nil {
+		return mock.ListFieldsForDatasetV2WithContextFunc(context.Background(), listFieldsForDatasetV2Options)
+	}
+	err = notProgrammed("ListFieldsForDatasetV2")
+	return
+}
+
+// ListFieldsForDatasetV2WithContext invokes the programmed ListFieldsForDatasetV2WithContextFunc.
+func (mock *LogpushJobsApiV1) ListFieldsForDatasetV2WithContext(ctx context.Context, listFieldsForDatasetV2Options *logpushjobsapiv1.ListFieldsForDatasetV2Options) (result *logpushjobsapiv1.ListFieldsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListFieldsForDatasetV2WithContext", ctx, listFieldsForDatasetV2Options)
+	if mock.ListFieldsForDatasetV2WithContextFunc != nil {
+		return mock.ListFieldsForDatasetV2WithContextFunc(ctx, listFieldsForDatasetV2Options)
+	}
+	if mock.ListFieldsForDatasetV2Func != nil {
+		return mock.ListFieldsForDatasetV2Func(listFieldsForDatasetV2Options)
+	}
+	err = notProgrammed("ListFieldsForDatasetV2WithContext")
+	return
+}
+
+// ListLogpushJobsForDatasetV2 invokes the programmed ListLogpushJobsForDatasetV2Func.
+func (mock *LogpushJobsApiV1) ListLogpushJobsForDatasetV2(listLogpushJobsForDatasetV2Options *logpushjobsapiv1.ListLogpushJobsForDatasetV2Options) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListLogpushJobsForDatasetV2", listLogpushJobsForDatasetV2Options)
+	if mock.ListLogpushJobsForDatasetV2Func != nil {
+		return mock.ListLogpushJobsForDatasetV2Func(listLogpushJobsForDatasetV2Options)
+	}
+	if mock.ListLogpushJobsForDatasetV2WithContextFunc != nil {
+		return mock.ListLogpushJobsForDatasetV2WithContextFunc(context.Background(), listLogpushJobsForDatasetV2Options)
+	}
+	err = notProgrammed("ListLogpushJobsForDatasetV2")
+	return
+}
+
+// ListLogpushJobsForDatasetV2WithContext invokes the programmed ListLogpushJobsForDatasetV2WithContextFunc.
+func (mock *LogpushJobsApiV1) ListLogpushJobsForDatasetV2WithContext(ctx context.Context, listLogpushJobsForDatasetV2Options *logpushjobsapiv1.ListLogpushJobsForDatasetV2Options) (result *logpushjobsapiv1.LogpushJobsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListLogpushJobsForDatasetV2WithContext", ctx, listLogpushJobsForDatasetV2Options)
+	if mock.ListLogpushJobsForDatasetV2WithContextFunc != nil {
+		return mock.ListLogpushJobsForDatasetV2WithContextFunc(ctx, listLogpushJobsForDatasetV2Options)
+	}
+	if mock.ListLogpushJobsForDatasetV2Func != nil {
+		return mock.ListLogpushJobsForDatasetV2Func(listLogpushJobsForDatasetV2Options)
+	}
+	err = notProgrammed("ListLogpushJobsForDatasetV2WithContext")
+	return
+}
@@ -0,0 +1,99 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mocks provides programmable mocks of the service clients, which implement the interfaces
+// of their service packages (e.g. dnssvcsv1.DnsSvcsV1API), for use in unit tests:
+//
+//   mock := &mocks.DnsSvcsV1{}
+//   mock.GetDnszoneFunc = func(options *dnssvcsv1.GetDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error) {
+//       return &dnssvcsv1.Dnszone{ID: options.DnszoneID}, &core.DetailedResponse{StatusCode: 200}, nil
+//   }
+//   var service dnssvcsv1.DnsSvcsV1API = mock
+//
+// An operation that isn't programmed returns an error that wraps ErrNotProgrammed. Every invocation
+// of an operation is recorded, and can be inspected with the Calls and CallCount methods.
+package mocks
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotProgrammed is wrapped by the errors returned by the operations that aren't programmed.
+var ErrNotProgrammed = errors.New("operation is not programmed")
+
+// Call is an invocation of an operation of a mock.
+type Call struct {
+	// The name of the operation (e.g. "GetDnszone").
+	Method string
+
+	// The arguments of the invocation.
+	Args []interface{}
+}
+
+// Mock records the invocations of the operations of a mock. It is safe for concurrent use.
+type Mock struct {
+	mutex sync.Mutex
+	calls []Call
+}
+
+// Calls returns the invocations of the operations with the given names, in order, or of all the
+// operations if no name is given.
+func (mock *Mock) Calls(methods ...string) []Call {
+	mock.mutex.Lock()
+	defer mock.mutex.Unlock()
+	var calls []Call
+	for _, call := range mock.calls {
+		if len(methods) == 0 || containsString(methods, call.Method) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// CallCount returns the number of invocations of the operation with the given name.
+func (mock *Mock) CallCount(method string) int {
+	return len(mock.Calls(method))
+}
+
+// Reset forgets the recorded invocations.
+func (mock *Mock) Reset() {
+	mock.mutex.Lock()
+	defer mock.mutex.Unlock()
+	mock.calls = nil
+}
+
+// called records an invocation.
+func (mock *Mock) called(method string, args ...interface{}) {
+	mock.mutex.Lock()
+	defer mock.mutex.Unlock()
+	mock.calls = append(mock.calls, Call{Method: method, Args: args})
+}
+
+// notProgrammed returns the error of an operation that isn't programmed.
+func notProgrammed(method string) error {
+	return fmt.Errorf("%s: %w", method, ErrNotProgrammed)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+	"github.com/stretchr/testify/assert"
+)
+
+// zoneName is the kind of code the mocks are meant for: it depends on the interface of a service
+// rather than on its client.
+func zoneName(service dnssvcsv1.DnsSvcsV1API, instanceID string, zoneID string) (string, error) {
+	zone, _, err := service.GetDnszone(&dnssvcsv1.GetDnszoneOptions{InstanceID: &instanceID, DnszoneID: &zoneID})
+	if err != nil {
+		return "", err
+	}
+	return *zone.Name, nil
+}
+
+func TestMockProgrammedOperations(t *testing.T) {
+	mock := &DnsSvcsV1{}
+	mock.GetDnszoneFunc = func(options *dnssvcsv1.GetDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error) {
+		return &dnssvcsv1.Dnszone{ID: options.DnszoneID, Name: core.StringPtr("example.com")}, &core.DetailedResponse{StatusCode: 200}, nil
+	}
+	name, err := zoneName(mock, "instance-1", "zone-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "example.com", name)
+
+	// The WithContext variant falls back to the plain one.
+	zone, response, err := mock.GetDnszoneWithContext(context.Background(), &dnssvcsv1.GetDnszoneOptions{DnszoneID: core.StringPtr("zone-2")})
+	assert.Nil(t, err)
+	assert.Equal(t, "zone-2", *zone.ID)
+	assert.Equal(t, 200, response.StatusCode)
+
+	calls := mock.Calls("GetDnszone")
+	assert.Len(t, calls, 1)
+	assert.Equal(t, "zone-1", *calls[0].Args[0].(*dnssvcsv1.GetDnszoneOptions).DnszoneID)
+	assert.Equal(t, 1, mock.CallCount("GetDnszoneWithContext"))
+	assert.Len(t, mock.Calls(), 2)
+	mock.Reset()
+	assert.Empty(t, mock.Calls())
+}
+
+func TestMockWithContextOperations(t *testing.T) {
+	mock := &ZonesV1{}
+	type key struct{}
+	mock.DeleteZoneWithContextFunc = func(ctx context.Context, options *zonesv1.DeleteZoneOptions) (*zonesv1.DeleteZoneResp, *core.DetailedResponse, error) {
+		if ctx.Value(key{}) == nil {
+			return nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found")
+		}
+		return &zonesv1.DeleteZoneResp{}, &core.DetailedResponse{StatusCode: 200}, nil
+	}
+	var service zonesv1.ZonesV1API = mock
+
+	_, _, err := service.DeleteZoneWithContext(context.WithValue(context.Background(), key{}, true), &zonesv1.DeleteZoneOptions{})
+	assert.Nil(t, err)
+	// The plain variant falls back to the WithContext one, with a background context.
+	_, response, err := service.DeleteZone(&zonesv1.DeleteZoneOptions{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+}
+
+func TestMockNotProgrammed(t *testing.T) {
+	mock := &DnsSvcsV1{}
+	_, _, err := mock.ListDnszones(&dnssvcsv1.ListDnszonesOptions{})
+	assert.True(t, errors.Is(err, ErrNotProgrammed))
+	assert.Contains(t, err.Error(), "ListDnszones")
+	assert.Equal(t, 1, mock.CallCount("ListDnszones"))
+}
@@ -0,0 +1,538 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/mtlsv1"
+)
+
+// MtlsV1 is a mock of mtlsv1.MtlsV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type MtlsV1 struct {
+	Mock
+
+	ListAccessCertificatesFunc              func(*mtlsv1.ListAccessCertificatesOptions) (*mtlsv1.ListAccessCertsResp, *core.DetailedResponse, error)
+	ListAccessCertificatesWithContextFunc   func(context.Context, *mtlsv1.ListAccessCertificatesOptions) (*mtlsv1.ListAccessCertsResp, *core.DetailedResponse, error)
+	CreateAccessCertificateFunc             func(*mtlsv1.CreateAccessCertificateOptions) (*mtlsv1.AccessCertResp, *core.DetailedResponse, error)
+	CreateAccessCertificateWithContextFunc  func(context.Context, *mtlsv1.CreateAccessCertificateOptions) (*mtlsv1.AccessCertResp, *core.DetailedResponse, error)
+	GetAccessCertificateFunc                func(*mtlsv1.GetAccessCertificateOptions) (*mtlsv1.AccessCertResp, *core.DetailedResponse, error)
+	GetAccessCertificateWithContextFunc     func(context.Context, *mtlsv1.GetAccessCertificateOptions) (*mtlsv1.AccessCertResp, *core.DetailedResponse, error)
+	UpdateAccessCertificateFunc             func(*mtlsv1.UpdateAccessCertificateOptions) (*mtlsv1.AccessCertResp, *core.DetailedResponse, error)
+	UpdateAccessCertificateWithContextFunc  func(context.Context, *mtlsv1.UpdateAccessCertificateOptions) (*mtlsv1.AccessCertResp, *core.DetailedResponse, error)
+	DeleteAccessCertificateFunc             func(*mtlsv1.DeleteAccessCertificateOptions) (*mtlsv1.DeleteAccessCertResp, *core.DetailedResponse, error)
+	DeleteAccessCertificateWithContextFunc  func(context.Context, *mtlsv1.DeleteAccessCertificateOptions) (*mtlsv1.DeleteAccessCertResp, *core.DetailedResponse, error)
+	ListAccessApplicationsFunc              func(*mtlsv1.ListAccessApplicationsOptions) (*mtlsv1.ListAccessAppsResp, *core.DetailedResponse, error)
+	ListAccessApplicationsWithContextFunc   func(context.Context, *mtlsv1.ListAccessApplicationsOptions) (*mtlsv1.ListAccessAppsResp, *core.DetailedResponse, error)
+	CreateAccessApplicationFunc             func(*mtlsv1.CreateAccessApplicationOptions) (*mtlsv1.CreateAccessAppResp, *core.DetailedResponse, error)
+	CreateAccessApplicationWithContextFunc  func(context.Context, *mtlsv1.CreateAccessApplicationOptions) (*mtlsv1.CreateAccessAppResp, *core.DetailedResponse, error)
+	GetAccessApplicationFunc                func(*mtlsv1.GetAccessApplicationOptions) (*mtlsv1.AccessAppResp, *core.DetailedResponse, error)
+	GetAccessApplicationWithContextFunc     func(context.Context, *mtlsv1.GetAccessApplicationOptions) (*mtlsv1.AccessAppResp, *core.DetailedResponse, error)
+	UpdateAccessApplicationFunc             func(*mtlsv1.UpdateAccessApplicationOptions) (*mtlsv1.AccessAppResp, *core.DetailedResponse, error)
+	UpdateAccessApplicationWithContextFunc  func(context.Context, *mtlsv1.UpdateAccessApplicationOptions) (*mtlsv1.AccessAppResp, *core.DetailedResponse, error)
+	DeleteAccessApplicationFunc             func(*mtlsv1.DeleteAccessApplicationOptions) (*mtlsv1.DeleteAccessAppResp, *core.DetailedResponse, error)
+	DeleteAccessApplicationWithContextFunc  func(context.Context, *mtlsv1.DeleteAccessApplicationOptions) (*mtlsv1.DeleteAccessAppResp, *core.DetailedResponse, error)
+	ListAccessPoliciesFunc                  func(*mtlsv1.ListAccessPoliciesOptions) (*mtlsv1.ListAccessPoliciesResp, *core.DetailedResponse, error)
+	ListAccessPoliciesWithContextFunc       func(context.Context, *mtlsv1.ListAccessPoliciesOptions) (*mtlsv1.ListAccessPoliciesResp, *core.DetailedResponse, error)
+	CreateAccessPolicyFunc                  func(*mtlsv1.CreateAccessPolicyOptions) (*mtlsv1.AccessPolicyResp, *core.DetailedResponse, error)
+	CreateAccessPolicyWithContextFunc       func(context.Context, *mtlsv1.CreateAccessPolicyOptions) (*mtlsv1.AccessPolicyResp, *core.DetailedResponse, error)
+	GetAccessPolicyFunc                     func(*mtlsv1.GetAccessPolicyOptions) (*mtlsv1.AccessPolicyResp, *core.DetailedResponse, error)
+	GetAccessPolicyWithContextFunc          func(context.Context, *mtlsv1.GetAccessPolicyOptions) (*mtlsv1.AccessPolicyResp, *core.DetailedResponse, error)
+	UpdateAccessPolicyFunc                  func(*mtlsv1.UpdateAccessPolicyOptions) (*mtlsv1.AccessPolicyResp, *core.DetailedResponse, error)
+	UpdateAccessPolicyWithContextFunc       func(context.Context, *mtlsv1.UpdateAccessPolicyOptions) (*mtlsv1.AccessPolicyResp, *core.DetailedResponse, error)
+	DeleteAccessPolicyFunc                  func(*mtlsv1.DeleteAccessPolicyOptions) (*mtlsv1.DeleteAccessPolicyResp, *core.DetailedResponse, error)
+	DeleteAccessPolicyWithContextFunc       func(context.Context, *mtlsv1.DeleteAccessPolicyOptions) (*mtlsv1.DeleteAccessPolicyResp, *core.DetailedResponse, error)
+	GetAccessCertSettingsFunc               func(*mtlsv1.GetAccessCertSettingsOptions) (*mtlsv1.AccessCertSettingsResp, *core.DetailedResponse, error)
+	GetAccessCertSettingsWithContextFunc    func(context.Context, *mtlsv1.GetAccessCertSettingsOptions) (*mtlsv1.AccessCertSettingsResp, *core.DetailedResponse, error)
+	UpdateAccessCertSettingsFunc            func(*mtlsv1.UpdateAccessCertSettingsOptions) (*mtlsv1.AccessCertSettingsResp, *core.DetailedResponse, error)
+	UpdateAccessCertSettingsWithContextFunc func(context.Context, *mtlsv1.UpdateAccessCertSettingsOptions) (*mtlsv1.AccessCertSettingsResp, *core.DetailedResponse, error)
+	CreateAccessOrganizationFunc            func(*mtlsv1.CreateAccessOrganizationOptions) (*mtlsv1.AccessOrgResp, *core.DetailedResponse, error)
+	CreateAccessOrganizationWithContextFunc func(context.Context, *mtlsv1.CreateAccessOrganizationOptions) (*mtlsv1.AccessOrgResp, *core.DetailedResponse, error)
+}
+
+// MtlsV1 implements mtlsv1.MtlsV1API.
+var _ mtlsv1.MtlsV1API = (*MtlsV1)(nil)
+
+// ListAccessCertificates invokes the programmed ListAccessCertificatesFunc.
+func (mock *MtlsV1) ListAccessCertificates(listAccessCertificatesOptions *mtlsv1.ListAccessCertificatesOptions) (result *mtlsv1.ListAccessCertsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAccessCertificates", listAccessCertificatesOptions)
+	if mock.ListAccessCertificatesFunc != nil {
+		return mock.ListAccessCertificatesFunc(listAccessCertificatesOptions)
+	}
+	if mock.ListAccessCertificatesWithContextFunc != nil {
+		return mock.ListAccessCertificatesWithContextFunc(context.Background(), listAccessCertificatesOptions)
+	}
+	err = notProgrammed("ListAccessCertificates")
+	return
+}
+
+// ListAccessCertificatesWithContext invokes the programmed ListAccessCertificatesWithContextFunc.
+func (mock *MtlsV1) ListAccessCertificatesWithContext(ctx context.Context, listAccessCertificatesOptions *mtlsv1.ListAccessCertificatesOptions) (result *mtlsv1.ListAccessCertsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAccessCertificatesWithContext", ctx, listAccessCertificatesOptions)
+	if mock.ListAccessCertificatesWithContextFunc != nil {
+		return mock.ListAccessCertificatesWithContextFunc(ctx, listAccessCertificatesOptions)
+	}
+	if mock.ListAccessCertificatesFunc != nil {
+		return mock.ListAccessCertificatesFunc(listAccessCertificatesOptions)
+	}
+	err = notProgrammed("ListAccessCertificatesWithContext")
+	return
+}
+
+// CreateAccessCertificate invokes the programmed CreateAccessCertificateFunc.
+func (mock *MtlsV1) CreateAccessCertificate(createAccessCertificateOptions *mtlsv1.CreateAccessCertificateOptions) (result *mtlsv1.AccessCertResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAccessCertificate", createAccessCertificateOptions)
+	if mock.CreateAccessCertificateFunc != nil {
+		return mock.CreateAccessCertificateFunc(createAccessCertificateOptions)
+	}
+	if mock.CreateAccessCertificateWithContextFunc != nil {
+		return mock.CreateAccessCertificateWithContextFunc(context.Background(), createAccessCertificateOptions)
+	}
+	err = notProgrammed("CreateAccessCertificate")
+	return
+}
+
+// CreateAccessCertificateWithContext invokes the programmed CreateAccessCertificateWithContextFunc.
+func (mock *MtlsV1) CreateAccessCertificateWithContext(ctx context.Context, createAccessCertificateOptions *mtlsv1.CreateAccessCertificateOptions) (result *mtlsv1.AccessCertResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAccessCertificateWithContext", ctx, createAccessCertificateOptions)
+	if mock.CreateAccessCertificateWithContextFunc != nil {
+		return mock.CreateAccessCertificateWithContextFunc(ctx, createAccessCertificateOptions)
+	}
+	if mock.CreateAccessCertificateFunc != nil {
+		return mock.CreateAccessCertificateFunc(createAccessCertificateOptions)
+	}
+	err = notProgrammed("CreateAccessCertificateWithContext")
+	return
+}
+
+// GetAccessCertificate invokes the programmed GetAccessCertificateFunc.
+func (mock *MtlsV1) GetAccessCertificate(getAccessCertificateOptions *mtlsv1.GetAccessCertificateOptions) (result *mtlsv1.AccessCertResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAccessCertificate", getAccessCertificateOptions)
+	if mock.GetAccessCertificateFunc != nil {
+		return mock.GetAccessCertificateFunc(getAccessCertificateOptions)
+	}
+	if mock.GetAccessCertificateWithContextFunc != nil {
+		return mock.GetAccessCertificateWithContextFunc(context.Background(), getAccessCertificateOptions)
+	}
+	err = notProgrammed("GetAccessCertificate")
+	return
+}
+
+// GetAccessCertificateWithContext invokes the programmed GetAccessCertificateWithContextFunc.
+func (mock *MtlsV1) GetAccessCertificateWithContext(ctx context.Context, getAccessCertificateOptions *mtlsv1.GetAccessCertificateOptions) (result *mtlsv1.AccessCertResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAccessCertificateWithContext", ctx, getAccessCertificateOptions)
+	if mock.GetAccessCertificateWithContextFunc != nil {
+		return mock.GetAccessCertificateWithContextFunc(ctx, getAccessCertificateOptions)
+	}
+	if mock.GetAccessCertificateFunc != nil {
+		return mock.GetAccessCertificateFunc(getAccessCertificateOptions)
+	}
+	err = notProgrammed("GetAccessCertificateWithContext")
+	return
+}
+
+// UpdateAccessCertificate invokes the programmed UpdateAccessCertificateFunc.
+func (mock *MtlsV1) UpdateAccessCertificate(updateAccessCertificateOptions *mtlsv1.UpdateAccessCertificateOptions) (result *mtlsv1.AccessCertResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAccessCertificate", updateAccessCertificateOptions)
+	if mock.UpdateAccessCertificateFunc != nil {
+		return mock.UpdateAccessCertificateFunc(updateAccessCertificateOptions)
+	}
+	if mock.UpdateAccessCertificateWithContextFunc != nil {
+		return mock.UpdateAccessCertificateWithContextFunc(context.Background(), updateAccessCertificateOptions)
+	}
+	err = notProgrammed("UpdateAccessCertificate")
+	return
+}
+
+// UpdateAccessCertificateWithContext invokes the programmed UpdateAccessCertificateWithContextFunc.
+func (mock *MtlsV1) UpdateAccessCertificateWithContext(ctx context.Context, updateAccessCertificateOptions *mtlsv1.UpdateAccessCertificateOptions) (result *mtlsv1.AccessCertResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAccessCertificateWithContext", ctx, updateAccessCertificateOptions)
+	if mock.UpdateAccessCertificateWithContextFunc != nil {
+		return mock.UpdateAccessCertificateWithContextFunc(ctx, updateAccessCertificateOptions)
+	}
+	if mock.UpdateAccessCertificateFunc != nil {
+		return mock.UpdateAccessCertificateFunc(updateAccessCertificateOptions)
+	}
+	err = notProgrammed("UpdateAccessCertificateWithContext")
+	return
+}
+
+// DeleteAccessCertificate invokes the programmed DeleteAccessCertificateFunc.
+func (mock *MtlsV1) DeleteAccessCertificate(deleteAccessCertificateOptions *mtlsv1.DeleteAccessCertificateOptions) (result *mtlsv1.DeleteAccessCertResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteAccessCertificate", deleteAccessCertificateOptions)
+	if mock.DeleteAccessCertificateFunc != nil {
+		return mock.DeleteAccessCertificateFunc(deleteAccessCertificateOptions)
+	}
+	if mock.DeleteAccessCertificateWithContextFunc != nil {
+		return mock.DeleteAccessCertificateWithContextFunc(context.Background(), deleteAccessCertificateOptions)
+	}
+	err = notProgrammed("DeleteAccessCertificate")
+	return
+}
+
+// DeleteAccessCertificateWithContext invokes the programmed DeleteAccessCertificateWithContextFunc.
+func (mock *MtlsV1) DeleteAccessCertificateWithContext(ctx context.Context, deleteAccessCertificateOptions *mtlsv1.DeleteAccessCertificateOptions) (result *mtlsv1.DeleteAccessCertResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteAccessCertificateWithContext", ctx, deleteAccessCertificateOptions)
+	if mock.DeleteAccessCertificateWithContextFunc != nil {
+		return mock.DeleteAccessCertificateWithContextFunc(ctx, deleteAccessCertificateOptions)
+	}
+	if mock.DeleteAccessCertificateFunc != nil {
+		return mock.DeleteAccessCertificateFunc(deleteAccessCertificateOptions)
+	}
+	err = notProgrammed("DeleteAccessCertificateWithContext")
+	return
+}
+
+// ListAccessApplications invokes the programmed ListAccessApplicationsFunc.
+func (mock *MtlsV1) ListAccessApplications(listAccessApplicationsOptions *mtlsv1.ListAccessApplicationsOptions) (result *mtlsv1.ListAccessAppsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAccessApplications", listAccessApplicationsOptions)
+	if mock.ListAccessApplicationsFunc != nil {
+		return mock.ListAccessApplicationsFunc(listAccessApplicationsOptions)
+	}
+	if mock.ListAccessApplicationsWithContextFunc != nil {
+		return mock.ListAccessApplicationsWithContextFunc(context.Background(), listAccessApplicationsOptions)
+	}
+	err = notProgrammed("ListAccessApplications")
+	return
+}
+
+// ListAccessApplicationsWithContext invokes the programmed ListAccessApplicationsWithContextFunc.
+func (mock *MtlsV1) ListAccessApplicationsWithContext(ctx context.Context, listAccessApplicationsOptions *mtlsv1.ListAccessApplicationsOptions) (result *mtlsv1.ListAccessAppsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAccessApplicationsWithContext", ctx, listAccessApplicationsOptions)
+	if mock.ListAccessApplicationsWithContextFunc != nil {
+		return mock.ListAccessApplicationsWithContextFunc(ctx, listAccessApplicationsOptions)
+	}
+	if mock.ListAccessApplicationsFunc != nil {
+		return mock.ListAccessApplicationsFunc(listAccessApplicationsOptions)
+	}
+	err = notProgrammed("ListAccessApplicationsWithContext")
+	return
+}
+
+// CreateAccessApplication invokes the programmed CreateAccessApplicationFunc.
+func (mock *MtlsV1) CreateAccessApplication(createAccessApplicationOptions *mtlsv1.CreateAccessApplicationOptions) (result *mtlsv1.CreateAccessAppResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAccessApplication", createAccessApplicationOptions)
+	if mock.CreateAccessApplicationFunc != nil {
+		return mock.CreateAccessApplicationFunc(createAccessApplicationOptions)
+	}
+	if mock.CreateAccessApplicationWithContextFunc != nil {
+		return mock.CreateAccessApplicationWithContextFunc(context.Background(), createAccessApplicationOptions)
+	}
+	err = notProgrammed("CreateAccessApplication")
+	return
+}
+
+// CreateAccessApplicationWithContext invokes the programmed CreateAccessApplicationWithContextFunc.
+func (mock *MtlsV1) CreateAccessApplicationWithContext(ctx context.Context, createAccessApplicationOptions *mtlsv1.CreateAccessApplicationOptions) (result *mtlsv1.CreateAccessAppResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAccessApplicationWithContext", ctx, createAccessApplicationOptions)
+	if mock.CreateAccessApplicationWithContextFunc != nil {
+		return mock.CreateAccessApplicationWithContextFunc(ctx, createAccessApplicationOptions)
+	}
+	if mock.CreateAccessApplicationFunc != nil {
+		return mock.CreateAccessApplicationFunc(createAccessApplicationOptions)
+	}
+	err = notProgrammed("CreateAccessApplicationWithContext")
+	return
+}
+
+// GetAccessApplication invokes the programmed GetAccessApplicationFunc.
+func (mock *MtlsV1) GetAccessApplication(getAccessApplicationOptions *mtlsv1.GetAccessApplicationOptions) (result *mtlsv1.AccessAppResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAccessApplication", getAccessApplicationOptions)
+	if mock.GetAccessApplicationFunc != nil {
+		return mock.GetAccessApplicationFunc(getAccessApplicationOptions)
+	}
+	if mock.GetAccessApplicationWithContextFunc != nil {
+		return mock.GetAccessApplicationWithContextFunc(context.Background(), getAccessApplicationOptions)
+	}
+	err = notProgrammed("GetAccessApplication")
+	return
+}
+
+// GetAccessApplicationWithContext invokes the programmed GetAccessApplicationWithContextFunc.
+func (mock *MtlsV1) GetAccessApplicationWithContext(ctx context.Context, getAccessApplicationOptions *mtlsv1.GetAccessApplicationOptions) (result *mtlsv1.AccessAppResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAccessApplicationWithContext", ctx, getAccessApplicationOptions)
+	if mock.GetAccessApplicationWithContextFunc != nil {
+		return mock.GetAccessApplicationWithContextFunc(ctx, getAccessApplicationOptions)
+	}
+	if mock.GetAccessApplicationFunc != nil {
+		return mock.GetAccessApplicationFunc(getAccessApplicationOptions)
+	}
+	err = notProgrammed("GetAccessApplicationWithContext")
+	return
+}
+
+// UpdateAccessApplication invokes the programmed UpdateAccessApplicationFunc.
+func (mock *MtlsV1) UpdateAccessApplication(updateAccessApplicationOptions *mtlsv1.UpdateAccessApplicationOptions) (result *mtlsv1.AccessAppResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAccessApplication", updateAccessApplicationOptions)
+	if mock.UpdateAccessApplicationFunc != nil {
+		return mock.UpdateAccessApplicationFunc(updateAccessApplicationOptions)
+	}
+	if mock.UpdateAccessApplicationWithContextFunc != nil {
+		return mock.UpdateAccessApplicationWithContextFunc(context.Background(), updateAccessApplicationOptions)
+	}
+	err = notProgrammed("UpdateAccessApplication")
+	return
+}
+
+// UpdateAccessApplicationWithContext invokes the programmed UpdateAccessApplicationWithContextFunc.
+func (mock *MtlsV1) UpdateAccessApplicationWithContext(ctx context.Context, updateAccessApplicationOptions *mtlsv1.UpdateAccessApplicationOptions) (result *mtlsv1.AccessAppResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAccessApplicationWithContext", ctx, updateAccessApplicationOptions)
+	if mock.UpdateAccessApplicationWithContextFunc != nil {
+		return mock.UpdateAccessApplicationWithContextFunc(ctx, updateAccessApplicationOptions)
+	}
+	if mock.UpdateAccessApplicationFunc != nil {
+		return mock.UpdateAccessApplicationFunc(updateAccessApplicationOptions)
+	}
+	err = notProgrammed("UpdateAccessApplicationWithContext")
+	return
+}
+
+// DeleteAccessApplication invokes the programmed DeleteAccessApplicationFunc.
+func (mock *MtlsV1) DeleteAccessApplication(deleteAccessApplicationOptions *mtlsv1.DeleteAccessApplicationOptions) (result *mtlsv1.DeleteAccessAppResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteAccessApplication", deleteAccessApplicationOptions)
+	if mock.DeleteAccessApplicationFunc != nil {
+		return mock.DeleteAccessApplicationFunc(deleteAccessApplicationOptions)
+	}
+	if mock.DeleteAccessApplicationWithContextFunc != nil {
+		return mock.DeleteAccessApplicationWithContextFunc(context.Background(), deleteAccessApplicationOptions)
+	}
+	err = notProgrammed("DeleteAccessApplication")
+	return
+}
+
+// DeleteAccessApplicationWithContext invokes the programmed DeleteAccessApplicationWithContextFunc.
+func (mock *MtlsV1) DeleteAccessApplicationWithContext(ctx context.Context, deleteAccessApplicationOptions *mtlsv1.DeleteAccessApplicationOptions) (result *mtlsv1.DeleteAccessAppResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteAccessApplicationWithContext", ctx, deleteAccessApplicationOptions)
+	if mock.DeleteAccessApplicationWithContextFunc != nil {
+		return mock.DeleteAccessApplicationWithContextFunc(ctx, deleteAccessApplicationOptions)
+	}
+	if mock.DeleteAccessApplicationFunc != nil {
+		return mock.DeleteAccessApplicationFunc(deleteAccessApplicationOptions)
+	}
+	err = notProgrammed("DeleteAccessApplicationWithContext")
+	return
+}
+
+// ListAccessPolicies invokes the programmed ListAccessPoliciesFunc.
+func (mock *MtlsV1) ListAccessPolicies(listAccessPoliciesOptions *mtlsv1.ListAccessPoliciesOptions) (result *mtlsv1.ListAccessPoliciesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAccessPolicies", listAccessPoliciesOptions)
+	if mock.ListAccessPoliciesFunc != nil {
+		return mock.ListAccessPoliciesFunc(listAccessPoliciesOptions)
+	}
+	if mock.ListAccessPoliciesWithContextFunc != nil {
+		return mock.ListAccessPoliciesWithContextFunc(context.Background(), listAccessPoliciesOptions)
+	}
+	err = notProgrammed("ListAccessPolicies")
+	return
+}
+
+// ListAccessPoliciesWithContext invokes the programmed ListAccessPoliciesWithContextFunc.
+func (mock *MtlsV1) ListAccessPoliciesWithContext(ctx context.Context, listAccessPoliciesOptions *mtlsv1.ListAccessPoliciesOptions) (result *mtlsv1.ListAccessPoliciesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAccessPoliciesWithContext", ctx, listAccessPoliciesOptions)
+	if mock.ListAccessPoliciesWithContextFunc != nil {
+		return mock.ListAccessPoliciesWithContextFunc(ctx, listAccessPoliciesOptions)
+	}
+	if mock.ListAccessPoliciesFunc != nil {
+		return mock.ListAccessPoliciesFunc(listAccessPoliciesOptions)
+	}
+	err = notProgrammed("ListAccessPoliciesWithContext")
+	return
+}
+
+// CreateAccessPolicy invokes the programmed CreateAccessPolicyFunc.
+func (mock *MtlsV1) CreateAccessPolicy(createAccessPolicyOptions *mtlsv1.CreateAccessPolicyOptions) (result *mtlsv1.AccessPolicyResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAccessPolicy", createAccessPolicyOptions)
+	if mock.CreateAccessPolicyFunc != nil {
+		return mock.CreateAccessPolicyFunc(createAccessPolicyOptions)
+	}
+	if mock.CreateAccessPolicyWithContextFunc != nil {
+		return mock.CreateAccessPolicyWithContextFunc(context.Background(), createAccessPolicyOptions)
+	}
+	err = notProgrammed("CreateAccessPolicy")
+	return
+}
+
+// CreateAccessPolicyWithContext invokes the programmed CreateAccessPolicyWithContextFunc.
+func (mock *MtlsV1) CreateAccessPolicyWithContext(ctx context.Context, createAccessPolicyOptions *mtlsv1.CreateAccessPolicyOptions) (result *mtlsv1.AccessPolicyResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAccessPolicyWithContext", ctx, createAccessPolicyOptions)
+	if mock.CreateAccessPolicyWithContextFunc != nil {
+		return mock.CreateAccessPolicyWithContextFunc(ctx, createAccessPolicyOptions)
+	}
+	if mock.CreateAccessPolicyFunc != nil {
+		return mock.CreateAccessPolicyFunc(createAccessPolicyOptions)
+	}
+	err = notProgrammed("CreateAccessPolicyWithContext")
+	return
+}
+
+// GetAccessPolicy invokes the programmed GetAccessPolicyFunc.
+func (mock *MtlsV1) GetAccessPolicy(getAccessPolicyOptions *mtlsv1.GetAccessPolicyOptions) (result *mtlsv1.AccessPolicyResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAccessPolicy", getAccessPolicyOptions)
+	if mock.GetAccessPolicyFunc != nil {
+		return mock.GetAccessPolicyFunc(getAccessPolicyOptions)
+	}
+	if mock.GetAccessPolicyWithContextFunc != nil {
+		return mock.GetAccessPolicyWithContextFunc(context.Background(), getAccessPolicyOptions)
+	}
+	err = notProgrammed("GetAccessPolicy")
+	return
+}
+
+// GetAccessPolicyWithContext invokes the programmed GetAccessPolicyWithContextFunc.
+func (mock *MtlsV1) GetAccessPolicyWithContext(ctx context.Context, getAccessPolicyOptions *mtlsv1.GetAccessPolicyOptions) (result *mtlsv1.AccessPolicyResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAccessPolicyWithContext", ctx, getAccessPolicyOptions)
+	if mock.GetAccessPolicyWithContextFunc != nil {
+		return mock.GetAccessPolicyWithContextFunc(ctx, getAccessPolicyOptions)
+	}
+	if mock.GetAccessPolicyFunc != nil {
+		return mock.GetAccessPolicyFunc(getAccessPolicyOptions)
+	}
+	err = notProgrammed("GetAccessPolicyWithContext")
+	return
+}
+
+// UpdateAccessPolicy invokes the programmed UpdateAccessPolicyFunc.
+func (mock *MtlsV1) UpdateAccessPolicy(updateAccessPolicyOptions *mtlsv1.UpdateAccessPolicyOptions) (result *mtlsv1.AccessPolicyResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAccessPolicy", updateAccessPolicyOptions)
+	if mock.UpdateAccessPolicyFunc != nil {
+		return mock.UpdateAccessPolicyFunc(updateAccessPolicyOptions)
+	}
+	if mock.UpdateAccessPolicyWithContextFunc != nil {
+		return mock.UpdateAccessPolicyWithContextFunc(context.Background(), updateAccessPolicyOptions)
+	}
+	err = notProgrammed("UpdateAccessPolicy")
+	return
+}
+
+// UpdateAccessPolicyWithContext invokes the programmed UpdateAccessPolicyWithContextFunc.
+func (mock *MtlsV1) UpdateAccessPolicyWithContext(ctx context.Context, updateAccessPolicyOptions *mtlsv1.UpdateAccessPolicyOptions) (result *mtlsv1.AccessPolicyResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAccessPolicyWithContext", ctx, updateAccessPolicyOptions)
+	if mock.UpdateAccessPolicyWithContextFunc != nil {
+		return mock.UpdateAccessPolicyWithContextFunc(ctx, updateAccessPolicyOptions)
+	}
+	if mock.UpdateAccessPolicyFunc != nil {
+		return mock.UpdateAccessPolicyFunc(updateAccessPolicyOptions)
+	}
+	err = notProgrammed("UpdateAccessPolicyWithContext")
+	return
+}
+
+// DeleteAccessPolicy invokes the programmed DeleteAccessPolicyFunc.
+func (mock *MtlsV1) DeleteAccessPolicy(deleteAccessPolicyOptions *mtlsv1.DeleteAccessPolicyOptions) (result *mtlsv1.DeleteAccessPolicyResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteAccessPolicy", deleteAccessPolicyOptions)
+	if mock.DeleteAccessPolicyFunc != nil {
+		return mock.DeleteAccessPolicyFunc(deleteAccessPolicyOptions)
+	}
+	if mock.DeleteAccessPolicyWithContextFunc != nil {
+		return mock.DeleteAccessPolicyWithContextFunc(context.Background(), deleteAccessPolicyOptions)
+	}
+	err = notProgrammed("DeleteAccessPolicy")
+	return
+}
+
+// DeleteAccessPolicyWithContext invokes the programmed DeleteAccessPolicyWithContextFunc.
+func (mock *MtlsV1) DeleteAccessPolicyWithContext(ctx context.Context, deleteAccessPolicyOptions *mtlsv1.DeleteAccessPolicyOptions) (result *mtlsv1.DeleteAccessPolicyResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteAccessPolicyWithContext", ctx, deleteAccessPolicyOptions)
+	if mock.DeleteAccessPolicyWithContextFunc != nil {
+		return mock.DeleteAccessPolicyWithContextFunc(ctx, deleteAccessPolicyOptions)
+	}
+	if mock.DeleteAccessPolicyFunc != nil {
+		return mock.DeleteAccessPolicyFunc(deleteAccessPolicyOptions)
+	}
+	err = notProgrammed("DeleteAccessPolicyWithContext")
+	return
+}
+
+// GetAccessCertSettings invokes the programmed GetAccessCertSettingsFunc.
+func (mock *MtlsV1) GetAccessCertSettings(getAccessCertSettingsOptions *mtlsv1.GetAccessCertSettingsOptions) (result *mtlsv1.AccessCertSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAccessCertSettings", getAccessCertSettingsOptions)
+	if mock.GetAccessCertSettingsFunc != nil {
+		return mock.GetAccessCertSettingsFunc(getAccessCertSettingsOptions)
+	}
+	if mock.GetAccessCertSettingsWithContextFunc != nil {
+		return mock.GetAccessCertSettingsWithContextFunc(context.Background(), getAccessCertSettingsOptions)
+	}
+	err = notProgrammed("GetAccessCertSettings")
+	return
+}
+
+// GetAccessCertSettingsWithContext invokes the programmed GetAccessCertSettingsWithContextFunc.
+func (mock *MtlsV1) GetAccessCertSettingsWithContext(ctx context.Context, getAccessCertSettingsOptions *mtlsv1.GetAccessCertSettingsOptions) (result *mtlsv1.AccessCertSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAccessCertSettingsWithContext", ctx, getAccessCertSettingsOptions)
+	if mock.GetAccessCertSettingsWithContextFunc != nil {
+		return mock.GetAccessCertSettingsWithContextFunc(ctx, getAccessCertSettingsOptions)
+	}
+	if mock.GetAccessCertSettingsFunc != nil {
+		return mock.GetAccessCertSettingsFunc(getAccessCertSettingsOptions)
+	}
+	err = notProgrammed("GetAccessCertSettingsWithContext")
+	return
+}
+
+// UpdateAccessCertSettings invokes the programmed UpdateAccessCertSettingsFunc.
+func (mock *MtlsV1) UpdateAccessCertSettings(updateAccessCertSettingsOptions *mtlsv1.UpdateAccessCertSettingsOptions) (result *mtlsv1.AccessCertSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAccessCertSettings", updateAccessCertSettingsOptions)
+	if mock.UpdateAccessCertSettingsFunc != nil {
+		return mock.UpdateAccessCertSettingsFunc(updateAccessCertSettingsOptions)
+	}
+	if mock.UpdateAccessCertSettingsWithContextFunc != nil {
+		return mock.UpdateAccessCertSettingsWithContextFunc(context.Background(), updateAccessCertSettingsOptions)
+	}
+	err = notProgrammed("UpdateAccessCertSettings")
+	return
+}
+
+// UpdateAccessCertSettingsWithContext invokes the programmed UpdateAccessCertSettingsWithContextFunc.
+func (mock *MtlsV1) UpdateAccessCertSettingsWithContext(ctx context.Context, updateAccessCertSettingsOptions *mtlsv1.UpdateAccessCertSettingsOptions) (result *mtlsv1.AccessCertSettingsResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAccessCertSettingsWithContext", ctx, updateAccessCertSettingsOptions)
+	if mock.UpdateAccessCertSettingsWithContextFunc != nil {
+		return mock.UpdateAccessCertSettingsWithContextFunc(ctx, updateAccessCertSettingsOptions)
+	}
+	if mock.UpdateAccessCertSettingsFunc != nil {
+		return mock.UpdateAccessCertSettingsFunc(updateAccessCertSettingsOptions)
+	}
+	err = notProgrammed("UpdateAccessCertSettingsWithContext")
+	return
+}
+
+// CreateAccessOrganization invokes the programmed CreateAccessOrganizationFunc.
+func (mock *MtlsV1) CreateAccessOrganization(createAccessOrganizationOptions *mtlsv1.CreateAccessOrganizationOptions) (result *mtlsv1.AccessOrgResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAccessOrganization", createAccessOrganizationOptions)
+	if mock.CreateAccessOrganizationFunc != nil {
+		return mock.CreateAccessOrganizationFunc(createAccessOrganizationOptions)
+	}
+	if mock.CreateAccessOrganizationWithContextFunc != nil {
+		return mock.CreateAccessOrganizationWithContextFunc(context.Background(), createAccessOrganizationOptions)
+	}
+	err = notProgrammed("CreateAccessOrganization")
+	return
+}
+
+// CreateAccessOrganizationWithContext invokes the programmed CreateAccessOrganizationWithContextFunc.
+func (mock *MtlsV1) CreateAccessOrganizationWithContext(ctx context.Context, createAccessOrganizationOptions *mtlsv1.CreateAccessOrganizationOptions) (result *mtlsv1.AccessOrgResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAccessOrganizationWithContext", ctx, createAccessOrganizationOptions)
+	if mock.CreateAccessOrganizationWithContextFunc != nil {
+		return mock.CreateAccessOrganizationWithContextFunc(ctx, createAccessOrganizationOptions)
+	}
+	if mock.CreateAccessOrganizationFunc != nil {
+		return mock.CreateAccessOrganizationFunc(createAccessOrganizationOptions)
+	}
+	err = notProgrammed("CreateAccessOrganizationWithContext")
+	return
+}
@@ -0,0 +1,202 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/pageruleapiv1"
+)
+
+// PageRuleApiV1 is a mock of pageruleapiv1.PageRuleApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type PageRuleApiV1 struct {
+	Mock
+
+	GetPageRuleFunc               func(*pageruleapiv1.GetPageRuleOptions) (*pageruleapiv1.PageRulesResponseWithoutResultInfo, *core.DetailedResponse, error)
+	GetPageRuleWithContextFunc    func(context.Context, *pageruleapiv1.GetPageRuleOptions) (*pageruleapiv1.PageRulesResponseWithoutResultInfo, *core.DetailedResponse, error)
+	ChangePageRuleFunc            func(*pageruleapiv1.ChangePageRuleOptions) (*pageruleapiv1.PageRulesResponseWithoutResultInfo, *core.DetailedResponse, error)
+	ChangePageRuleWithContextFunc func(context.Context, *pageruleapiv1.ChangePageRuleOptions) (*pageruleapiv1.PageRulesResponseWithoutResultInfo, *core.DetailedResponse, error)
+	UpdatePageRuleFunc            func(*pageruleapiv1.UpdatePageRuleOptions) (*pageruleapiv1.PageRulesResponseWithoutResultInfo, *core.DetailedResponse, error)
+	UpdatePageRuleWithContextFunc func(context.Context, *pageruleapiv1.UpdatePageRuleOptions) (*pageruleapiv1.PageRulesResponseWithoutResultInfo, *core.DetailedResponse, error)
+	DeletePageRuleFunc            func(*pageruleapiv1.DeletePageRuleOptions) (*pageruleapiv1.PageRulesDeleteResponse, *core.DetailedResponse, error)
+	DeletePageRuleWithContextFunc func(context.Context, *pageruleapiv1.DeletePageRuleOptions) (*pageruleapiv1.PageRulesDeleteResponse, *core.DetailedResponse, error)
+	ListPageRulesFunc             func(*pageruleapiv1.ListPageRulesOptions) (*pageruleapiv1.PageRulesResponseListAll, *core.DetailedResponse, error)
+	ListPageRulesWithContextFunc  func(context.Context, *pageruleapiv1.ListPageRulesOptions) (*pageruleapiv1.PageRulesResponseListAll, *core.DetailedResponse, error)
+	CreatePageRuleFunc            func(*pageruleapiv1.CreatePageRuleOptions) (*pageruleapiv1.PageRulesResponseWithoutResultInfo, *core.DetailedResponse, error)
+	CreatePageRuleWithContextFunc func(context.Context, *pageruleapiv1.CreatePageRuleOptions) (*pageruleapiv1.PageRulesResponseWithoutResultInfo, *core.DetailedResponse, error)
+}
+
+// PageRuleApiV1 implements pageruleapiv1.PageRuleApiV1API.
+var _ pageruleapiv1.PageRuleApiV1API = (*PageRuleApiV1)(nil)
+
+// GetPageRule invokes the programmed GetPageRuleFunc.
+func (mock *PageRuleApiV1) GetPageRule(getPageRuleOptions *pageruleapiv1.GetPageRuleOptions) (result *pageruleapiv1.PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error) {
+	mock.called("GetPageRule", getPageRuleOptions)
+	if mock.GetPageRuleFunc != nil {
+		return mock.GetPageRuleFunc(getPageRuleOptions)
+	}
+	if mock.GetPageRuleWithContextFunc != nil {
+		return mock.GetPageRuleWithContextFunc(context.Background(), getPageRuleOptions)
+	}
+	err = notProgrammed("GetPageRule")
+	return
+}
+
+// GetPageRuleWithContext invokes the programmed GetPageRuleWithContextFunc.
+func (mock *PageRuleApiV1) GetPageRuleWithContext(ctx context.Context, getPageRuleOptions *pageruleapiv1.GetPageRuleOptions) (result *pageruleapiv1.PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error) {
+	mock.called("GetPageRuleWithContext", ctx, getPageRuleOptions)
+	if mock.GetPageRuleWithContextFunc != nil {
+		return mock.GetPageRuleWithContextFunc(ctx, getPageRuleOptions)
+	}
+	if mock.GetPageRuleFunc != nil {
+		return mock.GetPageRuleFunc(getPageRuleOptions)
+	}
+	err = notProgrammed("GetPageRuleWithContext")
+	return
+}
+
+// ChangePageRule invokes the programmed ChangePageRuleFunc.
+func (mock *PageRuleApiV1) ChangePageRule(changePageRuleOptions *pageruleapiv1.ChangePageRuleOptions) (result *pageruleapiv1.PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error) {
+	mock.called("ChangePageRule", changePageRuleOptions)
+	if mock.ChangePageRuleFunc != nil {
+		return mock.ChangePageRuleFunc(changePageRuleOptions)
+	}
+	if mock.ChangePageRuleWithContextFunc != nil {
+		return mock.ChangePageRuleWithContextFunc(context.Background(), changePageRuleOptions)
+	}
+	err = notProgrammed("ChangePageRule")
+	return
+}
+
+// ChangePageRuleWithContext invokes the programmed ChangePageRuleWithContextFunc.
+func (mock *PageRuleApiV1) ChangePageRuleWithContext(ctx context.Context, changePageRuleOptions *pageruleapiv1.ChangePageRuleOptions) (result *pageruleapiv1.PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error) {
+	mock.called("ChangePageRuleWithContext", ctx, changePageRuleOptions)
+	if mock.ChangePageRuleWithContextFunc != nil {
+		return mock.ChangePageRuleWithContextFunc(ctx, changePageRuleOptions)
+	}
+	if mock.ChangePageRuleFunc != nil {
+		return mock.ChangePageRuleFunc(changePageRuleOptions)
+	}
+	err = notProgrammed("ChangePageRuleWithContext")
+	return
+}
+
+// UpdatePageRule invokes the programmed UpdatePageRuleFunc.
+func (mock *PageRuleApiV1) UpdatePageRule(updatePageRuleOptions *pageruleapiv1.UpdatePageRuleOptions) (result *pageruleapiv1.PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error) {
+	mock.called("UpdatePageRule", updatePageRuleOptions)
+	if mock.UpdatePageRuleFunc != nil {
+		return mock.UpdatePageRuleFunc(updatePageRuleOptions)
+	}
+	if mock.UpdatePageRuleWithContextFunc != nil {
+		return mock.UpdatePageRuleWithContextFunc(context.Background(), updatePageRuleOptions)
+	}
+	err = notProgrammed("UpdatePageRule")
+	return
+}
+
+// UpdatePageRuleWithContext invokes the programmed UpdatePageRuleWithContextFunc.
+func (mock *PageRuleApiV1) UpdatePageRuleWithContext(ctx context.Context, updatePageRuleOptions *pageruleapiv1.UpdatePageRuleOptions) (result *pageruleapiv1.PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error) {
+	mock.called("UpdatePageRuleWithContext", ctx, updatePageRuleOptions)
+	if mock.UpdatePageRuleWithContextFunc != nil {
+		return mock.UpdatePageRuleWithContextFunc(ctx, updatePageRuleOptions)
+	}
+	if mock.UpdatePageRuleFunc != nil {
+		return mock.UpdatePageRuleFunc(updatePageRuleOptions)
+	}
+	err = notProgrammed("UpdatePageRuleWithContext")
+	return
+}
+
+// DeletePageRule invokes the programmed DeletePageRuleFunc.
+func (mock *PageRuleApiV1) DeletePageRule(deletePageRuleOptions *pageruleapiv1.DeletePageRuleOptions) (result *pageruleapiv1.PageRulesDeleteResponse, response *core.DetailedResponse, err error) {
+	mock.called("DeletePageRule", deletePageRuleOptions)
+	if mock.DeletePageRuleFunc != nil {
+		return mock.DeletePageRuleFunc(deletePageRuleOptions)
+	}
+	if mock.DeletePageRuleWithContextFunc != nil {
+		return mock.DeletePageRuleWithContextFunc(context.Background(), deletePageRuleOptions)
+	}
+	err = notProgrammed("DeletePageRule")
+	return
+}
+
+// DeletePageRuleWithContext invokes the programmed DeletePageRuleWithContextFunc.
+func (mock *PageRuleApiV1) DeletePageRuleWithContext(ctx context.Context, deletePageRuleOptions *pageruleapiv1.DeletePageRuleOptions) (result *pageruleapiv1.PageRulesDeleteResponse, response *core.DetailedResponse, err error) {
+	mock.called("DeletePageRuleWithContext", ctx, deletePageRuleOptions)
+	if mock.DeletePageRuleWithContextFunc != nil {
+		return mock.DeletePageRuleWithContextFunc(ctx, deletePageRuleOptions)
+	}
+	if mock.DeletePageRuleFunc != nil {
+		return mock.DeletePageRuleFunc(deletePageRuleOptions)
+	}
+	err = notProgrammed("DeletePageRuleWithContext")
+	return
+}
+
+// ListPageRules invokes the programmed ListPageRulesFunc.
+func (mock *PageRuleApiV1) ListPageRules(listPageRulesOptions *pageruleapiv1.ListPageRulesOptions) (result *pageruleapiv1.PageRulesResponseListAll, response *core.DetailedResponse, err error) {
+	mock.called("ListPageRules", listPageRulesOptions)
+	if mock.ListPageRulesFunc != nil {
+		return mock.ListPageRulesFunc(listPageRulesOptions)
+	}
+	if mock.ListPageRulesWithContextFunc != nil {
+		return mock.ListPageRulesWithContextFunc(context.Background(), listPageRulesOptions)
+	}
+	err = notProgrammed("ListPageRules")
+	return
+}
+
+// ListPageRulesWithContext invokes the programmed ListPageRulesWithContextFunc.
+func (mock *PageRuleApiV1) ListPageRulesWithContext(ctx context.Context, listPageRulesOptions *pageruleapiv1.ListPageRulesOptions) (result *pageruleapiv1.PageRulesResponseListAll, response *core.DetailedResponse, err error) {
+	mock.called("ListPageRulesWithContext", ctx, listPageRulesOptions)
+	if mock.ListPageRulesWithContextFunc != nil {
+		return mock.ListPageRulesWithContextFunc(ctx, listPageRulesOptions)
+	}
+	if mock.ListPageRulesFunc != nil {
+		return mock.ListPageRulesFunc(listPageRulesOptions)
+	}
+	err = notProgrammed("ListPageRulesWithContext")
+	return
+}
+
+// CreatePageRule invokes the programmed CreatePageRuleFunc.
+func (mock *PageRuleApiV1) CreatePageRule(createPageRuleOptions *pageruleapiv1.CreatePageRuleOptions) (result *pageruleapiv1.PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error) {
+	mock.called("CreatePageRule", createPageRuleOptions)
+	if mock.CreatePageRuleFunc != nil {
+		return mock.CreatePageRuleFunc(createPageRuleOptions)
+	}
+	if mock.CreatePageRuleWithContextFunc != nil {
+		return mock.CreatePageRuleWithContextFunc(context.Background(), createPageRuleOptions)
+	}
+	err = notProgrammed("CreatePageRule")
+	return
+}
+
+// CreatePageRuleWithContext invokes the programmed CreatePageRuleWithContextFunc.
+func (mock *PageRuleApiV1) CreatePageRuleWithContext(ctx context.Context, createPageRuleOptions *pageruleapiv1.CreatePageRuleOptions) (result *pageruleapiv1.PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error) {
+	mock.called("CreatePageRuleWithContext", ctx, createPageRuleOptions)
+	if mock.CreatePageRuleWithContextFunc != nil {
+		return mock.CreatePageRuleWithContextFunc(ctx, createPageRuleOptions)
+	}
+	if mock.CreatePageRuleFunc != nil {
+		return mock.CreatePageRuleFunc(createPageRuleOptions)
+	}
+	err = notProgrammed("CreatePageRuleWithContext")
+	return
+}
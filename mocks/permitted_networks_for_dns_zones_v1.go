@@ -0,0 +1,76 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/permittednetworksfordnszonesv1"
+)
+
+// PermittedNetworksForDnsZonesV1 is a mock of permittednetworksfordnszonesv1.PermittedNetworksForDnsZonesV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type PermittedNetworksForDnsZonesV1 struct {
+	Mock
+
+	ListPermittedNetworksFunc  func(*permittednetworksfordnszonesv1.ListPermittedNetworksOptions) (*permittednetworksfordnszonesv1.ListPermittedNetworks, *core.DetailedResponse, error)
+	CreatePermittedNetworkFunc func(*permittednetworksfordnszonesv1.CreatePermittedNetworkOptions) (*permittednetworksfordnszonesv1.PermittedNetwork, *core.DetailedResponse, error)
+	DeletePermittedNetworkFunc func(*permittednetworksfordnszonesv1.DeletePermittedNetworkOptions) (*permittednetworksfordnszonesv1.PermittedNetwork, *core.DetailedResponse, error)
+	GetPermittedNetworkFunc    func(*permittednetworksfordnszonesv1.GetPermittedNetworkOptions) (*permittednetworksfordnszonesv1.PermittedNetwork, *core.DetailedResponse, error)
+}
+
+// PermittedNetworksForDnsZonesV1 implements permittednetworksfordnszonesv1.PermittedNetworksForDnsZonesV1API.
+var _ permittednetworksfordnszonesv1.PermittedNetworksForDnsZonesV1API = (*PermittedNetworksForDnsZonesV1)(nil)
+
+// ListPermittedNetworks invokes the programmed ListPermittedNetworksFunc.
+func (mock *PermittedNetworksForDnsZonesV1) ListPermittedNetworks(listPermittedNetworksOptions *permittednetworksfordnszonesv1.ListPermittedNetworksOptions) (result *permittednetworksfordnszonesv1.ListPermittedNetworks, response *core.DetailedResponse, err error) {
+	mock.called("ListPermittedNetworks", listPermittedNetworksOptions)
+	if mock.ListPermittedNetworksFunc != nil {
+		return mock.ListPermittedNetworksFunc(listPermittedNetworksOptions)
+	}
+	err = notProgrammed("ListPermittedNetworks")
+	return
+}
+
+// CreatePermittedNetwork invokes the programmed CreatePermittedNetworkFunc.
+func (mock *PermittedNetworksForDnsZonesV1) CreatePermittedNetwork(createPermittedNetworkOptions *permittednetworksfordnszonesv1.CreatePermittedNetworkOptions) (result *permittednetworksfordnszonesv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("CreatePermittedNetwork", createPermittedNetworkOptions)
+	if mock.CreatePermittedNetworkFunc != nil {
+		return mock.CreatePermittedNetworkFunc(createPermittedNetworkOptions)
+	}
+	err = notProgrammed("CreatePermittedNetwork")
+	return
+}
+
+// DeletePermittedNetwork invokes the programmed DeletePermittedNetworkFunc.
+func (mock *PermittedNetworksForDnsZonesV1) DeletePermittedNetwork(deletePermittedNetworkOptions *permittednetworksfordnszonesv1.DeletePermittedNetworkOptions) (result *permittednetworksfordnszonesv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("DeletePermittedNetwork", deletePermittedNetworkOptions)
+	if mock.DeletePermittedNetworkFunc != nil {
+		return mock.DeletePermittedNetworkFunc(deletePermittedNetworkOptions)
+	}
+	err = notProgrammed("DeletePermittedNetwork")
+	return
+}
+
+// GetPermittedNetwork invokes the programmed GetPermittedNetworkFunc.
+func (mock *PermittedNetworksForDnsZonesV1) GetPermittedNetwork(getPermittedNetworkOptions *permittednetworksfordnszonesv1.GetPermittedNetworkOptions) (result *permittednetworksfordnszonesv1.PermittedNetwork, response *core.DetailedResponse, err error) {
+	mock.called("GetPermittedNetwork", getPermittedNetworkOptions)
+	if mock.GetPermittedNetworkFunc != nil {
+		return mock.GetPermittedNetworkFunc(getPermittedNetworkOptions)
+	}
+	err = notProgrammed("GetPermittedNetwork")
+	return
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/rangeapplicationsv1"
+)
+
+// RangeApplicationsV1 is a mock of rangeapplicationsv1.RangeApplicationsV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type RangeApplicationsV1 struct {
+	Mock
+
+	ListRangeAppsFunc             func(*rangeapplicationsv1.ListRangeAppsOptions) (*rangeapplicationsv1.RangeApplications, *core.DetailedResponse, error)
+	ListRangeAppsWithContextFunc  func(context.Context, *rangeapplicationsv1.ListRangeAppsOptions) (*rangeapplicationsv1.RangeApplications, *core.DetailedResponse, error)
+	CreateRangeAppFunc            func(*rangeapplicationsv1.CreateRangeAppOptions) (*rangeapplicationsv1.RangeApplicationResp, *core.DetailedResponse, error)
+	CreateRangeAppWithContextFunc func(context.Context, *rangeapplicationsv1.CreateRangeAppOptions) (*rangeapplicationsv1.RangeApplicationResp, *core.DetailedResponse, error)
+	GetRangeAppFunc               func(*rangeapplicationsv1.GetRangeAppOptions) (*rangeapplicationsv1.RangeApplicationResp, *core.DetailedResponse, error)
+	GetRangeAppWithContextFunc    func(context.Context, *rangeapplicationsv1.GetRangeAppOptions) (*rangeapplicationsv1.RangeApplicationResp, *core.DetailedResponse, error)
+	UpdateRangeAppFunc            func(*rangeapplicationsv1.UpdateRangeAppOptions) (*rangeapplicationsv1.RangeApplicationResp, *core.DetailedResponse, error)
+	UpdateRangeAppWithContextFunc func(context.Context, *rangeapplicationsv1.UpdateRangeAppOptions) (*rangeapplicationsv1.RangeApplicationResp, *core.DetailedResponse, error)
+	DeleteRangeAppFunc            func(*rangeapplicationsv1.DeleteRangeAppOptions) (*rangeapplicationsv1.RangeApplicationResp, *core.DetailedResponse, error)
+	DeleteRangeAppWithContextFunc func(context.Context, *rangeapplicationsv1.DeleteRangeAppOptions) (*rangeapplicationsv1.RangeApplicationResp, *core.DetailedResponse, error)
+}
+
+// RangeApplicationsV1 implements rangeapplicationsv1.RangeApplicationsV1API.
+var _ rangeapplicationsv1.RangeApplicationsV1API = (*RangeApplicationsV1)(nil)
+
+// ListRangeApps invokes the programmed ListRangeAppsFunc.
+func (mock *RangeApplicationsV1) ListRangeApps(listRangeAppsOptions *rangeapplicationsv1.ListRangeAppsOptions) (result *rangeapplicationsv1.RangeApplications, response *core.DetailedResponse, err error) {
+	mock.called("ListRangeApps", listRangeAppsOptions)
+	if mock.ListRangeAppsFunc != nil {
+		return mock.ListRangeAppsFunc(listRangeAppsOptions)
+	}
+	if mock.ListRangeAppsWithContextFunc != nil {
+		return mock.ListRangeAppsWithContextFunc(context.Background(), listRangeAppsOptions)
+	}
+	err = notProgrammed("ListRangeApps")
+	return
+}
+
+// ListRangeAppsWithContext invokes the programmed ListRangeAppsWithContextFunc.
+func (mock *RangeApplicationsV1) ListRangeAppsWithContext(ctx context.Context, listRangeAppsOptions *rangeapplicationsv1.ListRangeAppsOptions) (result *rangeapplicationsv1.RangeApplications, response *core.DetailedResponse, err error) {
+	mock.called("ListRangeAppsWithContext", ctx, listRangeAppsOptions)
+	if mock.ListRangeAppsWithContextFunc != nil {
+		return mock.ListRangeAppsWithContextFunc(ctx, listRangeAppsOptions)
+	}
+	if mock.ListRangeAppsFunc != nil {
+		return mock.ListRangeAppsFunc(listRangeAppsOptions)
+	}
+	err = notProgrammed("ListRangeAppsWithContext")
+	return
+}
+
+// CreateRangeApp invokes the programmed CreateRangeAppFunc.
+func (mock *RangeApplicationsV1) CreateRangeApp(createRangeAppOptions *rangeapplicationsv1.CreateRangeAppOptions) (result *rangeapplicationsv1.RangeApplicationResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateRangeApp", createRangeAppOptions)
+	if mock.CreateRangeAppFunc != nil {
+		return mock.CreateRangeAppFunc(createRangeAppOptions)
+	}
+	if mock.CreateRangeAppWithContextFunc != nil {
+		return mock.CreateRangeAppWithContextFunc(context.Background(), createRangeAppOptions)
+	}
+	err = notProgrammed("CreateRangeApp")
+	return
+}
+
+// CreateRangeAppWithContext invokes the programmed CreateRangeAppWithContextFunc.
+func (mock *RangeApplicationsV1) CreateRangeAppWithContext(ctx context.Context, createRangeAppOptions *rangeapplicationsv1.CreateRangeAppOptions) (result *rangeapplicationsv1.RangeApplicationResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateRangeAppWithContext", ctx, createRangeAppOptions)
+	if mock.CreateRangeAppWithContextFunc != nil {
+		return mock.CreateRangeAppWithContextFunc(ctx, createRangeAppOptions)
+	}
+	if mock.CreateRangeAppFunc != nil {
+		return mock.CreateRangeAppFunc(createRangeAppOptions)
+	}
+	err = notProgrammed("CreateRangeAppWithContext")
+	return
+}
+
+// GetRangeApp invokes the programmed GetRangeAppFunc.
+func (mock *RangeApplicationsV1) GetRangeApp(getRangeAppOptions *rangeapplicationsv1.GetRangeAppOptions) (result *rangeapplicationsv1.RangeApplicationResp, response *core.DetailedResponse, err error) {
+	mock.called("GetRangeApp", getRangeAppOptions)
+	if mock.GetRangeAppFunc != nil {
+		return mock.GetRangeAppFunc(getRangeAppOptions)
+	}
+	if mock.GetRangeAppWithContextFunc != nil {
+		return mock.GetRangeAppWithContextFunc(context.Background(), getRangeAppOptions)
+	}
+	err = notProgrammed("GetRangeApp")
+	return
+}
+
+// GetRangeAppWithContext invokes the programmed GetRangeAppWithContextFunc.
+func (mock *RangeApplicationsV1) GetRangeAppWithContext(ctx context.Context, getRangeAppOptions *rangeapplicationsv1.GetRangeAppOptions) (result *rangeapplicationsv1.RangeApplicationResp, response *core.DetailedResponse, err error) {
+	mock.called("GetRangeAppWithContext", ctx, getRangeAppOptions)
+	if mock.GetRangeAppWithContextFunc != nil {
+		return mock.GetRangeAppWithContextFunc(ctx, getRangeAppOptions)
+	}
+	if mock.GetRangeAppFunc != nil {
+		return mock.GetRangeAppFunc(getRangeAppOptions)
+	}
+	err = notProgrammed("GetRangeAppWithContext")
+	return
+}
+
+// UpdateRangeApp invokes the programmed UpdateRangeAppFunc.
+func (mock *RangeApplicationsV1) UpdateRangeApp(updateRangeAppOptions *rangeapplicationsv1.UpdateRangeAppOptions) (result *rangeapplicationsv1.RangeApplicationResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateRangeApp", updateRangeAppOptions)
+	if mock.UpdateRangeAppFunc != nil {
+		return mock.UpdateRangeAppFunc(updateRangeAppOptions)
+	}
+	if mock.UpdateRangeAppWithContextFunc != nil {
+		return mock.UpdateRangeAppWithContextFunc(context.Background(), updateRangeAppOptions)
+	}
+	err = notProgrammed("UpdateRangeApp")
+	return
+}
+
+// UpdateRangeAppWithContext invokes the programmed UpdateRangeAppWithContextFunc.
+func (mock *RangeApplicationsV1) UpdateRangeAppWithContext(ctx context.Context, updateRangeAppOptions *rangeapplicationsv1.UpdateRangeAppOptions) (result *rangeapplicationsv1.RangeApplicationResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateRangeAppWithContext", ctx, updateRangeAppOptions)
+	if mock.UpdateRangeAppWithContextFunc != nil {
+		return mock.UpdateRangeAppWithContextFunc(ctx, updateRangeAppOptions)
+	}
+	if mock.UpdateRangeAppFunc != nil {
+		return mock.UpdateRangeAppFunc(updateRangeAppOptions)
+	}
+	err = notProgrammed("UpdateRangeAppWithContext")
+	return
+}
+
+// DeleteRangeApp invokes the programmed DeleteRangeAppFunc.
+func (mock *RangeApplicationsV1) DeleteRangeApp(deleteRangeAppOptions *rangeapplicationsv1.DeleteRangeAppOptions) (result *rangeapplicationsv1.RangeApplicationResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteRangeApp", deleteRangeAppOptions)
+	if mock.DeleteRangeAppFunc != nil {
+		return mock.DeleteRangeAppFunc(deleteRangeAppOptions)
+	}
+	if mock.DeleteRangeAppWithContextFunc != nil {
+		return mock.DeleteRangeAppWithContextFunc(context.Background(), deleteRangeAppOptions)
+	}
+	err = notProgrammed("DeleteRangeApp")
+	return
+}
+
+// DeleteRangeAppWithContext invokes the programmed DeleteRangeAppWithContextFunc.
+func (mock *RangeApplicationsV1) DeleteRangeAppWithContext(ctx context.Context, deleteRangeAppOptions *rangeapplicationsv1.DeleteRangeAppOptions) (result *rangeapplicationsv1.RangeApplicationResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteRangeAppWithContext", ctx, deleteRangeAppOptions)
+	if mock.DeleteRangeAppWithContextFunc != nil {
+		return mock.DeleteRangeAppWithContextFunc(ctx, deleteRangeAppOptions)
+	}
+	if mock.DeleteRangeAppFunc != nil {
+		return mock.DeleteRangeAppFunc(deleteRangeAppOptions)
+	}
+	err = notProgrammed("DeleteRangeAppWithContext")
+	return
+}
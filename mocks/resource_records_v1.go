@@ -0,0 +1,87 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/resourcerecordsv1"
+)
+
+// ResourceRecordsV1 is a mock of resourcerecordsv1.ResourceRecordsV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type ResourceRecordsV1 struct {
+	Mock
+
+	ListResourceRecordsFunc  func(*resourcerecordsv1.ListResourceRecordsOptions) (*resourcerecordsv1.ListResourceRecords, *core.DetailedResponse, error)
+	CreateResourceRecordFunc func(*resourcerecordsv1.CreateResourceRecordOptions) (*resourcerecordsv1.ResourceRecord, *core.DetailedResponse, error)
+	DeleteResourceRecordFunc func(*resourcerecordsv1.DeleteResourceRecordOptions) (*core.DetailedResponse, error)
+	GetResourceRecordFunc    func(*resourcerecordsv1.GetResourceRecordOptions) (*resourcerecordsv1.ResourceRecord, *core.DetailedResponse, error)
+	UpdateResourceRecordFunc func(*resourcerecordsv1.UpdateResourceRecordOptions) (*resourcerecordsv1.ResourceRecord, *core.DetailedResponse, error)
+}
+
+// ResourceRecordsV1 implements resourcerecordsv1.ResourceRecordsV1API.
+var _ resourcerecordsv1.ResourceRecordsV1API = (*ResourceRecordsV1)(nil)
+
+// ListResourceRecords invokes the programmed ListResourceRecordsFunc.
+func (mock *ResourceRecordsV1) ListResourceRecords(listResourceRecordsOptions *resourcerecordsv1.ListResourceRecordsOptions) (result *resourcerecordsv1.ListResourceRecords, response *core.DetailedResponse, err error) {
+	mock.called("ListResourceRecords", listResourceRecordsOptions)
+	if mock.ListResourceRecordsFunc != nil {
+		return mock.ListResourceRecordsFunc(listResourceRecordsOptions)
+	}
+	err = notProgrammed("ListResourceRecords")
+	return
+}
+
+// CreateResourceRecord invokes the programmed CreateResourceRecordFunc.
+func (mock *ResourceRecordsV1) CreateResourceRecord(createResourceRecordOptions *resourcerecordsv1.CreateResourceRecordOptions) (result *resourcerecordsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	mock.called("CreateResourceRecord", createResourceRecordOptions)
+	if mock.CreateResourceRecordFunc != nil {
+		return mock.CreateResourceRecordFunc(createResourceRecordOptions)
+	}
+	err = notProgrammed("CreateResourceRecord")
+	return
+}
+
+// DeleteResourceRecord invokes the programmed DeleteResourceRecordFunc.
+func (mock *ResourceRecordsV1) DeleteResourceRecord(deleteResourceRecordOptions *resourcerecordsv1.DeleteResourceRecordOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteResourceRecord", deleteResourceRecordOptions)
+	if mock.DeleteResourceRecordFunc != nil {
+		return mock.DeleteResourceRecordFunc(deleteResourceRecordOptions)
+	}
+	err = notProgrammed("DeleteResourceRecord")
+	return
+}
+
+// GetResourceRecord invokes the programmed GetResourceRecordFunc.
+func (mock *ResourceRecordsV1) GetResourceRecord(getResourceRecordOptions *resourcerecordsv1.GetResourceRecordOptions) (result *resourcerecordsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	mock.called("GetResourceRecord", getResourceRecordOptions)
+	if mock.GetResourceRecordFunc != nil {
+		return mock.GetResourceRecordFunc(getResourceRecordOptions)
+	}
+	err = notProgrammed("GetResourceRecord")
+	return
+}
+
+// UpdateResourceRecord invokes the programmed UpdateResourceRecordFunc.
+func (mock *ResourceRecordsV1) UpdateResourceRecord(updateResourceRecordOptions *resourcerecordsv1.UpdateResourceRecordOptions) (result *resourcerecordsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+	mock.called("UpdateResourceRecord", updateResourceRecordOptions)
+	if mock.UpdateResourceRecordFunc != nil {
+		return mock.UpdateResourceRecordFunc(updateResourceRecordOptions)
+	}
+	err = notProgrammed("UpdateResourceRecord")
+	return
+}
@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/routingv1"
+)
+
+// RoutingV1 is a mock of routingv1.RoutingV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type RoutingV1 struct {
+	Mock
+
+	GetSmartRoutingFunc               func(*routingv1.GetSmartRoutingOptions) (*routingv1.SmartRoutingResp, *core.DetailedResponse, error)
+	GetSmartRoutingWithContextFunc    func(context.Context, *routingv1.GetSmartRoutingOptions) (*routingv1.SmartRoutingResp, *core.DetailedResponse, error)
+	UpdateSmartRoutingFunc            func(*routingv1.UpdateSmartRoutingOptions) (*routingv1.SmartRoutingResp, *core.DetailedResponse, error)
+	UpdateSmartRoutingWithContextFunc func(context.Context, *routingv1.UpdateSmartRoutingOptions) (*routingv1.SmartRoutingResp, *core.DetailedResponse, error)
+}
+
+// RoutingV1 implements routingv1.RoutingV1API.
+var _ routingv1.RoutingV1API = (*RoutingV1)(nil)
+
+// GetSmartRouting invokes the programmed GetSmartRoutingFunc.
+func (mock *RoutingV1) GetSmartRouting(getSmartRoutingOptions *routingv1.GetSmartRoutingOptions) (result *routingv1.SmartRoutingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetSmartRouting", getSmartRoutingOptions)
+	if mock.GetSmartRoutingFunc != nil {
+		return mock.GetSmartRoutingFunc(getSmartRoutingOptions)
+	}
+	if mock.GetSmartRoutingWithContextFunc != nil {
+		return mock.GetSmartRoutingWithContextFunc(context.Background(), getSmartRoutingOptions)
+	}
+	err = notProgrammed("GetSmartRouting")
+	return
+}
+
+// GetSmartRoutingWithContext invokes the programmed GetSmartRoutingWithContextFunc.
+func (mock *RoutingV1) GetSmartRoutingWithContext(ctx context.Context, getSmartRoutingOptions *routingv1.GetSmartRoutingOptions) (result *routingv1.SmartRoutingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetSmartRoutingWithContext", ctx, getSmartRoutingOptions)
+	if mock.GetSmartRoutingWithContextFunc != nil {
+		return mock.GetSmartRoutingWithContextFunc(ctx, getSmartRoutingOptions)
+	}
+	if mock.GetSmartRoutingFunc != nil {
+		return mock.GetSmartRoutingFunc(getSmartRoutingOptions)
+	}
+	err = notProgrammed("GetSmartRoutingWithContext")
+	return
+}
+
+// UpdateSmartRouting invokes the programmed UpdateSmartRoutingFunc.
+func (mock *RoutingV1) UpdateSmartRouting(updateSmartRoutingOptions *routingv1.UpdateSmartRoutingOptions) (result *routingv1.SmartRoutingResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateSmartRouting", updateSmartRoutingOptions)
+	if mock.UpdateSmartRoutingFunc != nil {
+		return mock.UpdateSmartRoutingFunc(updateSmartRoutingOptions)
+	}
+	if mock.UpdateSmartRoutingWithContextFunc != nil {
+		return mock.UpdateSmartRoutingWithContextFunc(context.Background(), updateSmartRoutingOptions)
+	}
+	err = notProgrammed("UpdateSmartRouting")
+	return
+}
+
+// UpdateSmartRoutingWithContext invokes the programmed UpdateSmartRoutingWithContextFunc.
+func (mock *RoutingV1) UpdateSmartRoutingWithContext(ctx context.Context, updateSmartRoutingOptions *routingv1.UpdateSmartRoutingOptions) (result *routingv1.SmartRoutingResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateSmartRoutingWithContext", ctx, updateSmartRoutingOptions)
+	if mock.UpdateSmartRoutingWithContextFunc != nil {
+		return mock.UpdateSmartRoutingWithContextFunc(ctx, updateSmartRoutingOptions)
+	}
+	if mock.UpdateSmartRoutingFunc != nil {
+		return mock.UpdateSmartRoutingFunc(updateSmartRoutingOptions)
+	}
+	err = notProgrammed("UpdateSmartRoutingWithContext")
+	return
+}
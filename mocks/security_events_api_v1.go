@@ -0,0 +1,62 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/securityeventsapiv1"
+)
+
+// SecurityEventsApiV1 is a mock of securityeventsapiv1.SecurityEventsApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type SecurityEventsApiV1 struct {
+	Mock
+
+	SecurityEventsFunc            func(*securityeventsapiv1.SecurityEventsOptions) (*securityeventsapiv1.SecurityEvents, *core.DetailedResponse, error)
+	SecurityEventsWithContextFunc func(context.Context, *securityeventsapiv1.SecurityEventsOptions) (*securityeventsapiv1.SecurityEvents, *core.DetailedResponse, error)
+}
+
+// SecurityEventsApiV1 implements securityeventsapiv1.SecurityEventsApiV1API.
+var _ securityeventsapiv1.SecurityEventsApiV1API = (*SecurityEventsApiV1)(nil)
+
+// SecurityEvents invokes the programmed SecurityEventsFunc.
+func (mock *SecurityEventsApiV1) SecurityEvents(securityEventsOptions *securityeventsapiv1.SecurityEventsOptions) (result *securityeventsapiv1.SecurityEvents, response *core.DetailedResponse, err error) {
+	mock.called("SecurityEvents", securityEventsOptions)
+	if mock.SecurityEventsFunc != nil {
+		return mock.SecurityEventsFunc(securityEventsOptions)
+	}
+	if mock.SecurityEventsWithContextFunc != nil {
+		return mock.SecurityEventsWithContextFunc(context.Background(), securityEventsOptions)
+	}
+	err = notProgrammed("SecurityEvents")
+	return
+}
+
+// SecurityEventsWithContext invokes the programmed SecurityEventsWithContextFunc.
+func (mock *SecurityEventsApiV1) SecurityEventsWithContext(ctx context.Context, securityEventsOptions *securityeventsapiv1.SecurityEventsOptions) (result *securityeventsapiv1.SecurityEvents, response *core.DetailedResponse, err error) {
+	mock.called("SecurityEventsWithContext", ctx, securityEventsOptions)
+	if mock.SecurityEventsWithContextFunc != nil {
+		return mock.SecurityEventsWithContextFunc(ctx, securityEventsOptions)
+	}
+	if mock.SecurityEventsFunc != nil {
+		return mock.SecurityEventsFunc(securityEventsOptions)
+	}
+	err = notProgrammed("SecurityEventsWithContext")
+	return
+}
@@ -0,0 +1,510 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/sslcertificateapiv1"
+)
+
+// SslCertificateApiV1 is a mock of sslcertificateapiv1.SslCertificateApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type SslCertificateApiV1 struct {
+	Mock
+
+	ListCertificatesFunc                             func(*sslcertificateapiv1.ListCertificatesOptions) (*sslcertificateapiv1.ListCertificateResp, *core.DetailedResponse, error)
+	ListCertificatesWithContextFunc                  func(context.Context, *sslcertificateapiv1.ListCertificatesOptions) (*sslcertificateapiv1.ListCertificateResp, *core.DetailedResponse, error)
+	OrderCertificateFunc                             func(*sslcertificateapiv1.OrderCertificateOptions) (*sslcertificateapiv1.DedicatedCertificateResp, *core.DetailedResponse, error)
+	OrderCertificateWithContextFunc                  func(context.Context, *sslcertificateapiv1.OrderCertificateOptions) (*sslcertificateapiv1.DedicatedCertificateResp, *core.DetailedResponse, error)
+	DeleteCertificateFunc                            func(*sslcertificateapiv1.DeleteCertificateOptions) (*core.DetailedResponse, error)
+	DeleteCertificateWithContextFunc                 func(context.Context, *sslcertificateapiv1.DeleteCertificateOptions) (*core.DetailedResponse, error)
+	GetSslSettingFunc                                func(*sslcertificateapiv1.GetSslSettingOptions) (*sslcertificateapiv1.SslSettingResp, *core.DetailedResponse, error)
+	GetSslSettingWithContextFunc                     func(context.Context, *sslcertificateapiv1.GetSslSettingOptions) (*sslcertificateapiv1.SslSettingResp, *core.DetailedResponse, error)
+	ChangeSslSettingFunc                             func(*sslcertificateapiv1.ChangeSslSettingOptions) (*sslcertificateapiv1.SslSettingResp, *core.DetailedResponse, error)
+	ChangeSslSettingWithContextFunc                  func(context.Context, *sslcertificateapiv1.ChangeSslSettingOptions) (*sslcertificateapiv1.SslSettingResp, *core.DetailedResponse, error)
+	ListCustomCertificatesFunc                       func(*sslcertificateapiv1.ListCustomCertificatesOptions) (*sslcertificateapiv1.ListCustomCertsResp, *core.DetailedResponse, error)
+	ListCustomCertificatesWithContextFunc            func(context.Context, *sslcertificateapiv1.ListCustomCertificatesOptions) (*sslcertificateapiv1.ListCustomCertsResp, *core.DetailedResponse, error)
+	UploadCustomCertificateFunc                      func(*sslcertificateapiv1.UploadCustomCertificateOptions) (*sslcertificateapiv1.CustomCertResp, *core.DetailedResponse, error)
+	UploadCustomCertificateWithContextFunc           func(context.Context, *sslcertificateapiv1.UploadCustomCertificateOptions) (*sslcertificateapiv1.CustomCertResp, *core.DetailedResponse, error)
+	GetCustomCertificateFunc                         func(*sslcertificateapiv1.GetCustomCertificateOptions) (*sslcertificateapiv1.CustomCertResp, *core.DetailedResponse, error)
+	GetCustomCertificateWithContextFunc              func(context.Context, *sslcertificateapiv1.GetCustomCertificateOptions) (*sslcertificateapiv1.CustomCertResp, *core.DetailedResponse, error)
+	UpdateCustomCertificateFunc                      func(*sslcertificateapiv1.UpdateCustomCertificateOptions) (*sslcertificateapiv1.CustomCertResp, *core.DetailedResponse, error)
+	UpdateCustomCertificateWithContextFunc           func(context.Context, *sslcertificateapiv1.UpdateCustomCertificateOptions) (*sslcertificateapiv1.CustomCertResp, *core.DetailedResponse, error)
+	DeleteCustomCertificateFunc                      func(*sslcertificateapiv1.DeleteCustomCertificateOptions) (*core.DetailedResponse, error)
+	DeleteCustomCertificateWithContextFunc           func(context.Context, *sslcertificateapiv1.DeleteCustomCertificateOptions) (*core.DetailedResponse, error)
+	ChangeCertificatePriorityFunc                    func(*sslcertificateapiv1.ChangeCertificatePriorityOptions) (*core.DetailedResponse, error)
+	ChangeCertificatePriorityWithContextFunc         func(context.Context, *sslcertificateapiv1.ChangeCertificatePriorityOptions) (*core.DetailedResponse, error)
+	GetUniversalCertificateSettingFunc               func(*sslcertificateapiv1.GetUniversalCertificateSettingOptions) (*sslcertificateapiv1.UniversalSettingResp, *core.DetailedResponse, error)
+	GetUniversalCertificateSettingWithContextFunc    func(context.Context, *sslcertificateapiv1.GetUniversalCertificateSettingOptions) (*sslcertificateapiv1.UniversalSettingResp, *core.DetailedResponse, error)
+	ChangeUniversalCertificateSettingFunc            func(*sslcertificateapiv1.ChangeUniversalCertificateSettingOptions) (*core.DetailedResponse, error)
+	ChangeUniversalCertificateSettingWithContextFunc func(context.Context, *sslcertificateapiv1.ChangeUniversalCertificateSettingOptions) (*core.DetailedResponse, error)
+	GetTls12SettingFunc                              func(*sslcertificateapiv1.GetTls12SettingOptions) (*sslcertificateapiv1.Tls12SettingResp, *core.DetailedResponse, error)
+	GetTls12SettingWithContextFunc                   func(context.Context, *sslcertificateapiv1.GetTls12SettingOptions) (*sslcertificateapiv1.Tls12SettingResp, *core.DetailedResponse, error)
+	ChangeTls12SettingFunc                           func(*sslcertificateapiv1.ChangeTls12SettingOptions) (*sslcertificateapiv1.Tls12SettingResp, *core.DetailedResponse, error)
+	ChangeTls12SettingWithContextFunc                func(context.Context, *sslcertificateapiv1.ChangeTls12SettingOptions) (*sslcertificateapiv1.Tls12SettingResp, *core.DetailedResponse, error)
+	GetTls13SettingFunc                              func(*sslcertificateapiv1.GetTls13SettingOptions) (*sslcertificateapiv1.Tls13SettingResp, *core.DetailedResponse, error)
+	GetTls13SettingWithContextFunc                   func(context.Context, *sslcertificateapiv1.GetTls13SettingOptions) (*sslcertificateapiv1.Tls13SettingResp, *core.DetailedResponse, error)
+	ChangeTls13SettingFunc                           func(*sslcertificateapiv1.ChangeTls13SettingOptions) (*sslcertificateapiv1.Tls13SettingResp, *core.DetailedResponse, error)
+	ChangeTls13SettingWithContextFunc                func(context.Context, *sslcertificateapiv1.ChangeTls13SettingOptions) (*sslcertificateapiv1.Tls13SettingResp, *core.DetailedResponse, error)
+}
+
+// SslCertificateApiV1 implements sslcertificateapiv1.SslCertificateApiV1API.
+var _ sslcertificateapiv1.SslCertificateApiV1API = (*SslCertificateApiV1)(nil)
+
+// ListCertificates invokes the programmed ListCertificatesFunc.
+func (mock *SslCertificateApiV1) ListCertificates(listCertificatesOptions *sslcertificateapiv1.ListCertificatesOptions) (result *sslcertificateapiv1.ListCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("ListCertificates", listCertificatesOptions)
+	if mock.ListCertificatesFunc != nil {
+		return mock.ListCertificatesFunc(listCertificatesOptions)
+	}
+	if mock.ListCertificatesWithContextFunc != nil {
+		return mock.ListCertificatesWithContextFunc(context.Background(), listCertificatesOptions)
+	}
+	err = notProgrammed("ListCertificates")
+	return
+}
+
+// ListCertificatesWithContext invokes the programmed ListCertificatesWithContextFunc.
+func (mock *SslCertificateApiV1) ListCertificatesWithContext(ctx context.Context, listCertificatesOptions *sslcertificateapiv1.ListCertificatesOptions) (result *sslcertificateapiv1.ListCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("ListCertificatesWithContext", ctx, listCertificatesOptions)
+	if mock.ListCertificatesWithContextFunc != nil {
+		return mock.ListCertificatesWithContextFunc(ctx, listCertificatesOptions)
+	}
+	if mock.ListCertificatesFunc != nil {
+		return mock.ListCertificatesFunc(listCertificatesOptions)
+	}
+	err = notProgrammed("ListCertificatesWithContext")
+	return
+}
+
+// OrderCertificate invokes the programmed OrderCertificateFunc.
+func (mock *SslCertificateApiV1) OrderCertificate(orderCertificateOptions *sslcertificateapiv1.OrderCertificateOptions) (result *sslcertificateapiv1.DedicatedCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("OrderCertificate", orderCertificateOptions)
+	if mock.OrderCertificateFunc != nil {
+		return mock.OrderCertificateFunc(orderCertificateOptions)
+	}
+	if mock.OrderCertificateWithContextFunc != nil {
+		return mock.OrderCertificateWithContextFunc(context.Background(), orderCertificateOptions)
+	}
+	err = notProgrammed("OrderCertificate")
+	return
+}
+
+// OrderCertificateWithContext invokes the programmed OrderCertificateWithContextFunc.
+func (mock *SslCertificateApiV1) OrderCertificateWithContext(ctx context.Context, orderCertificateOptions *sslcertificateapiv1.OrderCertificateOptions) (result *sslcertificateapiv1.DedicatedCertificateResp, response *core.DetailedResponse, err error) {
+	mock.called("OrderCertificateWithContext", ctx, orderCertificateOptions)
+	if mock.OrderCertificateWithContextFunc != nil {
+		return mock.OrderCertificateWithContextFunc(ctx, orderCertificateOptions)
+	}
+	if mock.OrderCertificateFunc != nil {
+		return mock.OrderCertificateFunc(orderCertificateOptions)
+	}
+	err = notProgrammed("OrderCertificateWithContext")
+	return
+}
+
+// DeleteCertificate invokes the programmed DeleteCertificateFunc.
+func (mock *SslCertificateApiV1) DeleteCertificate(deleteCertificateOptions *sslcertificateapiv1.DeleteCertificateOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteCertificate", deleteCertificateOptions)
+	if mock.DeleteCertificateFunc != nil {
+		return mock.DeleteCertificateFunc(deleteCertificateOptions)
+	}
+	if mock.DeleteCertificateWithContextFunc != nil {
+		return mock.DeleteCertificateWithContextFunc(context.Background(), deleteCertificateOptions)
+	}
+	err = notProgrammed("DeleteCertificate")
+	return
+}
+
+// DeleteCertificateWithContext invokes the programmed DeleteCertificateWithContextFunc.
+func (mock *SslCertificateApiV1) DeleteCertificateWithContext(ctx context.Context, deleteCertificateOptions *sslcertificateapiv1.DeleteCertificateOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteCertificateWithContext", ctx, deleteCertificateOptions)
+	if mock.DeleteCertificateWithContextFunc != nil {
+		return mock.DeleteCertificateWithContextFunc(ctx, deleteCertificateOptions)
+	}
+	if mock.DeleteCertificateFunc != nil {
+		return mock.DeleteCertificateFunc(deleteCertificateOptions)
+	}
+	err = notProgrammed("DeleteCertificateWithContext")
+	return
+}
+
+// GetSslSetting invokes the programmed GetSslSettingFunc.
+func (mock *SslCertificateApiV1) GetSslSetting(getSslSettingOptions *sslcertificateapiv1.GetSslSettingOptions) (result *sslcertificateapiv1.SslSettingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetSslSetting", getSslSettingOptions)
+	if mock.GetSslSettingFunc != nil {
+		return mock.GetSslSettingFunc(getSslSettingOptions)
+	}
+	if mock.GetSslSettingWithContextFunc != nil {
+		return mock.GetSslSettingWithContextFunc(context.Background(), getSslSettingOptions)
+	}
+	err = notProgrammed("GetSslSetting")
+	return
+}
+
+// GetSslSettingWithContext invokes the programmed GetSslSettingWithContextFunc.
+func (mock *SslCertificateApiV1) GetSslSettingWithContext(ctx context.Context, getSslSettingOptions *sslcertificateapiv1.GetSslSettingOptions) (result *sslcertificateapiv1.SslSettingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetSslSettingWithContext", ctx, getSslSettingOptions)
+	if mock.GetSslSettingWithContextFunc != nil {
+		return mock.GetSslSettingWithContextFunc(ctx, getSslSettingOptions)
+	}
+	if mock.GetSslSettingFunc != nil {
+		return mock.GetSslSettingFunc(getSslSettingOptions)
+	}
+	err = notProgrammed("GetSslSettingWithContext")
+	return
+}
+
+// ChangeSslSetting invokes the programmed ChangeSslSettingFunc.
+func (mock *SslCertificateApiV1) ChangeSslSetting(changeSslSettingOptions *sslcertificateapiv1.ChangeSslSettingOptions) (result *sslcertificateapiv1.SslSettingResp, response *core.DetailedResponse, err error) {
+	mock.called("ChangeSslSetting", changeSslSettingOptions)
+	if mock.ChangeSslSettingFunc != nil {
+		return mock.ChangeSslSettingFunc(changeSslSettingOptions)
+	}
+	if mock.ChangeSslSettingWithContextFunc != nil {
+		return mock.ChangeSslSettingWithContextFunc(context.Background(), changeSslSettingOptions)
+	}
+	err = notProgrammed("ChangeSslSetting")
+	return
+}
+
+// ChangeSslSettingWithContext invokes the programmed ChangeSslSettingWithContextFunc.
+func (mock *SslCertificateApiV1) ChangeSslSettingWithContext(ctx context.Context, changeSslSettingOptions *sslcertificateapiv1.ChangeSslSettingOptions) (result *sslcertificateapiv1.SslSettingResp, response *core.DetailedResponse, err error) {
+	mock.called("ChangeSslSettingWithContext", ctx, changeSslSettingOptions)
+	if mock.ChangeSslSettingWithContextFunc != nil {
+		return mock.ChangeSslSettingWithContextFunc(ctx, changeSslSettingOptions)
+	}
+	if mock.ChangeSslSettingFunc != nil {
+		return mock.ChangeSslSettingFunc(changeSslSettingOptions)
+	}
+	err = notProgrammed("ChangeSslSettingWithContext")
+	return
+}
+
+// ListCustomCertificates invokes the programmed ListCustomCertificatesFunc.
+func (mock *SslCertificateApiV1) ListCustomCertificates(listCustomCertificatesOptions *sslcertificateapiv1.ListCustomCertificatesOptions) (result *sslcertificateapiv1.ListCustomCertsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListCustomCertificates", listCustomCertificatesOptions)
+	if mock.ListCustomCertificatesFunc != nil {
+		return mock.ListCustomCertificatesFunc(listCustomCertificatesOptions)
+	}
+	if mock.ListCustomCertificatesWithContextFunc != nil {
+		return mock.ListCustomCertificatesWithContextFunc(context.Background(), listCustomCertificatesOptions)
+	}
+	err = notProgrammed("ListCustomCertificates")
+	return
+}
+
+// ListCustomCertificatesWithContext invokes the programmed ListCustomCertificatesWithContextFunc.
+func (mock *SslCertificateApiV1) ListCustomCertificatesWithContext(ctx context.Context, listCustomCertificatesOptions *sslcertificateapiv1.ListCustomCertificatesOptions) (result *sslcertificateapiv1.ListCustomCertsResp, response *core.DetailedResponse, err error) {
+	mock.called("ListCustomCertificatesWithContext", ctx, listCustomCertificatesOptions)
+	if mock.ListCustomCertificatesWithContextFunc != nil {
+		return mock.ListCustomCertificatesWithContextFunc(ctx, listCustomCertificatesOptions)
+	}
+	if mock.ListCustomCertificatesFunc != nil {
+		return mock.ListCustomCertificatesFunc(listCustomCertificatesOptions)
+	}
+	err = notProgrammed("ListCustomCertificatesWithContext")
+	return
+}
+
+// UploadCustomCertificate invokes the programmed UploadCustomCertificateFunc.
+func (mock *SslCertificateApiV1) UploadCustomCertificate(uploadCustomCertificateOptions *sslcertificateapiv1.UploadCustomCertificateOptions) (result *sslcertificateapiv1.CustomCertResp, response *core.DetailedResponse, err error) {
+	mock.called("UploadCustomCertificate", uploadCustomCertificateOptions)
+	if mock.UploadCustomCertificateFunc != nil {
+		return mock.UploadCustomCertificateFunc(uploadCustomCertificateOptions)
+	}
+	if mock.UploadCustomCertificateWithContextFunc != nil {
+		return mock.UploadCustomCertificateWithContextFunc(context.Background(), uploadCustomCertificateOptions)
+	}
+	err = notProgrammed("UploadCustomCertificate")
+	return
+}
+
+// UploadCustomCertificateWithContext invokes the programmed UploadCustomCertificateWithContextFunc.
+func (mock *SslCertificateApiV1) UploadCustomCertificateWithContext(ctx context.Context, uploadCustomCertificateOptions *sslcertificateapiv1.UploadCustomCertificateOptions) (result *sslcertificateapiv1.CustomCertResp, response *core.DetailedResponse, err error) {
+	mock.called("UploadCustomCertificateWithContext", ctx, uploadCustomCertificateOptions)
+	if mock.UploadCustomCertificateWithContextFunc != nil {
+		return mock.UploadCustomCertificateWithContextFunc(ctx, uploadCustomCertificateOptions)
+	}
+	if mock.UploadCustomCertificateFunc != nil {
+		return mock.UploadCustomCertificateFunc(uploadCustomCertificateOptions)
+	}
+	err = notProgrammed("UploadCustomCertificateWithContext")
+	return
+}
+
+// GetCustomCertificate invokes the programmed GetCustomCertificateFunc.
+func (mock *SslCertificateApiV1) GetCustomCertificate(getCustomCertificateOptions *sslcertificateapiv1.GetCustomCertificateOptions) (result *sslcertificateapiv1.CustomCertResp, response *core.DetailedResponse, err error) {
+	mock.called("GetCustomCertificate", getCustomCertificateOptions)
+	if mock.GetCustomCertificateFunc != nil {
+		return mock.GetCustomCertificateFunc(getCustomCertificateOptions)
+	}
+	if mock.GetCustomCertificateWithContextFunc != nil {
+		return mock.GetCustomCertificateWithContextFunc(context.Background(), getCustomCertificateOptions)
+	}
+	err = notProgrammed("GetCustomCertificate")
+	return
+}
+
+// GetCustomCertificateWithContext invokes the programmed GetCustomCertificateWithContextFunc.
+func (mock *SslCertificateApiV1) GetCustomCertificateWithContext(ctx context.Context, getCustomCertificateOptions *sslcertificateapiv1.GetCustomCertificateOptions) (result *sslcertificateapiv1.CustomCertResp, response *core.DetailedResponse, err error) {
+	mock.called("GetCustomCertificateWithContext", ctx, getCustomCertificateOptions)
+	if mock.GetCustomCertificateWithContextFunc != nil {
+		return mock.GetCustomCertificateWithContextFunc(ctx, getCustomCertificateOptions)
+	}
+	if mock.GetCustomCertificateFunc != nil {
+		return mock.GetCustomCertificateFunc(getCustomCertificateOptions)
+	}
+	err = notProgrammed("GetCustomCertificateWithContext")
+	return
+}
+
+// UpdateCustomCertificate invokes the programmed UpdateCustomCertificateFunc.
+func (mock *SslCertificateApiV1) UpdateCustomCertificate(updateCustomCertificateOptions *sslcertificateapiv1.UpdateCustomCertificateOptions) (result *sslcertificateapiv1.CustomCertResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCustomCertificate", updateCustomCertificateOptions)
+	if mock.UpdateCustomCertificateFunc != nil {
+		return mock.UpdateCustomCertificateFunc(updateCustomCertificateOptions)
+	}
+	if mock.UpdateCustomCertificateWithContextFunc != nil {
+		return mock.UpdateCustomCertificateWithContextFunc(context.Background(), updateCustomCertificateOptions)
+	}
+	err = notProgrammed("UpdateCustomCertificate")
+	return
+}
+
+// UpdateCustomCertificateWithContext invokes the programmed UpdateCustomCertificateWithContextFunc.
+func (mock *SslCertificateApiV1) UpdateCustomCertificateWithContext(ctx context.Context, updateCustomCertificateOptions *sslcertificateapiv1.UpdateCustomCertificateOptions) (result *sslcertificateapiv1.CustomCertResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCustomCertificateWithContext", ctx, updateCustomCertificateOptions)
+	if mock.UpdateCustomCertificateWithContextFunc != nil {
+		return mock.UpdateCustomCertificateWithContextFunc(ctx, updateCustomCertificateOptions)
+	}
+	if mock.UpdateCustomCertificateFunc != nil {
+		return mock.UpdateCustomCertificateFunc(updateCustomCertificateOptions)
+	}
+	err = notProgrammed("UpdateCustomCertificateWithContext")
+	return
+}
+
+// DeleteCustomCertificate invokes the programmed DeleteCustomCertificateFunc.
+func (mock *SslCertificateApiV1) DeleteCustomCertificate(deleteCustomCertificateOptions *sslcertificateapiv1.DeleteCustomCertificateOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteCustomCertificate", deleteCustomCertificateOptions)
+	if mock.DeleteCustomCertificateFunc != nil {
+		return mock.DeleteCustomCertificateFunc(deleteCustomCertificateOptions)
+	}
+	if mock.DeleteCustomCertificateWithContextFunc != nil {
+		return mock.DeleteCustomCertificateWithContextFunc(context.Background(), deleteCustomCertificateOptions)
+	}
+	err = notProgrammed("DeleteCustomCertificate")
+	return
+}
+
+// DeleteCustomCertificateWithContext invokes the programmed DeleteCustomCertificateWithContextFunc.
+func (mock *SslCertificateApiV1) DeleteCustomCertificateWithContext(ctx context.Context, deleteCustomCertificateOptions *sslcertificateapiv1.DeleteCustomCertificateOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteCustomCertificateWithContext", ctx, deleteCustomCertificateOptions)
+	if mock.DeleteCustomCertificateWithContextFunc != nil {
+		return mock.DeleteCustomCertificateWithContextFunc(ctx, deleteCustomCertificateOptions)
+	}
+	if mock.DeleteCustomCertificateFunc != nil {
+		return mock.DeleteCustomCertificateFunc(deleteCustomCertificateOptions)
+	}
+	err = notProgrammed("DeleteCustomCertificateWithContext")
+	return
+}
+
+// ChangeCertificatePriority invokes the programmed ChangeCertificatePriorityFunc.
+func (mock *SslCertificateApiV1) ChangeCertificatePriority(changeCertificatePriorityOptions *sslcertificateapiv1.ChangeCertificatePriorityOptions) (response *core.DetailedResponse, err error) {
+	mock.called("ChangeCertificatePriority", changeCertificatePriorityOptions)
+	if mock.ChangeCertificatePriorityFunc != nil {
+		return mock.ChangeCertificatePriorityFunc(changeCertificatePriorityOptions)
+	}
+	if mock.ChangeCertificatePriorityWithContextFunc != nil {
+		return mock.ChangeCertificatePriorityWithContextFunc(context.Background(), changeCertificatePriorityOptions)
+	}
+	err = notProgrammed("ChangeCertificatePriority")
+	return
+}
+
+// ChangeCertificatePriorityWithContext invokes the programmed ChangeCertificatePriorityWithContextFunc.
+func (mock *SslCertificateApiV1) ChangeCertificatePriorityWithContext(ctx context.Context, changeCertificatePriorityOptions *sslcertificateapiv1.ChangeCertificatePriorityOptions) (response *core.DetailedResponse, err error) {
+	mock.called("ChangeCertificatePriorityWithContext", ctx, changeCertificatePriorityOptions)
+	if mock.ChangeCertificatePriorityWithContextFunc != nil {
+		return mock.ChangeCertificatePriorityWithContextFunc(ctx, changeCertificatePriorityOptions)
+	}
+	if mock.ChangeCertificatePriorityFunc != nil {
+		return mock.ChangeCertificatePriorityFunc(changeCertificatePriorityOptions)
+	}
+	err = notProgrammed("ChangeCertificatePriorityWithContext")
+	return
+}
+
+// GetUniversalCertificateSetting invokes the programmed GetUniversalCertificateSettingFunc.
+func (mock *SslCertificateApiV1) GetUniversalCertificateSetting(getUniversalCertificateSettingOptions *sslcertificateapiv1.GetUniversalCertificateSettingOptions) (result *sslcertificateapiv1.UniversalSettingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetUniversalCertificateSetting", getUniversalCertificateSettingOptions)
+	if mock.GetUniversalCertificateSettingFunc != nil {
+		return mock.GetUniversalCertificateSettingFunc(getUniversalCertificateSettingOptions)
+	}
+	if mock.GetUniversalCertificateSettingWithContextFunc != nil {
+		return mock.GetUniversalCertificateSettingWithContextFunc(context.Background(), getUniversalCertificateSettingOptions)
+	}
+	err = notProgrammed("GetUniversalCertificateSetting")
+	return
+}
+
+// GetUniversalCertificateSettingWithContext invokes the programmed GetUniversalCertificateSettingWithContextFunc.
+func (mock *SslCertificateApiV1) GetUniversalCertificateSettingWithContext(ctx context.Context, getUniversalCertificateSettingOptions *sslcertificateapiv1.GetUniversalCertificateSettingOptions) (result *sslcertificateapiv1.UniversalSettingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetUniversalCertificateSettingWithContext", ctx, getUniversalCertificateSettingOptions)
+	if mock.GetUniversalCertificateSettingWithContextFunc != nil {
+		return mock.GetUniversalCertificateSettingWithContextFunc(ctx, getUniversalCertificateSettingOptions)
+	}
+	if mock.GetUniversalCertificateSettingFunc != nil {
+		return mock.GetUniversalCertificateSettingFunc(getUniversalCertificateSettingOptions)
+	}
+	err = notProgrammed("GetUniversalCertificateSettingWithContext")
+	return
+}
+
+// ChangeUniversalCertificateSetting invokes the programmed ChangeUniversalCertificateSettingFunc.
+func (mock *SslCertificateApiV1) ChangeUniversalCertificateSetting(changeUniversalCertificateSettingOptions *sslcertificateapiv1.ChangeUniversalCertificateSettingOptions) (response *core.DetailedResponse, err error) {
+	mock.called("ChangeUniversalCertificateSetting", changeUniversalCertificateSettingOptions)
+	if mock.ChangeUniversalCertificateSettingFunc != nil {
+		return mock.ChangeUniversalCertificateSettingFunc(changeUniversalCertificateSettingOptions)
+	}
+	if mock.ChangeUniversalCertificateSettingWithContextFunc != nil {
+		return mock.ChangeUniversalCertificateSettingWithContextFunc(context.Background(), changeUniversalCertificateSettingOptions)
+	}
+	err = notProgrammed("ChangeUniversalCertificateSetting")
+	return
+}
+
+// ChangeUniversalCertificateSettingWithContext invokes the programmed ChangeUniversalCertificateSettingWithContextFunc.
+func (mock *SslCertificateApiV1) ChangeUniversalCertificateSettingWithContext(ctx context.Context, changeUniversalCertificateSettingOptions *sslcertificateapiv1.ChangeUniversalCertificateSettingOptions) (response *core.DetailedResponse, err error) {
+	mock.called("ChangeUniversalCertificateSettingWithContext", ctx, changeUniversalCertificateSettingOptions)
+	if mock.ChangeUniversalCertificateSettingWithContextFunc != nil {
+		return mock.ChangeUniversalCertificateSettingWithContextFunc(ctx, changeUniversalCertificateSettingOptions)
+	}
+	if mock.ChangeUniversalCertificateSettingFunc != nil {
+		return mock.ChangeUniversalCertificateSettingFunc(changeUniversalCertificateSettingOptions)
+	}
+	err = notProgrammed("ChangeUniversalCertificateSettingWithContext")
+	return
+}
+
+// GetTls12Setting invokes the programmed GetTls12SettingFunc.
+func (mock *SslCertificateApiV1) GetTls12Setting(getTls12SettingOptions *sslcertificateapiv1.GetTls12SettingOptions) (result *sslcertificateapiv1.Tls12SettingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetTls12Setting", getTls12SettingOptions)
+	if mock.GetTls12SettingFunc != nil {
+		return mock.GetTls12SettingFunc(getTls12SettingOptions)
+	}
+	if mock.GetTls12SettingWithContextFunc != nil {
+		return mock.GetTls12SettingWithContextFunc(context.Background(), getTls12SettingOptions)
+	}
+	err = notProgrammed("GetTls12Setting")
+	return
+}
+
+// GetTls12SettingWithContext invokes the programmed GetTls12SettingWithContextFunc.
+func (mock *SslCertificateApiV1) GetTls12SettingWithContext(ctx context.Context, getTls12SettingOptions *sslcertificateapiv1.GetTls12SettingOptions) (result *sslcertificateapiv1.Tls12SettingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetTls12SettingWithContext", ctx, getTls12SettingOptions)
+	if mock.GetTls12SettingWithContextFunc != nil {
+		return mock.GetTls12SettingWithContextFunc(ctx, getTls12SettingOptions)
+	}
+	if mock.GetTls12SettingFunc != nil {
+		return mock.GetTls12SettingFunc(getTls12SettingOptions)
+	}
+	err = notProgrammed("GetTls12SettingWithContext")
+	return
+}
+
+// ChangeTls12Setting invokes the programmed ChangeTls12SettingFunc.
+func (mock *SslCertificateApiV1) ChangeTls12Setting(changeTls12SettingOptions *sslcertificateapiv1.ChangeTls12SettingOptions) (result *sslcertificateapiv1.Tls12SettingResp, response *core.DetailedResponse, err error) {
+	mock.called("ChangeTls12Setting", changeTls12SettingOptions)
+	if mock.ChangeTls12SettingFunc != nil {
+		return mock.ChangeTls12SettingFunc(changeTls12SettingOptions)
+	}
+	if mock.ChangeTls12SettingWithContextFunc != nil {
+		return mock.ChangeTls12SettingWithContextFunc(context.Background(), changeTls12SettingOptions)
+	}
+	err = notProgrammed("ChangeTls12Setting")
+	return
+}
+
+// ChangeTls12SettingWithContext invokes the programmed ChangeTls12SettingWithContextFunc.
+func (mock *SslCertificateApiV1) ChangeTls12SettingWithContext(ctx context.Context, changeTls12SettingOptions *sslcertificateapiv1.ChangeTls12SettingOptions) (result *sslcertificateapiv1.Tls12SettingResp, response *core.DetailedResponse, err error) {
+	mock.called("ChangeTls12SettingWithContext", ctx, changeTls12SettingOptions)
+	if mock.ChangeTls12SettingWithContextFunc != nil {
+		return mock.ChangeTls12SettingWithContextFunc(ctx, changeTls12SettingOptions)
+	}
+	if mock.ChangeTls12SettingFunc != nil {
+		return mock.ChangeTls12SettingFunc(changeTls12SettingOptions)
+	}
+	err = notProgrammed("ChangeTls12SettingWithContext")
+	return
+}
+
+// GetTls13Setting invokes the programmed GetTls13SettingFunc.
+func (mock *SslCertificateApiV1) GetTls13Setting(getTls13SettingOptions *sslcertificateapiv1.GetTls13SettingOptions) (result *sslcertificateapiv1.Tls13SettingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetTls13Setting", getTls13SettingOptions)
+	if mock.GetTls13SettingFunc != nil {
+		return mock.GetTls13SettingFunc(getTls13SettingOptions)
+	}
+	if mock.GetTls13SettingWithContextFunc != nil {
+		return mock.GetTls13SettingWithContextFunc(context.Background(), getTls13SettingOptions)
+	}
+	err = notProgrammed("GetTls13Setting")
+	return
+}
+
+// GetTls13SettingWithContext invokes the programmed GetTls13SettingWithContextFunc.
+func (mock *SslCertificateApiV1) GetTls13SettingWithContext(ctx context.Context, getTls13SettingOptions *sslcertificateapiv1.GetTls13SettingOptions) (result *sslcertificateapiv1.Tls13SettingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetTls13SettingWithContext", ctx, getTls13SettingOptions)
+	if mock.GetTls13SettingWithContextFunc != nil {
+		return mock.GetTls13SettingWithContextFunc(ctx, getTls13SettingOptions)
+	}
+	if mock.GetTls13SettingFunc != nil {
+		return mock.GetTls13SettingFunc(getTls13SettingOptions)
+	}
+	err = notProgrammed("GetTls13SettingWithContext")
+	return
+}
+
+// ChangeTls13Setting invokes the programmed ChangeTls13SettingFunc.
+func (mock *SslCertificateApiV1) ChangeTls13Setting(changeTls13SettingOptions *sslcertificateapiv1.ChangeTls13SettingOptions) (result *sslcertificateapiv1.Tls13SettingResp, response *core.DetailedResponse, err error) {
+	mock.called("ChangeTls13Setting", changeTls13SettingOptions)
+	if mock.ChangeTls13SettingFunc != nil {
+		return mock.ChangeTls13SettingFunc(changeTls13SettingOptions)
+	}
+	if mock.ChangeTls13SettingWithContextFunc != nil {
+		return mock.ChangeTls13SettingWithContextFunc(context.Background(), changeTls13SettingOptions)
+	}
+	err = notProgrammed("ChangeTls13Setting")
+	return
+}
+
+// ChangeTls13SettingWithContext invokes the programmed ChangeTls13SettingWithContextFunc.
+func (mock *SslCertificateApiV1) ChangeTls13SettingWithContext(ctx context.Context, changeTls13SettingOptions *sslcertificateapiv1.ChangeTls13SettingOptions) (result *sslcertificateapiv1.Tls13SettingResp, response *core.DetailedResponse, err error) {
+	mock.called("ChangeTls13SettingWithContext", ctx, changeTls13SettingOptions)
+	if mock.ChangeTls13SettingWithContextFunc != nil {
+		return mock.ChangeTls13SettingWithContextFunc(ctx, changeTls13SettingOptions)
+	}
+	if mock.ChangeTls13SettingFunc != nil {
+		return mock.ChangeTls13SettingFunc(changeTls13SettingOptions)
+	}
+	err = notProgrammed("ChangeTls13SettingWithContext")
+	return
+}
@@ -0,0 +1,678 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+)
+
+// TransitGatewayApisV1 is a mock of transitgatewayapisv1.TransitGatewayApisV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type TransitGatewayApisV1 struct {
+	Mock
+
+	ListConnectionsFunc                                       func(*transitgatewayapisv1.ListConnectionsOptions) (*transitgatewayapisv1.TransitConnectionCollection, *core.DetailedResponse, error)
+	ListConnectionsWithContextFunc                            func(context.Context, *transitgatewayapisv1.ListConnectionsOptions) (*transitgatewayapisv1.TransitConnectionCollection, *core.DetailedResponse, error)
+	ListTransitGatewayConnectionPrefixFiltersFunc             func(*transitgatewayapisv1.ListTransitGatewayConnectionPrefixFiltersOptions) (*transitgatewayapisv1.PrefixFilterCollection, *core.DetailedResponse, error)
+	ListTransitGatewayConnectionPrefixFiltersWithContextFunc  func(context.Context, *transitgatewayapisv1.ListTransitGatewayConnectionPrefixFiltersOptions) (*transitgatewayapisv1.PrefixFilterCollection, *core.DetailedResponse, error)
+	CreateTransitGatewayConnectionPrefixFilterFunc            func(*transitgatewayapisv1.CreateTransitGatewayConnectionPrefixFilterOptions) (*transitgatewayapisv1.PrefixFilterCust, *core.DetailedResponse, error)
+	CreateTransitGatewayConnectionPrefixFilterWithContextFunc func(context.Context, *transitgatewayapisv1.CreateTransitGatewayConnectionPrefixFilterOptions) (*transitgatewayapisv1.PrefixFilterCust, *core.DetailedResponse, error)
+	DeleteTransitGatewayConnectionPrefixFilterFunc            func(*transitgatewayapisv1.DeleteTransitGatewayConnectionPrefixFilterOptions) (*core.DetailedResponse, error)
+	DeleteTransitGatewayConnectionPrefixFilterWithContextFunc func(context.Context, *transitgatewayapisv1.DeleteTransitGatewayConnectionPrefixFilterOptions) (*core.DetailedResponse, error)
+	GetTransitGatewayConnectionPrefixFilterFunc               func(*transitgatewayapisv1.GetTransitGatewayConnectionPrefixFilterOptions) (*transitgatewayapisv1.PrefixFilterCust, *core.DetailedResponse, error)
+	GetTransitGatewayConnectionPrefixFilterWithContextFunc    func(context.Context, *transitgatewayapisv1.GetTransitGatewayConnectionPrefixFilterOptions) (*transitgatewayapisv1.PrefixFilterCust, *core.DetailedResponse, error)
+	UpdateTransitGatewayConnectionPrefixFilterFunc            func(*transitgatewayapisv1.UpdateTransitGatewayConnectionPrefixFilterOptions) (*transitgatewayapisv1.PrefixFilterCust, *core.DetailedResponse, error)
+	UpdateTransitGatewayConnectionPrefixFilterWithContextFunc func(context.Context, *transitgatewayapisv1.UpdateTransitGatewayConnectionPrefixFilterOptions) (*transitgatewayapisv1.PrefixFilterCust, *core.DetailedResponse, error)
+	ListTransitGatewayRouteReportsFunc                        func(*transitgatewayapisv1.ListTransitGatewayRouteReportsOptions) (*transitgatewayapisv1.RouteReportCollection, *core.DetailedResponse, error)
+	ListTransitGatewayRouteReportsWithContextFunc             func(context.Context, *transitgatewayapisv1.ListTransitGatewayRouteReportsOptions) (*transitgatewayapisv1.RouteReportCollection, *core.DetailedResponse, error)
+	CreateTransitGatewayRouteReportFunc                       func(*transitgatewayapisv1.CreateTransitGatewayRouteReportOptions) (*transitgatewayapisv1.RouteReport, *core.DetailedResponse, error)
+	CreateTransitGatewayRouteReportWithContextFunc            func(context.Context, *transitgatewayapisv1.CreateTransitGatewayRouteReportOptions) (*transitgatewayapisv1.RouteReport, *core.DetailedResponse, error)
+	DeleteTransitGatewayRouteReportFunc                       func(*transitgatewayapisv1.DeleteTransitGatewayRouteReportOptions) (*core.DetailedResponse, error)
+	DeleteTransitGatewayRouteReportWithContextFunc            func(context.Context, *transitgatewayapisv1.DeleteTransitGatewayRouteReportOptions) (*core.DetailedResponse, error)
+	GetTransitGatewayRouteReportFunc                          func(*transitgatewayapisv1.GetTransitGatewayRouteReportOptions) (*transitgatewayapisv1.RouteReport, *core.DetailedResponse, error)
+	GetTransitGatewayRouteReportWithContextFunc               func(context.Context, *transitgatewayapisv1.GetTransitGatewayRouteReportOptions) (*transitgatewayapisv1.RouteReport, *core.DetailedResponse, error)
+	ListTransitGatewaysFunc                                   func(*transitgatewayapisv1.ListTransitGatewaysOptions) (*transitgatewayapisv1.TransitGatewayCollection, *core.DetailedResponse, error)
+	ListTransitGatewaysWithContextFunc                        func(context.Context, *transitgatewayapisv1.ListTransitGatewaysOptions) (*transitgatewayapisv1.TransitGatewayCollection, *core.DetailedResponse, error)
+	CreateTransitGatewayFunc                                  func(*transitgatewayapisv1.CreateTransitGatewayOptions) (*transitgatewayapisv1.TransitGateway, *core.DetailedResponse, error)
+	CreateTransitGatewayWithContextFunc                       func(context.Context, *transitgatewayapisv1.CreateTransitGatewayOptions) (*transitgatewayapisv1.TransitGateway, *core.DetailedResponse, error)
+	DeleteTransitGatewayFunc                                  func(*transitgatewayapisv1.DeleteTransitGatewayOptions) (*core.DetailedResponse, error)
+	DeleteTransitGatewayWithContextFunc                       func(context.Context, *transitgatewayapisv1.DeleteTransitGatewayOptions) (*core.DetailedResponse, error)
+	GetTransitGatewayFunc                                     func(*transitgatewayapisv1.GetTransitGatewayOptions) (*transitgatewayapisv1.TransitGateway, *core.DetailedResponse, error)
+	GetTransitGatewayWithContextFunc                          func(context.Context, *transitgatewayapisv1.GetTransitGatewayOptions) (*transitgatewayapisv1.TransitGateway, *core.DetailedResponse, error)
+	UpdateTransitGatewayFunc                                  func(*transitgatewayapisv1.UpdateTransitGatewayOptions) (*transitgatewayapisv1.TransitGateway, *core.DetailedResponse, error)
+	UpdateTransitGatewayWithContextFunc                       func(context.Context, *transitgatewayapisv1.UpdateTransitGatewayOptions) (*transitgatewayapisv1.TransitGateway, *core.DetailedResponse, error)
+	ListTransitGatewayConnectionsFunc                         func(*transitgatewayapisv1.ListTransitGatewayConnectionsOptions) (*transitgatewayapisv1.TransitGatewayConnectionCollection, *core.DetailedResponse, error)
+	ListTransitGatewayConnectionsWithContextFunc              func(context.Context, *transitgatewayapisv1.ListTransitGatewayConnectionsOptions) (*transitgatewayapisv1.TransitGatewayConnectionCollection, *core.DetailedResponse, error)
+	CreateTransitGatewayConnectionFunc                        func(*transitgatewayapisv1.CreateTransitGatewayConnectionOptions) (*transitgatewayapisv1.TransitGatewayConnectionCust, *core.DetailedResponse, error)
+	CreateTransitGatewayConnectionWithContextFunc             func(context.Context, *transitgatewayapisv1.CreateTransitGatewayConnectionOptions) (*transitgatewayapisv1.TransitGatewayConnectionCust, *core.DetailedResponse, error)
+	DeleteTransitGatewayConnectionFunc                        func(*transitgatewayapisv1.DeleteTransitGatewayConnectionOptions) (*core.DetailedResponse, error)
+	DeleteTransitGatewayConnectionWithContextFunc             func(context.Context, *transitgatewayapisv1.DeleteTransitGatewayConnectionOptions) (*core.DetailedResponse, error)
+	GetTransitGatewayConnectionFunc                           func(*transitgatewayapisv1.GetTransitGatewayConnectionOptions) (*transitgatewayapisv1.TransitGatewayConnectionCust, *core.DetailedResponse, error)
+	GetTransitGatewayConnectionWithContextFunc                func(context.Context, *transitgatewayapisv1.GetTransitGatewayConnectionOptions) (*transitgatewayapisv1.TransitGatewayConnectionCust, *core.DetailedResponse, error)
+	UpdateTransitGatewayConnectionFunc                        func(*transitgatewayapisv1.UpdateTransitGatewayConnectionOptions) (*transitgatewayapisv1.TransitGatewayConnectionCust, *core.DetailedResponse, error)
+	UpdateTransitGatewayConnectionWithContextFunc             func(context.Context, *transitgatewayapisv1.UpdateTransitGatewayConnectionOptions) (*transitgatewayapisv1.TransitGatewayConnectionCust, *core.DetailedResponse, error)
+	CreateTransitGatewayConnectionActionsFunc                 func(*transitgatewayapisv1.CreateTransitGatewayConnectionActionsOptions) (*core.DetailedResponse, error)
+	CreateTransitGatewayConnectionActionsWithContextFunc      func(context.Context, *transitgatewayapisv1.CreateTransitGatewayConnectionActionsOptions) (*core.DetailedResponse, error)
+	ListGatewayLocationsFunc                                  func(*transitgatewayapisv1.ListGatewayLocationsOptions) (*transitgatewayapisv1.TSCollection, *core.DetailedResponse, error)
+	ListGatewayLocationsWithContextFunc                       func(context.Context, *transitgatewayapisv1.ListGatewayLocationsOptions) (*transitgatewayapisv1.TSCollection, *core.DetailedResponse, error)
+	GetGatewayLocationFunc                                    func(*transitgatewayapisv1.GetGatewayLocationOptions) (*transitgatewayapisv1.TSLocation, *core.DetailedResponse, error)
+	GetGatewayLocationWithContextFunc                         func(context.Context, *transitgatewayapisv1.GetGatewayLocationOptions) (*transitgatewayapisv1.TSLocation, *core.DetailedResponse, error)
+}
+
+// TransitGatewayApisV1 implements transitgatewayapisv1.TransitGatewayApisV1API.
+var _ transitgatewayapisv1.TransitGatewayApisV1API = (*TransitGatewayApisV1)(nil)
+
+// ListConnections invokes the programmed ListConnectionsFunc.
+func (mock *TransitGatewayApisV1) ListConnections(listConnectionsOptions *transitgatewayapisv1.ListConnectionsOptions) (result *transitgatewayapisv1.TransitConnectionCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListConnections", listConnectionsOptions)
+	if mock.ListConnectionsFunc != nil {
+		return mock.ListConnectionsFunc(listConnectionsOptions)
+	}
+	if mock.ListConnectionsWithContextFunc != nil {
+		return mock.ListConnectionsWithContextFunc(context.Background(), listConnectionsOptions)
+	}
+	err = notProgrammed("ListConnections")
+	return
+}
+
+// ListConnectionsWithContext invokes the programmed ListConnectionsWithContextFunc.
+func (mock *TransitGatewayApisV1) ListConnectionsWithContext(ctx context.Context, listConnectionsOptions *transitgatewayapisv1.ListConnectionsOptions) (result *transitgatewayapisv1.TransitConnectionCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListConnectionsWithContext", ctx, listConnectionsOptions)
+	if mock.ListConnectionsWithContextFunc != nil {
+		return mock.ListConnectionsWithContextFunc(ctx, listConnectionsOptions)
+	}
+	if mock.ListConnectionsFunc != nil {
+		return mock.ListConnectionsFunc(listConnectionsOptions)
+	}
+	err = notProgrammed("ListConnectionsWithContext")
+	return
+}
+
+// ListTransitGatewayConnectionPrefixFilters invokes the programmed ListTransitGatewayConnectionPrefixFiltersFunc.
+func (mock *TransitGatewayApisV1) ListTransitGatewayConnectionPrefixFilters(listTransitGatewayConnectionPrefixFiltersOptions *transitgatewayapisv1.ListTransitGatewayConnectionPrefixFiltersOptions) (result *transitgatewayapisv1.PrefixFilterCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListTransitGatewayConnectionPrefixFilters", listTransitGatewayConnectionPrefixFiltersOptions)
+	if mock.ListTransitGatewayConnectionPrefixFiltersFunc != nil {
+		return mock.ListTransitGatewayConnectionPrefixFiltersFunc(listTransitGatewayConnectionPrefixFiltersOptions)
+	}
+	if mock.ListTransitGatewayConnectionPrefixFiltersWithContextFunc != nil {
+		return mock.ListTransitGatewayConnectionPrefixFiltersWithContextFunc(context.Background(), listTransitGatewayConnectionPrefixFiltersOptions)
+	}
+	err = notProgrammed("ListTransitGatewayConnectionPrefixFilters")
+	return
+}
+
+// ListTransitGatewayConnectionPrefixFiltersWithContext invokes the programmed ListTransitGatewayConnectionPrefixFiltersWithContextFunc.
+func (mock *TransitGatewayApisV1) ListTransitGatewayConnectionPrefixFiltersWithContext(ctx context.Context, listTransitGatewayConnectionPrefixFiltersOptions *transitgatewayapisv1.ListTransitGatewayConnectionPrefixFiltersOptions) (result *transitgatewayapisv1.PrefixFilterCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListTransitGatewayConnectionPrefixFiltersWithContext", ctx, listTransitGatewayConnectionPrefixFiltersOptions)
+	if mock.ListTransitGatewayConnectionPrefixFiltersWithContextFunc != nil {
+		return mock.ListTransitGatewayConnectionPrefixFiltersWithContextFunc(ctx, listTransitGatewayConnectionPrefixFiltersOptions)
+	}
+	if mock.ListTransitGatewayConnectionPrefixFiltersFunc != nil {
+		return mock.ListTransitGatewayConnectionPrefixFiltersFunc(listTransitGatewayConnectionPrefixFiltersOptions)
+	}
+	err = notProgrammed("ListTransitGatewayConnectionPrefixFiltersWithContext")
+	return
+}
+
+// CreateTransitGatewayConnectionPrefixFilter invokes the programmed CreateTransitGatewayConnectionPrefixFilterFunc.
+func (mock *TransitGatewayApisV1) CreateTransitGatewayConnectionPrefixFilter(createTransitGatewayConnectionPrefixFilterOptions *transitgatewayapisv1.CreateTransitGatewayConnectionPrefixFilterOptions) (result *transitgatewayapisv1.PrefixFilterCust, response *core.DetailedResponse, err error) {
+	mock.called("CreateTransitGatewayConnectionPrefixFilter", createTransitGatewayConnectionPrefixFilterOptions)
+	if mock.CreateTransitGatewayConnectionPrefixFilterFunc != nil {
+		return mock.CreateTransitGatewayConnectionPrefixFilterFunc(createTransitGatewayConnectionPrefixFilterOptions)
+	}
+	if mock.CreateTransitGatewayConnectionPrefixFilterWithContextFunc != nil {
+		return mock.CreateTransitGatewayConnectionPrefixFilterWithContextFunc(context.Background(), createTransitGatewayConnectionPrefixFilterOptions)
+	}
+	err = notProgrammed("CreateTransitGatewayConnectionPrefixFilter")
+	return
+}
+
+// CreateTransitGatewayConnectionPrefixFilterWithContext invokes the programmed CreateTransitGatewayConnectionPrefixFilterWithContextFunc.
+func (mock *TransitGatewayApisV1) CreateTransitGatewayConnectionPrefixFilterWithContext(ctx context.Context, createTransitGatewayConnectionPrefixFilterOptions *transitgatewayapisv1.CreateTransitGatewayConnectionPrefixFilterOptions) (result *transitgatewayapisv1.PrefixFilterCust, response *core.DetailedResponse, err error) {
+	mock.called("CreateTransitGatewayConnectionPrefixFilterWithContext", ctx, createTransitGatewayConnectionPrefixFilterOptions)
+	if mock.CreateTransitGatewayConnectionPrefixFilterWithContextFunc != nil {
+		return mock.CreateTransitGatewayConnectionPrefixFilterWithContextFunc(ctx, createTransitGatewayConnectionPrefixFilterOptions)
+	}
+	if mock.CreateTransitGatewayConnectionPrefixFilterFunc != nil {
+		return mock.CreateTransitGatewayConnectionPrefixFilterFunc(createTransitGatewayConnectionPrefixFilterOptions)
+	}
+	err = notProgrammed("CreateTransitGatewayConnectionPrefixFilterWithContext")
+	return
+}
+
+// DeleteTransitGatewayConnectionPrefixFilter invokes the programmed DeleteTransitGatewayConnectionPrefixFilterFunc.
+func (mock *TransitGatewayApisV1) DeleteTransitGatewayConnectionPrefixFilter(deleteTransitGatewayConnectionPrefixFilterOptions *transitgatewayapisv1.DeleteTransitGatewayConnectionPrefixFilterOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteTransitGatewayConnectionPrefixFilter", deleteTransitGatewayConnectionPrefixFilterOptions)
+	if mock.DeleteTransitGatewayConnectionPrefixFilterFunc != nil {
+		return mock.DeleteTransitGatewayConnectionPrefixFilterFunc(deleteTransitGatewayConnectionPrefixFilterOptions)
+	}
+	if mock.DeleteTransitGatewayConnectionPrefixFilterWithContextFunc != nil {
+		return mock.DeleteTransitGatewayConnectionPrefixFilterWithContextFunc(context.Background(), deleteTransitGatewayConnectionPrefixFilterOptions)
+	}
+	err = notProgrammed("DeleteTransitGatewayConnectionPrefixFilter")
+	return
+}
+
+// DeleteTransitGatewayConnectionPrefixFilterWithContext invokes the programmed DeleteTransitGatewayConnectionPrefixFilterWithContextFunc.
+func (mock *TransitGatewayApisV1) DeleteTransitGatewayConnectionPrefixFilterWithContext(ctx context.Context, deleteTransitGatewayConnectionPrefixFilterOptions *transitgatewayapisv1.DeleteTransitGatewayConnectionPrefixFilterOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteTransitGatewayConnectionPrefixFilterWithContext", ctx, deleteTransitGatewayConnectionPrefixFilterOptions)
+	if mock.DeleteTransitGatewayConnectionPrefixFilterWithContextFunc != nil {
+		return mock.DeleteTransitGatewayConnectionPrefixFilterWithContextFunc(ctx, deleteTransitGatewayConnectionPrefixFilterOptions)
+	}
+	if mock.DeleteTransitGatewayConnectionPrefixFilterFunc != nil {
+		return mock.DeleteTransitGatewayConnectionPrefixFilterFunc(deleteTransitGatewayConnectionPrefixFilterOptions)
+	}
+	err = notProgrammed("DeleteTransitGatewayConnectionPrefixFilterWithContext")
+	return
+}
+
+// GetTransitGatewayConnectionPrefixFilter invokes the programmed GetTransitGatewayConnectionPrefixFilterFunc.
+func (mock *TransitGatewayApisV1) GetTransitGatewayConnectionPrefixFilter(getTransitGatewayConnectionPrefixFilterOptions *transitgatewayapisv1.GetTransitGatewayConnectionPrefixFilterOptions) (result *transitgatewayapisv1.PrefixFilterCust, response *core.DetailedResponse, err error) {
+	mock.called("GetTransitGatewayConnectionPrefixFilter", getTransitGatewayConnectionPrefixFilterOptions)
+	if mock.GetTransitGatewayConnectionPrefixFilterFunc != nil {
+		return mock.GetTransitGatewayConnectionPrefixFilterFunc(getTransitGatewayConnectionPrefixFilterOptions)
+	}
+	if mock.GetTransitGatewayConnectionPrefixFilterWithContextFunc != nil {
+		return mock.GetTransitGatewayConnectionPrefixFilterWithContextFunc(context.Background(), getTransitGatewayConnectionPrefixFilterOptions)
+	}
+	err = notProgrammed("GetTransitGatewayConnectionPrefixFilter")
+	return
+}
+
+// GetTransitGatewayConnectionPrefixFilterWithContext invokes the programmed GetTransitGatewayConnectionPrefixFilterWithContextFunc.
+func (mock *TransitGatewayApisV1) GetTransitGatewayConnectionPrefixFilterWithContext(ctx context.Context, getTransitGatewayConnectionPrefixFilterOptions *transitgatewayapisv1.GetTransitGatewayConnectionPrefixFilterOptions) (result *transitgatewayapisv1.PrefixFilterCust, response *core.DetailedResponse, err error) {
+	mock.called("GetTransitGatewayConnectionPrefixFilterWithContext", ctx, getTransitGatewayConnectionPrefixFilterOptions)
+	if mock.GetTransitGatewayConnectionPrefixFilterWithContextFunc != nil {
+		return mock.GetTransitGatewayConnectionPrefixFilterWithContextFunc(ctx, getTransitGatewayConnectionPrefixFilterOptions)
+	}
+	if mock.GetTransitGatewayConnectionPrefixFilterFunc != nil {
+		return mock.GetTransitGatewayConnectionPrefixFilterFunc(getTransitGatewayConnectionPrefixFilterOptions)
+	}
+	err = notProgrammed("GetTransitGatewayConnectionPrefixFilterWithContext")
+	return
+}
+
+// UpdateTransitGatewayConnectionPrefixFilter invokes the programmed UpdateTransitGatewayConnectionPrefixFilterFunc.
+func (mock *TransitGatewayApisV1) UpdateTransitGatewayConnectionPrefixFilter(updateTransitGatewayConnectionPrefixFilterOptions *transitgatewayapisv1.UpdateTransitGatewayConnectionPrefixFilterOptions) (result *transitgatewayapisv1.PrefixFilterCust, response *core.DetailedResponse, err error) {
+	mock.called("UpdateTransitGatewayConnectionPrefixFilter", updateTransitGatewayConnectionPrefixFilterOptions)
+	if mock.UpdateTransitGatewayConnectionPrefixFilterFunc != nil {
+		return mock.UpdateTransitGatewayConnectionPrefixFilterFunc(updateTransitGatewayConnectionPrefixFilterOptions)
+	}
+	if mock.UpdateTransitGatewayConnectionPrefixFilterWithContextFunc != nil {
+		return mock.UpdateTransitGatewayConnectionPrefixFilterWithContextFunc(context.Background(), updateTransitGatewayConnectionPrefixFilterOptions)
+	}
+	err = notProgrammed("UpdateTransitGatewayConnectionPrefixFilter")
+	return
+}
+
+// UpdateTransitGatewayConnectionPrefixFilterWithContext invokes the programmed UpdateTransitGatewayConnectionPrefixFilterWithContextFunc.
+func (mock *TransitGatewayApisV1) UpdateTransitGatewayConnectionPrefixFilterWithContext(ctx context.Context, updateTransitGatewayConnectionPrefixFilterOptions *transitgatewayapisv1.UpdateTransitGatewayConnectionPrefixFilterOptions) (result *transitgatewayapisv1.PrefixFilterCust, response *core.DetailedResponse, err error) {
+	mock.called("UpdateTransitGatewayConnectionPrefixFilterWithContext", ctx, updateTransitGatewayConnectionPrefixFilterOptions)
+	if mock.UpdateTransitGatewayConnectionPrefixFilterWithContextFunc != nil {
+		return mock.UpdateTransitGatewayConnectionPrefixFilterWithContextFunc(ctx, updateTransitGatewayConnectionPrefixFilterOptions)
+	}
+	if mock.UpdateTransitGatewayConnectionPrefixFilterFunc != nil {
+		return mock.UpdateTransitGatewayConnectionPrefixFilterFunc(updateTransitGatewayConnectionPrefixFilterOptions)
+	}
+	err = notProgrammed("UpdateTransitGatewayConnectionPrefixFilterWithContext")
+	return
+}
+
+// ListTransitGatewayRouteReports invokes the programmed ListTransitGatewayRouteReportsFunc.
+func (mock *TransitGatewayApisV1) ListTransitGatewayRouteReports(listTransitGatewayRouteReportsOptions *transitgatewayapisv1.ListTransitGatewayRouteReportsOptions) (result *transitgatewayapisv1.RouteReportCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListTransitGatewayRouteReports", listTransitGatewayRouteReportsOptions)
+	if mock.ListTransitGatewayRouteReportsFunc != nil {
+		return mock.ListTransitGatewayRouteReportsFunc(listTransitGatewayRouteReportsOptions)
+	}
+	if mock.ListTransitGatewayRouteReportsWithContextFunc != nil {
+		return mock.ListTransitGatewayRouteReportsWithContextFunc(context.Background(), listTransitGatewayRouteReportsOptions)
+	}
+	err = notProgrammed("ListTransitGatewayRouteReports")
+	return
+}
+
+// ListTransitGatewayRouteReportsWithContext invokes the programmed ListTransitGatewayRouteReportsWithContextFunc.
+func (mock *TransitGatewayApisV1) ListTransitGatewayRouteReportsWithContext(ctx context.Context, listTransitGatewayRouteReportsOptions *transitgatewayapisv1.ListTransitGatewayRouteReportsOptions) (result *transitgatewayapisv1.RouteReportCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListTransitGatewayRouteReportsWithContext", ctx, listTransitGatewayRouteReportsOptions)
+	if mock.ListTransitGatewayRouteReportsWithContextFunc != nil {
+		return mock.ListTransitGatewayRouteReportsWithContextFunc(ctx, listTransitGatewayRouteReportsOptions)
+	}
+	if mock.ListTransitGatewayRouteReportsFunc != nil {
+		return mock.ListTransitGatewayRouteReportsFunc(listTransitGatewayRouteReportsOptions)
+	}
+	err = notProgrammed("ListTransitGatewayRouteReportsWithContext")
+	return
+}
+
+// CreateTransitGatewayRouteReport invokes the programmed CreateTransitGatewayRouteReportFunc.
+func (mock *TransitGatewayApisV1) CreateTransitGatewayRouteReport(createTransitGatewayRouteReportOptions *transitgatewayapisv1.CreateTransitGatewayRouteReportOptions) (result *transitgatewayapisv1.RouteReport, response *core.DetailedResponse, err error) {
+	mock.called("CreateTransitGatewayRouteReport", createTransitGatewayRouteReportOptions)
+	if mock.CreateTransitGatewayRouteReportFunc != nil {
+		return mock.CreateTransitGatewayRouteReportFunc(createTransitGatewayRouteReportOptions)
+	}
+	if mock.CreateTransitGatewayRouteReportWithContextFunc != nil {
+		return mock.CreateTransitGatewayRouteReportWithContextFunc(context.Background(), createTransitGatewayRouteReportOptions)
+	}
+	err = notProgrammed("CreateTransitGatewayRouteReport")
+	return
+}
+
+// CreateTransitGatewayRouteReportWithContext invokes the programmed CreateTransitGatewayRouteReportWithContextFunc.
+func (mock *TransitGatewayApisV1) CreateTransitGatewayRouteReportWithContext(ctx context.Context, createTransitGatewayRouteReportOptions *transitgatewayapisv1.CreateTransitGatewayRouteReportOptions) (result *transitgatewayapisv1.RouteReport, response *core.DetailedResponse, err error) {
+	mock.called("CreateTransitGatewayRouteReportWithContext", ctx, createTransitGatewayRouteReportOptions)
+	if mock.CreateTransitGatewayRouteReportWithContextFunc != nil {
+		return mock.CreateTransitGatewayRouteReportWithContextFunc(ctx, createTransitGatewayRouteReportOptions)
+	}
+	if mock.CreateTransitGatewayRouteReportFunc != nil {
+		return mock.CreateTransitGatewayRouteReportFunc(createTransitGatewayRouteReportOptions)
+	}
+	err = notProgrammed("CreateTransitGatewayRouteReportWithContext")
+	return
+}
+
+// DeleteTransitGatewayRouteReport invokes the programmed DeleteTransitGatewayRouteReportFunc.
+func (mock *TransitGatewayApisV1) DeleteTransitGatewayRouteReport(deleteTransitGatewayRouteReportOptions *transitgatewayapisv1.DeleteTransitGatewayRouteReportOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteTransitGatewayRouteReport", deleteTransitGatewayRouteReportOptions)
+	if mock.DeleteTransitGatewayRouteReportFunc != nil {
+		return mock.DeleteTransitGatewayRouteReportFunc(deleteTransitGatewayRouteReportOptions)
+	}
+	if mock.DeleteTransitGatewayRouteReportWithContextFunc != nil {
+		return mock.DeleteTransitGatewayRouteReportWithContextFunc(context.Background(), deleteTransitGatewayRouteReportOptions)
+	}
+	err = notProgrammed("DeleteTransitGatewayRouteReport")
+	return
+}
+
+// DeleteTransitGatewayRouteReportWithContext invokes the programmed DeleteTransitGatewayRouteReportWithContextFunc.
+func (mock *TransitGatewayApisV1) DeleteTransitGatewayRouteReportWithContext(ctx context.Context, deleteTransitGatewayRouteReportOptions *transitgatewayapisv1.DeleteTransitGatewayRouteReportOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteTransitGatewayRouteReportWithContext", ctx, deleteTransitGatewayRouteReportOptions)
+	if mock.DeleteTransitGatewayRouteReportWithContextFunc != nil {
+		return mock.DeleteTransitGatewayRouteReportWithContextFunc(ctx, deleteTransitGatewayRouteReportOptions)
+	}
+	if mock.DeleteTransitGatewayRouteReportFunc != nil {
+		return mock.DeleteTransitGatewayRouteReportFunc(deleteTransitGatewayRouteReportOptions)
+	}
+	err = notProgrammed("DeleteTransitGatewayRouteReportWithContext")
+	return
+}
+
+// GetTransitGatewayRouteReport invokes the programmed GetTransitGatewayRouteReportFunc.
+func (mock *TransitGatewayApisV1) GetTransitGatewayRouteReport(getTransitGatewayRouteReportOptions *transitgatewayapisv1.GetTransitGatewayRouteReportOptions) (result *transitgatewayapisv1.RouteReport, response *core.DetailedResponse, err error) {
+	mock.called("GetTransitGatewayRouteReport", getTransitGatewayRouteReportOptions)
+	if mock.GetTransitGatewayRouteReportFunc != nil {
+		return mock.GetTransitGatewayRouteReportFunc(getTransitGatewayRouteReportOptions)
+	}
+	if mock.GetTransitGatewayRouteReportWithContextFunc != nil {
+		return mock.GetTransitGatewayRouteReportWithContextFunc(context.Background(), getTransitGatewayRouteReportOptions)
+	}
+	err = notProgrammed("GetTransitGatewayRouteReport")
+	return
+}
+
+// GetTransitGatewayRouteReportWithContext invokes the programmed GetTransitGatewayRouteReportWithContextFunc.
+func (mock *TransitGatewayApisV1) GetTransitGatewayRouteReportWithContext(ctx context.Context, getTransitGatewayRouteReportOptions *transitgatewayapisv1.GetTransitGatewayRouteReportOptions) (result *transitgatewayapisv1.RouteReport, response *core.DetailedResponse, err error) {
+	mock.called("GetTransitGatewayRouteReportWithContext", ctx, getTransitGatewayRouteReportOptions)
+	if mock.GetTransitGatewayRouteReportWithContextFunc != nil {
+		return mock.GetTransitGatewayRouteReportWithContextFunc(ctx, getTransitGatewayRouteReportOptions)
+	}
+	if mock.GetTransitGatewayRouteReportFunc != nil {
+		return mock.GetTransitGatewayRouteReportFunc(getTransitGatewayRouteReportOptions)
+	}
+	err = notProgrammed("GetTransitGatewayRouteReportWithContext")
+	return
+}
+
+// ListTransitGateways invokes the programmed ListTransitGatewaysFunc.
+func (mock *TransitGatewayApisV1) ListTransitGateways(listTransitGatewaysOptions *transitgatewayapisv1.ListTransitGatewaysOptions) (result *transitgatewayapisv1.TransitGatewayCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListTransitGateways", listTransitGatewaysOptions)
+	if mock.ListTransitGatewaysFunc != nil {
+		return mock.ListTransitGatewaysFunc(listTransitGatewaysOptions)
+	}
+	if mock.ListTransitGatewaysWithContextFunc != nil {
+		return mock.ListTransitGatewaysWithContextFunc(context.Background(), listTransitGatewaysOptions)
+	}
+	err = notProgrammed("ListTransitGateways")
+	return
+}
+
+// ListTransitGatewaysWithContext invokes the programmed ListTransitGatewaysWithContextFunc.
+func (mock *TransitGatewayApisV1) ListTransitGatewaysWithContext(ctx context.Context, listTransitGatewaysOptions *transitgatewayapisv1.ListTransitGatewaysOptions) (result *transitgatewayapisv1.TransitGatewayCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListTransitGatewaysWithContext", ctx, listTransitGatewaysOptions)
+	if mock.ListTransitGatewaysWithContextFunc != nil {
+		return mock.ListTransitGatewaysWithContextFunc(ctx, listTransitGatewaysOptions)
+	}
+	if mock.ListTransitGatewaysFunc != nil {
+		return mock.ListTransitGatewaysFunc(listTransitGatewaysOptions)
+	}
+	err = notProgrammed("ListTransitGatewaysWithContext")
+	return
+}
+
+// CreateTransitGateway invokes the programmed CreateTransitGatewayFunc.
+func (mock *TransitGatewayApisV1) CreateTransitGateway(createTransitGatewayOptions *transitgatewayapisv1.CreateTransitGatewayOptions) (result *transitgatewayapisv1.TransitGateway, response *core.DetailedResponse, err error) {
+	mock.called("CreateTransitGateway", createTransitGatewayOptions)
+	if mock.CreateTransitGatewayFunc != nil {
+		return mock.CreateTransitGatewayFunc(createTransitGatewayOptions)
+	}
+	if mock.CreateTransitGatewayWithContextFunc != nil {
+		return mock.CreateTransitGatewayWithContextFunc(context.Background(), createTransitGatewayOptions)
+	}
+	err = notProgrammed("CreateTransitGateway")
+	return
+}
+
+// CreateTransitGatewayWithContext invokes the programmed CreateTransitGatewayWithContextFunc.
+func (mock *TransitGatewayApisV1) CreateTransitGatewayWithContext(ctx context.Context, createTransitGatewayOptions *transitgatewayapisv1.CreateTransitGatewayOptions) (result *transitgatewayapisv1.TransitGateway, response *core.DetailedResponse, err error) {
+	mock.called("CreateTransitGatewayWithContext", ctx, createTransitGatewayOptions)
+	if mock.CreateTransitGatewayWithContextFunc != nil {
+		return mock.CreateTransitGatewayWithContextFunc(ctx, createTransitGatewayOptions)
+	}
+	if mock.CreateTransitGatewayFunc != nil {
+		return mock.CreateTransitGatewayFunc(createTransitGatewayOptions)
+	}
+	err = notProgrammed("CreateTransitGatewayWithContext")
+	return
+}
+
+// DeleteTransitGateway invokes the programmed DeleteTransitGatewayFunc.
+func (mock *TransitGatewayApisV1) DeleteTransitGateway(deleteTransitGatewayOptions *transitgatewayapisv1.DeleteTransitGatewayOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteTransitGateway", deleteTransitGatewayOptions)
+	if mock.DeleteTransitGatewayFunc != nil {
+		return mock.DeleteTransitGatewayFunc(deleteTransitGatewayOptions)
+	}
+	if mock.DeleteTransitGatewayWithContextFunc != nil {
+		return mock.DeleteTransitGatewayWithContextFunc(context.Background(), deleteTransitGatewayOptions)
+	}
+	err = notProgrammed("DeleteTransitGateway")
+	return
+}
+
+// DeleteTransitGatewayWithContext invokes the programmed DeleteTransitGatewayWithContextFunc.
+func (mock *TransitGatewayApisV1) DeleteTransitGatewayWithContext(ctx context.Context, deleteTransitGatewayOptions *transitgatewayapisv1.DeleteTransitGatewayOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteTransitGatewayWithContext", ctx, deleteTransitGatewayOptions)
+	if mock.DeleteTransitGatewayWithContextFunc != nil {
+		return mock.DeleteTransitGatewayWithContextFunc(ctx, deleteTransitGatewayOptions)
+	}
+	if mock.DeleteTransitGatewayFunc != nil {
+		return mock.DeleteTransitGatewayFunc(deleteTransitGatewayOptions)
+	}
+	err = notProgrammed("DeleteTransitGatewayWithContext")
+	return
+}
+
+// GetTransitGateway invokes the programmed GetTransitGatewayFunc.
+func (mock *TransitGatewayApisV1) GetTransitGateway(getTransitGatewayOptions *transitgatewayapisv1.GetTransitGatewayOptions) (result *transitgatewayapisv1.TransitGateway, response *core.DetailedResponse, err error) {
+	mock.called("GetTransitGateway", getTransitGatewayOptions)
+	if mock.GetTransitGatewayFunc != nil {
+		return mock.GetTransitGatewayFunc(getTransitGatewayOptions)
+	}
+	if mock.GetTransitGatewayWithContextFunc != nil {
+		return mock.GetTransitGatewayWithContextFunc(context.Background(), getTransitGatewayOptions)
+	}
+	err = notProgrammed("GetTransitGateway")
+	return
+}
+
+// GetTransitGatewayWithContext invokes the programmed GetTransitGatewayWithContextFunc.
+func (mock *TransitGatewayApisV1) GetTransitGatewayWithContext(ctx context.Context, getTransitGatewayOptions *transitgatewayapisv1.GetTransitGatewayOptions) (result *transitgatewayapisv1.TransitGateway, response *core.DetailedResponse, err error) {
+	mock.called("GetTransitGatewayWithContext", ctx, getTransitGatewayOptions)
+	if mock.GetTransitGatewayWithContextFunc != nil {
+		return mock.GetTransitGatewayWithContextFunc(ctx, getTransitGatewayOptions)
+	}
+	if mock.GetTransitGatewayFunc != nil {
+		return mock.GetTransitGatewayFunc(getTransitGatewayOptions)
+	}
+	err = notProgrammed("GetTransitGatewayWithContext")
+	return
+}
+
+// UpdateTransitGateway invokes the programmed UpdateTransitGatewayFunc.
+func (mock *TransitGatewayApisV1) UpdateTransitGateway(updateTransitGatewayOptions *transitgatewayapisv1.UpdateTransitGatewayOptions) (result *transitgatewayapisv1.TransitGateway, response *core.DetailedResponse, err error) {
+	mock.called("UpdateTransitGateway", updateTransitGatewayOptions)
+	if mock.UpdateTransitGatewayFunc != nil {
+		return mock.UpdateTransitGatewayFunc(updateTransitGatewayOptions)
+	}
+	if mock.UpdateTransitGatewayWithContextFunc != nil {
+		return mock.UpdateTransitGatewayWithContextFunc(context.Background(), updateTransitGatewayOptions)
+	}
+	err = notProgrammed("UpdateTransitGateway")
+	return
+}
+
+// UpdateTransitGatewayWithContext invokes the programmed UpdateTransitGatewayWithContextFunc.
+func (mock *TransitGatewayApisV1) UpdateTransitGatewayWithContext(ctx context.Context, updateTransitGatewayOptions *transitgatewayapisv1.UpdateTransitGatewayOptions) (result *transitgatewayapisv1.TransitGateway, response *core.DetailedResponse, err error) {
+	mock.called("UpdateTransitGatewayWithContext", ctx, updateTransitGatewayOptions)
+	if mock.UpdateTransitGatewayWithContextFunc != nil {
+		return mock.UpdateTransitGatewayWithContextFunc(ctx, updateTransitGatewayOptions)
+	}
+	if mock.UpdateTransitGatewayFunc != nil {
+		return mock.UpdateTransitGatewayFunc(updateTransitGatewayOptions)
+	}
+	err = notProgrammed("UpdateTransitGatewayWithContext")
+	return
+}
+
+// ListTransitGatewayConnections invokes the programmed ListTransitGatewayConnectionsFunc.
+func (mock *TransitGatewayApisV1) ListTransitGatewayConnections(listTransitGatewayConnectionsOptions *transitgatewayapisv1.ListTransitGatewayConnectionsOptions) (result *transitgatewayapisv1.TransitGatewayConnectionCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListTransitGatewayConnections", listTransitGatewayConnectionsOptions)
+	if mock.ListTransitGatewayConnectionsFunc != nil {
+		return mock.ListTransitGatewayConnectionsFunc(listTransitGatewayConnectionsOptions)
+	}
+	if mock.ListTransitGatewayConnectionsWithContextFunc != nil {
+		return mock.ListTransitGatewayConnectionsWithContextFunc(context.Background(), listTransitGatewayConnectionsOptions)
+	}
+	err = notProgrammed("ListTransitGatewayConnections")
+	return
+}
+
+// ListTransitGatewayConnectionsWithContext invokes the programmed ListTransitGatewayConnectionsWithContextFunc.
+func (mock *TransitGatewayApisV1) ListTransitGatewayConnectionsWithContext(ctx context.Context, listTransitGatewayConnectionsOptions *transitgatewayapisv1.ListTransitGatewayConnectionsOptions) (result *transitgatewayapisv1.TransitGatewayConnectionCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListTransitGatewayConnectionsWithContext", ctx, listTransitGatewayConnectionsOptions)
+	if mock.ListTransitGatewayConnectionsWithContextFunc != nil {
+		return mock.ListTransitGatewayConnectionsWithContextFunc(ctx, listTransitGatewayConnectionsOptions)
+	}
+	if mock.ListTransitGatewayConnectionsFunc != nil {
+		return mock.ListTransitGatewayConnectionsFunc(listTransitGatewayConnectionsOptions)
+	}
+	err = notProgrammed("ListTransitGatewayConnectionsWithContext")
+	return
+}
+
+// CreateTransitGatewayConnection invokes the programmed CreateTransitGatewayConnectionFunc.
+func (mock *TransitGatewayApisV1) CreateTransitGatewayConnection(createTransitGatewayConnectionOptions *transitgatewayapisv1.CreateTransitGatewayConnectionOptions) (result *transitgatewayapisv1.TransitGatewayConnectionCust, response *core.DetailedResponse, err error) {
+	mock.called("CreateTransitGatewayConnection", createTransitGatewayConnectionOptions)
+	if mock.CreateTransitGatewayConnectionFunc != nil {
+		return mock.CreateTransitGatewayConnectionFunc(createTransitGatewayConnectionOptions)
+	}
+	if mock.CreateTransitGatewayConnectionWithContextFunc != nil {
+		return mock.CreateTransitGatewayConnectionWithContextFunc(context.Background(), createTransitGatewayConnectionOptions)
+	}
+	err = notProgrammed("CreateTransitGatewayConnection")
+	return
+}
+
+// CreateTransitGatewayConnectionWithContext invokes the programmed CreateTransitGatewayConnectionWithContextFunc.
+func (mock *TransitGatewayApisV1) CreateTransitGatewayConnectionWithContext(ctx context.Context, createTransitGatewayConnectionOptions *transitgatewayapisv1.CreateTransitGatewayConnectionOptions) (result *transitgatewayapisv1.TransitGatewayConnectionCust, response *core.DetailedResponse, err error) {
+	mock.called("CreateTransitGatewayConnectionWithContext", ctx, createTransitGatewayConnectionOptions)
+	if mock.CreateTransitGatewayConnectionWithContextFunc != nil {
+		return mock.CreateTransitGatewayConnectionWithContextFunc(ctx, createTransitGatewayConnectionOptions)
+	}
+	if mock.CreateTransitGatewayConnectionFunc != nil {
+		return mock.CreateTransitGatewayConnectionFunc(createTransitGatewayConnectionOptions)
+	}
+	err = notProgrammed("CreateTransitGatewayConnectionWithContext")
+	return
+}
+
+// DeleteTransitGatewayConnection invokes the programmed DeleteTransitGatewayConnectionFunc.
+func (mock *TransitGatewayApisV1) DeleteTransitGatewayConnection(deleteTransitGatewayConnectionOptions *transitgatewayapisv1.DeleteTransitGatewayConnectionOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteTransitGatewayConnection", deleteTransitGatewayConnectionOptions)
+	if mock.DeleteTransitGatewayConnectionFunc != nil {
+		return mock.DeleteTransitGatewayConnectionFunc(deleteTransitGatewayConnectionOptions)
+	}
+	if mock.DeleteTransitGatewayConnectionWithContextFunc != nil {
+		return mock.DeleteTransitGatewayConnectionWithContextFunc(context.Background(), deleteTransitGatewayConnectionOptions)
+	}
+	err = notProgrammed("DeleteTransitGatewayConnection")
+	return
+}
+
+// DeleteTransitGatewayConnectionWithContext invokes the programmed DeleteTransitGatewayConnectionWithContextFunc.
+func (mock *TransitGatewayApisV1) DeleteTransitGatewayConnectionWithContext(ctx context.Context, deleteTransitGatewayConnectionOptions *transitgatewayapisv1.DeleteTransitGatewayConnectionOptions) (response *core.DetailedResponse, err error) {
+	mock.called("DeleteTransitGatewayConnectionWithContext", ctx, deleteTransitGatewayConnectionOptions)
+	if mock.DeleteTransitGatewayConnectionWithContextFunc != nil {
+		return mock.DeleteTransitGatewayConnectionWithContextFunc(ctx, deleteTransitGatewayConnectionOptions)
+	}
+	if mock.DeleteTransitGatewayConnectionFunc != nil {
+		return mock.DeleteTransitGatewayConnectionFunc(deleteTransitGatewayConnectionOptions)
+	}
+	err = notProgrammed("DeleteTransitGatewayConnectionWithContext")
+	return
+}
+
+// GetTransitGatewayConnection invokes the programmed GetTransitGatewayConnectionFunc.
+func (mock *TransitGatewayApisV1) GetTransitGatewayConnection(getTransitGatewayConnectionOptions *transitgatewayapisv1.GetTransitGatewayConnectionOptions) (result *transitgatewayapisv1.TransitGatewayConnectionCust, response *core.DetailedResponse, err error) {
+	mock.called("GetTransitGatewayConnection", getTransitGatewayConnectionOptions)
+	if mock.GetTransitGatewayConnectionFunc != nil {
+		return mock.GetTransitGatewayConnectionFunc(getTransitGatewayConnectionOptions)
+	}
+	if mock.GetTransitGatewayConnectionWithContextFunc != nil {
+		return mock.GetTransitGatewayConnectionWithContextFunc(context.Background(), getTransitGatewayConnectionOptions)
+	}
+	err = notProgrammed("GetTransitGatewayConnection")
+	return
+}
+
+// GetTransitGatewayConnectionWithContext invokes the programmed GetTransitGatewayConnectionWithContextFunc.
+func (mock *TransitGatewayApisV1) GetTransitGatewayConnectionWithContext(ctx context.Context, getTransitGatewayConnectionOptions *transitgatewayapisv1.GetTransitGatewayConnectionOptions) (result *transitgatewayapisv1.TransitGatewayConnectionCust, response *core.DetailedResponse, err error) {
+	mock.called("GetTransitGatewayConnectionWithContext", ctx, getTransitGatewayConnectionOptions)
+	if mock.GetTransitGatewayConnectionWithContextFunc != nil {
+		return mock.GetTransitGatewayConnectionWithContextFunc(ctx, getTransitGatewayConnectionOptions)
+	}
+	if mock.GetTransitGatewayConnectionFunc != nil {
+		return mock.GetTransitGatewayConnectionFunc(getTransitGatewayConnectionOptions)
+	}
+	err = notProgrammed("GetTransitGatewayConnectionWithContext")
+	return
+}
+
+// UpdateTransitGatewayConnection invokes the programmed UpdateTransitGatewayConnectionFunc.
+func (mock *TransitGatewayApisV1) UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions *transitgatewayapisv1.UpdateTransitGatewayConnectionOptions) (result *transitgatewayapisv1.TransitGatewayConnectionCust, response *core.DetailedResponse, err error) {
+	mock.called("UpdateTransitGatewayConnection", updateTransitGatewayConnectionOptions)
+	if mock.UpdateTransitGatewayConnectionFunc != nil {
+		return mock.UpdateTransitGatewayConnectionFunc(updateTransitGatewayConnectionOptions)
+	}
+	if mock.UpdateTransitGatewayConnectionWithContextFunc != nil {
+		return mock.UpdateTransitGatewayConnectionWithContextFunc(context.Background(), updateTransitGatewayConnectionOptions)
+	}
+	err = notProgrammed("UpdateTransitGatewayConnection")
+	return
+}
+
+// UpdateTransitGatewayConnectionWithContext invokes the programmed UpdateTransitGatewayConnectionWithContextFunc.
+func (mock *TransitGatewayApisV1) UpdateTransitGatewayConnectionWithContext(ctx context.Context, updateTransitGatewayConnectionOptions *transitgatewayapisv1.UpdateTransitGatewayConnectionOptions) (result *transitgatewayapisv1.TransitGatewayConnectionCust, response *core.DetailedResponse, err error) {
+	mock.called("UpdateTransitGatewayConnectionWithContext", ctx, updateTransitGatewayConnectionOptions)
+	if mock.UpdateTransitGatewayConnectionWithContextFunc != nil {
+		return mock.UpdateTransitGatewayConnectionWithContextFunc(ctx, updateTransitGatewayConnectionOptions)
+	}
+	if mock.UpdateTransitGatewayConnectionFunc != nil {
+		return mock.UpdateTransitGatewayConnectionFunc(updateTransitGatewayConnectionOptions)
+	}
+	err = notProgrammed("UpdateTransitGatewayConnectionWithContext")
+	return
+}
+
+// CreateTransitGatewayConnectionActions invokes the programmed CreateTransitGatewayConnectionActionsFunc.
+func (mock *TransitGatewayApisV1) CreateTransitGatewayConnectionActions(createTransitGatewayConnectionActionsOptions *transitgatewayapisv1.CreateTransitGatewayConnectionActionsOptions) (response *core.DetailedResponse, err error) {
+	mock.called("CreateTransitGatewayConnectionActions", createTransitGatewayConnectionActionsOptions)
+	if mock.CreateTransitGatewayConnectionActionsFunc != nil {
+		return mock.CreateTransitGatewayConnectionActionsFunc(createTransitGatewayConnectionActionsOptions)
+	}
+	if mock.CreateTransitGatewayConnectionActionsWithContextFunc != nil {
+		return mock.CreateTransitGatewayConnectionActionsWithContextFunc(context.Background(), createTransitGatewayConnectionActionsOptions)
+	}
+	err = notProgrammed("CreateTransitGatewayConnectionActions")
+	return
+}
+
+// CreateTransitGatewayConnectionActionsWithContext invokes the programmed CreateTransitGatewayConnectionActionsWithContextFunc.
+func (mock *TransitGatewayApisV1) CreateTransitGatewayConnectionActionsWithContext(ctx context.Context, createTransitGatewayConnectionActionsOptions *transitgatewayapisv1.CreateTransitGatewayConnectionActionsOptions) (response *core.DetailedResponse, err error) {
+	mock.called("CreateTransitGatewayConnectionActionsWithContext", ctx, createTransitGatewayConnectionActionsOptions)
+	if mock.CreateTransitGatewayConnectionActionsWithContextFunc != nil {
+		return mock.CreateTransitGatewayConnectionActionsWithContextFunc(ctx, createTransitGatewayConnectionActionsOptions)
+	}
+	if mock.CreateTransitGatewayConnectionActionsFunc != nil {
+		return mock.CreateTransitGatewayConnectionActionsFunc(createTransitGatewayConnectionActionsOptions)
+	}
+	err = notProgrammed("CreateTransitGatewayConnectionActionsWithContext")
+	return
+}
+
+// ListGatewayLocations invokes the programmed ListGatewayLocationsFunc.
+func (mock *TransitGatewayApisV1) ListGatewayLocations(listGatewayLocationsOptions *transitgatewayapisv1.ListGatewayLocationsOptions) (result *transitgatewayapisv1.TSCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListGatewayLocations", listGatewayLocationsOptions)
+	if mock.ListGatewayLocationsFunc != nil {
+		return mock.ListGatewayLocationsFunc(listGatewayLocationsOptions)
+	}
+	if mock.ListGatewayLocationsWithContextFunc != nil {
+		return mock.ListGatewayLocationsWithContextFunc(context.Background(), listGatewayLocationsOptions)
+	}
+	err = notProgrammed("ListGatewayLocations")
+	return
+}
+
+// ListGatewayLocationsWithContext invokes the programmed ListGatewayLocationsWithContextFunc.
+func (mock *TransitGatewayApisV1) ListGatewayLocationsWithContext(ctx context.Context, listGatewayLocationsOptions *transitgatewayapisv1.ListGatewayLocationsOptions) (result *transitgatewayapisv1.TSCollection, response *core.DetailedResponse, err error) {
+	mock.called("ListGatewayLocationsWithContext", ctx, listGatewayLocationsOptions)
+	if mock.ListGatewayLocationsWithContextFunc != nil {
+		return mock.ListGatewayLocationsWithContextFunc(ctx, listGatewayLocationsOptions)
+	}
+	if mock.ListGatewayLocationsFunc != nil {
+		return mock.ListGatewayLocationsFunc(listGatewayLocationsOptions)
+	}
+	err = notProgrammed("ListGatewayLocationsWithContext")
+	return
+}
+
+// GetGatewayLocation invokes the programmed GetGatewayLocationFunc.
+func (mock *TransitGatewayApisV1) GetGatewayLocation(getGatewayLocationOptions *transitgatewayapisv1.GetGatewayLocationOptions) (result *transitgatewayapisv1.TSLocation, response *core.DetailedResponse, err error) {
+	mock.called("GetGatewayLocation", getGatewayLocationOptions)
+	if mock.GetGatewayLocationFunc != nil {
+		return mock.GetGatewayLocationFunc(getGatewayLocationOptions)
+	}
+	if mock.GetGatewayLocationWithContextFunc != nil {
+		return mock.GetGatewayLocationWithContextFunc(context.Background(), getGatewayLocationOptions)
+	}
+	err = notProgrammed("GetGatewayLocation")
+	return
+}
+
+// GetGatewayLocationWithContext invokes the programmed GetGatewayLocationWithContextFunc.
+func (mock *TransitGatewayApisV1) GetGatewayLocationWithContext(ctx context.Context, getGatewayLocationOptions *transitgatewayapisv1.GetGatewayLocationOptions) (result *transitgatewayapisv1.TSLocation, response *core.DetailedResponse, err error) {
+	mock.called("GetGatewayLocationWithContext", ctx, getGatewayLocationOptions)
+	if mock.GetGatewayLocationWithContextFunc != nil {
+		return mock.GetGatewayLocationWithContextFunc(ctx, getGatewayLocationOptions)
+	}
+	if mock.GetGatewayLocationFunc != nil {
+		return mock.GetGatewayLocationFunc(getGatewayLocationOptions)
+	}
+	err = notProgrammed("GetGatewayLocationWithContext")
+	return
+}
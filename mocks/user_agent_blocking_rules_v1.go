@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/useragentblockingrulesv1"
+)
+
+// UserAgentBlockingRulesV1 is a mock of useragentblockingrulesv1.UserAgentBlockingRulesV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type UserAgentBlockingRulesV1 struct {
+	Mock
+
+	ListAllZoneUserAgentRulesFunc            func(*useragentblockingrulesv1.ListAllZoneUserAgentRulesOptions) (*useragentblockingrulesv1.ListUseragentRulesResp, *core.DetailedResponse, error)
+	ListAllZoneUserAgentRulesWithContextFunc func(context.Context, *useragentblockingrulesv1.ListAllZoneUserAgentRulesOptions) (*useragentblockingrulesv1.ListUseragentRulesResp, *core.DetailedResponse, error)
+	CreateZoneUserAgentRuleFunc              func(*useragentblockingrulesv1.CreateZoneUserAgentRuleOptions) (*useragentblockingrulesv1.UseragentRuleResp, *core.DetailedResponse, error)
+	CreateZoneUserAgentRuleWithContextFunc   func(context.Context, *useragentblockingrulesv1.CreateZoneUserAgentRuleOptions) (*useragentblockingrulesv1.UseragentRuleResp, *core.DetailedResponse, error)
+	DeleteZoneUserAgentRuleFunc              func(*useragentblockingrulesv1.DeleteZoneUserAgentRuleOptions) (*useragentblockingrulesv1.DeleteUseragentRuleResp, *core.DetailedResponse, error)
+	DeleteZoneUserAgentRuleWithContextFunc   func(context.Context, *useragentblockingrulesv1.DeleteZoneUserAgentRuleOptions) (*useragentblockingrulesv1.DeleteUseragentRuleResp, *core.DetailedResponse, error)
+	GetUserAgentRuleFunc                     func(*useragentblockingrulesv1.GetUserAgentRuleOptions) (*useragentblockingrulesv1.UseragentRuleResp, *core.DetailedResponse, error)
+	GetUserAgentRuleWithContextFunc          func(context.Context, *useragentblockingrulesv1.GetUserAgentRuleOptions) (*useragentblockingrulesv1.UseragentRuleResp, *core.DetailedResponse, error)
+	UpdateUserAgentRuleFunc                  func(*useragentblockingrulesv1.UpdateUserAgentRuleOptions) (*useragentblockingrulesv1.UseragentRuleResp, *core.DetailedResponse, error)
+	UpdateUserAgentRuleWithContextFunc       func(context.Context, *useragentblockingrulesv1.UpdateUserAgentRuleOptions) (*useragentblockingrulesv1.UseragentRuleResp, *core.DetailedResponse, error)
+}
+
+// UserAgentBlockingRulesV1 implements useragentblockingrulesv1.UserAgentBlockingRulesV1API.
+var _ useragentblockingrulesv1.UserAgentBlockingRulesV1API = (*UserAgentBlockingRulesV1)(nil)
+
+// ListAllZoneUserAgentRules invokes the programmed ListAllZoneUserAgentRulesFunc.
+func (mock *UserAgentBlockingRulesV1) ListAllZoneUserAgentRules(listAllZoneUserAgentRulesOptions *useragentblockingrulesv1.ListAllZoneUserAgentRulesOptions) (result *useragentblockingrulesv1.ListUseragentRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllZoneUserAgentRules", listAllZoneUserAgentRulesOptions)
+	if mock.ListAllZoneUserAgentRulesFunc != nil {
+		return mock.ListAllZoneUserAgentRulesFunc(listAllZoneUserAgentRulesOptions)
+	}
+	if mock.ListAllZoneUserAgentRulesWithContextFunc != nil {
+		return mock.ListAllZoneUserAgentRulesWithContextFunc(context.Background(), listAllZoneUserAgentRulesOptions)
+	}
+	err = notProgrammed("ListAllZoneUserAgentRules")
+	return
+}
+
+// ListAllZoneUserAgentRulesWithContext invokes the programmed ListAllZoneUserAgentRulesWithContextFunc.
+func (mock *UserAgentBlockingRulesV1) ListAllZoneUserAgentRulesWithContext(ctx context.Context, listAllZoneUserAgentRulesOptions *useragentblockingrulesv1.ListAllZoneUserAgentRulesOptions) (result *useragentblockingrulesv1.ListUseragentRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllZoneUserAgentRulesWithContext", ctx, listAllZoneUserAgentRulesOptions)
+	if mock.ListAllZoneUserAgentRulesWithContextFunc != nil {
+		return mock.ListAllZoneUserAgentRulesWithContextFunc(ctx, listAllZoneUserAgentRulesOptions)
+	}
+	if mock.ListAllZoneUserAgentRulesFunc != nil {
+		return mock.ListAllZoneUserAgentRulesFunc(listAllZoneUserAgentRulesOptions)
+	}
+	err = notProgrammed("ListAllZoneUserAgentRulesWithContext")
+	return
+}
+
+// CreateZoneUserAgentRule invokes the programmed CreateZoneUserAgentRuleFunc.
+func (mock *UserAgentBlockingRulesV1) CreateZoneUserAgentRule(createZoneUserAgentRuleOptions *useragentblockingrulesv1.CreateZoneUserAgentRuleOptions) (result *useragentblockingrulesv1.UseragentRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateZoneUserAgentRule", createZoneUserAgentRuleOptions)
+	if mock.CreateZoneUserAgentRuleFunc != nil {
+		return mock.CreateZoneUserAgentRuleFunc(createZoneUserAgentRuleOptions)
+	}
+	if mock.CreateZoneUserAgentRuleWithContextFunc != nil {
+		return mock.CreateZoneUserAgentRuleWithContextFunc(context.Background(), createZoneUserAgentRuleOptions)
+	}
+	err = notProgrammed("CreateZoneUserAgentRule")
+	return
+}
+
+// CreateZoneUserAgentRuleWithContext invokes the programmed CreateZoneUserAgentRuleWithContextFunc.
+func (mock *UserAgentBlockingRulesV1) CreateZoneUserAgentRuleWithContext(ctx context.Context, createZoneUserAgentRuleOptions *useragentblockingrulesv1.CreateZoneUserAgentRuleOptions) (result *useragentblockingrulesv1.UseragentRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateZoneUserAgentRuleWithContext", ctx, createZoneUserAgentRuleOptions)
+	if mock.CreateZoneUserAgentRuleWithContextFunc != nil {
+		return mock.CreateZoneUserAgentRuleWithContextFunc(ctx, createZoneUserAgentRuleOptions)
+	}
+	if mock.CreateZoneUserAgentRuleFunc != nil {
+		return mock.CreateZoneUserAgentRuleFunc(createZoneUserAgentRuleOptions)
+	}
+	err = notProgrammed("CreateZoneUserAgentRuleWithContext")
+	return
+}
+
+// DeleteZoneUserAgentRule invokes the programmed DeleteZoneUserAgentRuleFunc.
+func (mock *UserAgentBlockingRulesV1) DeleteZoneUserAgentRule(deleteZoneUserAgentRuleOptions *useragentblockingrulesv1.DeleteZoneUserAgentRuleOptions) (result *useragentblockingrulesv1.DeleteUseragentRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneUserAgentRule", deleteZoneUserAgentRuleOptions)
+	if mock.DeleteZoneUserAgentRuleFunc != nil {
+		return mock.DeleteZoneUserAgentRuleFunc(deleteZoneUserAgentRuleOptions)
+	}
+	if mock.DeleteZoneUserAgentRuleWithContextFunc != nil {
+		return mock.DeleteZoneUserAgentRuleWithContextFunc(context.Background(), deleteZoneUserAgentRuleOptions)
+	}
+	err = notProgrammed("DeleteZoneUserAgentRule")
+	return
+}
+
+// DeleteZoneUserAgentRuleWithContext invokes the programmed DeleteZoneUserAgentRuleWithContextFunc.
+func (mock *UserAgentBlockingRulesV1) DeleteZoneUserAgentRuleWithContext(ctx context.Context, deleteZoneUserAgentRuleOptions *useragentblockingrulesv1.DeleteZoneUserAgentRuleOptions) (result *useragentblockingrulesv1.DeleteUseragentRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneUserAgentRuleWithContext", ctx, deleteZoneUserAgentRuleOptions)
+	if mock.DeleteZoneUserAgentRuleWithContextFunc != nil {
+		return mock.DeleteZoneUserAgentRuleWithContextFunc(ctx, deleteZoneUserAgentRuleOptions)
+	}
+	if mock.DeleteZoneUserAgentRuleFunc != nil {
+		return mock.DeleteZoneUserAgentRuleFunc(deleteZoneUserAgentRuleOptions)
+	}
+	err = notProgrammed("DeleteZoneUserAgentRuleWithContext")
+	return
+}
+
+// GetUserAgentRule invokes the programmed GetUserAgentRuleFunc.
+func (mock *UserAgentBlockingRulesV1) GetUserAgentRule(getUserAgentRuleOptions *useragentblockingrulesv1.GetUserAgentRuleOptions) (result *useragentblockingrulesv1.UseragentRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("GetUserAgentRule", getUserAgentRuleOptions)
+	if mock.GetUserAgentRuleFunc != nil {
+		return mock.GetUserAgentRuleFunc(getUserAgentRuleOptions)
+	}
+	if mock.GetUserAgentRuleWithContextFunc != nil {
+		return mock.GetUserAgentRuleWithContextFunc(context.Background(), getUserAgentRuleOptions)
+	}
+	err = notProgrammed("GetUserAgentRule")
+	return
+}
+
+// GetUserAgentRuleWithContext invokes the programmed GetUserAgentRuleWithContextFunc.
+func (mock *UserAgentBlockingRulesV1) GetUserAgentRuleWithContext(ctx context.Context, getUserAgentRuleOptions *useragentblockingrulesv1.GetUserAgentRuleOptions) (result *useragentblockingrulesv1.UseragentRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("GetUserAgentRuleWithContext", ctx, getUserAgentRuleOptions)
+	if mock.GetUserAgentRuleWithContextFunc != nil {
+		return mock.GetUserAgentRuleWithContextFunc(ctx, getUserAgentRuleOptions)
+	}
+	if mock.GetUserAgentRuleFunc != nil {
+		return mock.GetUserAgentRuleFunc(getUserAgentRuleOptions)
+	}
+	err = notProgrammed("GetUserAgentRuleWithContext")
+	return
+}
+
+// UpdateUserAgentRule invokes the programmed UpdateUserAgentRuleFunc.
+func (mock *UserAgentBlockingRulesV1) UpdateUserAgentRule(updateUserAgentRuleOptions *useragentblockingrulesv1.UpdateUserAgentRuleOptions) (result *useragentblockingrulesv1.UseragentRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateUserAgentRule", updateUserAgentRuleOptions)
+	if mock.UpdateUserAgentRuleFunc != nil {
+		return mock.UpdateUserAgentRuleFunc(updateUserAgentRuleOptions)
+	}
+	if mock.UpdateUserAgentRuleWithContextFunc != nil {
+		return mock.UpdateUserAgentRuleWithContextFunc(context.Background(), updateUserAgentRuleOptions)
+	}
+	err = notProgrammed("UpdateUserAgentRule")
+	return
+}
+
+// UpdateUserAgentRuleWithContext invokes the programmed UpdateUserAgentRuleWithContextFunc.
+func (mock *UserAgentBlockingRulesV1) UpdateUserAgentRuleWithContext(ctx context.Context, updateUserAgentRuleOptions *useragentblockingrulesv1.UpdateUserAgentRuleOptions) (result *useragentblockingrulesv1.UseragentRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateUserAgentRuleWithContext", ctx, updateUserAgentRuleOptions)
+	if mock.UpdateUserAgentRuleWithContextFunc != nil {
+		return mock.UpdateUserAgentRuleWithContextFunc(ctx, updateUserAgentRuleOptions)
+	}
+	if mock.UpdateUserAgentRuleFunc != nil {
+		return mock.UpdateUserAgentRuleFunc(updateUserAgentRuleOptions)
+	}
+	err = notProgrammed("UpdateUserAgentRuleWithContext")
+	return
+}
@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/wafapiv1"
+)
+
+// WafApiV1 is a mock of wafapiv1.WafApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type WafApiV1 struct {
+	Mock
+
+	GetWafSettingsFunc               func(*wafapiv1.GetWafSettingsOptions) (*wafapiv1.WafResponse, *core.DetailedResponse, error)
+	GetWafSettingsWithContextFunc    func(context.Context, *wafapiv1.GetWafSettingsOptions) (*wafapiv1.WafResponse, *core.DetailedResponse, error)
+	UpdateWafSettingsFunc            func(*wafapiv1.UpdateWafSettingsOptions) (*wafapiv1.WafResponse, *core.DetailedResponse, error)
+	UpdateWafSettingsWithContextFunc func(context.Context, *wafapiv1.UpdateWafSettingsOptions) (*wafapiv1.WafResponse, *core.DetailedResponse, error)
+}
+
+// WafApiV1 implements wafapiv1.WafApiV1API.
+var _ wafapiv1.WafApiV1API = (*WafApiV1)(nil)
+
+// GetWafSettings invokes the programmed GetWafSettingsFunc.
+func (mock *WafApiV1) GetWafSettings(getWafSettingsOptions *wafapiv1.GetWafSettingsOptions) (result *wafapiv1.WafResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetWafSettings", getWafSettingsOptions)
+	if mock.GetWafSettingsFunc != nil {
+		return mock.GetWafSettingsFunc(getWafSettingsOptions)
+	}
+	if mock.GetWafSettingsWithContextFunc != nil {
+		return mock.GetWafSettingsWithContextFunc(context.Background(), getWafSettingsOptions)
+	}
+	err = notProgrammed("GetWafSettings")
+	return
+}
+
+// GetWafSettingsWithContext invokes the programmed GetWafSettingsWithContextFunc.
+func (mock *WafApiV1) GetWafSettingsWithContext(ctx context.Context, getWafSettingsOptions *wafapiv1.GetWafSettingsOptions) (result *wafapiv1.WafResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetWafSettingsWithContext", ctx, getWafSettingsOptions)
+	if mock.GetWafSettingsWithContextFunc != nil {
+		return mock.GetWafSettingsWithContextFunc(ctx, getWafSettingsOptions)
+	}
+	if mock.GetWafSettingsFunc != nil {
+		return mock.GetWafSettingsFunc(getWafSettingsOptions)
+	}
+	err = notProgrammed("GetWafSettingsWithContext")
+	return
+}
+
+// UpdateWafSettings invokes the programmed UpdateWafSettingsFunc.
+func (mock *WafApiV1) UpdateWafSettings(updateWafSettingsOptions *wafapiv1.UpdateWafSettingsOptions) (result *wafapiv1.WafResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWafSettings", updateWafSettingsOptions)
+	if mock.UpdateWafSettingsFunc != nil {
+		return mock.UpdateWafSettingsFunc(updateWafSettingsOptions)
+	}
+	if mock.UpdateWafSettingsWithContextFunc != nil {
+		return mock.UpdateWafSettingsWithContextFunc(context.Background(), updateWafSettingsOptions)
+	}
+	err = notProgrammed("UpdateWafSettings")
+	return
+}
+
+// UpdateWafSettingsWithContext invokes the programmed UpdateWafSettingsWithContextFunc.
+func (mock *WafApiV1) UpdateWafSettingsWithContext(ctx context.Context, updateWafSettingsOptions *wafapiv1.UpdateWafSettingsOptions) (result *wafapiv1.WafResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWafSettingsWithContext", ctx, updateWafSettingsOptions)
+	if mock.UpdateWafSettingsWithContextFunc != nil {
+		return mock.UpdateWafSettingsWithContextFunc(ctx, updateWafSettingsOptions)
+	}
+	if mock.UpdateWafSettingsFunc != nil {
+		return mock.UpdateWafSettingsFunc(updateWafSettingsOptions)
+	}
+	err = notProgrammed("UpdateWafSettingsWithContext")
+	return
+}
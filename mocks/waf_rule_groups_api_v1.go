@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/wafrulegroupsapiv1"
+)
+
+// WafRuleGroupsApiV1 is a mock of wafrulegroupsapiv1.WafRuleGroupsApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type WafRuleGroupsApiV1 struct {
+	Mock
+
+	ListWafRuleGroupsFunc             func(*wafrulegroupsapiv1.ListWafRuleGroupsOptions) (*wafrulegroupsapiv1.WafGroupsResponse, *core.DetailedResponse, error)
+	ListWafRuleGroupsWithContextFunc  func(context.Context, *wafrulegroupsapiv1.ListWafRuleGroupsOptions) (*wafrulegroupsapiv1.WafGroupsResponse, *core.DetailedResponse, error)
+	GetWafRuleGroupFunc               func(*wafrulegroupsapiv1.GetWafRuleGroupOptions) (*wafrulegroupsapiv1.WafGroupResponse, *core.DetailedResponse, error)
+	GetWafRuleGroupWithContextFunc    func(context.Context, *wafrulegroupsapiv1.GetWafRuleGroupOptions) (*wafrulegroupsapiv1.WafGroupResponse, *core.DetailedResponse, error)
+	UpdateWafRuleGroupFunc            func(*wafrulegroupsapiv1.UpdateWafRuleGroupOptions) (*wafrulegroupsapiv1.WafGroupResponse, *core.DetailedResponse, error)
+	UpdateWafRuleGroupWithContextFunc func(context.Context, *wafrulegroupsapiv1.UpdateWafRuleGroupOptions) (*wafrulegroupsapiv1.WafGroupResponse, *core.DetailedResponse, error)
+}
+
+// WafRuleGroupsApiV1 implements wafrulegroupsapiv1.WafRuleGroupsApiV1API.
+var _ wafrulegroupsapiv1.WafRuleGroupsApiV1API = (*WafRuleGroupsApiV1)(nil)
+
+// ListWafRuleGroups invokes the programmed ListWafRuleGroupsFunc.
+func (mock *WafRuleGroupsApiV1) ListWafRuleGroups(listWafRuleGroupsOptions *wafrulegroupsapiv1.ListWafRuleGroupsOptions) (result *wafrulegroupsapiv1.WafGroupsResponse, response *core.DetailedResponse, err error) {
+	mock.called("ListWafRuleGroups", listWafRuleGroupsOptions)
+	if mock.ListWafRuleGroupsFunc != nil {
+		return mock.ListWafRuleGroupsFunc(listWafRuleGroupsOptions)
+	}
+	if mock.ListWafRuleGroupsWithContextFunc != nil {
+		return mock.ListWafRuleGroupsWithContextFunc(context.Background(), listWafRuleGroupsOptions)
+	}
+	err = notProgrammed("ListWafRuleGroups")
+	return
+}
+
+// ListWafRuleGroupsWithContext invokes the programmed ListWafRuleGroupsWithContextFunc.
+func (mock *WafRuleGroupsApiV1) ListWafRuleGroupsWithContext(ctx context.Context, listWafRuleGroupsOptions *wafrulegroupsapiv1.ListWafRuleGroupsOptions) (result *wafrulegroupsapiv1.WafGroupsResponse, response *core.DetailedResponse, err error) {
+	mock.called("ListWafRuleGroupsWithContext", ctx, listWafRuleGroupsOptions)
+	if mock.ListWafRuleGroupsWithContextFunc != nil {
+		return mock.ListWafRuleGroupsWithContextFunc(ctx, listWafRuleGroupsOptions)
+	}
+	if mock.ListWafRuleGroupsFunc != nil {
+		return mock.ListWafRuleGroupsFunc(listWafRuleGroupsOptions)
+	}
+	err = notProgrammed("ListWafRuleGroupsWithContext")
+	return
+}
+
+// GetWafRuleGroup invokes the programmed GetWafRuleGroupFunc.
+func (mock *WafRuleGroupsApiV1) GetWafRuleGroup(getWafRuleGroupOptions *wafrulegroupsapiv1.GetWafRuleGroupOptions) (result *wafrulegroupsapiv1.WafGroupResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetWafRuleGroup", getWafRuleGroupOptions)
+	if mock.GetWafRuleGroupFunc != nil {
+		return mock.GetWafRuleGroupFunc(getWafRuleGroupOptions)
+	}
+	if mock.GetWafRuleGroupWithContextFunc != nil {
+		return mock.GetWafRuleGroupWithContextFunc(context.Background(), getWafRuleGroupOptions)
+	}
+	err = notProgrammed("GetWafRuleGroup")
+	return
+}
+
+// GetWafRuleGroupWithContext invokes the programmed GetWafRuleGroupWithContextFunc.
+func (mock *WafRuleGroupsApiV1) GetWafRuleGroupWithContext(ctx context.Context, getWafRuleGroupOptions *wafrulegroupsapiv1.GetWafRuleGroupOptions) (result *wafrulegroupsapiv1.WafGroupResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetWafRuleGroupWithContext", ctx, getWafRuleGroupOptions)
+	if mock.GetWafRuleGroupWithContextFunc != nil {
+		return mock.GetWafRuleGroupWithContextFunc(ctx, getWafRuleGroupOptions)
+	}
+	if mock.GetWafRuleGroupFunc != nil {
+		return mock.GetWafRuleGroupFunc(getWafRuleGroupOptions)
+	}
+	err = notProgrammed("GetWafRuleGroupWithContext")
+	return
+}
+
+// UpdateWafRuleGroup invokes the programmed UpdateWafRuleGroupFunc.
+func (mock *WafRuleGroupsApiV1) UpdateWafRuleGroup(updateWafRuleGroupOptions *wafrulegroupsapiv1.UpdateWafRuleGroupOptions) (result *wafrulegroupsapiv1.WafGroupResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWafRuleGroup", updateWafRuleGroupOptions)
+	if mock.UpdateWafRuleGroupFunc != nil {
+		return mock.UpdateWafRuleGroupFunc(updateWafRuleGroupOptions)
+	}
+	if mock.UpdateWafRuleGroupWithContextFunc != nil {
+		return mock.UpdateWafRuleGroupWithContextFunc(context.Background(), updateWafRuleGroupOptions)
+	}
+	err = notProgrammed("UpdateWafRuleGroup")
+	return
+}
+
+// UpdateWafRuleGroupWithContext invokes the programmed UpdateWafRuleGroupWithContextFunc.
+func (mock *WafRuleGroupsApiV1) UpdateWafRuleGroupWithContext(ctx context.Context, updateWafRuleGroupOptions *wafrulegroupsapiv1.UpdateWafRuleGroupOptions) (result *wafrulegroupsapiv1.WafGroupResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWafRuleGroupWithContext", ctx, updateWafRuleGroupOptions)
+	if mock.UpdateWafRuleGroupWithContextFunc != nil {
+		return mock.UpdateWafRuleGroupWithContextFunc(ctx, updateWafRuleGroupOptions)
+	}
+	if mock.UpdateWafRuleGroupFunc != nil {
+		return mock.UpdateWafRuleGroupFunc(updateWafRuleGroupOptions)
+	}
+	err = notProgrammed("UpdateWafRuleGroupWithContext")
+	return
+}
@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/wafrulepackagesapiv1"
+)
+
+// WafRulePackagesApiV1 is a mock of wafrulepackagesapiv1.WafRulePackagesApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type WafRulePackagesApiV1 struct {
+	Mock
+
+	ListWafPackagesFunc             func(*wafrulepackagesapiv1.ListWafPackagesOptions) (*wafrulepackagesapiv1.WafPackagesResponse, *core.DetailedResponse, error)
+	ListWafPackagesWithContextFunc  func(context.Context, *wafrulepackagesapiv1.ListWafPackagesOptions) (*wafrulepackagesapiv1.WafPackagesResponse, *core.DetailedResponse, error)
+	GetWafPackageFunc               func(*wafrulepackagesapiv1.GetWafPackageOptions) (*wafrulepackagesapiv1.WafPackageResponse, *core.DetailedResponse, error)
+	GetWafPackageWithContextFunc    func(context.Context, *wafrulepackagesapiv1.GetWafPackageOptions) (*wafrulepackagesapiv1.WafPackageResponse, *core.DetailedResponse, error)
+	UpdateWafPackageFunc            func(*wafrulepackagesapiv1.UpdateWafPackageOptions) (*wafrulepackagesapiv1.WafPackageResponse, *core.DetailedResponse, error)
+	UpdateWafPackageWithContextFunc func(context.Context, *wafrulepackagesapiv1.UpdateWafPackageOptions) (*wafrulepackagesapiv1.WafPackageResponse, *core.DetailedResponse, error)
+}
+
+// WafRulePackagesApiV1 implements wafrulepackagesapiv1.WafRulePackagesApiV1API.
+var _ wafrulepackagesapiv1.WafRulePackagesApiV1API = (*WafRulePackagesApiV1)(nil)
+
+// ListWafPackages invokes the programmed ListWafPackagesFunc.
+func (mock *WafRulePackagesApiV1) ListWafPackages(listWafPackagesOptions *wafrulepackagesapiv1.ListWafPackagesOptions) (result *wafrulepackagesapiv1.WafPackagesResponse, response *core.DetailedResponse, err error) {
+	mock.called("ListWafPackages", listWafPackagesOptions)
+	if mock.ListWafPackagesFunc != nil {
+		return mock.ListWafPackagesFunc(listWafPackagesOptions)
+	}
+	if mock.ListWafPackagesWithContextFunc != nil {
+		return mock.ListWafPackagesWithContextFunc(context.Background(), listWafPackagesOptions)
+	}
+	err = notProgrammed("ListWafPackages")
+	return
+}
+
+// ListWafPackagesWithContext invokes the programmed ListWafPackagesWithContextFunc.
+func (mock *WafRulePackagesApiV1) ListWafPackagesWithContext(ctx context.Context, listWafPackagesOptions *wafrulepackagesapiv1.ListWafPackagesOptions) (result *wafrulepackagesapiv1.WafPackagesResponse, response *core.DetailedResponse, err error) {
+	mock.called("ListWafPackagesWithContext", ctx, listWafPackagesOptions)
+	if mock.ListWafPackagesWithContextFunc != nil {
+		return mock.ListWafPackagesWithContextFunc(ctx, listWafPackagesOptions)
+	}
+	if mock.ListWafPackagesFunc != nil {
+		return mock.ListWafPackagesFunc(listWafPackagesOptions)
+	}
+	err = notProgrammed("ListWafPackagesWithContext")
+	return
+}
+
+// GetWafPackage invokes the programmed GetWafPackageFunc.
+func (mock *WafRulePackagesApiV1) GetWafPackage(getWafPackageOptions *wafrulepackagesapiv1.GetWafPackageOptions) (result *wafrulepackagesapiv1.WafPackageResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetWafPackage", getWafPackageOptions)
+	if mock.GetWafPackageFunc != nil {
+		return mock.GetWafPackageFunc(getWafPackageOptions)
+	}
+	if mock.GetWafPackageWithContextFunc != nil {
+		return mock.GetWafPackageWithContextFunc(context.Background(), getWafPackageOptions)
+	}
+	err = notProgrammed("GetWafPackage")
+	return
+}
+
+// GetWafPackageWithContext invokes the programmed GetWafPackageWithContextFunc.
+func (mock *WafRulePackagesApiV1) GetWafPackageWithContext(ctx context.Context, getWafPackageOptions *wafrulepackagesapiv1.GetWafPackageOptions) (result *wafrulepackagesapiv1.WafPackageResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetWafPackageWithContext", ctx, getWafPackageOptions)
+	if mock.GetWafPackageWithContextFunc != nil {
+		return mock.GetWafPackageWithContextFunc(ctx, getWafPackageOptions)
+	}
+	if mock.GetWafPackageFunc != nil {
+		return mock.GetWafPackageFunc(getWafPackageOptions)
+	}
+	err = notProgrammed("GetWafPackageWithContext")
+	return
+}
+
+// UpdateWafPackage invokes the programmed UpdateWafPackageFunc.
+func (mock *WafRulePackagesApiV1) UpdateWafPackage(updateWafPackageOptions *wafrulepackagesapiv1.UpdateWafPackageOptions) (result *wafrulepackagesapiv1.WafPackageResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWafPackage", updateWafPackageOptions)
+	if mock.UpdateWafPackageFunc != nil {
+		return mock.UpdateWafPackageFunc(updateWafPackageOptions)
+	}
+	if mock.UpdateWafPackageWithContextFunc != nil {
+		return mock.UpdateWafPackageWithContextFunc(context.Background(), updateWafPackageOptions)
+	}
+	err = notProgrammed("UpdateWafPackage")
+	return
+}
+
+// UpdateWafPackageWithContext invokes the programmed UpdateWafPackageWithContextFunc.
+func (mock *WafRulePackagesApiV1) UpdateWafPackageWithContext(ctx context.Context, updateWafPackageOptions *wafrulepackagesapiv1.UpdateWafPackageOptions) (result *wafrulepackagesapiv1.WafPackageResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWafPackageWithContext", ctx, updateWafPackageOptions)
+	if mock.UpdateWafPackageWithContextFunc != nil {
+		return mock.UpdateWafPackageWithContextFunc(ctx, updateWafPackageOptions)
+	}
+	if mock.UpdateWafPackageFunc != nil {
+		return mock.UpdateWafPackageFunc(updateWafPackageOptions)
+	}
+	err = notProgrammed("UpdateWafPackageWithContext")
+	return
+}
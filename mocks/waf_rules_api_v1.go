@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/wafrulesapiv1"
+)
+
+// WafRulesApiV1 is a mock of wafrulesapiv1.WafRulesApiV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type WafRulesApiV1 struct {
+	Mock
+
+	ListWafRulesFunc             func(*wafrulesapiv1.ListWafRulesOptions) (*wafrulesapiv1.WafRulesResponse, *core.DetailedResponse, error)
+	ListWafRulesWithContextFunc  func(context.Context, *wafrulesapiv1.ListWafRulesOptions) (*wafrulesapiv1.WafRulesResponse, *core.DetailedResponse, error)
+	GetWafRuleFunc               func(*wafrulesapiv1.GetWafRuleOptions) (*wafrulesapiv1.WafRuleResponse, *core.DetailedResponse, error)
+	GetWafRuleWithContextFunc    func(context.Context, *wafrulesapiv1.GetWafRuleOptions) (*wafrulesapiv1.WafRuleResponse, *core.DetailedResponse, error)
+	UpdateWafRuleFunc            func(*wafrulesapiv1.UpdateWafRuleOptions) (*wafrulesapiv1.WafRuleResponse, *core.DetailedResponse, error)
+	UpdateWafRuleWithContextFunc func(context.Context, *wafrulesapiv1.UpdateWafRuleOptions) (*wafrulesapiv1.WafRuleResponse, *core.DetailedResponse, error)
+}
+
+// WafRulesApiV1 implements wafrulesapiv1.WafRulesApiV1API.
+var _ wafrulesapiv1.WafRulesApiV1API = (*WafRulesApiV1)(nil)
+
+// ListWafRules invokes the programmed ListWafRulesFunc.
+func (mock *WafRulesApiV1) ListWafRules(listWafRulesOptions *wafrulesapiv1.ListWafRulesOptions) (result *wafrulesapiv1.WafRulesResponse, response *core.DetailedResponse, err error) {
+	mock.called("ListWafRules", listWafRulesOptions)
+	if mock.ListWafRulesFunc != nil {
+		return mock.ListWafRulesFunc(listWafRulesOptions)
+	}
+	if mock.ListWafRulesWithContextFunc != nil {
+		return mock.ListWafRulesWithContextFunc(context.Background(), listWafRulesOptions)
+	}
+	err = notProgrammed("ListWafRules")
+	return
+}
+
+// ListWafRulesWithContext invokes the programmed ListWafRulesWithContextFunc.
+func (mock *WafRulesApiV1) ListWafRulesWithContext(ctx context.Context, listWafRulesOptions *wafrulesapiv1.ListWafRulesOptions) (result *wafrulesapiv1.WafRulesResponse, response *core.DetailedResponse, err error) {
+	mock.called("ListWafRulesWithContext", ctx, listWafRulesOptions)
+	if mock.ListWafRulesWithContextFunc != nil {
+		return mock.ListWafRulesWithContextFunc(ctx, listWafRulesOptions)
+	}
+	if mock.ListWafRulesFunc != nil {
+		return mock.ListWafRulesFunc(listWafRulesOptions)
+	}
+	err = notProgrammed("ListWafRulesWithContext")
+	return
+}
+
+// GetWafRule invokes the programmed GetWafRuleFunc.
+func (mock *WafRulesApiV1) GetWafRule(getWafRuleOptions *wafrulesapiv1.GetWafRuleOptions) (result *wafrulesapiv1.WafRuleResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetWafRule", getWafRuleOptions)
+	if mock.GetWafRuleFunc != nil {
+		return mock.GetWafRuleFunc(getWafRuleOptions)
+	}
+	if mock.GetWafRuleWithContextFunc != nil {
+		return mock.GetWafRuleWithContextFunc(context.Background(), getWafRuleOptions)
+	}
+	err = notProgrammed("GetWafRule")
+	return
+}
+
+// GetWafRuleWithContext invokes the programmed GetWafRuleWithContextFunc.
+func (mock *WafRulesApiV1) GetWafRuleWithContext(ctx context.Context, getWafRuleOptions *wafrulesapiv1.GetWafRuleOptions) (result *wafrulesapiv1.WafRuleResponse, response *core.DetailedResponse, err error) {
+	mock.called("GetWafRuleWithContext", ctx, getWafRuleOptions)
+	if mock.GetWafRuleWithContextFunc != nil {
+		return mock.GetWafRuleWithContextFunc(ctx, getWafRuleOptions)
+	}
+	if mock.GetWafRuleFunc != nil {
+		return mock.GetWafRuleFunc(getWafRuleOptions)
+	}
+	err = notProgrammed("GetWafRuleWithContext")
+	return
+}
+
+// UpdateWafRule invokes the programmed UpdateWafRuleFunc.
+func (mock *WafRulesApiV1) UpdateWafRule(updateWafRuleOptions *wafrulesapiv1.UpdateWafRuleOptions) (result *wafrulesapiv1.WafRuleResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWafRule", updateWafRuleOptions)
+	if mock.UpdateWafRuleFunc != nil {
+		return mock.UpdateWafRuleFunc(updateWafRuleOptions)
+	}
+	if mock.UpdateWafRuleWithContextFunc != nil {
+		return mock.UpdateWafRuleWithContextFunc(context.Background(), updateWafRuleOptions)
+	}
+	err = notProgrammed("UpdateWafRule")
+	return
+}
+
+// UpdateWafRuleWithContext invokes the programmed UpdateWafRuleWithContextFunc.
+func (mock *WafRulesApiV1) UpdateWafRuleWithContext(ctx context.Context, updateWafRuleOptions *wafrulesapiv1.UpdateWafRuleOptions) (result *wafrulesapiv1.WafRuleResponse, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWafRuleWithContext", ctx, updateWafRuleOptions)
+	if mock.UpdateWafRuleWithContextFunc != nil {
+		return mock.UpdateWafRuleWithContextFunc(ctx, updateWafRuleOptions)
+	}
+	if mock.UpdateWafRuleFunc != nil {
+		return mock.UpdateWafRuleFunc(updateWafRuleOptions)
+	}
+	err = notProgrammed("UpdateWafRuleWithContext")
+	return
+}
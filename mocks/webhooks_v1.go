@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/webhooksv1"
+)
+
+// WebhooksV1 is a mock of webhooksv1.WebhooksV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type WebhooksV1 struct {
+	Mock
+
+	ListWebhooksFunc                  func(*webhooksv1.ListWebhooksOptions) (*webhooksv1.ListAlertWebhooksResp, *core.DetailedResponse, error)
+	ListWebhooksWithContextFunc       func(context.Context, *webhooksv1.ListWebhooksOptions) (*webhooksv1.ListAlertWebhooksResp, *core.DetailedResponse, error)
+	CreateAlertWebhookFunc            func(*webhooksv1.CreateAlertWebhookOptions) (*webhooksv1.WebhookSuccessResp, *core.DetailedResponse, error)
+	CreateAlertWebhookWithContextFunc func(context.Context, *webhooksv1.CreateAlertWebhookOptions) (*webhooksv1.WebhookSuccessResp, *core.DetailedResponse, error)
+	GetWebhookFunc                    func(*webhooksv1.GetWebhookOptions) (*webhooksv1.GetAlertWebhookResp, *core.DetailedResponse, error)
+	GetWebhookWithContextFunc         func(context.Context, *webhooksv1.GetWebhookOptions) (*webhooksv1.GetAlertWebhookResp, *core.DetailedResponse, error)
+	UpdateAlertWebhookFunc            func(*webhooksv1.UpdateAlertWebhookOptions) (*webhooksv1.WebhookSuccessResp, *core.DetailedResponse, error)
+	UpdateAlertWebhookWithContextFunc func(context.Context, *webhooksv1.UpdateAlertWebhookOptions) (*webhooksv1.WebhookSuccessResp, *core.DetailedResponse, error)
+	DeleteWebhookFunc                 func(*webhooksv1.DeleteWebhookOptions) (*webhooksv1.WebhookSuccessResp, *core.DetailedResponse, error)
+	DeleteWebhookWithContextFunc      func(context.Context, *webhooksv1.DeleteWebhookOptions) (*webhooksv1.WebhookSuccessResp, *core.DetailedResponse, error)
+}
+
+// WebhooksV1 implements webhooksv1.WebhooksV1API.
+var _ webhooksv1.WebhooksV1API = (*WebhooksV1)(nil)
+
+// ListWebhooks invokes the programmed ListWebhooksFunc.
+func (mock *WebhooksV1) ListWebhooks(listWebhooksOptions *webhooksv1.ListWebhooksOptions) (result *webhooksv1.ListAlertWebhooksResp, response *core.DetailedResponse, err error) {
+	mock.called("ListWebhooks", listWebhooksOptions)
+	if mock.ListWebhooksFunc != nil {
+		return mock.ListWebhooksFunc(listWebhooksOptions)
+	}
+	if mock.ListWebhooksWithContextFunc != nil {
+		return mock.ListWebhooksWithContextFunc(context.Background(), listWebhooksOptions)
+	}
+	err = notProgrammed("ListWebhooks")
+	return
+}
+
+// ListWebhooksWithContext invokes the programmed ListWebhooksWithContextFunc.
+func (mock *WebhooksV1) ListWebhooksWithContext(ctx context.Context, listWebhooksOptions *webhooksv1.ListWebhooksOptions) (result *webhooksv1.ListAlertWebhooksResp, response *core.DetailedResponse, err error) {
+	mock.called("ListWebhooksWithContext", ctx, listWebhooksOptions)
+	if mock.ListWebhooksWithContextFunc != nil {
+		return mock.ListWebhooksWithContextFunc(ctx, listWebhooksOptions)
+	}
+	if mock.ListWebhooksFunc != nil {
+		return mock.ListWebhooksFunc(listWebhooksOptions)
+	}
+	err = notProgrammed("ListWebhooksWithContext")
+	return
+}
+
+// CreateAlertWebhook invokes the programmed CreateAlertWebhookFunc.
+func (mock *WebhooksV1) CreateAlertWebhook(createAlertWebhookOptions *webhooksv1.CreateAlertWebhookOptions) (result *webhooksv1.WebhookSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAlertWebhook", createAlertWebhookOptions)
+	if mock.CreateAlertWebhookFunc != nil {
+		return mock.CreateAlertWebhookFunc(createAlertWebhookOptions)
+	}
+	if mock.CreateAlertWebhookWithContextFunc != nil {
+		return mock.CreateAlertWebhookWithContextFunc(context.Background(), createAlertWebhookOptions)
+	}
+	err = notProgrammed("CreateAlertWebhook")
+	return
+}
+
+// CreateAlertWebhookWithContext invokes the programmed CreateAlertWebhookWithContextFunc.
+func (mock *WebhooksV1) CreateAlertWebhookWithContext(ctx context.Context, createAlertWebhookOptions *webhooksv1.CreateAlertWebhookOptions) (result *webhooksv1.WebhookSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateAlertWebhookWithContext", ctx, createAlertWebhookOptions)
+	if mock.CreateAlertWebhookWithContextFunc != nil {
+		return mock.CreateAlertWebhookWithContextFunc(ctx, createAlertWebhookOptions)
+	}
+	if mock.CreateAlertWebhookFunc != nil {
+		return mock.CreateAlertWebhookFunc(createAlertWebhookOptions)
+	}
+	err = notProgrammed("CreateAlertWebhookWithContext")
+	return
+}
+
+// GetWebhook invokes the programmed GetWebhookFunc.
+func (mock *WebhooksV1) GetWebhook(getWebhookOptions *webhooksv1.GetWebhookOptions) (result *webhooksv1.GetAlertWebhookResp, response *core.DetailedResponse, err error) {
+	mock.called("GetWebhook", getWebhookOptions)
+	if mock.GetWebhookFunc != nil {
+		return mock.GetWebhookFunc(getWebhookOptions)
+	}
+	if mock.GetWebhookWithContextFunc != nil {
+		return mock.GetWebhookWithContextFunc(context.Background(), getWebhookOptions)
+	}
+	err = notProgrammed("GetWebhook")
+	return
+}
+
+// GetWebhookWithContext invokes the programmed GetWebhookWithContextFunc.
+func (mock *WebhooksV1) GetWebhookWithContext(ctx context.Context, getWebhookOptions *webhooksv1.GetWebhookOptions) (result *webhooksv1.GetAlertWebhookResp, response *core.DetailedResponse, err error) {
+	mock.called("GetWebhookWithContext", ctx, getWebhookOptions)
+	if mock.GetWebhookWithContextFunc != nil {
+		return mock.GetWebhookWithContextFunc(ctx, getWebhookOptions)
+	}
+	if mock.GetWebhookFunc != nil {
+		return mock.GetWebhookFunc(getWebhookOptions)
+	}
+	err = notProgrammed("GetWebhookWithContext")
+	return
+}
+
+// UpdateAlertWebhook invokes the programmed UpdateAlertWebhookFunc.
+func (mock *WebhooksV1) UpdateAlertWebhook(updateAlertWebhookOptions *webhooksv1.UpdateAlertWebhookOptions) (result *webhooksv1.WebhookSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAlertWebhook", updateAlertWebhookOptions)
+	if mock.UpdateAlertWebhookFunc != nil {
+		return mock.UpdateAlertWebhookFunc(updateAlertWebhookOptions)
+	}
+	if mock.UpdateAlertWebhookWithContextFunc != nil {
+		return mock.UpdateAlertWebhookWithContextFunc(context.Background(), updateAlertWebhookOptions)
+	}
+	err = notProgrammed("UpdateAlertWebhook")
+	return
+}
+
+// UpdateAlertWebhookWithContext invokes the programmed UpdateAlertWebhookWithContextFunc.
+func (mock *WebhooksV1) UpdateAlertWebhookWithContext(ctx context.Context, updateAlertWebhookOptions *webhooksv1.UpdateAlertWebhookOptions) (result *webhooksv1.WebhookSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAlertWebhookWithContext", ctx, updateAlertWebhookOptions)
+	if mock.UpdateAlertWebhookWithContextFunc != nil {
+		return mock.UpdateAlertWebhookWithContextFunc(ctx, updateAlertWebhookOptions)
+	}
+	if mock.UpdateAlertWebhookFunc != nil {
+		return mock.UpdateAlertWebhookFunc(updateAlertWebhookOptions)
+	}
+	err = notProgrammed("UpdateAlertWebhookWithContext")
+	return
+}
+
+// DeleteWebhook invokes the programmed DeleteWebhookFunc.
+func (mock *WebhooksV1) DeleteWebhook(deleteWebhookOptions *webhooksv1.DeleteWebhookOptions) (result *webhooksv1.WebhookSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteWebhook", deleteWebhookOptions)
+	if mock.DeleteWebhookFunc != nil {
+		return mock.DeleteWebhookFunc(deleteWebhookOptions)
+	}
+	if mock.DeleteWebhookWithContextFunc != nil {
+		return mock.DeleteWebhookWithContextFunc(context.Background(), deleteWebhookOptions)
+	}
+	err = notProgrammed("DeleteWebhook")
+	return
+}
+
+// DeleteWebhookWithContext invokes the programmed DeleteWebhookWithContextFunc.
+func (mock *WebhooksV1) DeleteWebhookWithContext(ctx context.Context, deleteWebhookOptions *webhooksv1.DeleteWebhookOptions) (result *webhooksv1.WebhookSuccessResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteWebhookWithContext", ctx, deleteWebhookOptions)
+	if mock.DeleteWebhookWithContextFunc != nil {
+		return mock.DeleteWebhookWithContextFunc(ctx, deleteWebhookOptions)
+	}
+	if mock.DeleteWebhookFunc != nil {
+		return mock.DeleteWebhookFunc(deleteWebhookOptions)
+	}
+	err = notProgrammed("DeleteWebhookWithContext")
+	return
+}
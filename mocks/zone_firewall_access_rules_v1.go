@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/zonefirewallaccessrulesv1"
+)
+
+// ZoneFirewallAccessRulesV1 is a mock of zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type ZoneFirewallAccessRulesV1 struct {
+	Mock
+
+	ListAllZoneAccessRulesFunc            func(*zonefirewallaccessrulesv1.ListAllZoneAccessRulesOptions) (*zonefirewallaccessrulesv1.ListZoneAccessRulesResp, *core.DetailedResponse, error)
+	ListAllZoneAccessRulesWithContextFunc func(context.Context, *zonefirewallaccessrulesv1.ListAllZoneAccessRulesOptions) (*zonefirewallaccessrulesv1.ListZoneAccessRulesResp, *core.DetailedResponse, error)
+	CreateZoneAccessRuleFunc              func(*zonefirewallaccessrulesv1.CreateZoneAccessRuleOptions) (*zonefirewallaccessrulesv1.ZoneAccessRuleResp, *core.DetailedResponse, error)
+	CreateZoneAccessRuleWithContextFunc   func(context.Context, *zonefirewallaccessrulesv1.CreateZoneAccessRuleOptions) (*zonefirewallaccessrulesv1.ZoneAccessRuleResp, *core.DetailedResponse, error)
+	DeleteZoneAccessRuleFunc              func(*zonefirewallaccessrulesv1.DeleteZoneAccessRuleOptions) (*zonefirewallaccessrulesv1.DeleteZoneAccessRuleResp, *core.DetailedResponse, error)
+	DeleteZoneAccessRuleWithContextFunc   func(context.Context, *zonefirewallaccessrulesv1.DeleteZoneAccessRuleOptions) (*zonefirewallaccessrulesv1.DeleteZoneAccessRuleResp, *core.DetailedResponse, error)
+	GetZoneAccessRuleFunc                 func(*zonefirewallaccessrulesv1.GetZoneAccessRuleOptions) (*zonefirewallaccessrulesv1.ZoneAccessRuleResp, *core.DetailedResponse, error)
+	GetZoneAccessRuleWithContextFunc      func(context.Context, *zonefirewallaccessrulesv1.GetZoneAccessRuleOptions) (*zonefirewallaccessrulesv1.ZoneAccessRuleResp, *core.DetailedResponse, error)
+	UpdateZoneAccessRuleFunc              func(*zonefirewallaccessrulesv1.UpdateZoneAccessRuleOptions) (*zonefirewallaccessrulesv1.ZoneAccessRuleResp, *core.DetailedResponse, error)
+	UpdateZoneAccessRuleWithContextFunc   func(context.Context, *zonefirewallaccessrulesv1.UpdateZoneAccessRuleOptions) (*zonefirewallaccessrulesv1.ZoneAccessRuleResp, *core.DetailedResponse, error)
+}
+
+// ZoneFirewallAccessRulesV1 implements zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1API.
+var _ zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1API = (*ZoneFirewallAccessRulesV1)(nil)
+
+// ListAllZoneAccessRules invokes the programmed ListAllZoneAccessRulesFunc.
+func (mock *ZoneFirewallAccessRulesV1) ListAllZoneAccessRules(listAllZoneAccessRulesOptions *zonefirewallaccessrulesv1.ListAllZoneAccessRulesOptions) (result *zonefirewallaccessrulesv1.ListZoneAccessRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllZoneAccessRules", listAllZoneAccessRulesOptions)
+	if mock.ListAllZoneAccessRulesFunc != nil {
+		return mock.ListAllZoneAccessRulesFunc(listAllZoneAccessRulesOptions)
+	}
+	if mock.ListAllZoneAccessRulesWithContextFunc != nil {
+		return mock.ListAllZoneAccessRulesWithContextFunc(context.Background(), listAllZoneAccessRulesOptions)
+	}
+	err = notProgrammed("ListAllZoneAccessRules")
+	return
+}
+
+// ListAllZoneAccessRulesWithContext invokes the programmed ListAllZoneAccessRulesWithContextFunc.
+func (mock *ZoneFirewallAccessRulesV1) ListAllZoneAccessRulesWithContext(ctx context.Context, listAllZoneAccessRulesOptions *zonefirewallaccessrulesv1.ListAllZoneAccessRulesOptions) (result *zonefirewallaccessrulesv1.ListZoneAccessRulesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllZoneAccessRulesWithContext", ctx, listAllZoneAccessRulesOptions)
+	if mock.ListAllZoneAccessRulesWithContextFunc != nil {
+		return mock.ListAllZoneAccessRulesWithContextFunc(ctx, listAllZoneAccessRulesOptions)
+	}
+	if mock.ListAllZoneAccessRulesFunc != nil {
+		return mock.ListAllZoneAccessRulesFunc(listAllZoneAccessRulesOptions)
+	}
+	err = notProgrammed("ListAllZoneAccessRulesWithContext")
+	return
+}
+
+// CreateZoneAccessRule invokes the programmed CreateZoneAccessRuleFunc.
+func (mock *ZoneFirewallAccessRulesV1) CreateZoneAccessRule(createZoneAccessRuleOptions *zonefirewallaccessrulesv1.CreateZoneAccessRuleOptions) (result *zonefirewallaccessrulesv1.ZoneAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateZoneAccessRule", createZoneAccessRuleOptions)
+	if mock.CreateZoneAccessRuleFunc != nil {
+		return mock.CreateZoneAccessRuleFunc(createZoneAccessRuleOptions)
+	}
+	if mock.CreateZoneAccessRuleWithContextFunc != nil {
+		return mock.CreateZoneAccessRuleWithContextFunc(context.Background(), createZoneAccessRuleOptions)
+	}
+	err = notProgrammed("CreateZoneAccessRule")
+	return
+}
+
+// CreateZoneAccessRuleWithContext invokes the programmed CreateZoneAccessRuleWithContextFunc.
+func (mock *ZoneFirewallAccessRulesV1) CreateZoneAccessRuleWithContext(ctx context.Context, createZoneAccessRuleOptions *zonefirewallaccessrulesv1.CreateZoneAccessRuleOptions) (result *zonefirewallaccessrulesv1.ZoneAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateZoneAccessRuleWithContext", ctx, createZoneAccessRuleOptions)
+	if mock.CreateZoneAccessRuleWithContextFunc != nil {
+		return mock.CreateZoneAccessRuleWithContextFunc(ctx, createZoneAccessRuleOptions)
+	}
+	if mock.CreateZoneAccessRuleFunc != nil {
+		return mock.CreateZoneAccessRuleFunc(createZoneAccessRuleOptions)
+	}
+	err = notProgrammed("CreateZoneAccessRuleWithContext")
+	return
+}
+
+// DeleteZoneAccessRule invokes the programmed DeleteZoneAccessRuleFunc.
+func (mock *ZoneFirewallAccessRulesV1) DeleteZoneAccessRule(deleteZoneAccessRuleOptions *zonefirewallaccessrulesv1.DeleteZoneAccessRuleOptions) (result *zonefirewallaccessrulesv1.DeleteZoneAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneAccessRule", deleteZoneAccessRuleOptions)
+	if mock.DeleteZoneAccessRuleFunc != nil {
+		return mock.DeleteZoneAccessRuleFunc(deleteZoneAccessRuleOptions)
+	}
+	if mock.DeleteZoneAccessRuleWithContextFunc != nil {
+		return mock.DeleteZoneAccessRuleWithContextFunc(context.Background(), deleteZoneAccessRuleOptions)
+	}
+	err = notProgrammed("DeleteZoneAccessRule")
+	return
+}
+
+// DeleteZoneAccessRuleWithContext invokes the programmed DeleteZoneAccessRuleWithContextFunc.
+func (mock *ZoneFirewallAccessRulesV1) DeleteZoneAccessRuleWithContext(ctx context.Context, deleteZoneAccessRuleOptions *zonefirewallaccessrulesv1.DeleteZoneAccessRuleOptions) (result *zonefirewallaccessrulesv1.DeleteZoneAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneAccessRuleWithContext", ctx, deleteZoneAccessRuleOptions)
+	if mock.DeleteZoneAccessRuleWithContextFunc != nil {
+		return mock.DeleteZoneAccessRuleWithContextFunc(ctx, deleteZoneAccessRuleOptions)
+	}
+	if mock.DeleteZoneAccessRuleFunc != nil {
+		return mock.DeleteZoneAccessRuleFunc(deleteZoneAccessRuleOptions)
+	}
+	err = notProgrammed("DeleteZoneAccessRuleWithContext")
+	return
+}
+
+// GetZoneAccessRule invokes the programmed GetZoneAccessRuleFunc.
+func (mock *ZoneFirewallAccessRulesV1) GetZoneAccessRule(getZoneAccessRuleOptions *zonefirewallaccessrulesv1.GetZoneAccessRuleOptions) (result *zonefirewallaccessrulesv1.ZoneAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneAccessRule", getZoneAccessRuleOptions)
+	if mock.GetZoneAccessRuleFunc != nil {
+		return mock.GetZoneAccessRuleFunc(getZoneAccessRuleOptions)
+	}
+	if mock.GetZoneAccessRuleWithContextFunc != nil {
+		return mock.GetZoneAccessRuleWithContextFunc(context.Background(), getZoneAccessRuleOptions)
+	}
+	err = notProgrammed("GetZoneAccessRule")
+	return
+}
+
+// GetZoneAccessRuleWithContext invokes the programmed GetZoneAccessRuleWithContextFunc.
+func (mock *ZoneFirewallAccessRulesV1) GetZoneAccessRuleWithContext(ctx context.Context, getZoneAccessRuleOptions *zonefirewallaccessrulesv1.GetZoneAccessRuleOptions) (result *zonefirewallaccessrulesv1.ZoneAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneAccessRuleWithContext", ctx, getZoneAccessRuleOptions)
+	if mock.GetZoneAccessRuleWithContextFunc != nil {
+		return mock.GetZoneAccessRuleWithContextFunc(ctx, getZoneAccessRuleOptions)
+	}
+	if mock.GetZoneAccessRuleFunc != nil {
+		return mock.GetZoneAccessRuleFunc(getZoneAccessRuleOptions)
+	}
+	err = notProgrammed("GetZoneAccessRuleWithContext")
+	return
+}
+
+// UpdateZoneAccessRule invokes the programmed UpdateZoneAccessRuleFunc.
+func (mock *ZoneFirewallAccessRulesV1) UpdateZoneAccessRule(updateZoneAccessRuleOptions *zonefirewallaccessrulesv1.UpdateZoneAccessRuleOptions) (result *zonefirewallaccessrulesv1.ZoneAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateZoneAccessRule", updateZoneAccessRuleOptions)
+	if mock.UpdateZoneAccessRuleFunc != nil {
+		return mock.UpdateZoneAccessRuleFunc(updateZoneAccessRuleOptions)
+	}
+	if mock.UpdateZoneAccessRuleWithContextFunc != nil {
+		return mock.UpdateZoneAccessRuleWithContextFunc(context.Background(), updateZoneAccessRuleOptions)
+	}
+	err = notProgrammed("UpdateZoneAccessRule")
+	return
+}
+
+// UpdateZoneAccessRuleWithContext invokes the programmed UpdateZoneAccessRuleWithContextFunc.
+func (mock *ZoneFirewallAccessRulesV1) UpdateZoneAccessRuleWithContext(ctx context.Context, updateZoneAccessRuleOptions *zonefirewallaccessrulesv1.UpdateZoneAccessRuleOptions) (result *zonefirewallaccessrulesv1.ZoneAccessRuleResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateZoneAccessRuleWithContext", ctx, updateZoneAccessRuleOptions)
+	if mock.UpdateZoneAccessRuleWithContextFunc != nil {
+		return mock.UpdateZoneAccessRuleWithContextFunc(ctx, updateZoneAccessRuleOptions)
+	}
+	if mock.UpdateZoneAccessRuleFunc != nil {
+		return mock.UpdateZoneAccessRuleFunc(updateZoneAccessRuleOptions)
+	}
+	err = notProgrammed("UpdateZoneAccessRuleWithContext")
+	return
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/zonelockdownv1"
+)
+
+// ZoneLockdownV1 is a mock of zonelockdownv1.ZoneLockdownV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type ZoneLockdownV1 struct {
+	Mock
+
+	ListAllZoneLockownRulesFunc            func(*zonelockdownv1.ListAllZoneLockownRulesOptions) (*zonelockdownv1.ListLockdownResp, *core.DetailedResponse, error)
+	ListAllZoneLockownRulesWithContextFunc func(context.Context, *zonelockdownv1.ListAllZoneLockownRulesOptions) (*zonelockdownv1.ListLockdownResp, *core.DetailedResponse, error)
+	CreateZoneLockdownRuleFunc             func(*zonelockdownv1.CreateZoneLockdownRuleOptions) (*zonelockdownv1.LockdownResp, *core.DetailedResponse, error)
+	CreateZoneLockdownRuleWithContextFunc  func(context.Context, *zonelockdownv1.CreateZoneLockdownRuleOptions) (*zonelockdownv1.LockdownResp, *core.DetailedResponse, error)
+	DeleteZoneLockdownRuleFunc             func(*zonelockdownv1.DeleteZoneLockdownRuleOptions) (*zonelockdownv1.DeleteLockdownResp, *core.DetailedResponse, error)
+	DeleteZoneLockdownRuleWithContextFunc  func(context.Context, *zonelockdownv1.DeleteZoneLockdownRuleOptions) (*zonelockdownv1.DeleteLockdownResp, *core.DetailedResponse, error)
+	GetLockdownFunc                        func(*zonelockdownv1.GetLockdownOptions) (*zonelockdownv1.LockdownResp, *core.DetailedResponse, error)
+	GetLockdownWithContextFunc             func(context.Context, *zonelockdownv1.GetLockdownOptions) (*zonelockdownv1.LockdownResp, *core.DetailedResponse, error)
+	UpdateLockdownRuleFunc                 func(*zonelockdownv1.UpdateLockdownRuleOptions) (*zonelockdownv1.LockdownResp, *core.DetailedResponse, error)
+	UpdateLockdownRuleWithContextFunc      func(context.Context, *zonelockdownv1.UpdateLockdownRuleOptions) (*zonelockdownv1.LockdownResp, *core.DetailedResponse, error)
+}
+
+// ZoneLockdownV1 implements zonelockdownv1.ZoneLockdownV1API.
+var _ zonelockdownv1.ZoneLockdownV1API = (*ZoneLockdownV1)(nil)
+
+// ListAllZoneLockownRules invokes the programmed ListAllZoneLockownRulesFunc.
+func (mock *ZoneLockdownV1) ListAllZoneLockownRules(listAllZoneLockownRulesOptions *zonelockdownv1.ListAllZoneLockownRulesOptions) (result *zonelockdownv1.ListLockdownResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllZoneLockownRules", listAllZoneLockownRulesOptions)
+	if mock.ListAllZoneLockownRulesFunc != nil {
+		return mock.ListAllZoneLockownRulesFunc(listAllZoneLockownRulesOptions)
+	}
+	if mock.ListAllZoneLockownRulesWithContextFunc != nil {
+		return mock.ListAllZoneLockownRulesWithContextFunc(context.Background(), listAllZoneLockownRulesOptions)
+	}
+	err = notProgrammed("ListAllZoneLockownRules")
+	return
+}
+
+// ListAllZoneLockownRulesWithContext invokes the programmed ListAllZoneLockownRulesWithContextFunc.
+func (mock *ZoneLockdownV1) ListAllZoneLockownRulesWithContext(ctx context.Context, listAllZoneLockownRulesOptions *zonelockdownv1.ListAllZoneLockownRulesOptions) (result *zonelockdownv1.ListLockdownResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllZoneLockownRulesWithContext", ctx, listAllZoneLockownRulesOptions)
+	if mock.ListAllZoneLockownRulesWithContextFunc != nil {
+		return mock.ListAllZoneLockownRulesWithContextFunc(ctx, listAllZoneLockownRulesOptions)
+	}
+	if mock.ListAllZoneLockownRulesFunc != nil {
+		return mock.ListAllZoneLockownRulesFunc(listAllZoneLockownRulesOptions)
+	}
+	err = notProgrammed("ListAllZoneLockownRulesWithContext")
+	return
+}
+
+// CreateZoneLockdownRule invokes the programmed CreateZoneLockdownRuleFunc.
+func (mock *ZoneLockdownV1) CreateZoneLockdownRule(createZoneLockdownRuleOptions *zonelockdownv1.CreateZoneLockdownRuleOptions) (result *zonelockdownv1.LockdownResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateZoneLockdownRule", createZoneLockdownRuleOptions)
+	if mock.CreateZoneLockdownRuleFunc != nil {
+		return mock.CreateZoneLockdownRuleFunc(createZoneLockdownRuleOptions)
+	}
+	if mock.CreateZoneLockdownRuleWithContextFunc != nil {
+		return mock.CreateZoneLockdownRuleWithContextFunc(context.Background(), createZoneLockdownRuleOptions)
+	}
+	err = notProgrammed("CreateZoneLockdownRule")
+	return
+}
+
+// CreateZoneLockdownRuleWithContext invokes the programmed CreateZoneLockdownRuleWithContextFunc.
+func (mock *ZoneLockdownV1) CreateZoneLockdownRuleWithContext(ctx context.Context, createZoneLockdownRuleOptions *zonelockdownv1.CreateZoneLockdownRuleOptions) (result *zonelockdownv1.LockdownResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateZoneLockdownRuleWithContext", ctx, createZoneLockdownRuleOptions)
+	if mock.CreateZoneLockdownRuleWithContextFunc != nil {
+		return mock.CreateZoneLockdownRuleWithContextFunc(ctx, createZoneLockdownRuleOptions)
+	}
+	if mock.CreateZoneLockdownRuleFunc != nil {
+		return mock.CreateZoneLockdownRuleFunc(createZoneLockdownRuleOptions)
+	}
+	err = notProgrammed("CreateZoneLockdownRuleWithContext")
+	return
+}
+
+// DeleteZoneLockdownRule invokes the programmed DeleteZoneLockdownRuleFunc.
+func (mock *ZoneLockdownV1) DeleteZoneLockdownRule(deleteZoneLockdownRuleOptions *zonelockdownv1.DeleteZoneLockdownRuleOptions) (result *zonelockdownv1.DeleteLockdownResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneLockdownRule", deleteZoneLockdownRuleOptions)
+	if mock.DeleteZoneLockdownRuleFunc != nil {
+		return mock.DeleteZoneLockdownRuleFunc(deleteZoneLockdownRuleOptions)
+	}
+	if mock.DeleteZoneLockdownRuleWithContextFunc != nil {
+		return mock.DeleteZoneLockdownRuleWithContextFunc(context.Background(), deleteZoneLockdownRuleOptions)
+	}
+	err = notProgrammed("DeleteZoneLockdownRule")
+	return
+}
+
+// DeleteZoneLockdownRuleWithContext invokes the programmed DeleteZoneLockdownRuleWithContextFunc.
+func (mock *ZoneLockdownV1) DeleteZoneLockdownRuleWithContext(ctx context.Context, deleteZoneLockdownRuleOptions *zonelockdownv1.DeleteZoneLockdownRuleOptions) (result *zonelockdownv1.DeleteLockdownResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneLockdownRuleWithContext", ctx, deleteZoneLockdownRuleOptions)
+	if mock.DeleteZoneLockdownRuleWithContextFunc != nil {
+		return mock.DeleteZoneLockdownRuleWithContextFunc(ctx, deleteZoneLockdownRuleOptions)
+	}
+	if mock.DeleteZoneLockdownRuleFunc != nil {
+		return mock.DeleteZoneLockdownRuleFunc(deleteZoneLockdownRuleOptions)
+	}
+	err = notProgrammed("DeleteZoneLockdownRuleWithContext")
+	return
+}
+
+// GetLockdown invokes the programmed GetLockdownFunc.
+func (mock *ZoneLockdownV1) GetLockdown(getLockdownOptions *zonelockdownv1.GetLockdownOptions) (result *zonelockdownv1.LockdownResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLockdown", getLockdownOptions)
+	if mock.GetLockdownFunc != nil {
+		return mock.GetLockdownFunc(getLockdownOptions)
+	}
+	if mock.GetLockdownWithContextFunc != nil {
+		return mock.GetLockdownWithContextFunc(context.Background(), getLockdownOptions)
+	}
+	err = notProgrammed("GetLockdown")
+	return
+}
+
+// GetLockdownWithContext invokes the programmed GetLockdownWithContextFunc.
+func (mock *ZoneLockdownV1) GetLockdownWithContext(ctx context.Context, getLockdownOptions *zonelockdownv1.GetLockdownOptions) (result *zonelockdownv1.LockdownResp, response *core.DetailedResponse, err error) {
+	mock.called("GetLockdownWithContext", ctx, getLockdownOptions)
+	if mock.GetLockdownWithContextFunc != nil {
+		return mock.GetLockdownWithContextFunc(ctx, getLockdownOptions)
+	}
+	if mock.GetLockdownFunc != nil {
+		return mock.GetLockdownFunc(getLockdownOptions)
+	}
+	err = notProgrammed("GetLockdownWithContext")
+	return
+}
+
+// UpdateLockdownRule invokes the programmed UpdateLockdownRuleFunc.
+func (mock *ZoneLockdownV1) UpdateLockdownRule(updateLockdownRuleOptions *zonelockdownv1.UpdateLockdownRuleOptions) (result *zonelockdownv1.LockdownResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLockdownRule", updateLockdownRuleOptions)
+	if mock.UpdateLockdownRuleFunc != nil {
+		return mock.UpdateLockdownRuleFunc(updateLockdownRuleOptions)
+	}
+	if mock.UpdateLockdownRuleWithContextFunc != nil {
+		return mock.UpdateLockdownRuleWithContextFunc(context.Background(), updateLockdownRuleOptions)
+	}
+	err = notProgrammed("UpdateLockdownRule")
+	return
+}
+
+// UpdateLockdownRuleWithContext invokes the programmed UpdateLockdownRuleWithContextFunc.
+func (mock *ZoneLockdownV1) UpdateLockdownRuleWithContext(ctx context.Context, updateLockdownRuleOptions *zonelockdownv1.UpdateLockdownRuleOptions) (result *zonelockdownv1.LockdownResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateLockdownRuleWithContext", ctx, updateLockdownRuleOptions)
+	if mock.UpdateLockdownRuleWithContextFunc != nil {
+		return mock.UpdateLockdownRuleWithContextFunc(ctx, updateLockdownRuleOptions)
+	}
+	if mock.UpdateLockdownRuleFunc != nil {
+		return mock.UpdateLockdownRuleFunc(updateLockdownRuleOptions)
+	}
+	err = notProgrammed("UpdateLockdownRuleWithContext")
+	return
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/zoneratelimitsv1"
+)
+
+// ZoneRateLimitsV1 is a mock of zoneratelimitsv1.ZoneRateLimitsV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type ZoneRateLimitsV1 struct {
+	Mock
+
+	ListAllZoneRateLimitsFunc            func(*zoneratelimitsv1.ListAllZoneRateLimitsOptions) (*zoneratelimitsv1.ListRatelimitResp, *core.DetailedResponse, error)
+	ListAllZoneRateLimitsWithContextFunc func(context.Context, *zoneratelimitsv1.ListAllZoneRateLimitsOptions) (*zoneratelimitsv1.ListRatelimitResp, *core.DetailedResponse, error)
+	CreateZoneRateLimitsFunc             func(*zoneratelimitsv1.CreateZoneRateLimitsOptions) (*zoneratelimitsv1.RatelimitResp, *core.DetailedResponse, error)
+	CreateZoneRateLimitsWithContextFunc  func(context.Context, *zoneratelimitsv1.CreateZoneRateLimitsOptions) (*zoneratelimitsv1.RatelimitResp, *core.DetailedResponse, error)
+	DeleteZoneRateLimitFunc              func(*zoneratelimitsv1.DeleteZoneRateLimitOptions) (*zoneratelimitsv1.DeleteRateLimitResp, *core.DetailedResponse, error)
+	DeleteZoneRateLimitWithContextFunc   func(context.Context, *zoneratelimitsv1.DeleteZoneRateLimitOptions) (*zoneratelimitsv1.DeleteRateLimitResp, *core.DetailedResponse, error)
+	GetRateLimitFunc                     func(*zoneratelimitsv1.GetRateLimitOptions) (*zoneratelimitsv1.RatelimitResp, *core.DetailedResponse, error)
+	GetRateLimitWithContextFunc          func(context.Context, *zoneratelimitsv1.GetRateLimitOptions) (*zoneratelimitsv1.RatelimitResp, *core.DetailedResponse, error)
+	UpdateRateLimitFunc                  func(*zoneratelimitsv1.UpdateRateLimitOptions) (*zoneratelimitsv1.RatelimitResp, *core.DetailedResponse, error)
+	UpdateRateLimitWithContextFunc       func(context.Context, *zoneratelimitsv1.UpdateRateLimitOptions) (*zoneratelimitsv1.RatelimitResp, *core.DetailedResponse, error)
+}
+
+// ZoneRateLimitsV1 implements zoneratelimitsv1.ZoneRateLimitsV1API.
+var _ zoneratelimitsv1.ZoneRateLimitsV1API = (*ZoneRateLimitsV1)(nil)
+
+// ListAllZoneRateLimits invokes the programmed ListAllZoneRateLimitsFunc.
+func (mock *ZoneRateLimitsV1) ListAllZoneRateLimits(listAllZoneRateLimitsOptions *zoneratelimitsv1.ListAllZoneRateLimitsOptions) (result *zoneratelimitsv1.ListRatelimitResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllZoneRateLimits", listAllZoneRateLimitsOptions)
+	if mock.ListAllZoneRateLimitsFunc != nil {
+		return mock.ListAllZoneRateLimitsFunc(listAllZoneRateLimitsOptions)
+	}
+	if mock.ListAllZoneRateLimitsWithContextFunc != nil {
+		return mock.ListAllZoneRateLimitsWithContextFunc(context.Background(), listAllZoneRateLimitsOptions)
+	}
+	err = notProgrammed("ListAllZoneRateLimits")
+	return
+}
+
+// ListAllZoneRateLimitsWithContext invokes the programmed ListAllZoneRateLimitsWithContextFunc.
+func (mock *ZoneRateLimitsV1) ListAllZoneRateLimitsWithContext(ctx context.Context, listAllZoneRateLimitsOptions *zoneratelimitsv1.ListAllZoneRateLimitsOptions) (result *zoneratelimitsv1.ListRatelimitResp, response *core.DetailedResponse, err error) {
+	mock.called("ListAllZoneRateLimitsWithContext", ctx, listAllZoneRateLimitsOptions)
+	if mock.ListAllZoneRateLimitsWithContextFunc != nil {
+		return mock.ListAllZoneRateLimitsWithContextFunc(ctx, listAllZoneRateLimitsOptions)
+	}
+	if mock.ListAllZoneRateLimitsFunc != nil {
+		return mock.ListAllZoneRateLimitsFunc(listAllZoneRateLimitsOptions)
+	}
+	err = notProgrammed("ListAllZoneRateLimitsWithContext")
+	return
+}
+
+// CreateZoneRateLimits invokes the programmed CreateZoneRateLimitsFunc.
+func (mock *ZoneRateLimitsV1) CreateZoneRateLimits(createZoneRateLimitsOptions *zoneratelimitsv1.CreateZoneRateLimitsOptions) (result *zoneratelimitsv1.RatelimitResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateZoneRateLimits", createZoneRateLimitsOptions)
+	if mock.CreateZoneRateLimitsFunc != nil {
+		return mock.CreateZoneRateLimitsFunc(createZoneRateLimitsOptions)
+	}
+	if mock.CreateZoneRateLimitsWithContextFunc != nil {
+		return mock.CreateZoneRateLimitsWithContextFunc(context.Background(), createZoneRateLimitsOptions)
+	}
+	err = notProgrammed("CreateZoneRateLimits")
+	return
+}
+
+// CreateZoneRateLimitsWithContext invokes the programmed CreateZoneRateLimitsWithContextFunc.
+func (mock *ZoneRateLimitsV1) CreateZoneRateLimitsWithContext(ctx context.Context, createZoneRateLimitsOptions *zoneratelimitsv1.CreateZoneRateLimitsOptions) (result *zoneratelimitsv1.RatelimitResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateZoneRateLimitsWithContext", ctx, createZoneRateLimitsOptions)
+	if mock.CreateZoneRateLimitsWithContextFunc != nil {
+		return mock.CreateZoneRateLimitsWithContextFunc(ctx, createZoneRateLimitsOptions)
+	}
+	if mock.CreateZoneRateLimitsFunc != nil {
+		return mock.CreateZoneRateLimitsFunc(createZoneRateLimitsOptions)
+	}
+	err = notProgrammed("CreateZoneRateLimitsWithContext")
+	return
+}
+
+// DeleteZoneRateLimit invokes the programmed DeleteZoneRateLimitFunc.
+func (mock *ZoneRateLimitsV1) DeleteZoneRateLimit(deleteZoneRateLimitOptions *zoneratelimitsv1.DeleteZoneRateLimitOptions) (result *zoneratelimitsv1.DeleteRateLimitResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneRateLimit", deleteZoneRateLimitOptions)
+	if mock.DeleteZoneRateLimitFunc != nil {
+		return mock.DeleteZoneRateLimitFunc(deleteZoneRateLimitOptions)
+	}
+	if mock.DeleteZoneRateLimitWithContextFunc != nil {
+		return mock.DeleteZoneRateLimitWithContextFunc(context.Background(), deleteZoneRateLimitOptions)
+	}
+	err = notProgrammed("DeleteZoneRateLimit")
+	return
+}
+
+// DeleteZoneRateLimitWithContext invokes the programmed DeleteZoneRateLimitWithContextFunc.
+func (mock *ZoneRateLimitsV1) DeleteZoneRateLimitWithContext(ctx context.Context, deleteZoneRateLimitOptions *zoneratelimitsv1.DeleteZoneRateLimitOptions) (result *zoneratelimitsv1.DeleteRateLimitResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneRateLimitWithContext", ctx, deleteZoneRateLimitOptions)
+	if mock.DeleteZoneRateLimitWithContextFunc != nil {
+		return mock.DeleteZoneRateLimitWithContextFunc(ctx, deleteZoneRateLimitOptions)
+	}
+	if mock.DeleteZoneRateLimitFunc != nil {
+		return mock.DeleteZoneRateLimitFunc(deleteZoneRateLimitOptions)
+	}
+	err = notProgrammed("DeleteZoneRateLimitWithContext")
+	return
+}
+
+// GetRateLimit invokes the programmed GetRateLimitFunc.
+func (mock *ZoneRateLimitsV1) GetRateLimit(getRateLimitOptions *zoneratelimitsv1.GetRateLimitOptions) (result *zoneratelimitsv1.RatelimitResp, response *core.DetailedResponse, err error) {
+	mock.called("GetRateLimit", getRateLimitOptions)
+	if mock.GetRateLimitFunc != nil {
+		return mock.GetRateLimitFunc(getRateLimitOptions)
+	}
+	if mock.GetRateLimitWithContextFunc != nil {
+		return mock.GetRateLimitWithContextFunc(context.Background(), getRateLimitOptions)
+	}
+	err = notProgrammed("GetRateLimit")
+	return
+}
+
+// GetRateLimitWithContext invokes the programmed GetRateLimitWithContextFunc.
+func (mock *ZoneRateLimitsV1) GetRateLimitWithContext(ctx context.Context, getRateLimitOptions *zoneratelimitsv1.GetRateLimitOptions) (result *zoneratelimitsv1.RatelimitResp, response *core.DetailedResponse, err error) {
+	mock.called("GetRateLimitWithContext", ctx, getRateLimitOptions)
+	if mock.GetRateLimitWithContextFunc != nil {
+		return mock.GetRateLimitWithContextFunc(ctx, getRateLimitOptions)
+	}
+	if mock.GetRateLimitFunc != nil {
+		return mock.GetRateLimitFunc(getRateLimitOptions)
+	}
+	err = notProgrammed("GetRateLimitWithContext")
+	return
+}
+
+// UpdateRateLimit invokes the programmed UpdateRateLimitFunc.
+func (mock *ZoneRateLimitsV1) UpdateRateLimit(updateRateLimitOptions *zoneratelimitsv1.UpdateRateLimitOptions) (result *zoneratelimitsv1.RatelimitResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateRateLimit", updateRateLimitOptions)
+	if mock.UpdateRateLimitFunc != nil {
+		return mock.UpdateRateLimitFunc(updateRateLimitOptions)
+	}
+	if mock.UpdateRateLimitWithContextFunc != nil {
+		return mock.UpdateRateLimitWithContextFunc(context.Background(), updateRateLimitOptions)
+	}
+	err = notProgrammed("UpdateRateLimit")
+	return
+}
+
+// UpdateRateLimitWithContext invokes the programmed UpdateRateLimitWithContextFunc.
+func (mock *ZoneRateLimitsV1) UpdateRateLimitWithContext(ctx context.Context, updateRateLimitOptions *zoneratelimitsv1.UpdateRateLimitOptions) (result *zoneratelimitsv1.RatelimitResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateRateLimitWithContext", ctx, updateRateLimitOptions)
+	if mock.UpdateRateLimitWithContextFunc != nil {
+		return mock.UpdateRateLimitWithContextFunc(ctx, updateRateLimitOptions)
+	}
+	if mock.UpdateRateLimitFunc != nil {
+		return mock.UpdateRateLimitFunc(updateRateLimitOptions)
+	}
+	err = notProgrammed("UpdateRateLimitWithContext")
+	return
+}
@@ -0,0 +1,1770 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/zonessettingsv1"
+)
+
+// ZonesSettingsV1 is a mock of zonessettingsv1.ZonesSettingsV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type ZonesSettingsV1 struct {
+	Mock
+
+	GetZoneDnssecFunc                            func(*zonessettingsv1.GetZoneDnssecOptions) (*zonessettingsv1.ZonesDnssecResp, *core.DetailedResponse, error)
+	GetZoneDnssecWithContextFunc                 func(context.Context, *zonessettingsv1.GetZoneDnssecOptions) (*zonessettingsv1.ZonesDnssecResp, *core.DetailedResponse, error)
+	UpdateZoneDnssecFunc                         func(*zonessettingsv1.UpdateZoneDnssecOptions) (*zonessettingsv1.ZonesDnssecResp, *core.DetailedResponse, error)
+	UpdateZoneDnssecWithContextFunc              func(context.Context, *zonessettingsv1.UpdateZoneDnssecOptions) (*zonessettingsv1.ZonesDnssecResp, *core.DetailedResponse, error)
+	GetZoneCnameFlatteningFunc                   func(*zonessettingsv1.GetZoneCnameFlatteningOptions) (*zonessettingsv1.ZonesCnameFlatteningResp, *core.DetailedResponse, error)
+	GetZoneCnameFlatteningWithContextFunc        func(context.Context, *zonessettingsv1.GetZoneCnameFlatteningOptions) (*zonessettingsv1.ZonesCnameFlatteningResp, *core.DetailedResponse, error)
+	UpdateZoneCnameFlatteningFunc                func(*zonessettingsv1.UpdateZoneCnameFlatteningOptions) (*zonessettingsv1.ZonesCnameFlatteningResp, *core.DetailedResponse, error)
+	UpdateZoneCnameFlatteningWithContextFunc     func(context.Context, *zonessettingsv1.UpdateZoneCnameFlatteningOptions) (*zonessettingsv1.ZonesCnameFlatteningResp, *core.DetailedResponse, error)
+	GetOpportunisticEncryptionFunc               func(*zonessettingsv1.GetOpportunisticEncryptionOptions) (*zonessettingsv1.OpportunisticEncryptionResp, *core.DetailedResponse, error)
+	GetOpportunisticEncryptionWithContextFunc    func(context.Context, *zonessettingsv1.GetOpportunisticEncryptionOptions) (*zonessettingsv1.OpportunisticEncryptionResp, *core.DetailedResponse, error)
+	UpdateOpportunisticEncryptionFunc            func(*zonessettingsv1.UpdateOpportunisticEncryptionOptions) (*zonessettingsv1.OpportunisticEncryptionResp, *core.DetailedResponse, error)
+	UpdateOpportunisticEncryptionWithContextFunc func(context.Context, *zonessettingsv1.UpdateOpportunisticEncryptionOptions) (*zonessettingsv1.OpportunisticEncryptionResp, *core.DetailedResponse, error)
+	GetChallengeTTLFunc                          func(*zonessettingsv1.GetChallengeTtlOptions) (*zonessettingsv1.ChallengeTtlResp, *core.DetailedResponse, error)
+	GetChallengeTTLWithContextFunc               func(context.Context, *zonessettingsv1.GetChallengeTtlOptions) (*zonessettingsv1.ChallengeTtlResp, *core.DetailedResponse, error)
+	UpdateChallengeTTLFunc                       func(*zonessettingsv1.UpdateChallengeTtlOptions) (*zonessettingsv1.ChallengeTtlResp, *core.DetailedResponse, error)
+	UpdateChallengeTTLWithContextFunc            func(context.Context, *zonessettingsv1.UpdateChallengeTtlOptions) (*zonessettingsv1.ChallengeTtlResp, *core.DetailedResponse, error)
+	GetAutomaticHttpsRewritesFunc                func(*zonessettingsv1.GetAutomaticHttpsRewritesOptions) (*zonessettingsv1.AutomaticHttpsRewritesResp, *core.DetailedResponse, error)
+	GetAutomaticHttpsRewritesWithContextFunc     func(context.Context, *zonessettingsv1.GetAutomaticHttpsRewritesOptions) (*zonessettingsv1.AutomaticHttpsRewritesResp, *core.DetailedResponse, error)
+	UpdateAutomaticHttpsRewritesFunc             func(*zonessettingsv1.UpdateAutomaticHttpsRewritesOptions) (*zonessettingsv1.AutomaticHttpsRewritesResp, *core.DetailedResponse, error)
+	UpdateAutomaticHttpsRewritesWithContextFunc  func(context.Context, *zonessettingsv1.UpdateAutomaticHttpsRewritesOptions) (*zonessettingsv1.AutomaticHttpsRewritesResp, *core.DetailedResponse, error)
+	GetTrueClientIpFunc                          func(*zonessettingsv1.GetTrueClientIpOptions) (*zonessettingsv1.TrueClientIpResp, *core.DetailedResponse, error)
+	GetTrueClientIpWithContextFunc               func(context.Context, *zonessettingsv1.GetTrueClientIpOptions) (*zonessettingsv1.TrueClientIpResp, *core.DetailedResponse, error)
+	UpdateTrueClientIpFunc                       func(*zonessettingsv1.UpdateTrueClientIpOptions) (*zonessettingsv1.TrueClientIpResp, *core.DetailedResponse, error)
+	UpdateTrueClientIpWithContextFunc            func(context.Context, *zonessettingsv1.UpdateTrueClientIpOptions) (*zonessettingsv1.TrueClientIpResp, *core.DetailedResponse, error)
+	GetAlwaysUseHttpsFunc                        func(*zonessettingsv1.GetAlwaysUseHttpsOptions) (*zonessettingsv1.AlwaysUseHttpsResp, *core.DetailedResponse, error)
+	GetAlwaysUseHttpsWithContextFunc             func(context.Context, *zonessettingsv1.GetAlwaysUseHttpsOptions) (*zonessettingsv1.AlwaysUseHttpsResp, *core.DetailedResponse, error)
+	UpdateAlwaysUseHttpsFunc                     func(*zonessettingsv1.UpdateAlwaysUseHttpsOptions) (*zonessettingsv1.AlwaysUseHttpsResp, *core.DetailedResponse, error)
+	UpdateAlwaysUseHttpsWithContextFunc          func(context.Context, *zonessettingsv1.UpdateAlwaysUseHttpsOptions) (*zonessettingsv1.AlwaysUseHttpsResp, *core.DetailedResponse, error)
+	GetImageSizeOptimizationFunc                 func(*zonessettingsv1.GetImageSizeOptimizationOptions) (*zonessettingsv1.ImageSizeOptimizationResp, *core.DetailedResponse, error)
+	GetImageSizeOptimizationWithContextFunc      func(context.Context, *zonessettingsv1.GetImageSizeOptimizationOptions) (*zonessettingsv1.ImageSizeOptimizationResp, *core.DetailedResponse, error)
+	UpdateImageSizeOptimizationFunc              func(*zonessettingsv1.UpdateImageSizeOptimizationOptions) (*zonessettingsv1.ImageSizeOptimizationResp, *core.DetailedResponse, error)
+	UpdateImageSizeOptimizationWithContextFunc   func(context.Context, *zonessettingsv1.UpdateImageSizeOptimizationOptions) (*zonessettingsv1.ImageSizeOptimizationResp, *core.DetailedResponse, error)
+	GetScriptLoadOptimizationFunc                func(*zonessettingsv1.GetScriptLoadOptimizationOptions) (*zonessettingsv1.ScriptLoadOptimizationResp, *core.DetailedResponse, error)
+	GetScriptLoadOptimizationWithContextFunc     func(context.Context, *zonessettingsv1.GetScriptLoadOptimizationOptions) (*zonessettingsv1.ScriptLoadOptimizationResp, *core.DetailedResponse, error)
+	UpdateScriptLoadOptimizationFunc             func(*zonessettingsv1.UpdateScriptLoadOptimizationOptions) (*zonessettingsv1.ScriptLoadOptimizationResp, *core.DetailedResponse, error)
+	UpdateScriptLoadOptimizationWithContextFunc  func(context.Context, *zonessettingsv1.UpdateScriptLoadOptimizationOptions) (*zonessettingsv1.ScriptLoadOptimizationResp, *core.DetailedResponse, error)
+	GetImageLoadOptimizationFunc                 func(*zonessettingsv1.GetImageLoadOptimizationOptions) (*zonessettingsv1.ImageLoadOptimizationResp, *core.DetailedResponse, error)
+	GetImageLoadOptimizationWithContextFunc      func(context.Context, *zonessettingsv1.GetImageLoadOptimizationOptions) (*zonessettingsv1.ImageLoadOptimizationResp, *core.DetailedResponse, error)
+	UpdateImageLoadOptimizationFunc              func(*zonessettingsv1.UpdateImageLoadOptimizationOptions) (*zonessettingsv1.ImageLoadOptimizationResp, *core.DetailedResponse, error)
+	UpdateImageLoadOptimizationWithContextFunc   func(context.Context, *zonessettingsv1.UpdateImageLoadOptimizationOptions) (*zonessettingsv1.ImageLoadOptimizationResp, *core.DetailedResponse, error)
+	GetMinifyFunc                                func(*zonessettingsv1.GetMinifyOptions) (*zonessettingsv1.MinifyResp, *core.DetailedResponse, error)
+	GetMinifyWithContextFunc                     func(context.Context, *zonessettingsv1.GetMinifyOptions) (*zonessettingsv1.MinifyResp, *core.DetailedResponse, error)
+	UpdateMinifyFunc                             func(*zonessettingsv1.UpdateMinifyOptions) (*zonessettingsv1.MinifyResp, *core.DetailedResponse, error)
+	UpdateMinifyWithContextFunc                  func(context.Context, *zonessettingsv1.UpdateMinifyOptions) (*zonessettingsv1.MinifyResp, *core.DetailedResponse, error)
+	GetMinTlsVersionFunc                         func(*zonessettingsv1.GetMinTlsVersionOptions) (*zonessettingsv1.MinTlsVersionResp, *core.DetailedResponse, error)
+	GetMinTlsVersionWithContextFunc              func(context.Context, *zonessettingsv1.GetMinTlsVersionOptions) (*zonessettingsv1.MinTlsVersionResp, *core.DetailedResponse, error)
+	UpdateMinTlsVersionFunc                      func(*zonessettingsv1.UpdateMinTlsVersionOptions) (*zonessettingsv1.MinTlsVersionResp, *core.DetailedResponse, error)
+	UpdateMinTlsVersionWithContextFunc           func(context.Context, *zonessettingsv1.UpdateMinTlsVersionOptions) (*zonessettingsv1.MinTlsVersionResp, *core.DetailedResponse, error)
+	GetIpGeolocationFunc                         func(*zonessettingsv1.GetIpGeolocationOptions) (*zonessettingsv1.IpGeolocationResp, *core.DetailedResponse, error)
+	GetIpGeolocationWithContextFunc              func(context.Context, *zonessettingsv1.GetIpGeolocationOptions) (*zonessettingsv1.IpGeolocationResp, *core.DetailedResponse, error)
+	UpdateIpGeolocationFunc                      func(*zonessettingsv1.UpdateIpGeolocationOptions) (*zonessettingsv1.IpGeolocationResp, *core.DetailedResponse, error)
+	UpdateIpGeolocationWithContextFunc           func(context.Context, *zonessettingsv1.UpdateIpGeolocationOptions) (*zonessettingsv1.IpGeolocationResp, *core.DetailedResponse, error)
+	GetServerSideExcludeFunc                     func(*zonessettingsv1.GetServerSideExcludeOptions) (*zonessettingsv1.ServerSideExcludeResp, *core.DetailedResponse, error)
+	GetServerSideExcludeWithContextFunc          func(context.Context, *zonessettingsv1.GetServerSideExcludeOptions) (*zonessettingsv1.ServerSideExcludeResp, *core.DetailedResponse, error)
+	UpdateServerSideExcludeFunc                  func(*zonessettingsv1.UpdateServerSideExcludeOptions) (*zonessettingsv1.ServerSideExcludeResp, *core.DetailedResponse, error)
+	UpdateServerSideExcludeWithContextFunc       func(context.Context, *zonessettingsv1.UpdateServerSideExcludeOptions) (*zonessettingsv1.ServerSideExcludeResp, *core.DetailedResponse, error)
+	GetSecurityHeaderFunc                        func(*zonessettingsv1.GetSecurityHeaderOptions) (*zonessettingsv1.SecurityHeaderResp, *core.DetailedResponse, error)
+	GetSecurityHeaderWithContextFunc             func(context.Context, *zonessettingsv1.GetSecurityHeaderOptions) (*zonessettingsv1.SecurityHeaderResp, *core.DetailedResponse, error)
+	UpdateSecurityHeaderFunc                     func(*zonessettingsv1.UpdateSecurityHeaderOptions) (*zonessettingsv1.SecurityHeaderResp, *core.DetailedResponse, error)
+	UpdateSecurityHeaderWithContextFunc          func(context.Context, *zonessettingsv1.UpdateSecurityHeaderOptions) (*zonessettingsv1.SecurityHeaderResp, *core.DetailedResponse, error)
+	GetMobileRedirectFunc                        func(*zonessettingsv1.GetMobileRedirectOptions) (*zonessettingsv1.MobileRedirectResp, *core.DetailedResponse, error)
+	GetMobileRedirectWithContextFunc             func(context.Context, *zonessettingsv1.GetMobileRedirectOptions) (*zonessettingsv1.MobileRedirectResp, *core.DetailedResponse, error)
+	UpdateMobileRedirectFunc                     func(*zonessettingsv1.UpdateMobileRedirectOptions) (*zonessettingsv1.MobileRedirectResp, *core.DetailedResponse, error)
+	UpdateMobileRedirectWithContextFunc          func(context.Context, *zonessettingsv1.UpdateMobileRedirectOptions) (*zonessettingsv1.MobileRedirectResp, *core.DetailedResponse, error)
+	GetPrefetchPreloadFunc                       func(*zonessettingsv1.GetPrefetchPreloadOptions) (*zonessettingsv1.PrefetchPreloadResp, *core.DetailedResponse, error)
+	GetPrefetchPreloadWithContextFunc            func(context.Context, *zonessettingsv1.GetPrefetchPreloadOptions) (*zonessettingsv1.PrefetchPreloadResp, *core.DetailedResponse, error)
+	UpdatePrefetchPreloadFunc                    func(*zonessettingsv1.UpdatePrefetchPreloadOptions) (*zonessettingsv1.PrefetchPreloadResp, *core.DetailedResponse, error)
+	UpdatePrefetchPreloadWithContextFunc         func(context.Context, *zonessettingsv1.UpdatePrefetchPreloadOptions) (*zonessettingsv1.PrefetchPreloadResp, *core.DetailedResponse, error)
+	GetHttp2Func                                 func(*zonessettingsv1.GetHttp2Options) (*zonessettingsv1.Http2Resp, *core.DetailedResponse, error)
+	GetHttp2WithContextFunc                      func(context.Context, *zonessettingsv1.GetHttp2Options) (*zonessettingsv1.Http2Resp, *core.DetailedResponse, error)
+	UpdateHttp2Func                              func(*zonessettingsv1.UpdateHttp2Options) (*zonessettingsv1.Http2Resp, *core.DetailedResponse, error)
+	UpdateHttp2WithContextFunc                   func(context.Context, *zonessettingsv1.UpdateHttp2Options) (*zonessettingsv1.Http2Resp, *core.DetailedResponse, error)
+	GetHttp3Func                                 func(*zonessettingsv1.GetHttp3Options) (*zonessettingsv1.Http3Resp, *core.DetailedResponse, error)
+	GetHttp3WithContextFunc                      func(context.Context, *zonessettingsv1.GetHttp3Options) (*zonessettingsv1.Http3Resp, *core.DetailedResponse, error)
+	UpdateHttp3Func                              func(*zonessettingsv1.UpdateHttp3Options) (*zonessettingsv1.Http3Resp, *core.DetailedResponse, error)
+	UpdateHttp3WithContextFunc                   func(context.Context, *zonessettingsv1.UpdateHttp3Options) (*zonessettingsv1.Http3Resp, *core.DetailedResponse, error)
+	GetIpv6Func                                  func(*zonessettingsv1.GetIpv6Options) (*zonessettingsv1.Ipv6Resp, *core.DetailedResponse, error)
+	GetIpv6WithContextFunc                       func(context.Context, *zonessettingsv1.GetIpv6Options) (*zonessettingsv1.Ipv6Resp, *core.DetailedResponse, error)
+	UpdateIpv6Func                               func(*zonessettingsv1.UpdateIpv6Options) (*zonessettingsv1.Ipv6Resp, *core.DetailedResponse, error)
+	UpdateIpv6WithContextFunc                    func(context.Context, *zonessettingsv1.UpdateIpv6Options) (*zonessettingsv1.Ipv6Resp, *core.DetailedResponse, error)
+	GetWebSocketsFunc                            func(*zonessettingsv1.GetWebSocketsOptions) (*zonessettingsv1.WebsocketsResp, *core.DetailedResponse, error)
+	GetWebSocketsWithContextFunc                 func(context.Context, *zonessettingsv1.GetWebSocketsOptions) (*zonessettingsv1.WebsocketsResp, *core.DetailedResponse, error)
+	UpdateWebSocketsFunc                         func(*zonessettingsv1.UpdateWebSocketsOptions) (*zonessettingsv1.WebsocketsResp, *core.DetailedResponse, error)
+	UpdateWebSocketsWithContextFunc              func(context.Context, *zonessettingsv1.UpdateWebSocketsOptions) (*zonessettingsv1.WebsocketsResp, *core.DetailedResponse, error)
+	GetPseudoIpv4Func                            func(*zonessettingsv1.GetPseudoIpv4Options) (*zonessettingsv1.PseudoIpv4Resp, *core.DetailedResponse, error)
+	GetPseudoIpv4WithContextFunc                 func(context.Context, *zonessettingsv1.GetPseudoIpv4Options) (*zonessettingsv1.PseudoIpv4Resp, *core.DetailedResponse, error)
+	UpdatePseudoIpv4Func                         func(*zonessettingsv1.UpdatePseudoIpv4Options) (*zonessettingsv1.PseudoIpv4Resp, *core.DetailedResponse, error)
+	UpdatePseudoIpv4WithContextFunc              func(context.Context, *zonessettingsv1.UpdatePseudoIpv4Options) (*zonessettingsv1.PseudoIpv4Resp, *core.DetailedResponse, error)
+	GetResponseBufferingFunc                     func(*zonessettingsv1.GetResponseBufferingOptions) (*zonessettingsv1.ResponseBufferingResp, *core.DetailedResponse, error)
+	GetResponseBufferingWithContextFunc          func(context.Context, *zonessettingsv1.GetResponseBufferingOptions) (*zonessettingsv1.ResponseBufferingResp, *core.DetailedResponse, error)
+	UpdateResponseBufferingFunc                  func(*zonessettingsv1.UpdateResponseBufferingOptions) (*zonessettingsv1.ResponseBufferingResp, *core.DetailedResponse, error)
+	UpdateResponseBufferingWithContextFunc       func(context.Context, *zonessettingsv1.UpdateResponseBufferingOptions) (*zonessettingsv1.ResponseBufferingResp, *core.DetailedResponse, error)
+	GetHotlinkProtectionFunc                     func(*zonessettingsv1.GetHotlinkProtectionOptions) (*zonessettingsv1.HotlinkProtectionResp, *core.DetailedResponse, error)
+	GetHotlinkProtectionWithContextFunc          func(context.Context, *zonessettingsv1.GetHotlinkProtectionOptions) (*zonessettingsv1.HotlinkProtectionResp, *core.DetailedResponse, error)
+	UpdateHotlinkProtectionFunc                  func(*zonessettingsv1.UpdateHotlinkProtectionOptions) (*zonessettingsv1.HotlinkProtectionResp, *core.DetailedResponse, error)
+	UpdateHotlinkProtectionWithContextFunc       func(context.Context, *zonessettingsv1.UpdateHotlinkProtectionOptions) (*zonessettingsv1.HotlinkProtectionResp, *core.DetailedResponse, error)
+	GetMaxUploadFunc                             func(*zonessettingsv1.GetMaxUploadOptions) (*zonessettingsv1.MaxUploadResp, *core.DetailedResponse, error)
+	GetMaxUploadWithContextFunc                  func(context.Context, *zonessettingsv1.GetMaxUploadOptions) (*zonessettingsv1.MaxUploadResp, *core.DetailedResponse, error)
+	UpdateMaxUploadFunc                          func(*zonessettingsv1.UpdateMaxUploadOptions) (*zonessettingsv1.MaxUploadResp, *core.DetailedResponse, error)
+	UpdateMaxUploadWithContextFunc               func(context.Context, *zonessettingsv1.UpdateMaxUploadOptions) (*zonessettingsv1.MaxUploadResp, *core.DetailedResponse, error)
+	GetTlsClientAuthFunc                         func(*zonessettingsv1.GetTlsClientAuthOptions) (*zonessettingsv1.TlsClientAuthResp, *core.DetailedResponse, error)
+	GetTlsClientAuthWithContextFunc              func(context.Context, *zonessettingsv1.GetTlsClientAuthOptions) (*zonessettingsv1.TlsClientAuthResp, *core.DetailedResponse, error)
+	UpdateTlsClientAuthFunc                      func(*zonessettingsv1.UpdateTlsClientAuthOptions) (*zonessettingsv1.TlsClientAuthResp, *core.DetailedResponse, error)
+	UpdateTlsClientAuthWithContextFunc           func(context.Context, *zonessettingsv1.UpdateTlsClientAuthOptions) (*zonessettingsv1.TlsClientAuthResp, *core.DetailedResponse, error)
+	GetBrotliFunc                                func(*zonessettingsv1.GetBrotliOptions) (*zonessettingsv1.BrotliResp, *core.DetailedResponse, error)
+	GetBrotliWithContextFunc                     func(context.Context, *zonessettingsv1.GetBrotliOptions) (*zonessettingsv1.BrotliResp, *core.DetailedResponse, error)
+	UpdateBrotliFunc                             func(*zonessettingsv1.UpdateBrotliOptions) (*zonessettingsv1.BrotliResp, *core.DetailedResponse, error)
+	UpdateBrotliWithContextFunc                  func(context.Context, *zonessettingsv1.UpdateBrotliOptions) (*zonessettingsv1.BrotliResp, *core.DetailedResponse, error)
+	GetBrowserCheckFunc                          func(*zonessettingsv1.GetBrowserCheckOptions) (*zonessettingsv1.BrowserCheckResp, *core.DetailedResponse, error)
+	GetBrowserCheckWithContextFunc               func(context.Context, *zonessettingsv1.GetBrowserCheckOptions) (*zonessettingsv1.BrowserCheckResp, *core.DetailedResponse, error)
+	UpdateBrowserCheckFunc                       func(*zonessettingsv1.UpdateBrowserCheckOptions) (*zonessettingsv1.BrowserCheckResp, *core.DetailedResponse, error)
+	UpdateBrowserCheckWithContextFunc            func(context.Context, *zonessettingsv1.UpdateBrowserCheckOptions) (*zonessettingsv1.BrowserCheckResp, *core.DetailedResponse, error)
+	GetEnableErrorPagesOnFunc                    func(*zonessettingsv1.GetEnableErrorPagesOnOptions) (*zonessettingsv1.OriginErrorPagePassThruResp, *core.DetailedResponse, error)
+	GetEnableErrorPagesOnWithContextFunc         func(context.Context, *zonessettingsv1.GetEnableErrorPagesOnOptions) (*zonessettingsv1.OriginErrorPagePassThruResp, *core.DetailedResponse, error)
+	UpdateEnableErrorPagesOnFunc                 func(*zonessettingsv1.UpdateEnableErrorPagesOnOptions) (*zonessettingsv1.OriginErrorPagePassThruResp, *core.DetailedResponse, error)
+	UpdateEnableErrorPagesOnWithContextFunc      func(context.Context, *zonessettingsv1.UpdateEnableErrorPagesOnOptions) (*zonessettingsv1.OriginErrorPagePassThruResp, *core.DetailedResponse, error)
+	GetWebApplicationFirewallFunc                func(*zonessettingsv1.GetWebApplicationFirewallOptions) (*zonessettingsv1.WafResp, *core.DetailedResponse, error)
+	GetWebApplicationFirewallWithContextFunc     func(context.Context, *zonessettingsv1.GetWebApplicationFirewallOptions) (*zonessettingsv1.WafResp, *core.DetailedResponse, error)
+	UpdateWebApplicationFirewallFunc             func(*zonessettingsv1.UpdateWebApplicationFirewallOptions) (*zonessettingsv1.WafResp, *core.DetailedResponse, error)
+	UpdateWebApplicationFirewallWithContextFunc  func(context.Context, *zonessettingsv1.UpdateWebApplicationFirewallOptions) (*zonessettingsv1.WafResp, *core.DetailedResponse, error)
+	GetCiphersFunc                               func(*zonessettingsv1.GetCiphersOptions) (*zonessettingsv1.CiphersResp, *core.DetailedResponse, error)
+	GetCiphersWithContextFunc                    func(context.Context, *zonessettingsv1.GetCiphersOptions) (*zonessettingsv1.CiphersResp, *core.DetailedResponse, error)
+	UpdateCiphersFunc                            func(*zonessettingsv1.UpdateCiphersOptions) (*zonessettingsv1.CiphersResp, *core.DetailedResponse, error)
+	UpdateCiphersWithContextFunc                 func(context.Context, *zonessettingsv1.UpdateCiphersOptions) (*zonessettingsv1.CiphersResp, *core.DetailedResponse, error)
+}
+
+// ZonesSettingsV1 implements zonessettingsv1.ZonesSettingsV1API.
+var _ zonessettingsv1.ZonesSettingsV1API = (*ZonesSettingsV1)(nil)
+
+// GetZoneDnssec invokes the programmed GetZoneDnssecFunc.
+func (mock *ZonesSettingsV1) GetZoneDnssec(getZoneDnssecOptions *zonessettingsv1.GetZoneDnssecOptions) (result *zonessettingsv1.ZonesDnssecResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneDnssec", getZoneDnssecOptions)
+	if mock.GetZoneDnssecFunc != nil {
+		return mock.GetZoneDnssecFunc(getZoneDnssecOptions)
+	}
+	if mock.GetZoneDnssecWithContextFunc != nil {
+		return mock.GetZoneDnssecWithContextFunc(context.Background(), getZoneDnssecOptions)
+	}
+	err = notProgrammed("GetZoneDnssec")
+	return
+}
+
+// GetZoneDnssecWithContext invokes the programmed GetZoneDnssecWithContextFunc.
+func (mock *ZonesSettingsV1) GetZoneDnssecWithContext(ctx context.Context, getZoneDnssecOptions *zonessettingsv1.GetZoneDnssecOptions) (result *zonessettingsv1.ZonesDnssecResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneDnssecWithContext", ctx, getZoneDnssecOptions)
+	if mock.GetZoneDnssecWithContextFunc != nil {
+		return mock.GetZoneDnssecWithContextFunc(ctx, getZoneDnssecOptions)
+	}
+	if mock.GetZoneDnssecFunc != nil {
+		return mock.GetZoneDnssecFunc(getZoneDnssecOptions)
+	}
+	err = notProgrammed("GetZoneDnssecWithContext")
+	return
+}
+
+// UpdateZoneDnssec invokes the programmed UpdateZoneDnssecFunc.
+func (mock *ZonesSettingsV1) UpdateZoneDnssec(updateZoneDnssecOptions *zonessettingsv1.UpdateZoneDnssecOptions) (result *zonessettingsv1.ZonesDnssecResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateZoneDnssec", updateZoneDnssecOptions)
+	if mock.UpdateZoneDnssecFunc != nil {
+		return mock.UpdateZoneDnssecFunc(updateZoneDnssecOptions)
+	}
+	if mock.UpdateZoneDnssecWithContextFunc != nil {
+		return mock.UpdateZoneDnssecWithContextFunc(context.Background(), updateZoneDnssecOptions)
+	}
+	err = notProgrammed("UpdateZoneDnssec")
+	return
+}
+
+// UpdateZoneDnssecWithContext invokes the programmed UpdateZoneDnssecWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateZoneDnssecWithContext(ctx context.Context, updateZoneDnssecOptions *zonessettingsv1.UpdateZoneDnssecOptions) (result *zonessettingsv1.ZonesDnssecResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateZoneDnssecWithContext", ctx, updateZoneDnssecOptions)
+	if mock.UpdateZoneDnssecWithContextFunc != nil {
+		return mock.UpdateZoneDnssecWithContextFunc(ctx, updateZoneDnssecOptions)
+	}
+	if mock.UpdateZoneDnssecFunc != nil {
+		return mock.UpdateZoneDnssecFunc(updateZoneDnssecOptions)
+	}
+	err = notProgrammed("UpdateZoneDnssecWithContext")
+	return
+}
+
+// GetZoneCnameFlattening invokes the programmed GetZoneCnameFlatteningFunc.
+func (mock *ZonesSettingsV1) GetZoneCnameFlattening(getZoneCnameFlatteningOptions *zonessettingsv1.GetZoneCnameFlatteningOptions) (result *zonessettingsv1.ZonesCnameFlatteningResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneCnameFlattening", getZoneCnameFlatteningOptions)
+	if mock.GetZoneCnameFlatteningFunc != nil {
+		return mock.GetZoneCnameFlatteningFunc(getZoneCnameFlatteningOptions)
+	}
+	if mock.GetZoneCnameFlatteningWithContextFunc != nil {
+		return mock.GetZoneCnameFlatteningWithContextFunc(context.Background(), getZoneCnameFlatteningOptions)
+	}
+	err = notProgrammed("GetZoneCnameFlattening")
+	return
+}
+
+// GetZoneCnameFlatteningWithContext invokes the programmed GetZoneCnameFlatteningWithContextFunc.
+func (mock *ZonesSettingsV1) GetZoneCnameFlatteningWithContext(ctx context.Context, getZoneCnameFlatteningOptions *zonessettingsv1.GetZoneCnameFlatteningOptions) (result *zonessettingsv1.ZonesCnameFlatteningResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneCnameFlatteningWithContext", ctx, getZoneCnameFlatteningOptions)
+	if mock.GetZoneCnameFlatteningWithContextFunc != nil {
+		return mock.GetZoneCnameFlatteningWithContextFunc(ctx, getZoneCnameFlatteningOptions)
+	}
+	if mock.GetZoneCnameFlatteningFunc != nil {
+		return mock.GetZoneCnameFlatteningFunc(getZoneCnameFlatteningOptions)
+	}
+	err = notProgrammed("GetZoneCnameFlatteningWithContext")
+	return
+}
+
+// UpdateZoneCnameFlattening invokes the programmed UpdateZoneCnameFlatteningFunc.
+func (mock *ZonesSettingsV1) UpdateZoneCnameFlattening(updateZoneCnameFlatteningOptions *zonessettingsv1.UpdateZoneCnameFlatteningOptions) (result *zonessettingsv1.ZonesCnameFlatteningResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateZoneCnameFlattening", updateZoneCnameFlatteningOptions)
+	if mock.UpdateZoneCnameFlatteningFunc != nil {
+		return mock.UpdateZoneCnameFlatteningFunc(updateZoneCnameFlatteningOptions)
+	}
+	if mock.UpdateZoneCnameFlatteningWithContextFunc != nil {
+		return mock.UpdateZoneCnameFlatteningWithContextFunc(context.Background(), updateZoneCnameFlatteningOptions)
+	}
+	err = notProgrammed("UpdateZoneCnameFlattening")
+	return
+}
+
+// UpdateZoneCnameFlatteningWithContext invokes the programmed UpdateZoneCnameFlatteningWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateZoneCnameFlatteningWithContext(ctx context.Context, updateZoneCnameFlatteningOptions *zonessettingsv1.UpdateZoneCnameFlatteningOptions) (result *zonessettingsv1.ZonesCnameFlatteningResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateZoneCnameFlatteningWithContext", ctx, updateZoneCnameFlatteningOptions)
+	if mock.UpdateZoneCnameFlatteningWithContextFunc != nil {
+		return mock.UpdateZoneCnameFlatteningWithContextFunc(ctx, updateZoneCnameFlatteningOptions)
+	}
+	if mock.UpdateZoneCnameFlatteningFunc != nil {
+		return mock.UpdateZoneCnameFlatteningFunc(updateZoneCnameFlatteningOptions)
+	}
+	err = notProgrammed("UpdateZoneCnameFlatteningWithContext")
+	return
+}
+
+// GetOpportunisticEncryption invokes the programmed GetOpportunisticEncryptionFunc.
+func (mock *ZonesSettingsV1) GetOpportunisticEncryption(getOpportunisticEncryptionOptions *zonessettingsv1.GetOpportunisticEncryptionOptions) (result *zonessettingsv1.OpportunisticEncryptionResp, response *core.DetailedResponse, err error) {
+	mock.called("GetOpportunisticEncryption", getOpportunisticEncryptionOptions)
+	if mock.GetOpportunisticEncryptionFunc != nil {
+		return mock.GetOpportunisticEncryptionFunc(getOpportunisticEncryptionOptions)
+	}
+	if mock.GetOpportunisticEncryptionWithContextFunc != nil {
+		return mock.GetOpportunisticEncryptionWithContextFunc(context.Background(), getOpportunisticEncryptionOptions)
+	}
+	err = notProgrammed("GetOpportunisticEncryption")
+	return
+}
+
+// GetOpportunisticEncryptionWithContext invokes the programmed GetOpportunisticEncryptionWithContextFunc.
+func (mock *ZonesSettingsV1) GetOpportunisticEncryptionWithContext(ctx context.Context, getOpportunisticEncryptionOptions *zonessettingsv1.GetOpportunisticEncryptionOptions) (result *zonessettingsv1.OpportunisticEncryptionResp, response *core.DetailedResponse, err error) {
+	mock.called("GetOpportunisticEncryptionWithContext", ctx, getOpportunisticEncryptionOptions)
+	if mock.GetOpportunisticEncryptionWithContextFunc != nil {
+		return mock.GetOpportunisticEncryptionWithContextFunc(ctx, getOpportunisticEncryptionOptions)
+	}
+	if mock.GetOpportunisticEncryptionFunc != nil {
+		return mock.GetOpportunisticEncryptionFunc(getOpportunisticEncryptionOptions)
+	}
+	err = notProgrammed("GetOpportunisticEncryptionWithContext")
+	return
+}
+
+// UpdateOpportunisticEncryption invokes the programmed UpdateOpportunisticEncryptionFunc.
+func (mock *ZonesSettingsV1) UpdateOpportunisticEncryption(updateOpportunisticEncryptionOptions *zonessettingsv1.UpdateOpportunisticEncryptionOptions) (result *zonessettingsv1.OpportunisticEncryptionResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateOpportunisticEncryption", updateOpportunisticEncryptionOptions)
+	if mock.UpdateOpportunisticEncryptionFunc != nil {
+		return mock.UpdateOpportunisticEncryptionFunc(updateOpportunisticEncryptionOptions)
+	}
+	if mock.UpdateOpportunisticEncryptionWithContextFunc != nil {
+		return mock.UpdateOpportunisticEncryptionWithContextFunc(context.Background(), updateOpportunisticEncryptionOptions)
+	}
+	err = notProgrammed("UpdateOpportunisticEncryption")
+	return
+}
+
+// UpdateOpportunisticEncryptionWithContext invokes the programmed UpdateOpportunisticEncryptionWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateOpportunisticEncryptionWithContext(ctx context.Context, updateOpportunisticEncryptionOptions *zonessettingsv1.UpdateOpportunisticEncryptionOptions) (result *zonessettingsv1.OpportunisticEncryptionResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateOpportunisticEncryptionWithContext", ctx, updateOpportunisticEncryptionOptions)
+	if mock.UpdateOpportunisticEncryptionWithContextFunc != nil {
+		return mock.UpdateOpportunisticEncryptionWithContextFunc(ctx, updateOpportunisticEncryptionOptions)
+	}
+	if mock.UpdateOpportunisticEncryptionFunc != nil {
+		return mock.UpdateOpportunisticEncryptionFunc(updateOpportunisticEncryptionOptions)
+	}
+	err = notProgrammed("UpdateOpportunisticEncryptionWithContext")
+	return
+}
+
+// GetChallengeTTL invokes the programmed GetChallengeTTLFunc.
+func (mock *ZonesSettingsV1) GetChallengeTTL(getChallengeTtlOptions *zonessettingsv1.GetChallengeTtlOptions) (result *zonessettingsv1.ChallengeTtlResp, response *core.DetailedResponse, err error) {
+	mock.called("GetChallengeTTL", getChallengeTtlOptions)
+	if mock.GetChallengeTTLFunc != nil {
+		return mock.GetChallengeTTLFunc(getChallengeTtlOptions)
+	}
+	if mock.GetChallengeTTLWithContextFunc != nil {
+		return mock.GetChallengeTTLWithContextFunc(context.Background(), getChallengeTtlOptions)
+	}
+	err = notProgrammed("GetChallengeTTL")
+	return
+}
+
+// GetChallengeTTLWithContext invokes the programmed GetChallengeTTLWithContextFunc.
+func (mock *ZonesSettingsV1) GetChallengeTTLWithContext(ctx context.Context, getChallengeTtlOptions *zonessettingsv1.GetChallengeTtlOptions) (result *zonessettingsv1.ChallengeTtlResp, response *core.DetailedResponse, err error) {
+	mock.called("GetChallengeTTLWithContext", ctx, getChallengeTtlOptions)
+	if mock.GetChallengeTTLWithContextFunc != nil {
+		return mock.GetChallengeTTLWithContextFunc(ctx, getChallengeTtlOptions)
+	}
+	if mock.GetChallengeTTLFunc != nil {
+		return mock.GetChallengeTTLFunc(getChallengeTtlOptions)
+	}
+	err = notProgrammed("GetChallengeTTLWithContext")
+	return
+}
+
+// UpdateChallengeTTL invokes the programmed UpdateChallengeTTLFunc.
+func (mock *ZonesSettingsV1) UpdateChallengeTTL(updateChallengeTtlOptions *zonessettingsv1.UpdateChallengeTtlOptions) (result *zonessettingsv1.ChallengeTtlResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateChallengeTTL", updateChallengeTtlOptions)
+	if mock.UpdateChallengeTTLFunc != nil {
+		return mock.UpdateChallengeTTLFunc(updateChallengeTtlOptions)
+	}
+	if mock.UpdateChallengeTTLWithContextFunc != nil {
+		return mock.UpdateChallengeTTLWithContextFunc(context.Background(), updateChallengeTtlOptions)
+	}
+	err = notProgrammed("UpdateChallengeTTL")
+	return
+}
+
+// UpdateChallengeTTLWithContext invokes the programmed UpdateChallengeTTLWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateChallengeTTLWithContext(ctx context.Context, updateChallengeTtlOptions *zonessettingsv1.UpdateChallengeTtlOptions) (result *zonessettingsv1.ChallengeTtlResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateChallengeTTLWithContext", ctx, updateChallengeTtlOptions)
+	if mock.UpdateChallengeTTLWithContextFunc != nil {
+		return mock.UpdateChallengeTTLWithContextFunc(ctx, updateChallengeTtlOptions)
+	}
+	if mock.UpdateChallengeTTLFunc != nil {
+		return mock.UpdateChallengeTTLFunc(updateChallengeTtlOptions)
+	}
+	err = notProgrammed("UpdateChallengeTTLWithContext")
+	return
+}
+
+// GetAutomaticHttpsRewrites invokes the programmed GetAutomaticHttpsRewritesFunc.
+func (mock *ZonesSettingsV1) GetAutomaticHttpsRewrites(getAutomaticHttpsRewritesOptions *zonessettingsv1.GetAutomaticHttpsRewritesOptions) (result *zonessettingsv1.AutomaticHttpsRewritesResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAutomaticHttpsRewrites", getAutomaticHttpsRewritesOptions)
+	if mock.GetAutomaticHttpsRewritesFunc != nil {
+		return mock.GetAutomaticHttpsRewritesFunc(getAutomaticHttpsRewritesOptions)
+	}
+	if mock.GetAutomaticHttpsRewritesWithContextFunc != nil {
+		return mock.GetAutomaticHttpsRewritesWithContextFunc(context.Background(), getAutomaticHttpsRewritesOptions)
+	}
+	err = notProgrammed("GetAutomaticHttpsRewrites")
+	return
+}
+
+// GetAutomaticHttpsRewritesWithContext invokes the programmed GetAutomaticHttpsRewritesWithContextFunc.
+func (mock *ZonesSettingsV1) GetAutomaticHttpsRewritesWithContext(ctx context.Context, getAutomaticHttpsRewritesOptions *zonessettingsv1.GetAutomaticHttpsRewritesOptions) (result *zonessettingsv1.AutomaticHttpsRewritesResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAutomaticHttpsRewritesWithContext", ctx, getAutomaticHttpsRewritesOptions)
+	if mock.GetAutomaticHttpsRewritesWithContextFunc != nil {
+		return mock.GetAutomaticHttpsRewritesWithContextFunc(ctx, getAutomaticHttpsRewritesOptions)
+	}
+	if mock.GetAutomaticHttpsRewritesFunc != nil {
+		return mock.GetAutomaticHttpsRewritesFunc(getAutomaticHttpsRewritesOptions)
+	}
+	err = notProgrammed("GetAutomaticHttpsRewritesWithContext")
+	return
+}
+
+// UpdateAutomaticHttpsRewrites invokes the programmed UpdateAutomaticHttpsRewritesFunc.
+func (mock *ZonesSettingsV1) UpdateAutomaticHttpsRewrites(updateAutomaticHttpsRewritesOptions *zonessettingsv1.UpdateAutomaticHttpsRewritesOptions) (result *zonessettingsv1.AutomaticHttpsRewritesResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAutomaticHttpsRewrites", updateAutomaticHttpsRewritesOptions)
+	if mock.UpdateAutomaticHttpsRewritesFunc != nil {
+		return mock.UpdateAutomaticHttpsRewritesFunc(updateAutomaticHttpsRewritesOptions)
+	}
+	if mock.UpdateAutomaticHttpsRewritesWithContextFunc != nil {
+		return mock.UpdateAutomaticHttpsRewritesWithContextFunc(context.Background(), updateAutomaticHttpsRewritesOptions)
+	}
+	err = notProgrammed("UpdateAutomaticHttpsRewrites")
+	return
+}
+
+// UpdateAutomaticHttpsRewritesWithContext invokes the programmed UpdateAutomaticHttpsRewritesWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateAutomaticHttpsRewritesWithContext(ctx context.Context, updateAutomaticHttpsRewritesOptions *zonessettingsv1.UpdateAutomaticHttpsRewritesOptions) (result *zonessettingsv1.AutomaticHttpsRewritesResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAutomaticHttpsRewritesWithContext", ctx, updateAutomaticHttpsRewritesOptions)
+	if mock.UpdateAutomaticHttpsRewritesWithContextFunc != nil {
+		return mock.UpdateAutomaticHttpsRewritesWithContextFunc(ctx, updateAutomaticHttpsRewritesOptions)
+	}
+	if mock.UpdateAutomaticHttpsRewritesFunc != nil {
+		return mock.UpdateAutomaticHttpsRewritesFunc(updateAutomaticHttpsRewritesOptions)
+	}
+	err = notProgrammed("UpdateAutomaticHttpsRewritesWithContext")
+	return
+}
+
+// GetTrueClientIp invokes the programmed GetTrueClientIpFunc.
+func (mock *ZonesSettingsV1) GetTrueClientIp(getTrueClientIpOptions *zonessettingsv1.GetTrueClientIpOptions) (result *zonessettingsv1.TrueClientIpResp, response *core.DetailedResponse, err error) {
+	mock.called("GetTrueClientIp", getTrueClientIpOptions)
+	if mock.GetTrueClientIpFunc != nil {
+		return mock.GetTrueClientIpFunc(getTrueClientIpOptions)
+	}
+	if mock.GetTrueClientIpWithContextFunc != nil {
+		return mock.GetTrueClientIpWithContextFunc(context.Background(), getTrueClientIpOptions)
+	}
+	err = notProgrammed("GetTrueClientIp")
+	return
+}
+
+// GetTrueClientIpWithContext invokes the programmed GetTrueClientIpWithContextFunc.
+func (mock *ZonesSettingsV1) GetTrueClientIpWithContext(ctx context.Context, getTrueClientIpOptions *zonessettingsv1.GetTrueClientIpOptions) (result *zonessettingsv1.TrueClientIpResp, response *core.DetailedResponse, err error) {
+	mock.called("GetTrueClientIpWithContext", ctx, getTrueClientIpOptions)
+	if mock.GetTrueClientIpWithContextFunc != nil {
+		return mock.GetTrueClientIpWithContextFunc(ctx, getTrueClientIpOptions)
+	}
+	if mock.GetTrueClientIpFunc != nil {
+		return mock.GetTrueClientIpFunc(getTrueClientIpOptions)
+	}
+	err = notProgrammed("GetTrueClientIpWithContext")
+	return
+}
+
+// UpdateTrueClientIp invokes the programmed UpdateTrueClientIpFunc.
+func (mock *ZonesSettingsV1) UpdateTrueClientIp(updateTrueClientIpOptions *zonessettingsv1.UpdateTrueClientIpOptions) (result *zonessettingsv1.TrueClientIpResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateTrueClientIp", updateTrueClientIpOptions)
+	if mock.UpdateTrueClientIpFunc != nil {
+		return mock.UpdateTrueClientIpFunc(updateTrueClientIpOptions)
+	}
+	if mock.UpdateTrueClientIpWithContextFunc != nil {
+		return mock.UpdateTrueClientIpWithContextFunc(context.Background(), updateTrueClientIpOptions)
+	}
+	err = notProgrammed("UpdateTrueClientIp")
+	return
+}
+
+// UpdateTrueClientIpWithContext invokes the programmed UpdateTrueClientIpWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateTrueClientIpWithContext(ctx context.Context, updateTrueClientIpOptions *zonessettingsv1.UpdateTrueClientIpOptions) (result *zonessettingsv1.TrueClientIpResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateTrueClientIpWithContext", ctx, updateTrueClientIpOptions)
+	if mock.UpdateTrueClientIpWithContextFunc != nil {
+		return mock.UpdateTrueClientIpWithContextFunc(ctx, updateTrueClientIpOptions)
+	}
+	if mock.UpdateTrueClientIpFunc != nil {
+		return mock.UpdateTrueClientIpFunc(updateTrueClientIpOptions)
+	}
+	err = notProgrammed("UpdateTrueClientIpWithContext")
+	return
+}
+
+// GetAlwaysUseHttps invokes the programmed GetAlwaysUseHttpsFunc.
+func (mock *ZonesSettingsV1) GetAlwaysUseHttps(getAlwaysUseHttpsOptions *zonessettingsv1.GetAlwaysUseHttpsOptions) (result *zonessettingsv1.AlwaysUseHttpsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAlwaysUseHttps", getAlwaysUseHttpsOptions)
+	if mock.GetAlwaysUseHttpsFunc != nil {
+		return mock.GetAlwaysUseHttpsFunc(getAlwaysUseHttpsOptions)
+	}
+	if mock.GetAlwaysUseHttpsWithContextFunc != nil {
+		return mock.GetAlwaysUseHttpsWithContextFunc(context.Background(), getAlwaysUseHttpsOptions)
+	}
+	err = notProgrammed("GetAlwaysUseHttps")
+	return
+}
+
+// GetAlwaysUseHttpsWithContext invokes the programmed GetAlwaysUseHttpsWithContextFunc.
+func (mock *ZonesSettingsV1) GetAlwaysUseHttpsWithContext(ctx context.Context, getAlwaysUseHttpsOptions *zonessettingsv1.GetAlwaysUseHttpsOptions) (result *zonessettingsv1.AlwaysUseHttpsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetAlwaysUseHttpsWithContext", ctx, getAlwaysUseHttpsOptions)
+	if mock.GetAlwaysUseHttpsWithContextFunc != nil {
+		return mock.GetAlwaysUseHttpsWithContextFunc(ctx, getAlwaysUseHttpsOptions)
+	}
+	if mock.GetAlwaysUseHttpsFunc != nil {
+		return mock.GetAlwaysUseHttpsFunc(getAlwaysUseHttpsOptions)
+	}
+	err = notProgrammed("GetAlwaysUseHttpsWithContext")
+	return
+}
+
+// UpdateAlwaysUseHttps invokes the programmed UpdateAlwaysUseHttpsFunc.
+func (mock *ZonesSettingsV1) UpdateAlwaysUseHttps(updateAlwaysUseHttpsOptions *zonessettingsv1.UpdateAlwaysUseHttpsOptions) (result *zonessettingsv1.AlwaysUseHttpsResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAlwaysUseHttps", updateAlwaysUseHttpsOptions)
+	if mock.UpdateAlwaysUseHttpsFunc != nil {
+		return mock.UpdateAlwaysUseHttpsFunc(updateAlwaysUseHttpsOptions)
+	}
+	if mock.UpdateAlwaysUseHttpsWithContextFunc != nil {
+		return mock.UpdateAlwaysUseHttpsWithContextFunc(context.Background(), updateAlwaysUseHttpsOptions)
+	}
+	err = notProgrammed("UpdateAlwaysUseHttps")
+	return
+}
+
+// UpdateAlwaysUseHttpsWithContext invokes the programmed UpdateAlwaysUseHttpsWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateAlwaysUseHttpsWithContext(ctx context.Context, updateAlwaysUseHttpsOptions *zonessettingsv1.UpdateAlwaysUseHttpsOptions) (result *zonessettingsv1.AlwaysUseHttpsResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateAlwaysUseHttpsWithContext", ctx, updateAlwaysUseHttpsOptions)
+	if mock.UpdateAlwaysUseHttpsWithContextFunc != nil {
+		return mock.UpdateAlwaysUseHttpsWithContextFunc(ctx, updateAlwaysUseHttpsOptions)
+	}
+	if mock.UpdateAlwaysUseHttpsFunc != nil {
+		return mock.UpdateAlwaysUseHttpsFunc(updateAlwaysUseHttpsOptions)
+	}
+	err = notProgrammed("UpdateAlwaysUseHttpsWithContext")
+	return
+}
+
+// GetImageSizeOptimization invokes the programmed GetImageSizeOptimizationFunc.
+func (mock *ZonesSettingsV1) GetImageSizeOptimization(getImageSizeOptimizationOptions *zonessettingsv1.GetImageSizeOptimizationOptions) (result *zonessettingsv1.ImageSizeOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("GetImageSizeOptimization", getImageSizeOptimizationOptions)
+	if mock.GetImageSizeOptimizationFunc != nil {
+		return mock.GetImageSizeOptimizationFunc(getImageSizeOptimizationOptions)
+	}
+	if mock.GetImageSizeOptimizationWithContextFunc != nil {
+		return mock.GetImageSizeOptimizationWithContextFunc(context.Background(), getImageSizeOptimizationOptions)
+	}
+	err = notProgrammed("GetImageSizeOptimization")
+	return
+}
+
+// GetImageSizeOptimizationWithContext invokes the programmed GetImageSizeOptimizationWithContextFunc.
+func (mock *ZonesSettingsV1) GetImageSizeOptimizationWithContext(ctx context.Context, getImageSizeOptimizationOptions *zonessettingsv1.GetImageSizeOptimizationOptions) (result *zonessettingsv1.ImageSizeOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("GetImageSizeOptimizationWithContext", ctx, getImageSizeOptimizationOptions)
+	if mock.GetImageSizeOptimizationWithContextFunc != nil {
+		return mock.GetImageSizeOptimizationWithContextFunc(ctx, getImageSizeOptimizationOptions)
+	}
+	if mock.GetImageSizeOptimizationFunc != nil {
+		return mock.GetImageSizeOptimizationFunc(getImageSizeOptimizationOptions)
+	}
+	err = notProgrammed("GetImageSizeOptimizationWithContext")
+	return
+}
+
+// UpdateImageSizeOptimization invokes the programmed UpdateImageSizeOptimizationFunc.
+func (mock *ZonesSettingsV1) UpdateImageSizeOptimization(updateImageSizeOptimizationOptions *zonessettingsv1.UpdateImageSizeOptimizationOptions) (result *zonessettingsv1.ImageSizeOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateImageSizeOptimization", updateImageSizeOptimizationOptions)
+	if mock.UpdateImageSizeOptimizationFunc != nil {
+		return mock.UpdateImageSizeOptimizationFunc(updateImageSizeOptimizationOptions)
+	}
+	if mock.UpdateImageSizeOptimizationWithContextFunc != nil {
+		return mock.UpdateImageSizeOptimizationWithContextFunc(context.Background(), updateImageSizeOptimizationOptions)
+	}
+	err = notProgrammed("UpdateImageSizeOptimization")
+	return
+}
+
+// UpdateImageSizeOptimizationWithContext invokes the programmed UpdateImageSizeOptimizationWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateImageSizeOptimizationWithContext(ctx context.Context, updateImageSizeOptimizationOptions *zonessettingsv1.UpdateImageSizeOptimizationOptions) (result *zonessettingsv1.ImageSizeOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateImageSizeOptimizationWithContext", ctx, updateImageSizeOptimizationOptions)
+	if mock.UpdateImageSizeOptimizationWithContextFunc != nil {
+		return mock.UpdateImageSizeOptimizationWithContextFunc(ctx, updateImageSizeOptimizationOptions)
+	}
+	if mock.UpdateImageSizeOptimizationFunc != nil {
+		return mock.UpdateImageSizeOptimizationFunc(updateImageSizeOptimizationOptions)
+	}
+	err = notProgrammed("UpdateImageSizeOptimizationWithContext")
+	return
+}
+
+// GetScriptLoadOptimization invokes the programmed GetScriptLoadOptimizationFunc.
+func (mock *ZonesSettingsV1) GetScriptLoadOptimization(getScriptLoadOptimizationOptions *zonessettingsv1.GetScriptLoadOptimizationOptions) (result *zonessettingsv1.ScriptLoadOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("GetScriptLoadOptimization", getScriptLoadOptimizationOptions)
+	if mock.GetScriptLoadOptimizationFunc != nil {
+		return mock.GetScriptLoadOptimizationFunc(getScriptLoadOptimizationOptions)
+	}
+	if mock.GetScriptLoadOptimizationWithContextFunc != nil {
+		return mock.GetScriptLoadOptimizationWithContextFunc(context.Background(), getScriptLoadOptimizationOptions)
+	}
+	err = notProgrammed("GetScriptLoadOptimization")
+	return
+}
+
+// GetScriptLoadOptimizationWithContext invokes the programmed GetScriptLoadOptimizationWithContextFunc.
+func (mock *ZonesSettingsV1) GetScriptLoadOptimizationWithContext(ctx context.Context, getScriptLoadOptimizationOptions *zonessettingsv1.GetScriptLoadOptimizationOptions) (result *zonessettingsv1.ScriptLoadOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("GetScriptLoadOptimizationWithContext", ctx, getScriptLoadOptimizationOptions)
+	if mock.GetScriptLoadOptimizationWithContextFunc != nil {
+		return mock.GetScriptLoadOptimizationWithContextFunc(ctx, getScriptLoadOptimizationOptions)
+	}
+	if mock.GetScriptLoadOptimizationFunc != nil {
+		return mock.GetScriptLoadOptimizationFunc(getScriptLoadOptimizationOptions)
+	}
+	err = notProgrammed("GetScriptLoadOptimizationWithContext")
+	return
+}
+
+// UpdateScriptLoadOptimization invokes the programmed UpdateScriptLoadOptimizationFunc.
+func (mock *ZonesSettingsV1) UpdateScriptLoadOptimization(updateScriptLoadOptimizationOptions *zonessettingsv1.UpdateScriptLoadOptimizationOptions) (result *zonessettingsv1.ScriptLoadOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateScriptLoadOptimization", updateScriptLoadOptimizationOptions)
+	if mock.UpdateScriptLoadOptimizationFunc != nil {
+		return mock.UpdateScriptLoadOptimizationFunc(updateScriptLoadOptimizationOptions)
+	}
+	if mock.UpdateScriptLoadOptimizationWithContextFunc != nil {
+		return mock.UpdateScriptLoadOptimizationWithContextFunc(context.Background(), updateScriptLoadOptimizationOptions)
+	}
+	err = notProgrammed("UpdateScriptLoadOptimization")
+	return
+}
+
+// UpdateScriptLoadOptimizationWithContext invokes the programmed UpdateScriptLoadOptimizationWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateScriptLoadOptimizationWithContext(ctx context.Context, updateScriptLoadOptimizationOptions *zonessettingsv1.UpdateScriptLoadOptimizationOptions) (result *zonessettingsv1.ScriptLoadOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateScriptLoadOptimizationWithContext", ctx, updateScriptLoadOptimizationOptions)
+	if mock.UpdateScriptLoadOptimizationWithContextFunc != nil {
+		return mock.UpdateScriptLoadOptimizationWithContextFunc(ctx, updateScriptLoadOptimizationOptions)
+	}
+	if mock.UpdateScriptLoadOptimizationFunc != nil {
+		return mock.UpdateScriptLoadOptimizationFunc(updateScriptLoadOptimizationOptions)
+	}
+	err = notProgrammed("UpdateScriptLoadOptimizationWithContext")
+	return
+}
+
+// GetImageLoadOptimization invokes the programmed GetImageLoadOptimizationFunc.
+func (mock *ZonesSettingsV1) GetImageLoadOptimization(getImageLoadOptimizationOptions *zonessettingsv1.GetImageLoadOptimizationOptions) (result *zonessettingsv1.ImageLoadOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("GetImageLoadOptimization", getImageLoadOptimizationOptions)
+	if mock.GetImageLoadOptimizationFunc != nil {
+		return mock.GetImageLoadOptimizationFunc(getImageLoadOptimizationOptions)
+	}
+	if mock.GetImageLoadOptimizationWithContextFunc != nil {
+		return mock.GetImageLoadOptimizationWithContextFunc(context.Background(), getImageLoadOptimizationOptions)
+	}
+	err = notProgrammed("GetImageLoadOptimization")
+	return
+}
+
+// GetImageLoadOptimizationWithContext invokes the programmed GetImageLoadOptimizationWithContextFunc.
+func (mock *ZonesSettingsV1) GetImageLoadOptimizationWithContext(ctx context.Context, getImageLoadOptimizationOptions *zonessettingsv1.GetImageLoadOptimizationOptions) (result *zonessettingsv1.ImageLoadOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("GetImageLoadOptimizationWithContext", ctx, getImageLoadOptimizationOptions)
+	if mock.GetImageLoadOptimizationWithContextFunc != nil {
+		return mock.GetImageLoadOptimizationWithContextFunc(ctx, getImageLoadOptimizationOptions)
+	}
+	if mock.GetImageLoadOptimizationFunc != nil {
+		return mock.GetImageLoadOptimizationFunc(getImageLoadOptimizationOptions)
+	}
+	err = notProgrammed("GetImageLoadOptimizationWithContext")
+	return
+}
+
+// UpdateImageLoadOptimization invokes the programmed UpdateImageLoadOptimizationFunc.
+func (mock *ZonesSettingsV1) UpdateImageLoadOptimization(updateImageLoadOptimizationOptions *zonessettingsv1.UpdateImageLoadOptimizationOptions) (result *zonessettingsv1.ImageLoadOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateImageLoadOptimization", updateImageLoadOptimizationOptions)
+	if mock.UpdateImageLoadOptimizationFunc != nil {
+		return mock.UpdateImageLoadOptimizationFunc(updateImageLoadOptimizationOptions)
+	}
+	if mock.UpdateImageLoadOptimizationWithContextFunc != nil {
+		return mock.UpdateImageLoadOptimizationWithContextFunc(context.Background(), updateImageLoadOptimizationOptions)
+	}
+	err = notProgrammed("UpdateImageLoadOptimization")
+	return
+}
+
+// UpdateImageLoadOptimizationWithContext invokes the programmed UpdateImageLoadOptimizationWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateImageLoadOptimizationWithContext(ctx context.Context, updateImageLoadOptimizationOptions *zonessettingsv1.UpdateImageLoadOptimizationOptions) (result *zonessettingsv1.ImageLoadOptimizationResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateImageLoadOptimizationWithContext", ctx, updateImageLoadOptimizationOptions)
+	if mock.UpdateImageLoadOptimizationWithContextFunc != nil {
+		return mock.UpdateImageLoadOptimizationWithContextFunc(ctx, updateImageLoadOptimizationOptions)
+	}
+	if mock.UpdateImageLoadOptimizationFunc != nil {
+		return mock.UpdateImageLoadOptimizationFunc(updateImageLoadOptimizationOptions)
+	}
+	err = notProgrammed("UpdateImageLoadOptimizationWithContext")
+	return
+}
+
+// GetMinify invokes the programmed GetMinifyFunc.
+func (mock *ZonesSettingsV1) GetMinify(getMinifyOptions *zonessettingsv1.GetMinifyOptions) (result *zonessettingsv1.MinifyResp, response *core.DetailedResponse, err error) {
+	mock.called("GetMinify", getMinifyOptions)
+	if mock.GetMinifyFunc != nil {
+		return mock.GetMinifyFunc(getMinifyOptions)
+	}
+	if mock.GetMinifyWithContextFunc != nil {
+		return mock.GetMinifyWithContextFunc(context.Background(), getMinifyOptions)
+	}
+	err = notProgrammed("GetMinify")
+	return
+}
+
+// GetMinifyWithContext invokes the programmed GetMinifyWithContextFunc.
+func (mock *ZonesSettingsV1) GetMinifyWithContext(ctx context.Context, getMinifyOptions *zonessettingsv1.GetMinifyOptions) (result *zonessettingsv1.MinifyResp, response *core.DetailedResponse, err error) {
+	mock.called("GetMinifyWithContext", ctx, getMinifyOptions)
+	if mock.GetMinifyWithContextFunc != nil {
+		return mock.GetMinifyWithContextFunc(ctx, getMinifyOptions)
+	}
+	if mock.GetMinifyFunc != nil {
+		return mock.GetMinifyFunc(getMinifyOptions)
+	}
+	err = notProgrammed("GetMinifyWithContext")
+	return
+}
+
+// UpdateMinify invokes the programmed UpdateMinifyFunc.
+func (mock *ZonesSettingsV1) UpdateMinify(updateMinifyOptions *zonessettingsv1.UpdateMinifyOptions) (result *zonessettingsv1.MinifyResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMinify", updateMinifyOptions)
+	if mock.UpdateMinifyFunc != nil {
+		return mock.UpdateMinifyFunc(updateMinifyOptions)
+	}
+	if mock.UpdateMinifyWithContextFunc != nil {
+		return mock.UpdateMinifyWithContextFunc(context.Background(), updateMinifyOptions)
+	}
+	err = notProgrammed("UpdateMinify")
+	return
+}
+
+// UpdateMinifyWithContext invokes the programmed UpdateMinifyWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateMinifyWithContext(ctx context.Context, updateMinifyOptions *zonessettingsv1.UpdateMinifyOptions) (result *zonessettingsv1.MinifyResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMinifyWithContext", ctx, updateMinifyOptions)
+	if mock.UpdateMinifyWithContextFunc != nil {
+		return mock.UpdateMinifyWithContextFunc(ctx, updateMinifyOptions)
+	}
+	if mock.UpdateMinifyFunc != nil {
+		return mock.UpdateMinifyFunc(updateMinifyOptions)
+	}
+	err = notProgrammed("UpdateMinifyWithContext")
+	return
+}
+
+// GetMinTlsVersion invokes the programmed GetMinTlsVersionFunc.
+func (mock *ZonesSettingsV1) GetMinTlsVersion(getMinTlsVersionOptions *zonessettingsv1.GetMinTlsVersionOptions) (result *zonessettingsv1.MinTlsVersionResp, response *core.DetailedResponse, err error) {
+	mock.called("GetMinTlsVersion", getMinTlsVersionOptions)
+	if mock.GetMinTlsVersionFunc != nil {
+		return mock.GetMinTlsVersionFunc(getMinTlsVersionOptions)
+	}
+	if mock.GetMinTlsVersionWithContextFunc != nil {
+		return mock.GetMinTlsVersionWithContextFunc(context.Background(), getMinTlsVersionOptions)
+	}
+	err = notProgrammed("GetMinTlsVersion")
+	return
+}
+
+// GetMinTlsVersionWithContext invokes the programmed GetMinTlsVersionWithContextFunc.
+func (mock *ZonesSettingsV1) GetMinTlsVersionWithContext(ctx context.Context, getMinTlsVersionOptions *zonessettingsv1.GetMinTlsVersionOptions) (result *zonessettingsv1.MinTlsVersionResp, response *core.DetailedResponse, err error) {
+	mock.called("GetMinTlsVersionWithContext", ctx, getMinTlsVersionOptions)
+	if mock.GetMinTlsVersionWithContextFunc != nil {
+		return mock.GetMinTlsVersionWithContextFunc(ctx, getMinTlsVersionOptions)
+	}
+	if mock.GetMinTlsVersionFunc != nil {
+		return mock.GetMinTlsVersionFunc(getMinTlsVersionOptions)
+	}
+	err = notProgrammed("GetMinTlsVersionWithContext")
+	return
+}
+
+// UpdateMinTlsVersion invokes the programmed UpdateMinTlsVersionFunc.
+func (mock *ZonesSettingsV1) UpdateMinTlsVersion(updateMinTlsVersionOptions *zonessettingsv1.UpdateMinTlsVersionOptions) (result *zonessettingsv1.MinTlsVersionResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMinTlsVersion", updateMinTlsVersionOptions)
+	if mock.UpdateMinTlsVersionFunc != nil {
+		return mock.UpdateMinTlsVersionFunc(updateMinTlsVersionOptions)
+	}
+	if mock.UpdateMinTlsVersionWithContextFunc != nil {
+		return mock.UpdateMinTlsVersionWithContextFunc(context.Background(), updateMinTlsVersionOptions)
+	}
+	err = notProgrammed("UpdateMinTlsVersion")
+	return
+}
+
+// UpdateMinTlsVersionWithContext invokes the programmed UpdateMinTlsVersionWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateMinTlsVersionWithContext(ctx context.Context, updateMinTlsVersionOptions *zonessettingsv1.UpdateMinTlsVersionOptions) (result *zonessettingsv1.MinTlsVersionResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMinTlsVersionWithContext", ctx, updateMinTlsVersionOptions)
+	if mock.UpdateMinTlsVersionWithContextFunc != nil {
+		return mock.UpdateMinTlsVersionWithContextFunc(ctx, updateMinTlsVersionOptions)
+	}
+	if mock.UpdateMinTlsVersionFunc != nil {
+		return mock.UpdateMinTlsVersionFunc(updateMinTlsVersionOptions)
+	}
+	err = notProgrammed("UpdateMinTlsVersionWithContext")
+	return
+}
+
+// GetIpGeolocation invokes the programmed GetIpGeolocationFunc.
+func (mock *ZonesSettingsV1) GetIpGeolocation(getIpGeolocationOptions *zonessettingsv1.GetIpGeolocationOptions) (result *zonessettingsv1.IpGeolocationResp, response *core.DetailedResponse, err error) {
+	mock.called("GetIpGeolocation", getIpGeolocationOptions)
+	if mock.GetIpGeolocationFunc != nil {
+		return mock.GetIpGeolocationFunc(getIpGeolocationOptions)
+	}
+	if mock.GetIpGeolocationWithContextFunc != nil {
+		return mock.GetIpGeolocationWithContextFunc(context.Background(), getIpGeolocationOptions)
+	}
+	err = notProgrammed("GetIpGeolocation")
+	return
+}
+
+// GetIpGeolocationWithContext invokes the programmed GetIpGeolocationWithContextFunc.
+func (mock *ZonesSettingsV1) GetIpGeolocationWithContext(ctx context.Context, getIpGeolocationOptions *zonessettingsv1.GetIpGeolocationOptions) (result *zonessettingsv1.IpGeolocationResp, response *core.DetailedResponse, err error) {
+	mock.called("GetIpGeolocationWithContext", ctx, getIpGeolocationOptions)
+	if mock.GetIpGeolocationWithContextFunc != nil {
+		return mock.GetIpGeolocationWithContextFunc(ctx, getIpGeolocationOptions)
+	}
+	if mock.GetIpGeolocationFunc != nil {
+		return mock.GetIpGeolocationFunc(getIpGeolocationOptions)
+	}
+	err = notProgrammed("GetIpGeolocationWithContext")
+	return
+}
+
+// UpdateIpGeolocation invokes the programmed UpdateIpGeolocationFunc.
+func (mock *ZonesSettingsV1) UpdateIpGeolocation(updateIpGeolocationOptions *zonessettingsv1.UpdateIpGeolocationOptions) (result *zonessettingsv1.IpGeolocationResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateIpGeolocation", updateIpGeolocationOptions)
+	if mock.UpdateIpGeolocationFunc != nil {
+		return mock.UpdateIpGeolocationFunc(updateIpGeolocationOptions)
+	}
+	if mock.UpdateIpGeolocationWithContextFunc != nil {
+		return mock.UpdateIpGeolocationWithContextFunc(context.Background(), updateIpGeolocationOptions)
+	}
+	err = notProgrammed("UpdateIpGeolocation")
+	return
+}
+
+// UpdateIpGeolocationWithContext invokes the programmed UpdateIpGeolocationWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateIpGeolocationWithContext(ctx context.Context, updateIpGeolocationOptions *zonessettingsv1.UpdateIpGeolocationOptions) (result *zonessettingsv1.IpGeolocationResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateIpGeolocationWithContext", ctx, updateIpGeolocationOptions)
+	if mock.UpdateIpGeolocationWithContextFunc != nil {
+		return mock.UpdateIpGeolocationWithContextFunc(ctx, updateIpGeolocationOptions)
+	}
+	if mock.UpdateIpGeolocationFunc != nil {
+		return mock.UpdateIpGeolocationFunc(updateIpGeolocationOptions)
+	}
+	err = notProgrammed("UpdateIpGeolocationWithContext")
+	return
+}
+
+// GetServerSideExclude invokes the programmed GetServerSideExcludeFunc.
+func (mock *ZonesSettingsV1) GetServerSideExclude(getServerSideExcludeOptions *zonessettingsv1.GetServerSideExcludeOptions) (result *zonessettingsv1.ServerSideExcludeResp, response *core.DetailedResponse, err error) {
+	mock.called("GetServerSideExclude", getServerSideExcludeOptions)
+	if mock.GetServerSideExcludeFunc != nil {
+		return mock.GetServerSideExcludeFunc(getServerSideExcludeOptions)
+	}
+	if mock.GetServerSideExcludeWithContextFunc != nil {
+		return mock.GetServerSideExcludeWithContextFunc(context.Background(), getServerSideExcludeOptions)
+	}
+	err = notProgrammed("GetServerSideExclude")
+	return
+}
+
+// GetServerSideExcludeWithContext invokes the programmed GetServerSideExcludeWithContextFunc.
+func (mock *ZonesSettingsV1) GetServerSideExcludeWithContext(ctx context.Context, getServerSideExcludeOptions *zonessettingsv1.GetServerSideExcludeOptions) (result *zonessettingsv1.ServerSideExcludeResp, response *core.DetailedResponse, err error) {
+	mock.called("GetServerSideExcludeWithContext", ctx, getServerSideExcludeOptions)
+	if mock.GetServerSideExcludeWithContextFunc != nil {
+		return mock.GetServerSideExcludeWithContextFunc(ctx, getServerSideExcludeOptions)
+	}
+	if mock.GetServerSideExcludeFunc != nil {
+		return mock.GetServerSideExcludeFunc(getServerSideExcludeOptions)
+	}
+	err = notProgrammed("GetServerSideExcludeWithContext")
+	return
+}
+
+// UpdateServerSideExclude invokes the programmed UpdateServerSideExcludeFunc.
+func (mock *ZonesSettingsV1) UpdateServerSideExclude(updateServerSideExcludeOptions *zonessettingsv1.UpdateServerSideExcludeOptions) (result *zonessettingsv1.ServerSideExcludeResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateServerSideExclude", updateServerSideExcludeOptions)
+	if mock.UpdateServerSideExcludeFunc != nil {
+		return mock.UpdateServerSideExcludeFunc(updateServerSideExcludeOptions)
+	}
+	if mock.UpdateServerSideExcludeWithContextFunc != nil {
+		return mock.UpdateServerSideExcludeWithContextFunc(context.Background(), updateServerSideExcludeOptions)
+	}
+	err = notProgrammed("UpdateServerSideExclude")
+	return
+}
+
+// UpdateServerSideExcludeWithContext invokes the programmed UpdateServerSideExcludeWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateServerSideExcludeWithContext(ctx context.Context, updateServerSideExcludeOptions *zonessettingsv1.UpdateServerSideExcludeOptions) (result *zonessettingsv1.ServerSideExcludeResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateServerSideExcludeWithContext", ctx, updateServerSideExcludeOptions)
+	if mock.UpdateServerSideExcludeWithContextFunc != nil {
+		return mock.UpdateServerSideExcludeWithContextFunc(ctx, updateServerSideExcludeOptions)
+	}
+	if mock.UpdateServerSideExcludeFunc != nil {
+		return mock.UpdateServerSideExcludeFunc(updateServerSideExcludeOptions)
+	}
+	err = notProgrammed("UpdateServerSideExcludeWithContext")
+	return
+}
+
+// GetSecurityHeader invokes the programmed GetSecurityHeaderFunc.
+func (mock *ZonesSettingsV1) GetSecurityHeader(getSecurityHeaderOptions *zonessettingsv1.GetSecurityHeaderOptions) (result *zonessettingsv1.SecurityHeaderResp, response *core.DetailedResponse, err error) {
+	mock.called("GetSecurityHeader", getSecurityHeaderOptions)
+	if mock.GetSecurityHeaderFunc != nil {
+		return mock.GetSecurityHeaderFunc(getSecurityHeaderOptions)
+	}
+	if mock.GetSecurityHeaderWithContextFunc != nil {
+		return mock.GetSecurityHeaderWithContextFunc(context.Background(), getSecurityHeaderOptions)
+	}
+	err = notProgrammed("GetSecurityHeader")
+	return
+}
+
+// GetSecurityHeaderWithContext invokes the programmed GetSecurityHeaderWithContextFunc.
+func (mock *ZonesSettingsV1) GetSecurityHeaderWithContext(ctx context.Context, getSecurityHeaderOptions *zonessettingsv1.GetSecurityHeaderOptions) (result *zonessettingsv1.SecurityHeaderResp, response *core.DetailedResponse, err error) {
+	mock.called("GetSecurityHeaderWithContext", ctx, getSecurityHeaderOptions)
+	if mock.GetSecurityHeaderWithContextFunc != nil {
+		return mock.GetSecurityHeaderWithContextFunc(ctx, getSecurityHeaderOptions)
+	}
+	if mock.GetSecurityHeaderFunc != nil {
+		return mock.GetSecurityHeaderFunc(getSecurityHeaderOptions)
+	}
+	err = notProgrammed("GetSecurityHeaderWithContext")
+	return
+}
+
+// UpdateSecurityHeader invokes the programmed UpdateSecurityHeaderFunc.
+func (mock *ZonesSettingsV1) UpdateSecurityHeader(updateSecurityHeaderOptions *zonessettingsv1.UpdateSecurityHeaderOptions) (result *zonessettingsv1.SecurityHeaderResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateSecurityHeader", updateSecurityHeaderOptions)
+	if mock.UpdateSecurityHeaderFunc != nil {
+		return mock.UpdateSecurityHeaderFunc(updateSecurityHeaderOptions)
+	}
+	if mock.UpdateSecurityHeaderWithContextFunc != nil {
+		return mock.UpdateSecurityHeaderWithContextFunc(context.Background(), updateSecurityHeaderOptions)
+	}
+	err = notProgrammed("UpdateSecurityHeader")
+	return
+}
+
+// UpdateSecurityHeaderWithContext invokes the programmed UpdateSecurityHeaderWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateSecurityHeaderWithContext(ctx context.Context, updateSecurityHeaderOptions *zonessettingsv1.UpdateSecurityHeaderOptions) (result *zonessettingsv1.SecurityHeaderResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateSecurityHeaderWithContext", ctx, updateSecurityHeaderOptions)
+	if mock.UpdateSecurityHeaderWithContextFunc != nil {
+		return mock.UpdateSecurityHeaderWithContextFunc(ctx, updateSecurityHeaderOptions)
+	}
+	if mock.UpdateSecurityHeaderFunc != nil {
+		return mock.UpdateSecurityHeaderFunc(updateSecurityHeaderOptions)
+	}
+	err = notProgrammed("UpdateSecurityHeaderWithContext")
+	return
+}
+
+// GetMobileRedirect invokes the programmed GetMobileRedirectFunc.
+func (mock *ZonesSettingsV1) GetMobileRedirect(getMobileRedirectOptions *zonessettingsv1.GetMobileRedirectOptions) (result *zonessettingsv1.MobileRedirectResp, response *core.DetailedResponse, err error) {
+	mock.called("GetMobileRedirect", getMobileRedirectOptions)
+	if mock.GetMobileRedirectFunc != nil {
+		return mock.GetMobileRedirectFunc(getMobileRedirectOptions)
+	}
+	if mock.GetMobileRedirectWithContextFunc != nil {
+		return mock.GetMobileRedirectWithContextFunc(context.Background(), getMobileRedirectOptions)
+	}
+	err = notProgrammed("GetMobileRedirect")
+	return
+}
+
+// GetMobileRedirectWithContext invokes the programmed GetMobileRedirectWithContextFunc.
+func (mock *ZonesSettingsV1) GetMobileRedirectWithContext(ctx context.Context, getMobileRedirectOptions *zonessettingsv1.GetMobileRedirectOptions) (result *zonessettingsv1.MobileRedirectResp, response *core.DetailedResponse, err error) {
+	mock.called("GetMobileRedirectWithContext", ctx, getMobileRedirectOptions)
+	if mock.GetMobileRedirectWithContextFunc != nil {
+		return mock.GetMobileRedirectWithContextFunc(ctx, getMobileRedirectOptions)
+	}
+	if mock.GetMobileRedirectFunc != nil {
+		return mock.GetMobileRedirectFunc(getMobileRedirectOptions)
+	}
+	err = notProgrammed("GetMobileRedirectWithContext")
+	return
+}
+
+// UpdateMobileRedirect invokes the programmed UpdateMobileRedirectFunc.
+func (mock *ZonesSettingsV1) UpdateMobileRedirect(updateMobileRedirectOptions *zonessettingsv1.UpdateMobileRedirectOptions) (result *zonessettingsv1.MobileRedirectResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMobileRedirect", updateMobileRedirectOptions)
+	if mock.UpdateMobileRedirectFunc != nil {
+		return mock.UpdateMobileRedirectFunc(updateMobileRedirectOptions)
+	}
+	if mock.UpdateMobileRedirectWithContextFunc != nil {
+		return mock.UpdateMobileRedirectWithContextFunc(context.Background(), updateMobileRedirectOptions)
+	}
+	err = notProgrammed("UpdateMobileRedirect")
+	return
+}
+
+// UpdateMobileRedirectWithContext invokes the programmed UpdateMobileRedirectWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateMobileRedirectWithContext(ctx context.Context, updateMobileRedirectOptions *zonessettingsv1.UpdateMobileRedirectOptions) (result *zonessettingsv1.MobileRedirectResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMobileRedirectWithContext", ctx, updateMobileRedirectOptions)
+	if mock.UpdateMobileRedirectWithContextFunc != nil {
+		return mock.UpdateMobileRedirectWithContextFunc(ctx, updateMobileRedirectOptions)
+	}
+	if mock.UpdateMobileRedirectFunc != nil {
+		return mock.UpdateMobileRedirectFunc(updateMobileRedirectOptions)
+	}
+	err = notProgrammed("UpdateMobileRedirectWithContext")
+	return
+}
+
+// GetPrefetchPreload invokes the programmed GetPrefetchPreloadFunc.
+func (mock *ZonesSettingsV1) GetPrefetchPreload(getPrefetchPreloadOptions *zonessettingsv1.GetPrefetchPreloadOptions) (result *zonessettingsv1.PrefetchPreloadResp, response *core.DetailedResponse, err error) {
+	mock.called("GetPrefetchPreload", getPrefetchPreloadOptions)
+	if mock.GetPrefetchPreloadFunc != nil {
+		return mock.GetPrefetchPreloadFunc(getPrefetchPreloadOptions)
+	}
+	if mock.GetPrefetchPreloadWithContextFunc != nil {
+		return mock.GetPrefetchPreloadWithContextFunc(context.Background(), getPrefetchPreloadOptions)
+	}
+	err = notProgrammed("GetPrefetchPreload")
+	return
+}
+
+// GetPrefetchPreloadWithContext invokes the programmed GetPrefetchPreloadWithContextFunc.
+func (mock *ZonesSettingsV1) GetPrefetchPreloadWithContext(ctx context.Context, getPrefetchPreloadOptions *zonessettingsv1.GetPrefetchPreloadOptions) (result *zonessettingsv1.PrefetchPreloadResp, response *core.DetailedResponse, err error) {
+	mock.called("GetPrefetchPreloadWithContext", ctx, getPrefetchPreloadOptions)
+	if mock.GetPrefetchPreloadWithContextFunc != nil {
+		return mock.GetPrefetchPreloadWithContextFunc(ctx, getPrefetchPreloadOptions)
+	}
+	if mock.GetPrefetchPreloadFunc != nil {
+		return mock.GetPrefetchPreloadFunc(getPrefetchPreloadOptions)
+	}
+	err = notProgrammed("GetPrefetchPreloadWithContext")
+	return
+}
+
+// UpdatePrefetchPreload invokes the programmed UpdatePrefetchPreloadFunc.
+func (mock *ZonesSettingsV1) UpdatePrefetchPreload(updatePrefetchPreloadOptions *zonessettingsv1.UpdatePrefetchPreloadOptions) (result *zonessettingsv1.PrefetchPreloadResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdatePrefetchPreload", updatePrefetchPreloadOptions)
+	if mock.UpdatePrefetchPreloadFunc != nil {
+		return mock.UpdatePrefetchPreloadFunc(updatePrefetchPreloadOptions)
+	}
+	if mock.UpdatePrefetchPreloadWithContextFunc != nil {
+		return mock.UpdatePrefetchPreloadWithContextFunc(context.Background(), updatePrefetchPreloadOptions)
+	}
+	err = notProgrammed("UpdatePrefetchPreload")
+	return
+}
+
+// UpdatePrefetchPreloadWithContext invokes the programmed UpdatePrefetchPreloadWithContextFunc.
+func (mock *ZonesSettingsV1) UpdatePrefetchPreloadWithContext(ctx context.Context, updatePrefetchPreloadOptions *zonessettingsv1.UpdatePrefetchPreloadOptions) (result *zonessettingsv1.PrefetchPreloadResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdatePrefetchPreloadWithContext", ctx, updatePrefetchPreloadOptions)
+	if mock.UpdatePrefetchPreloadWithContextFunc != nil {
+		return mock.UpdatePrefetchPreloadWithContextFunc(ctx, updatePrefetchPreloadOptions)
+	}
+	if mock.UpdatePrefetchPreloadFunc != nil {
+		return mock.UpdatePrefetchPreloadFunc(updatePrefetchPreloadOptions)
+	}
+	err = notProgrammed("UpdatePrefetchPreloadWithContext")
+	return
+}
+
+// GetHttp2 invokes the programmed GetHttp2Func.
+func (mock *ZonesSettingsV1) GetHttp2(getHttp2Options *zonessettingsv1.GetHttp2Options) (result *zonessettingsv1.Http2Resp, response *core.DetailedResponse, err error) {
+	mock.called("GetHttp2", getHttp2Options)
+	if mock.GetHttp2Func != nil {
+		return mock.GetHttp2Func(getHttp2Options)
+	}
+	if mock.GetHttp2WithContextFunc != nil {
+		return mock.GetHttp2WithContextFunc(context.Background(), getHttp2Options)
+	}
+	err = notProgrammed("GetHttp2")
+	return
+}
+
+// GetHttp2WithContext invokes the programmed GetHttp2WithContextFunc.
+func (mock *ZonesSettingsV1) GetHttp2WithContext(ctx context.Context, getHttp2Options *zonessettingsv1.GetHttp2Options) (result *zonessettingsv1.Http2Resp, response *core.DetailedResponse, err error) {
+	mock.called("GetHttp2WithContext", ctx, getHttp2Options)
+	if mock.GetHttp2WithContextFunc != nil {
+		return mock.GetHttp2WithContextFunc(ctx, getHttp2Options)
+	}
+	if mock.GetHttp2Func != nil {
+		return mock.GetHttp2Func(getHttp2Options)
+	}
+	err = notProgrammed("GetHttp2WithContext")
+	return
+}
+
+// UpdateHttp2 invokes the programmed UpdateHttp2Func.
+func (mock *ZonesSettingsV1) UpdateHttp2(updateHttp2Options *zonessettingsv1.UpdateHttp2Options) (result *zonessettingsv1.Http2Resp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateHttp2", updateHttp2Options)
+	if mock.UpdateHttp2Func != nil {
+		return mock.UpdateHttp2Func(updateHttp2Options)
+	}
+	if mock.UpdateHttp2WithContextFunc != nil {
+		return mock.UpdateHttp2WithContextFunc(context.Background(), updateHttp2Options)
+	}
+	err = notProgrammed("UpdateHttp2")
+	return
+}
+
+// UpdateHttp2WithContext invokes the programmed UpdateHttp2WithContextFunc.
+func (mock *ZonesSettingsV1) UpdateHttp2WithContext(ctx context.Context, updateHttp2Options *zonessettingsv1.UpdateHttp2Options) (result *zonessettingsv1.Http2Resp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateHttp2WithContext", ctx, updateHttp2Options)
+	if mock.UpdateHttp2WithContextFunc != nil {
+		return mock.UpdateHttp2WithContextFunc(ctx, updateHttp2Options)
+	}
+	if mock.UpdateHttp2Func != nil {
+		return mock.UpdateHttp2Func(updateHttp2Options)
+	}
+	err = notProgrammed("UpdateHttp2WithContext")
+	return
+}
+
+// GetHttp3 invokes the programmed GetHttp3Func.
+func (mock *ZonesSettingsV1) GetHttp3(getHttp3Options *zonessettingsv1.GetHttp3Options) (result *zonessettingsv1.Http3Resp, response *core.DetailedResponse, err error) {
+	mock.called("GetHttp3", getHttp3Options)
+	if mock.GetHttp3Func != nil {
+		return mock.GetHttp3Func(getHttp3Options)
+	}
+	if mock.GetHttp3WithContextFunc != nil {
+		return mock.GetHttp3WithContextFunc(context.Background(), getHttp3Options)
+	}
+	err = notProgrammed("GetHttp3")
+	return
+}
+
+// GetHttp3WithContext invokes the programmed GetHttp3WithContextFunc.
+func (mock *ZonesSettingsV1) GetHttp3WithContext(ctx context.Context, getHttp3Options *zonessettingsv1.GetHttp3Options) (result *zonessettingsv1.Http3Resp, response *core.DetailedResponse, err error) {
+	mock.called("GetHttp3WithContext", ctx, getHttp3Options)
+	if mock.GetHttp3WithContextFunc != nil {
+		return mock.GetHttp3WithContextFunc(ctx, getHttp3Options)
+	}
+	if mock.GetHttp3Func != nil {
+		return mock.GetHttp3Func(getHttp3Options)
+	}
+	err = notProgrammed("GetHttp3WithContext")
+	return
+}
+
+// UpdateHttp3 invokes the programmed UpdateHttp3Func.
+func (mock *ZonesSettingsV1) UpdateHttp3(updateHttp3Options *zonessettingsv1.UpdateHttp3Options) (result *zonessettingsv1.Http3Resp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateHttp3", updateHttp3Options)
+	if mock.UpdateHttp3Func != nil {
+		return mock.UpdateHttp3Func(updateHttp3Options)
+	}
+	if mock.UpdateHttp3WithContextFunc != nil {
+		return mock.UpdateHttp3WithContextFunc(context.Background(), updateHttp3Options)
+	}
+	err = notProgrammed("UpdateHttp3")
+	return
+}
+
+// UpdateHttp3WithContext invokes the programmed UpdateHttp3WithContextFunc.
+func (mock *ZonesSettingsV1) UpdateHttp3WithContext(ctx context.Context, updateHttp3Options *zonessettingsv1.UpdateHttp3Options) (result *zonessettingsv1.Http3Resp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateHttp3WithContext", ctx, updateHttp3Options)
+	if mock.UpdateHttp3WithContextFunc != nil {
+		return mock.UpdateHttp3WithContextFunc(ctx, updateHttp3Options)
+	}
+	if mock.UpdateHttp3Func != nil {
+		return mock.UpdateHttp3Func(updateHttp3Options)
+	}
+	err = notProgrammed("UpdateHttp3WithContext")
+	return
+}
+
+// GetIpv6 invokes the programmed GetIpv6Func.
+func (mock *ZonesSettingsV1) GetIpv6(getIpv6Options *zonessettingsv1.GetIpv6Options) (result *zonessettingsv1.Ipv6Resp, response *core.DetailedResponse, err error) {
+	mock.called("GetIpv6", getIpv6Options)
+	if mock.GetIpv6Func != nil {
+		return mock.GetIpv6Func(getIpv6Options)
+	}
+	if mock.GetIpv6WithContextFunc != nil {
+		return mock.GetIpv6WithContextFunc(context.Background(), getIpv6Options)
+	}
+	err = notProgrammed("GetIpv6")
+	return
+}
+
+// GetIpv6WithContext invokes the programmed GetIpv6WithContextFunc.
+func (mock *ZonesSettingsV1) GetIpv6WithContext(ctx context.Context, getIpv6Options *zonessettingsv1.GetIpv6Options) (result *zonessettingsv1.Ipv6Resp, response *core.DetailedResponse, err error) {
+	mock.called("GetIpv6WithContext", ctx, getIpv6Options)
+	if mock.GetIpv6WithContextFunc != nil {
+		return mock.GetIpv6WithContextFunc(ctx, getIpv6Options)
+	}
+	if mock.GetIpv6Func != nil {
+		return mock.GetIpv6Func(getIpv6Options)
+	}
+	err = notProgrammed("GetIpv6WithContext")
+	return
+}
+
+// UpdateIpv6 invokes the programmed UpdateIpv6Func.
+func (mock *ZonesSettingsV1) UpdateIpv6(updateIpv6Options *zonessettingsv1.UpdateIpv6Options) (result *zonessettingsv1.Ipv6Resp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateIpv6", updateIpv6Options)
+	if mock.UpdateIpv6Func != nil {
+		return mock.UpdateIpv6Func(updateIpv6Options)
+	}
+	if mock.UpdateIpv6WithContextFunc != nil {
+		return mock.UpdateIpv6WithContextFunc(context.Background(), updateIpv6Options)
+	}
+	err = notProgrammed("UpdateIpv6")
+	return
+}
+
+// UpdateIpv6WithContext invokes the programmed UpdateIpv6WithContextFunc.
+func (mock *ZonesSettingsV1) UpdateIpv6WithContext(ctx context.Context, updateIpv6Options *zonessettingsv1.UpdateIpv6Options) (result *zonessettingsv1.Ipv6Resp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateIpv6WithContext", ctx, updateIpv6Options)
+	if mock.UpdateIpv6WithContextFunc != nil {
+		return mock.UpdateIpv6WithContextFunc(ctx, updateIpv6Options)
+	}
+	if mock.UpdateIpv6Func != nil {
+		return mock.UpdateIpv6Func(updateIpv6Options)
+	}
+	err = notProgrammed("UpdateIpv6WithContext")
+	return
+}
+
+// GetWebSockets invokes the programmed GetWebSocketsFunc.
+func (mock *ZonesSettingsV1) GetWebSockets(getWebSocketsOptions *zonessettingsv1.GetWebSocketsOptions) (result *zonessettingsv1.WebsocketsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetWebSockets", getWebSocketsOptions)
+	if mock.GetWebSocketsFunc != nil {
+		return mock.GetWebSocketsFunc(getWebSocketsOptions)
+	}
+	if mock.GetWebSocketsWithContextFunc != nil {
+		return mock.GetWebSocketsWithContextFunc(context.Background(), getWebSocketsOptions)
+	}
+	err = notProgrammed("GetWebSockets")
+	return
+}
+
+// GetWebSocketsWithContext invokes the programmed GetWebSocketsWithContextFunc.
+func (mock *ZonesSettingsV1) GetWebSocketsWithContext(ctx context.Context, getWebSocketsOptions *zonessettingsv1.GetWebSocketsOptions) (result *zonessettingsv1.WebsocketsResp, response *core.DetailedResponse, err error) {
+	mock.called("GetWebSocketsWithContext", ctx, getWebSocketsOptions)
+	if mock.GetWebSocketsWithContextFunc != nil {
+		return mock.GetWebSocketsWithContextFunc(ctx, getWebSocketsOptions)
+	}
+	if mock.GetWebSocketsFunc != nil {
+		return mock.GetWebSocketsFunc(getWebSocketsOptions)
+	}
+	err = notProgrammed("GetWebSocketsWithContext")
+	return
+}
+
+// UpdateWebSockets invokes the programmed UpdateWebSocketsFunc.
+func (mock *ZonesSettingsV1) UpdateWebSockets(updateWebSocketsOptions *zonessettingsv1.UpdateWebSocketsOptions) (result *zonessettingsv1.WebsocketsResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWebSockets", updateWebSocketsOptions)
+	if mock.UpdateWebSocketsFunc != nil {
+		return mock.UpdateWebSocketsFunc(updateWebSocketsOptions)
+	}
+	if mock.UpdateWebSocketsWithContextFunc != nil {
+		return mock.UpdateWebSocketsWithContextFunc(context.Background(), updateWebSocketsOptions)
+	}
+	err = notProgrammed("UpdateWebSockets")
+	return
+}
+
+// UpdateWebSocketsWithContext invokes the programmed UpdateWebSocketsWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateWebSocketsWithContext(ctx context.Context, updateWebSocketsOptions *zonessettingsv1.UpdateWebSocketsOptions) (result *zonessettingsv1.WebsocketsResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWebSocketsWithContext", ctx, updateWebSocketsOptions)
+	if mock.UpdateWebSocketsWithContextFunc != nil {
+		return mock.UpdateWebSocketsWithContextFunc(ctx, updateWebSocketsOptions)
+	}
+	if mock.UpdateWebSocketsFunc != nil {
+		return mock.UpdateWebSocketsFunc(updateWebSocketsOptions)
+	}
+	err = notProgrammed("UpdateWebSocketsWithContext")
+	return
+}
+
+// GetPseudoIpv4 invokes the programmed GetPseudoIpv4Func.
+func (mock *ZonesSettingsV1) GetPseudoIpv4(getPseudoIpv4Options *zonessettingsv1.GetPseudoIpv4Options) (result *zonessettingsv1.PseudoIpv4Resp, response *core.DetailedResponse, err error) {
+	mock.called("GetPseudoIpv4", getPseudoIpv4Options)
+	if mock.GetPseudoIpv4Func != nil {
+		return mock.GetPseudoIpv4Func(getPseudoIpv4Options)
+	}
+	if mock.GetPseudoIpv4WithContextFunc != nil {
+		return mock.GetPseudoIpv4WithContextFunc(context.Background(), getPseudoIpv4Options)
+	}
+	err = notProgrammed("GetPseudoIpv4")
+	return
+}
+
+// GetPseudoIpv4WithContext invokes the programmed GetPseudoIpv4WithContextFunc.
+func (mock *ZonesSettingsV1) GetPseudoIpv4WithContext(ctx context.Context, getPseudoIpv4Options *zonessettingsv1.GetPseudoIpv4Options) (result *zonessettingsv1.PseudoIpv4Resp, response *core.DetailedResponse, err error) {
+	mock.called("GetPseudoIpv4WithContext", ctx, getPseudoIpv4Options)
+	if mock.GetPseudoIpv4WithContextFunc != nil {
+		return mock.GetPseudoIpv4WithContextFunc(ctx, getPseudoIpv4Options)
+	}
+	if mock.GetPseudoIpv4Func != nil {
+		return mock.GetPseudoIpv4Func(getPseudoIpv4Options)
+	}
+	err = notProgrammed("GetPseudoIpv4WithContext")
+	return
+}
+
+// UpdatePseudoIpv4 invokes the programmed UpdatePseudoIpv4Func.
+func (mock *ZonesSettingsV1) UpdatePseudoIpv4(updatePseudoIpv4Options *zonessettingsv1.UpdatePseudoIpv4Options) (result *zonessettingsv1.PseudoIpv4Resp, response *core.DetailedResponse, err error) {
+	mock.called("UpdatePseudoIpv4", updatePseudoIpv4Options)
+	if mock.UpdatePseudoIpv4Func != nil {
+		return mock.UpdatePseudoIpv4Func(updatePseudoIpv4Options)
+	}
+	if mock.UpdatePseudoIpv4WithContextFunc != nil {
+		return mock.UpdatePseudoIpv4WithContextFunc(context.Background(), updatePseudoIpv4Options)
+	}
+	err = notProgrammed("UpdatePseudoIpv4")
+	return
+}
+
+// UpdatePseudoIpv4WithContext invokes the programmed UpdatePseudoIpv4WithContextFunc.
+func (mock *ZonesSettingsV1) UpdatePseudoIpv4WithContext(ctx context.Context, updatePseudoIpv4Options *zonessettingsv1.UpdatePseudoIpv4Options) (result *zonessettingsv1.PseudoIpv4Resp, response *core.DetailedResponse, err error) {
+	mock.called("UpdatePseudoIpv4WithContext", ctx, updatePseudoIpv4Options)
+	if mock.UpdatePseudoIpv4WithContextFunc != nil {
+		return mock.UpdatePseudoIpv4WithContextFunc(ctx, updatePseudoIpv4Options)
+	}
+	if mock.UpdatePseudoIpv4Func != nil {
+		return mock.UpdatePseudoIpv4Func(updatePseudoIpv4Options)
+	}
+	err = notProgrammed("UpdatePseudoIpv4WithContext")
+	return
+}
+
+// GetResponseBuffering invokes the programmed GetResponseBufferingFunc.
+func (mock *ZonesSettingsV1) GetResponseBuffering(getResponseBufferingOptions *zonessettingsv1.GetResponseBufferingOptions) (result *zonessettingsv1.ResponseBufferingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetResponseBuffering", getResponseBufferingOptions)
+	if mock.GetResponseBufferingFunc != nil {
+		return mock.GetResponseBufferingFunc(getResponseBufferingOptions)
+	}
+	if mock.GetResponseBufferingWithContextFunc != nil {
+		return mock.GetResponseBufferingWithContextFunc(context.Background(), getResponseBufferingOptions)
+	}
+	err = notProgrammed("GetResponseBuffering")
+	return
+}
+
+// GetResponseBufferingWithContext invokes the programmed GetResponseBufferingWithContextFunc.
+func (mock *ZonesSettingsV1) GetResponseBufferingWithContext(ctx context.Context, getResponseBufferingOptions *zonessettingsv1.GetResponseBufferingOptions) (result *zonessettingsv1.ResponseBufferingResp, response *core.DetailedResponse, err error) {
+	mock.called("GetResponseBufferingWithContext", ctx, getResponseBufferingOptions)
+	if mock.GetResponseBufferingWithContextFunc != nil {
+		return mock.GetResponseBufferingWithContextFunc(ctx, getResponseBufferingOptions)
+	}
+	if mock.GetResponseBufferingFunc != nil {
+		return mock.GetResponseBufferingFunc(getResponseBufferingOptions)
+	}
+	err = notProgrammed("GetResponseBufferingWithContext")
+	return
+}
+
+// UpdateResponseBuffering invokes the programmed UpdateResponseBufferingFunc.
+func (mock *ZonesSettingsV1) UpdateResponseBuffering(updateResponseBufferingOptions *zonessettingsv1.UpdateResponseBufferingOptions) (result *zonessettingsv1.ResponseBufferingResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateResponseBuffering", updateResponseBufferingOptions)
+	if mock.UpdateResponseBufferingFunc != nil {
+		return mock.UpdateResponseBufferingFunc(updateResponseBufferingOptions)
+	}
+	if mock.UpdateResponseBufferingWithContextFunc != nil {
+		return mock.UpdateResponseBufferingWithContextFunc(context.Background(), updateResponseBufferingOptions)
+	}
+	err = notProgrammed("UpdateResponseBuffering")
+	return
+}
+
+// UpdateResponseBufferingWithContext invokes the programmed UpdateResponseBufferingWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateResponseBufferingWithContext(ctx context.Context, updateResponseBufferingOptions *zonessettingsv1.UpdateResponseBufferingOptions) (result *zonessettingsv1.ResponseBufferingResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateResponseBufferingWithContext", ctx, updateResponseBufferingOptions)
+	if mock.UpdateResponseBufferingWithContextFunc != nil {
+		return mock.UpdateResponseBufferingWithContextFunc(ctx, updateResponseBufferingOptions)
+	}
+	if mock.UpdateResponseBufferingFunc != nil {
+		return mock.UpdateResponseBufferingFunc(updateResponseBufferingOptions)
+	}
+	err = notProgrammed("UpdateResponseBufferingWithContext")
+	return
+}
+
+// GetHotlinkProtection invokes the programmed GetHotlinkProtectionFunc.
+func (mock *ZonesSettingsV1) GetHotlinkProtection(getHotlinkProtectionOptions *zonessettingsv1.GetHotlinkProtectionOptions) (result *zonessettingsv1.HotlinkProtectionResp, response *core.DetailedResponse, err error) {
+	mock.called("GetHotlinkProtection", getHotlinkProtectionOptions)
+	if mock.GetHotlinkProtectionFunc != nil {
+		return mock.GetHotlinkProtectionFunc(getHotlinkProtectionOptions)
+	}
+	if mock.GetHotlinkProtectionWithContextFunc != nil {
+		return mock.GetHotlinkProtectionWithContextFunc(context.Background(), getHotlinkProtectionOptions)
+	}
+	err = notProgrammed("GetHotlinkProtection")
+	return
+}
+
+// GetHotlinkProtectionWithContext invokes the programmed GetHotlinkProtectionWithContextFunc.
+func (mock *ZonesSettingsV1) GetHotlinkProtectionWithContext(ctx context.Context, getHotlinkProtectionOptions *zonessettingsv1.GetHotlinkProtectionOptions) (result *zonessettingsv1.HotlinkProtectionResp, response *core.DetailedResponse, err error) {
+	mock.called("GetHotlinkProtectionWithContext", ctx, getHotlinkProtectionOptions)
+	if mock.GetHotlinkProtectionWithContextFunc != nil {
+		return mock.GetHotlinkProtectionWithContextFunc(ctx, getHotlinkProtectionOptions)
+	}
+	if mock.GetHotlinkProtectionFunc != nil {
+		return mock.GetHotlinkProtectionFunc(getHotlinkProtectionOptions)
+	}
+	err = notProgrammed("GetHotlinkProtectionWithContext")
+	return
+}
+
+// UpdateHotlinkProtection invokes the programmed UpdateHotlinkProtectionFunc.
+func (mock *ZonesSettingsV1) UpdateHotlinkProtection(updateHotlinkProtectionOptions *zonessettingsv1.UpdateHotlinkProtectionOptions) (result *zonessettingsv1.HotlinkProtectionResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateHotlinkProtection", updateHotlinkProtectionOptions)
+	if mock.UpdateHotlinkProtectionFunc != nil {
+		return mock.UpdateHotlinkProtectionFunc(updateHotlinkProtectionOptions)
+	}
+	if mock.UpdateHotlinkProtectionWithContextFunc != nil {
+		return mock.UpdateHotlinkProtectionWithContextFunc(context.Background(), updateHotlinkProtectionOptions)
+	}
+	err = notProgrammed("UpdateHotlinkProtection")
+	return
+}
+
+// UpdateHotlinkProtectionWithContext invokes the programmed UpdateHotlinkProtectionWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateHotlinkProtectionWithContext(ctx context.Context, updateHotlinkProtectionOptions *zonessettingsv1.UpdateHotlinkProtectionOptions) (result *zonessettingsv1.HotlinkProtectionResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateHotlinkProtectionWithContext", ctx, updateHotlinkProtectionOptions)
+	if mock.UpdateHotlinkProtectionWithContextFunc != nil {
+		return mock.UpdateHotlinkProtectionWithContextFunc(ctx, updateHotlinkProtectionOptions)
+	}
+	if mock.UpdateHotlinkProtectionFunc != nil {
+		return mock.UpdateHotlinkProtectionFunc(updateHotlinkProtectionOptions)
+	}
+	err = notProgrammed("UpdateHotlinkProtectionWithContext")
+	return
+}
+
+// GetMaxUpload invokes the programmed GetMaxUploadFunc.
+func (mock *ZonesSettingsV1) GetMaxUpload(getMaxUploadOptions *zonessettingsv1.GetMaxUploadOptions) (result *zonessettingsv1.MaxUploadResp, response *core.DetailedResponse, err error) {
+	mock.called("GetMaxUpload", getMaxUploadOptions)
+	if mock.GetMaxUploadFunc != nil {
+		return mock.GetMaxUploadFunc(getMaxUploadOptions)
+	}
+	if mock.GetMaxUploadWithContextFunc != nil {
+		return mock.GetMaxUploadWithContextFunc(context.Background(), getMaxUploadOptions)
+	}
+	err = notProgrammed("GetMaxUpload")
+	return
+}
+
+// GetMaxUploadWithContext invokes the programmed GetMaxUploadWithContextFunc.
+func (mock *ZonesSettingsV1) GetMaxUploadWithContext(ctx context.Context, getMaxUploadOptions *zonessettingsv1.GetMaxUploadOptions) (result *zonessettingsv1.MaxUploadResp, response *core.DetailedResponse, err error) {
+	mock.called("GetMaxUploadWithContext", ctx, getMaxUploadOptions)
+	if mock.GetMaxUploadWithContextFunc != nil {
+		return mock.GetMaxUploadWithContextFunc(ctx, getMaxUploadOptions)
+	}
+	if mock.GetMaxUploadFunc != nil {
+		return mock.GetMaxUploadFunc(getMaxUploadOptions)
+	}
+	err = notProgrammed("GetMaxUploadWithContext")
+	return
+}
+
+// UpdateMaxUpload invokes the programmed UpdateMaxUploadFunc.
+func (mock *ZonesSettingsV1) UpdateMaxUpload(updateMaxUploadOptions *zonessettingsv1.UpdateMaxUploadOptions) (result *zonessettingsv1.MaxUploadResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMaxUpload", updateMaxUploadOptions)
+	if mock.UpdateMaxUploadFunc != nil {
+		return mock.UpdateMaxUploadFunc(updateMaxUploadOptions)
+	}
+	if mock.UpdateMaxUploadWithContextFunc != nil {
+		return mock.UpdateMaxUploadWithContextFunc(context.Background(), updateMaxUploadOptions)
+	}
+	err = notProgrammed("UpdateMaxUpload")
+	return
+}
+
+// UpdateMaxUploadWithContext invokes the programmed UpdateMaxUploadWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateMaxUploadWithContext(ctx context.Context, updateMaxUploadOptions *zonessettingsv1.UpdateMaxUploadOptions) (result *zonessettingsv1.MaxUploadResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateMaxUploadWithContext", ctx, updateMaxUploadOptions)
+	if mock.UpdateMaxUploadWithContextFunc != nil {
+		return mock.UpdateMaxUploadWithContextFunc(ctx, updateMaxUploadOptions)
+	}
+	if mock.UpdateMaxUploadFunc != nil {
+		return mock.UpdateMaxUploadFunc(updateMaxUploadOptions)
+	}
+	err = notProgrammed("UpdateMaxUploadWithContext")
+	return
+}
+
+// GetTlsClientAuth invokes the programmed GetTlsClientAuthFunc.
+func (mock *ZonesSettingsV1) GetTlsClientAuth(getTlsClientAuthOptions *zonessettingsv1.GetTlsClientAuthOptions) (result *zonessettingsv1.TlsClientAuthResp, response *core.DetailedResponse, err error) {
+	mock.called("GetTlsClientAuth", getTlsClientAuthOptions)
+	if mock.GetTlsClientAuthFunc != nil {
+		return mock.GetTlsClientAuthFunc(getTlsClientAuthOptions)
+	}
+	if mock.GetTlsClientAuthWithContextFunc != nil {
+		return mock.GetTlsClientAuthWithContextFunc(context.Background(), getTlsClientAuthOptions)
+	}
+	err = notProgrammed("GetTlsClientAuth")
+	return
+}
+
+// GetTlsClientAuthWithContext invokes the programmed GetTlsClientAuthWithContextFunc.
+func (mock *ZonesSettingsV1) GetTlsClientAuthWithContext(ctx context.Context, getTlsClientAuthOptions *zonessettingsv1.GetTlsClientAuthOptions) (result *zonessettingsv1.TlsClientAuthResp, response *core.DetailedResponse, err error) {
+	mock.called("GetTlsClientAuthWithContext", ctx, getTlsClientAuthOptions)
+	if mock.GetTlsClientAuthWithContextFunc != nil {
+		return mock.GetTlsClientAuthWithContextFunc(ctx, getTlsClientAuthOptions)
+	}
+	if mock.GetTlsClientAuthFunc != nil {
+		return mock.GetTlsClientAuthFunc(getTlsClientAuthOptions)
+	}
+	err = notProgrammed("GetTlsClientAuthWithContext")
+	return
+}
+
+// UpdateTlsClientAuth invokes the programmed UpdateTlsClientAuthFunc.
+func (mock *ZonesSettingsV1) UpdateTlsClientAuth(updateTlsClientAuthOptions *zonessettingsv1.UpdateTlsClientAuthOptions) (result *zonessettingsv1.TlsClientAuthResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateTlsClientAuth", updateTlsClientAuthOptions)
+	if mock.UpdateTlsClientAuthFunc != nil {
+		return mock.UpdateTlsClientAuthFunc(updateTlsClientAuthOptions)
+	}
+	if mock.UpdateTlsClientAuthWithContextFunc != nil {
+		return mock.UpdateTlsClientAuthWithContextFunc(context.Background(), updateTlsClientAuthOptions)
+	}
+	err = notProgrammed("UpdateTlsClientAuth")
+	return
+}
+
+// UpdateTlsClientAuthWithContext invokes the programmed UpdateTlsClientAuthWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateTlsClientAuthWithContext(ctx context.Context, updateTlsClientAuthOptions *zonessettingsv1.UpdateTlsClientAuthOptions) (result *zonessettingsv1.TlsClientAuthResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateTlsClientAuthWithContext", ctx, updateTlsClientAuthOptions)
+	if mock.UpdateTlsClientAuthWithContextFunc != nil {
+		return mock.UpdateTlsClientAuthWithContextFunc(ctx, updateTlsClientAuthOptions)
+	}
+	if mock.UpdateTlsClientAuthFunc != nil {
+		return mock.UpdateTlsClientAuthFunc(updateTlsClientAuthOptions)
+	}
+	err = notProgrammed("UpdateTlsClientAuthWithContext")
+	return
+}
+
+// GetBrotli invokes the programmed GetBrotliFunc.
+func (mock *ZonesSettingsV1) GetBrotli(getBrotliOptions *zonessettingsv1.GetBrotliOptions) (result *zonessettingsv1.BrotliResp, response *core.DetailedResponse, err error) {
+	mock.called("GetBrotli", getBrotliOptions)
+	if mock.GetBrotliFunc != nil {
+		return mock.GetBrotliFunc(getBrotliOptions)
+	}
+	if mock.GetBrotliWithContextFunc != nil {
+		return mock.GetBrotliWithContextFunc(context.Background(), getBrotliOptions)
+	}
+	err = notProgrammed("GetBrotli")
+	return
+}
+
+// GetBrotliWithContext invokes the programmed GetBrotliWithContextFunc.
+func (mock *ZonesSettingsV1) GetBrotliWithContext(ctx context.Context, getBrotliOptions *zonessettingsv1.GetBrotliOptions) (result *zonessettingsv1.BrotliResp, response *core.DetailedResponse, err error) {
+	mock.called("GetBrotliWithContext", ctx, getBrotliOptions)
+	if mock.GetBrotliWithContextFunc != nil {
+		return mock.GetBrotliWithContextFunc(ctx, getBrotliOptions)
+	}
+	if mock.GetBrotliFunc != nil {
+		return mock.GetBrotliFunc(getBrotliOptions)
+	}
+	err = notProgrammed("GetBrotliWithContext")
+	return
+}
+
+// UpdateBrotli invokes the programmed UpdateBrotliFunc.
+func (mock *ZonesSettingsV1) UpdateBrotli(updateBrotliOptions *zonessettingsv1.UpdateBrotliOptions) (result *zonessettingsv1.BrotliResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateBrotli", updateBrotliOptions)
+	if mock.UpdateBrotliFunc != nil {
+		return mock.UpdateBrotliFunc(updateBrotliOptions)
+	}
+	if mock.UpdateBrotliWithContextFunc != nil {
+		return mock.UpdateBrotliWithContextFunc(context.Background(), updateBrotliOptions)
+	}
+	err = notProgrammed("UpdateBrotli")
+	return
+}
+
+// UpdateBrotliWithContext invokes the programmed UpdateBrotliWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateBrotliWithContext(ctx context.Context, updateBrotliOptions *zonessettingsv1.UpdateBrotliOptions) (result *zonessettingsv1.BrotliResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateBrotliWithContext", ctx, updateBrotliOptions)
+	if mock.UpdateBrotliWithContextFunc != nil {
+		return mock.UpdateBrotliWithContextFunc(ctx, updateBrotliOptions)
+	}
+	if mock.UpdateBrotliFunc != nil {
+		return mock.UpdateBrotliFunc(updateBrotliOptions)
+	}
+	err = notProgrammed("UpdateBrotliWithContext")
+	return
+}
+
+// GetBrowserCheck invokes the programmed GetBrowserCheckFunc.
+func (mock *ZonesSettingsV1) GetBrowserCheck(getBrowserCheckOptions *zonessettingsv1.GetBrowserCheckOptions) (result *zonessettingsv1.BrowserCheckResp, response *core.DetailedResponse, err error) {
+	mock.called("GetBrowserCheck", getBrowserCheckOptions)
+	if mock.GetBrowserCheckFunc != nil {
+		return mock.GetBrowserCheckFunc(getBrowserCheckOptions)
+	}
+	if mock.GetBrowserCheckWithContextFunc != nil {
+		return mock.GetBrowserCheckWithContextFunc(context.Background(), getBrowserCheckOptions)
+	}
+	err = notProgrammed("GetBrowserCheck")
+	return
+}
+
+// GetBrowserCheckWithContext invokes the programmed GetBrowserCheckWithContextFunc.
+func (mock *ZonesSettingsV1) GetBrowserCheckWithContext(ctx context.Context, getBrowserCheckOptions *zonessettingsv1.GetBrowserCheckOptions) (result *zonessettingsv1.BrowserCheckResp, response *core.DetailedResponse, err error) {
+	mock.called("GetBrowserCheckWithContext", ctx, getBrowserCheckOptions)
+	if mock.GetBrowserCheckWithContextFunc != nil {
+		return mock.GetBrowserCheckWithContextFunc(ctx, getBrowserCheckOptions)
+	}
+	if mock.GetBrowserCheckFunc != nil {
+		return mock.GetBrowserCheckFunc(getBrowserCheckOptions)
+	}
+	err = notProgrammed("GetBrowserCheckWithContext")
+	return
+}
+
+// UpdateBrowserCheck invokes the programmed UpdateBrowserCheckFunc.
+func (mock *ZonesSettingsV1) UpdateBrowserCheck(updateBrowserCheckOptions *zonessettingsv1.UpdateBrowserCheckOptions) (result *zonessettingsv1.BrowserCheckResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateBrowserCheck", updateBrowserCheckOptions)
+	if mock.UpdateBrowserCheckFunc != nil {
+		return mock.UpdateBrowserCheckFunc(updateBrowserCheckOptions)
+	}
+	if mock.UpdateBrowserCheckWithContextFunc != nil {
+		return mock.UpdateBrowserCheckWithContextFunc(context.Background(), updateBrowserCheckOptions)
+	}
+	err = notProgrammed("UpdateBrowserCheck")
+	return
+}
+
+// UpdateBrowserCheckWithContext invokes the programmed UpdateBrowserCheckWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateBrowserCheckWithContext(ctx context.Context, updateBrowserCheckOptions *zonessettingsv1.UpdateBrowserCheckOptions) (result *zonessettingsv1.BrowserCheckResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateBrowserCheckWithContext", ctx, updateBrowserCheckOptions)
+	if mock.UpdateBrowserCheckWithContextFunc != nil {
+		return mock.UpdateBrowserCheckWithContextFunc(ctx, updateBrowserCheckOptions)
+	}
+	if mock.UpdateBrowserCheckFunc != nil {
+		return mock.UpdateBrowserCheckFunc(updateBrowserCheckOptions)
+	}
+	err = notProgrammed("UpdateBrowserCheckWithContext")
+	return
+}
+
+// GetEnableErrorPagesOn invokes the programmed GetEnableErrorPagesOnFunc.
+func (mock *ZonesSettingsV1) GetEnableErrorPagesOn(getEnableErrorPagesOnOptions *zonessettingsv1.GetEnableErrorPagesOnOptions) (result *zonessettingsv1.OriginErrorPagePassThruResp, response *core.DetailedResponse, err error) {
+	mock.called("GetEnableErrorPagesOn", getEnableErrorPagesOnOptions)
+	if mock.GetEnableErrorPagesOnFunc != nil {
+		return mock.GetEnableErrorPagesOnFunc(getEnableErrorPagesOnOptions)
+	}
+	if mock.GetEnableErrorPagesOnWithContextFunc != nil {
+		return mock.GetEnableErrorPagesOnWithContextFunc(context.Background(), getEnableErrorPagesOnOptions)
+	}
+	err = notProgrammed("GetEnableErrorPagesOn")
+	return
+}
+
+// GetEnableErrorPagesOnWithContext invokes the programmed GetEnableErrorPagesOnWithContextFunc.
+func (mock *ZonesSettingsV1) GetEnableErrorPagesOnWithContext(ctx context.Context, getEnableErrorPagesOnOptions *zonessettingsv1.GetEnableErrorPagesOnOptions) (result *zonessettingsv1.OriginErrorPagePassThruResp, response *core.DetailedResponse, err error) {
+	mock.called("GetEnableErrorPagesOnWithContext", ctx, getEnableErrorPagesOnOptions)
+	if mock.GetEnableErrorPagesOnWithContextFunc != nil {
+		return mock.GetEnableErrorPagesOnWithContextFunc(ctx, getEnableErrorPagesOnOptions)
+	}
+	if mock.GetEnableErrorPagesOnFunc != nil {
+		return mock.GetEnableErrorPagesOnFunc(getEnableErrorPagesOnOptions)
+	}
+	err = notProgrammed("GetEnableErrorPagesOnWithContext")
+	return
+}
+
+// UpdateEnableErrorPagesOn invokes the programmed UpdateEnableErrorPagesOnFunc.
+func (mock *ZonesSettingsV1) UpdateEnableErrorPagesOn(updateEnableErrorPagesOnOptions *zonessettingsv1.UpdateEnableErrorPagesOnOptions) (result *zonessettingsv1.OriginErrorPagePassThruResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateEnableErrorPagesOn", updateEnableErrorPagesOnOptions)
+	if mock.UpdateEnableErrorPagesOnFunc != nil {
+		return mock.UpdateEnableErrorPagesOnFunc(updateEnableErrorPagesOnOptions)
+	}
+	if mock.UpdateEnableErrorPagesOnWithContextFunc != nil {
+		return mock.UpdateEnableErrorPagesOnWithContextFunc(context.Background(), updateEnableErrorPagesOnOptions)
+	}
+	err = notProgrammed("UpdateEnableErrorPagesOn")
+	return
+}
+
+// UpdateEnableErrorPagesOnWithContext invokes the programmed UpdateEnableErrorPagesOnWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateEnableErrorPagesOnWithContext(ctx context.Context, updateEnableErrorPagesOnOptions *zonessettingsv1.UpdateEnableErrorPagesOnOptions) (result *zonessettingsv1.OriginErrorPagePassThruResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateEnableErrorPagesOnWithContext", ctx, updateEnableErrorPagesOnOptions)
+	if mock.UpdateEnableErrorPagesOnWithContextFunc != nil {
+		return mock.UpdateEnableErrorPagesOnWithContextFunc(ctx, updateEnableErrorPagesOnOptions)
+	}
+	if mock.UpdateEnableErrorPagesOnFunc != nil {
+		return mock.UpdateEnableErrorPagesOnFunc(updateEnableErrorPagesOnOptions)
+	}
+	err = notProgrammed("UpdateEnableErrorPagesOnWithContext")
+	return
+}
+
+// GetWebApplicationFirewall invokes the programmed GetWebApplicationFirewallFunc.
+func (mock *ZonesSettingsV1) GetWebApplicationFirewall(getWebApplicationFirewallOptions *zonessettingsv1.GetWebApplicationFirewallOptions) (result *zonessettingsv1.WafResp, response *core.DetailedResponse, err error) {
+	mock.called("GetWebApplicationFirewall", getWebApplicationFirewallOptions)
+	if mock.GetWebApplicationFirewallFunc != nil {
+		return mock.GetWebApplicationFirewallFunc(getWebApplicationFirewallOptions)
+	}
+	if mock.GetWebApplicationFirewallWithContextFunc != nil {
+		return mock.GetWebApplicationFirewallWithContextFunc(context.Background(), getWebApplicationFirewallOptions)
+	}
+	err = notProgrammed("GetWebApplicationFirewall")
+	return
+}
+
+// GetWebApplicationFirewallWithContext invokes the programmed GetWebApplicationFirewallWithContextFunc.
+func (mock *ZonesSettingsV1) GetWebApplicationFirewallWithContext(ctx context.Context, getWebApplicationFirewallOptions *zonessettingsv1.GetWebApplicationFirewallOptions) (result *zonessettingsv1.WafResp, response *core.DetailedResponse, err error) {
+	mock.called("GetWebApplicationFirewallWithContext", ctx, getWebApplicationFirewallOptions)
+	if mock.GetWebApplicationFirewallWithContextFunc != nil {
+		return mock.GetWebApplicationFirewallWithContextFunc(ctx, getWebApplicationFirewallOptions)
+	}
+	if mock.GetWebApplicationFirewallFunc != nil {
+		return mock.GetWebApplicationFirewallFunc(getWebApplicationFirewallOptions)
+	}
+	err = notProgrammed("GetWebApplicationFirewallWithContext")
+	return
+}
+
+// UpdateWebApplicationFirewall invokes the programmed UpdateWebApplicationFirewallFunc.
+func (mock *ZonesSettingsV1) UpdateWebApplicationFirewall(updateWebApplicationFirewallOptions *zonessettingsv1.UpdateWebApplicationFirewallOptions) (result *zonessettingsv1.WafResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWebApplicationFirewall", updateWebApplicationFirewallOptions)
+	if mock.UpdateWebApplicationFirewallFunc != nil {
+		return mock.UpdateWebApplicationFirewallFunc(updateWebApplicationFirewallOptions)
+	}
+	if mock.UpdateWebApplicationFirewallWithContextFunc != nil {
+		return mock.UpdateWebApplicationFirewallWithContextFunc(context.Background(), updateWebApplicationFirewallOptions)
+	}
+	err = notProgrammed("UpdateWebApplicationFirewall")
+	return
+}
+
+// UpdateWebApplicationFirewallWithContext invokes the programmed UpdateWebApplicationFirewallWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateWebApplicationFirewallWithContext(ctx context.Context, updateWebApplicationFirewallOptions *zonessettingsv1.UpdateWebApplicationFirewallOptions) (result *zonessettingsv1.WafResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateWebApplicationFirewallWithContext", ctx, updateWebApplicationFirewallOptions)
+	if mock.UpdateWebApplicationFirewallWithContextFunc != nil {
+		return mock.UpdateWebApplicationFirewallWithContextFunc(ctx, updateWebApplicationFirewallOptions)
+	}
+	if mock.UpdateWebApplicationFirewallFunc != nil {
+		return mock.UpdateWebApplicationFirewallFunc(updateWebApplicationFirewallOptions)
+	}
+	err = notProgrammed("UpdateWebApplicationFirewallWithContext")
+	return
+}
+
+// GetCiphers invokes the programmed GetCiphersFunc.
+func (mock *ZonesSettingsV1) GetCiphers(getCiphersOptions *zonessettingsv1.GetCiphersOptions) (result *zonessettingsv1.CiphersResp, response *core.DetailedResponse, err error) {
+	mock.called("GetCiphers", getCiphersOptions)
+	if mock.GetCiphersFunc != nil {
+		return mock.GetCiphersFunc(getCiphersOptions)
+	}
+	if mock.GetCiphersWithContextFunc != nil {
+		return mock.GetCiphersWithContextFunc(context.Background(), getCiphersOptions)
+	}
+	err = notProgrammed("GetCiphers")
+	return
+}
+
+// GetCiphersWithContext invokes the programmed GetCiphersWithContextFunc.
+func (mock *ZonesSettingsV1) GetCiphersWithContext(ctx context.Context, getCiphersOptions *zonessettingsv1.GetCiphersOptions) (result *zonessettingsv1.CiphersResp, response *core.DetailedResponse, err error) {
+	mock.called("GetCiphersWithContext", ctx, getCiphersOptions)
+	if mock.GetCiphersWithContextFunc != nil {
+		return mock.GetCiphersWithContextFunc(ctx, getCiphersOptions)
+	}
+	if mock.GetCiphersFunc != nil {
+		return mock.GetCiphersFunc(getCiphersOptions)
+	}
+	err = notProgrammed("GetCiphersWithContext")
+	return
+}
+
+// UpdateCiphers invokes the programmed UpdateCiphersFunc.
+func (mock *ZonesSettingsV1) UpdateCiphers(updateCiphersOptions *zonessettingsv1.UpdateCiphersOptions) (result *zonessettingsv1.CiphersResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCiphers", updateCiphersOptions)
+	if mock.UpdateCiphersFunc != nil {
+		return mock.UpdateCiphersFunc(updateCiphersOptions)
+	}
+	if mock.UpdateCiphersWithContextFunc != nil {
+		return mock.UpdateCiphersWithContextFunc(context.Background(), updateCiphersOptions)
+	}
+	err = notProgrammed("UpdateCiphers")
+	return
+}
+
+// UpdateCiphersWithContext invokes the programmed UpdateCiphersWithContextFunc.
+func (mock *ZonesSettingsV1) UpdateCiphersWithContext(ctx context.Context, updateCiphersOptions *zonessettingsv1.UpdateCiphersOptions) (result *zonessettingsv1.CiphersResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateCiphersWithContext", ctx, updateCiphersOptions)
+	if mock.UpdateCiphersWithContextFunc != nil {
+		return mock.UpdateCiphersWithContextFunc(ctx, updateCiphersOptions)
+	}
+	if mock.UpdateCiphersFunc != nil {
+		return mock.UpdateCiphersFunc(updateCiphersOptions)
+	}
+	err = notProgrammed("UpdateCiphersWithContext")
+	return
+}
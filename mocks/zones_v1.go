@@ -0,0 +1,202 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+)
+
+// ZonesV1 is a mock of zonesv1.ZonesV1API. Program an operation by setting its function; an operation
+// whose plain or WithContext variant is programmed can be invoked through either variant.
+type ZonesV1 struct {
+	Mock
+
+	ListZonesFunc                      func(*zonesv1.ListZonesOptions) (*zonesv1.ListZonesResp, *core.DetailedResponse, error)
+	ListZonesWithContextFunc           func(context.Context, *zonesv1.ListZonesOptions) (*zonesv1.ListZonesResp, *core.DetailedResponse, error)
+	CreateZoneFunc                     func(*zonesv1.CreateZoneOptions) (*zonesv1.ZoneResp, *core.DetailedResponse, error)
+	CreateZoneWithContextFunc          func(context.Context, *zonesv1.CreateZoneOptions) (*zonesv1.ZoneResp, *core.DetailedResponse, error)
+	DeleteZoneFunc                     func(*zonesv1.DeleteZoneOptions) (*zonesv1.DeleteZoneResp, *core.DetailedResponse, error)
+	DeleteZoneWithContextFunc          func(context.Context, *zonesv1.DeleteZoneOptions) (*zonesv1.DeleteZoneResp, *core.DetailedResponse, error)
+	GetZoneFunc                        func(*zonesv1.GetZoneOptions) (*zonesv1.ZoneResp, *core.DetailedResponse, error)
+	GetZoneWithContextFunc             func(context.Context, *zonesv1.GetZoneOptions) (*zonesv1.ZoneResp, *core.DetailedResponse, error)
+	UpdateZoneFunc                     func(*zonesv1.UpdateZoneOptions) (*zonesv1.ZoneResp, *core.DetailedResponse, error)
+	UpdateZoneWithContextFunc          func(context.Context, *zonesv1.UpdateZoneOptions) (*zonesv1.ZoneResp, *core.DetailedResponse, error)
+	ZoneActivationCheckFunc            func(*zonesv1.ZoneActivationCheckOptions) (*zonesv1.ZoneActivationcheckResp, *core.DetailedResponse, error)
+	ZoneActivationCheckWithContextFunc func(context.Context, *zonesv1.ZoneActivationCheckOptions) (*zonesv1.ZoneActivationcheckResp, *core.DetailedResponse, error)
+}
+
+// ZonesV1 implements zonesv1.ZonesV1API.
+var _ zonesv1.ZonesV1API = (*ZonesV1)(nil)
+
+// ListZones invokes the programmed ListZonesFunc.
+func (mock *ZonesV1) ListZones(listZonesOptions *zonesv1.ListZonesOptions) (result *zonesv1.ListZonesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListZones", listZonesOptions)
+	if mock.ListZonesFunc != nil {
+		return mock.ListZonesFunc(listZonesOptions)
+	}
+	if mock.ListZonesWithContextFunc != nil {
+		return mock.ListZonesWithContextFunc(context.Background(), listZonesOptions)
+	}
+	err = notProgrammed("ListZones")
+	return
+}
+
+// ListZonesWithContext invokes the programmed ListZonesWithContextFunc.
+func (mock *ZonesV1) ListZonesWithContext(ctx context.Context, listZonesOptions *zonesv1.ListZonesOptions) (result *zonesv1.ListZonesResp, response *core.DetailedResponse, err error) {
+	mock.called("ListZonesWithContext", ctx, listZonesOptions)
+	if mock.ListZonesWithContextFunc != nil {
+		return mock.ListZonesWithContextFunc(ctx, listZonesOptions)
+	}
+	if mock.ListZonesFunc != nil {
+		return mock.ListZonesFunc(listZonesOptions)
+	}
+	err = notProgrammed("ListZonesWithContext")
+	return
+}
+
+// CreateZone invokes the programmed CreateZoneFunc.
+func (mock *ZonesV1) CreateZone(createZoneOptions *zonesv1.CreateZoneOptions) (result *zonesv1.ZoneResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateZone", createZoneOptions)
+	if mock.CreateZoneFunc != nil {
+		return mock.CreateZoneFunc(createZoneOptions)
+	}
+	if mock.CreateZoneWithContextFunc != nil {
+		return mock.CreateZoneWithContextFunc(context.Background(), createZoneOptions)
+	}
+	err = notProgrammed("CreateZone")
+	return
+}
+
+// CreateZoneWithContext invokes the programmed CreateZoneWithContextFunc.
+func (mock *ZonesV1) CreateZoneWithContext(ctx context.Context, createZoneOptions *zonesv1.CreateZoneOptions) (result *zonesv1.ZoneResp, response *core.DetailedResponse, err error) {
+	mock.called("CreateZoneWithContext", ctx, createZoneOptions)
+	if mock.CreateZoneWithContextFunc != nil {
+		return mock.CreateZoneWithContextFunc(ctx, createZoneOptions)
+	}
+	if mock.CreateZoneFunc != nil {
+		return mock.CreateZoneFunc(createZoneOptions)
+	}
+	err = notProgrammed("CreateZoneWithContext")
+	return
+}
+
+// DeleteZone invokes the programmed DeleteZoneFunc.
+func (mock *ZonesV1) DeleteZone(deleteZoneOptions *zonesv1.DeleteZoneOptions) (result *zonesv1.DeleteZoneResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZone", deleteZoneOptions)
+	if mock.DeleteZoneFunc != nil {
+		return mock.DeleteZoneFunc(deleteZoneOptions)
+	}
+	if mock.DeleteZoneWithContextFunc != nil {
+		return mock.DeleteZoneWithContextFunc(context.Background(), deleteZoneOptions)
+	}
+	err = notProgrammed("DeleteZone")
+	return
+}
+
+// DeleteZoneWithContext invokes the programmed DeleteZoneWithContextFunc.
+func (mock *ZonesV1) DeleteZoneWithContext(ctx context.Context, deleteZoneOptions *zonesv1.DeleteZoneOptions) (result *zonesv1.DeleteZoneResp, response *core.DetailedResponse, err error) {
+	mock.called("DeleteZoneWithContext", ctx, deleteZoneOptions)
+	if mock.DeleteZoneWithContextFunc != nil {
+		return mock.DeleteZoneWithContextFunc(ctx, deleteZoneOptions)
+	}
+	if mock.DeleteZoneFunc != nil {
+		return mock.DeleteZoneFunc(deleteZoneOptions)
+	}
+	err = notProgrammed("DeleteZoneWithContext")
+	return
+}
+
+// GetZone invokes the programmed GetZoneFunc.
+func (mock *ZonesV1) GetZone(getZoneOptions *zonesv1.GetZoneOptions) (result *zonesv1.ZoneResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZone", getZoneOptions)
+	if mock.GetZoneFunc != nil {
+		return mock.GetZoneFunc(getZoneOptions)
+	}
+	if mock.GetZoneWithContextFunc != nil {
+		return mock.GetZoneWithContextFunc(context.Background(), getZoneOptions)
+	}
+	err = notProgrammed("GetZone")
+	return
+}
+
+// GetZoneWithContext invokes the programmed GetZoneWithContextFunc.
+func (mock *ZonesV1) GetZoneWithContext(ctx context.Context, getZoneOptions *zonesv1.GetZoneOptions) (result *zonesv1.ZoneResp, response *core.DetailedResponse, err error) {
+	mock.called("GetZoneWithContext", ctx, getZoneOptions)
+	if mock.GetZoneWithContextFunc != nil {
+		return mock.GetZoneWithContextFunc(ctx, getZoneOptions)
+	}
+	if mock.GetZoneFunc != nil {
+		return mock.GetZoneFunc(getZoneOptions)
+	}
+	err = notProgrammed("GetZoneWithContext")
+	return
+}
+
+// UpdateZone invokes the programmed UpdateZoneFunc.
+func (mock *ZonesV1) UpdateZone(updateZoneOptions *zonesv1.UpdateZoneOptions) (result *zonesv1.ZoneResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateZone", updateZoneOptions)
+	if mock.UpdateZoneFunc != nil {
+		return mock.UpdateZoneFunc(updateZoneOptions)
+	}
+	if mock.UpdateZoneWithContextFunc != nil {
+		return mock.UpdateZoneWithContextFunc(context.Background(), updateZoneOptions)
+	}
+	err = notProgrammed("UpdateZone")
+	return
+}
+
+// UpdateZoneWithContext invokes the programmed UpdateZoneWithContextFunc.
+func (mock *ZonesV1) UpdateZoneWithContext(ctx context.Context, updateZoneOptions *zonesv1.UpdateZoneOptions) (result *zonesv1.ZoneResp, response *core.DetailedResponse, err error) {
+	mock.called("UpdateZoneWithContext", ctx, updateZoneOptions)
+	if mock.UpdateZoneWithContextFunc != nil {
+		return mock.UpdateZoneWithContextFunc(ctx, updateZoneOptions)
+	}
+	if mock.UpdateZoneFunc != nil {
+		return mock.UpdateZoneFunc(updateZoneOptions)
+	}
+	err = notProgrammed("UpdateZoneWithContext")
+	return
+}
+
+// ZoneActivationCheck invokes the programmed ZoneActivationCheckFunc.
+func (mock *ZonesV1) ZoneActivationCheck(zoneActivationCheckOptions *zonesv1.ZoneActivationCheckOptions) (result *zonesv1.ZoneActivationcheckResp, response *core.DetailedResponse, err error) {
+	mock.called("ZoneActivationCheck", zoneActivationCheckOptions)
+	if mock.ZoneActivationCheckFunc != nil {
+		return mock.ZoneActivationCheckFunc(zoneActivationCheckOptions)
+	}
+	if mock.ZoneActivationCheckWithContextFunc != nil {
+		return mock.ZoneActivationCheckWithContextFunc(context.Background(), zoneActivationCheckOptions)
+	}
+	err = notProgrammed("ZoneActivationCheck")
+	return
+}
+
+// ZoneActivationCheckWithContext invokes the programmed ZoneActivationCheckWithContextFunc.
+func (mock *ZonesV1) ZoneActivationCheckWithContext(ctx context.Context, zoneActivationCheckOptions *zonesv1.ZoneActivationCheckOptions) (result *zonesv1.ZoneActivationcheckResp, response *core.DetailedResponse, err error) {
+	mock.called("ZoneActivationCheckWithContext", ctx, zoneActivationCheckOptions)
+	if mock.ZoneActivationCheckWithContextFunc != nil {
+		return mock.ZoneActivationCheckWithContextFunc(ctx, zoneActivationCheckOptions)
+	}
+	if mock.ZoneActivationCheckFunc != nil {
+		return mock.ZoneActivationCheckFunc(zoneActivationCheckOptions)
+	}
+	err = notProgrammed("ZoneActivationCheckWithContext")
+	return
+}
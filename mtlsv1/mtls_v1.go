@@ -171,6 +171,24 @@ func (mtls *MtlsV1) DisableRetries() {
 	mtls.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (mtls *MtlsV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(mtls.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (mtls *MtlsV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(mtls.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (mtls *MtlsV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(mtls.Service, middleware...)
+}
+
 // ListAccessCertificates : List access certificates
 // List access certificates.
 func (mtls *MtlsV1) ListAccessCertificates(listAccessCertificatesOptions *ListAccessCertificatesOptions) (result *ListAccessCertsResp, response *core.DetailedResponse, err error) {
@@ -219,6 +237,7 @@ func (mtls *MtlsV1) ListAccessCertificatesWithContext(ctx context.Context, listA
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -296,6 +315,7 @@ func (mtls *MtlsV1) CreateAccessCertificateWithContext(ctx context.Context, crea
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -358,6 +378,7 @@ func (mtls *MtlsV1) GetAccessCertificateWithContext(ctx context.Context, getAcce
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -433,6 +454,7 @@ func (mtls *MtlsV1) UpdateAccessCertificateWithContext(ctx context.Context, upda
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -495,6 +517,7 @@ func (mtls *MtlsV1) DeleteAccessCertificateWithContext(ctx context.Context, dele
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -556,6 +579,7 @@ func (mtls *MtlsV1) ListAccessApplicationsWithContext(ctx context.Context, listA
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -633,6 +657,7 @@ func (mtls *MtlsV1) CreateAccessApplicationWithContext(ctx context.Context, crea
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -695,6 +720,7 @@ func (mtls *MtlsV1) GetAccessApplicationWithContext(ctx context.Context, getAcce
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -773,6 +799,7 @@ func (mtls *MtlsV1) UpdateAccessApplicationWithContext(ctx context.Context, upda
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -835,6 +862,7 @@ func (mtls *MtlsV1) DeleteAccessApplicationWithContext(ctx context.Context, dele
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -897,6 +925,7 @@ func (mtls *MtlsV1) ListAccessPoliciesWithContext(ctx context.Context, listAcces
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -975,6 +1004,7 @@ func (mtls *MtlsV1) CreateAccessPolicyWithContext(ctx context.Context, createAcc
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1038,6 +1068,7 @@ func (mtls *MtlsV1) GetAccessPolicyWithContext(ctx context.Context, getAccessPol
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1117,6 +1148,7 @@ func (mtls *MtlsV1) UpdateAccessPolicyWithContext(ctx context.Context, updateAcc
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1180,6 +1212,7 @@ func (mtls *MtlsV1) DeleteAccessPolicyWithContext(ctx context.Context, deleteAcc
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1241,6 +1274,7 @@ func (mtls *MtlsV1) GetAccessCertSettingsWithContext(ctx context.Context, getAcc
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1312,6 +1346,7 @@ func (mtls *MtlsV1) UpdateAccessCertSettingsWithContext(ctx context.Context, upd
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1381,6 +1416,7 @@ func (mtls *MtlsV1) CreateAccessOrganizationWithContext(ctx context.Context, cre
 	var rawResponse map[string]json.RawMessage
 	response, err = mtls.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,67 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mtlsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MtlsV1API is the interface of the operations of MtlsV1, through which they can be replaced
+// in unit tests, for instance with the mocks.MtlsV1 of the mocks package.
+type MtlsV1API interface {
+	ListAccessCertificates(listAccessCertificatesOptions *ListAccessCertificatesOptions) (result *ListAccessCertsResp, response *core.DetailedResponse, err error)
+	ListAccessCertificatesWithContext(ctx context.Context, listAccessCertificatesOptions *ListAccessCertificatesOptions) (result *ListAccessCertsResp, response *core.DetailedResponse, err error)
+	CreateAccessCertificate(createAccessCertificateOptions *CreateAccessCertificateOptions) (result *AccessCertResp, response *core.DetailedResponse, err error)
+	CreateAccessCertificateWithContext(ctx context.Context, createAccessCertificateOptions *CreateAccessCertificateOptions) (result *AccessCertResp, response *core.DetailedResponse, err error)
+	GetAccessCertificate(getAccessCertificateOptions *GetAccessCertificateOptions) (result *AccessCertResp, response *core.DetailedResponse, err error)
+	GetAccessCertificateWithContext(ctx context.Context, getAccessCertificateOptions *GetAccessCertificateOptions) (result *AccessCertResp, response *core.DetailedResponse, err error)
+	UpdateAccessCertificate(updateAccessCertificateOptions *UpdateAccessCertificateOptions) (result *AccessCertResp, response *core.DetailedResponse, err error)
+	UpdateAccessCertificateWithContext(ctx context.Context, updateAccessCertificateOptions *UpdateAccessCertificateOptions) (result *AccessCertResp, response *core.DetailedResponse, err error)
+	DeleteAccessCertificate(deleteAccessCertificateOptions *DeleteAccessCertificateOptions) (result *DeleteAccessCertResp, response *core.DetailedResponse, err error)
+	DeleteAccessCertificateWithContext(ctx context.Context, deleteAccessCertificateOptions *DeleteAccessCertificateOptions) (result *DeleteAccessCertResp, response *core.DetailedResponse, err error)
+	ListAccessApplications(listAccessApplicationsOptions *ListAccessApplicationsOptions) (result *ListAccessAppsResp, response *core.DetailedResponse, err error)
+	ListAccessApplicationsWithContext(ctx context.Context, listAccessApplicationsOptions *ListAccessApplicationsOptions) (result *ListAccessAppsResp, response *core.DetailedResponse, err error)
+	CreateAccessApplication(createAccessApplicationOptions *CreateAccessApplicationOptions) (result *CreateAccessAppResp, response *core.DetailedResponse, err error)
+	CreateAccessApplicationWithContext(ctx context.Context, createAccessApplicationOptions *CreateAccessApplicationOptions) (result *CreateAccessAppResp, response *core.DetailedResponse, err error)
+	GetAccessApplication(getAccessApplicationOptions *GetAccessApplicationOptions) (result *AccessAppResp, response *core.DetailedResponse, err error)
+	GetAccessApplicationWithContext(ctx context.Context, getAccessApplicationOptions *GetAccessApplicationOptions) (result *AccessAppResp, response *core.DetailedResponse, err error)
+	UpdateAccessApplication(updateAccessApplicationOptions *UpdateAccessApplicationOptions) (result *AccessAppResp, response *core.DetailedResponse, err error)
+	UpdateAccessApplicationWithContext(ctx context.Context, updateAccessApplicationOptions *UpdateAccessApplicationOptions) (result *AccessAppResp, response *core.DetailedResponse, err error)
+	DeleteAccessApplication(deleteAccessApplicationOptions *DeleteAccessApplicationOptions) (result *DeleteAccessAppResp, response *core.DetailedResponse, err error)
+	DeleteAccessApplicationWithContext(ctx context.Context, deleteAccessApplicationOptions *DeleteAccessApplicationOptions) (result *DeleteAccessAppResp, response *core.DetailedResponse, err error)
+	ListAccessPolicies(listAccessPoliciesOptions *ListAccessPoliciesOptions) (result *ListAccessPoliciesResp, response *core.DetailedResponse, err error)
+	ListAccessPoliciesWithContext(ctx context.Context, listAccessPoliciesOptions *ListAccessPoliciesOptions) (result *ListAccessPoliciesResp, response *core.DetailedResponse, err error)
+	CreateAccessPolicy(createAccessPolicyOptions *CreateAccessPolicyOptions) (result *AccessPolicyResp, response *core.DetailedResponse, err error)
+	CreateAccessPolicyWithContext(ctx context.Context, createAccessPolicyOptions *CreateAccessPolicyOptions) (result *AccessPolicyResp, response *core.DetailedResponse, err error)
+	GetAccessPolicy(getAccessPolicyOptions *GetAccessPolicyOptions) (result *AccessPolicyResp, response *core.DetailedResponse, err error)
+	GetAccessPolicyWithContext(ctx context.Context, getAccessPolicyOptions *GetAccessPolicyOptions) (result *AccessPolicyResp, response *core.DetailedResponse, err error)
+	UpdateAccessPolicy(updateAccessPolicyOptions *UpdateAccessPolicyOptions) (result *AccessPolicyResp, response *core.DetailedResponse, err error)
+	UpdateAccessPolicyWithContext(ctx context.Context, updateAccessPolicyOptions *UpdateAccessPolicyOptions) (result *AccessPolicyResp, response *core.DetailedResponse, err error)
+	DeleteAccessPolicy(deleteAccessPolicyOptions *DeleteAccessPolicyOptions) (result *DeleteAccessPolicyResp, response *core.DetailedResponse, err error)
+	DeleteAccessPolicyWithContext(ctx context.Context, deleteAccessPolicyOptions *DeleteAccessPolicyOptions) (result *DeleteAccessPolicyResp, response *core.DetailedResponse, err error)
+	GetAccessCertSettings(getAccessCertSettingsOptions *GetAccessCertSettingsOptions) (result *AccessCertSettingsResp, response *core.DetailedResponse, err error)
+	GetAccessCertSettingsWithContext(ctx context.Context, getAccessCertSettingsOptions *GetAccessCertSettingsOptions) (result *AccessCertSettingsResp, response *core.DetailedResponse, err error)
+	UpdateAccessCertSettings(updateAccessCertSettingsOptions *UpdateAccessCertSettingsOptions) (result *AccessCertSettingsResp, response *core.DetailedResponse, err error)
+	UpdateAccessCertSettingsWithContext(ctx context.Context, updateAccessCertSettingsOptions *UpdateAccessCertSettingsOptions) (result *AccessCertSettingsResp, response *core.DetailedResponse, err error)
+	CreateAccessOrganization(createAccessOrganizationOptions *CreateAccessOrganizationOptions) (result *AccessOrgResp, response *core.DetailedResponse, err error)
+	CreateAccessOrganizationWithContext(ctx context.Context, createAccessOrganizationOptions *CreateAccessOrganizationOptions) (result *AccessOrgResp, response *core.DetailedResponse, err error)
+}
+
+// MtlsV1 implements MtlsV1API.
+var _ MtlsV1API = (*MtlsV1)(nil)
@@ -0,0 +1,253 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package networking provides a Client that builds the clients of all the services of the SDK from
+// a single configuration.
+package networking
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+)
+
+// DefaultServiceName is the default key used to find the external configuration of the authenticator.
+const DefaultServiceName = "networking"
+
+// The endpoints of the services, which can be set in the Endpoints of a Config.
+const (
+	// CisEndpoint is the endpoint of the Cloud Internet Services APIs.
+	CisEndpoint = "cis"
+
+	// DnsSvcsEndpoint is the endpoint of the DNS Services APIs.
+	DnsSvcsEndpoint = "dns_svcs"
+
+	// TransitGatewayEndpoint is the endpoint of the Transit Gateway API.
+	TransitGatewayEndpoint = "transit_gateway"
+
+	// DirectLinkEndpoint is the endpoint of the Direct Link API.
+	DirectLinkEndpoint = "direct_link"
+
+	// DirectLinkProviderEndpoint is the endpoint of the Direct Link Provider API.
+	DirectLinkProviderEndpoint = "direct_link_provider"
+)
+
+// publicURLs are the URLs of the public endpoints.
+var publicURLs = map[string]string{
+	CisEndpoint:                "https://api.cis.cloud.ibm.com",
+	DnsSvcsEndpoint:            "https://api.dns-svcs.cloud.ibm.com/v1",
+	TransitGatewayEndpoint:     "https://transit.cloud.ibm.com/v1",
+	DirectLinkEndpoint:         "https://directlink.cloud.ibm.com/v1",
+	DirectLinkProviderEndpoint: "https://directlink.cloud.ibm.com/provider/v2",
+}
+
+// privateURLs are the URLs of the private endpoints.
+var privateURLs = map[string]string{
+	CisEndpoint:                "https://api.private.cis.cloud.ibm.com",
+	DnsSvcsEndpoint:            "https://api.private.dns-svcs.cloud.ibm.com/v1",
+	TransitGatewayEndpoint:     "https://private.transit.cloud.ibm.com/v1",
+	DirectLinkEndpoint:         "https://private.directlink.cloud.ibm.com/v1",
+	DirectLinkProviderEndpoint: "https://private.directlink.cloud.ibm.com/provider/v2",
+}
+
+// Config is the configuration shared by the service clients of a Client.
+type Config struct {
+	// The authenticator of the requests. If nil, it is loaded from the external configuration
+	// of ServiceName when the first service client is built.
+	Authenticator core.Authenticator
+
+	// The key used to find the external configuration of the authenticator. Defaults to DefaultServiceName.
+	ServiceName string
+
+	// The CRN of the Cloud Internet Services instance, required by the CIS service clients.
+	Crn string
+
+	// The identifier of the zone, required by the CIS service clients scoped to a zone.
+	ZoneID string
+
+	// The version date (e.g. "2022-01-01") of the Transit Gateway and Direct Link APIs.
+	// Defaults to the current date.
+	Version string
+
+	// Whether to use the private endpoints of the services, which are reachable from the IBM Cloud
+	// private network only.
+	PrivateEndpoint bool
+
+	// The URLs of the endpoints, keyed by endpoint (e.g. CisEndpoint) or by the DefaultServiceName of
+	// a service package (e.g. "dns_records"), overriding the public and private URLs. The services
+	// have no regional endpoints; set the URLs here to reach another region or a test server.
+	Endpoints map[string]string
+
+	// The HTTP client of the service clients. If nil, each service client has its own default client.
+	HTTPClient *http.Client
+
+	// The default retry policy of the service clients. If nil, requests are not retried.
+	RetryPolicy *common.RetryPolicy
+
+	// The middleware that the requests of the service clients pass through, along with the global
+	// middleware. The middleware is enabled on every service client, even if this is empty.
+	Middleware []common.Middleware
+}
+
+// Client builds the service clients from a Config. A service client is built the first time it is
+// asked for, and then reused. Use WithCrn and WithZone to derive a Client scoped to another
+// instance or zone; the service clients that are not scoped to one are shared with it.
+//
+// A Client is safe for concurrent use.
+type Client struct {
+	config Config
+	auth   *authenticator
+
+	// The service clients scoped to the account, the instance, and the zone.
+	account  *cache
+	instance *cache
+	zone     *cache
+}
+
+// NewClient returns a Client that builds service clients from the given configuration.
+func NewClient(config *Config) *Client {
+	client := &Client{
+		auth:     &authenticator{},
+		account:  newCache(),
+		instance: newCache(),
+		zone:     newCache(),
+	}
+	if config != nil {
+		client.config = *config
+	}
+	if client.config.ServiceName == "" {
+		client.config.ServiceName = DefaultServiceName
+	}
+	if client.config.Version == "" {
+		client.config.Version = time.Now().UTC().Format("2006-01-02")
+	}
+	return client
+}
+
+// Config returns a copy of the configuration of the client.
+func (client *Client) Config() Config {
+	return client.config
+}
+
+// WithCrn returns a Client that shares the configuration and the service clients scoped to the account
+// with this one, for the CIS instance with the given CRN.
+func (client *Client) WithCrn(crn string) *Client {
+	derived := *client
+	derived.config.Crn = crn
+	derived.instance = newCache()
+	derived.zone = newCache()
+	return &derived
+}
+
+// WithZone returns a Client that shares the configuration and the service clients scoped to the account
+// and to the instance with this one, for the zone with the given identifier.
+func (client *Client) WithZone(zoneID string) *Client {
+	derived := *client
+	derived.config.ZoneID = zoneID
+	derived.zone = newCache()
+	return &derived
+}
+
+// serviceURL returns the URL of the service with the given name, which is reached through an endpoint.
+func (client *Client) serviceURL(endpoint string, serviceName string) string {
+	if url, ok := client.config.Endpoints[serviceName]; ok {
+		return url
+	}
+	if url, ok := client.config.Endpoints[endpoint]; ok {
+		return url
+	}
+	if client.config.PrivateEndpoint {
+		return privateURLs[endpoint]
+	}
+	return publicURLs[endpoint]
+}
+
+// authenticator returns the authenticator of the service clients.
+func (client *Client) authenticator() (core.Authenticator, error) {
+	if client.config.Authenticator != nil {
+		return client.config.Authenticator, nil
+	}
+	return client.auth.load(client.config.ServiceName)
+}
+
+// configure applies the HTTP client, the retry policy and the middleware of the configuration to a service.
+// The middleware is installed last, so that it runs once for all the attempts of a request.
+func (client *Client) configure(service *core.BaseService) {
+	if client.config.HTTPClient != nil {
+		service.SetHTTPClient(client.config.HTTPClient)
+	}
+	if client.config.RetryPolicy != nil {
+		common.EnableRetryPolicies(service, client.config.RetryPolicy)
+	}
+	common.EnableMiddleware(service, client.config.Middleware...)
+}
+
+// stringPtr returns a pointer to s, or nil if s is empty, so that the validation of the service
+// options reports a missing value.
+func stringPtr(s string) *string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	return &s
+}
+
+// authenticator loads the authenticator from the external configuration once.
+type authenticator struct {
+	once          sync.Once
+	authenticator core.Authenticator
+	err           error
+}
+
+func (auth *authenticator) load(serviceName string) (core.Authenticator, error) {
+	auth.once.Do(func() {
+		auth.authenticator, auth.err = core.GetAuthenticatorFromEnvironment(serviceName)
+		if auth.err == nil && auth.authenticator == nil {
+			auth.err = fmt.Errorf("no authenticator is configured for %s", serviceName)
+		}
+	})
+	return auth.authenticator, auth.err
+}
+
+// cache holds service clients by name.
+type cache struct {
+	mutex    sync.Mutex
+	services map[string]interface{}
+}
+
+func newCache() *cache {
+	return &cache{services: make(map[string]interface{})}
+}
+
+// get returns the service client with the given name, building it if it hasn't been built yet.
+// A service client that fails to build is not kept, so that it is built again the next time.
+func (c *cache) get(name string, build func() (interface{}, error)) (interface{}, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if service, ok := c.services[name]; ok {
+		return service, nil
+	}
+	service, err := build()
+	if err != nil {
+		return nil, err
+	}
+	c.services[name] = service
+	return service, nil
+}
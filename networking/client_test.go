@@ -0,0 +1,133 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package networking
+
+import (
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/fakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCrn = "crn:v1:bluemix:public:internet-svcs:global:a/fake-account:instance-1::"
+
+func TestClientServices(t *testing.T) {
+	cis := httptest.NewServer(fakes.NewCisServer())
+	defer cis.Close()
+	transit := httptest.NewServer(fakes.NewTransitGatewayServer())
+	defer transit.Close()
+	var operations []string
+	client := NewClient(&Config{
+		Middleware: []common.Middleware{common.MetricsMiddleware(func(metrics *common.RequestMetrics) {
+			operations = append(operations, metrics.OperationId)
+		})},
+		Authenticator: &core.NoAuthAuthenticator{},
+		Crn:           testCrn,
+		Version:       "2022-01-01",
+		Endpoints:     map[string]string{CisEndpoint: cis.URL, TransitGatewayEndpoint: transit.URL},
+	})
+
+	zones, err := client.ZonesV1()
+	require.Nil(t, err)
+	again, err := client.ZonesV1()
+	require.Nil(t, err)
+	assert.Same(t, zones, again)
+	zone, _, err := zones.CreateZone(zones.NewCreateZoneOptions().SetName("example.com"))
+	require.Nil(t, err)
+
+	_, err = client.DnsRecordsV1()
+	assert.NotNil(t, err)
+	scoped := client.WithZone(*zone.Result.ID)
+	records, err := scoped.DnsRecordsV1()
+	require.Nil(t, err)
+	assert.Equal(t, *zone.Result.ID, *records.ZoneIdentifier)
+	_, _, err = records.CreateDnsRecord(records.NewCreateDnsRecordOptions().SetType("A").SetName("www").SetContent("10.0.0.1"))
+	require.Nil(t, err)
+	list, _, err := records.ListAllDnsRecords(records.NewListAllDnsRecordsOptions())
+	require.Nil(t, err)
+	assert.Len(t, list.Result, 1)
+
+	// The derived client shares the clients that aren't scoped to the zone.
+	scopedZones, err := scoped.ZonesV1()
+	require.Nil(t, err)
+	assert.Same(t, zones, scopedZones)
+	other, err := client.WithCrn("crn:v1:bluemix:public:internet-svcs:global:a/fake-account:instance-2::").ZonesV1()
+	require.Nil(t, err)
+	assert.NotSame(t, zones, other)
+
+	gateways, err := scoped.TransitGatewayApisV1()
+	require.Nil(t, err)
+	assert.Equal(t, "2022-01-01", *gateways.Version)
+	_, _, err = gateways.CreateTransitGateway(gateways.NewCreateTransitGatewayOptions("us-south", "gateway-1"))
+	require.Nil(t, err)
+	otherGateways, err := client.WithCrn("").TransitGatewayApisV1()
+	require.Nil(t, err)
+	assert.Same(t, gateways, otherGateways)
+
+	assert.Equal(t, []string{"CreateZone", "CreateDnsRecord", "ListAllDnsRecords", "CreateTransitGateway"}, operations)
+
+	logpush, err := scoped.LogpushJobsApiV1("http_requests")
+	require.Nil(t, err)
+	assert.Equal(t, "http_requests", *logpush.Dataset)
+}
+
+func TestClientConfig(t *testing.T) {
+	client := NewClient(&Config{Authenticator: &core.NoAuthAuthenticator{}, Crn: testCrn, ZoneID: "zone-1"})
+	assert.Equal(t, DefaultServiceName, client.Config().ServiceName)
+	assert.NotEmpty(t, client.Config().Version)
+
+	records, err := client.DnsRecordsV1()
+	require.Nil(t, err)
+	assert.Equal(t, "https://api.cis.cloud.ibm.com", records.Service.GetServiceURL())
+	dnsSvcs, err := client.DnsSvcsV1()
+	require.Nil(t, err)
+	assert.Equal(t, "https://api.dns-svcs.cloud.ibm.com/v1", dnsSvcs.Service.GetServiceURL())
+
+	client = NewClient(&Config{
+		Authenticator:   &core.NoAuthAuthenticator{},
+		PrivateEndpoint: true,
+		Endpoints:       map[string]string{"direct_link": "https://example.com/v1"},
+		RetryPolicy:     &common.RetryPolicy{MaxRetries: 2},
+	})
+	gateways, err := client.TransitGatewayApisV1()
+	require.Nil(t, err)
+	assert.Equal(t, "https://private.transit.cloud.ibm.com/v1", gateways.Service.GetServiceURL())
+	require.IsType(t, &common.MiddlewareTransport{}, gateways.Service.Client.Transport)
+	assert.IsType(t, &common.RetryTransport{}, gateways.Service.Client.Transport.(*common.MiddlewareTransport).Base)
+	directLink, err := client.DirectLinkV1()
+	require.Nil(t, err)
+	assert.Equal(t, "https://example.com/v1", directLink.Service.GetServiceURL())
+	_, err = client.ZonesV1()
+	assert.NotNil(t, err)
+}
+
+func TestClientExternalAuthenticator(t *testing.T) {
+	os.Setenv("NETWORKING_TEST_AUTH_TYPE", "noauth")
+	defer os.Unsetenv("NETWORKING_TEST_AUTH_TYPE")
+	client := NewClient(&Config{ServiceName: "networking_test"})
+	service, err := client.DnsSvcsV1()
+	require.Nil(t, err)
+	assert.IsType(t, &core.NoAuthAuthenticator{}, service.Service.Options.Authenticator)
+
+	_, err = NewClient(&Config{ServiceName: "networking_missing"}).DnsSvcsV1()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,1129 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package networking
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/alertsv1"
+	"github.com/IBM/networking-go-sdk/authenticatedoriginpullapiv1"
+	"github.com/IBM/networking-go-sdk/cachingapiv1"
+	"github.com/IBM/networking-go-sdk/cisipapiv1"
+	"github.com/IBM/networking-go-sdk/custompagesv1"
+	"github.com/IBM/networking-go-sdk/directlinkproviderv2"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/IBM/networking-go-sdk/dnsrecordbulkv1"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/networking-go-sdk/dnszonesv1"
+	"github.com/IBM/networking-go-sdk/edgefunctionsapiv1"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/IBM/networking-go-sdk/firewallaccessrulesv1"
+	"github.com/IBM/networking-go-sdk/firewallapiv1"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+	"github.com/IBM/networking-go-sdk/globalloadbalancereventsv1"
+	"github.com/IBM/networking-go-sdk/globalloadbalancermonitorv1"
+	"github.com/IBM/networking-go-sdk/globalloadbalancerpoolsv0"
+	"github.com/IBM/networking-go-sdk/globalloadbalancersv1"
+	"github.com/IBM/networking-go-sdk/globalloadbalancerv1"
+	"github.com/IBM/networking-go-sdk/logpushjobsapiv1"
+	"github.com/IBM/networking-go-sdk/mtlsv1"
+	"github.com/IBM/networking-go-sdk/pageruleapiv1"
+	"github.com/IBM/networking-go-sdk/permittednetworksfordnszonesv1"
+	"github.com/IBM/networking-go-sdk/rangeapplicationsv1"
+	"github.com/IBM/networking-go-sdk/resourcerecordsv1"
+	"github.com/IBM/networking-go-sdk/routingv1"
+	"github.com/IBM/networking-go-sdk/securityeventsapiv1"
+	"github.com/IBM/networking-go-sdk/sslcertificateapiv1"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/networking-go-sdk/useragentblockingrulesv1"
+	"github.com/IBM/networking-go-sdk/wafapiv1"
+	"github.com/IBM/networking-go-sdk/wafrulegroupsapiv1"
+	"github.com/IBM/networking-go-sdk/wafrulepackagesapiv1"
+	"github.com/IBM/networking-go-sdk/wafrulesapiv1"
+	"github.com/IBM/networking-go-sdk/webhooksv1"
+	"github.com/IBM/networking-go-sdk/zonefirewallaccessrulesv1"
+	"github.com/IBM/networking-go-sdk/zonelockdownv1"
+	"github.com/IBM/networking-go-sdk/zoneratelimitsv1"
+	"github.com/IBM/networking-go-sdk/zonessettingsv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+)
+
+// AlertsV1 returns the client of the AlertsV1 service for the CIS instance of the configuration.
+func (client *Client) AlertsV1() (*alertsv1.AlertsV1, error) {
+	service, err := client.instance.get(alertsv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, alertsv1.DefaultServiceName)
+		service, err := alertsv1.NewAlertsV1(&alertsv1.AlertsV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*alertsv1.AlertsV1), nil
+}
+
+// AuthenticatedOriginPullApiV1 returns the client of the AuthenticatedOriginPullApiV1 service for the zone of the configuration.
+func (client *Client) AuthenticatedOriginPullApiV1() (*authenticatedoriginpullapiv1.AuthenticatedOriginPullApiV1, error) {
+	service, err := client.zone.get(authenticatedoriginpullapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, authenticatedoriginpullapiv1.DefaultServiceName)
+		service, err := authenticatedoriginpullapiv1.NewAuthenticatedOriginPullApiV1(&authenticatedoriginpullapiv1.AuthenticatedOriginPullApiV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*authenticatedoriginpullapiv1.AuthenticatedOriginPullApiV1), nil
+}
+
+// CachingApiV1 returns the client of the CachingApiV1 service for the zone of the configuration.
+func (client *Client) CachingApiV1() (*cachingapiv1.CachingApiV1, error) {
+	service, err := client.zone.get(cachingapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, cachingapiv1.DefaultServiceName)
+		service, err := cachingapiv1.NewCachingApiV1(&cachingapiv1.CachingApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+			ZoneID:        stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*cachingapiv1.CachingApiV1), nil
+}
+
+// CisIpApiV1 returns the client of the CisIpApiV1 service.
+func (client *Client) CisIpApiV1() (*cisipapiv1.CisIpApiV1, error) {
+	service, err := client.account.get(cisipapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, cisipapiv1.DefaultServiceName)
+		service, err := cisipapiv1.NewCisIpApiV1(&cisipapiv1.CisIpApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*cisipapiv1.CisIpApiV1), nil
+}
+
+// CustomPagesV1 returns the client of the CustomPagesV1 service for the zone of the configuration.
+func (client *Client) CustomPagesV1() (*custompagesv1.CustomPagesV1, error) {
+	service, err := client.zone.get(custompagesv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, custompagesv1.DefaultServiceName)
+		service, err := custompagesv1.NewCustomPagesV1(&custompagesv1.CustomPagesV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*custompagesv1.CustomPagesV1), nil
+}
+
+// DirectLinkProviderV2 returns the client of the DirectLinkProviderV2 service.
+func (client *Client) DirectLinkProviderV2() (*directlinkproviderv2.DirectLinkProviderV2, error) {
+	service, err := client.account.get(directlinkproviderv2.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(DirectLinkProviderEndpoint, directlinkproviderv2.DefaultServiceName)
+		service, err := directlinkproviderv2.NewDirectLinkProviderV2(&directlinkproviderv2.DirectLinkProviderV2Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Version:       core.StringPtr(client.config.Version),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*directlinkproviderv2.DirectLinkProviderV2), nil
+}
+
+// DirectLinkV1 returns the client of the DirectLinkV1 service.
+func (client *Client) DirectLinkV1() (*directlinkv1.DirectLinkV1, error) {
+	service, err := client.account.get(directlinkv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(DirectLinkEndpoint, directlinkv1.DefaultServiceName)
+		service, err := directlinkv1.NewDirectLinkV1(&directlinkv1.DirectLinkV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Version:       core.StringPtr(client.config.Version),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*directlinkv1.DirectLinkV1), nil
+}
+
+// DnsRecordBulkV1 returns the client of the DnsRecordBulkV1 service for the zone of the configuration.
+func (client *Client) DnsRecordBulkV1() (*dnsrecordbulkv1.DnsRecordBulkV1, error) {
+	service, err := client.zone.get(dnsrecordbulkv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, dnsrecordbulkv1.DefaultServiceName)
+		service, err := dnsrecordbulkv1.NewDnsRecordBulkV1(&dnsrecordbulkv1.DnsRecordBulkV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*dnsrecordbulkv1.DnsRecordBulkV1), nil
+}
+
+// DnsRecordsV1 returns the client of the DnsRecordsV1 service for the zone of the configuration.
+func (client *Client) DnsRecordsV1() (*dnsrecordsv1.DnsRecordsV1, error) {
+	service, err := client.zone.get(dnsrecordsv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, dnsrecordsv1.DefaultServiceName)
+		service, err := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*dnsrecordsv1.DnsRecordsV1), nil
+}
+
+// DnsSvcsV1 returns the client of the DnsSvcsV1 service.
+func (client *Client) DnsSvcsV1() (*dnssvcsv1.DnsSvcsV1, error) {
+	service, err := client.account.get(dnssvcsv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(DnsSvcsEndpoint, dnssvcsv1.DefaultServiceName)
+		service, err := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*dnssvcsv1.DnsSvcsV1), nil
+}
+
+// DnsZonesV1 returns the client of the DnsZonesV1 service.
+func (client *Client) DnsZonesV1() (*dnszonesv1.DnsZonesV1, error) {
+	service, err := client.account.get(dnszonesv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(DnsSvcsEndpoint, dnszonesv1.DefaultServiceName)
+		service, err := dnszonesv1.NewDnsZonesV1(&dnszonesv1.DnsZonesV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*dnszonesv1.DnsZonesV1), nil
+}
+
+// EdgeFunctionsApiV1 returns the client of the EdgeFunctionsApiV1 service for the zone of the configuration.
+func (client *Client) EdgeFunctionsApiV1() (*edgefunctionsapiv1.EdgeFunctionsApiV1, error) {
+	service, err := client.zone.get(edgefunctionsapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, edgefunctionsapiv1.DefaultServiceName)
+		service, err := edgefunctionsapiv1.NewEdgeFunctionsApiV1(&edgefunctionsapiv1.EdgeFunctionsApiV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*edgefunctionsapiv1.EdgeFunctionsApiV1), nil
+}
+
+// FiltersV1 returns the client of the FiltersV1 service.
+func (client *Client) FiltersV1() (*filtersv1.FiltersV1, error) {
+	service, err := client.account.get(filtersv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, filtersv1.DefaultServiceName)
+		service, err := filtersv1.NewFiltersV1(&filtersv1.FiltersV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*filtersv1.FiltersV1), nil
+}
+
+// FirewallAccessRulesV1 returns the client of the FirewallAccessRulesV1 service for the CIS instance of the configuration.
+func (client *Client) FirewallAccessRulesV1() (*firewallaccessrulesv1.FirewallAccessRulesV1, error) {
+	service, err := client.instance.get(firewallaccessrulesv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, firewallaccessrulesv1.DefaultServiceName)
+		service, err := firewallaccessrulesv1.NewFirewallAccessRulesV1(&firewallaccessrulesv1.FirewallAccessRulesV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*firewallaccessrulesv1.FirewallAccessRulesV1), nil
+}
+
+// FirewallApiV1 returns the client of the FirewallApiV1 service for the zone of the configuration.
+func (client *Client) FirewallApiV1() (*firewallapiv1.FirewallApiV1, error) {
+	service, err := client.zone.get(firewallapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, firewallapiv1.DefaultServiceName)
+		service, err := firewallapiv1.NewFirewallApiV1(&firewallapiv1.FirewallApiV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*firewallapiv1.FirewallApiV1), nil
+}
+
+// FirewallRulesV1 returns the client of the FirewallRulesV1 service.
+func (client *Client) FirewallRulesV1() (*firewallrulesv1.FirewallRulesV1, error) {
+	service, err := client.account.get(firewallrulesv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, firewallrulesv1.DefaultServiceName)
+		service, err := firewallrulesv1.NewFirewallRulesV1(&firewallrulesv1.FirewallRulesV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*firewallrulesv1.FirewallRulesV1), nil
+}
+
+// GlobalLoadBalancerEventsV1 returns the client of the GlobalLoadBalancerEventsV1 service for the CIS instance of the configuration.
+func (client *Client) GlobalLoadBalancerEventsV1() (*globalloadbalancereventsv1.GlobalLoadBalancerEventsV1, error) {
+	service, err := client.instance.get(globalloadbalancereventsv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, globalloadbalancereventsv1.DefaultServiceName)
+		service, err := globalloadbalancereventsv1.NewGlobalLoadBalancerEventsV1(&globalloadbalancereventsv1.GlobalLoadBalancerEventsV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*globalloadbalancereventsv1.GlobalLoadBalancerEventsV1), nil
+}
+
+// GlobalLoadBalancerMonitorV1 returns the client of the GlobalLoadBalancerMonitorV1 service for the CIS instance of the configuration.
+func (client *Client) GlobalLoadBalancerMonitorV1() (*globalloadbalancermonitorv1.GlobalLoadBalancerMonitorV1, error) {
+	service, err := client.instance.get(globalloadbalancermonitorv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, globalloadbalancermonitorv1.DefaultServiceName)
+		service, err := globalloadbalancermonitorv1.NewGlobalLoadBalancerMonitorV1(&globalloadbalancermonitorv1.GlobalLoadBalancerMonitorV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*globalloadbalancermonitorv1.GlobalLoadBalancerMonitorV1), nil
+}
+
+// GlobalLoadBalancerPoolsV0 returns the client of the GlobalLoadBalancerPoolsV0 service for the CIS instance of the configuration.
+func (client *Client) GlobalLoadBalancerPoolsV0() (*globalloadbalancerpoolsv0.GlobalLoadBalancerPoolsV0, error) {
+	service, err := client.instance.get(globalloadbalancerpoolsv0.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, globalloadbalancerpoolsv0.DefaultServiceName)
+		service, err := globalloadbalancerpoolsv0.NewGlobalLoadBalancerPoolsV0(&globalloadbalancerpoolsv0.GlobalLoadBalancerPoolsV0Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*globalloadbalancerpoolsv0.GlobalLoadBalancerPoolsV0), nil
+}
+
+// GlobalLoadBalancersV1 returns the client of the GlobalLoadBalancersV1 service.
+func (client *Client) GlobalLoadBalancersV1() (*globalloadbalancersv1.GlobalLoadBalancersV1, error) {
+	service, err := client.account.get(globalloadbalancersv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(DnsSvcsEndpoint, globalloadbalancersv1.DefaultServiceName)
+		service, err := globalloadbalancersv1.NewGlobalLoadBalancersV1(&globalloadbalancersv1.GlobalLoadBalancersV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*globalloadbalancersv1.GlobalLoadBalancersV1), nil
+}
+
+// GlobalLoadBalancerV1 returns the client of the GlobalLoadBalancerV1 service for the zone of the configuration.
+func (client *Client) GlobalLoadBalancerV1() (*globalloadbalancerv1.GlobalLoadBalancerV1, error) {
+	service, err := client.zone.get(globalloadbalancerv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, globalloadbalancerv1.DefaultServiceName)
+		service, err := globalloadbalancerv1.NewGlobalLoadBalancerV1(&globalloadbalancerv1.GlobalLoadBalancerV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*globalloadbalancerv1.GlobalLoadBalancerV1), nil
+}
+
+// LogpushJobsApiV1 returns the client of the LogpushJobsApiV1 service for the zone of the configuration, and the given dataset.
+func (client *Client) LogpushJobsApiV1(dataset string) (*logpushjobsapiv1.LogpushJobsApiV1, error) {
+	service, err := client.zone.get(logpushjobsapiv1.DefaultServiceName+"/"+dataset, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, logpushjobsapiv1.DefaultServiceName)
+		service, err := logpushjobsapiv1.NewLogpushJobsApiV1(&logpushjobsapiv1.LogpushJobsApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+			ZoneID:        stringPtr(client.config.ZoneID),
+			Dataset:       stringPtr(dataset),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*logpushjobsapiv1.LogpushJobsApiV1), nil
+}
+
+// MtlsV1 returns the client of the MtlsV1 service for the CIS instance of the configuration.
+func (client *Client) MtlsV1() (*mtlsv1.MtlsV1, error) {
+	service, err := client.instance.get(mtlsv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, mtlsv1.DefaultServiceName)
+		service, err := mtlsv1.NewMtlsV1(&mtlsv1.MtlsV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*mtlsv1.MtlsV1), nil
+}
+
+// PageRuleApiV1 returns the client of the PageRuleApiV1 service for the zone of the configuration.
+func (client *Client) PageRuleApiV1() (*pageruleapiv1.PageRuleApiV1, error) {
+	service, err := client.zone.get(pageruleapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, pageruleapiv1.DefaultServiceName)
+		service, err := pageruleapiv1.NewPageRuleApiV1(&pageruleapiv1.PageRuleApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+			ZoneID:        stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*pageruleapiv1.PageRuleApiV1), nil
+}
+
+// PermittedNetworksForDnsZonesV1 returns the client of the PermittedNetworksForDnsZonesV1 service.
+func (client *Client) PermittedNetworksForDnsZonesV1() (*permittednetworksfordnszonesv1.PermittedNetworksForDnsZonesV1, error) {
+	service, err := client.account.get(permittednetworksfordnszonesv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(DnsSvcsEndpoint, permittednetworksfordnszonesv1.DefaultServiceName)
+		service, err := permittednetworksfordnszonesv1.NewPermittedNetworksForDnsZonesV1(&permittednetworksfordnszonesv1.PermittedNetworksForDnsZonesV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*permittednetworksfordnszonesv1.PermittedNetworksForDnsZonesV1), nil
+}
+
+// RangeApplicationsV1 returns the client of the RangeApplicationsV1 service for the zone of the configuration.
+func (client *Client) RangeApplicationsV1() (*rangeapplicationsv1.RangeApplicationsV1, error) {
+	service, err := client.zone.get(rangeapplicationsv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, rangeapplicationsv1.DefaultServiceName)
+		service, err := rangeapplicationsv1.NewRangeApplicationsV1(&rangeapplicationsv1.RangeApplicationsV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*rangeapplicationsv1.RangeApplicationsV1), nil
+}
+
+// ResourceRecordsV1 returns the client of the ResourceRecordsV1 service.
+func (client *Client) ResourceRecordsV1() (*resourcerecordsv1.ResourceRecordsV1, error) {
+	service, err := client.account.get(resourcerecordsv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(DnsSvcsEndpoint, resourcerecordsv1.DefaultServiceName)
+		service, err := resourcerecordsv1.NewResourceRecordsV1(&resourcerecordsv1.ResourceRecordsV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*resourcerecordsv1.ResourceRecordsV1), nil
+}
+
+// RoutingV1 returns the client of the RoutingV1 service for the zone of the configuration.
+func (client *Client) RoutingV1() (*routingv1.RoutingV1, error) {
+	service, err := client.zone.get(routingv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, routingv1.DefaultServiceName)
+		service, err := routingv1.NewRoutingV1(&routingv1.RoutingV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*routingv1.RoutingV1), nil
+}
+
+// SecurityEventsApiV1 returns the client of the SecurityEventsApiV1 service for the zone of the configuration.
+func (client *Client) SecurityEventsApiV1() (*securityeventsapiv1.SecurityEventsApiV1, error) {
+	service, err := client.zone.get(securityeventsapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, securityeventsapiv1.DefaultServiceName)
+		service, err := securityeventsapiv1.NewSecurityEventsApiV1(&securityeventsapiv1.SecurityEventsApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+			ZoneID:        stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*securityeventsapiv1.SecurityEventsApiV1), nil
+}
+
+// SslCertificateApiV1 returns the client of the SslCertificateApiV1 service for the zone of the configuration.
+func (client *Client) SslCertificateApiV1() (*sslcertificateapiv1.SslCertificateApiV1, error) {
+	service, err := client.zone.get(sslcertificateapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, sslcertificateapiv1.DefaultServiceName)
+		service, err := sslcertificateapiv1.NewSslCertificateApiV1(&sslcertificateapiv1.SslCertificateApiV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*sslcertificateapiv1.SslCertificateApiV1), nil
+}
+
+// TransitGatewayApisV1 returns the client of the TransitGatewayApisV1 service.
+func (client *Client) TransitGatewayApisV1() (*transitgatewayapisv1.TransitGatewayApisV1, error) {
+	service, err := client.account.get(transitgatewayapisv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(TransitGatewayEndpoint, transitgatewayapisv1.DefaultServiceName)
+		service, err := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Version:       core.StringPtr(client.config.Version),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*transitgatewayapisv1.TransitGatewayApisV1), nil
+}
+
+// UserAgentBlockingRulesV1 returns the client of the UserAgentBlockingRulesV1 service for the zone of the configuration.
+func (client *Client) UserAgentBlockingRulesV1() (*useragentblockingrulesv1.UserAgentBlockingRulesV1, error) {
+	service, err := client.zone.get(useragentblockingrulesv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, useragentblockingrulesv1.DefaultServiceName)
+		service, err := useragentblockingrulesv1.NewUserAgentBlockingRulesV1(&useragentblockingrulesv1.UserAgentBlockingRulesV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*useragentblockingrulesv1.UserAgentBlockingRulesV1), nil
+}
+
+// WafApiV1 returns the client of the WafApiV1 service for the zone of the configuration.
+func (client *Client) WafApiV1() (*wafapiv1.WafApiV1, error) {
+	service, err := client.zone.get(wafapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, wafapiv1.DefaultServiceName)
+		service, err := wafapiv1.NewWafApiV1(&wafapiv1.WafApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+			ZoneID:        stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*wafapiv1.WafApiV1), nil
+}
+
+// WafRuleGroupsApiV1 returns the client of the WafRuleGroupsApiV1 service for the zone of the configuration.
+func (client *Client) WafRuleGroupsApiV1() (*wafrulegroupsapiv1.WafRuleGroupsApiV1, error) {
+	service, err := client.zone.get(wafrulegroupsapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, wafrulegroupsapiv1.DefaultServiceName)
+		service, err := wafrulegroupsapiv1.NewWafRuleGroupsApiV1(&wafrulegroupsapiv1.WafRuleGroupsApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+			ZoneID:        stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*wafrulegroupsapiv1.WafRuleGroupsApiV1), nil
+}
+
+// WafRulePackagesApiV1 returns the client of the WafRulePackagesApiV1 service for the zone of the configuration.
+func (client *Client) WafRulePackagesApiV1() (*wafrulepackagesapiv1.WafRulePackagesApiV1, error) {
+	service, err := client.zone.get(wafrulepackagesapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, wafrulepackagesapiv1.DefaultServiceName)
+		service, err := wafrulepackagesapiv1.NewWafRulePackagesApiV1(&wafrulepackagesapiv1.WafRulePackagesApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+			ZoneID:        stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*wafrulepackagesapiv1.WafRulePackagesApiV1), nil
+}
+
+// WafRulesApiV1 returns the client of the WafRulesApiV1 service for the zone of the configuration.
+func (client *Client) WafRulesApiV1() (*wafrulesapiv1.WafRulesApiV1, error) {
+	service, err := client.zone.get(wafrulesapiv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, wafrulesapiv1.DefaultServiceName)
+		service, err := wafrulesapiv1.NewWafRulesApiV1(&wafrulesapiv1.WafRulesApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+			ZoneID:        stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*wafrulesapiv1.WafRulesApiV1), nil
+}
+
+// WebhooksV1 returns the client of the WebhooksV1 service for the CIS instance of the configuration.
+func (client *Client) WebhooksV1() (*webhooksv1.WebhooksV1, error) {
+	service, err := client.instance.get(webhooksv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, webhooksv1.DefaultServiceName)
+		service, err := webhooksv1.NewWebhooksV1(&webhooksv1.WebhooksV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*webhooksv1.WebhooksV1), nil
+}
+
+// ZoneFirewallAccessRulesV1 returns the client of the ZoneFirewallAccessRulesV1 service for the zone of the configuration.
+func (client *Client) ZoneFirewallAccessRulesV1() (*zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1, error) {
+	service, err := client.zone.get(zonefirewallaccessrulesv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, zonefirewallaccessrulesv1.DefaultServiceName)
+		service, err := zonefirewallaccessrulesv1.NewZoneFirewallAccessRulesV1(&zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*zonefirewallaccessrulesv1.ZoneFirewallAccessRulesV1), nil
+}
+
+// ZoneLockdownV1 returns the client of the ZoneLockdownV1 service for the zone of the configuration.
+func (client *Client) ZoneLockdownV1() (*zonelockdownv1.ZoneLockdownV1, error) {
+	service, err := client.zone.get(zonelockdownv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, zonelockdownv1.DefaultServiceName)
+		service, err := zonelockdownv1.NewZoneLockdownV1(&zonelockdownv1.ZoneLockdownV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*zonelockdownv1.ZoneLockdownV1), nil
+}
+
+// ZoneRateLimitsV1 returns the client of the ZoneRateLimitsV1 service for the zone of the configuration.
+func (client *Client) ZoneRateLimitsV1() (*zoneratelimitsv1.ZoneRateLimitsV1, error) {
+	service, err := client.zone.get(zoneratelimitsv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, zoneratelimitsv1.DefaultServiceName)
+		service, err := zoneratelimitsv1.NewZoneRateLimitsV1(&zoneratelimitsv1.ZoneRateLimitsV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*zoneratelimitsv1.ZoneRateLimitsV1), nil
+}
+
+// ZonesSettingsV1 returns the client of the ZonesSettingsV1 service for the zone of the configuration.
+func (client *Client) ZonesSettingsV1() (*zonessettingsv1.ZonesSettingsV1, error) {
+	service, err := client.zone.get(zonessettingsv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, zonessettingsv1.DefaultServiceName)
+		service, err := zonessettingsv1.NewZonesSettingsV1(&zonessettingsv1.ZonesSettingsV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            stringPtr(client.config.Crn),
+			ZoneIdentifier: stringPtr(client.config.ZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*zonessettingsv1.ZonesSettingsV1), nil
+}
+
+// ZonesV1 returns the client of the ZonesV1 service for the CIS instance of the configuration.
+func (client *Client) ZonesV1() (*zonesv1.ZonesV1, error) {
+	service, err := client.instance.get(zonesv1.DefaultServiceName, func() (interface{}, error) {
+		authenticator, err := client.authenticator()
+		if err != nil {
+			return nil, err
+		}
+		url := client.serviceURL(CisEndpoint, zonesv1.DefaultServiceName)
+		service, err := zonesv1.NewZonesV1(&zonesv1.ZonesV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           stringPtr(client.config.Crn),
+		})
+		if err != nil {
+			return nil, err
+		}
+		client.configure(service.Service)
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.(*zonesv1.ZonesV1), nil
+}
@@ -178,6 +178,24 @@ func (pageRuleApi *PageRuleApiV1) DisableRetries() {
 	pageRuleApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (pageRuleApi *PageRuleApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(pageRuleApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (pageRuleApi *PageRuleApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(pageRuleApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (pageRuleApi *PageRuleApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(pageRuleApi.Service, middleware...)
+}
+
 // GetPageRule : Get page rule
 // Get a page rule details.
 func (pageRuleApi *PageRuleApiV1) GetPageRule(getPageRuleOptions *GetPageRuleOptions) (result *PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error) {
@@ -227,6 +245,7 @@ func (pageRuleApi *PageRuleApiV1) GetPageRuleWithContext(ctx context.Context, ge
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesResponseWithoutResultInfo)
@@ -306,6 +325,7 @@ func (pageRuleApi *PageRuleApiV1) ChangePageRuleWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesResponseWithoutResultInfo)
@@ -385,6 +405,7 @@ func (pageRuleApi *PageRuleApiV1) UpdatePageRuleWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesResponseWithoutResultInfo)
@@ -445,6 +466,7 @@ func (pageRuleApi *PageRuleApiV1) DeletePageRuleWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesDeleteResponse)
@@ -513,6 +535,7 @@ func (pageRuleApi *PageRuleApiV1) ListPageRulesWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesResponseListAll)
@@ -587,6 +610,7 @@ func (pageRuleApi *PageRuleApiV1) CreatePageRuleWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = pageRuleApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPageRulesResponseWithoutResultInfo)
@@ -0,0 +1,43 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pageruleapiv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PageRuleApiV1API is the interface of the operations of PageRuleApiV1, through which they can be replaced
+// in unit tests, for instance with the mocks.PageRuleApiV1 of the mocks package.
+type PageRuleApiV1API interface {
+	GetPageRule(getPageRuleOptions *GetPageRuleOptions) (result *PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error)
+	GetPageRuleWithContext(ctx context.Context, getPageRuleOptions *GetPageRuleOptions) (result *PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error)
+	ChangePageRule(changePageRuleOptions *ChangePageRuleOptions) (result *PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error)
+	ChangePageRuleWithContext(ctx context.Context, changePageRuleOptions *ChangePageRuleOptions) (result *PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error)
+	UpdatePageRule(updatePageRuleOptions *UpdatePageRuleOptions) (result *PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error)
+	UpdatePageRuleWithContext(ctx context.Context, updatePageRuleOptions *UpdatePageRuleOptions) (result *PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error)
+	DeletePageRule(deletePageRuleOptions *DeletePageRuleOptions) (result *PageRulesDeleteResponse, response *core.DetailedResponse, err error)
+	DeletePageRuleWithContext(ctx context.Context, deletePageRuleOptions *DeletePageRuleOptions) (result *PageRulesDeleteResponse, response *core.DetailedResponse, err error)
+	ListPageRules(listPageRulesOptions *ListPageRulesOptions) (result *PageRulesResponseListAll, response *core.DetailedResponse, err error)
+	ListPageRulesWithContext(ctx context.Context, listPageRulesOptions *ListPageRulesOptions) (result *PageRulesResponseListAll, response *core.DetailedResponse, err error)
+	CreatePageRule(createPageRuleOptions *CreatePageRuleOptions) (result *PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error)
+	CreatePageRuleWithContext(ctx context.Context, createPageRuleOptions *CreatePageRuleOptions) (result *PageRulesResponseWithoutResultInfo, response *core.DetailedResponse, err error)
+}
+
+// PageRuleApiV1 implements PageRuleApiV1API.
+var _ PageRuleApiV1API = (*PageRuleApiV1)(nil)
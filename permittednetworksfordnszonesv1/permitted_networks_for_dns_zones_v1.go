@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	common "github.com/IBM/networking-go-sdk/common"
@@ -107,6 +108,35 @@ func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) SetServiceUR
 	return permittedNetworksForDnsZones.Service.SetServiceURL(url)
 }
 
+// EnableRetries enables automatic retries for requests invoked for this service instance.
+// If either parameter is specified as 0, then a default value is used instead.
+func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
+	permittedNetworksForDnsZones.Service.EnableRetries(maxRetries, maxRetryInterval)
+}
+
+// DisableRetries disables automatic retries for requests invoked for this service instance.
+func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) DisableRetries() {
+	permittedNetworksForDnsZones.Service.DisableRetries()
+}
+
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(permittedNetworksForDnsZones.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(permittedNetworksForDnsZones.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(permittedNetworksForDnsZones.Service, middleware...)
+}
+
 // ListPermittedNetworks : List permitted networks
 // List the permitted networks for a given DNS zone.
 func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) ListPermittedNetworks(listPermittedNetworksOptions *ListPermittedNetworksOptions) (result *ListPermittedNetworks, response *core.DetailedResponse, err error) {
@@ -156,6 +186,7 @@ func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) ListPermitte
 	var rawResponse map[string]json.RawMessage
 	response, err = permittedNetworksForDnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListPermittedNetworks)
@@ -222,6 +253,7 @@ func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) CreatePermit
 	var rawResponse map[string]json.RawMessage
 	response, err = permittedNetworksForDnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPermittedNetwork)
@@ -275,6 +307,7 @@ func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) DeletePermit
 	var rawResponse map[string]json.RawMessage
 	response, err = permittedNetworksForDnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPermittedNetwork)
@@ -328,6 +361,7 @@ func (permittedNetworksForDnsZones *PermittedNetworksForDnsZonesV1) GetPermitted
 	var rawResponse map[string]json.RawMessage
 	response, err = permittedNetworksForDnsZones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPermittedNetwork)
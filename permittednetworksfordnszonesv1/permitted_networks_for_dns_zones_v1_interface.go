@@ -0,0 +1,33 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package permittednetworksfordnszonesv1
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PermittedNetworksForDnsZonesV1API is the interface of the operations of PermittedNetworksForDnsZonesV1, through which they can be replaced
+// in unit tests, for instance with the mocks.PermittedNetworksForDnsZonesV1 of the mocks package.
+type PermittedNetworksForDnsZonesV1API interface {
+	ListPermittedNetworks(listPermittedNetworksOptions *ListPermittedNetworksOptions) (result *ListPermittedNetworks, response *core.DetailedResponse, err error)
+	CreatePermittedNetwork(createPermittedNetworkOptions *CreatePermittedNetworkOptions) (result *PermittedNetwork, response *core.DetailedResponse, err error)
+	DeletePermittedNetwork(deletePermittedNetworkOptions *DeletePermittedNetworkOptions) (result *PermittedNetwork, response *core.DetailedResponse, err error)
+	GetPermittedNetwork(getPermittedNetworkOptions *GetPermittedNetworkOptions) (result *PermittedNetwork, response *core.DetailedResponse, err error)
+}
+
+// PermittedNetworksForDnsZonesV1 implements PermittedNetworksForDnsZonesV1API.
+var _ PermittedNetworksForDnsZonesV1API = (*PermittedNetworksForDnsZonesV1)(nil)
@@ -179,6 +179,24 @@ func (rangeApplications *RangeApplicationsV1) DisableRetries() {
 	rangeApplications.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (rangeApplications *RangeApplicationsV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(rangeApplications.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (rangeApplications *RangeApplicationsV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(rangeApplications.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (rangeApplications *RangeApplicationsV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(rangeApplications.Service, middleware...)
+}
+
 // ListRangeApps : List range applications
 // Get a list of currently existing Range Applications inside a zone.
 func (rangeApplications *RangeApplicationsV1) ListRangeApps(listRangeAppsOptions *ListRangeAppsOptions) (result *RangeApplications, response *core.DetailedResponse, err error) {
@@ -236,6 +254,7 @@ func (rangeApplications *RangeApplicationsV1) ListRangeAppsWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = rangeApplications.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRangeApplications)
@@ -332,6 +351,7 @@ func (rangeApplications *RangeApplicationsV1) CreateRangeAppWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = rangeApplications.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRangeApplicationResp)
@@ -392,6 +412,7 @@ func (rangeApplications *RangeApplicationsV1) GetRangeAppWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = rangeApplications.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRangeApplicationResp)
@@ -489,6 +510,7 @@ func (rangeApplications *RangeApplicationsV1) UpdateRangeAppWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = rangeApplications.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRangeApplicationResp)
@@ -549,6 +571,7 @@ func (rangeApplications *RangeApplicationsV1) DeleteRangeAppWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = rangeApplications.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRangeApplicationResp)
@@ -0,0 +1,41 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rangeapplicationsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RangeApplicationsV1API is the interface of the operations of RangeApplicationsV1, through which they can be replaced
+// in unit tests, for instance with the mocks.RangeApplicationsV1 of the mocks package.
+type RangeApplicationsV1API interface {
+	ListRangeApps(listRangeAppsOptions *ListRangeAppsOptions) (result *RangeApplications, response *core.DetailedResponse, err error)
+	ListRangeAppsWithContext(ctx context.Context, listRangeAppsOptions *ListRangeAppsOptions) (result *RangeApplications, response *core.DetailedResponse, err error)
+	CreateRangeApp(createRangeAppOptions *CreateRangeAppOptions) (result *RangeApplicationResp, response *core.DetailedResponse, err error)
+	CreateRangeAppWithContext(ctx context.Context, createRangeAppOptions *CreateRangeAppOptions) (result *RangeApplicationResp, response *core.DetailedResponse, err error)
+	GetRangeApp(getRangeAppOptions *GetRangeAppOptions) (result *RangeApplicationResp, response *core.DetailedResponse, err error)
+	GetRangeAppWithContext(ctx context.Context, getRangeAppOptions *GetRangeAppOptions) (result *RangeApplicationResp, response *core.DetailedResponse, err error)
+	UpdateRangeApp(updateRangeAppOptions *UpdateRangeAppOptions) (result *RangeApplicationResp, response *core.DetailedResponse, err error)
+	UpdateRangeAppWithContext(ctx context.Context, updateRangeAppOptions *UpdateRangeAppOptions) (result *RangeApplicationResp, response *core.DetailedResponse, err error)
+	DeleteRangeApp(deleteRangeAppOptions *DeleteRangeAppOptions) (result *RangeApplicationResp, response *core.DetailedResponse, err error)
+	DeleteRangeAppWithContext(ctx context.Context, deleteRangeAppOptions *DeleteRangeAppOptions) (result *RangeApplicationResp, response *core.DetailedResponse, err error)
+}
+
+// RangeApplicationsV1 implements RangeApplicationsV1API.
+var _ RangeApplicationsV1API = (*RangeApplicationsV1)(nil)
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	common "github.com/IBM/networking-go-sdk/common"
@@ -107,6 +108,35 @@ func (resourceRecords *ResourceRecordsV1) SetServiceURL(url string) error {
 	return resourceRecords.Service.SetServiceURL(url)
 }
 
+// EnableRetries enables automatic retries for requests invoked for this service instance.
+// If either parameter is specified as 0, then a default value is used instead.
+func (resourceRecords *ResourceRecordsV1) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
+	resourceRecords.Service.EnableRetries(maxRetries, maxRetryInterval)
+}
+
+// DisableRetries disables automatic retries for requests invoked for this service instance.
+func (resourceRecords *ResourceRecordsV1) DisableRetries() {
+	resourceRecords.Service.DisableRetries()
+}
+
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (resourceRecords *ResourceRecordsV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(resourceRecords.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (resourceRecords *ResourceRecordsV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(resourceRecords.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (resourceRecords *ResourceRecordsV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(resourceRecords.Service, middleware...)
+}
+
 // ListResourceRecords : List Resource Records
 // List the Resource Records for a given DNS zone.
 func (resourceRecords *ResourceRecordsV1) ListResourceRecords(listResourceRecordsOptions *ListResourceRecordsOptions) (result *ListResourceRecords, response *core.DetailedResponse, err error) {
@@ -156,6 +186,7 @@ func (resourceRecords *ResourceRecordsV1) ListResourceRecords(listResourceRecord
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListResourceRecords)
@@ -234,6 +265,7 @@ func (resourceRecords *ResourceRecordsV1) CreateResourceRecord(createResourceRec
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceRecord)
@@ -284,6 +316,9 @@ func (resourceRecords *ResourceRecordsV1) DeleteResourceRecord(deleteResourceRec
 	}
 
 	response, err = resourceRecords.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -330,6 +365,7 @@ func (resourceRecords *ResourceRecordsV1) GetResourceRecord(getResourceRecordOpt
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceRecord)
@@ -405,6 +441,7 @@ func (resourceRecords *ResourceRecordsV1) UpdateResourceRecord(updateResourceRec
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceRecords.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceRecord)
@@ -0,0 +1,34 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcerecordsv1
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ResourceRecordsV1API is the interface of the operations of ResourceRecordsV1, through which they can be replaced
+// in unit tests, for instance with the mocks.ResourceRecordsV1 of the mocks package.
+type ResourceRecordsV1API interface {
+	ListResourceRecords(listResourceRecordsOptions *ListResourceRecordsOptions) (result *ListResourceRecords, response *core.DetailedResponse, err error)
+	CreateResourceRecord(createResourceRecordOptions *CreateResourceRecordOptions) (result *ResourceRecord, response *core.DetailedResponse, err error)
+	DeleteResourceRecord(deleteResourceRecordOptions *DeleteResourceRecordOptions) (response *core.DetailedResponse, err error)
+	GetResourceRecord(getResourceRecordOptions *GetResourceRecordOptions) (result *ResourceRecord, response *core.DetailedResponse, err error)
+	UpdateResourceRecord(updateResourceRecordOptions *UpdateResourceRecordOptions) (result *ResourceRecord, response *core.DetailedResponse, err error)
+}
+
+// ResourceRecordsV1 implements ResourceRecordsV1API.
+var _ ResourceRecordsV1API = (*ResourceRecordsV1)(nil)
@@ -179,6 +179,24 @@ func (routing *RoutingV1) DisableRetries() {
 	routing.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (routing *RoutingV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(routing.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (routing *RoutingV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(routing.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (routing *RoutingV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(routing.Service, middleware...)
+}
+
 // GetSmartRouting : Get Routing feature smart routing setting
 // Get Routing feature smart routing setting for a zone.
 func (routing *RoutingV1) GetSmartRouting(getSmartRoutingOptions *GetSmartRoutingOptions) (result *SmartRoutingResp, response *core.DetailedResponse, err error) {
@@ -223,6 +241,7 @@ func (routing *RoutingV1) GetSmartRoutingWithContext(ctx context.Context, getSma
 	var rawResponse map[string]json.RawMessage
 	response, err = routing.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSmartRoutingResp)
@@ -288,6 +307,7 @@ func (routing *RoutingV1) UpdateSmartRoutingWithContext(ctx context.Context, upd
 	var rawResponse map[string]json.RawMessage
 	response, err = routing.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSmartRoutingResp)
@@ -179,6 +179,24 @@ func (securityEventsApi *SecurityEventsApiV1) DisableRetries() {
 	securityEventsApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (securityEventsApi *SecurityEventsApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(securityEventsApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (securityEventsApi *SecurityEventsApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(securityEventsApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (securityEventsApi *SecurityEventsApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(securityEventsApi.Service, middleware...)
+}
+
 // SecurityEvents : Logs of the mitigations performed by Firewall features
 // Provides a full log of the mitigations performed by the CIS Firewall features including; Firewall Rules, Rate
 // Limiting, Security Level, Access Rules (IP, IP Range, ASN, and Country), WAF (Web Application Firewall), User Agent
@@ -283,6 +301,7 @@ func (securityEventsApi *SecurityEventsApiV1) SecurityEventsWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = securityEventsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSecurityEvents)
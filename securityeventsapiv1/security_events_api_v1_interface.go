@@ -0,0 +1,33 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityeventsapiv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SecurityEventsApiV1API is the interface of the operations of SecurityEventsApiV1, through which they can be replaced
+// in unit tests, for instance with the mocks.SecurityEventsApiV1 of the mocks package.
+type SecurityEventsApiV1API interface {
+	SecurityEvents(securityEventsOptions *SecurityEventsOptions) (result *SecurityEvents, response *core.DetailedResponse, err error)
+	SecurityEventsWithContext(ctx context.Context, securityEventsOptions *SecurityEventsOptions) (result *SecurityEvents, response *core.DetailedResponse, err error)
+}
+
+// SecurityEventsApiV1 implements SecurityEventsApiV1API.
+var _ SecurityEventsApiV1API = (*SecurityEventsApiV1)(nil)
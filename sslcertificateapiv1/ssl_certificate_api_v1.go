@@ -179,6 +179,24 @@ func (sslCertificateApi *SslCertificateApiV1) DisableRetries() {
 	sslCertificateApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (sslCertificateApi *SslCertificateApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(sslCertificateApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (sslCertificateApi *SslCertificateApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(sslCertificateApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (sslCertificateApi *SslCertificateApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(sslCertificateApi.Service, middleware...)
+}
+
 // ListCertificates : List all certificates
 // CIS automatically add an active DNS zone to a universal SSL certificate, shared among multiple customers. Customer
 // may order dedicated certificates for the owning zones. This API list all certificates for a given zone, including
@@ -228,6 +246,7 @@ func (sslCertificateApi *SslCertificateApiV1) ListCertificatesWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListCertificateResp)
@@ -300,6 +319,7 @@ func (sslCertificateApi *SslCertificateApiV1) OrderCertificateWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDedicatedCertificateResp)
@@ -360,6 +380,9 @@ func (sslCertificateApi *SslCertificateApiV1) DeleteCertificateWithContext(ctx c
 	}
 
 	response, err = sslCertificateApi.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -408,6 +431,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetSslSettingWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSslSettingResp)
@@ -473,6 +497,7 @@ func (sslCertificateApi *SslCertificateApiV1) ChangeSslSettingWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSslSettingResp)
@@ -520,6 +545,13 @@ func (sslCertificateApi *SslCertificateApiV1) ListCustomCertificatesWithContext(
 	}
 	builder.AddHeader("Accept", "application/json")
 
+	if listCustomCertificatesOptions.Page != nil {
+		builder.AddQuery("page", fmt.Sprint(*listCustomCertificatesOptions.Page))
+	}
+	if listCustomCertificatesOptions.PerPage != nil {
+		builder.AddQuery("per_page", fmt.Sprint(*listCustomCertificatesOptions.PerPage))
+	}
+
 	request, err := builder.Build()
 	if err != nil {
 		return
@@ -528,6 +560,7 @@ func (sslCertificateApi *SslCertificateApiV1) ListCustomCertificatesWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListCustomCertsResp)
@@ -602,6 +635,7 @@ func (sslCertificateApi *SslCertificateApiV1) UploadCustomCertificateWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomCertResp)
@@ -662,6 +696,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetCustomCertificateWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomCertResp)
@@ -741,6 +776,7 @@ func (sslCertificateApi *SslCertificateApiV1) UpdateCustomCertificateWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCustomCertResp)
@@ -798,6 +834,9 @@ func (sslCertificateApi *SslCertificateApiV1) DeleteCustomCertificateWithContext
 	}
 
 	response, err = sslCertificateApi.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -853,6 +892,9 @@ func (sslCertificateApi *SslCertificateApiV1) ChangeCertificatePriorityWithConte
 	}
 
 	response, err = sslCertificateApi.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -901,6 +943,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetUniversalCertificateSettingWith
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalUniversalSettingResp)
@@ -963,6 +1006,9 @@ func (sslCertificateApi *SslCertificateApiV1) ChangeUniversalCertificateSettingW
 	}
 
 	response, err = sslCertificateApi.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -1011,6 +1057,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetTls12SettingWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTls12SettingResp)
@@ -1076,6 +1123,7 @@ func (sslCertificateApi *SslCertificateApiV1) ChangeTls12SettingWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTls12SettingResp)
@@ -1131,6 +1179,7 @@ func (sslCertificateApi *SslCertificateApiV1) GetTls13SettingWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTls13SettingResp)
@@ -1196,6 +1245,7 @@ func (sslCertificateApi *SslCertificateApiV1) ChangeTls13SettingWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = sslCertificateApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTls13SettingResp)
@@ -1621,6 +1671,11 @@ func (options *ListCertificatesOptions) SetHeaders(param map[string]string) *Lis
 
 // ListCustomCertificatesOptions : The ListCustomCertificates options.
 type ListCustomCertificatesOptions struct {
+	// Page number of paginated results.
+	Page *int64 `json:"page,omitempty"`
+
+	// Maximum number of custom certificates per page.
+	PerPage *int64 `json:"per_page,omitempty"`
 
 	// Allows users to set headers on API requests
 	Headers map[string]string
@@ -1631,6 +1686,18 @@ func (*SslCertificateApiV1) NewListCustomCertificatesOptions() *ListCustomCertif
 	return &ListCustomCertificatesOptions{}
 }
 
+// SetPage : Allow user to set Page
+func (options *ListCustomCertificatesOptions) SetPage(page int64) *ListCustomCertificatesOptions {
+	options.Page = core.Int64Ptr(page)
+	return options
+}
+
+// SetPerPage : Allow user to set PerPage
+func (options *ListCustomCertificatesOptions) SetPerPage(perPage int64) *ListCustomCertificatesOptions {
+	options.PerPage = core.Int64Ptr(perPage)
+	return options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *ListCustomCertificatesOptions) SetHeaders(param map[string]string) *ListCustomCertificatesOptions {
 	options.Headers = param
@@ -2512,3 +2579,97 @@ func UnmarshalUniversalSettingResp(m map[string]json.RawMessage, result interfac
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// CustomCertificatesPager can be used to simplify the use of the "ListCustomCertificates" method.
+type CustomCertificatesPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListCustomCertificatesOptions
+	client   *SslCertificateApiV1
+}
+
+// NewCustomCertificatesPager returns a new CustomCertificatesPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (sslCertificateApi *SslCertificateApiV1) NewCustomCertificatesPager(options *ListCustomCertificatesOptions) (pager *CustomCertificatesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListCustomCertificatesOptions = *options
+	pager = &CustomCertificatesPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   sslCertificateApi,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *CustomCertificatesPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *CustomCertificatesPager) GetNextWithContext(ctx context.Context) (page []CustomCertPack, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListCustomCertificatesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *CustomCertificatesPager) GetAllWithContext(ctx context.Context) (allItems []CustomCertPack, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []CustomCertPack
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *CustomCertificatesPager) GetNext() (page []CustomCertPack, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *CustomCertificatesPager) GetAll() (allItems []CustomCertPack, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -175,6 +175,24 @@ func (transitGatewayApis *TransitGatewayApisV1) DisableRetries() {
 	transitGatewayApis.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (transitGatewayApis *TransitGatewayApisV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(transitGatewayApis.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (transitGatewayApis *TransitGatewayApisV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(transitGatewayApis.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (transitGatewayApis *TransitGatewayApisV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(transitGatewayApis.Service, middleware...)
+}
+
 // ListConnections : Retrieves all connections
 // List all transit gateway connections associated with this account.
 func (transitGatewayApis *TransitGatewayApisV1) ListConnections(listConnectionsOptions *ListConnectionsOptions) (result *TransitConnectionCollection, response *core.DetailedResponse, err error) {
@@ -225,6 +243,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListConnectionsWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -288,6 +307,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListTransitGatewayConnectionPref
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -373,6 +393,7 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayConnectionPr
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -434,6 +455,9 @@ func (transitGatewayApis *TransitGatewayApisV1) DeleteTransitGatewayConnectionPr
 	}
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -489,6 +513,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetTransitGatewayConnectionPrefi
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -575,6 +600,7 @@ func (transitGatewayApis *TransitGatewayApisV1) UpdateTransitGatewayConnectionPr
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -638,6 +664,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListTransitGatewayRouteReportsWi
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -701,6 +728,7 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayRouteReportW
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -761,6 +789,9 @@ func (transitGatewayApis *TransitGatewayApisV1) DeleteTransitGatewayRouteReportW
 	}
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -815,6 +846,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetTransitGatewayRouteReportWith
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -875,6 +907,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListTransitGatewaysWithContext(c
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -952,6 +985,7 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1012,6 +1046,9 @@ func (transitGatewayApis *TransitGatewayApisV1) DeleteTransitGatewayWithContext(
 	}
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -1065,6 +1102,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetTransitGatewayWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1140,6 +1178,7 @@ func (transitGatewayApis *TransitGatewayApisV1) UpdateTransitGatewayWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1193,6 +1232,12 @@ func (transitGatewayApis *TransitGatewayApisV1) ListTransitGatewayConnectionsWit
 	builder.AddHeader("Accept", "application/json")
 
 	builder.AddQuery("version", fmt.Sprint(*transitGatewayApis.Version))
+	if listTransitGatewayConnectionsOptions.Limit != nil {
+		builder.AddQuery("limit", fmt.Sprint(*listTransitGatewayConnectionsOptions.Limit))
+	}
+	if listTransitGatewayConnectionsOptions.Start != nil {
+		builder.AddQuery("start", fmt.Sprint(*listTransitGatewayConnectionsOptions.Start))
+	}
 
 	request, err := builder.Build()
 	if err != nil {
@@ -1202,6 +1247,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListTransitGatewayConnectionsWit
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1310,6 +1356,7 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayConnectionWi
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1371,6 +1418,9 @@ func (transitGatewayApis *TransitGatewayApisV1) DeleteTransitGatewayConnectionWi
 	}
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -1425,6 +1475,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetTransitGatewayConnectionWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1501,6 +1552,7 @@ func (transitGatewayApis *TransitGatewayApisV1) UpdateTransitGatewayConnectionWi
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1571,6 +1623,9 @@ func (transitGatewayApis *TransitGatewayApisV1) CreateTransitGatewayConnectionAc
 	}
 
 	response, err = transitGatewayApis.Service.Request(request, nil)
+	if err != nil {
+		err = common.NewAPIError(response, err)
+	}
 
 	return
 }
@@ -1616,6 +1671,7 @@ func (transitGatewayApis *TransitGatewayApisV1) ListGatewayLocationsWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -1678,6 +1734,7 @@ func (transitGatewayApis *TransitGatewayApisV1) GetGatewayLocationWithContext(ct
 	var rawResponse map[string]json.RawMessage
 	response, err = transitGatewayApis.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -2558,6 +2615,12 @@ type ListTransitGatewayConnectionsOptions struct {
 	// The Transit Gateway identifier.
 	TransitGatewayID *string `json:"transit_gateway_id" validate:"required,ne="`
 
+	// The maximum number of resources to return per page.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// A server supplied token determining which resource to start the page on.
+	Start *string `json:"start,omitempty"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -2575,6 +2638,18 @@ func (_options *ListTransitGatewayConnectionsOptions) SetTransitGatewayID(transi
 	return _options
 }
 
+// SetLimit : Allow user to set Limit
+func (_options *ListTransitGatewayConnectionsOptions) SetLimit(limit int64) *ListTransitGatewayConnectionsOptions {
+	_options.Limit = core.Int64Ptr(limit)
+	return _options
+}
+
+// SetStart : Allow user to set Start
+func (_options *ListTransitGatewayConnectionsOptions) SetStart(start string) *ListTransitGatewayConnectionsOptions {
+	_options.Start = core.StringPtr(start)
+	return _options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *ListTransitGatewayConnectionsOptions) SetHeaders(param map[string]string) *ListTransitGatewayConnectionsOptions {
 	options.Headers = param
@@ -3399,7 +3474,10 @@ func (resp *TransitConnectionCollection) GetNextStart() (*string, error) {
 	if core.IsNil(resp.Next) {
 		return nil, nil
 	}
-	return resp.Next.Start, nil
+	if resp.Next.Start != nil {
+		return resp.Next.Start, nil
+	}
+	return core.GetQueryParam(resp.Next.Href, "start")
 }
 
 // TransitConnectionCollectionFirst : A reference to the first page of resources.
@@ -3571,7 +3649,10 @@ func (resp *TransitGatewayCollection) GetNextStart() (*string, error) {
 	if core.IsNil(resp.Next) {
 		return nil, nil
 	}
-	return resp.Next.Start, nil
+	if resp.Next.Start != nil {
+		return resp.Next.Start, nil
+	}
+	return core.GetQueryParam(resp.Next.Href, "start")
 }
 
 // TransitGatewayCollectionFirst : A reference to the first page of resources.
@@ -3619,6 +3700,15 @@ func UnmarshalTransitGatewayCollectionNext(m map[string]json.RawMessage, result
 type TransitGatewayConnectionCollection struct {
 	// Array of transit gateways network Connections.
 	Connections []TransitGatewayConnectionCust `json:"connections" validate:"required"`
+
+	// A reference to the first page of resources.
+	First *TransitGatewayConnectionCollectionFirst `json:"first,omitempty"`
+
+	// The maximum number of connections returned on one request.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// A reference to the next page of resources; this reference is included for all pages except the last page.
+	Next *TransitGatewayConnectionCollectionNext `json:"next,omitempty"`
 }
 
 // UnmarshalTransitGatewayConnectionCollection unmarshals an instance of TransitGatewayConnectionCollection from the specified map of raw messages.
@@ -3628,6 +3718,70 @@ func UnmarshalTransitGatewayConnectionCollection(m map[string]json.RawMessage, r
 	if err != nil {
 		return
 	}
+	err = core.UnmarshalModel(m, "first", &obj.First, UnmarshalTransitGatewayConnectionCollectionFirst)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "next", &obj.Next, UnmarshalTransitGatewayConnectionCollectionNext)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *TransitGatewayConnectionCollection) GetNextStart() (*string, error) {
+	if core.IsNil(resp.Next) {
+		return nil, nil
+	}
+	if resp.Next.Start != nil {
+		return resp.Next.Start, nil
+	}
+	return core.GetQueryParam(resp.Next.Href, "start")
+}
+
+// TransitGatewayConnectionCollectionFirst : A reference to the first page of resources.
+type TransitGatewayConnectionCollectionFirst struct {
+	// url.
+	Href *string `json:"href" validate:"required"`
+}
+
+// UnmarshalTransitGatewayConnectionCollectionFirst unmarshals an instance of TransitGatewayConnectionCollectionFirst from the specified map of raw messages.
+func UnmarshalTransitGatewayConnectionCollectionFirst(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(TransitGatewayConnectionCollectionFirst)
+	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// TransitGatewayConnectionCollectionNext : A reference to the next page of resources; this reference is included for all pages except the last page.
+type TransitGatewayConnectionCollectionNext struct {
+	// url.
+	Href *string `json:"href,omitempty"`
+
+	// server generated start token for next page of resources.
+	Start *string `json:"start,omitempty"`
+}
+
+// UnmarshalTransitGatewayConnectionCollectionNext unmarshals an instance of TransitGatewayConnectionCollectionNext from the specified map of raw messages.
+func UnmarshalTransitGatewayConnectionCollectionNext(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(TransitGatewayConnectionCollectionNext)
+	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "start", &obj.Start)
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4316,3 +4470,285 @@ func UnmarshalZoneIdentityByName(m map[string]json.RawMessage, result interface{
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ConnectionsPager can be used to simplify the use of the "ListConnections" method.
+type ConnectionsPager struct {
+	hasNext     bool
+	options     *ListConnectionsOptions
+	client      *TransitGatewayApisV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewConnectionsPager returns a new ConnectionsPager instance.
+func (transitGatewayApis *TransitGatewayApisV1) NewConnectionsPager(options *ListConnectionsOptions) (pager *ConnectionsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Start != nil && *options.Start != "" {
+		err = fmt.Errorf("the 'options.Start' field should not be set")
+		return
+	}
+
+	var optionsCopy ListConnectionsOptions = *options
+	pager = &ConnectionsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  transitGatewayApis,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ConnectionsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ConnectionsPager) GetNextWithContext(ctx context.Context) (page []TransitConnection, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.ListConnectionsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextStart()
+	if err != nil {
+		return
+	}
+	// Stop if the service hands back the token of the page just retrieved, rather than loop on it.
+	if next != nil && (*next == "" || (pager.options.Start != nil && *next == *pager.options.Start)) {
+		next = nil
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Connections
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ConnectionsPager) GetAllWithContext(ctx context.Context) (allItems []TransitConnection, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []TransitConnection
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ConnectionsPager) GetNext() (page []TransitConnection, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ConnectionsPager) GetAll() (allItems []TransitConnection, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// TransitGatewaysPager can be used to simplify the use of the "ListTransitGateways" method.
+type TransitGatewaysPager struct {
+	hasNext     bool
+	options     *ListTransitGatewaysOptions
+	client      *TransitGatewayApisV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewTransitGatewaysPager returns a new TransitGatewaysPager instance.
+func (transitGatewayApis *TransitGatewayApisV1) NewTransitGatewaysPager(options *ListTransitGatewaysOptions) (pager *TransitGatewaysPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Start != nil && *options.Start != "" {
+		err = fmt.Errorf("the 'options.Start' field should not be set")
+		return
+	}
+
+	var optionsCopy ListTransitGatewaysOptions = *options
+	pager = &TransitGatewaysPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  transitGatewayApis,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *TransitGatewaysPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *TransitGatewaysPager) GetNextWithContext(ctx context.Context) (page []TransitGateway, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.ListTransitGatewaysWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextStart()
+	if err != nil {
+		return
+	}
+	// Stop if the service hands back the token of the page just retrieved, rather than loop on it.
+	if next != nil && (*next == "" || (pager.options.Start != nil && *next == *pager.options.Start)) {
+		next = nil
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.TransitGateways
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *TransitGatewaysPager) GetAllWithContext(ctx context.Context) (allItems []TransitGateway, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []TransitGateway
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *TransitGatewaysPager) GetNext() (page []TransitGateway, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *TransitGatewaysPager) GetAll() (allItems []TransitGateway, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// TransitGatewayConnectionsPager can be used to simplify the use of the "ListTransitGatewayConnections" method.
+type TransitGatewayConnectionsPager struct {
+	hasNext     bool
+	options     *ListTransitGatewayConnectionsOptions
+	client      *TransitGatewayApisV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewTransitGatewayConnectionsPager returns a new TransitGatewayConnectionsPager instance.
+func (transitGatewayApis *TransitGatewayApisV1) NewTransitGatewayConnectionsPager(options *ListTransitGatewayConnectionsOptions) (pager *TransitGatewayConnectionsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Start != nil && *options.Start != "" {
+		err = fmt.Errorf("the 'options.Start' field should not be set")
+		return
+	}
+
+	var optionsCopy ListTransitGatewayConnectionsOptions = *options
+	pager = &TransitGatewayConnectionsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  transitGatewayApis,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *TransitGatewayConnectionsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *TransitGatewayConnectionsPager) GetNextWithContext(ctx context.Context) (page []TransitGatewayConnectionCust, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.ListTransitGatewayConnectionsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	next, err := result.GetNextStart()
+	if err != nil {
+		return
+	}
+	// Stop if the service hands back the token of the page just retrieved, rather than loop on it.
+	if next != nil && (*next == "" || (pager.options.Start != nil && *next == *pager.options.Start)) {
+		next = nil
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Connections
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *TransitGatewayConnectionsPager) GetAllWithContext(ctx context.Context) (allItems []TransitGatewayConnectionCust, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []TransitGatewayConnectionCust
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *TransitGatewayConnectionsPager) GetNext() (page []TransitGatewayConnectionCust, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *TransitGatewayConnectionsPager) GetAll() (allItems []TransitGatewayConnectionCust, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewayapisv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`TransitGatewayApisV1 errors`, func() {
+	Describe(`GetTransitGateway(getTransitGatewayOptions *GetTransitGatewayOptions)`, func() {
+		Context(`Using mock server endpoint returning an error`, func() {
+			It(`Invoke GetTransitGateway returning a *common.APIError`, func() {
+				testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					Expect(req.URL.EscapedPath()).To(Equal("/transit_gateways/tgw-1"))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(404)
+					fmt.Fprintf(res, "%s", `{"errors": [{"code": "not_found", "message": "Transit gateway not found", "more_info": "https://cloud.ibm.com/docs"}], "trace": "trace-1"}`)
+				}))
+				defer testServer.Close()
+
+				transitGatewayApisService, serviceErr := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+					Version:       core.StringPtr("testString"),
+				})
+				Expect(serviceErr).To(BeNil())
+
+				result, response, operationErr := transitGatewayApisService.GetTransitGateway(transitGatewayApisService.NewGetTransitGatewayOptions("tgw-1"))
+				Expect(operationErr).ToNot(BeNil())
+				Expect(result).To(BeNil())
+				Expect(response.StatusCode).To(Equal(404))
+				Expect(operationErr.Error()).To(Equal("Transit gateway not found"))
+
+				var apiErr *common.APIError
+				Expect(errors.As(operationErr, &apiErr)).To(BeTrue())
+				Expect(apiErr.Codes()).To(Equal([]string{"not_found"}))
+				Expect(apiErr.Trace).To(Equal("trace-1"))
+				Expect(apiErr.Response).To(Equal(response))
+				Expect(common.IsNotFound(operationErr)).To(BeTrue())
+			})
+		})
+	})
+})
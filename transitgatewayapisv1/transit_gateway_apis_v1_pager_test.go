@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewayapisv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`TransitGatewayApisV1 pagers`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+	version := "testString"
+
+	newService := func() *transitgatewayapisv1.TransitGatewayApisV1 {
+		transitGatewayApisService, serviceErr := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr(version),
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(transitGatewayApisService).ToNot(BeNil())
+		return transitGatewayApisService
+	}
+
+	Describe(`TransitGatewaysPager`, func() {
+		Context(`Using mock server endpoint with start tokens only in the "next" href`, func() {
+			BeforeEach(func() {
+				requestCount = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					requestCount++
+					Expect(req.URL.EscapedPath()).To(Equal("/transit_gateways"))
+					Expect(req.URL.Query()["version"]).To(Equal([]string{"testString"}))
+					Expect(req.URL.Query()["limit"]).To(Equal([]string{"1"}))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					switch requestCount {
+					case 1:
+						Expect(req.URL.Query()["start"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"first": {"href": "https://transit.cloud.ibm.com/v1/transit_gateways?limit=1"}, "limit": 1, "next": {"href": "https://transit.cloud.ibm.com/v1/transit_gateways?start=token-2&limit=1"}, "transit_gateways": [{"id": "gateway-1", "name": "gw1", "status": "available"}]}`)
+					case 2:
+						Expect(req.URL.Query()["start"]).To(Equal([]string{"token-2"}))
+						fmt.Fprintf(res, "%s", `{"first": {"href": "https://transit.cloud.ibm.com/v1/transit_gateways?limit=1"}, "limit": 1, "next": {"href": "https://transit.cloud.ibm.com/v1/transit_gateways?start=token-3&limit=1", "start": "token-3"}, "transit_gateways": [{"id": "gateway-2", "name": "gw2", "status": "available"}]}`)
+					default:
+						Expect(req.URL.Query()["start"]).To(Equal([]string{"token-3"}))
+						fmt.Fprintf(res, "%s", `{"first": {"href": "https://transit.cloud.ibm.com/v1/transit_gateways?limit=1"}, "limit": 1, "transit_gateways": [{"id": "gateway-3", "name": "gw3", "status": "pending"}]}`)
+					}
+				}))
+			})
+			It(`Use TransitGatewaysPager.GetNext successfully`, func() {
+				transitGatewayApisService := newService()
+
+				listTransitGatewaysOptionsModel := transitGatewayApisService.NewListTransitGatewaysOptions()
+				listTransitGatewaysOptionsModel.SetLimit(1)
+
+				pager, err := transitGatewayApisService.NewTransitGatewaysPager(listTransitGatewaysOptionsModel)
+				Expect(err).To(BeNil())
+
+				var allResults []transitgatewayapisv1.TransitGateway
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(3))
+				Expect(*allResults[2].ID).To(Equal("gateway-3"))
+				Expect(requestCount).To(Equal(3))
+				Expect(listTransitGatewaysOptionsModel.Start).To(BeNil())
+			})
+			It(`Use TransitGatewaysPager.GetAll successfully`, func() {
+				transitGatewayApisService := newService()
+
+				pager, err := transitGatewayApisService.NewTransitGatewaysPager(transitGatewayApisService.NewListTransitGatewaysOptions().SetLimit(1))
+				Expect(err).To(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(len(allResults)).To(Equal(3))
+			})
+			It(`Invoke TransitGatewaysPager.GetAllWithContext with a cancelled context`, func() {
+				transitGatewayApisService := newService()
+
+				pager, err := transitGatewayApisService.NewTransitGatewaysPager(transitGatewayApisService.NewListTransitGatewaysOptions().SetLimit(1))
+				Expect(err).To(BeNil())
+
+				ctx, cancelFunc := context.WithCancel(context.Background())
+				cancelFunc()
+				_, err = pager.GetAllWithContext(ctx)
+				Expect(err).To(Equal(context.Canceled))
+				Expect(requestCount).To(Equal(0))
+			})
+			It(`Invoke NewTransitGatewaysPager with error: Start is set`, func() {
+				transitGatewayApisService := newService()
+
+				pager, err := transitGatewayApisService.NewTransitGatewaysPager(transitGatewayApisService.NewListTransitGatewaysOptions().SetStart("token-2"))
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`TransitGatewayConnectionsPager`, func() {
+		Context(`Using mock server endpoint that repeats its start token`, func() {
+			BeforeEach(func() {
+				requestCount = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					requestCount++
+					Expect(req.URL.EscapedPath()).To(Equal("/transit_gateways/testString/connections"))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"connections": [{"id": "connection-%d", "name": "conn", "network_type": "vpc"}], "limit": 1, "next": {"start": "token-2"}}`, requestCount)
+				}))
+			})
+			It(`Use TransitGatewayConnectionsPager.GetAll successfully`, func() {
+				transitGatewayApisService := newService()
+
+				listTransitGatewayConnectionsOptionsModel := transitGatewayApisService.NewListTransitGatewayConnectionsOptions("testString")
+				pager, err := transitGatewayApisService.NewTransitGatewayConnectionsPager(listTransitGatewayConnectionsOptionsModel)
+				Expect(err).To(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+				Expect(requestCount).To(Equal(2))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`GetNextStart`, func() {
+		It(`Invoke GetNextStart with a start token only in the "next" href`, func() {
+			responseObject := new(transitgatewayapisv1.TransitConnectionCollection)
+			responseObject.Next = &transitgatewayapisv1.TransitConnectionCollectionNext{
+				Href: core.StringPtr("https://transit.cloud.ibm.com/v1/connections?start=abc123&limit=50"),
+			}
+
+			value, err := responseObject.GetNextStart()
+			Expect(err).To(BeNil())
+			Expect(value).To(Equal(core.StringPtr("abc123")))
+		})
+		It(`Invoke GetNextStart without a "next" property in the response`, func() {
+			responseObject := new(transitgatewayapisv1.TransitGatewayConnectionCollection)
+
+			value, err := responseObject.GetNextStart()
+			Expect(err).To(BeNil())
+			Expect(value).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewayapisv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/networking-go-sdk/common"
+)
+
+// TransitGatewayConnectionCust_Status_PendingApproval is the status of a cross-account connection that is
+// waiting for the owner of the connected network to approve it.
+const TransitGatewayConnectionCust_Status_PendingApproval = "pending_approval"
+
+// TransitGatewayFailureStatuses are the transit gateway statuses from which a transit gateway
+// will not become available.
+var TransitGatewayFailureStatuses = []string{
+	TransitGateway_Status_Failed,
+	TransitGateway_Status_Deleting,
+}
+
+// ConnectionFailureStatuses are the connection statuses from which a transit gateway connection
+// will not become attached.
+var ConnectionFailureStatuses = []string{
+	TransitGatewayConnectionCust_Status_Failed,
+	TransitGatewayConnectionCust_Status_Deleting,
+	TransitGatewayConnectionCust_Status_Detaching,
+	TransitGatewayConnectionCust_Status_Detached,
+}
+
+// WaitForTransitGatewayAvailable polls GetTransitGateway until the status of the transit gateway is "available",
+// typically after CreateTransitGateway. If the transit gateway reaches one of the TransitGatewayFailureStatuses,
+// or if the wait ends first, a *common.ResourceStateError holding the last observed transit gateway is returned.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForTransitGatewayAvailable(ctx context.Context, id string, waitOptions *common.WaitOptions) (result *TransitGateway, err error) {
+	getTransitGatewayOptions := transitGatewayApis.NewGetTransitGatewayOptions(id)
+	_, err = common.WaitForStatus(ctx, waitOptions, "transit gateway", id,
+		[]string{TransitGateway_Status_Available}, TransitGatewayFailureStatuses,
+		func(ctx context.Context) (string, interface{}, error) {
+			transitGateway, _, err := transitGatewayApis.GetTransitGatewayWithContext(ctx, getTransitGatewayOptions)
+			if err != nil {
+				return "", nil, err
+			}
+			result = transitGateway
+			return core.StringNilMapper(transitGateway.Status), transitGateway, nil
+		})
+	return
+}
+
+// WaitForTransitGatewayDeleted polls GetTransitGateway until the transit gateway no longer exists,
+// typically after DeleteTransitGateway.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForTransitGatewayDeleted(ctx context.Context, id string, waitOptions *common.WaitOptions) (err error) {
+	getTransitGatewayOptions := transitGatewayApis.NewGetTransitGatewayOptions(id)
+	var last *TransitGateway
+	err = common.WaitFor(ctx, waitOptions, func(ctx context.Context) (bool, error) {
+		transitGateway, _, err := transitGatewayApis.GetTransitGatewayWithContext(ctx, getTransitGatewayOptions)
+		if common.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		last = transitGateway
+		return false, nil
+	})
+	if err == context.DeadlineExceeded || err == context.Canceled {
+		stateErr := &common.ResourceStateError{
+			ResourceType: "transit gateway",
+			ID:           id,
+			Err:          err,
+		}
+		if last != nil {
+			stateErr.Status = core.StringNilMapper(last.Status)
+			stateErr.Resource = last
+		}
+		err = stateErr
+	}
+	return
+}
+
+// WaitForConnectionAttached polls GetTransitGatewayConnection until the status of the connection is "attached",
+// typically after CreateTransitGatewayConnection. A cross-account connection stays "pending_approval" until the
+// owner of the connected network approves it, and the wait continues through that status; use
+// WaitForConnectionCreated to stop as soon as the connection awaits approval. If the connection reaches one of
+// the ConnectionFailureStatuses, or if the wait ends first, a *common.ResourceStateError holding the last
+// observed connection is returned.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForConnectionAttached(ctx context.Context, transitGatewayID string, id string, waitOptions *common.WaitOptions) (result *TransitGatewayConnectionCust, err error) {
+	return transitGatewayApis.waitForConnectionStatus(ctx, transitGatewayID, id, waitOptions,
+		TransitGatewayConnectionCust_Status_Attached)
+}
+
+// WaitForConnectionCreated is like WaitForConnectionAttached, but also returns successfully once a cross-account
+// connection is "pending_approval".
+func (transitGatewayApis *TransitGatewayApisV1) WaitForConnectionCreated(ctx context.Context, transitGatewayID string, id string, waitOptions *common.WaitOptions) (result *TransitGatewayConnectionCust, err error) {
+	return transitGatewayApis.waitForConnectionStatus(ctx, transitGatewayID, id, waitOptions,
+		TransitGatewayConnectionCust_Status_Attached, TransitGatewayConnectionCust_Status_PendingApproval)
+}
+
+func (transitGatewayApis *TransitGatewayApisV1) waitForConnectionStatus(ctx context.Context, transitGatewayID string, id string, waitOptions *common.WaitOptions, target ...string) (result *TransitGatewayConnectionCust, err error) {
+	getTransitGatewayConnectionOptions := transitGatewayApis.NewGetTransitGatewayConnectionOptions(transitGatewayID, id)
+	_, err = common.WaitForStatus(ctx, waitOptions, "transit gateway connection", id,
+		target, ConnectionFailureStatuses,
+		func(ctx context.Context) (string, interface{}, error) {
+			connection, _, err := transitGatewayApis.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
+			if err != nil {
+				return "", nil, err
+			}
+			result = connection
+			return core.StringNilMapper(connection.Status), connection, nil
+		})
+	return
+}
+
+// WaitForConnectionDeleted polls GetTransitGatewayConnection until the connection no longer exists,
+// typically after DeleteTransitGatewayConnection.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForConnectionDeleted(ctx context.Context, transitGatewayID string, id string, waitOptions *common.WaitOptions) (err error) {
+	getTransitGatewayConnectionOptions := transitGatewayApis.NewGetTransitGatewayConnectionOptions(transitGatewayID, id)
+	var last *TransitGatewayConnectionCust
+	err = common.WaitFor(ctx, waitOptions, func(ctx context.Context) (bool, error) {
+		connection, _, err := transitGatewayApis.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
+		if common.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		last = connection
+		return false, nil
+	})
+	if err == context.DeadlineExceeded || err == context.Canceled {
+		stateErr := &common.ResourceStateError{
+			ResourceType: "transit gateway connection",
+			ID:           id,
+			Err:          err,
+		}
+		if last != nil {
+			stateErr.Status = core.StringNilMapper(last.Status)
+			stateErr.Resource = last
+		}
+		err = stateErr
+	}
+	return
+}
+
+// WaitForRouteReportComplete polls GetTransitGatewayRouteReport until the status of the route report is
+// "complete", and returns the finished route report. Route reports are created asynchronously by
+// CreateTransitGatewayRouteReport. If the wait ends first, a *common.ResourceStateError holding the last
+// observed route report is returned.
+func (transitGatewayApis *TransitGatewayApisV1) WaitForRouteReportComplete(ctx context.Context, transitGatewayID string, id string, waitOptions *common.WaitOptions) (result *RouteReport, err error) {
+	getTransitGatewayRouteReportOptions := transitGatewayApis.NewGetTransitGatewayRouteReportOptions(transitGatewayID, id)
+	_, err = common.WaitForStatus(ctx, waitOptions, "route report", id,
+		[]string{RouteReport_Status_Complete}, nil,
+		func(ctx context.Context) (string, interface{}, error) {
+			routeReport, _, err := transitGatewayApis.GetTransitGatewayRouteReportWithContext(ctx, getTransitGatewayRouteReportOptions)
+			if err != nil {
+				return "", nil, err
+			}
+			result = routeReport
+			return core.StringNilMapper(routeReport.Status), routeReport, nil
+		})
+	return
+}
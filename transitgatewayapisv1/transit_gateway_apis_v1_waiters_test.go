@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transitgatewayapisv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/common"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`TransitGatewayApisV1 waiters`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+	version := "testString"
+	waitOptions := &common.WaitOptions{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     2 * time.Millisecond,
+	}
+
+	// serveStatuses answers each request with the next of the given status codes and
+	// response bodies, repeating the last one once the list is exhausted.
+	serveStatuses := func(path string, codes []int, bodies []string) {
+		requestCount = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal(path))
+			Expect(req.Method).To(Equal("GET"))
+			i := requestCount
+			if i >= len(codes) {
+				i = len(codes) - 1
+			}
+			requestCount++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(codes[i])
+			fmt.Fprintf(res, "%s", bodies[i])
+		}))
+	}
+
+	newService := func() *transitgatewayapisv1.TransitGatewayApisV1 {
+		transitGatewayApisService, serviceErr := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       core.StringPtr(version),
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(transitGatewayApisService).ToNot(BeNil())
+		return transitGatewayApisService
+	}
+
+	transitGateway := func(status string) string {
+		return fmt.Sprintf(`{"id": "tgw-1", "name": "my-transit-gateway", "status": "%s"}`, status)
+	}
+	connection := func(status string) string {
+		return fmt.Sprintf(`{"id": "conn-1", "name": "conn", "network_type": "vpc", "status": "%s"}`, status)
+	}
+	routeReport := func(status string) string {
+		return fmt.Sprintf(`{"id": "report-1", "status": "%s", "connections": [{"id": "conn-1", "name": "conn", "type": "vpc", "routes": [{"prefix": "10.0.0.0/16"}]}], "overlapping_routes": []}`, status)
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`WaitForTransitGatewayAvailable`, func() {
+		It(`Invoke WaitForTransitGatewayAvailable successfully`, func() {
+			serveStatuses("/transit_gateways/tgw-1", []int{200, 200},
+				[]string{transitGateway("pending"), transitGateway("available")})
+
+			result, err := newService().WaitForTransitGatewayAvailable(context.Background(), "tgw-1", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*result.Status).To(Equal(transitgatewayapisv1.TransitGateway_Status_Available))
+			Expect(requestCount).To(Equal(2))
+		})
+		It(`Invoke WaitForTransitGatewayAvailable with error: terminal failure status`, func() {
+			serveStatuses("/transit_gateways/tgw-1", []int{200}, []string{transitGateway("failed")})
+
+			_, err := newService().WaitForTransitGatewayAvailable(context.Background(), "tgw-1", waitOptions)
+
+			var stateErr *common.ResourceStateError
+			Expect(errors.As(err, &stateErr)).To(BeTrue())
+			Expect(stateErr.Status).To(Equal("failed"))
+			Expect(stateErr.Resource).ToNot(BeNil())
+		})
+	})
+	Describe(`WaitForTransitGatewayDeleted`, func() {
+		It(`Invoke WaitForTransitGatewayDeleted successfully`, func() {
+			serveStatuses("/transit_gateways/tgw-1", []int{200, 404},
+				[]string{transitGateway("deleting"), `{"errors": [{"code": "not_found", "message": "Not found"}]}`})
+
+			err := newService().WaitForTransitGatewayDeleted(context.Background(), "tgw-1", waitOptions)
+			Expect(err).To(BeNil())
+		})
+	})
+	Describe(`WaitForConnectionAttached`, func() {
+		It(`Invoke WaitForConnectionAttached successfully through pending_approval`, func() {
+			serveStatuses("/transit_gateways/tgw-1/connections/conn-1", []int{200, 200, 200},
+				[]string{connection("pending"), connection("pending_approval"), connection("attached")})
+
+			result, err := newService().WaitForConnectionAttached(context.Background(), "tgw-1", "conn-1", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*result.Status).To(Equal(transitgatewayapisv1.TransitGatewayConnectionCust_Status_Attached))
+			Expect(requestCount).To(Equal(3))
+		})
+		It(`Invoke WaitForConnectionCreated successfully at pending_approval`, func() {
+			serveStatuses("/transit_gateways/tgw-1/connections/conn-1", []int{200, 200},
+				[]string{connection("pending"), connection("pending_approval")})
+
+			result, err := newService().WaitForConnectionCreated(context.Background(), "tgw-1", "conn-1", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*result.Status).To(Equal(transitgatewayapisv1.TransitGatewayConnectionCust_Status_PendingApproval))
+		})
+		It(`Invoke WaitForConnectionAttached with error: context deadline`, func() {
+			serveStatuses("/transit_gateways/tgw-1/connections/conn-1", []int{200}, []string{connection("pending")})
+
+			options := &common.WaitOptions{InitialInterval: time.Millisecond, Timeout: 50 * time.Millisecond}
+			_, err := newService().WaitForConnectionAttached(context.Background(), "tgw-1", "conn-1", options)
+
+			var stateErr *common.ResourceStateError
+			Expect(errors.As(err, &stateErr)).To(BeTrue())
+			Expect(stateErr.Status).To(Equal("pending"))
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+	})
+	Describe(`WaitForConnectionDeleted`, func() {
+		It(`Invoke WaitForConnectionDeleted successfully`, func() {
+			serveStatuses("/transit_gateways/tgw-1/connections/conn-1", []int{200, 404},
+				[]string{connection("deleting"), `{"errors": [{"code": "not_found", "message": "Not found"}]}`})
+
+			err := newService().WaitForConnectionDeleted(context.Background(), "tgw-1", "conn-1", waitOptions)
+			Expect(err).To(BeNil())
+		})
+	})
+	Describe(`WaitForRouteReportComplete`, func() {
+		It(`Invoke WaitForRouteReportComplete successfully`, func() {
+			serveStatuses("/transit_gateways/tgw-1/route_reports/report-1", []int{200, 200},
+				[]string{routeReport("pending"), routeReport("complete")})
+
+			result, err := newService().WaitForRouteReportComplete(context.Background(), "tgw-1", "report-1", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*result.Status).To(Equal(transitgatewayapisv1.RouteReport_Status_Complete))
+			Expect(len(result.Connections)).To(Equal(1))
+		})
+		It(`Invoke WaitForRouteReportComplete with error: request error`, func() {
+			serveStatuses("/transit_gateways/tgw-1/route_reports/report-1", []int{404},
+				[]string{`{"errors": [{"code": "not_found", "message": "Not found"}]}`})
+
+			result, err := newService().WaitForRouteReportComplete(context.Background(), "tgw-1", "report-1", waitOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(result).To(BeNil())
+		})
+	})
+})
@@ -178,6 +178,24 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) DisableRetries() {
 	userAgentBlockingRules.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (userAgentBlockingRules *UserAgentBlockingRulesV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(userAgentBlockingRules.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (userAgentBlockingRules *UserAgentBlockingRulesV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(userAgentBlockingRules.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (userAgentBlockingRules *UserAgentBlockingRulesV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(userAgentBlockingRules.Service, middleware...)
+}
+
 // ListAllZoneUserAgentRules : List all user-agent blocking rules
 // List all user agent blocking rules.
 func (userAgentBlockingRules *UserAgentBlockingRulesV1) ListAllZoneUserAgentRules(listAllZoneUserAgentRulesOptions *ListAllZoneUserAgentRulesOptions) (result *ListUseragentRulesResp, response *core.DetailedResponse, err error) {
@@ -229,6 +247,7 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) ListAllZoneUserAgentRule
 	var rawResponse map[string]json.RawMessage
 	response, err = userAgentBlockingRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListUseragentRulesResp)
@@ -303,6 +322,7 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) CreateZoneUserAgentRuleW
 	var rawResponse map[string]json.RawMessage
 	response, err = userAgentBlockingRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalUseragentRuleResp)
@@ -363,6 +383,7 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) DeleteZoneUserAgentRuleW
 	var rawResponse map[string]json.RawMessage
 	response, err = userAgentBlockingRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteUseragentRuleResp)
@@ -423,6 +444,7 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) GetUserAgentRuleWithCont
 	var rawResponse map[string]json.RawMessage
 	response, err = userAgentBlockingRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalUseragentRuleResp)
@@ -502,6 +524,7 @@ func (userAgentBlockingRules *UserAgentBlockingRulesV1) UpdateUserAgentRuleWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = userAgentBlockingRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalUseragentRuleResp)
@@ -1035,3 +1058,97 @@ func UnmarshalUseragentRuleResp(m map[string]json.RawMessage, result interface{}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZoneUserAgentRulesPager can be used to simplify the use of the "ListAllZoneUserAgentRules" method.
+type ZoneUserAgentRulesPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListAllZoneUserAgentRulesOptions
+	client   *UserAgentBlockingRulesV1
+}
+
+// NewZoneUserAgentRulesPager returns a new ZoneUserAgentRulesPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (userAgentBlockingRules *UserAgentBlockingRulesV1) NewZoneUserAgentRulesPager(options *ListAllZoneUserAgentRulesOptions) (pager *ZoneUserAgentRulesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListAllZoneUserAgentRulesOptions = *options
+	pager = &ZoneUserAgentRulesPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   userAgentBlockingRules,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZoneUserAgentRulesPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZoneUserAgentRulesPager) GetNextWithContext(ctx context.Context) (page []UseragentRuleObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListAllZoneUserAgentRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ListUseragentRulesRespResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZoneUserAgentRulesPager) GetAllWithContext(ctx context.Context) (allItems []UseragentRuleObject, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []UseragentRuleObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneUserAgentRulesPager) GetNext() (page []UseragentRuleObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneUserAgentRulesPager) GetAll() (allItems []UseragentRuleObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -178,6 +178,24 @@ func (wafApi *WafApiV1) DisableRetries() {
 	wafApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (wafApi *WafApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(wafApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (wafApi *WafApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(wafApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (wafApi *WafApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(wafApi.Service, middleware...)
+}
+
 // GetWafSettings : Get WAF setting
 // Get WAF of a specific zone.
 func (wafApi *WafApiV1) GetWafSettings(getWafSettingsOptions *GetWafSettingsOptions) (result *WafResponse, response *core.DetailedResponse, err error) {
@@ -222,6 +240,7 @@ func (wafApi *WafApiV1) GetWafSettingsWithContext(ctx context.Context, getWafSet
 	var rawResponse map[string]json.RawMessage
 	response, err = wafApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafResponse)
@@ -287,6 +306,7 @@ func (wafApi *WafApiV1) UpdateWafSettingsWithContext(ctx context.Context, update
 	var rawResponse map[string]json.RawMessage
 	response, err = wafApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafResponse)
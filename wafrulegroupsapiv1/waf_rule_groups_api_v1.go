@@ -178,6 +178,24 @@ func (wafRuleGroupsApi *WafRuleGroupsApiV1) DisableRetries() {
 	wafRuleGroupsApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (wafRuleGroupsApi *WafRuleGroupsApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(wafRuleGroupsApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (wafRuleGroupsApi *WafRuleGroupsApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(wafRuleGroupsApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (wafRuleGroupsApi *WafRuleGroupsApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(wafRuleGroupsApi.Service, middleware...)
+}
+
 // ListWafRuleGroups : List all WAF rule groups
 // List all WAF rule groups contained within a package.
 func (wafRuleGroupsApi *WafRuleGroupsApiV1) ListWafRuleGroups(listWafRuleGroupsOptions *ListWafRuleGroupsOptions) (result *WafGroupsResponse, response *core.DetailedResponse, err error) {
@@ -252,6 +270,7 @@ func (wafRuleGroupsApi *WafRuleGroupsApiV1) ListWafRuleGroupsWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRuleGroupsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafGroupsResponse)
@@ -313,6 +332,7 @@ func (wafRuleGroupsApi *WafRuleGroupsApiV1) GetWafRuleGroupWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRuleGroupsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafGroupResponse)
@@ -384,6 +404,7 @@ func (wafRuleGroupsApi *WafRuleGroupsApiV1) UpdateWafRuleGroupWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRuleGroupsApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafGroupResponse)
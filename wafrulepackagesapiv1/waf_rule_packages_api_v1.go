@@ -178,6 +178,24 @@ func (wafRulePackagesApi *WafRulePackagesApiV1) DisableRetries() {
 	wafRulePackagesApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (wafRulePackagesApi *WafRulePackagesApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(wafRulePackagesApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (wafRulePackagesApi *WafRulePackagesApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(wafRulePackagesApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (wafRulePackagesApi *WafRulePackagesApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(wafRulePackagesApi.Service, middleware...)
+}
+
 // ListWafPackages : List all WAF rule packages
 // Get firewall packages for a zone.
 func (wafRulePackagesApi *WafRulePackagesApiV1) ListWafPackages(listWafPackagesOptions *ListWafPackagesOptions) (result *WafPackagesResponse, response *core.DetailedResponse, err error) {
@@ -241,6 +259,7 @@ func (wafRulePackagesApi *WafRulePackagesApiV1) ListWafPackagesWithContext(ctx c
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulePackagesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafPackagesResponse)
@@ -301,6 +320,7 @@ func (wafRulePackagesApi *WafRulePackagesApiV1) GetWafPackageWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulePackagesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafPackageResponse)
@@ -374,6 +394,7 @@ func (wafRulePackagesApi *WafRulePackagesApiV1) UpdateWafPackageWithContext(ctx
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulePackagesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafPackageResponse)
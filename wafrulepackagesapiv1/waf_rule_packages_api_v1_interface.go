@@ -0,0 +1,37 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wafrulepackagesapiv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// WafRulePackagesApiV1API is the interface of the operations of WafRulePackagesApiV1, through which they can be replaced
+// in unit tests, for instance with the mocks.WafRulePackagesApiV1 of the mocks package.
+type WafRulePackagesApiV1API interface {
+	ListWafPackages(listWafPackagesOptions *ListWafPackagesOptions) (result *WafPackagesResponse, response *core.DetailedResponse, err error)
+	ListWafPackagesWithContext(ctx context.Context, listWafPackagesOptions *ListWafPackagesOptions) (result *WafPackagesResponse, response *core.DetailedResponse, err error)
+	GetWafPackage(getWafPackageOptions *GetWafPackageOptions) (result *WafPackageResponse, response *core.DetailedResponse, err error)
+	GetWafPackageWithContext(ctx context.Context, getWafPackageOptions *GetWafPackageOptions) (result *WafPackageResponse, response *core.DetailedResponse, err error)
+	UpdateWafPackage(updateWafPackageOptions *UpdateWafPackageOptions) (result *WafPackageResponse, response *core.DetailedResponse, err error)
+	UpdateWafPackageWithContext(ctx context.Context, updateWafPackageOptions *UpdateWafPackageOptions) (result *WafPackageResponse, response *core.DetailedResponse, err error)
+}
+
+// WafRulePackagesApiV1 implements WafRulePackagesApiV1API.
+var _ WafRulePackagesApiV1API = (*WafRulePackagesApiV1)(nil)
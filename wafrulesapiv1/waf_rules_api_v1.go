@@ -178,6 +178,24 @@ func (wafRulesApi *WafRulesApiV1) DisableRetries() {
 	wafRulesApi.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (wafRulesApi *WafRulesApiV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(wafRulesApi.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (wafRulesApi *WafRulesApiV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(wafRulesApi.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (wafRulesApi *WafRulesApiV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(wafRulesApi.Service, middleware...)
+}
+
 // ListWafRules : List all WAF rules
 // List all Web Application Firewall (WAF) rules.
 func (wafRulesApi *WafRulesApiV1) ListWafRules(listWafRulesOptions *ListWafRulesOptions) (result *WafRulesResponse, response *core.DetailedResponse, err error) {
@@ -255,6 +273,7 @@ func (wafRulesApi *WafRulesApiV1) ListWafRulesWithContext(ctx context.Context, l
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafRulesResponse)
@@ -316,6 +335,7 @@ func (wafRulesApi *WafRulesApiV1) GetWafRuleWithContext(ctx context.Context, get
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafRuleResponse)
@@ -390,6 +410,7 @@ func (wafRulesApi *WafRulesApiV1) UpdateWafRuleWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = wafRulesApi.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWafRuleResponse)
@@ -171,6 +171,24 @@ func (webhooks *WebhooksV1) DisableRetries() {
 	webhooks.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (webhooks *WebhooksV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(webhooks.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (webhooks *WebhooksV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(webhooks.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (webhooks *WebhooksV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(webhooks.Service, middleware...)
+}
+
 // ListWebhooks : List alert webhooks
 // List configured alert webhooks for the CIS instance.
 func (webhooks *WebhooksV1) ListWebhooks(listWebhooksOptions *ListWebhooksOptions) (result *ListAlertWebhooksResp, response *core.DetailedResponse, err error) {
@@ -214,6 +232,7 @@ func (webhooks *WebhooksV1) ListWebhooksWithContext(ctx context.Context, listWeb
 	var rawResponse map[string]json.RawMessage
 	response, err = webhooks.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -286,6 +305,7 @@ func (webhooks *WebhooksV1) CreateAlertWebhookWithContext(ctx context.Context, c
 	var rawResponse map[string]json.RawMessage
 	response, err = webhooks.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -347,6 +367,7 @@ func (webhooks *WebhooksV1) GetWebhookWithContext(ctx context.Context, getWebhoo
 	var rawResponse map[string]json.RawMessage
 	response, err = webhooks.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -424,6 +445,7 @@ func (webhooks *WebhooksV1) UpdateAlertWebhookWithContext(ctx context.Context, u
 	var rawResponse map[string]json.RawMessage
 	response, err = webhooks.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
@@ -485,6 +507,7 @@ func (webhooks *WebhooksV1) DeleteWebhookWithContext(ctx context.Context, delete
 	var rawResponse map[string]json.RawMessage
 	response, err = webhooks.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	if rawResponse != nil {
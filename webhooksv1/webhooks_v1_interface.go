@@ -0,0 +1,41 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhooksv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// WebhooksV1API is the interface of the operations of WebhooksV1, through which they can be replaced
+// in unit tests, for instance with the mocks.WebhooksV1 of the mocks package.
+type WebhooksV1API interface {
+	ListWebhooks(listWebhooksOptions *ListWebhooksOptions) (result *ListAlertWebhooksResp, response *core.DetailedResponse, err error)
+	ListWebhooksWithContext(ctx context.Context, listWebhooksOptions *ListWebhooksOptions) (result *ListAlertWebhooksResp, response *core.DetailedResponse, err error)
+	CreateAlertWebhook(createAlertWebhookOptions *CreateAlertWebhookOptions) (result *WebhookSuccessResp, response *core.DetailedResponse, err error)
+	CreateAlertWebhookWithContext(ctx context.Context, createAlertWebhookOptions *CreateAlertWebhookOptions) (result *WebhookSuccessResp, response *core.DetailedResponse, err error)
+	GetWebhook(getWebhookOptions *GetWebhookOptions) (result *GetAlertWebhookResp, response *core.DetailedResponse, err error)
+	GetWebhookWithContext(ctx context.Context, getWebhookOptions *GetWebhookOptions) (result *GetAlertWebhookResp, response *core.DetailedResponse, err error)
+	UpdateAlertWebhook(updateAlertWebhookOptions *UpdateAlertWebhookOptions) (result *WebhookSuccessResp, response *core.DetailedResponse, err error)
+	UpdateAlertWebhookWithContext(ctx context.Context, updateAlertWebhookOptions *UpdateAlertWebhookOptions) (result *WebhookSuccessResp, response *core.DetailedResponse, err error)
+	DeleteWebhook(deleteWebhookOptions *DeleteWebhookOptions) (result *WebhookSuccessResp, response *core.DetailedResponse, err error)
+	DeleteWebhookWithContext(ctx context.Context, deleteWebhookOptions *DeleteWebhookOptions) (result *WebhookSuccessResp, response *core.DetailedResponse, err error)
+}
+
+// WebhooksV1 implements WebhooksV1API.
+var _ WebhooksV1API = (*WebhooksV1)(nil)
@@ -0,0 +1,413 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zonefile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+)
+
+// ParseError describes a syntax error in a zone file.
+type ParseError struct {
+	// The line of the zone file at which the erroneous entry starts.
+	Line int
+
+	// A description of the error.
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("zone file line %d: %s", e.Line, e.Message)
+}
+
+// token is a word of a zone file entry.
+type token struct {
+	text   string
+	quoted bool
+}
+
+// entry is a directive or a record of a zone file, which may span several lines within parentheses.
+type entry struct {
+	line int
+
+	// True if the entry starts with blank space, in which case the owner name is the previous one.
+	inheritOwner bool
+
+	tokens []token
+}
+
+// Parse parses a zone file. Relative names are qualified with origin until a $ORIGIN directive
+// changes it; origin may be empty if the zone file starts with a $ORIGIN directive or only holds
+// fully qualified names. The Origin of the zone is origin or, if empty, that of the first $ORIGIN
+// directive. A record without TTL takes the TTL of the last $TTL directive or, failing that, of the
+// previous record.
+func Parse(r io.Reader, origin string) (zone *Zone, err error) {
+	p := &parser{
+		origin: strings.ToLower(strings.TrimSuffix(origin, ".")),
+	}
+	zone = &Zone{Origin: p.origin}
+
+	entries, err := splitEntries(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		var record *Record
+		record, err = p.parseEntry(zone, e)
+		if err != nil {
+			return nil, err
+		}
+		if record != nil {
+			zone.Records = append(zone.Records, *record)
+		}
+	}
+	zone.TTL = p.defaultTTL
+	return
+}
+
+type parser struct {
+	origin     string
+	defaultTTL int64
+	lastTTL    int64
+	lastOwner  string
+}
+
+// parseEntry processes a directive, or returns the record of a record entry. The records of the types
+// managed by the service are skipped. The first $ORIGIN directive sets the origin of the zone if unset.
+func (p *parser) parseEntry(zone *Zone, e entry) (record *Record, err error) {
+	fail := func(format string, args ...interface{}) (*Record, error) {
+		return nil, &ParseError{Line: e.line, Message: fmt.Sprintf(format, args...)}
+	}
+	tokens := e.tokens
+
+	if !e.inheritOwner && !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+		directive := strings.ToUpper(tokens[0].text)
+		switch directive {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return fail("$ORIGIN requires one domain name")
+			}
+			p.origin, err = p.qualify(tokens[1].text)
+			if err != nil {
+				return fail("%s", err.Error())
+			}
+			if zone.Origin == "" {
+				zone.Origin = p.origin
+			}
+		case "$TTL":
+			if len(tokens) != 2 {
+				return fail("$TTL requires one TTL value")
+			}
+			p.defaultTTL, err = parseTTL(tokens[1].text)
+			if err != nil {
+				return fail("%s", err.Error())
+			}
+		default:
+			return fail("unsupported directive %s", tokens[0].text)
+		}
+		return nil, nil
+	}
+
+	owner := p.lastOwner
+	if !e.inheritOwner {
+		owner, err = p.qualify(tokens[0].text)
+		if err != nil {
+			return fail("%s", err.Error())
+		}
+		tokens = tokens[1:]
+	}
+	if owner == "" {
+		return fail("missing owner name")
+	}
+	p.lastOwner = owner
+
+	// The TTL and the class are optional and may appear in either order.
+	ttl := int64(-1)
+	for len(tokens) > 0 && !tokens[0].quoted {
+		if strings.EqualFold(tokens[0].text, ClassIN) {
+			tokens = tokens[1:]
+		} else if value, ttlErr := parseTTL(tokens[0].text); ttlErr == nil && ttl < 0 {
+			ttl = value
+			tokens = tokens[1:]
+		} else {
+			break
+		}
+	}
+	if len(tokens) == 0 {
+		return fail("missing record type")
+	}
+	if ttl < 0 {
+		ttl = p.defaultTTL
+		if ttl == 0 {
+			ttl = p.lastTTL
+		}
+	}
+	p.lastTTL = ttl
+
+	record = &Record{
+		Name: owner,
+		TTL:  ttl,
+		Type: strings.ToUpper(tokens[0].text),
+	}
+	args := tokens[1:]
+	argc := func(n int) error {
+		if len(args) != n {
+			return fmt.Errorf("%s record requires %d data fields, found %d", record.Type, n, len(args))
+		}
+		return nil
+	}
+
+	switch record.Type {
+	case "SOA", "NS":
+		return nil, nil
+	case dnssvcsv1.ResourceRecord_Type_A, dnssvcsv1.ResourceRecord_Type_Aaaa:
+		if err = argc(1); err != nil {
+			return fail("%s", err.Error())
+		}
+		if record.Type == dnssvcsv1.ResourceRecord_Type_A {
+			record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: core.StringPtr(args[0].text)}
+		} else {
+			record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord{Ip: core.StringPtr(args[0].text)}
+		}
+	case dnssvcsv1.ResourceRecord_Type_Cname, dnssvcsv1.ResourceRecord_Type_Ptr:
+		if err = argc(1); err != nil {
+			return fail("%s", err.Error())
+		}
+		var target string
+		if target, err = p.qualify(args[0].text); err != nil {
+			return fail("%s", err.Error())
+		}
+		if record.Type == dnssvcsv1.ResourceRecord_Type_Cname {
+			record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: core.StringPtr(target)}
+		} else {
+			record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataPtrRecord{Ptrdname: core.StringPtr(target)}
+		}
+	case dnssvcsv1.ResourceRecord_Type_Mx:
+		if err = argc(2); err != nil {
+			return fail("%s", err.Error())
+		}
+		var preference int64
+		var exchange string
+		if preference, err = parseUint16(args[0].text, "preference"); err != nil {
+			return fail("%s", err.Error())
+		}
+		if exchange, err = p.qualify(args[1].text); err != nil {
+			return fail("%s", err.Error())
+		}
+		record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataMxRecord{
+			Preference: core.Int64Ptr(preference),
+			Exchange:   core.StringPtr(exchange),
+		}
+	case dnssvcsv1.ResourceRecord_Type_Srv:
+		if err = argc(4); err != nil {
+			return fail("%s", err.Error())
+		}
+		var values [3]int64
+		for i, field := range []string{"priority", "weight", "port"} {
+			if values[i], err = parseUint16(args[i].text, field); err != nil {
+				return fail("%s", err.Error())
+			}
+		}
+		var target string
+		if target, err = p.qualify(args[3].text); err != nil {
+			return fail("%s", err.Error())
+		}
+		record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord{
+			Priority: core.Int64Ptr(values[0]),
+			Weight:   core.Int64Ptr(values[1]),
+			Port:     core.Int64Ptr(values[2]),
+			Target:   core.StringPtr(target),
+		}
+		labels := strings.SplitN(owner, ".", 3)
+		if len(labels) == 3 && strings.HasPrefix(labels[0], "_") && strings.HasPrefix(labels[1], "_") {
+			record.Service, record.Protocol, record.Name = labels[0], labels[1], labels[2]
+		}
+	case dnssvcsv1.ResourceRecord_Type_Txt:
+		if len(args) == 0 {
+			return fail("TXT record requires at least one character-string")
+		}
+		var text strings.Builder
+		for _, arg := range args {
+			text.WriteString(arg.text)
+		}
+		record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr(text.String())}
+	default:
+		return fail("unsupported record type %s", tokens[0].text)
+	}
+	return
+}
+
+// qualify returns the fully qualified form of a possibly relative name, without trailing dot.
+func (p *parser) qualify(name string) (string, error) {
+	name = strings.ToLower(name)
+	if name == "@" {
+		if p.origin == "" {
+			return "", fmt.Errorf("@ used without an origin")
+		}
+		return p.origin, nil
+	}
+	if strings.HasSuffix(name, ".") {
+		return strings.TrimSuffix(name, "."), nil
+	}
+	if p.origin == "" {
+		return "", fmt.Errorf("relative name %q used without an origin", name)
+	}
+	return name + "." + p.origin, nil
+}
+
+// parseTTL parses a TTL given in seconds or with BIND units (e.g. "1h30m").
+func parseTTL(text string) (ttl int64, err error) {
+	if value, convErr := strconv.ParseUint(text, 10, 31); convErr == nil {
+		return int64(value), nil
+	}
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	var number int64
+	digits := false
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c >= '0' && c <= '9':
+			number = number*10 + int64(c-'0')
+			digits = true
+		case digits && units[c|0x20] > 0:
+			ttl += number * units[c|0x20]
+			number, digits = 0, false
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", text)
+		}
+		if number > 1<<31-1 || ttl > 1<<31-1 {
+			return 0, fmt.Errorf("invalid TTL %q", text)
+		}
+	}
+	if text == "" {
+		return 0, fmt.Errorf("invalid TTL %q", text)
+	}
+	// Trailing digits without unit are seconds.
+	ttl += number
+	return
+}
+
+func parseUint16(text string, field string) (int64, error) {
+	value, err := strconv.ParseUint(text, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", field, text)
+	}
+	return int64(value), nil
+}
+
+// splitEntries splits a zone file into entries, removing comments and joining the lines of
+// parenthesized entries.
+func splitEntries(r io.Reader) (entries []entry, err error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var current *entry
+	depth := 0
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+
+		if depth == 0 {
+			current = &entry{
+				line:         lineNumber,
+				inheritOwner: len(line) > 0 && (line[0] == ' ' || line[0] == '\t'),
+			}
+		}
+
+		for i := 0; i < len(line); {
+			c := line[i]
+			switch {
+			case c == ' ' || c == '\t' || c == '\r':
+				i++
+			case c == ';':
+				i = len(line)
+			case c == '(':
+				depth++
+				i++
+			case c == ')':
+				if depth == 0 {
+					return nil, &ParseError{Line: lineNumber, Message: "unbalanced parenthesis"}
+				}
+				depth--
+				i++
+			case c == '"':
+				text, end, quoteErr := unquote(line, i)
+				if quoteErr != nil {
+					return nil, &ParseError{Line: lineNumber, Message: quoteErr.Error()}
+				}
+				current.tokens = append(current.tokens, token{text: text, quoted: true})
+				i = end
+			default:
+				start := i
+				for i < len(line) && !strings.ContainsRune(" \t\r;()\"", rune(line[i])) {
+					i++
+				}
+				current.tokens = append(current.tokens, token{text: line[start:i]})
+			}
+		}
+
+		if depth == 0 && len(current.tokens) > 0 {
+			entries = append(entries, *current)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, &ParseError{Line: current.line, Message: "unbalanced parenthesis"}
+	}
+	return
+}
+
+// unquote decodes the quoted character-string starting at line[start], and returns its text and
+// the index following the closing quote.
+func unquote(line string, start int) (text string, end int, err error) {
+	var b strings.Builder
+	for i := start + 1; i < len(line); i++ {
+		c := line[i]
+		switch c {
+		case '"':
+			return b.String(), i + 1, nil
+		case '\\':
+			if i+3 < len(line) && isDigit(line[i+1]) && isDigit(line[i+2]) && isDigit(line[i+3]) {
+				value, _ := strconv.Atoi(line[i+1 : i+4])
+				if value > 255 {
+					return "", 0, fmt.Errorf("invalid escape sequence %q", line[i:i+4])
+				}
+				b.WriteByte(byte(value))
+				i += 3
+			} else if i+1 < len(line) {
+				b.WriteByte(line[i+1])
+				i++
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted string")
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
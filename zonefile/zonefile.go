@@ -0,0 +1,290 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package zonefile parses and writes BIND zone files, such as the ones returned by
+// dnssvcsv1.ExportResourceRecords and accepted by dnssvcsv1.ImportResourceRecords.
+//
+// The records of a zone file are represented as Record values whose Rdata is one of the
+// dnssvcsv1.ResourceRecordInputRdataRdata*Record types, so that they can be compared with, or
+// turned into, the resource records of a DNS Services zone. The record types supported are those
+// of DNS Services: A, AAAA, CNAME, MX, PTR, SRV and TXT. The SOA and NS records of the zone, which
+// are managed by the service, are skipped.
+package zonefile
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+)
+
+// ClassIN is the only record class supported.
+const ClassIN = "IN"
+
+// maxCharacterString is the maximum length of one character-string of a TXT record.
+const maxCharacterString = 255
+
+// Zone is the content of a zone file.
+type Zone struct {
+	// The origin of the zone (e.g. "example.com"), without trailing dot.
+	Origin string
+
+	// The default TTL of the records of the zone, in seconds, or zero if not set.
+	TTL int64
+
+	// The records of the zone.
+	Records []Record
+}
+
+// Record is a resource record of a zone file.
+type Record struct {
+	// The fully qualified name of the record, without trailing dot. For an SRV record, the name
+	// does not include the Service and Protocol labels.
+	Name string
+
+	// The time to live of the record, in seconds.
+	TTL int64
+
+	// The type of the record (one of the dnssvcsv1.ResourceRecord_Type_* constants).
+	Type string
+
+	// The data of the record: a *dnssvcsv1.ResourceRecordInputRdataRdataARecord for an A record,
+	// a *dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord for an SRV record, and so on.
+	Rdata dnssvcsv1.ResourceRecordInputRdataIntf
+
+	// The service (e.g. "_sip") and protocol (e.g. "_udp") of an SRV record.
+	Service  string
+	Protocol string
+}
+
+// OwnerName returns the fully qualified owner name of the record as it appears in a zone file,
+// which includes the Service and Protocol labels of an SRV record.
+func (record *Record) OwnerName() string {
+	if record.Type == dnssvcsv1.ResourceRecord_Type_Srv && record.Service != "" && record.Protocol != "" {
+		return record.Service + "." + record.Protocol + "." + record.Name
+	}
+	return record.Name
+}
+
+// RdataString returns the data of the record in zone file presentation format, with fully
+// qualified names ending with a dot.
+func (record *Record) RdataString() string {
+	switch rdata := record.Rdata.(type) {
+	case *dnssvcsv1.ResourceRecordInputRdataRdataARecord:
+		return core.StringNilMapper(rdata.Ip)
+	case *dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord:
+		return core.StringNilMapper(rdata.Ip)
+	case *dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord:
+		return absoluteName(core.StringNilMapper(rdata.Cname))
+	case *dnssvcsv1.ResourceRecordInputRdataRdataPtrRecord:
+		return absoluteName(core.StringNilMapper(rdata.Ptrdname))
+	case *dnssvcsv1.ResourceRecordInputRdataRdataMxRecord:
+		return fmt.Sprintf("%d %s", int64Value(rdata.Preference), absoluteName(core.StringNilMapper(rdata.Exchange)))
+	case *dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord:
+		return fmt.Sprintf("%d %d %d %s", int64Value(rdata.Priority), int64Value(rdata.Weight), int64Value(rdata.Port),
+			absoluteName(core.StringNilMapper(rdata.Target)))
+	case *dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord:
+		return quoteText(core.StringNilMapper(rdata.Text))
+	}
+	return ""
+}
+
+// String returns the record as a line of a zone file.
+func (record *Record) String() string {
+	return fmt.Sprintf("%s %d %s %s %s", absoluteName(record.OwnerName()), record.TTL, ClassIN, record.Type, record.RdataString())
+}
+
+// NewCreateResourceRecordOptions returns the options to create the record in the given DNS Services zone.
+func (record *Record) NewCreateResourceRecordOptions(instanceID string, dnszoneID string) *dnssvcsv1.CreateResourceRecordOptions {
+	options := &dnssvcsv1.CreateResourceRecordOptions{
+		InstanceID: core.StringPtr(instanceID),
+		DnszoneID:  core.StringPtr(dnszoneID),
+		Name:       core.StringPtr(record.Name),
+		Type:       core.StringPtr(record.Type),
+		Rdata:      record.Rdata,
+	}
+	if record.TTL > 0 {
+		options.TTL = core.Int64Ptr(record.TTL)
+	}
+	if record.Service != "" {
+		options.Service = core.StringPtr(record.Service)
+	}
+	if record.Protocol != "" {
+		options.Protocol = core.StringPtr(record.Protocol)
+	}
+	return options
+}
+
+// FromResourceRecord returns the Record for a resource record retrieved from DNS Services.
+func FromResourceRecord(resourceRecord dnssvcsv1.ResourceRecord) (record Record, err error) {
+	record = Record{
+		Name:     strings.TrimSuffix(strings.ToLower(core.StringNilMapper(resourceRecord.Name)), "."),
+		TTL:      int64Value(resourceRecord.TTL),
+		Type:     strings.ToUpper(core.StringNilMapper(resourceRecord.Type)),
+		Service:  core.StringNilMapper(resourceRecord.Service),
+		Protocol: core.StringNilMapper(resourceRecord.Protocol),
+	}
+	if record.Service != "" && record.Protocol != "" {
+		record.Name = strings.TrimPrefix(record.Name, strings.ToLower(record.Service+"."+record.Protocol+"."))
+	}
+
+	rdata, _ := resourceRecord.Rdata.(map[string]interface{})
+	str := func(key string) *string {
+		if value, ok := rdata[key].(string); ok {
+			return core.StringPtr(value)
+		}
+		return nil
+	}
+	name := func(key string) *string {
+		if value, ok := rdata[key].(string); ok {
+			return core.StringPtr(strings.TrimSuffix(strings.ToLower(value), "."))
+		}
+		return nil
+	}
+	num := func(key string) *int64 {
+		switch value := rdata[key].(type) {
+		case float64:
+			return core.Int64Ptr(int64(value))
+		case int64:
+			return core.Int64Ptr(value)
+		}
+		return nil
+	}
+	switch record.Type {
+	case dnssvcsv1.ResourceRecord_Type_A:
+		record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: str("ip")}
+	case dnssvcsv1.ResourceRecord_Type_Aaaa:
+		record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataAaaaRecord{Ip: str("ip")}
+	case dnssvcsv1.ResourceRecord_Type_Cname:
+		record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: name("cname")}
+	case dnssvcsv1.ResourceRecord_Type_Ptr:
+		record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataPtrRecord{Ptrdname: name("ptrdname")}
+	case dnssvcsv1.ResourceRecord_Type_Mx:
+		record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataMxRecord{Exchange: name("exchange"), Preference: num("preference")}
+	case dnssvcsv1.ResourceRecord_Type_Srv:
+		record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataSrvRecord{
+			Priority: num("priority"),
+			Weight:   num("weight"),
+			Port:     num("port"),
+			Target:   name("target"),
+		}
+	case dnssvcsv1.ResourceRecord_Type_Txt:
+		record.Rdata = &dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: str("text")}
+	default:
+		err = fmt.Errorf("unsupported record type %q", record.Type)
+	}
+	return
+}
+
+// Sort sorts the records of the zone in canonical order: by owner name, type and data.
+func (zone *Zone) Sort() {
+	sortRecords(zone.Records)
+}
+
+// sortRecords sorts records in canonical order.
+func sortRecords(records []Record) {
+	sort.SliceStable(records, func(i, j int) bool {
+		a, b := &records[i], &records[j]
+		if a.OwnerName() != b.OwnerName() {
+			return a.OwnerName() < b.OwnerName()
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.RdataString() < b.RdataString()
+	})
+}
+
+// WriteTo writes the zone to w as a canonical zone file: $ORIGIN and $TTL directives followed by one
+// line per record, in canonical order, with fully qualified names and explicit TTL and class.
+// The records of the zone are left in their order.
+func (zone *Zone) WriteTo(w io.Writer) (n int64, err error) {
+	records := append([]Record(nil), zone.Records...)
+	sortRecords(records)
+
+	var b strings.Builder
+	if zone.Origin != "" {
+		fmt.Fprintf(&b, "$ORIGIN %s\n", absoluteName(zone.Origin))
+	}
+	if zone.TTL > 0 {
+		fmt.Fprintf(&b, "$TTL %d\n", zone.TTL)
+	}
+	for i := range records {
+		b.WriteString(records[i].String())
+		b.WriteByte('\n')
+	}
+	written, err := io.WriteString(w, b.String())
+	return int64(written), err
+}
+
+// String returns the zone as a canonical zone file (see WriteTo).
+func (zone *Zone) String() string {
+	var b strings.Builder
+	_, _ = zone.WriteTo(&b)
+	return b.String()
+}
+
+// absoluteName returns the fully qualified form of a name, ending with a dot.
+func absoluteName(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// quoteText returns the text of a TXT record as one or more quoted character-strings.
+func quoteText(text string) string {
+	var parts []string
+	for {
+		chunk := text
+		if len(chunk) > maxCharacterString {
+			chunk = chunk[:maxCharacterString]
+		}
+		text = text[len(chunk):]
+
+		var b strings.Builder
+		b.WriteByte('"')
+		for i := 0; i < len(chunk); i++ {
+			c := chunk[i]
+			switch {
+			case c == '"' || c == '\\':
+				b.WriteByte('\\')
+				b.WriteByte(c)
+			case c < ' ' || c > '~':
+				fmt.Fprintf(&b, "\\%03d", c)
+			default:
+				b.WriteByte(c)
+			}
+		}
+		b.WriteByte('"')
+		parts = append(parts, b.String())
+
+		if text == "" {
+			break
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -0,0 +1,184 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zonefile
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+const exportedZone = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.dns.ibm.com. hostmaster.example.com. (
+		2022010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		3600 )     ; minimum
+	IN	NS	ns1.dns.ibm.com.
+www	300	IN	A	10.0.0.1
+	IN	AAAA	2001:db8::1 ; same owner, zone TTL
+api.example.com.	IN	CNAME	www
+@	IN	MX	10 mail
+_sip._udp.voice	600	IN	SRV	1 5 5060 sip.example.net.
+txt	IN	TXT	( "v=spf1 include:_spf.example.com"
+		" ~all" )
+quote	IN	TXT	"say \"hi\"\059 ok"
+$ORIGIN 0.0.10.in-addr.arpa.
+1	IN	PTR	www.example.com.
+`
+
+func TestParse(t *testing.T) {
+	zone, err := Parse(strings.NewReader(exportedZone), "")
+	assert.Nil(t, err)
+	assert.Equal(t, "example.com", zone.Origin)
+	assert.Equal(t, int64(3600), zone.TTL)
+	assert.Equal(t, 8, len(zone.Records))
+
+	a := zone.Records[0]
+	assert.Equal(t, "www.example.com", a.Name)
+	assert.Equal(t, int64(300), a.TTL)
+	assert.Equal(t, "10.0.0.1", *a.Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataARecord).Ip)
+
+	aaaa := zone.Records[1]
+	assert.Equal(t, "www.example.com", aaaa.Name)
+	assert.Equal(t, dnssvcsv1.ResourceRecord_Type_Aaaa, aaaa.Type)
+	assert.Equal(t, int64(3600), aaaa.TTL)
+
+	cname := zone.Records[2]
+	assert.Equal(t, "api.example.com", cname.Name)
+	assert.Equal(t, "www.example.com", *cname.Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord).Cname)
+
+	mx := zone.Records[3].Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataMxRecord)
+	assert.Equal(t, "example.com", zone.Records[3].Name)
+	assert.Equal(t, int64(10), *mx.Preference)
+	assert.Equal(t, "mail.example.com", *mx.Exchange)
+
+	srv := zone.Records[4]
+	assert.Equal(t, "voice.example.com", srv.Name)
+	assert.Equal(t, "_sip", srv.Service)
+	assert.Equal(t, "_udp", srv.Protocol)
+	assert.Equal(t, "_sip._udp.voice.example.com", srv.OwnerName())
+	assert.Equal(t, "1 5 5060 sip.example.net.", srv.RdataString())
+
+	txt := zone.Records[5].Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord)
+	assert.Equal(t, "v=spf1 include:_spf.example.com ~all", *txt.Text)
+	quote := zone.Records[6].Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord)
+	assert.Equal(t, `say "hi"; ok`, *quote.Text)
+
+	ptr := zone.Records[7]
+	assert.Equal(t, "1.0.0.10.in-addr.arpa", ptr.Name)
+	assert.Equal(t, "www.example.com", *ptr.Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataPtrRecord).Ptrdname)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse(strings.NewReader("www IN A 10.0.0.1\n"), "")
+	var parseErr *ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 1, parseErr.Line)
+	assert.Contains(t, err.Error(), "without an origin")
+
+	_, err = Parse(strings.NewReader("$ORIGIN example.com.\nwww IN MX mail\n"), "")
+	assert.Contains(t, err.Error(), "line 2: MX record requires 2 data fields")
+
+	_, err = Parse(strings.NewReader("www IN CAA 0 issue \"ca.example.net\"\n"), "example.com")
+	assert.Contains(t, err.Error(), "unsupported record type CAA")
+
+	_, err = Parse(strings.NewReader("txt IN TXT ( \"a\"\n"), "example.com")
+	assert.Contains(t, err.Error(), "unbalanced parenthesis")
+
+	_, err = Parse(strings.NewReader("$INCLUDE other.zone\n"), "example.com")
+	assert.Contains(t, err.Error(), "unsupported directive $INCLUDE")
+}
+
+func TestParseTTL(t *testing.T) {
+	for text, expected := range map[string]int64{"3600": 3600, "1h": 3600, "1h30m": 5400, "1W2D": 777600, "2m5": 125} {
+		ttl, err := parseTTL(text)
+		assert.Nil(t, err, text)
+		assert.Equal(t, expected, ttl, text)
+	}
+	for _, text := range []string{"", "h", "1x", "IN", "99999999999"} {
+		_, err := parseTTL(text)
+		assert.NotNil(t, err, text)
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	zone, err := Parse(strings.NewReader(exportedZone), "")
+	assert.Nil(t, err)
+	longText := strings.Repeat("a", 300)
+	zone.Records = append(zone.Records, Record{
+		Name:  "long.example.com",
+		TTL:   60,
+		Type:  dnssvcsv1.ResourceRecord_Type_Txt,
+		Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: core.StringPtr(longText)},
+	})
+
+	var b strings.Builder
+	n, err := zone.WriteTo(&b)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(b.Len()), n)
+	expected := `$ORIGIN example.com.
+$TTL 3600
+1.0.0.10.in-addr.arpa. 3600 IN PTR www.example.com.
+_sip._udp.voice.example.com. 600 IN SRV 1 5 5060 sip.example.net.
+api.example.com. 3600 IN CNAME www.example.com.
+example.com. 3600 IN MX 10 mail.example.com.
+long.example.com. 60 IN TXT "` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"
+quote.example.com. 3600 IN TXT "say \"hi\"; ok"
+txt.example.com. 3600 IN TXT "v=spf1 include:_spf.example.com ~all"
+www.example.com. 300 IN A 10.0.0.1
+www.example.com. 3600 IN AAAA 2001:db8::1
+`
+	assert.Equal(t, expected, b.String())
+	assert.Equal(t, "long.example.com", zone.Records[len(zone.Records)-1].Name)
+
+	// The canonical form parses back to the same zone.
+	reparsed, err := Parse(strings.NewReader(expected), "")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, reparsed.String())
+	assert.Equal(t, longText, *reparsed.Records[4].Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord).Text)
+}
+
+func TestFromResourceRecord(t *testing.T) {
+	record, err := FromResourceRecord(dnssvcsv1.ResourceRecord{
+		Name:     core.StringPtr("_sip._udp.Voice.example.com"),
+		Type:     core.StringPtr("SRV"),
+		TTL:      core.Int64Ptr(600),
+		Service:  core.StringPtr("_sip"),
+		Protocol: core.StringPtr("_udp"),
+		Rdata: map[string]interface{}{
+			"priority": float64(1), "weight": float64(5), "port": float64(5060), "target": "SIP.example.net.",
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "_sip._udp.voice.example.com. 600 IN SRV 1 5 5060 sip.example.net.", record.String())
+
+	options := record.NewCreateResourceRecordOptions("instance-1", "zone-1")
+	assert.Equal(t, "voice.example.com", *options.Name)
+	assert.Equal(t, "_sip", *options.Service)
+	assert.Equal(t, int64(600), *options.TTL)
+	assert.Equal(t, record.Rdata, options.Rdata)
+
+	_, err = FromResourceRecord(dnssvcsv1.ResourceRecord{Type: core.StringPtr("CAA")})
+	assert.NotNil(t, err)
+}
@@ -178,6 +178,24 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) DisableRetries() {
 	zoneFirewallAccessRules.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(zoneFirewallAccessRules.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(zoneFirewallAccessRules.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(zoneFirewallAccessRules.Service, middleware...)
+}
+
 // ListAllZoneAccessRules : List all firewall access rules
 // List all firewall access rules for a zone.
 func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) ListAllZoneAccessRules(listAllZoneAccessRulesOptions *ListAllZoneAccessRulesOptions) (result *ListZoneAccessRulesResp, response *core.DetailedResponse, err error) {
@@ -250,6 +268,7 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) ListAllZoneAccessRules
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneFirewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListZoneAccessRulesResp)
@@ -321,6 +340,7 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) CreateZoneAccessRuleWi
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneFirewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalZoneAccessRuleResp)
@@ -381,6 +401,7 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) DeleteZoneAccessRuleWi
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneFirewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteZoneAccessRuleResp)
@@ -441,6 +462,7 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) GetZoneAccessRuleWithC
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneFirewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalZoneAccessRuleResp)
@@ -514,6 +536,7 @@ func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) UpdateZoneAccessRuleWi
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneFirewallAccessRules.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalZoneAccessRuleResp)
@@ -1189,3 +1212,97 @@ func UnmarshalZoneAccessRuleResp(m map[string]json.RawMessage, result interface{
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZoneAccessRulesPager can be used to simplify the use of the "ListAllZoneAccessRules" method.
+type ZoneAccessRulesPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListAllZoneAccessRulesOptions
+	client   *ZoneFirewallAccessRulesV1
+}
+
+// NewZoneAccessRulesPager returns a new ZoneAccessRulesPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (zoneFirewallAccessRules *ZoneFirewallAccessRulesV1) NewZoneAccessRulesPager(options *ListAllZoneAccessRulesOptions) (pager *ZoneAccessRulesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListAllZoneAccessRulesOptions = *options
+	pager = &ZoneAccessRulesPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   zoneFirewallAccessRules,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZoneAccessRulesPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZoneAccessRulesPager) GetNextWithContext(ctx context.Context) (page []ZoneAccessRuleObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListAllZoneAccessRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ListZoneAccessRulesRespResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZoneAccessRulesPager) GetAllWithContext(ctx context.Context) (allItems []ZoneAccessRuleObject, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []ZoneAccessRuleObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneAccessRulesPager) GetNext() (page []ZoneAccessRuleObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneAccessRulesPager) GetAll() (allItems []ZoneAccessRuleObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
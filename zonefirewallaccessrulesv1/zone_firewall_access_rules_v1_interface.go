@@ -0,0 +1,41 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zonefirewallaccessrulesv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ZoneFirewallAccessRulesV1API is the interface of the operations of ZoneFirewallAccessRulesV1, through which they can be replaced
+// in unit tests, for instance with the mocks.ZoneFirewallAccessRulesV1 of the mocks package.
+type ZoneFirewallAccessRulesV1API interface {
+	ListAllZoneAccessRules(listAllZoneAccessRulesOptions *ListAllZoneAccessRulesOptions) (result *ListZoneAccessRulesResp, response *core.DetailedResponse, err error)
+	ListAllZoneAccessRulesWithContext(ctx context.Context, listAllZoneAccessRulesOptions *ListAllZoneAccessRulesOptions) (result *ListZoneAccessRulesResp, response *core.DetailedResponse, err error)
+	CreateZoneAccessRule(createZoneAccessRuleOptions *CreateZoneAccessRuleOptions) (result *ZoneAccessRuleResp, response *core.DetailedResponse, err error)
+	CreateZoneAccessRuleWithContext(ctx context.Context, createZoneAccessRuleOptions *CreateZoneAccessRuleOptions) (result *ZoneAccessRuleResp, response *core.DetailedResponse, err error)
+	DeleteZoneAccessRule(deleteZoneAccessRuleOptions *DeleteZoneAccessRuleOptions) (result *DeleteZoneAccessRuleResp, response *core.DetailedResponse, err error)
+	DeleteZoneAccessRuleWithContext(ctx context.Context, deleteZoneAccessRuleOptions *DeleteZoneAccessRuleOptions) (result *DeleteZoneAccessRuleResp, response *core.DetailedResponse, err error)
+	GetZoneAccessRule(getZoneAccessRuleOptions *GetZoneAccessRuleOptions) (result *ZoneAccessRuleResp, response *core.DetailedResponse, err error)
+	GetZoneAccessRuleWithContext(ctx context.Context, getZoneAccessRuleOptions *GetZoneAccessRuleOptions) (result *ZoneAccessRuleResp, response *core.DetailedResponse, err error)
+	UpdateZoneAccessRule(updateZoneAccessRuleOptions *UpdateZoneAccessRuleOptions) (result *ZoneAccessRuleResp, response *core.DetailedResponse, err error)
+	UpdateZoneAccessRuleWithContext(ctx context.Context, updateZoneAccessRuleOptions *UpdateZoneAccessRuleOptions) (result *ZoneAccessRuleResp, response *core.DetailedResponse, err error)
+}
+
+// ZoneFirewallAccessRulesV1 implements ZoneFirewallAccessRulesV1API.
+var _ ZoneFirewallAccessRulesV1API = (*ZoneFirewallAccessRulesV1)(nil)
@@ -178,6 +178,24 @@ func (zoneLockdown *ZoneLockdownV1) DisableRetries() {
 	zoneLockdown.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (zoneLockdown *ZoneLockdownV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(zoneLockdown.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (zoneLockdown *ZoneLockdownV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(zoneLockdown.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (zoneLockdown *ZoneLockdownV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(zoneLockdown.Service, middleware...)
+}
+
 // ListAllZoneLockownRules : List all lockdown rules
 // List all lockdown rules for a zone.
 func (zoneLockdown *ZoneLockdownV1) ListAllZoneLockownRules(listAllZoneLockownRulesOptions *ListAllZoneLockownRulesOptions) (result *ListLockdownResp, response *core.DetailedResponse, err error) {
@@ -229,6 +247,7 @@ func (zoneLockdown *ZoneLockdownV1) ListAllZoneLockownRulesWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneLockdown.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListLockdownResp)
@@ -309,6 +328,7 @@ func (zoneLockdown *ZoneLockdownV1) CreateZoneLockdownRuleWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneLockdown.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLockdownResp)
@@ -369,6 +389,7 @@ func (zoneLockdown *ZoneLockdownV1) DeleteZoneLockdownRuleWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneLockdown.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteLockdownResp)
@@ -429,6 +450,7 @@ func (zoneLockdown *ZoneLockdownV1) GetLockdownWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneLockdown.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLockdownResp)
@@ -514,6 +536,7 @@ func (zoneLockdown *ZoneLockdownV1) UpdateLockdownRuleWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneLockdown.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLockdownResp)
@@ -1074,3 +1097,97 @@ func UnmarshalLockdownResp(m map[string]json.RawMessage, result interface{}) (er
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZoneLockdownRulesPager can be used to simplify the use of the "ListAllZoneLockownRules" method.
+type ZoneLockdownRulesPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListAllZoneLockownRulesOptions
+	client   *ZoneLockdownV1
+}
+
+// NewZoneLockdownRulesPager returns a new ZoneLockdownRulesPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (zoneLockdown *ZoneLockdownV1) NewZoneLockdownRulesPager(options *ListAllZoneLockownRulesOptions) (pager *ZoneLockdownRulesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListAllZoneLockownRulesOptions = *options
+	pager = &ZoneLockdownRulesPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   zoneLockdown,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZoneLockdownRulesPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZoneLockdownRulesPager) GetNextWithContext(ctx context.Context) (page []LockdownObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListAllZoneLockownRulesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ListLockdownRespResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZoneLockdownRulesPager) GetAllWithContext(ctx context.Context) (allItems []LockdownObject, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []LockdownObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneLockdownRulesPager) GetNext() (page []LockdownObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneLockdownRulesPager) GetAll() (allItems []LockdownObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -178,6 +178,24 @@ func (zoneRateLimits *ZoneRateLimitsV1) DisableRetries() {
 	zoneRateLimits.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (zoneRateLimits *ZoneRateLimitsV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(zoneRateLimits.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (zoneRateLimits *ZoneRateLimitsV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(zoneRateLimits.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (zoneRateLimits *ZoneRateLimitsV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(zoneRateLimits.Service, middleware...)
+}
+
 // ListAllZoneRateLimits : List all rate limits
 // The details of Rate Limit for a given zone under a given service instance.
 func (zoneRateLimits *ZoneRateLimitsV1) ListAllZoneRateLimits(listAllZoneRateLimitsOptions *ListAllZoneRateLimitsOptions) (result *ListRatelimitResp, response *core.DetailedResponse, err error) {
@@ -229,6 +247,7 @@ func (zoneRateLimits *ZoneRateLimitsV1) ListAllZoneRateLimitsWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneRateLimits.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListRatelimitResp)
@@ -315,6 +334,7 @@ func (zoneRateLimits *ZoneRateLimitsV1) CreateZoneRateLimitsWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneRateLimits.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRatelimitResp)
@@ -375,6 +395,7 @@ func (zoneRateLimits *ZoneRateLimitsV1) DeleteZoneRateLimitWithContext(ctx conte
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneRateLimits.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteRateLimitResp)
@@ -435,6 +456,7 @@ func (zoneRateLimits *ZoneRateLimitsV1) GetRateLimitWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneRateLimits.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRatelimitResp)
@@ -526,6 +548,7 @@ func (zoneRateLimits *ZoneRateLimitsV1) UpdateRateLimitWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = zoneRateLimits.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRatelimitResp)
@@ -1684,3 +1707,97 @@ func UnmarshalRatelimitResp(m map[string]json.RawMessage, result interface{}) (e
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZoneRateLimitsPager can be used to simplify the use of the "ListAllZoneRateLimits" method.
+type ZoneRateLimitsPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListAllZoneRateLimitsOptions
+	client   *ZoneRateLimitsV1
+}
+
+// NewZoneRateLimitsPager returns a new ZoneRateLimitsPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (zoneRateLimits *ZoneRateLimitsV1) NewZoneRateLimitsPager(options *ListAllZoneRateLimitsOptions) (pager *ZoneRateLimitsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListAllZoneRateLimitsOptions = *options
+	pager = &ZoneRateLimitsPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   zoneRateLimits,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZoneRateLimitsPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZoneRateLimitsPager) GetNextWithContext(ctx context.Context) (page []RatelimitObject, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListAllZoneRateLimitsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ListRatelimitRespResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZoneRateLimitsPager) GetAllWithContext(ctx context.Context) (allItems []RatelimitObject, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []RatelimitObject
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneRateLimitsPager) GetNext() (page []RatelimitObject, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZoneRateLimitsPager) GetAll() (allItems []RatelimitObject, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
@@ -171,6 +171,24 @@ func (zones *ZonesV1) DisableRetries() {
 	zones.Service.DisableRetries()
 }
 
+// EnableRetryPolicies enables automatic retries governed by the specified default policy for requests invoked
+// for this service instance, and returns the transport on which per-operation policies can be set.
+func (zones *ZonesV1) EnableRetryPolicies(policy *common.RetryPolicy) *common.RetryTransport {
+	return common.EnableRetryPolicies(zones.Service, policy)
+}
+
+// EnableRecorder records the requests invoked for this service instance, along with their responses, in the
+// cassette file at path, or replays them from it, according to the specified mode.
+func (zones *ZonesV1) EnableRecorder(path string, mode common.RecorderMode) (*common.Recorder, error) {
+	return common.EnableRecorder(zones.Service, path, mode)
+}
+
+// EnableMiddleware adds the specified middleware to the chain that every request invoked for this service
+// instance passes through, and returns the transport that runs the chain.
+func (zones *ZonesV1) EnableMiddleware(middleware ...common.Middleware) *common.MiddlewareTransport {
+	return common.EnableMiddleware(zones.Service, middleware...)
+}
+
 // ListZones : List all zones
 // List all zones for a service instance.
 func (zones *ZonesV1) ListZones(listZonesOptions *ListZonesOptions) (result *ListZonesResp, response *core.DetailedResponse, err error) {
@@ -221,6 +239,7 @@ func (zones *ZonesV1) ListZonesWithContext(ctx context.Context, listZonesOptions
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalListZonesResp)
@@ -285,6 +304,7 @@ func (zones *ZonesV1) CreateZoneWithContext(ctx context.Context, createZoneOptio
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalZoneResp)
@@ -344,6 +364,7 @@ func (zones *ZonesV1) DeleteZoneWithContext(ctx context.Context, deleteZoneOptio
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalDeleteZoneResp)
@@ -403,6 +424,7 @@ func (zones *ZonesV1) GetZoneWithContext(ctx context.Context, getZoneOptions *Ge
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalZoneResp)
@@ -472,6 +494,7 @@ func (zones *ZonesV1) UpdateZoneWithContext(ctx context.Context, updateZoneOptio
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalZoneResp)
@@ -531,6 +554,7 @@ func (zones *ZonesV1) ZoneActivationCheckWithContext(ctx context.Context, zoneAc
 	var rawResponse map[string]json.RawMessage
 	response, err = zones.Service.Request(request, &rawResponse)
 	if err != nil {
+		err = common.NewAPIError(response, err)
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalZoneActivationcheckResp)
@@ -1034,3 +1058,97 @@ func UnmarshalZoneResp(m map[string]json.RawMessage, result interface{}) (err er
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZonesPager can be used to simplify the use of the "ListZones" method.
+type ZonesPager struct {
+	pageInfo *common.ResultInfoPager
+	options  *ListZonesOptions
+	client   *ZonesV1
+}
+
+// NewZonesPager returns a new ZonesPager instance. The page size is taken from
+// options.PerPage, or left to the service default if it is not set.
+func (zones *ZonesV1) NewZonesPager(options *ListZonesOptions) (pager *ZonesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	if options.Page != nil && *options.Page > 1 {
+		err = fmt.Errorf("the 'options.Page' field should not be set")
+		return
+	}
+
+	pageInfo, err := common.NewResultInfoPager(options.PerPage)
+	if err != nil {
+		return
+	}
+
+	var optionsCopy ListZonesOptions = *options
+	pager = &ZonesPager{
+		pageInfo: pageInfo,
+		options:  &optionsCopy,
+		client:   zones,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZonesPager) HasNext() bool {
+	return pager.pageInfo.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZonesPager) GetNextWithContext(ctx context.Context) (page []ZoneDetails, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Page = pager.pageInfo.Page()
+	pager.options.PerPage = pager.pageInfo.PerPage()
+
+	result, _, err := pager.client.ListZonesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		pager.pageInfo.Done()
+		return
+	}
+
+	resultInfo := result.ResultInfo
+	if resultInfo == nil {
+		resultInfo = new(ResultInfo)
+	}
+	if pager.pageInfo.Update(resultInfo.Page, resultInfo.PerPage, resultInfo.Count, resultInfo.TotalCount, len(result.Result)) {
+		page = result.Result
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZonesPager) GetAllWithContext(ctx context.Context) (allItems []ZoneDetails, err error) {
+	for pager.HasNext() {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var nextPage []ZoneDetails
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZonesPager) GetNext() (page []ZoneDetails, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZonesPager) GetAll() (allItems []ZoneDetails, err error) {
+	return pager.GetAllWithContext(context.Background())
+}